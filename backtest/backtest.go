@@ -0,0 +1,624 @@
+package backtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/service"
+	"github.com/ShahoBashoki/kucoin/strategy"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// Backtester is an interface.
+	Backtester interface {
+		// Run is a function.
+		Run(
+			context.Context,
+			[]strategy.Strategier,
+		) (Resulter, error)
+	}
+
+	backtest struct {
+		configConfigger  config.Configger
+		logRuntimeLogger log.RuntimeLogger
+		objectTimer      object.Timer
+		servicer         service.Servicer
+		traceTracer      trace.Tracer
+		utilUUIDer       util.UUIDer
+	}
+
+	backtestPosition struct {
+		entryAt    int64
+		entryPrice float64
+		fee        float64
+		funds      float64
+		heldCount  uint32
+		reason     string
+		size       float64
+		strategy   string
+	}
+
+	backtestRun struct {
+		cash       float64
+		equities   []om.BacktestEquitier
+		exposed    uint32
+		feeRates   map[string]float64
+		lastPrices map[string]float64
+		positions  map[string]*backtestPosition
+		trades     []om.BacktestTrader
+	}
+
+	backtestOrderBook struct {
+		Asks     [][]string `json:"asks"`
+		Bids     [][]string `json:"bids"`
+		Sequence int64      `json:"sequence"`
+		Symbol   string     `json:"symbol"`
+		Time     int64      `json:"time"`
+	}
+)
+
+var (
+	_ Backtester           = (*backtest)(nil)
+	_ config.GetConfigger  = (*backtest)(nil)
+	_ log.GetRuntimeLogger = (*backtest)(nil)
+	_ object.GetTimer      = (*backtest)(nil)
+	_ service.GetServicer  = (*backtest)(nil)
+	_ util.GetTracer       = (*backtest)(nil)
+	_ util.GetUUIDer       = (*backtest)(nil)
+)
+
+// NewBacktest is a function.
+// The timer is only used to resolve an end of zero to now,
+// the replay itself runs on a simulated clock.
+func NewBacktest(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	objectTimer object.Timer,
+	servicer service.Servicer,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+) *backtest {
+	return &backtest{
+		configConfigger:  configConfigger,
+		logRuntimeLogger: logRuntimeLogger,
+		objectTimer:      objectTimer,
+		servicer:         servicer,
+		traceTracer:      traceTracer,
+		utilUUIDer:       utilUUIDer,
+	}
+}
+
+// GetConfigger is a function.
+func (backtest *backtest) GetConfigger() config.Configger {
+	return backtest.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (backtest *backtest) GetRuntimeLogger() log.RuntimeLogger {
+	return backtest.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (backtest *backtest) GetServicer() service.Servicer {
+	return backtest.servicer
+}
+
+// GetTimer is a function.
+func (backtest *backtest) GetTimer() object.Timer {
+	return backtest.objectTimer
+}
+
+// GetTracer is a function.
+func (backtest *backtest) GetTracer() trace.Tracer {
+	return backtest.traceTracer
+}
+
+// GetUUIDer is a function.
+func (backtest *backtest) GetUUIDer() util.UUIDer {
+	return backtest.utilUUIDer
+}
+
+// Run is a function.
+// Every kline boundary between the configured start and end the clock is set, the strategies
+// are evaluated and their signals are filled at the open of the candle that starts there,
+// moved against the order by the slippage and charged the taker fee of the ticker.
+// A position is closed by a sell signal or after the max hold kline count.
+func (backtest *backtest) Run(
+	ctx context.Context,
+	strategiers []strategy.Strategier,
+) (Resulter, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = backtest.GetTracer().Start(
+		ctx,
+		"Run",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, backtest.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Run",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": backtest.configConfigger,
+	}
+
+	backtest.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	backtestConfigger := backtest.GetConfigger().GetBacktestConfigger()
+	klineType := object.KlineTypeType(backtestConfigger.GetKlineType())
+	secondKlineType := util.KlineTypeToSecond(klineType)
+	startAt := util.KlineBucketStartAt(klineType, backtestConfigger.GetStartAt())
+	endAt := backtestConfigger.GetEndAt()
+
+	if endAt == 0 {
+		backtest.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`endAt == 0`)
+
+		endAt = backtest.GetTimer().NowUTC().Unix()
+	}
+
+	backtest.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldStartAt, startAt).
+		WithField(object.URIFieldEndAt, endAt).
+		Debug(object.URIEmpty)
+
+	omTickers := make([]om.Tickerer, 0, len(backtestConfigger.GetSymbols()))
+	feeRates := map[string]float64{}
+
+	for _, symbol := range backtestConfigger.GetSymbols() {
+		omTicker, err := backtest.GetServicer().GetTickerServicer().GetBySymbol(ctx, symbol)
+		if err != nil {
+			backtest.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrTickerServiceGetBySymbol.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrTickerServiceGetBySymbol.Error())
+
+			return nil, err
+		}
+
+		feeRate, err := backtest.feeRate(omTicker)
+		if err != nil {
+			backtest.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrSTRCONVParseFloat.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseFloat.Error())
+
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		omTickers = append(omTickers, omTicker)
+		feeRates[symbol] = feeRate
+	}
+
+	omOrderBooks, err := backtest.loadOrderBooks(backtestConfigger.GetOrderBookFile())
+	if err != nil {
+		backtest.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBacktestOrderBookLoad.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBacktestOrderBookLoad.Error())
+
+		return nil, err
+	}
+
+	clocker := NewClock(time.Unix(startAt, 0))
+	market := newMarket(clocker, backtest.GetServicer(), omOrderBooks, omTickers, endAt, startAt)
+	backtestRun := &backtestRun{
+		cash:       backtestConfigger.GetInitialBalance(),
+		equities:   []om.BacktestEquitier{},
+		exposed:    0,
+		feeRates:   feeRates,
+		lastPrices: map[string]float64{},
+		positions:  map[string]*backtestPosition{},
+		trades:     []om.BacktestTrader{},
+	}
+
+	for nowUnix := startAt; nowUnix <= endAt; nowUnix += secondKlineType {
+		if err = ctx.Err(); err != nil {
+			backtest.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrBacktestRun.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrBacktestRun.Error())
+
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		clocker.Set(time.Unix(nowUnix, 0))
+
+		omSignals := make([]om.Signaler, 0)
+
+		for _, strategier := range strategiers {
+			strategyOMSignals, errStrategyEvaluate := strategier.Evaluate(ctx, market)
+			if errStrategyEvaluate != nil {
+				backtest.GetRuntimeLogger().
+					WithFields(fields).
+					WithField(object.URIFieldStrategy, strategier.GetName()).
+					WithField(object.URIFieldError, errStrategyEvaluate).
+					Error(object.ErrStrategyEvaluate.Error())
+
+				continue
+			}
+
+			omSignals = append(omSignals, strategyOMSignals...)
+		}
+
+		backtest.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldStartAt, nowUnix).
+			WithField(object.URIFieldOMSignals, omSignals).
+			Debug(object.URIEmpty)
+
+		backtest.step(ctx, market, backtestRun, klineType, nowUnix, omSignals)
+	}
+
+	backtest.closeAll(backtestRun, endAt)
+
+	omBacktestStatistic := backtest.statistic(backtestRun, secondKlineType)
+
+	backtest.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMBacktestStatistic, omBacktestStatistic).
+		Debug(object.URIEmpty)
+
+	return NewResult(backtestRun.equities, omBacktestStatistic, backtestRun.trades), nil
+}
+
+// step fills the exits before the entries, so a sell signal frees the funds for a buy.
+func (backtest *backtest) step(
+	ctx context.Context,
+	market *market,
+	backtestRun *backtestRun,
+	klineType object.KlineTypeType,
+	nowUnix int64,
+	omSignals []om.Signaler,
+) {
+	backtestConfigger := backtest.GetConfigger().GetBacktestConfigger()
+	slippage := backtestConfigger.GetSlippage()
+	opens := map[string]float64{}
+
+	for symbol := range backtestRun.feeRates {
+		omKliner, err := market.kline(ctx, klineType, symbol, nowUnix)
+		if err != nil {
+			continue
+		}
+
+		open, err := strconv.ParseFloat(omKliner.GetOpen(), 64)
+		if err != nil {
+			continue
+		}
+
+		opens[symbol] = open
+		backtestRun.lastPrices[symbol] = open
+	}
+
+	sells := map[string]bool{}
+	for _, omSignal := range omSignals {
+		if omSignal.GetSide() == string(object.OrderSideTypeSell) {
+			sells[omSignal.GetSymbol()] = true
+		}
+	}
+
+	for _, symbol := range backtest.positionSymbols(backtestRun) {
+		position := backtestRun.positions[symbol]
+		position.heldCount++
+
+		open, ok := opens[symbol]
+		if !ok {
+			continue
+		}
+
+		exitReason := object.URIEmpty
+
+		switch {
+		case sells[symbol]:
+			exitReason = object.URIBacktestExitReasonSignal
+
+		case position.heldCount >= backtestConfigger.GetMaxHoldKlineCount():
+			exitReason = object.URIBacktestExitReasonHold
+
+		default:
+			continue
+		}
+
+		backtest.close(backtestRun, symbol, open*(1-slippage), nowUnix, exitReason)
+	}
+
+	for _, omSignal := range omSignals {
+		symbol := omSignal.GetSymbol()
+
+		if omSignal.GetSide() != string(object.OrderSideTypeBuy) {
+			continue
+		}
+
+		if _, ok := backtestRun.positions[symbol]; ok {
+			continue
+		}
+
+		open, ok := opens[symbol]
+		if !ok || backtestRun.cash < backtestConfigger.GetOrderFunds() {
+			continue
+		}
+
+		price := open * (1 + slippage)
+		funds := backtestConfigger.GetOrderFunds()
+		fee := funds * backtestRun.feeRates[symbol]
+
+		backtestRun.cash -= funds
+		backtestRun.positions[symbol] = &backtestPosition{
+			entryAt:    nowUnix,
+			entryPrice: price,
+			fee:        fee,
+			funds:      funds,
+			heldCount:  0,
+			reason:     omSignal.GetReason(),
+			size:       (funds - fee) / price,
+			strategy:   omSignal.GetStrategy(),
+		}
+	}
+
+	positionValue := 0.0
+	for symbol, position := range backtestRun.positions {
+		positionValue += position.size * backtestRun.lastPrices[symbol]
+	}
+
+	if len(backtestRun.positions) != 0 {
+		backtestRun.exposed++
+	}
+
+	backtestRun.equities = append(backtestRun.equities, om.NewBacktestEquity(
+		nowUnix,
+		backtestRun.cash,
+		backtestRun.cash+positionValue,
+		positionValue,
+		uuid.Nil,
+	))
+}
+
+func (backtest *backtest) close(
+	backtestRun *backtestRun,
+	symbol string,
+	price float64,
+	nowUnix int64,
+	exitReason string,
+) {
+	position := backtestRun.positions[symbol]
+	value := position.size * price
+	fee := value * backtestRun.feeRates[symbol]
+	profit := value - fee - position.funds
+
+	backtestRun.cash += value - fee
+	backtestRun.trades = append(backtestRun.trades, om.NewBacktestTrade(
+		exitReason,
+		position.reason,
+		position.strategy,
+		symbol,
+		position.entryAt,
+		nowUnix,
+		position.entryPrice,
+		price,
+		position.fee+fee,
+		profit,
+		profit/position.funds,
+		position.size,
+		uuid.Nil,
+	))
+
+	delete(backtestRun.positions, symbol)
+}
+
+// closeAll closes what is still open at the last known price and restates the last equity.
+func (backtest *backtest) closeAll(
+	backtestRun *backtestRun,
+	endAt int64,
+) {
+	slippage := backtest.GetConfigger().GetBacktestConfigger().GetSlippage()
+
+	for _, symbol := range backtest.positionSymbols(backtestRun) {
+		price := backtestRun.lastPrices[symbol] * (1 - slippage)
+
+		backtest.close(backtestRun, symbol, price, endAt, object.URIBacktestExitReasonEnd)
+	}
+
+	if len(backtestRun.equities) == 0 {
+		return
+	}
+
+	last := len(backtestRun.equities) - 1
+	backtestRun.equities[last] = om.NewBacktestEquity(
+		backtestRun.equities[last].GetTime(),
+		backtestRun.cash,
+		backtestRun.cash,
+		0,
+		uuid.Nil,
+	)
+}
+
+// feeRate is the taker fee rate of the ticker scaled by its coefficient.
+func (backtest *backtest) feeRate(
+	omTicker om.Tickerer,
+) (float64, error) {
+	takerFeeRate, err := strconv.ParseFloat(omTicker.GetTakerFeeRate(), 64)
+	if err != nil {
+		return 0, err
+	}
+
+	takerCoefficient, err := strconv.ParseFloat(omTicker.GetTakerCoefficient(), 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return takerFeeRate * takerCoefficient, nil
+}
+
+// loadOrderBooks reads the recorded order books, a JSON array in the om.OrderBooker layout with
+// the time in milliseconds, and sorts them by time per symbol.
+func (backtest *backtest) loadOrderBooks(
+	file string,
+) (map[string][]om.OrderBooker, error) {
+	omOrderBooks := map[string][]om.OrderBooker{}
+
+	if file == object.URIEmpty {
+		return omOrderBooks, nil
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", object.ErrBacktestOrderBookLoad, err)
+	}
+
+	backtestOrderBooks := []backtestOrderBook{}
+
+	if err = json.Unmarshal(data, &backtestOrderBooks); err != nil {
+		return nil, fmt.Errorf("%w: %w", object.ErrBacktestOrderBookLoad, err)
+	}
+
+	for _, backtestOrderBook := range backtestOrderBooks {
+		omOrderBooks[backtestOrderBook.Symbol] = append(
+			omOrderBooks[backtestOrderBook.Symbol],
+			om.NewOrderBook(
+				backtestOrderBook.Asks,
+				backtestOrderBook.Bids,
+				backtestOrderBook.Symbol,
+				backtestOrderBook.Sequence,
+				backtestOrderBook.Time,
+				uuid.Nil,
+			),
+		)
+	}
+
+	for _, omOrderBookers := range omOrderBooks {
+		sort.SliceStable(omOrderBookers, func(first, second int) bool {
+			return omOrderBookers[first].GetTime() < omOrderBookers[second].GetTime()
+		})
+	}
+
+	return omOrderBooks, nil
+}
+
+// positionSymbols keeps the fills in a stable order between runs.
+func (backtest *backtest) positionSymbols(
+	backtestRun *backtestRun,
+) []string {
+	symbols := make([]string, 0, len(backtestRun.positions))
+	for symbol := range backtestRun.positions {
+		symbols = append(symbols, symbol)
+	}
+
+	sort.Strings(symbols)
+
+	return symbols
+}
+
+// statistic annualizes the Sharpe ratio of the per kline returns with a zero risk free rate.
+func (backtest *backtest) statistic(
+	backtestRun *backtestRun,
+	secondKlineType int64,
+) om.BacktestStatisticer {
+	initialEquity := backtest.GetConfigger().GetBacktestConfigger().GetInitialBalance()
+	finalEquity := backtestRun.cash
+	peak := initialEquity
+	maxDrawdown := 0.0
+	previous := initialEquity
+	returns := make([]float64, 0, len(backtestRun.equities))
+
+	for _, omBacktestEquitier := range backtestRun.equities {
+		equity := omBacktestEquitier.GetEquity()
+
+		if equity > peak {
+			peak = equity
+		}
+
+		if peak > 0 && (peak-equity)/peak > maxDrawdown {
+			maxDrawdown = (peak - equity) / peak
+		}
+
+		if previous != 0 {
+			returns = append(returns, equity/previous-1)
+		}
+
+		previous = equity
+	}
+
+	totalReturn := 0.0
+	if initialEquity != 0 {
+		totalReturn = finalEquity/initialEquity - 1
+	}
+
+	sharpe := 0.0
+
+	if len(returns) > 1 {
+		mean := 0.0
+		for _, value := range returns {
+			mean += value
+		}
+
+		mean /= float64(len(returns))
+
+		variance := 0.0
+		for _, value := range returns {
+			variance += (value - mean) * (value - mean)
+		}
+
+		deviation := math.Sqrt(variance / float64(len(returns)-1))
+		if deviation != 0 {
+			sharpe = mean / deviation * math.Sqrt(float64(object.NUM1YearToSecond/secondKlineType))
+		}
+	}
+
+	wins := 0
+	for _, omBacktestTrader := range backtestRun.trades {
+		if omBacktestTrader.GetProfit() > 0 {
+			wins++
+		}
+	}
+
+	winRate := 0.0
+	if len(backtestRun.trades) != 0 {
+		winRate = float64(wins) / float64(len(backtestRun.trades))
+	}
+
+	exposure := 0.0
+	if len(backtestRun.equities) != 0 {
+		exposure = float64(backtestRun.exposed) / float64(len(backtestRun.equities))
+	}
+
+	return om.NewBacktestStatistic(
+		exposure,
+		finalEquity,
+		initialEquity,
+		maxDrawdown,
+		totalReturn,
+		sharpe,
+		winRate,
+		uint32(len(backtestRun.trades)),
+		uuid.Nil,
+	)
+}
@@ -0,0 +1,356 @@
+package backtest
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/service"
+	"github.com/ShahoBashoki/kucoin/strategy"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type (
+	backtestTestServicer struct {
+		service.Servicer
+		klineServicer  service.KlineServicer
+		tickerServicer service.TickerServicer
+	}
+
+	backtestTestKlineServicer struct {
+		service.KlineServicer
+		omKliners []om.Kliner
+	}
+
+	backtestTestTickerServicer struct {
+		service.TickerServicer
+	}
+
+	// backtestTestStrategy signals the side at every time it is keyed by.
+	backtestTestStrategy struct {
+		sides map[int64]object.OrderSideType
+	}
+)
+
+// GetKlineServicer is a function.
+func (servicer *backtestTestServicer) GetKlineServicer() service.KlineServicer {
+	return servicer.klineServicer
+}
+
+// GetTickerServicer is a function.
+func (servicer *backtestTestServicer) GetTickerServicer() service.TickerServicer {
+	return servicer.tickerServicer
+}
+
+// GetResampledListFromRepository is a function.
+func (servicer *backtestTestKlineServicer) GetResampledListFromRepository(
+	_ context.Context,
+	_ dto.KlineRequester,
+) ([]om.Kliner, error) {
+	return servicer.omKliners, nil
+}
+
+// GetBySymbol is a function.
+func (servicer *backtestTestTickerServicer) GetBySymbol(
+	_ context.Context,
+	symbol string,
+) (om.Tickerer, error) {
+	return om.NewTicker(
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		"1",
+		"0.001",
+		object.URIEmpty,
+		symbol,
+		symbol,
+		"1",
+		"0.001",
+		object.URIEmpty,
+		object.URIEmpty,
+		uuid.Nil,
+	), nil
+}
+
+// GetName is a function.
+func (strategy *backtestTestStrategy) GetName() string {
+	return "test"
+}
+
+// Evaluate is a function.
+func (strategy *backtestTestStrategy) Evaluate(
+	_ context.Context,
+	marketer strategy.Marketer,
+) ([]om.Signaler, error) {
+	nowUnix := marketer.GetTimer().NowUTC().Unix()
+
+	side, ok := strategy.sides[nowUnix]
+	if !ok {
+		return []om.Signaler{}, nil
+	}
+
+	return []om.Signaler{om.NewSignal(
+		"reason",
+		string(side),
+		strategy.GetName(),
+		"BTC-USDT",
+		nowUnix,
+		uuid.Nil,
+	)}, nil
+}
+
+var backtestTestStartAt = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()
+
+func newBacktestTest(
+	maxHoldKlineCount uint32,
+) *backtest {
+	configConfigger := config.NewConfig(
+		config.WithLogConfigger(),
+		config.WithBacktestConfigger(
+			config.WithBacktestConfigEndAt(backtestTestStartAt+3*object.NUM1HourToSecond),
+			config.WithBacktestConfigInitialBalance(1000),
+			config.WithBacktestConfigKlineType(string(object.KlineTypeType1hour)),
+			config.WithBacktestConfigMaxHoldKlineCount(maxHoldKlineCount),
+			config.WithBacktestConfigOrderFunds(100),
+			config.WithBacktestConfigSlippage(0.01),
+			config.WithBacktestConfigStartAt(backtestTestStartAt),
+			config.WithBacktestConfigSymbols([]string{"BTC-USDT"}),
+		),
+	)
+
+	omKliners := make([]om.Kliner, 0, 4)
+
+	for index, open := range []string{"100", "110", "120", "130"} {
+		omKliners = append(omKliners, om.NewKline(
+			open,
+			open,
+			string(object.KlineTypeType1hour),
+			open,
+			open,
+			"BTC-USDT",
+			"1",
+			"1",
+			backtestTestStartAt+int64(index)*object.NUM1HourToSecond,
+			uuid.Nil,
+		))
+	}
+
+	return NewBacktest(
+		configConfigger,
+		log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+		NewClock(time.Unix(backtestTestStartAt, 0)),
+		&backtestTestServicer{
+			Servicer: nil,
+			klineServicer: &backtestTestKlineServicer{
+				KlineServicer: nil,
+				omKliners:     omKliners,
+			},
+			tickerServicer: &backtestTestTickerServicer{TickerServicer: nil},
+		},
+		trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+		util.NewUUID(),
+	)
+}
+
+func backtestTestEqual(
+	first float64,
+	second float64,
+) bool {
+	return math.Abs(first-second) < 1e-9
+}
+
+func TestBacktestRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		maxHold      uint32
+		sides        map[int64]object.OrderSideType
+		exitAt       int64
+		exitPrice    float64
+		exitReason   string
+		wantExposure float64
+	}{
+		{
+			name:    "sell signal",
+			maxHold: 10,
+			sides: map[int64]object.OrderSideType{
+				backtestTestStartAt:                             object.OrderSideTypeBuy,
+				backtestTestStartAt + 2*object.NUM1HourToSecond: object.OrderSideTypeSell,
+			},
+			exitAt:       backtestTestStartAt + 2*object.NUM1HourToSecond,
+			exitPrice:    120 * 0.99,
+			exitReason:   object.URIBacktestExitReasonSignal,
+			wantExposure: 0.5,
+		},
+		{
+			name:    "max hold",
+			maxHold: 1,
+			sides: map[int64]object.OrderSideType{
+				backtestTestStartAt: object.OrderSideTypeBuy,
+			},
+			exitAt:       backtestTestStartAt + object.NUM1HourToSecond,
+			exitPrice:    110 * 0.99,
+			exitReason:   object.URIBacktestExitReasonHold,
+			wantExposure: 0.25,
+		},
+		{
+			name:    "end of the replay",
+			maxHold: 10,
+			sides: map[int64]object.OrderSideType{
+				backtestTestStartAt: object.OrderSideTypeBuy,
+			},
+			exitAt:       backtestTestStartAt + 3*object.NUM1HourToSecond,
+			exitPrice:    130 * 0.99,
+			exitReason:   object.URIBacktestExitReasonEnd,
+			wantExposure: 1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			resulter, err := newBacktestTest(test.maxHold).Run(
+				context.Background(),
+				[]strategy.Strategier{&backtestTestStrategy{sides: test.sides}},
+			)
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			omBacktestTraders := resulter.GetTrades()
+			if len(omBacktestTraders) != 1 {
+				t.Fatalf("GetTrades() = %d trades, want 1", len(omBacktestTraders))
+			}
+
+			// The entry fills at the open of the candle, moved against the order
+			// by the slippage and charged the taker fee.
+			entryFee := 100 * 0.001
+			size := (100 - entryFee) / (100 * 1.01)
+			exitValue := size * test.exitPrice
+			profit := exitValue*(1-0.001) - 100
+
+			omBacktestTrader := omBacktestTraders[0]
+			if omBacktestTrader.GetExitReason() != test.exitReason ||
+				omBacktestTrader.GetEntryAt() != backtestTestStartAt ||
+				omBacktestTrader.GetExitAt() != test.exitAt {
+				t.Errorf(
+					"GetExitReason(), GetEntryAt(), GetExitAt() = %q, %d, %d, want %q, %d, %d",
+					omBacktestTrader.GetExitReason(),
+					omBacktestTrader.GetEntryAt(),
+					omBacktestTrader.GetExitAt(),
+					test.exitReason,
+					backtestTestStartAt,
+					test.exitAt,
+				)
+			}
+
+			if !backtestTestEqual(omBacktestTrader.GetEntryPrice(), 101) ||
+				!backtestTestEqual(omBacktestTrader.GetExitPrice(), test.exitPrice) ||
+				!backtestTestEqual(omBacktestTrader.GetSize(), size) ||
+				!backtestTestEqual(omBacktestTrader.GetProfit(), profit) ||
+				!backtestTestEqual(omBacktestTrader.GetFee(), entryFee+exitValue*0.001) {
+				t.Errorf(
+					"GetTrades()[0] = %v, want entry 101, exit %v, profit %v",
+					omBacktestTrader,
+					test.exitPrice,
+					profit,
+				)
+			}
+
+			omBacktestStatisticer := resulter.GetStatistic()
+			if !backtestTestEqual(omBacktestStatisticer.GetFinalEquity(), 1000+profit) ||
+				!backtestTestEqual(omBacktestStatisticer.GetTotalReturn(), profit/1000) ||
+				!backtestTestEqual(omBacktestStatisticer.GetExposure(), test.wantExposure) ||
+				omBacktestStatisticer.GetWinRate() != 1 ||
+				omBacktestStatisticer.GetTradeCount() != 1 {
+				t.Errorf("GetStatistic() = %v, want a final equity of %v", omBacktestStatisticer, 1000+profit)
+			}
+
+			omBacktestEquitiers := resulter.GetEquities()
+			if len(omBacktestEquitiers) != 4 {
+				t.Fatalf("GetEquities() = %d, want 4", len(omBacktestEquitiers))
+			}
+
+			if !backtestTestEqual(omBacktestEquitiers[0].GetCash(), 900) ||
+				!backtestTestEqual(omBacktestEquitiers[0].GetEquity(), 900+size*100) {
+				t.Errorf("GetEquities()[0] = %v, want cash 900 and the position at 100", omBacktestEquitiers[0])
+			}
+
+			if last := omBacktestEquitiers[3]; !backtestTestEqual(last.GetEquity(), 1000+profit) ||
+				last.GetPositionValue() != 0 {
+				t.Errorf("GetEquities()[3] = %v, want the final equity flat", last)
+			}
+		})
+	}
+}
+
+func TestMarketGetKlines(t *testing.T) {
+	t.Parallel()
+
+	backtest := newBacktestTest(10)
+	clocker := NewClock(time.Unix(backtestTestStartAt+object.NUM1HourToSecond+1, 0))
+	market := newMarket(clocker, backtest.GetServicer(), nil, nil, backtestTestStartAt, backtestTestStartAt)
+
+	// The candle that started an hour ago has closed, the current one has not.
+	omKliners, err := market.GetKlines(context.Background(), dto.NewKlineRequest(
+		object.KlineTypeType1hour,
+		"BTC-USDT",
+		0,
+		backtestTestStartAt,
+	))
+	if err != nil {
+		t.Fatalf("GetKlines() error = %v", err)
+	}
+
+	if len(omKliners) != 1 || omKliners[0].GetStartAt() != backtestTestStartAt {
+		t.Errorf("GetKlines() = %v, want the closed candle only", omKliners)
+	}
+
+	// The engine still reads the open of the current candle.
+	omKliner, err := market.kline(
+		context.Background(),
+		object.KlineTypeType1hour,
+		"BTC-USDT",
+		backtestTestStartAt+object.NUM1HourToSecond,
+	)
+	if err != nil || omKliner.GetOpen() != "110" {
+		t.Errorf("kline() = %v, %v, want the open 110", omKliner, err)
+	}
+
+	if _, err = market.GetOrderBook(context.Background(), "BTC-USDT"); err == nil {
+		t.Errorf("GetOrderBook() error = nil, want %v", object.ErrBacktestOrderBookNotFound)
+	}
+}
+
+func TestClockAfter(t *testing.T) {
+	t.Parallel()
+
+	clocker := NewClock(time.Unix(backtestTestStartAt, 0))
+
+	timeTime := <-clocker.After(time.Minute)
+	if want := time.Unix(backtestTestStartAt, 0).Add(time.Minute).UTC(); !timeTime.Equal(want) ||
+		!clocker.NowUTC().Equal(want) {
+		t.Errorf("After() = %v, NowUTC() = %v, want %v", timeTime, clocker.NowUTC(), want)
+	}
+
+	if got := clocker.Since(time.Unix(backtestTestStartAt, 0)); got != time.Minute {
+		t.Errorf("Since() = %v, want %v", got, time.Minute)
+	}
+}
@@ -0,0 +1,78 @@
+package backtest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// Clocker is an interface.
+	Clocker interface {
+		object.Timer
+		// Set is a function.
+		Set(
+			time.Time,
+		)
+	}
+
+	clock struct {
+		mutex sync.RWMutex
+		now   time.Time
+	}
+)
+
+var _ Clocker = (*clock)(nil)
+
+// NewClock is a function.
+// It is a simulated object.Timer that only moves when it is set or waited on.
+func NewClock(
+	now time.Time,
+) *clock {
+	return &clock{
+		mutex: sync.RWMutex{},
+		now:   now.UTC(),
+	}
+}
+
+// After is a function.
+// The clock jumps forward by the duration and the channel fires immediately.
+func (clock *clock) After(
+	timeDuration time.Duration,
+) <-chan time.Time {
+	clock.mutex.Lock()
+	clock.now = clock.now.Add(timeDuration)
+	now := clock.now
+	clock.mutex.Unlock()
+
+	channel := make(chan time.Time, 1)
+	channel <- now
+
+	return channel
+}
+
+// NowUTC is a function.
+func (clock *clock) NowUTC() time.Time {
+	clock.mutex.RLock()
+	defer clock.mutex.RUnlock()
+
+	return clock.now
+}
+
+// Set is a function.
+func (clock *clock) Set(
+	now time.Time,
+) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	clock.now = now.UTC()
+}
+
+// Since is a function.
+func (clock *clock) Since(
+	timeTime time.Time,
+) time.Duration {
+	return clock.NowUTC().Sub(timeTime)
+}
@@ -0,0 +1,4 @@
+/*
+Package backtest is a package.
+*/
+package backtest
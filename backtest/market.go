@@ -0,0 +1,275 @@
+package backtest
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/service"
+	"github.com/ShahoBashoki/kucoin/strategy"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+)
+
+type (
+	market struct {
+		clocker    Clocker
+		endAt      int64
+		klines     map[marketKey][]om.Kliner
+		mutex      sync.Mutex
+		orderBooks map[string][]om.OrderBooker
+		servicer   service.Servicer
+		startAt    int64
+		tickers    []om.Tickerer
+	}
+
+	marketKey struct {
+		klineType object.KlineTypeType
+		symbol    string
+	}
+)
+
+var (
+	_ object.GetTimer     = (*market)(nil)
+	_ service.GetServicer = (*market)(nil)
+	_ strategy.Marketer   = (*market)(nil)
+)
+
+// newMarket is a function.
+// It replays the stored klines and the recorded order books up to the clock,
+// so a strategy never sees a candle that has not closed yet.
+func newMarket(
+	clocker Clocker,
+	servicer service.Servicer,
+	orderBooks map[string][]om.OrderBooker,
+	tickers []om.Tickerer,
+	endAt int64,
+	startAt int64,
+) *market {
+	return &market{
+		clocker:    clocker,
+		endAt:      endAt,
+		klines:     map[marketKey][]om.Kliner{},
+		mutex:      sync.Mutex{},
+		orderBooks: orderBooks,
+		servicer:   servicer,
+		startAt:    startAt,
+		tickers:    tickers,
+	}
+}
+
+// GetServicer is a function.
+func (market *market) GetServicer() service.Servicer {
+	return market.servicer
+}
+
+// GetTimer is a function.
+func (market *market) GetTimer() object.Timer {
+	return market.clocker
+}
+
+// GetDebtRatio is a function.
+// The backtest trades spot only, so nothing is ever borrowed.
+func (market *market) GetDebtRatio(
+	_ context.Context,
+) (string, error) {
+	return "0", nil
+}
+
+// GetExchangeMode is a function.
+// The replayed exchange never pauses.
+func (market *market) GetExchangeMode() object.ExchangeModeType {
+	return object.ExchangeModeTypeOpen
+}
+
+// GetKlines is a function.
+func (market *market) GetKlines(
+	ctx context.Context,
+	dtoKlineRequester dto.KlineRequester,
+) ([]om.Kliner, error) {
+	omKliners, err := market.load(
+		ctx,
+		dtoKlineRequester.GetKlineType(),
+		dtoKlineRequester.GetSymbol(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	secondKlineType := util.KlineTypeToSecond(dtoKlineRequester.GetKlineType())
+	nowUnix := market.GetTimer().NowUTC().Unix()
+	closedOMKliners := make([]om.Kliner, 0, len(omKliners))
+
+	for _, omKliner := range omKliners {
+		if omKliner.GetStartAt() < dtoKlineRequester.GetStartAt() ||
+			omKliner.GetStartAt()+secondKlineType > nowUnix {
+			continue
+		}
+
+		if dtoKlineRequester.GetEndAt() != 0 &&
+			omKliner.GetStartAt() >= dtoKlineRequester.GetEndAt() {
+			continue
+		}
+
+		closedOMKliners = append(closedOMKliners, omKliner)
+	}
+
+	return closedOMKliners, nil
+}
+
+// GetOrderBook is a function.
+// It returns the last recorded snapshot taken at or before the clock.
+func (market *market) GetOrderBook(
+	_ context.Context,
+	symbol string,
+) (om.OrderBooker, error) {
+	omOrderBookers := market.orderBooks[symbol]
+	nowUnixMilli := market.GetTimer().NowUTC().UnixMilli()
+
+	index := sort.Search(len(omOrderBookers), func(index int) bool {
+		return omOrderBookers[index].GetTime() > nowUnixMilli
+	})
+	if index == 0 {
+		return nil, object.ErrBacktestOrderBookNotFound
+	}
+
+	return omOrderBookers[index-1], nil
+}
+
+// GetTickers is a function.
+// The change rate is replayed from the hourly candles of the last day,
+// and the tickers are sorted by it like the live ticker list.
+func (market *market) GetTickers(
+	ctx context.Context,
+	count uint32,
+) ([]om.Tickerer, error) {
+	nowUnix := market.GetTimer().NowUTC().Unix()
+	omTickers := make([]om.Tickerer, 0, len(market.tickers))
+	changeRates := map[string]float64{}
+
+	for _, omTicker := range market.tickers {
+		omKliners, err := market.GetKlines(ctx, dto.NewKlineRequest(
+			object.KlineTypeType1hour,
+			omTicker.GetSymbol(),
+			0,
+			nowUnix-object.NUM1DayToSecond,
+		))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(omKliners) == 0 {
+			continue
+		}
+
+		open, err := strconv.ParseFloat(omKliners[0].GetOpen(), 64)
+		if err != nil {
+			return nil, err
+		}
+
+		last := omKliners[len(omKliners)-1].GetClose()
+
+		closePrice, err := strconv.ParseFloat(last, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		changeRate := 0.0
+		if open != 0 {
+			changeRate = (closePrice - open) / open
+		}
+
+		changeRates[omTicker.GetSymbol()] = changeRate
+		omTickers = append(omTickers, om.NewTicker(
+			omTicker.GetAveragePrice(),
+			omTicker.GetBuy(),
+			omTicker.GetChangePrice(),
+			strconv.FormatFloat(changeRate, 'f', -1, 64),
+			omTicker.GetHigh(),
+			last,
+			omTicker.GetLow(),
+			omTicker.GetMakerCoefficient(),
+			omTicker.GetMakerFeeRate(),
+			omTicker.GetSell(),
+			omTicker.GetSymbol(),
+			omTicker.GetSymbolName(),
+			omTicker.GetTakerCoefficient(),
+			omTicker.GetTakerFeeRate(),
+			omTicker.GetVol(),
+			omTicker.GetVolValue(),
+			uuid.Nil,
+		))
+	}
+
+	sort.SliceStable(omTickers, func(first, second int) bool {
+		return changeRates[omTickers[first].GetSymbol()] > changeRates[omTickers[second].GetSymbol()]
+	})
+
+	if uint32(len(omTickers)) > count {
+		omTickers = omTickers[:count]
+	}
+
+	return omTickers, nil
+}
+
+// kline returns the candle that starts at startAt, whether or not the clock has reached its close.
+// Only the engine uses it, to fill orders at the open of the next candle.
+func (market *market) kline(
+	ctx context.Context,
+	klineType object.KlineTypeType,
+	symbol string,
+	startAt int64,
+) (om.Kliner, error) {
+	omKliners, err := market.load(ctx, klineType, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	index := sort.Search(len(omKliners), func(index int) bool {
+		return omKliners[index].GetStartAt() >= startAt
+	})
+	if index == len(omKliners) || omKliners[index].GetStartAt() != startAt {
+		return nil, object.ErrBacktestKlineNotFound
+	}
+
+	return omKliners[index], nil
+}
+
+// load reads the whole replay window of a symbol once, with a lookback for the indicators.
+func (market *market) load(
+	ctx context.Context,
+	klineType object.KlineTypeType,
+	symbol string,
+) ([]om.Kliner, error) {
+	key := marketKey{
+		klineType: klineType,
+		symbol:    symbol,
+	}
+
+	market.mutex.Lock()
+	defer market.mutex.Unlock()
+
+	if omKliners, ok := market.klines[key]; ok {
+		return omKliners, nil
+	}
+
+	omKliners, err := market.GetServicer().
+		GetKlineServicer().
+		GetResampledListFromRepository(ctx, dto.NewKlineRequest(
+			klineType,
+			symbol,
+			market.endAt+util.KlineTypeToSecond(klineType),
+			market.startAt-object.NUMBacktestLookbackToSecond,
+		))
+	if err != nil {
+		return nil, err
+	}
+
+	market.klines[key] = omKliners
+
+	return omKliners, nil
+}
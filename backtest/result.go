@@ -0,0 +1,253 @@
+package backtest
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/om"
+)
+
+type (
+	// Resulter is an interface.
+	Resulter interface {
+		object.GetMap
+		// GetEquities is a function.
+		GetEquities() []om.BacktestEquitier
+		// GetStatistic is a function.
+		GetStatistic() om.BacktestStatisticer
+		// GetTrades is a function.
+		GetTrades() []om.BacktestTrader
+	}
+
+	result struct {
+		equities  []om.BacktestEquitier
+		statistic om.BacktestStatisticer
+		trades    []om.BacktestTrader
+	}
+)
+
+var (
+	_ Resulter       = (*result)(nil)
+	_ json.Marshaler = (*result)(nil)
+)
+
+var (
+	resultEquityColumns = []string{
+		"time",
+		"cash",
+		"equity",
+		"position_value",
+	}
+	resultStatisticColumns = []string{
+		"initial_equity",
+		"final_equity",
+		"total_return",
+		"max_drawdown",
+		"sharpe",
+		"win_rate",
+		"exposure",
+		"trade_count",
+	}
+	resultTradeColumns = []string{
+		"strategy",
+		"reason",
+		"symbol",
+		"entry_at",
+		"entry_price",
+		"exit_at",
+		"exit_price",
+		"exit_reason",
+		"size",
+		"fee",
+		"profit",
+		"return_rate",
+	}
+)
+
+// NewResult is a function.
+func NewResult(
+	equities []om.BacktestEquitier,
+	statistic om.BacktestStatisticer,
+	trades []om.BacktestTrader,
+) *result {
+	return &result{
+		equities:  equities,
+		statistic: statistic,
+		trades:    trades,
+	}
+}
+
+// GetEquities is a function.
+func (result *result) GetEquities() []om.BacktestEquitier {
+	return result.equities
+}
+
+// GetStatistic is a function.
+func (result *result) GetStatistic() om.BacktestStatisticer {
+	return result.statistic
+}
+
+// GetTrades is a function.
+func (result *result) GetTrades() []om.BacktestTrader {
+	return result.trades
+}
+
+// GetMap is a function.
+func (result *result) GetMap() map[string]any {
+	return map[string]any{
+		"equities":  result.GetEquities(),
+		"statistic": result.GetStatistic(),
+		"trades":    result.GetTrades(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (result *result) MarshalJSON() ([]byte, error) {
+	return json.Marshal(result.GetMap())
+}
+
+// WriteEquitiesCSV is a function.
+func WriteEquitiesCSV(
+	writer io.Writer,
+	resulter Resulter,
+) error {
+	records := make([][]string, 0, len(resulter.GetEquities()))
+	for _, omBacktestEquitier := range resulter.GetEquities() {
+		records = append(records, []string{
+			strconv.FormatInt(omBacktestEquitier.GetTime(), 10),
+			formatFloat(omBacktestEquitier.GetCash()),
+			formatFloat(omBacktestEquitier.GetEquity()),
+			formatFloat(omBacktestEquitier.GetPositionValue()),
+		})
+	}
+
+	return writeCSV(writer, resultEquityColumns, records)
+}
+
+// WriteJSON is a function.
+func WriteJSON(
+	writer io.Writer,
+	resulter Resulter,
+) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent(object.URIEmpty, "\t")
+
+	if err := encoder.Encode(resulter.GetMap()); err != nil {
+		return fmt.Errorf("%w: %w", object.ErrBacktestWrite, err)
+	}
+
+	return nil
+}
+
+// WriteResult is a function.
+// It writes the JSON result and the trade, equity and statistic CSV files into the directory.
+func WriteResult(
+	directory string,
+	resulter Resulter,
+) error {
+	if err := os.MkdirAll(directory, os.ModePerm); err != nil {
+		return fmt.Errorf("%w: %w", object.ErrBacktestWrite, err)
+	}
+
+	writers := map[string]func(io.Writer, Resulter) error{
+		object.URIBacktestFileEquities:  WriteEquitiesCSV,
+		object.URIBacktestFileResult:    WriteJSON,
+		object.URIBacktestFileStatistic: WriteStatisticCSV,
+		object.URIBacktestFileTrades:    WriteTradesCSV,
+	}
+
+	for name, write := range writers {
+		file, err := os.Create(filepath.Join(directory, name))
+		if err != nil {
+			return fmt.Errorf("%w: %w", object.ErrBacktestWrite, err)
+		}
+
+		err = write(file, resulter)
+
+		if errClose := file.Close(); err == nil && errClose != nil {
+			err = fmt.Errorf("%w: %w", object.ErrBacktestWrite, errClose)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteStatisticCSV is a function.
+func WriteStatisticCSV(
+	writer io.Writer,
+	resulter Resulter,
+) error {
+	omBacktestStatisticer := resulter.GetStatistic()
+
+	return writeCSV(writer, resultStatisticColumns, [][]string{{
+		formatFloat(omBacktestStatisticer.GetInitialEquity()),
+		formatFloat(omBacktestStatisticer.GetFinalEquity()),
+		formatFloat(omBacktestStatisticer.GetTotalReturn()),
+		formatFloat(omBacktestStatisticer.GetMaxDrawdown()),
+		formatFloat(omBacktestStatisticer.GetSharpe()),
+		formatFloat(omBacktestStatisticer.GetWinRate()),
+		formatFloat(omBacktestStatisticer.GetExposure()),
+		strconv.FormatUint(uint64(omBacktestStatisticer.GetTradeCount()), 10),
+	}})
+}
+
+// WriteTradesCSV is a function.
+func WriteTradesCSV(
+	writer io.Writer,
+	resulter Resulter,
+) error {
+	records := make([][]string, 0, len(resulter.GetTrades()))
+	for _, omBacktestTrader := range resulter.GetTrades() {
+		records = append(records, []string{
+			omBacktestTrader.GetStrategy(),
+			omBacktestTrader.GetReason(),
+			omBacktestTrader.GetSymbol(),
+			strconv.FormatInt(omBacktestTrader.GetEntryAt(), 10),
+			formatFloat(omBacktestTrader.GetEntryPrice()),
+			strconv.FormatInt(omBacktestTrader.GetExitAt(), 10),
+			formatFloat(omBacktestTrader.GetExitPrice()),
+			omBacktestTrader.GetExitReason(),
+			formatFloat(omBacktestTrader.GetSize()),
+			formatFloat(omBacktestTrader.GetFee()),
+			formatFloat(omBacktestTrader.GetProfit()),
+			formatFloat(omBacktestTrader.GetReturnRate()),
+		})
+	}
+
+	return writeCSV(writer, resultTradeColumns, records)
+}
+
+func formatFloat(
+	value float64,
+) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func writeCSV(
+	writer io.Writer,
+	columns []string,
+	records [][]string,
+) error {
+	csvWriter := csv.NewWriter(writer)
+
+	if err := csvWriter.Write(columns); err != nil {
+		return fmt.Errorf("%w: %w", object.ErrBacktestWrite, err)
+	}
+
+	if err := csvWriter.WriteAll(records); err != nil {
+		return fmt.Errorf("%w: %w", object.ErrBacktestWrite, err)
+	}
+
+	return nil
+}
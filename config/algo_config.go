@@ -0,0 +1,102 @@
+package config
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// AlgoConfigger is an interface.
+	AlgoConfigger interface {
+		// GetVWAPLookback is a function.
+		GetVWAPLookback() time.Duration
+	}
+
+	// GetAlgoConfigger is an interface.
+	GetAlgoConfigger interface {
+		// GetAlgoConfigger is a function.
+		GetAlgoConfigger() AlgoConfigger
+	}
+
+	algoConfig struct {
+		vwapLookback time.Duration
+	}
+
+	algoConfigOptioner interface {
+		apply(*algoConfig)
+	}
+
+	algoConfigOptionerFunc func(*algoConfig)
+)
+
+var (
+	_ AlgoConfigger  = (*algoConfig)(nil)
+	_ json.Marshaler = (*algoConfig)(nil)
+	_ object.GetMap  = (*algoConfig)(nil)
+)
+
+// NewAlgoConfig is a function.
+func NewAlgoConfig(
+	optioners ...algoConfigOptioner,
+) *algoConfig {
+	algoConfig := &algoConfig{
+		vwapLookback: 0,
+	}
+
+	return algoConfig.WithOptioners(optioners...)
+}
+
+// WithAlgoConfigVWAPLookback is a function.
+func WithAlgoConfigVWAPLookback(
+	vwapLookback time.Duration,
+) algoConfigOptioner {
+	return algoConfigOptionerFunc(func(
+		config *algoConfig,
+	) {
+		config.vwapLookback = vwapLookback
+	})
+}
+
+// GetVWAPLookback is a function.
+func (config *algoConfig) GetVWAPLookback() time.Duration {
+	return config.vwapLookback
+}
+
+// GetMap is a function.
+func (config *algoConfig) GetMap() map[string]any {
+	return map[string]any{
+		"vwap_lookback": config.GetVWAPLookback(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (config *algoConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(config.GetMap())
+}
+
+// WithOptioners is a function.
+func (config *algoConfig) WithOptioners(
+	optioners ...algoConfigOptioner,
+) *algoConfig {
+	newConfig := config.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newConfig)
+	}
+
+	return newConfig
+}
+
+func (config *algoConfig) clone() *algoConfig {
+	newConfig := config
+
+	return newConfig
+}
+
+func (optionerFunc algoConfigOptionerFunc) apply(
+	config *algoConfig,
+) {
+	optionerFunc(config)
+}
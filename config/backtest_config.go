@@ -0,0 +1,311 @@
+package config
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// BacktestConfigger is an interface.
+	BacktestConfigger interface {
+		// GetEnabled is a function.
+		GetEnabled() bool
+		// GetEndAt is a function.
+		GetEndAt() int64
+		// GetInitialBalance is a function.
+		GetInitialBalance() float64
+		// GetKlineType is a function.
+		GetKlineType() string
+		// GetMaxHoldKlineCount is a function.
+		GetMaxHoldKlineCount() uint32
+		// GetOrderBookFile is a function.
+		GetOrderBookFile() string
+		// GetOrderFunds is a function.
+		GetOrderFunds() float64
+		// GetOutputDir is a function.
+		GetOutputDir() string
+		// GetSlippage is a function.
+		GetSlippage() float64
+		// GetStartAt is a function.
+		GetStartAt() int64
+		// GetSymbols is a function.
+		GetSymbols() []string
+	}
+
+	// GetBacktestConfigger is an interface.
+	GetBacktestConfigger interface {
+		// GetBacktestConfigger is a function.
+		GetBacktestConfigger() BacktestConfigger
+	}
+
+	backtestConfig struct {
+		enabled           bool
+		endAt             int64
+		initialBalance    float64
+		klineType         string
+		maxHoldKlineCount uint32
+		orderBookFile     string
+		orderFunds        float64
+		outputDir         string
+		slippage          float64
+		startAt           int64
+		symbols           []string
+	}
+
+	backtestConfigOptioner interface {
+		apply(*backtestConfig)
+	}
+
+	backtestConfigOptionerFunc func(*backtestConfig)
+)
+
+var (
+	_ BacktestConfigger = (*backtestConfig)(nil)
+	_ json.Marshaler    = (*backtestConfig)(nil)
+	_ object.GetMap     = (*backtestConfig)(nil)
+)
+
+// NewBacktestConfig is a function.
+func NewBacktestConfig(
+	optioners ...backtestConfigOptioner,
+) *backtestConfig {
+	backtestConfig := &backtestConfig{
+		enabled:           false,
+		endAt:             0,
+		initialBalance:    0,
+		klineType:         object.URIEmpty,
+		maxHoldKlineCount: 0,
+		orderBookFile:     object.URIEmpty,
+		orderFunds:        0,
+		outputDir:         object.URIEmpty,
+		slippage:          0,
+		startAt:           0,
+		symbols:           []string{},
+	}
+
+	return backtestConfig.WithOptioners(optioners...)
+}
+
+// WithBacktestConfigEnabled is a function.
+func WithBacktestConfigEnabled(
+	enabled bool,
+) backtestConfigOptioner {
+	return backtestConfigOptionerFunc(func(
+		config *backtestConfig,
+	) {
+		config.enabled = enabled
+	})
+}
+
+// WithBacktestConfigEndAt is a function.
+func WithBacktestConfigEndAt(
+	endAt int64,
+) backtestConfigOptioner {
+	return backtestConfigOptionerFunc(func(
+		config *backtestConfig,
+	) {
+		config.endAt = endAt
+	})
+}
+
+// WithBacktestConfigInitialBalance is a function.
+func WithBacktestConfigInitialBalance(
+	initialBalance float64,
+) backtestConfigOptioner {
+	return backtestConfigOptionerFunc(func(
+		config *backtestConfig,
+	) {
+		config.initialBalance = initialBalance
+	})
+}
+
+// WithBacktestConfigKlineType is a function.
+func WithBacktestConfigKlineType(
+	klineType string,
+) backtestConfigOptioner {
+	return backtestConfigOptionerFunc(func(
+		config *backtestConfig,
+	) {
+		config.klineType = klineType
+	})
+}
+
+// WithBacktestConfigMaxHoldKlineCount is a function.
+func WithBacktestConfigMaxHoldKlineCount(
+	maxHoldKlineCount uint32,
+) backtestConfigOptioner {
+	return backtestConfigOptionerFunc(func(
+		config *backtestConfig,
+	) {
+		config.maxHoldKlineCount = maxHoldKlineCount
+	})
+}
+
+// WithBacktestConfigOrderBookFile is a function.
+func WithBacktestConfigOrderBookFile(
+	orderBookFile string,
+) backtestConfigOptioner {
+	return backtestConfigOptionerFunc(func(
+		config *backtestConfig,
+	) {
+		config.orderBookFile = orderBookFile
+	})
+}
+
+// WithBacktestConfigOrderFunds is a function.
+func WithBacktestConfigOrderFunds(
+	orderFunds float64,
+) backtestConfigOptioner {
+	return backtestConfigOptionerFunc(func(
+		config *backtestConfig,
+	) {
+		config.orderFunds = orderFunds
+	})
+}
+
+// WithBacktestConfigOutputDir is a function.
+func WithBacktestConfigOutputDir(
+	outputDir string,
+) backtestConfigOptioner {
+	return backtestConfigOptionerFunc(func(
+		config *backtestConfig,
+	) {
+		config.outputDir = outputDir
+	})
+}
+
+// WithBacktestConfigSlippage is a function.
+func WithBacktestConfigSlippage(
+	slippage float64,
+) backtestConfigOptioner {
+	return backtestConfigOptionerFunc(func(
+		config *backtestConfig,
+	) {
+		config.slippage = slippage
+	})
+}
+
+// WithBacktestConfigStartAt is a function.
+func WithBacktestConfigStartAt(
+	startAt int64,
+) backtestConfigOptioner {
+	return backtestConfigOptionerFunc(func(
+		config *backtestConfig,
+	) {
+		config.startAt = startAt
+	})
+}
+
+// WithBacktestConfigSymbols is a function.
+func WithBacktestConfigSymbols(
+	symbols []string,
+) backtestConfigOptioner {
+	return backtestConfigOptionerFunc(func(
+		config *backtestConfig,
+	) {
+		config.symbols = symbols
+	})
+}
+
+// GetEnabled is a function.
+func (config *backtestConfig) GetEnabled() bool {
+	return config.enabled
+}
+
+// GetEndAt is a function.
+func (config *backtestConfig) GetEndAt() int64 {
+	return config.endAt
+}
+
+// GetInitialBalance is a function.
+func (config *backtestConfig) GetInitialBalance() float64 {
+	return config.initialBalance
+}
+
+// GetKlineType is a function.
+func (config *backtestConfig) GetKlineType() string {
+	return config.klineType
+}
+
+// GetMaxHoldKlineCount is a function.
+func (config *backtestConfig) GetMaxHoldKlineCount() uint32 {
+	return config.maxHoldKlineCount
+}
+
+// GetOrderBookFile is a function.
+func (config *backtestConfig) GetOrderBookFile() string {
+	return config.orderBookFile
+}
+
+// GetOrderFunds is a function.
+func (config *backtestConfig) GetOrderFunds() float64 {
+	return config.orderFunds
+}
+
+// GetOutputDir is a function.
+func (config *backtestConfig) GetOutputDir() string {
+	return config.outputDir
+}
+
+// GetSlippage is a function.
+func (config *backtestConfig) GetSlippage() float64 {
+	return config.slippage
+}
+
+// GetStartAt is a function.
+func (config *backtestConfig) GetStartAt() int64 {
+	return config.startAt
+}
+
+// GetSymbols is a function.
+func (config *backtestConfig) GetSymbols() []string {
+	return config.symbols
+}
+
+// GetMap is a function.
+func (config *backtestConfig) GetMap() map[string]any {
+	return map[string]any{
+		"enabled":              config.GetEnabled(),
+		"end_at":               config.GetEndAt(),
+		"initial_balance":      config.GetInitialBalance(),
+		"kline_type":           config.GetKlineType(),
+		"max_hold_kline_count": config.GetMaxHoldKlineCount(),
+		"order_book_file":      config.GetOrderBookFile(),
+		"order_funds":          config.GetOrderFunds(),
+		"output_dir":           config.GetOutputDir(),
+		"slippage":             config.GetSlippage(),
+		"start_at":             config.GetStartAt(),
+		"symbols":              config.GetSymbols(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (config *backtestConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(config.GetMap())
+}
+
+// WithOptioners is a function.
+func (config *backtestConfig) WithOptioners(
+	optioners ...backtestConfigOptioner,
+) *backtestConfig {
+	newConfig := config.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newConfig)
+	}
+
+	return newConfig
+}
+
+func (config *backtestConfig) clone() *backtestConfig {
+	newConfig := config
+
+	return newConfig
+}
+
+func (optionerFunc backtestConfigOptionerFunc) apply(
+	config *backtestConfig,
+) {
+	optionerFunc(config)
+}
@@ -9,13 +9,24 @@ import (
 type (
 	// Configger interface is the core configuration.
 	Configger interface {
+		GetAlgoConfigger
+		GetBacktestConfigger
 		GetDatabaseConfigger
+		GetKlineConfigger
 		GetKucoinConfigger
 		GetLogConfigger
 		GetOtelConfigger
+		GetPaperConfigger
+		GetPortfolioConfigger
 		GetRedpandaConfigger
+		GetRequestConfigger
+		GetRiskConfigger
 		GetRuntimeConfigger
+		GetSchedulerConfigger
 		GetServerConfigger
+		GetSizingConfigger
+		GetStrategyConfigger
+		GetStreamConfigger
 	}
 
 	// GetConfigger is an interface.
@@ -25,13 +36,24 @@ type (
 	}
 
 	config struct {
-		databaseConfigger DatabaseConfigger
-		kucoinConfigger   KucoinConfigger
-		logConfigger      LogConfigger
-		otelConfigger     OtelConfigger
-		redpandaConfigger RedpandaConfigger
-		runtimeConfigger  RuntimeConfigger
-		serverConfigger   ServerConfigger
+		algoConfigger      AlgoConfigger
+		backtestConfigger  BacktestConfigger
+		databaseConfigger  DatabaseConfigger
+		klineConfigger     KlineConfigger
+		kucoinConfigger    KucoinConfigger
+		logConfigger       LogConfigger
+		otelConfigger      OtelConfigger
+		paperConfigger     PaperConfigger
+		portfolioConfigger PortfolioConfigger
+		redpandaConfigger  RedpandaConfigger
+		requestConfigger   RequestConfigger
+		riskConfigger      RiskConfigger
+		runtimeConfigger   RuntimeConfigger
+		schedulerConfigger SchedulerConfigger
+		serverConfigger    ServerConfigger
+		sizingConfigger    SizingConfigger
+		strategyConfigger  StrategyConfigger
+		streamConfigger    StreamConfigger
 	}
 
 	configOptioner interface {
@@ -42,16 +64,27 @@ type (
 )
 
 var (
-	_ Configger            = (*config)(nil)
-	_ GetDatabaseConfigger = (*config)(nil)
-	_ GetKucoinConfigger   = (*config)(nil)
-	_ GetLogConfigger      = (*config)(nil)
-	_ GetOtelConfigger     = (*config)(nil)
-	_ GetRedpandaConfigger = (*config)(nil)
-	_ GetRuntimeConfigger  = (*config)(nil)
-	_ GetServerConfigger   = (*config)(nil)
-	_ json.Marshaler       = (*config)(nil)
-	_ object.GetMap        = (*config)(nil)
+	_ Configger             = (*config)(nil)
+	_ GetAlgoConfigger      = (*config)(nil)
+	_ GetBacktestConfigger  = (*config)(nil)
+	_ GetDatabaseConfigger  = (*config)(nil)
+	_ GetKlineConfigger     = (*config)(nil)
+	_ GetKucoinConfigger    = (*config)(nil)
+	_ GetLogConfigger       = (*config)(nil)
+	_ GetOtelConfigger      = (*config)(nil)
+	_ GetPaperConfigger     = (*config)(nil)
+	_ GetPortfolioConfigger = (*config)(nil)
+	_ GetRedpandaConfigger  = (*config)(nil)
+	_ GetRequestConfigger   = (*config)(nil)
+	_ GetRiskConfigger      = (*config)(nil)
+	_ GetRuntimeConfigger   = (*config)(nil)
+	_ GetSchedulerConfigger = (*config)(nil)
+	_ GetServerConfigger    = (*config)(nil)
+	_ GetSizingConfigger    = (*config)(nil)
+	_ GetStrategyConfigger  = (*config)(nil)
+	_ GetStreamConfigger    = (*config)(nil)
+	_ json.Marshaler        = (*config)(nil)
+	_ object.GetMap         = (*config)(nil)
 )
 
 // NewConfig constructs a Config struct which represents server settings,
@@ -60,18 +93,51 @@ func NewConfig(
 	optioners ...configOptioner,
 ) *config {
 	config := &config{
-		databaseConfigger: nil,
-		kucoinConfigger:   nil,
-		logConfigger:      nil,
-		otelConfigger:     nil,
-		redpandaConfigger: nil,
-		runtimeConfigger:  nil,
-		serverConfigger:   nil,
+		algoConfigger:      nil,
+		backtestConfigger:  nil,
+		databaseConfigger:  nil,
+		klineConfigger:     nil,
+		kucoinConfigger:    nil,
+		logConfigger:       nil,
+		otelConfigger:      nil,
+		paperConfigger:     nil,
+		portfolioConfigger: nil,
+		redpandaConfigger:  nil,
+		requestConfigger:   nil,
+		riskConfigger:      nil,
+		runtimeConfigger:   nil,
+		schedulerConfigger: nil,
+		serverConfigger:    nil,
+		sizingConfigger:    nil,
+		strategyConfigger:  nil,
+		streamConfigger:    nil,
 	}
 
 	return config.WithOptioners(optioners...)
 }
 
+// WithAlgoConfigger is a function.
+func WithAlgoConfigger(
+	optioners ...algoConfigOptioner,
+) configOptioner {
+	return configOptionerFunc(func(
+		config *config,
+	) {
+		config.algoConfigger = NewAlgoConfig(optioners...)
+	})
+}
+
+// WithBacktestConfigger is a function.
+func WithBacktestConfigger(
+	optioners ...backtestConfigOptioner,
+) configOptioner {
+	return configOptionerFunc(func(
+		config *config,
+	) {
+		config.backtestConfigger = NewBacktestConfig(optioners...)
+	})
+}
+
 // WithDatabaseConfigger is a function.
 func WithDatabaseConfigger(
 	optioners ...databaseConfigOptioner,
@@ -83,6 +149,17 @@ func WithDatabaseConfigger(
 	})
 }
 
+// WithKlineConfigger is a function.
+func WithKlineConfigger(
+	optioners ...klineConfigOptioner,
+) configOptioner {
+	return configOptionerFunc(func(
+		config *config,
+	) {
+		config.klineConfigger = NewKlineConfig(optioners...)
+	})
+}
+
 // WithKucoinConfigger is a function.
 func WithKucoinConfigger(
 	optioners ...kucoinConfigOptioner,
@@ -116,6 +193,28 @@ func WithOtelConfigger(
 	})
 }
 
+// WithPaperConfigger is a function.
+func WithPaperConfigger(
+	optioners ...paperConfigOptioner,
+) configOptioner {
+	return configOptionerFunc(func(
+		config *config,
+	) {
+		config.paperConfigger = NewPaperConfig(optioners...)
+	})
+}
+
+// WithPortfolioConfigger is a function.
+func WithPortfolioConfigger(
+	optioners ...portfolioConfigOptioner,
+) configOptioner {
+	return configOptionerFunc(func(
+		config *config,
+	) {
+		config.portfolioConfigger = NewPortfolioConfig(optioners...)
+	})
+}
+
 // WithRedpandaConfigger is a function.
 func WithRedpandaConfigger(
 	optioners ...redpandaConfigOptioner,
@@ -127,6 +226,28 @@ func WithRedpandaConfigger(
 	})
 }
 
+// WithRequestConfigger is a function.
+func WithRequestConfigger(
+	optioners ...requestConfigOptioner,
+) configOptioner {
+	return configOptionerFunc(func(
+		config *config,
+	) {
+		config.requestConfigger = NewRequestConfig(optioners...)
+	})
+}
+
+// WithRiskConfigger is a function.
+func WithRiskConfigger(
+	optioners ...riskConfigOptioner,
+) configOptioner {
+	return configOptionerFunc(func(
+		config *config,
+	) {
+		config.riskConfigger = NewRiskConfig(optioners...)
+	})
+}
+
 // WithRuntimeConfigger is a function.
 func WithRuntimeConfigger(
 	optioners ...runtimeConfigOptioner,
@@ -138,6 +259,17 @@ func WithRuntimeConfigger(
 	})
 }
 
+// WithSchedulerConfigger is a function.
+func WithSchedulerConfigger(
+	optioners ...schedulerConfigOptioner,
+) configOptioner {
+	return configOptionerFunc(func(
+		config *config,
+	) {
+		config.schedulerConfigger = NewSchedulerConfig(optioners...)
+	})
+}
+
 // WithServerConfigger is a function.
 func WithServerConfigger(
 	optioners ...serverConfigOptioner,
@@ -149,11 +281,59 @@ func WithServerConfigger(
 	})
 }
 
+// WithSizingConfigger is a function.
+func WithSizingConfigger(
+	optioners ...sizingConfigOptioner,
+) configOptioner {
+	return configOptionerFunc(func(
+		config *config,
+	) {
+		config.sizingConfigger = NewSizingConfig(optioners...)
+	})
+}
+
+// WithStrategyConfigger is a function.
+func WithStrategyConfigger(
+	optioners ...strategyConfigOptioner,
+) configOptioner {
+	return configOptionerFunc(func(
+		config *config,
+	) {
+		config.strategyConfigger = NewStrategyConfig(optioners...)
+	})
+}
+
+// WithStreamConfigger is a function.
+func WithStreamConfigger(
+	optioners ...streamConfigOptioner,
+) configOptioner {
+	return configOptionerFunc(func(
+		config *config,
+	) {
+		config.streamConfigger = NewStreamConfig(optioners...)
+	})
+}
+
+// GetAlgoConfigger is a function.
+func (config *config) GetAlgoConfigger() AlgoConfigger {
+	return config.algoConfigger
+}
+
+// GetBacktestConfigger is a function.
+func (config *config) GetBacktestConfigger() BacktestConfigger {
+	return config.backtestConfigger
+}
+
 // GetDatabaseConfigger is a function.
 func (config *config) GetDatabaseConfigger() DatabaseConfigger {
 	return config.databaseConfigger
 }
 
+// GetKlineConfigger is a function.
+func (config *config) GetKlineConfigger() KlineConfigger {
+	return config.klineConfigger
+}
+
 // GetKucoinConfigger is a function.
 func (config *config) GetKucoinConfigger() KucoinConfigger {
 	return config.kucoinConfigger
@@ -169,31 +349,82 @@ func (config *config) GetOtelConfigger() OtelConfigger {
 	return config.otelConfigger
 }
 
+// GetPaperConfigger is a function.
+func (config *config) GetPaperConfigger() PaperConfigger {
+	return config.paperConfigger
+}
+
+// GetPortfolioConfigger is a function.
+func (config *config) GetPortfolioConfigger() PortfolioConfigger {
+	return config.portfolioConfigger
+}
+
 // GetRedpandaConfigger is a function.
 func (config *config) GetRedpandaConfigger() RedpandaConfigger {
 	return config.redpandaConfigger
 }
 
+// GetRequestConfigger is a function.
+func (config *config) GetRequestConfigger() RequestConfigger {
+	return config.requestConfigger
+}
+
+// GetRiskConfigger is a function.
+func (config *config) GetRiskConfigger() RiskConfigger {
+	return config.riskConfigger
+}
+
 // GetRuntimeConfigger is a function.
 func (config *config) GetRuntimeConfigger() RuntimeConfigger {
 	return config.runtimeConfigger
 }
 
+// GetSchedulerConfigger is a function.
+func (config *config) GetSchedulerConfigger() SchedulerConfigger {
+	return config.schedulerConfigger
+}
+
 // GetServerConfigger is a function.
 func (config *config) GetServerConfigger() ServerConfigger {
 	return config.serverConfigger
 }
 
+// GetSizingConfigger is a function.
+func (config *config) GetSizingConfigger() SizingConfigger {
+	return config.sizingConfigger
+}
+
+// GetStrategyConfigger is a function.
+func (config *config) GetStrategyConfigger() StrategyConfigger {
+	return config.strategyConfigger
+}
+
+// GetStreamConfigger is a function.
+func (config *config) GetStreamConfigger() StreamConfigger {
+	return config.streamConfigger
+}
+
 // GetMap is a function.
 func (config *config) GetMap() map[string]any {
 	return map[string]any{
-		"database_configger": config.GetDatabaseConfigger(),
-		"kucoin_configger":   config.GetKucoinConfigger(),
-		"logger_configger":   config.GetLogConfigger(),
-		"otel_configger":     config.GetOtelConfigger(),
-		"redpanda_configger": config.GetRedpandaConfigger(),
-		"runtime_configger":  config.GetRuntimeConfigger(),
-		"server_configger":   config.GetServerConfigger(),
+		"algo_configger":      config.GetAlgoConfigger(),
+		"backtest_configger":  config.GetBacktestConfigger(),
+		"database_configger":  config.GetDatabaseConfigger(),
+		"kline_configger":     config.GetKlineConfigger(),
+		"kucoin_configger":    config.GetKucoinConfigger(),
+		"logger_configger":    config.GetLogConfigger(),
+		"otel_configger":      config.GetOtelConfigger(),
+		"paper_configger":     config.GetPaperConfigger(),
+		"portfolio_configger": config.GetPortfolioConfigger(),
+		"redpanda_configger":  config.GetRedpandaConfigger(),
+		"request_configger":   config.GetRequestConfigger(),
+		"risk_configger":      config.GetRiskConfigger(),
+		"runtime_configger":   config.GetRuntimeConfigger(),
+		"scheduler_configger": config.GetSchedulerConfigger(),
+		"server_configger":    config.GetServerConfigger(),
+		"sizing_configger":    config.GetSizingConfigger(),
+		"strategy_configger":  config.GetStrategyConfigger(),
+		"stream_configger":    config.GetStreamConfigger(),
 	}
 }
 
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// KlineConfigger is an interface.
+	KlineConfigger interface {
+		// GetBackfillLookback is a function.
+		GetBackfillLookback() time.Duration
+	}
+
+	// GetKlineConfigger is an interface.
+	GetKlineConfigger interface {
+		// GetKlineConfigger is a function.
+		GetKlineConfigger() KlineConfigger
+	}
+
+	klineConfig struct {
+		backfillLookback time.Duration
+	}
+
+	klineConfigOptioner interface {
+		apply(*klineConfig)
+	}
+
+	klineConfigOptionerFunc func(*klineConfig)
+)
+
+var (
+	_ KlineConfigger = (*klineConfig)(nil)
+	_ json.Marshaler = (*klineConfig)(nil)
+	_ object.GetMap  = (*klineConfig)(nil)
+)
+
+// NewKlineConfig is a function.
+func NewKlineConfig(
+	optioners ...klineConfigOptioner,
+) *klineConfig {
+	klineConfig := &klineConfig{
+		backfillLookback: 0,
+	}
+
+	return klineConfig.WithOptioners(optioners...)
+}
+
+// WithKlineConfigBackfillLookback is a function.
+func WithKlineConfigBackfillLookback(
+	backfillLookback time.Duration,
+) klineConfigOptioner {
+	return klineConfigOptionerFunc(func(
+		config *klineConfig,
+	) {
+		config.backfillLookback = backfillLookback
+	})
+}
+
+// GetBackfillLookback is a function.
+// It is how far back from its end a backfill starts when neither a start nor a
+// stored candle is given.
+func (config *klineConfig) GetBackfillLookback() time.Duration {
+	return config.backfillLookback
+}
+
+// GetMap is a function.
+func (config *klineConfig) GetMap() map[string]any {
+	return map[string]any{
+		"backfill_lookback": config.GetBackfillLookback(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (config *klineConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(config.GetMap())
+}
+
+// WithOptioners is a function.
+func (config *klineConfig) WithOptioners(
+	optioners ...klineConfigOptioner,
+) *klineConfig {
+	newConfig := config.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newConfig)
+	}
+
+	return newConfig
+}
+
+func (config *klineConfig) clone() *klineConfig {
+	newConfig := config
+
+	return newConfig
+}
+
+func (optionerFunc klineConfigOptionerFunc) apply(
+	config *klineConfig,
+) {
+	optionerFunc(config)
+}
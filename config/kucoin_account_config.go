@@ -0,0 +1,139 @@
+package config
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// KucoinAccountConfigger is an interface.
+	KucoinAccountConfigger interface {
+		// GetKey is a function.
+		GetKey() string
+		// GetPassPhrase is a function.
+		GetPassPhrase() string
+		// GetSecret is a function.
+		GetSecret() string
+	}
+
+	kucoinAccountConfig struct {
+		key        string
+		passPhrase string
+		secret     string
+	}
+
+	kucoinAccountConfigOptioner interface {
+		apply(*kucoinAccountConfig)
+	}
+
+	kucoinAccountConfigOptionerFunc func(*kucoinAccountConfig)
+)
+
+var (
+	_ KucoinAccountConfigger = (*kucoinAccountConfig)(nil)
+	_ json.Marshaler         = (*kucoinAccountConfig)(nil)
+	_ object.GetMap          = (*kucoinAccountConfig)(nil)
+)
+
+// NewKucoinAccountConfig is a function.
+// It holds the credentials of one of the named accounts, a sub-account with
+// its own API key most of the time.
+func NewKucoinAccountConfig(
+	optioners ...kucoinAccountConfigOptioner,
+) *kucoinAccountConfig {
+	kucoinAccountConfig := &kucoinAccountConfig{
+		key:        object.URIEmpty,
+		passPhrase: object.URIEmpty,
+		secret:     object.URIEmpty,
+	}
+
+	return kucoinAccountConfig.WithOptioners(optioners...)
+}
+
+// WithKucoinAccountConfigKey is a function.
+func WithKucoinAccountConfigKey(
+	key string,
+) kucoinAccountConfigOptioner {
+	return kucoinAccountConfigOptionerFunc(func(
+		config *kucoinAccountConfig,
+	) {
+		config.key = key
+	})
+}
+
+// WithKucoinAccountConfigPassPhrase is a function.
+func WithKucoinAccountConfigPassPhrase(
+	passPhrase string,
+) kucoinAccountConfigOptioner {
+	return kucoinAccountConfigOptionerFunc(func(
+		config *kucoinAccountConfig,
+	) {
+		config.passPhrase = passPhrase
+	})
+}
+
+// WithKucoinAccountConfigSecret is a function.
+func WithKucoinAccountConfigSecret(
+	secret string,
+) kucoinAccountConfigOptioner {
+	return kucoinAccountConfigOptionerFunc(func(
+		config *kucoinAccountConfig,
+	) {
+		config.secret = secret
+	})
+}
+
+// GetKey is a function.
+func (config *kucoinAccountConfig) GetKey() string {
+	return config.key
+}
+
+// GetPassPhrase is a function.
+func (config *kucoinAccountConfig) GetPassPhrase() string {
+	return config.passPhrase
+}
+
+// GetSecret is a function.
+func (config *kucoinAccountConfig) GetSecret() string {
+	return config.secret
+}
+
+// GetMap is a function.
+func (config *kucoinAccountConfig) GetMap() map[string]any {
+	return map[string]any{
+		"key":         config.GetKey(),
+		"pass_phrase": config.GetPassPhrase(),
+		"secret":      config.GetSecret(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (config *kucoinAccountConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(config.GetMap())
+}
+
+// WithOptioners is a function.
+func (config *kucoinAccountConfig) WithOptioners(
+	optioners ...kucoinAccountConfigOptioner,
+) *kucoinAccountConfig {
+	newConfig := config.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newConfig)
+	}
+
+	return newConfig
+}
+
+func (config *kucoinAccountConfig) clone() *kucoinAccountConfig {
+	newConfig := config
+
+	return newConfig
+}
+
+func (optionerFunc kucoinAccountConfigOptionerFunc) apply(
+	config *kucoinAccountConfig,
+) {
+	optionerFunc(config)
+}
@@ -9,6 +9,10 @@ import (
 type (
 	// KucoinConfigger is an interface.
 	KucoinConfigger interface {
+		// GetAccount is a function.
+		GetAccount() string
+		// GetAccounts is a function.
+		GetAccounts() map[string]KucoinAccountConfigger
 		// GetKey is a function.
 		GetKey() string
 		// GetPassPhrase is a function.
@@ -24,6 +28,8 @@ type (
 	}
 
 	kucoinConfig struct {
+		accounts   map[string]KucoinAccountConfigger
+		account    string
 		key        string
 		passPhrase string
 		secret     string
@@ -47,6 +53,8 @@ func NewKucoinConfig(
 	optioners ...kucoinConfigOptioner,
 ) *kucoinConfig {
 	kucoinConfig := &kucoinConfig{
+		accounts:   map[string]KucoinAccountConfigger{},
+		account:    object.URIEmpty,
 		key:        object.URIEmpty,
 		passPhrase: object.URIEmpty,
 		secret:     object.URIEmpty,
@@ -55,6 +63,28 @@ func NewKucoinConfig(
 	return kucoinConfig.WithOptioners(optioners...)
 }
 
+// WithKucoinConfigAccount is a function.
+func WithKucoinConfigAccount(
+	account string,
+) kucoinConfigOptioner {
+	return kucoinConfigOptionerFunc(func(
+		config *kucoinConfig,
+	) {
+		config.account = account
+	})
+}
+
+// WithKucoinConfigAccounts is a function.
+func WithKucoinConfigAccounts(
+	accounts map[string]KucoinAccountConfigger,
+) kucoinConfigOptioner {
+	return kucoinConfigOptionerFunc(func(
+		config *kucoinConfig,
+	) {
+		config.accounts = accounts
+	})
+}
+
 // WithKucoinConfigKey is a function.
 func WithKucoinConfigKey(
 	key string,
@@ -88,6 +118,19 @@ func WithKucoinConfigSecret(
 	})
 }
 
+// GetAccount is a function.
+// It is the name of the account the key, the pass phrase and the secret belong
+// to, the master account most of the time.
+func (config *kucoinConfig) GetAccount() string {
+	return config.account
+}
+
+// GetAccounts is a function.
+// They are the other accounts by name, each with credentials of its own.
+func (config *kucoinConfig) GetAccounts() map[string]KucoinAccountConfigger {
+	return config.accounts
+}
+
 // GetKey is a function.
 func (config *kucoinConfig) GetKey() string {
 	return config.key
@@ -106,6 +149,8 @@ func (config *kucoinConfig) GetSecret() string {
 // GetMap is a function.
 func (config *kucoinConfig) GetMap() map[string]any {
 	return map[string]any{
+		"account":     config.GetAccount(),
+		"accounts":    config.GetAccounts(),
 		"key":         config.GetKey(),
 		"pass_phrase": config.GetPassPhrase(),
 		"secret":      config.GetSecret(),
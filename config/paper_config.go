@@ -0,0 +1,207 @@
+package config
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// PaperConfigger is an interface.
+	PaperConfigger interface {
+		// GetBalances is a function.
+		GetBalances() map[string]string
+		// GetEnabled is a function.
+		GetEnabled() bool
+		// GetMakerFeeRate is a function.
+		GetMakerFeeRate() float64
+		// GetMatchInterval is a function.
+		GetMatchInterval() time.Duration
+		// GetQuoteFile is a function.
+		GetQuoteFile() string
+		// GetTakerFeeRate is a function.
+		GetTakerFeeRate() float64
+	}
+
+	// GetPaperConfigger is an interface.
+	GetPaperConfigger interface {
+		// GetPaperConfigger is a function.
+		GetPaperConfigger() PaperConfigger
+	}
+
+	paperConfig struct {
+		balances      map[string]string
+		enabled       bool
+		makerFeeRate  float64
+		matchInterval time.Duration
+		quoteFile     string
+		takerFeeRate  float64
+	}
+
+	paperConfigOptioner interface {
+		apply(*paperConfig)
+	}
+
+	paperConfigOptionerFunc func(*paperConfig)
+)
+
+var (
+	_ PaperConfigger = (*paperConfig)(nil)
+	_ json.Marshaler = (*paperConfig)(nil)
+	_ object.GetMap  = (*paperConfig)(nil)
+)
+
+// NewPaperConfig is a function.
+func NewPaperConfig(
+	optioners ...paperConfigOptioner,
+) *paperConfig {
+	paperConfig := &paperConfig{
+		balances:      map[string]string{},
+		enabled:       false,
+		makerFeeRate:  0,
+		matchInterval: 0,
+		quoteFile:     object.URIEmpty,
+		takerFeeRate:  0,
+	}
+
+	return paperConfig.WithOptioners(optioners...)
+}
+
+// WithPaperConfigBalances is a function.
+func WithPaperConfigBalances(
+	balances map[string]string,
+) paperConfigOptioner {
+	return paperConfigOptionerFunc(func(
+		config *paperConfig,
+	) {
+		config.balances = balances
+	})
+}
+
+// WithPaperConfigEnabled is a function.
+func WithPaperConfigEnabled(
+	enabled bool,
+) paperConfigOptioner {
+	return paperConfigOptionerFunc(func(
+		config *paperConfig,
+	) {
+		config.enabled = enabled
+	})
+}
+
+// WithPaperConfigMakerFeeRate is a function.
+func WithPaperConfigMakerFeeRate(
+	makerFeeRate float64,
+) paperConfigOptioner {
+	return paperConfigOptionerFunc(func(
+		config *paperConfig,
+	) {
+		config.makerFeeRate = makerFeeRate
+	})
+}
+
+// WithPaperConfigMatchInterval is a function.
+func WithPaperConfigMatchInterval(
+	matchInterval time.Duration,
+) paperConfigOptioner {
+	return paperConfigOptionerFunc(func(
+		config *paperConfig,
+	) {
+		config.matchInterval = matchInterval
+	})
+}
+
+// WithPaperConfigQuoteFile is a function.
+func WithPaperConfigQuoteFile(
+	quoteFile string,
+) paperConfigOptioner {
+	return paperConfigOptionerFunc(func(
+		config *paperConfig,
+	) {
+		config.quoteFile = quoteFile
+	})
+}
+
+// WithPaperConfigTakerFeeRate is a function.
+func WithPaperConfigTakerFeeRate(
+	takerFeeRate float64,
+) paperConfigOptioner {
+	return paperConfigOptionerFunc(func(
+		config *paperConfig,
+	) {
+		config.takerFeeRate = takerFeeRate
+	})
+}
+
+// GetBalances is a function.
+func (config *paperConfig) GetBalances() map[string]string {
+	return config.balances
+}
+
+// GetEnabled is a function.
+func (config *paperConfig) GetEnabled() bool {
+	return config.enabled
+}
+
+// GetMakerFeeRate is a function.
+func (config *paperConfig) GetMakerFeeRate() float64 {
+	return config.makerFeeRate
+}
+
+// GetMatchInterval is a function.
+func (config *paperConfig) GetMatchInterval() time.Duration {
+	return config.matchInterval
+}
+
+// GetQuoteFile is a function.
+func (config *paperConfig) GetQuoteFile() string {
+	return config.quoteFile
+}
+
+// GetTakerFeeRate is a function.
+func (config *paperConfig) GetTakerFeeRate() float64 {
+	return config.takerFeeRate
+}
+
+// GetMap is a function.
+func (config *paperConfig) GetMap() map[string]any {
+	return map[string]any{
+		"balances":       config.GetBalances(),
+		"enabled":        config.GetEnabled(),
+		"maker_fee_rate": config.GetMakerFeeRate(),
+		"match_interval": config.GetMatchInterval(),
+		"quote_file":     config.GetQuoteFile(),
+		"taker_fee_rate": config.GetTakerFeeRate(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (config *paperConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(config.GetMap())
+}
+
+// WithOptioners is a function.
+func (config *paperConfig) WithOptioners(
+	optioners ...paperConfigOptioner,
+) *paperConfig {
+	newConfig := config.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newConfig)
+	}
+
+	return newConfig
+}
+
+func (config *paperConfig) clone() *paperConfig {
+	newConfig := config
+
+	return newConfig
+}
+
+func (optionerFunc paperConfigOptionerFunc) apply(
+	config *paperConfig,
+) {
+	optionerFunc(config)
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// PortfolioConfigger is an interface.
+	PortfolioConfigger interface {
+		// GetCurrency is a function.
+		GetCurrency() string
+	}
+
+	// GetPortfolioConfigger is an interface.
+	GetPortfolioConfigger interface {
+		// GetPortfolioConfigger is a function.
+		GetPortfolioConfigger() PortfolioConfigger
+	}
+
+	portfolioConfig struct {
+		currency string
+	}
+
+	portfolioConfigOptioner interface {
+		apply(*portfolioConfig)
+	}
+
+	portfolioConfigOptionerFunc func(*portfolioConfig)
+)
+
+var (
+	_ PortfolioConfigger = (*portfolioConfig)(nil)
+	_ json.Marshaler     = (*portfolioConfig)(nil)
+	_ object.GetMap      = (*portfolioConfig)(nil)
+)
+
+// NewPortfolioConfig is a function.
+func NewPortfolioConfig(
+	optioners ...portfolioConfigOptioner,
+) *portfolioConfig {
+	portfolioConfig := &portfolioConfig{
+		currency: object.URIEmpty,
+	}
+
+	return portfolioConfig.WithOptioners(optioners...)
+}
+
+// WithPortfolioConfigCurrency is a function.
+func WithPortfolioConfigCurrency(
+	currency string,
+) portfolioConfigOptioner {
+	return portfolioConfigOptionerFunc(func(
+		config *portfolioConfig,
+	) {
+		config.currency = currency
+	})
+}
+
+// GetCurrency is a function.
+func (config *portfolioConfig) GetCurrency() string {
+	return config.currency
+}
+
+// GetMap is a function.
+func (config *portfolioConfig) GetMap() map[string]any {
+	return map[string]any{
+		"currency": config.GetCurrency(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (config *portfolioConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(config.GetMap())
+}
+
+// WithOptioners is a function.
+func (config *portfolioConfig) WithOptioners(
+	optioners ...portfolioConfigOptioner,
+) *portfolioConfig {
+	newConfig := config.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newConfig)
+	}
+
+	return newConfig
+}
+
+func (config *portfolioConfig) clone() *portfolioConfig {
+	newConfig := config
+
+	return newConfig
+}
+
+func (optionerFunc portfolioConfigOptionerFunc) apply(
+	config *portfolioConfig,
+) {
+	optionerFunc(config)
+}
@@ -0,0 +1,284 @@
+package config
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// RequestConfigger is an interface.
+	RequestConfigger interface {
+		// GetBreakerCooldown is a function.
+		GetBreakerCooldown() time.Duration
+		// GetLimitWindow is a function.
+		GetLimitWindow() time.Duration
+		// GetMaxBackoff is a function.
+		GetMaxBackoff() time.Duration
+		// GetMinBackoff is a function.
+		GetMinBackoff() time.Duration
+		// GetBreakerThreshold is a function.
+		GetBreakerThreshold() uint32
+		// GetManagementLimit is a function.
+		GetManagementLimit() uint32
+		// GetMaxAttempts is a function.
+		GetMaxAttempts() uint32
+		// GetPublicLimit is a function.
+		GetPublicLimit() uint32
+		// GetSpotLimit is a function.
+		GetSpotLimit() uint32
+	}
+
+	// GetRequestConfigger is an interface.
+	GetRequestConfigger interface {
+		// GetRequestConfigger is a function.
+		GetRequestConfigger() RequestConfigger
+	}
+
+	requestConfig struct {
+		breakerCooldown  time.Duration
+		limitWindow      time.Duration
+		maxBackoff       time.Duration
+		minBackoff       time.Duration
+		breakerThreshold uint32
+		managementLimit  uint32
+		maxAttempts      uint32
+		publicLimit      uint32
+		spotLimit        uint32
+	}
+
+	requestConfigOptioner interface {
+		apply(*requestConfig)
+	}
+
+	requestConfigOptionerFunc func(*requestConfig)
+)
+
+var (
+	_ RequestConfigger = (*requestConfig)(nil)
+	_ json.Marshaler   = (*requestConfig)(nil)
+	_ object.GetMap    = (*requestConfig)(nil)
+)
+
+// NewRequestConfig is a function.
+func NewRequestConfig(
+	optioners ...requestConfigOptioner,
+) *requestConfig {
+	requestConfig := &requestConfig{
+		breakerCooldown:  0,
+		limitWindow:      0,
+		maxBackoff:       0,
+		minBackoff:       0,
+		breakerThreshold: 0,
+		managementLimit:  0,
+		maxAttempts:      0,
+		publicLimit:      0,
+		spotLimit:        0,
+	}
+
+	return requestConfig.WithOptioners(optioners...)
+}
+
+// WithRequestConfigBreakerCooldown is a function.
+func WithRequestConfigBreakerCooldown(
+	breakerCooldown time.Duration,
+) requestConfigOptioner {
+	return requestConfigOptionerFunc(func(
+		config *requestConfig,
+	) {
+		config.breakerCooldown = breakerCooldown
+	})
+}
+
+// WithRequestConfigLimitWindow is a function.
+func WithRequestConfigLimitWindow(
+	limitWindow time.Duration,
+) requestConfigOptioner {
+	return requestConfigOptionerFunc(func(
+		config *requestConfig,
+	) {
+		config.limitWindow = limitWindow
+	})
+}
+
+// WithRequestConfigMaxBackoff is a function.
+func WithRequestConfigMaxBackoff(
+	maxBackoff time.Duration,
+) requestConfigOptioner {
+	return requestConfigOptionerFunc(func(
+		config *requestConfig,
+	) {
+		config.maxBackoff = maxBackoff
+	})
+}
+
+// WithRequestConfigMinBackoff is a function.
+func WithRequestConfigMinBackoff(
+	minBackoff time.Duration,
+) requestConfigOptioner {
+	return requestConfigOptionerFunc(func(
+		config *requestConfig,
+	) {
+		config.minBackoff = minBackoff
+	})
+}
+
+// WithRequestConfigBreakerThreshold is a function.
+func WithRequestConfigBreakerThreshold(
+	breakerThreshold uint32,
+) requestConfigOptioner {
+	return requestConfigOptionerFunc(func(
+		config *requestConfig,
+	) {
+		config.breakerThreshold = breakerThreshold
+	})
+}
+
+// WithRequestConfigManagementLimit is a function.
+func WithRequestConfigManagementLimit(
+	managementLimit uint32,
+) requestConfigOptioner {
+	return requestConfigOptionerFunc(func(
+		config *requestConfig,
+	) {
+		config.managementLimit = managementLimit
+	})
+}
+
+// WithRequestConfigMaxAttempts is a function.
+func WithRequestConfigMaxAttempts(
+	maxAttempts uint32,
+) requestConfigOptioner {
+	return requestConfigOptionerFunc(func(
+		config *requestConfig,
+	) {
+		config.maxAttempts = maxAttempts
+	})
+}
+
+// WithRequestConfigPublicLimit is a function.
+func WithRequestConfigPublicLimit(
+	publicLimit uint32,
+) requestConfigOptioner {
+	return requestConfigOptionerFunc(func(
+		config *requestConfig,
+	) {
+		config.publicLimit = publicLimit
+	})
+}
+
+// WithRequestConfigSpotLimit is a function.
+func WithRequestConfigSpotLimit(
+	spotLimit uint32,
+) requestConfigOptioner {
+	return requestConfigOptionerFunc(func(
+		config *requestConfig,
+	) {
+		config.spotLimit = spotLimit
+	})
+}
+
+// GetBreakerCooldown is a function.
+// It is how long an open circuit refuses the calls of its endpoint group before
+// it lets one through to probe the exchange.
+func (config *requestConfig) GetBreakerCooldown() time.Duration {
+	return config.breakerCooldown
+}
+
+// GetLimitWindow is a function.
+// It is the window the limits of the endpoint groups refill over.
+func (config *requestConfig) GetLimitWindow() time.Duration {
+	return config.limitWindow
+}
+
+// GetMaxBackoff is a function.
+// It caps the wait between two attempts of a call.
+func (config *requestConfig) GetMaxBackoff() time.Duration {
+	return config.maxBackoff
+}
+
+// GetMinBackoff is a function.
+// It is the wait before the second attempt of a call, doubled after each one.
+func (config *requestConfig) GetMinBackoff() time.Duration {
+	return config.minBackoff
+}
+
+// GetBreakerThreshold is a function.
+// It is how many calls of an endpoint group in a row may find the exchange
+// unreachable or busy before its circuit opens.
+func (config *requestConfig) GetBreakerThreshold() uint32 {
+	return config.breakerThreshold
+}
+
+// GetManagementLimit is a function.
+// It is the weight the management endpoints of an account may spend in a
+// window.
+func (config *requestConfig) GetManagementLimit() uint32 {
+	return config.managementLimit
+}
+
+// GetMaxAttempts is a function.
+// It counts the first attempt of a call too.
+func (config *requestConfig) GetMaxAttempts() uint32 {
+	return config.maxAttempts
+}
+
+// GetPublicLimit is a function.
+// It is the weight the public endpoints may spend in a window, shared by every
+// account since KuCoin counts it by IP.
+func (config *requestConfig) GetPublicLimit() uint32 {
+	return config.publicLimit
+}
+
+// GetSpotLimit is a function.
+// It is the weight the spot and margin endpoints of an account may spend in a
+// window.
+func (config *requestConfig) GetSpotLimit() uint32 {
+	return config.spotLimit
+}
+
+// GetMap is a function.
+func (config *requestConfig) GetMap() map[string]any {
+	return map[string]any{
+		"breaker_cooldown":  config.GetBreakerCooldown(),
+		"limit_window":      config.GetLimitWindow(),
+		"max_backoff":       config.GetMaxBackoff(),
+		"min_backoff":       config.GetMinBackoff(),
+		"breaker_threshold": config.GetBreakerThreshold(),
+		"management_limit":  config.GetManagementLimit(),
+		"max_attempts":      config.GetMaxAttempts(),
+		"public_limit":      config.GetPublicLimit(),
+		"spot_limit":        config.GetSpotLimit(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (config *requestConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(config.GetMap())
+}
+
+// WithOptioners is a function.
+func (config *requestConfig) WithOptioners(
+	optioners ...requestConfigOptioner,
+) *requestConfig {
+	newConfig := config.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newConfig)
+	}
+
+	return newConfig
+}
+
+func (config *requestConfig) clone() *requestConfig {
+	newConfig := config
+
+	return newConfig
+}
+
+func (optionerFunc requestConfigOptionerFunc) apply(
+	config *requestConfig,
+) {
+	optionerFunc(config)
+}
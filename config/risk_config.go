@@ -0,0 +1,101 @@
+package config
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// RiskConfigger is an interface.
+	RiskConfigger interface {
+		// GetLimits is a function.
+		GetLimits() map[string]string
+	}
+
+	// GetRiskConfigger is an interface.
+	GetRiskConfigger interface {
+		// GetRiskConfigger is a function.
+		GetRiskConfigger() RiskConfigger
+	}
+
+	riskConfig struct {
+		limits map[string]string
+	}
+
+	riskConfigOptioner interface {
+		apply(*riskConfig)
+	}
+
+	riskConfigOptionerFunc func(*riskConfig)
+)
+
+var (
+	_ RiskConfigger  = (*riskConfig)(nil)
+	_ json.Marshaler = (*riskConfig)(nil)
+	_ object.GetMap  = (*riskConfig)(nil)
+)
+
+// NewRiskConfig is a function.
+func NewRiskConfig(
+	optioners ...riskConfigOptioner,
+) *riskConfig {
+	riskConfig := &riskConfig{
+		limits: map[string]string{},
+	}
+
+	return riskConfig.WithOptioners(optioners...)
+}
+
+// WithRiskConfigLimits is a function.
+func WithRiskConfigLimits(
+	limits map[string]string,
+) riskConfigOptioner {
+	return riskConfigOptionerFunc(func(
+		config *riskConfig,
+	) {
+		config.limits = limits
+	})
+}
+
+// GetLimits is a function.
+func (config *riskConfig) GetLimits() map[string]string {
+	return config.limits
+}
+
+// GetMap is a function.
+func (config *riskConfig) GetMap() map[string]any {
+	return map[string]any{
+		"limits": config.GetLimits(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (config *riskConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(config.GetMap())
+}
+
+// WithOptioners is a function.
+func (config *riskConfig) WithOptioners(
+	optioners ...riskConfigOptioner,
+) *riskConfig {
+	newConfig := config.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newConfig)
+	}
+
+	return newConfig
+}
+
+func (config *riskConfig) clone() *riskConfig {
+	newConfig := config
+
+	return newConfig
+}
+
+func (optionerFunc riskConfigOptionerFunc) apply(
+	config *riskConfig,
+) {
+	optionerFunc(config)
+}
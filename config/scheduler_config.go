@@ -0,0 +1,354 @@
+package config
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// SchedulerConfigger is an interface.
+	SchedulerConfigger interface {
+		// GetAlgoOrderSyncInterval is a function.
+		GetAlgoOrderSyncInterval() time.Duration
+		// GetBracketSyncInterval is a function.
+		GetBracketSyncInterval() time.Duration
+		// GetExchangeStatusSyncInterval is a function.
+		GetExchangeStatusSyncInterval() time.Duration
+		// GetFillSyncInterval is a function.
+		GetFillSyncInterval() time.Duration
+		// GetMarginSyncInterval is a function.
+		GetMarginSyncInterval() time.Duration
+		// GetOrderReconcileInterval is a function.
+		GetOrderReconcileInterval() time.Duration
+		// GetOrderSyncCron is a function.
+		GetOrderSyncCron() string
+		// GetPortfolioSnapshotInterval is a function.
+		GetPortfolioSnapshotInterval() time.Duration
+		// GetStopOrderSyncInterval is a function.
+		GetStopOrderSyncInterval() time.Duration
+		// GetStrategyEvaluationDelay is a function.
+		GetStrategyEvaluationDelay() time.Duration
+		// GetStrategyEvaluationKlineType is a function.
+		GetStrategyEvaluationKlineType() string
+		// GetSymbolRefreshInterval is a function.
+		GetSymbolRefreshInterval() time.Duration
+		// GetTickerRefreshInterval is a function.
+		GetTickerRefreshInterval() time.Duration
+	}
+
+	// GetSchedulerConfigger is an interface.
+	GetSchedulerConfigger interface {
+		// GetSchedulerConfigger is a function.
+		GetSchedulerConfigger() SchedulerConfigger
+	}
+
+	schedulerConfig struct {
+		algoOrderSyncInterval       time.Duration
+		bracketSyncInterval         time.Duration
+		exchangeStatusSyncInterval  time.Duration
+		fillSyncInterval            time.Duration
+		marginSyncInterval          time.Duration
+		orderReconcileInterval      time.Duration
+		orderSyncCron               string
+		portfolioSnapshotInterval   time.Duration
+		stopOrderSyncInterval       time.Duration
+		strategyEvaluationDelay     time.Duration
+		strategyEvaluationKlineType string
+		symbolRefreshInterval       time.Duration
+		tickerRefreshInterval       time.Duration
+	}
+
+	schedulerConfigOptioner interface {
+		apply(*schedulerConfig)
+	}
+
+	schedulerConfigOptionerFunc func(*schedulerConfig)
+)
+
+var (
+	_ SchedulerConfigger = (*schedulerConfig)(nil)
+	_ json.Marshaler     = (*schedulerConfig)(nil)
+	_ object.GetMap      = (*schedulerConfig)(nil)
+)
+
+// NewSchedulerConfig is a function.
+func NewSchedulerConfig(
+	optioners ...schedulerConfigOptioner,
+) *schedulerConfig {
+	schedulerConfig := &schedulerConfig{
+		algoOrderSyncInterval:       0,
+		bracketSyncInterval:         0,
+		exchangeStatusSyncInterval:  0,
+		fillSyncInterval:            0,
+		marginSyncInterval:          0,
+		orderReconcileInterval:      0,
+		orderSyncCron:               object.URIEmpty,
+		portfolioSnapshotInterval:   0,
+		stopOrderSyncInterval:       0,
+		strategyEvaluationDelay:     0,
+		strategyEvaluationKlineType: object.URIEmpty,
+		symbolRefreshInterval:       0,
+		tickerRefreshInterval:       0,
+	}
+
+	return schedulerConfig.WithOptioners(optioners...)
+}
+
+// WithSchedulerConfigAlgoOrderSyncInterval is a function.
+func WithSchedulerConfigAlgoOrderSyncInterval(
+	algoOrderSyncInterval time.Duration,
+) schedulerConfigOptioner {
+	return schedulerConfigOptionerFunc(func(
+		config *schedulerConfig,
+	) {
+		config.algoOrderSyncInterval = algoOrderSyncInterval
+	})
+}
+
+// WithSchedulerConfigBracketSyncInterval is a function.
+func WithSchedulerConfigBracketSyncInterval(
+	bracketSyncInterval time.Duration,
+) schedulerConfigOptioner {
+	return schedulerConfigOptionerFunc(func(
+		config *schedulerConfig,
+	) {
+		config.bracketSyncInterval = bracketSyncInterval
+	})
+}
+
+// WithSchedulerConfigExchangeStatusSyncInterval is a function.
+func WithSchedulerConfigExchangeStatusSyncInterval(
+	exchangeStatusSyncInterval time.Duration,
+) schedulerConfigOptioner {
+	return schedulerConfigOptionerFunc(func(
+		config *schedulerConfig,
+	) {
+		config.exchangeStatusSyncInterval = exchangeStatusSyncInterval
+	})
+}
+
+// WithSchedulerConfigFillSyncInterval is a function.
+func WithSchedulerConfigFillSyncInterval(
+	fillSyncInterval time.Duration,
+) schedulerConfigOptioner {
+	return schedulerConfigOptionerFunc(func(
+		config *schedulerConfig,
+	) {
+		config.fillSyncInterval = fillSyncInterval
+	})
+}
+
+// WithSchedulerConfigMarginSyncInterval is a function.
+func WithSchedulerConfigMarginSyncInterval(
+	marginSyncInterval time.Duration,
+) schedulerConfigOptioner {
+	return schedulerConfigOptionerFunc(func(
+		config *schedulerConfig,
+	) {
+		config.marginSyncInterval = marginSyncInterval
+	})
+}
+
+// WithSchedulerConfigOrderReconcileInterval is a function.
+func WithSchedulerConfigOrderReconcileInterval(
+	orderReconcileInterval time.Duration,
+) schedulerConfigOptioner {
+	return schedulerConfigOptionerFunc(func(
+		config *schedulerConfig,
+	) {
+		config.orderReconcileInterval = orderReconcileInterval
+	})
+}
+
+// WithSchedulerConfigOrderSyncCron is a function.
+func WithSchedulerConfigOrderSyncCron(
+	orderSyncCron string,
+) schedulerConfigOptioner {
+	return schedulerConfigOptionerFunc(func(
+		config *schedulerConfig,
+	) {
+		config.orderSyncCron = orderSyncCron
+	})
+}
+
+// WithSchedulerConfigPortfolioSnapshotInterval is a function.
+func WithSchedulerConfigPortfolioSnapshotInterval(
+	portfolioSnapshotInterval time.Duration,
+) schedulerConfigOptioner {
+	return schedulerConfigOptionerFunc(func(
+		config *schedulerConfig,
+	) {
+		config.portfolioSnapshotInterval = portfolioSnapshotInterval
+	})
+}
+
+// WithSchedulerConfigStopOrderSyncInterval is a function.
+func WithSchedulerConfigStopOrderSyncInterval(
+	stopOrderSyncInterval time.Duration,
+) schedulerConfigOptioner {
+	return schedulerConfigOptionerFunc(func(
+		config *schedulerConfig,
+	) {
+		config.stopOrderSyncInterval = stopOrderSyncInterval
+	})
+}
+
+// WithSchedulerConfigStrategyEvaluationDelay is a function.
+func WithSchedulerConfigStrategyEvaluationDelay(
+	strategyEvaluationDelay time.Duration,
+) schedulerConfigOptioner {
+	return schedulerConfigOptionerFunc(func(
+		config *schedulerConfig,
+	) {
+		config.strategyEvaluationDelay = strategyEvaluationDelay
+	})
+}
+
+// WithSchedulerConfigStrategyEvaluationKlineType is a function.
+func WithSchedulerConfigStrategyEvaluationKlineType(
+	strategyEvaluationKlineType string,
+) schedulerConfigOptioner {
+	return schedulerConfigOptionerFunc(func(
+		config *schedulerConfig,
+	) {
+		config.strategyEvaluationKlineType = strategyEvaluationKlineType
+	})
+}
+
+// WithSchedulerConfigSymbolRefreshInterval is a function.
+func WithSchedulerConfigSymbolRefreshInterval(
+	symbolRefreshInterval time.Duration,
+) schedulerConfigOptioner {
+	return schedulerConfigOptionerFunc(func(
+		config *schedulerConfig,
+	) {
+		config.symbolRefreshInterval = symbolRefreshInterval
+	})
+}
+
+// WithSchedulerConfigTickerRefreshInterval is a function.
+func WithSchedulerConfigTickerRefreshInterval(
+	tickerRefreshInterval time.Duration,
+) schedulerConfigOptioner {
+	return schedulerConfigOptionerFunc(func(
+		config *schedulerConfig,
+	) {
+		config.tickerRefreshInterval = tickerRefreshInterval
+	})
+}
+
+// GetAlgoOrderSyncInterval is a function.
+func (config *schedulerConfig) GetAlgoOrderSyncInterval() time.Duration {
+	return config.algoOrderSyncInterval
+}
+
+// GetBracketSyncInterval is a function.
+func (config *schedulerConfig) GetBracketSyncInterval() time.Duration {
+	return config.bracketSyncInterval
+}
+
+// GetExchangeStatusSyncInterval is a function.
+func (config *schedulerConfig) GetExchangeStatusSyncInterval() time.Duration {
+	return config.exchangeStatusSyncInterval
+}
+
+// GetFillSyncInterval is a function.
+func (config *schedulerConfig) GetFillSyncInterval() time.Duration {
+	return config.fillSyncInterval
+}
+
+// GetMarginSyncInterval is a function.
+func (config *schedulerConfig) GetMarginSyncInterval() time.Duration {
+	return config.marginSyncInterval
+}
+
+// GetOrderReconcileInterval is a function.
+func (config *schedulerConfig) GetOrderReconcileInterval() time.Duration {
+	return config.orderReconcileInterval
+}
+
+// GetOrderSyncCron is a function.
+func (config *schedulerConfig) GetOrderSyncCron() string {
+	return config.orderSyncCron
+}
+
+// GetPortfolioSnapshotInterval is a function.
+func (config *schedulerConfig) GetPortfolioSnapshotInterval() time.Duration {
+	return config.portfolioSnapshotInterval
+}
+
+// GetStopOrderSyncInterval is a function.
+func (config *schedulerConfig) GetStopOrderSyncInterval() time.Duration {
+	return config.stopOrderSyncInterval
+}
+
+// GetStrategyEvaluationDelay is a function.
+func (config *schedulerConfig) GetStrategyEvaluationDelay() time.Duration {
+	return config.strategyEvaluationDelay
+}
+
+// GetStrategyEvaluationKlineType is a function.
+func (config *schedulerConfig) GetStrategyEvaluationKlineType() string {
+	return config.strategyEvaluationKlineType
+}
+
+// GetSymbolRefreshInterval is a function.
+func (config *schedulerConfig) GetSymbolRefreshInterval() time.Duration {
+	return config.symbolRefreshInterval
+}
+
+// GetTickerRefreshInterval is a function.
+func (config *schedulerConfig) GetTickerRefreshInterval() time.Duration {
+	return config.tickerRefreshInterval
+}
+
+// GetMap is a function.
+func (config *schedulerConfig) GetMap() map[string]any {
+	return map[string]any{
+		"algo_order_sync_interval":       config.GetAlgoOrderSyncInterval(),
+		"bracket_sync_interval":          config.GetBracketSyncInterval(),
+		"exchange_status_sync_interval":  config.GetExchangeStatusSyncInterval(),
+		"fill_sync_interval":             config.GetFillSyncInterval(),
+		"margin_sync_interval":           config.GetMarginSyncInterval(),
+		"order_reconcile_interval":       config.GetOrderReconcileInterval(),
+		"order_sync_cron":                config.GetOrderSyncCron(),
+		"portfolio_snapshot_interval":    config.GetPortfolioSnapshotInterval(),
+		"stop_order_sync_interval":       config.GetStopOrderSyncInterval(),
+		"strategy_evaluation_delay":      config.GetStrategyEvaluationDelay(),
+		"strategy_evaluation_kline_type": config.GetStrategyEvaluationKlineType(),
+		"symbol_refresh_interval":        config.GetSymbolRefreshInterval(),
+		"ticker_refresh_interval":        config.GetTickerRefreshInterval(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (config *schedulerConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(config.GetMap())
+}
+
+// WithOptioners is a function.
+func (config *schedulerConfig) WithOptioners(
+	optioners ...schedulerConfigOptioner,
+) *schedulerConfig {
+	newConfig := config.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newConfig)
+	}
+
+	return newConfig
+}
+
+func (config *schedulerConfig) clone() *schedulerConfig {
+	newConfig := config
+
+	return newConfig
+}
+
+func (optionerFunc schedulerConfigOptionerFunc) apply(
+	config *schedulerConfig,
+) {
+	optionerFunc(config)
+}
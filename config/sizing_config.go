@@ -0,0 +1,101 @@
+package config
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// SizingConfigger is an interface.
+	SizingConfigger interface {
+		// GetParameters is a function.
+		GetParameters() map[string]string
+	}
+
+	// GetSizingConfigger is an interface.
+	GetSizingConfigger interface {
+		// GetSizingConfigger is a function.
+		GetSizingConfigger() SizingConfigger
+	}
+
+	sizingConfig struct {
+		parameters map[string]string
+	}
+
+	sizingConfigOptioner interface {
+		apply(*sizingConfig)
+	}
+
+	sizingConfigOptionerFunc func(*sizingConfig)
+)
+
+var (
+	_ SizingConfigger = (*sizingConfig)(nil)
+	_ json.Marshaler  = (*sizingConfig)(nil)
+	_ object.GetMap   = (*sizingConfig)(nil)
+)
+
+// NewSizingConfig is a function.
+func NewSizingConfig(
+	optioners ...sizingConfigOptioner,
+) *sizingConfig {
+	sizingConfig := &sizingConfig{
+		parameters: map[string]string{},
+	}
+
+	return sizingConfig.WithOptioners(optioners...)
+}
+
+// WithSizingConfigParameters is a function.
+func WithSizingConfigParameters(
+	parameters map[string]string,
+) sizingConfigOptioner {
+	return sizingConfigOptionerFunc(func(
+		config *sizingConfig,
+	) {
+		config.parameters = parameters
+	})
+}
+
+// GetParameters is a function.
+func (config *sizingConfig) GetParameters() map[string]string {
+	return config.parameters
+}
+
+// GetMap is a function.
+func (config *sizingConfig) GetMap() map[string]any {
+	return map[string]any{
+		"parameters": config.GetParameters(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (config *sizingConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(config.GetMap())
+}
+
+// WithOptioners is a function.
+func (config *sizingConfig) WithOptioners(
+	optioners ...sizingConfigOptioner,
+) *sizingConfig {
+	newConfig := config.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newConfig)
+	}
+
+	return newConfig
+}
+
+func (config *sizingConfig) clone() *sizingConfig {
+	newConfig := config
+
+	return newConfig
+}
+
+func (optionerFunc sizingConfigOptionerFunc) apply(
+	config *sizingConfig,
+) {
+	optionerFunc(config)
+}
@@ -0,0 +1,145 @@
+package config
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// StrategyConfigger is an interface.
+	StrategyConfigger interface {
+		// GetAccounts is a function.
+		GetAccounts() map[string]string
+		// GetNames is a function.
+		GetNames() []string
+		// GetParameters is a function.
+		GetParameters() map[string]string
+	}
+
+	// GetStrategyConfigger is an interface.
+	GetStrategyConfigger interface {
+		// GetStrategyConfigger is a function.
+		GetStrategyConfigger() StrategyConfigger
+	}
+
+	strategyConfig struct {
+		accounts   map[string]string
+		names      []string
+		parameters map[string]string
+	}
+
+	strategyConfigOptioner interface {
+		apply(*strategyConfig)
+	}
+
+	strategyConfigOptionerFunc func(*strategyConfig)
+)
+
+var (
+	_ StrategyConfigger = (*strategyConfig)(nil)
+	_ json.Marshaler    = (*strategyConfig)(nil)
+	_ object.GetMap     = (*strategyConfig)(nil)
+)
+
+// NewStrategyConfig is a function.
+func NewStrategyConfig(
+	optioners ...strategyConfigOptioner,
+) *strategyConfig {
+	strategyConfig := &strategyConfig{
+		accounts:   map[string]string{},
+		names:      []string{},
+		parameters: map[string]string{},
+	}
+
+	return strategyConfig.WithOptioners(optioners...)
+}
+
+// WithStrategyConfigAccounts is a function.
+func WithStrategyConfigAccounts(
+	accounts map[string]string,
+) strategyConfigOptioner {
+	return strategyConfigOptionerFunc(func(
+		config *strategyConfig,
+	) {
+		config.accounts = accounts
+	})
+}
+
+// WithStrategyConfigNames is a function.
+func WithStrategyConfigNames(
+	names []string,
+) strategyConfigOptioner {
+	return strategyConfigOptionerFunc(func(
+		config *strategyConfig,
+	) {
+		config.names = names
+	})
+}
+
+// WithStrategyConfigParameters is a function.
+func WithStrategyConfigParameters(
+	parameters map[string]string,
+) strategyConfigOptioner {
+	return strategyConfigOptionerFunc(func(
+		config *strategyConfig,
+	) {
+		config.parameters = parameters
+	})
+}
+
+// GetAccounts is a function.
+// They are the accounts the strategies trade on by strategy name. A strategy
+// missing from them trades on the default account.
+func (config *strategyConfig) GetAccounts() map[string]string {
+	return config.accounts
+}
+
+// GetNames is a function.
+func (config *strategyConfig) GetNames() []string {
+	return config.names
+}
+
+// GetParameters is a function.
+func (config *strategyConfig) GetParameters() map[string]string {
+	return config.parameters
+}
+
+// GetMap is a function.
+func (config *strategyConfig) GetMap() map[string]any {
+	return map[string]any{
+		"accounts":   config.GetAccounts(),
+		"names":      config.GetNames(),
+		"parameters": config.GetParameters(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (config *strategyConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(config.GetMap())
+}
+
+// WithOptioners is a function.
+func (config *strategyConfig) WithOptioners(
+	optioners ...strategyConfigOptioner,
+) *strategyConfig {
+	newConfig := config.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newConfig)
+	}
+
+	return newConfig
+}
+
+func (config *strategyConfig) clone() *strategyConfig {
+	newConfig := config
+
+	return newConfig
+}
+
+func (optionerFunc strategyConfigOptionerFunc) apply(
+	config *strategyConfig,
+) {
+	optionerFunc(config)
+}
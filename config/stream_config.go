@@ -0,0 +1,207 @@
+package config
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// StreamConfigger is an interface.
+	StreamConfigger interface {
+		// GetKlineTypes is a function.
+		GetKlineTypes() []string
+		// GetSymbols is a function.
+		GetSymbols() []string
+		// GetReconnectMaxBackoff is a function.
+		GetReconnectMaxBackoff() time.Duration
+		// GetReconnectMinBackoff is a function.
+		GetReconnectMinBackoff() time.Duration
+		// GetOrderBook is a function.
+		GetOrderBook() bool
+		// GetPrivate is a function.
+		GetPrivate() bool
+	}
+
+	// GetStreamConfigger is an interface.
+	GetStreamConfigger interface {
+		// GetStreamConfigger is a function.
+		GetStreamConfigger() StreamConfigger
+	}
+
+	streamConfig struct {
+		klineTypes          []string
+		symbols             []string
+		reconnectMaxBackoff time.Duration
+		reconnectMinBackoff time.Duration
+		orderBook           bool
+		private             bool
+	}
+
+	streamConfigOptioner interface {
+		apply(*streamConfig)
+	}
+
+	streamConfigOptionerFunc func(*streamConfig)
+)
+
+var (
+	_ StreamConfigger = (*streamConfig)(nil)
+	_ json.Marshaler  = (*streamConfig)(nil)
+	_ object.GetMap   = (*streamConfig)(nil)
+)
+
+// NewStreamConfig is a function.
+func NewStreamConfig(
+	optioners ...streamConfigOptioner,
+) *streamConfig {
+	streamConfig := &streamConfig{
+		klineTypes:          []string{},
+		symbols:             []string{},
+		reconnectMaxBackoff: 0,
+		reconnectMinBackoff: 0,
+		orderBook:           false,
+		private:             false,
+	}
+
+	return streamConfig.WithOptioners(optioners...)
+}
+
+// WithStreamConfigKlineTypes is a function.
+func WithStreamConfigKlineTypes(
+	klineTypes []string,
+) streamConfigOptioner {
+	return streamConfigOptionerFunc(func(
+		config *streamConfig,
+	) {
+		config.klineTypes = klineTypes
+	})
+}
+
+// WithStreamConfigSymbols is a function.
+func WithStreamConfigSymbols(
+	symbols []string,
+) streamConfigOptioner {
+	return streamConfigOptionerFunc(func(
+		config *streamConfig,
+	) {
+		config.symbols = symbols
+	})
+}
+
+// WithStreamConfigReconnectMaxBackoff is a function.
+func WithStreamConfigReconnectMaxBackoff(
+	reconnectMaxBackoff time.Duration,
+) streamConfigOptioner {
+	return streamConfigOptionerFunc(func(
+		config *streamConfig,
+	) {
+		config.reconnectMaxBackoff = reconnectMaxBackoff
+	})
+}
+
+// WithStreamConfigReconnectMinBackoff is a function.
+func WithStreamConfigReconnectMinBackoff(
+	reconnectMinBackoff time.Duration,
+) streamConfigOptioner {
+	return streamConfigOptionerFunc(func(
+		config *streamConfig,
+	) {
+		config.reconnectMinBackoff = reconnectMinBackoff
+	})
+}
+
+// WithStreamConfigOrderBook is a function.
+func WithStreamConfigOrderBook(
+	orderBook bool,
+) streamConfigOptioner {
+	return streamConfigOptionerFunc(func(
+		config *streamConfig,
+	) {
+		config.orderBook = orderBook
+	})
+}
+
+// WithStreamConfigPrivate is a function.
+func WithStreamConfigPrivate(
+	private bool,
+) streamConfigOptioner {
+	return streamConfigOptionerFunc(func(
+		config *streamConfig,
+	) {
+		config.private = private
+	})
+}
+
+// GetKlineTypes is a function.
+func (config *streamConfig) GetKlineTypes() []string {
+	return config.klineTypes
+}
+
+// GetSymbols is a function.
+func (config *streamConfig) GetSymbols() []string {
+	return config.symbols
+}
+
+// GetReconnectMaxBackoff is a function.
+func (config *streamConfig) GetReconnectMaxBackoff() time.Duration {
+	return config.reconnectMaxBackoff
+}
+
+// GetReconnectMinBackoff is a function.
+func (config *streamConfig) GetReconnectMinBackoff() time.Duration {
+	return config.reconnectMinBackoff
+}
+
+// GetOrderBook is a function.
+func (config *streamConfig) GetOrderBook() bool {
+	return config.orderBook
+}
+
+// GetPrivate is a function.
+func (config *streamConfig) GetPrivate() bool {
+	return config.private
+}
+
+// GetMap is a function.
+func (config *streamConfig) GetMap() map[string]any {
+	return map[string]any{
+		"kline_types":           config.GetKlineTypes(),
+		"symbols":               config.GetSymbols(),
+		"reconnect_max_backoff": config.GetReconnectMaxBackoff(),
+		"reconnect_min_backoff": config.GetReconnectMinBackoff(),
+		"order_book":            config.GetOrderBook(),
+		"private":               config.GetPrivate(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (config *streamConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(config.GetMap())
+}
+
+// WithOptioners is a function.
+func (config *streamConfig) WithOptioners(
+	optioners ...streamConfigOptioner,
+) *streamConfig {
+	newConfig := config.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newConfig)
+	}
+
+	return newConfig
+}
+
+func (config *streamConfig) clone() *streamConfig {
+	newConfig := config
+
+	return newConfig
+}
+
+func (optionerFunc streamConfigOptionerFunc) apply(
+	config *streamConfig,
+) {
+	optionerFunc(config)
+}
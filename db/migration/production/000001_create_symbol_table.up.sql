@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS symbol (
+  id UUID NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  deleted_at TIMESTAMP,
+  base_currency STRING NOT NULL,
+  base_increment STRING NOT NULL,
+  base_max_size STRING NOT NULL,
+  base_min_size STRING NOT NULL,
+  fee_currency STRING NOT NULL,
+  market STRING NOT NULL,
+  min_funds STRING NOT NULL,
+  name STRING NOT NULL,
+  price_increment STRING NOT NULL,
+  price_limit_rate STRING NOT NULL,
+  quote_currency STRING NOT NULL,
+  quote_increment STRING NOT NULL,
+  quote_max_size STRING NOT NULL,
+  quote_min_size STRING NOT NULL,
+  symbol STRING NOT NULL,
+  enable_trading BOOL NOT NULL,
+  is_margin_enabled BOOL NOT NULL,
+  CONSTRAINT pk PRIMARY KEY (id),
+  CONSTRAINT uq_symbol UNIQUE (symbol),
+  INDEX ix_created_at (created_at) USING HASH
+);
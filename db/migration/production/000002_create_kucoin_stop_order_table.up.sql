@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS kucoin_stop_order (
+  id UUID NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  deleted_at TIMESTAMP,
+  channel STRING NOT NULL,
+  client_oid STRING NOT NULL,
+  fee_currency STRING NOT NULL,
+  funds STRING NOT NULL,
+  kucoin_id STRING NOT NULL,
+  kucoin_type STRING NOT NULL,
+  maker_fee_rate STRING NOT NULL,
+  price STRING NOT NULL,
+  remark STRING NOT NULL,
+  side STRING NOT NULL,
+  size STRING NOT NULL,
+  status STRING NOT NULL,
+  stop STRING NOT NULL,
+  stop_price STRING NOT NULL,
+  stop_trigger_time STRING NOT NULL,
+  stp STRING NOT NULL,
+  symbol STRING NOT NULL,
+  tags STRING NOT NULL,
+  taker_fee_rate STRING NOT NULL,
+  time_in_force STRING NOT NULL,
+  trade_type STRING NOT NULL,
+  visible_size STRING NOT NULL,
+  cancel_after INT NOT NULL,
+  kucoin_created_at INT NOT NULL,
+  order_time INT NOT NULL,
+  hidden BOOL NOT NULL,
+  ice_berg BOOL NOT NULL,
+  is_active BOOL NOT NULL,
+  paper BOOL NOT NULL DEFAULT false,
+  post_only BOOL NOT NULL,
+  CONSTRAINT pk PRIMARY KEY (id),
+  CONSTRAINT uq_kucoin_id UNIQUE (kucoin_id),
+  INDEX ix_client_oid (client_oid),
+  INDEX ix_created_at (created_at) USING HASH
+);
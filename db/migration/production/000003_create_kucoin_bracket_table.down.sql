@@ -0,0 +1 @@
+DROP TABLE IF EXISTS kucoin_bracket RESTRICT;
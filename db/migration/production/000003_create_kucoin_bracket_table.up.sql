@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS kucoin_bracket (
+  id UUID NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  deleted_at TIMESTAMP,
+  entry_client_oid STRING NOT NULL,
+  exit_client_oid STRING NOT NULL,
+  reason STRING NOT NULL,
+  side STRING NOT NULL,
+  size STRING NOT NULL,
+  state STRING NOT NULL,
+  stop_client_oid STRING NOT NULL,
+  stop_price STRING NOT NULL,
+  symbol STRING NOT NULL,
+  take_profit_client_oid STRING NOT NULL,
+  take_profit_price STRING NOT NULL,
+  trailing_distance STRING NOT NULL,
+  trailing_price STRING NOT NULL,
+  is_active BOOL NOT NULL,
+  paper BOOL NOT NULL DEFAULT false,
+  CONSTRAINT pk PRIMARY KEY (id),
+  CONSTRAINT uq_entry_client_oid UNIQUE (entry_client_oid),
+  INDEX ix_is_active (is_active),
+  INDEX ix_created_at (created_at) USING HASH
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS kucoin_order@ix_remark;
+
+DROP TABLE IF EXISTS kucoin_algo_order RESTRICT;
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS kucoin_algo_order (
+  id UUID NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  deleted_at TIMESTAMP,
+  algo_type STRING NOT NULL,
+  average_price STRING NOT NULL,
+  child_client_oid STRING NOT NULL,
+  child_deal_funds STRING NOT NULL,
+  child_deal_size STRING NOT NULL,
+  client_oid STRING NOT NULL,
+  deal_funds STRING NOT NULL,
+  deal_size STRING NOT NULL,
+  limit_price STRING NOT NULL,
+  reason STRING NOT NULL,
+  schedule STRING NOT NULL,
+  side STRING NOT NULL,
+  size STRING NOT NULL,
+  state STRING NOT NULL,
+  symbol STRING NOT NULL,
+  visible_size STRING NOT NULL,
+  child_at INT NOT NULL,
+  child_count INT NOT NULL,
+  end_at INT NOT NULL,
+  slices INT NOT NULL,
+  start_at INT NOT NULL,
+  is_active BOOL NOT NULL,
+  paper BOOL NOT NULL DEFAULT false,
+  CONSTRAINT pk PRIMARY KEY (id),
+  CONSTRAINT uq_client_oid UNIQUE (client_oid),
+  INDEX ix_is_active (is_active),
+  INDEX ix_created_at (created_at) USING HASH
+);
+
+CREATE INDEX IF NOT EXISTS ix_remark ON kucoin_order (remark);
@@ -0,0 +1 @@
+ALTER TABLE kucoin_order ADD COLUMN IF NOT EXISTS status STRING NOT NULL DEFAULT '';
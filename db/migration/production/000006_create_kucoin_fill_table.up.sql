@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS kucoin_fill (
+  id UUID NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  deleted_at TIMESTAMP,
+  counter_order_id STRING NOT NULL,
+  fee STRING NOT NULL,
+  fee_currency STRING NOT NULL,
+  fee_rate STRING NOT NULL,
+  funds STRING NOT NULL,
+  kucoin_order_id STRING NOT NULL,
+  kucoin_type STRING NOT NULL,
+  liquidity STRING NOT NULL,
+  price STRING NOT NULL,
+  side STRING NOT NULL,
+  size STRING NOT NULL,
+  stop STRING NOT NULL,
+  symbol STRING NOT NULL,
+  trade_id STRING NOT NULL,
+  trade_type STRING NOT NULL,
+  kucoin_created_at INT NOT NULL,
+  force_taker BOOL NOT NULL,
+  paper BOOL NOT NULL DEFAULT false,
+  CONSTRAINT pk PRIMARY KEY (id),
+  CONSTRAINT uq_trade_id UNIQUE (trade_id),
+  INDEX ix_kucoin_order_id (kucoin_order_id),
+  INDEX ix_symbol (symbol),
+  INDEX ix_created_at (created_at) USING HASH
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS kucoin_equity RESTRICT;
+DROP TABLE IF EXISTS kucoin_balance RESTRICT;
@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS kucoin_balance (
+  id UUID NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  deleted_at TIMESTAMP,
+  available STRING NOT NULL,
+  balance STRING NOT NULL,
+  currency STRING NOT NULL,
+  holds STRING NOT NULL,
+  kucoin_id STRING NOT NULL,
+  kucoin_type STRING NOT NULL,
+  paper BOOL NOT NULL DEFAULT false,
+  CONSTRAINT pk PRIMARY KEY (id),
+  CONSTRAINT uq_kucoin_id UNIQUE (kucoin_id),
+  INDEX ix_currency (currency),
+  INDEX ix_created_at (created_at) USING HASH
+);
+
+CREATE TABLE IF NOT EXISTS kucoin_equity (
+  id UUID NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  deleted_at TIMESTAMP,
+  currency STRING NOT NULL,
+  equity STRING NOT NULL,
+  fee STRING NOT NULL,
+  realized_pnl STRING NOT NULL,
+  unrealized_pnl STRING NOT NULL,
+  snapshot_at INT NOT NULL,
+  paper BOOL NOT NULL DEFAULT false,
+  CONSTRAINT pk PRIMARY KEY (id),
+  INDEX ix_snapshot_at (snapshot_at),
+  INDEX ix_created_at (created_at) USING HASH
+);
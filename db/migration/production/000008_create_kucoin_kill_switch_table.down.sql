@@ -0,0 +1 @@
+DROP TABLE IF EXISTS kucoin_kill_switch RESTRICT;
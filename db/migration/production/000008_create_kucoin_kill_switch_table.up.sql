@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS kucoin_kill_switch (
+  id UUID NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  deleted_at TIMESTAMP,
+  reason STRING NOT NULL,
+  enabled BOOL NOT NULL DEFAULT false,
+  paper BOOL NOT NULL DEFAULT false,
+  CONSTRAINT pk PRIMARY KEY (id),
+  CONSTRAINT uq_paper UNIQUE (paper),
+  INDEX ix_created_at (created_at) USING HASH
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS kucoin_borrow RESTRICT;
+DROP TABLE IF EXISTS kucoin_margin_risk_limit RESTRICT;
+DROP TABLE IF EXISTS kucoin_margin_balance RESTRICT;
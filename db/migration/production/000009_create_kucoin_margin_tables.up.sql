@@ -0,0 +1,55 @@
+CREATE TABLE IF NOT EXISTS kucoin_margin_balance (
+  id UUID NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  deleted_at TIMESTAMP,
+  available STRING NOT NULL,
+  currency STRING NOT NULL,
+  holds STRING NOT NULL,
+  liability STRING NOT NULL,
+  max_borrow_size STRING NOT NULL,
+  total STRING NOT NULL,
+  CONSTRAINT pk PRIMARY KEY (id),
+  CONSTRAINT uq_currency UNIQUE (currency),
+  INDEX ix_created_at (created_at) USING HASH
+);
+
+CREATE TABLE IF NOT EXISTS kucoin_margin_risk_limit (
+  id UUID NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  deleted_at TIMESTAMP,
+  borrow_max_amount STRING NOT NULL,
+  buy_max_amount STRING NOT NULL,
+  currency STRING NOT NULL,
+  margin_model STRING NOT NULL,
+  precision STRING NOT NULL,
+  CONSTRAINT pk PRIMARY KEY (id),
+  CONSTRAINT uq_currency_margin_model UNIQUE (currency, margin_model),
+  INDEX ix_created_at (created_at) USING HASH
+);
+
+CREATE TABLE IF NOT EXISTS kucoin_borrow (
+  id UUID NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  deleted_at TIMESTAMP,
+  accrued_interest STRING NOT NULL,
+  currency STRING NOT NULL,
+  daily_int_rate STRING NOT NULL,
+  interest STRING NOT NULL,
+  liability STRING NOT NULL,
+  principal STRING NOT NULL,
+  repaid_size STRING NOT NULL,
+  status STRING NOT NULL,
+  trade_id STRING NOT NULL,
+  kucoin_created_at INT NOT NULL,
+  maturity_time INT NOT NULL,
+  repaid_at INT NOT NULL,
+  term INT NOT NULL,
+  CONSTRAINT pk PRIMARY KEY (id),
+  CONSTRAINT uq_trade_id UNIQUE (trade_id),
+  INDEX ix_currency (currency),
+  INDEX ix_status (status),
+  INDEX ix_created_at (created_at) USING HASH
+);
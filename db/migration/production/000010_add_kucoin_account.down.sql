@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS kucoin_balance@ix_account;
+DROP INDEX IF EXISTS kucoin_fill@ix_account;
+DROP INDEX IF EXISTS kucoin_order@ix_account;
+
+ALTER TABLE kucoin_balance DROP COLUMN IF EXISTS account;
+ALTER TABLE kucoin_fill DROP COLUMN IF EXISTS account;
+ALTER TABLE kucoin_order DROP COLUMN IF EXISTS account;
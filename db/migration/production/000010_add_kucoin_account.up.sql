@@ -0,0 +1,7 @@
+ALTER TABLE kucoin_order ADD COLUMN IF NOT EXISTS account STRING NOT NULL DEFAULT 'master';
+ALTER TABLE kucoin_fill ADD COLUMN IF NOT EXISTS account STRING NOT NULL DEFAULT 'master';
+ALTER TABLE kucoin_balance ADD COLUMN IF NOT EXISTS account STRING NOT NULL DEFAULT 'master';
+
+CREATE INDEX IF NOT EXISTS ix_account ON kucoin_order (account);
+CREATE INDEX IF NOT EXISTS ix_account ON kucoin_fill (account);
+CREATE INDEX IF NOT EXISTS ix_account ON kucoin_balance (account);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS kline (
+  id UUID NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  deleted_at TIMESTAMP,
+  close STRING NOT NULL,
+  high STRING NOT NULL,
+  kline_type STRING NOT NULL,
+  low STRING NOT NULL,
+  open STRING NOT NULL,
+  symbol STRING NOT NULL,
+  turnover STRING NOT NULL,
+  volume STRING NOT NULL,
+  start_at INT NOT NULL,
+  CONSTRAINT pk PRIMARY KEY (id),
+  CONSTRAINT uq_symbol_kline_type_start_at UNIQUE (symbol, kline_type, start_at),
+  INDEX ix_created_at (created_at) USING HASH
+);
@@ -0,0 +1 @@
+ALTER TABLE kucoin_order DROP COLUMN IF EXISTS paper;
@@ -0,0 +1 @@
+ALTER TABLE kucoin_order ADD COLUMN IF NOT EXISTS paper BOOL NOT NULL DEFAULT false;
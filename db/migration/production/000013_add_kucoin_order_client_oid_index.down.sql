@@ -0,0 +1 @@
+DROP INDEX IF EXISTS kucoin_order@ix_client_oid;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS ix_client_oid ON kucoin_order (client_oid);
@@ -0,0 +1,19 @@
+CREATE UNIQUE INDEX IF NOT EXISTS uq_currency ON kucoin_margin_balance (currency);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_trade_id ON kucoin_borrow (trade_id);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_client_oid ON kucoin_algo_order (client_oid);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_entry_client_oid ON kucoin_bracket (entry_client_oid);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_kucoin_id ON kucoin_stop_order (kucoin_id);
+
+DROP INDEX IF EXISTS kucoin_margin_balance@uq_account_currency;
+DROP INDEX IF EXISTS kucoin_borrow@uq_account_trade_id;
+DROP INDEX IF EXISTS kucoin_equity@ix_account_snapshot_at;
+DROP INDEX IF EXISTS kucoin_algo_order@uq_account_client_oid;
+DROP INDEX IF EXISTS kucoin_bracket@uq_account_entry_client_oid;
+DROP INDEX IF EXISTS kucoin_stop_order@uq_account_kucoin_id;
+
+ALTER TABLE kucoin_margin_balance DROP COLUMN IF EXISTS account;
+ALTER TABLE kucoin_borrow DROP COLUMN IF EXISTS account;
+ALTER TABLE kucoin_equity DROP COLUMN IF EXISTS account;
+ALTER TABLE kucoin_algo_order DROP COLUMN IF EXISTS account;
+ALTER TABLE kucoin_bracket DROP COLUMN IF EXISTS account;
+ALTER TABLE kucoin_stop_order DROP COLUMN IF EXISTS account;
@@ -0,0 +1,19 @@
+ALTER TABLE kucoin_stop_order ADD COLUMN IF NOT EXISTS account STRING NOT NULL DEFAULT 'master';
+ALTER TABLE kucoin_bracket ADD COLUMN IF NOT EXISTS account STRING NOT NULL DEFAULT 'master';
+ALTER TABLE kucoin_algo_order ADD COLUMN IF NOT EXISTS account STRING NOT NULL DEFAULT 'master';
+ALTER TABLE kucoin_equity ADD COLUMN IF NOT EXISTS account STRING NOT NULL DEFAULT 'master';
+ALTER TABLE kucoin_borrow ADD COLUMN IF NOT EXISTS account STRING NOT NULL DEFAULT 'master';
+ALTER TABLE kucoin_margin_balance ADD COLUMN IF NOT EXISTS account STRING NOT NULL DEFAULT 'master';
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_account_kucoin_id ON kucoin_stop_order (account, kucoin_id);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_account_entry_client_oid ON kucoin_bracket (account, entry_client_oid);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_account_client_oid ON kucoin_algo_order (account, client_oid);
+CREATE INDEX IF NOT EXISTS ix_account_snapshot_at ON kucoin_equity (account, snapshot_at);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_account_trade_id ON kucoin_borrow (account, trade_id);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_account_currency ON kucoin_margin_balance (account, currency);
+
+DROP INDEX IF EXISTS kucoin_stop_order@uq_kucoin_id CASCADE;
+DROP INDEX IF EXISTS kucoin_bracket@uq_entry_client_oid CASCADE;
+DROP INDEX IF EXISTS kucoin_algo_order@uq_client_oid CASCADE;
+DROP INDEX IF EXISTS kucoin_borrow@uq_trade_id CASCADE;
+DROP INDEX IF EXISTS kucoin_margin_balance@uq_currency CASCADE;
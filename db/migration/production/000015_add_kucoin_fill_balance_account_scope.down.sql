@@ -0,0 +1,5 @@
+CREATE UNIQUE INDEX IF NOT EXISTS uq_kucoin_id ON kucoin_balance (kucoin_id);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_trade_id ON kucoin_fill (trade_id);
+
+DROP INDEX IF EXISTS kucoin_balance@uq_account_kucoin_id;
+DROP INDEX IF EXISTS kucoin_fill@uq_account_trade_id;
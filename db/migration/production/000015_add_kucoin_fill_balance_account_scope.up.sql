@@ -0,0 +1,5 @@
+CREATE UNIQUE INDEX IF NOT EXISTS uq_account_trade_id ON kucoin_fill (account, trade_id);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_account_kucoin_id ON kucoin_balance (account, kucoin_id);
+
+DROP INDEX IF EXISTS kucoin_fill@uq_trade_id CASCADE;
+DROP INDEX IF EXISTS kucoin_balance@uq_kucoin_id CASCADE;
@@ -0,0 +1,115 @@
+package exchange
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// AccountExchanger is an interface.
+	AccountExchanger interface {
+		Exchanger
+		// GetAccount is a function.
+		GetAccount() string
+		// GetAccountExchanger is a function.
+		GetAccountExchanger(
+			string,
+		) Exchanger
+		// GetAccounts is a function.
+		GetAccounts() []string
+	}
+
+	accountExchange struct {
+		Exchanger
+		exchangers map[string]Exchanger
+		account    string
+	}
+
+	unknownAccountRequester struct {
+		account string
+	}
+)
+
+var (
+	_ AccountExchanger = (*accountExchange)(nil)
+	_ kucoin.Requester = (*unknownAccountRequester)(nil)
+)
+
+// NewAccountExchange is a function.
+// The exchanger serves the default account, which answers every call made
+// without an account, and each of the exchangers serves the account it is
+// keyed by. Every account has its own client, so the accounts share neither
+// the credentials nor the request budget.
+func NewAccountExchange(
+	account string,
+	exchanger Exchanger,
+	exchangers map[string]Exchanger,
+) *accountExchange {
+	accountExchange := &accountExchange{
+		Exchanger:  exchanger,
+		exchangers: make(map[string]Exchanger, len(exchangers)+1),
+		account:    account,
+	}
+
+	for name, accountExchanger := range exchangers {
+		accountExchange.exchangers[name] = accountExchanger
+	}
+
+	accountExchange.exchangers[account] = exchanger
+
+	return accountExchange
+}
+
+// GetAccount is a function.
+// It is the name of the default account.
+func (exchange *accountExchange) GetAccount() string {
+	return exchange.account
+}
+
+// GetAccountExchanger is a function.
+// An empty account is the default one. The calls to an account that is not
+// configured fail, rather than reach the exchange with the credentials of
+// another account.
+func (exchange *accountExchange) GetAccountExchanger(
+	account string,
+) Exchanger {
+	if account == object.URIEmpty {
+		return exchange.Exchanger
+	}
+
+	if exchanger, ok := exchange.exchangers[account]; ok {
+		return exchanger
+	}
+
+	return kucoin.NewApiService(kucoin.ApiRequesterOption(&unknownAccountRequester{
+		account: account,
+	}))
+}
+
+// GetAccounts is a function.
+// The default account comes first and the others follow by name.
+func (exchange *accountExchange) GetAccounts() []string {
+	accounts := make([]string, 0, len(exchange.exchangers))
+
+	for account := range exchange.exchangers {
+		if account != exchange.GetAccount() {
+			accounts = append(accounts, account)
+		}
+	}
+
+	sort.Strings(accounts)
+
+	return append([]string{exchange.GetAccount()}, accounts...)
+}
+
+// Request is a function.
+func (requester *unknownAccountRequester) Request(
+	*kucoin.Request,
+	time.Duration,
+) (*kucoin.Response, error) {
+	return nil, fmt.Errorf("%w: %s", object.ErrExchangeAccountUnknown, requester.account)
+}
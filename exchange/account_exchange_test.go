@@ -0,0 +1,91 @@
+package exchange
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+func TestAccountExchangeGetAccountExchanger(t *testing.T) {
+	t.Parallel()
+
+	exchangers := map[string]Exchanger{
+		"beta":  newPaperExchangeTestExchanger("beta"),
+		"alpha": newPaperExchangeTestExchanger("alpha"),
+	}
+
+	accountExchange := NewAccountExchange("master", newPaperExchangeTestExchanger("master"), exchangers)
+
+	// The exchange keeps its own copy of the accounts it was built with.
+	exchangers["gamma"] = newPaperExchangeTestExchanger("gamma")
+
+	if got := accountExchange.GetAccount(); got != "master" {
+		t.Errorf("GetAccount() = %q, want %q", got, "master")
+	}
+
+	wantAccounts := []string{"master", "alpha", "beta"}
+	if got := accountExchange.GetAccounts(); !reflect.DeepEqual(got, wantAccounts) {
+		t.Errorf("GetAccounts() = %v, want %v", got, wantAccounts)
+	}
+
+	tests := []struct {
+		name    string
+		account string
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "empty",
+			account: object.URIEmpty,
+			want:    "master",
+			wantErr: nil,
+		},
+		{
+			name:    "default",
+			account: "master",
+			want:    "master",
+			wantErr: nil,
+		},
+		{
+			name:    "sub",
+			account: "alpha",
+			want:    "alpha",
+			wantErr: nil,
+		},
+		{
+			name:    "other sub",
+			account: "beta",
+			want:    "beta",
+			wantErr: nil,
+		},
+		{
+			name:    "unknown",
+			account: "gamma",
+			want:    object.URIEmpty,
+			wantErr: object.ErrExchangeAccountUnknown,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			kucoinAPIResponse, err := accountExchange.GetAccountExchanger(test.account).ServiceStatus()
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("ServiceStatus() error = %v, want %v", err, test.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if kucoinAPIResponse.Code != test.want {
+				t.Errorf("ServiceStatus() = %q, want %q", kucoinAPIResponse.Code, test.want)
+			}
+		})
+	}
+}
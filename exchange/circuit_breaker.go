@@ -0,0 +1,115 @@
+package exchange
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// CircuitBreaker is an interface.
+	CircuitBreaker interface {
+		// Allow is a function.
+		Allow() error
+		// GetState is a function.
+		GetState() object.CircuitStateType
+		// Record is a function.
+		Record(
+			error,
+		) object.CircuitStateType
+	}
+
+	circuitBreaker struct {
+		objectTimer object.Timer
+		openedAt    time.Time
+		state       object.CircuitStateType
+		cooldown    time.Duration
+		failures    uint32
+		threshold   uint32
+		mutex       sync.Mutex
+	}
+)
+
+var _ CircuitBreaker = (*circuitBreaker)(nil)
+
+// NewCircuitBreaker is a function.
+// The circuit opens once threshold calls in a row find the exchange
+// unreachable or busy, and refuses every call until the cooldown has passed. A
+// zero threshold never opens it.
+func NewCircuitBreaker(
+	objectTimer object.Timer,
+	threshold uint32,
+	cooldown time.Duration,
+) *circuitBreaker {
+	return &circuitBreaker{
+		objectTimer: objectTimer,
+		openedAt:    time.Time{},
+		state:       object.CircuitStateTypeClosed,
+		cooldown:    cooldown,
+		failures:    0,
+		threshold:   threshold,
+		mutex:       sync.Mutex{},
+	}
+}
+
+// Allow is a function.
+// An open circuit half-opens once the cooldown has passed and lets a single
+// call through to probe the exchange. Every other call is refused until the
+// probe is recorded, or until another cooldown has passed without it.
+func (breaker *circuitBreaker) Allow() error {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	if breaker.state == object.CircuitStateTypeClosed {
+		return nil
+	}
+
+	now := breaker.objectTimer.NowUTC()
+	if now.Sub(breaker.openedAt) < breaker.cooldown {
+		return object.ErrExchangeCircuitOpen
+	}
+
+	breaker.state = object.CircuitStateTypeHalfOpen
+	breaker.openedAt = now
+
+	return nil
+}
+
+// GetState is a function.
+func (breaker *circuitBreaker) GetState() object.CircuitStateType {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	return breaker.state
+}
+
+// Record is a function.
+// Only a call that did not reach the exchange or found it busy counts as a
+// failure. A refusal for the request itself, like an invalid parameter, proves
+// the exchange is up, so it closes the circuit like a success does. It returns
+// the state the circuit is left in.
+func (breaker *circuitBreaker) Record(
+	err error,
+) object.CircuitStateType {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	if !errors.Is(err, object.ErrKucoinTransport) && !errors.Is(err, object.ErrKucoinSystemBusy) {
+		breaker.state = object.CircuitStateTypeClosed
+		breaker.failures = 0
+
+		return breaker.state
+	}
+
+	breaker.failures++
+
+	if breaker.state == object.CircuitStateTypeHalfOpen ||
+		(breaker.threshold > 0 && breaker.failures >= breaker.threshold) {
+		breaker.state = object.CircuitStateTypeOpen
+		breaker.openedAt = breaker.objectTimer.NowUTC()
+	}
+
+	return breaker.state
+}
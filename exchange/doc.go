@@ -0,0 +1,4 @@
+/*
+Package exchange is a package.
+*/
+package exchange
@@ -0,0 +1,170 @@
+package exchange
+
+import (
+	"github.com/Kucoin/kucoin-go-sdk"
+)
+
+type (
+	// Exchanger is an interface.
+	Exchanger interface {
+		// Accounts is a function.
+		Accounts(
+			string,
+			string,
+		) (*kucoin.ApiResponse, error)
+		// AggregatedFullOrderBookV3 is a function.
+		AggregatedFullOrderBookV3(
+			string,
+		) (*kucoin.ApiResponse, error)
+		// BorrowOutstandingRecords is a function.
+		BorrowOutstandingRecords(
+			string,
+			*kucoin.PaginationParam,
+		) (*kucoin.ApiResponse, error)
+		// BorrowRepaidRecords is a function.
+		BorrowRepaidRecords(
+			string,
+			*kucoin.PaginationParam,
+		) (*kucoin.ApiResponse, error)
+		// CancelOrder is a function.
+		CancelOrder(
+			string,
+		) (*kucoin.ApiResponse, error)
+		// CancelOrderByClient is a function.
+		CancelOrderByClient(
+			string,
+		) (*kucoin.ApiResponse, error)
+		// CancelOrders is a function.
+		CancelOrders(
+			map[string]string,
+		) (*kucoin.ApiResponse, error)
+		// CancelStopOrder is a function.
+		CancelStopOrder(
+			string,
+		) (*kucoin.ApiResponse, error)
+		// CancelStopOrderByClient is a function.
+		CancelStopOrderByClient(
+			string,
+			map[string]string,
+		) (*kucoin.ApiResponse, error)
+		// CreateBorrowOrder is a function.
+		CreateBorrowOrder(
+			map[string]string,
+		) (*kucoin.ApiResponse, error)
+		// CreateMarginOrder is a function.
+		CreateMarginOrder(
+			*kucoin.CreateOrderModel,
+		) (*kucoin.ApiResponse, error)
+		// CreateOrder is a function.
+		CreateOrder(
+			*kucoin.CreateOrderModel,
+		) (*kucoin.ApiResponse, error)
+		// CreateMultiOrder is a function.
+		CreateMultiOrder(
+			string,
+			[]*kucoin.CreateOrderModel,
+		) (*kucoin.ApiResponse, error)
+		// CreateStopOrder is a function.
+		CreateStopOrder(
+			*kucoin.CreateOrderModel,
+		) (*kucoin.ApiResponse, error)
+		// Fills is a function.
+		Fills(
+			map[string]string,
+			*kucoin.PaginationParam,
+		) (*kucoin.ApiResponse, error)
+		// KLines is a function.
+		KLines(
+			string,
+			string,
+			int64,
+			int64,
+		) (*kucoin.ApiResponse, error)
+		// MarginAccount is a function.
+		MarginAccount() (*kucoin.ApiResponse, error)
+		// MarginRiskLimit is a function.
+		MarginRiskLimit(
+			string,
+		) (*kucoin.ApiResponse, error)
+		// NewWebSocketClient is a function.
+		NewWebSocketClient(
+			*kucoin.WebSocketTokenModel,
+		) *kucoin.WebSocketClient
+		// Order is a function.
+		Order(
+			string,
+		) (*kucoin.ApiResponse, error)
+		// OrderByClient is a function.
+		OrderByClient(
+			string,
+		) (*kucoin.ApiResponse, error)
+		// Orders is a function.
+		Orders(
+			map[string]string,
+			*kucoin.PaginationParam,
+		) (*kucoin.ApiResponse, error)
+		// RecentFills is a function.
+		RecentFills() (*kucoin.ApiResponse, error)
+		// RecentOrders is a function.
+		RecentOrders() (*kucoin.ApiResponse, error)
+		// RepayAll is a function.
+		RepayAll(
+			map[string]string,
+		) (*kucoin.ApiResponse, error)
+		// RepaySingle is a function.
+		RepaySingle(
+			map[string]string,
+		) (*kucoin.ApiResponse, error)
+		// ServiceStatus is a function.
+		ServiceStatus() (*kucoin.ApiResponse, error)
+		// StopOrder is a function.
+		StopOrder(
+			string,
+		) (*kucoin.ApiResponse, error)
+		// StopOrderByClient is a function.
+		StopOrderByClient(
+			string,
+			map[string]string,
+		) (*kucoin.ApiResponse, error)
+		// StopOrders is a function.
+		StopOrders(
+			map[string]string,
+			*kucoin.PaginationParam,
+		) (*kucoin.ApiResponse, error)
+		// SubAccounts is a function.
+		SubAccounts() (*kucoin.ApiResponse, error)
+		// SubTransferV2 is a function.
+		SubTransferV2(
+			map[string]string,
+		) (*kucoin.ApiResponse, error)
+		// Symbols is a function.
+		Symbols(
+			string,
+		) (*kucoin.ApiResponse, error)
+		// TickerLevel1 is a function.
+		TickerLevel1(
+			string,
+		) (*kucoin.ApiResponse, error)
+		// Tickers is a function.
+		Tickers() (*kucoin.ApiResponse, error)
+		// WebSocketPrivateToken is a function.
+		WebSocketPrivateToken() (*kucoin.ApiResponse, error)
+		// WebSocketPublicToken is a function.
+		WebSocketPublicToken() (*kucoin.ApiResponse, error)
+	}
+
+	// GetExchanger is an interface.
+	GetExchanger interface {
+		// GetExchanger is a function.
+		GetExchanger() Exchanger
+	}
+)
+
+var _ Exchanger = (*kucoin.ApiService)(nil)
+
+// NewExchanger is a function.
+func NewExchanger(
+	optioners ...kucoin.ApiServiceOption,
+) Exchanger {
+	return kucoin.NewApiService(optioners...)
+}
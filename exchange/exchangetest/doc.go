@@ -0,0 +1,6 @@
+/*
+Package exchangetest is a package.
+It serves a fake KuCoin REST API for the tests of the services, so they run
+without a network.
+*/
+package exchangetest
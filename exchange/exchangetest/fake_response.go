@@ -0,0 +1,156 @@
+package exchangetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// FakeResponser is an interface.
+	FakeResponser interface {
+		object.GetMap
+		// GetStatusCode is a function.
+		GetStatusCode() int
+		// GetCode is a function.
+		GetCode() string
+		// GetMessage is a function.
+		GetMessage() string
+		// GetData is a function.
+		GetData() any
+	}
+
+	fakeResponse struct {
+		data       any
+		code       string
+		message    string
+		statusCode int
+	}
+)
+
+var (
+	_ FakeResponser  = (*fakeResponse)(nil)
+	_ json.Marshaler = (*fakeResponse)(nil)
+)
+
+// NewFakeResponse is a function.
+func NewFakeResponse(
+	statusCode int,
+	code string,
+	message string,
+	data any,
+) FakeResponser {
+	return &fakeResponse{
+		data:       data,
+		code:       code,
+		message:    message,
+		statusCode: statusCode,
+	}
+}
+
+// NewFakeSuccessResponse is a function.
+func NewFakeSuccessResponse(
+	data any,
+) FakeResponser {
+	return NewFakeResponse(http.StatusOK, kucoin.ApiSuccess, object.URIEmpty, data)
+}
+
+// NewFakeErrorResponse is a function.
+func NewFakeErrorResponse(
+	statusCode int,
+	code string,
+	message string,
+) FakeResponser {
+	return NewFakeResponse(statusCode, code, message, nil)
+}
+
+// GetStatusCode is a function.
+func (response *fakeResponse) GetStatusCode() int {
+	return response.statusCode
+}
+
+// GetCode is a function.
+func (response *fakeResponse) GetCode() string {
+	return response.code
+}
+
+// GetMessage is a function.
+func (response *fakeResponse) GetMessage() string {
+	return response.message
+}
+
+// GetData is a function.
+func (response *fakeResponse) GetData() any {
+	return response.data
+}
+
+// GetMap is a function.
+func (response *fakeResponse) GetMap() map[string]any {
+	return map[string]any{
+		"code": response.GetCode(),
+		"msg":  response.GetMessage(),
+		"data": response.GetData(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (response *fakeResponse) MarshalJSON() ([]byte, error) {
+	return json.Marshal(response.GetMap())
+}
+
+// NewFakePageResponse is a function.
+// Items are split into pages by the currentPage and pageSize query parameters,
+// the way KuCoin pages its lists.
+func NewFakePageResponse(
+	query url.Values,
+	items []any,
+) FakeResponser {
+	currentPage, err := strconv.ParseInt(query.Get("currentPage"), 10, 64)
+	if err != nil || currentPage < 1 {
+		currentPage = 1
+	}
+
+	pageSize, err := strconv.ParseInt(query.Get("pageSize"), 10, 64)
+	if err != nil || pageSize < 1 {
+		pageSize = object.NUMFakeServerDefaultPageSize
+	}
+
+	totalNum := int64(len(items))
+	totalPage := (totalNum + pageSize - 1) / pageSize
+	start := (currentPage - 1) * pageSize
+	end := start + pageSize
+
+	if start > totalNum {
+		start = totalNum
+	}
+
+	if end > totalNum {
+		end = totalNum
+	}
+
+	return NewFakeSuccessResponse(map[string]any{
+		"currentPage": currentPage,
+		"pageSize":    pageSize,
+		"totalNum":    totalNum,
+		"totalPage":   totalPage,
+		"items":       items[start:end],
+	})
+}
+
+// WriteFakeResponse is a function.
+// It writes the response the way KuCoin does, as a JSON envelope under its
+// status code.
+func WriteFakeResponse(
+	responseWriter http.ResponseWriter,
+	fakeResponser FakeResponser,
+) {
+	responseWriter.Header().Set(object.URIHTTPHeaderContentType, "application/json")
+	responseWriter.WriteHeader(fakeResponser.GetStatusCode())
+
+	_ = json.NewEncoder(responseWriter).Encode(fakeResponser.GetMap())
+}
@@ -0,0 +1,280 @@
+package exchangetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// FakeRequester is an interface.
+	FakeRequester interface {
+		object.GetMap
+		// GetMethod is a function.
+		GetMethod() string
+		// GetPath is a function.
+		GetPath() string
+		// GetQuery is a function.
+		GetQuery() url.Values
+		// GetBody is a function.
+		GetBody() []byte
+	}
+
+	// FakeServerer is an interface.
+	FakeServerer interface {
+		exchange.GetExchanger
+		// GetURL is a function.
+		GetURL() string
+		// Close is a function.
+		Close()
+		// Enqueue is a function.
+		Enqueue(
+			string,
+			string,
+			...FakeResponser,
+		)
+		// Handle is a function.
+		Handle(
+			string,
+			string,
+			FakeResponser,
+		)
+		// HandlePagination is a function.
+		HandlePagination(
+			string,
+			string,
+			[]any,
+		)
+		// GetRequesters is a function.
+		GetRequesters(
+			string,
+			string,
+		) []FakeRequester
+	}
+
+	fakeRequest struct {
+		method string
+		path   string
+		query  url.Values
+		body   []byte
+	}
+
+	fakeServer struct {
+		exchanger      exchange.Exchanger
+		httptestServer *httptest.Server
+		handlers       map[string]FakeResponser
+		paginations    map[string][]any
+		queues         map[string][]FakeResponser
+		requesters     map[string][]FakeRequester
+		mutex          sync.Mutex
+	}
+)
+
+var (
+	_ FakeRequester  = (*fakeRequest)(nil)
+	_ FakeServerer   = (*fakeServer)(nil)
+	_ http.Handler   = (*fakeServer)(nil)
+	_ json.Marshaler = (*fakeRequest)(nil)
+)
+
+// GetMethod is a function.
+func (request *fakeRequest) GetMethod() string {
+	return request.method
+}
+
+// GetPath is a function.
+func (request *fakeRequest) GetPath() string {
+	return request.path
+}
+
+// GetQuery is a function.
+func (request *fakeRequest) GetQuery() url.Values {
+	return request.query
+}
+
+// GetBody is a function.
+func (request *fakeRequest) GetBody() []byte {
+	return request.body
+}
+
+// GetMap is a function.
+func (request *fakeRequest) GetMap() map[string]any {
+	return map[string]any{
+		"method": request.GetMethod(),
+		"path":   request.GetPath(),
+		"query":  request.GetQuery(),
+		"body":   string(request.GetBody()),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (request *fakeRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(request.GetMap())
+}
+
+// NewFakeServer is a function.
+// The returned server listens on a local port until Close is called, and its
+// exchanger talks to it through kucoin.ApiBaseURIOption.
+func NewFakeServer(
+	optioners ...kucoin.ApiServiceOption,
+) FakeServerer {
+	fakeServer := &fakeServer{
+		exchanger:      nil,
+		httptestServer: nil,
+		handlers:       map[string]FakeResponser{},
+		paginations:    map[string][]any{},
+		queues:         map[string][]FakeResponser{},
+		requesters:     map[string][]FakeRequester{},
+		mutex:          sync.Mutex{},
+	}
+
+	fakeServer.httptestServer = httptest.NewServer(fakeServer)
+	fakeServer.exchanger = exchange.NewExchanger(
+		append(
+			[]kucoin.ApiServiceOption{
+				kucoin.ApiBaseURIOption(fakeServer.httptestServer.URL),
+			},
+			optioners...,
+		)...,
+	)
+
+	return fakeServer
+}
+
+// GetExchanger is a function.
+func (server *fakeServer) GetExchanger() exchange.Exchanger {
+	return server.exchanger
+}
+
+// GetURL is a function.
+func (server *fakeServer) GetURL() string {
+	return server.httptestServer.URL
+}
+
+// Close is a function.
+func (server *fakeServer) Close() {
+	server.httptestServer.Close()
+}
+
+// Enqueue is a function.
+// Queued responses are served once each, in order, before any handler.
+func (server *fakeServer) Enqueue(
+	method string,
+	path string,
+	fakeResponsers ...FakeResponser,
+) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	key := fakeServerKey(method, path)
+	server.queues[key] = append(server.queues[key], fakeResponsers...)
+}
+
+// Handle is a function.
+// The handler response is served whenever the queue of the route is empty.
+func (server *fakeServer) Handle(
+	method string,
+	path string,
+	fakeResponser FakeResponser,
+) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.handlers[fakeServerKey(method, path)] = fakeResponser
+}
+
+// HandlePagination is a function.
+// Items are split into pages by the currentPage and pageSize query parameters.
+func (server *fakeServer) HandlePagination(
+	method string,
+	path string,
+	items []any,
+) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.paginations[fakeServerKey(method, path)] = items
+}
+
+// GetRequesters is a function.
+func (server *fakeServer) GetRequesters(
+	method string,
+	path string,
+) []FakeRequester {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	key := fakeServerKey(method, path)
+	fakeRequesters := make([]FakeRequester, len(server.requesters[key]))
+	copy(fakeRequesters, server.requesters[key])
+
+	return fakeRequesters
+}
+
+// ServeHTTP is a function.
+// read more https://pkg.go.dev/net/http#Handler
+func (server *fakeServer) ServeHTTP(
+	responseWriter http.ResponseWriter,
+	request *http.Request,
+) {
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		WriteFakeResponse(
+			responseWriter,
+			NewFakeErrorResponse(http.StatusBadRequest, "400000", err.Error()),
+		)
+
+		return
+	}
+
+	key := fakeServerKey(request.Method, request.URL.Path)
+
+	server.mutex.Lock()
+
+	server.requesters[key] = append(server.requesters[key], &fakeRequest{
+		method: request.Method,
+		path:   request.URL.Path,
+		query:  request.URL.Query(),
+		body:   body,
+	})
+
+	var fakeResponser FakeResponser
+
+	if queue := server.queues[key]; len(queue) != 0 {
+		fakeResponser = queue[0]
+		server.queues[key] = queue[1:]
+	} else if items, ok := server.paginations[key]; ok {
+		fakeResponser = NewFakePageResponse(request.URL.Query(), items)
+	} else if handler, ok := server.handlers[key]; ok {
+		fakeResponser = handler
+	}
+
+	server.mutex.Unlock()
+
+	if fakeResponser == nil {
+		fakeResponser = NewFakeErrorResponse(
+			http.StatusNotFound,
+			"404000",
+			fmt.Sprintf("Url Not Found %s", key),
+		)
+	}
+
+	WriteFakeResponse(responseWriter, fakeResponser)
+}
+
+// fakeServerKey is the key of the route of method and path.
+func fakeServerKey(
+	method string,
+	path string,
+) string {
+	return fmt.Sprintf("%s %s", method, path)
+}
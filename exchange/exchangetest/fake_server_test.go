@@ -0,0 +1,179 @@
+package exchangetest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+func newFakeServerTest(
+	t *testing.T,
+) FakeServerer {
+	t.Helper()
+
+	fakeServerer := NewFakeServer(
+		kucoin.ApiKeyOption("key"),
+		kucoin.ApiSecretOption("secret"),
+		kucoin.ApiPassPhraseOption("passphrase"),
+		kucoin.ApiKeyVersionOption(kucoin.ApiKeyVersionV2),
+	)
+	t.Cleanup(fakeServerer.Close)
+
+	return fakeServerer
+}
+
+func newFakeServerTestCreateOrderModel() *kucoin.CreateOrderModel {
+	return &kucoin.CreateOrderModel{
+		ClientOid: "client",
+		Side:      string(object.OrderSideTypeBuy),
+		Symbol:    "BTC-USDT",
+		Type:      string(object.OrderTypeTypeLimit),
+		Price:     "100",
+		Size:      "1",
+	}
+}
+
+func TestFakeServerCreateOrder(t *testing.T) {
+	t.Parallel()
+
+	fakeServerer := newFakeServerTest(t)
+	fakeServerer.Enqueue(
+		http.MethodPost,
+		object.URIKucoinPathOrders,
+		NewFakeSuccessResponse(map[string]any{"orderId": "1"}),
+	)
+
+	kucoinAPIResponse, err := fakeServerer.GetExchanger().CreateOrder(newFakeServerTestCreateOrderModel())
+	if err = exchange.NewResponseError(kucoinAPIResponse, err); err != nil {
+		t.Fatalf("CreateOrder() error = %v", err)
+	}
+
+	kucoinCreateOrderResultModel := kucoin.CreateOrderResultModel{}
+	if err = kucoinAPIResponse.ReadData(&kucoinCreateOrderResultModel); err != nil {
+		t.Fatalf("ReadData() error = %v", err)
+	}
+
+	if kucoinCreateOrderResultModel.OrderId != "1" {
+		t.Errorf("OrderId = %q, want %q", kucoinCreateOrderResultModel.OrderId, "1")
+	}
+
+	fakeRequesters := fakeServerer.GetRequesters(http.MethodPost, object.URIKucoinPathOrders)
+	if len(fakeRequesters) != 1 {
+		t.Fatalf("requests = %d, want 1", len(fakeRequesters))
+	}
+
+	body := map[string]any{}
+	if err = json.Unmarshal(fakeRequesters[0].GetBody(), &body); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if body["clientOid"] != "client" || body["symbol"] != "BTC-USDT" || body["price"] != "100" {
+		t.Errorf("body = %v, want the order", body)
+	}
+
+	// The queue is drained, so the route is no longer served.
+	kucoinAPIResponse, err = fakeServerer.GetExchanger().CreateOrder(newFakeServerTestCreateOrderModel())
+	if err = exchange.NewResponseError(kucoinAPIResponse, err); !errors.Is(err, object.ErrKucoinNotFound) {
+		t.Errorf("CreateOrder() error = %v, want %v", err, object.ErrKucoinNotFound)
+	}
+}
+
+func TestFakeServerErrorCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		fakeResponser FakeResponser
+		want          error
+		code          string
+	}{
+		{
+			name: "balance insufficient",
+			fakeResponser: NewFakeErrorResponse(
+				http.StatusOK,
+				object.URIKucoinCodeBalanceInsufficient,
+				object.URIKucoinMessageBalanceInsufficient,
+			),
+			want: object.ErrKucoinBalanceInsufficient,
+			code: object.URIKucoinCodeBalanceInsufficient,
+		},
+		{
+			name: "clientOid duplicated",
+			fakeResponser: NewFakeErrorResponse(
+				http.StatusOK,
+				object.URIKucoinCodeInvalidParameter,
+				object.URIKucoinMessageClientOIDDuplicated,
+			),
+			want: object.ErrKucoinClientOIDDuplicated,
+			code: object.URIKucoinCodeInvalidParameter,
+		},
+		{
+			name: "invalid parameter",
+			fakeResponser: NewFakeErrorResponse(
+				http.StatusOK,
+				object.URIKucoinCodeInvalidParameter,
+				"size invalid",
+			),
+			want: object.ErrKucoinInvalidParameter,
+			code: object.URIKucoinCodeInvalidParameter,
+		},
+		{
+			name: "signature invalid",
+			fakeResponser: NewFakeErrorResponse(
+				http.StatusUnauthorized,
+				object.URIKucoinCodeSignatureInvalid,
+				"Invalid KC-API-SIGN",
+			),
+			want: object.ErrKucoinAuth,
+			code: object.URIKucoinCodeSignatureInvalid,
+		},
+		{
+			name: "too many requests",
+			fakeResponser: NewFakeErrorResponse(
+				http.StatusTooManyRequests,
+				object.URIKucoinCodeTooManyRequests,
+				"Too Many Requests",
+			),
+			want: object.ErrKucoinRateLimit,
+			code: object.URIKucoinCodeTooManyRequests,
+		},
+		{
+			name: "system busy",
+			fakeResponser: NewFakeErrorResponse(
+				http.StatusServiceUnavailable,
+				"503000",
+				"Service Unavailable",
+			),
+			want: object.ErrKucoinSystemBusy,
+			code: "503000",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fakeServerer := newFakeServerTest(t)
+			fakeServerer.Enqueue(http.MethodPost, object.URIKucoinPathOrders, test.fakeResponser)
+
+			err := exchange.NewResponseError(
+				fakeServerer.GetExchanger().CreateOrder(newFakeServerTestCreateOrderModel()),
+			)
+			if !errors.Is(err, test.want) {
+				t.Fatalf("CreateOrder() error = %v, want %v", err, test.want)
+			}
+
+			var objectKucoinErrorer object.KucoinErrorer
+			if !errors.As(err, &objectKucoinErrorer) || objectKucoinErrorer.GetCode() != test.code {
+				t.Errorf("CreateOrder() error = %v, want code %s", err, test.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package exchange
+
+import (
+	"encoding/json"
+)
+
+type (
+	// AccountBalanceModel is a struct.
+	// read more https://docs.kucoin.com/#account-balance-notice
+	AccountBalanceModel struct {
+		AccountID       string `json:"accountId"`
+		Available       string `json:"available"`
+		AvailableChange string `json:"availableChange"`
+		Currency        string `json:"currency"`
+		Hold            string `json:"hold"`
+		HoldChange      string `json:"holdChange"`
+		RelationEvent   string `json:"relationEvent"`
+		RelationEventID string `json:"relationEventId"`
+		Time            string `json:"time"`
+		Total           string `json:"total"`
+	}
+
+	// CreateMultiOrderModel is a struct.
+	// read more https://docs.kucoin.com/#place-bulk-orders
+	CreateMultiOrderModel struct {
+		ClientOid string `json:"clientOid"`
+		FailMsg   string `json:"failMsg"`
+		ID        string `json:"id"`
+		Status    string `json:"status"`
+		Symbol    string `json:"symbol"`
+	}
+
+	// CreateMultiOrderResultModel is a struct.
+	CreateMultiOrderResultModel struct {
+		Data []*CreateMultiOrderModel `json:"data"`
+	}
+
+	// MarketCandlesModel is a struct.
+	// read more https://docs.kucoin.com/#klines
+	MarketCandlesModel struct {
+		Symbol  string   `json:"symbol"`
+		Candles []string `json:"candles"`
+		Time    int64    `json:"time"`
+	}
+
+	// MarketLevel2Model is a struct.
+	// read more https://docs.kucoin.com/#level-2-market-data
+	MarketLevel2Model struct {
+		Changes       MarketLevel2ChangesModel `json:"changes"`
+		Symbol        string                   `json:"symbol"`
+		SequenceEnd   int64                    `json:"sequenceEnd"`
+		SequenceStart int64                    `json:"sequenceStart"`
+		Time          int64                    `json:"time"`
+	}
+
+	// MarketLevel2ChangesModel is a struct.
+	MarketLevel2ChangesModel struct {
+		Asks [][]string `json:"asks"`
+		Bids [][]string `json:"bids"`
+	}
+
+	// MarketSnapshotModel is a struct.
+	// read more https://docs.kucoin.com/#symbol-snapshot
+	MarketSnapshotModel struct {
+		Data     MarketSnapshotDataModel `json:"data"`
+		Sequence json.Number             `json:"sequence"`
+	}
+
+	// MarketSnapshotDataModel is a struct.
+	MarketSnapshotDataModel struct {
+		AveragePrice     json.Number `json:"averagePrice"`
+		Buy              json.Number `json:"buy"`
+		ChangePrice      json.Number `json:"changePrice"`
+		ChangeRate       json.Number `json:"changeRate"`
+		High             json.Number `json:"high"`
+		LastTradedPrice  json.Number `json:"lastTradedPrice"`
+		Low              json.Number `json:"low"`
+		MakerCoefficient json.Number `json:"makerCoefficient"`
+		MakerFeeRate     json.Number `json:"makerFeeRate"`
+		Sell             json.Number `json:"sell"`
+		Symbol           string      `json:"symbol"`
+		SymbolCode       string      `json:"symbolCode"`
+		TakerCoefficient json.Number `json:"takerCoefficient"`
+		TakerFeeRate     json.Number `json:"takerFeeRate"`
+		Vol              json.Number `json:"vol"`
+		VolValue         json.Number `json:"volValue"`
+		Datetime         int64       `json:"datetime"`
+	}
+
+	// OrderChangeModel is a struct.
+	// read more https://docs.kucoin.com/#private-order-change-events
+	OrderChangeModel struct {
+		ClientOid  string `json:"clientOid"`
+		FilledSize string `json:"filledSize"`
+		Liquidity  string `json:"liquidity"`
+		MatchPrice string `json:"matchPrice"`
+		MatchSize  string `json:"matchSize"`
+		OldSize    string `json:"oldSize"`
+		OrderID    string `json:"orderId"`
+		OrderType  string `json:"orderType"`
+		Price      string `json:"price"`
+		RemainSize string `json:"remainSize"`
+		Side       string `json:"side"`
+		Size       string `json:"size"`
+		Status     string `json:"status"`
+		Symbol     string `json:"symbol"`
+		TradeID    string `json:"tradeId"`
+		Type       string `json:"type"`
+		OrderTime  int64  `json:"orderTime"`
+		TS         int64  `json:"ts"`
+	}
+
+	// SymbolModel is a struct.
+	// It adds minFunds, which the sdk model does not decode.
+	// read more https://docs.kucoin.com/#get-symbols-list
+	SymbolModel struct {
+		BaseCurrency    string `json:"baseCurrency"`
+		BaseIncrement   string `json:"baseIncrement"`
+		BaseMaxSize     string `json:"baseMaxSize"`
+		BaseMinSize     string `json:"baseMinSize"`
+		FeeCurrency     string `json:"feeCurrency"`
+		Market          string `json:"market"`
+		MinFunds        string `json:"minFunds"`
+		Name            string `json:"name"`
+		PriceIncrement  string `json:"priceIncrement"`
+		PriceLimitRate  string `json:"priceLimitRate"`
+		QuoteCurrency   string `json:"quoteCurrency"`
+		QuoteIncrement  string `json:"quoteIncrement"`
+		QuoteMaxSize    string `json:"quoteMaxSize"`
+		QuoteMinSize    string `json:"quoteMinSize"`
+		Symbol          string `json:"symbol"`
+		EnableTrading   bool   `json:"enableTrading"`
+		IsMarginEnabled bool   `json:"isMarginEnabled"`
+	}
+)
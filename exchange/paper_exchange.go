@@ -0,0 +1,1127 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// PaperExchanger is an interface.
+	PaperExchanger interface {
+		Exchanger
+		GetExchanger
+		object.GetTimer
+		// GetPaperQuoter is a function.
+		GetPaperQuoter() PaperQuoter
+		// Match is a function.
+		Match() error
+	}
+
+	paperAccount struct {
+		balance float64
+		holds   float64
+	}
+
+	// paperBook is the simulated state of one account. It is shared by every
+	// exchanger bound to the account, so a copy of the exchanger keeps
+	// trading against the same book.
+	paperBook struct {
+		accounts   map[string]*paperAccount
+		clientOIDs map[string]*paperOrder
+		fills      []*kucoin.FillModel
+		orders     map[string]*paperOrder
+		stopOrders map[string]*paperOrder
+		sequence   uint64
+		mutex      sync.Mutex
+	}
+
+	paperExchange struct {
+		*paperBook
+		apiService   *kucoin.ApiService
+		exchanger    Exchanger
+		objectTimer  object.Timer
+		paperBooks   map[string]*paperBook
+		paperQuoter  PaperQuoter
+		makerFeeRate float64
+		takerFeeRate float64
+	}
+)
+
+var (
+	_ AccountExchanger = (*paperExchange)(nil)
+	_ ContextExchanger = (*paperExchange)(nil)
+	_ PaperExchanger   = (*paperExchange)(nil)
+	_ http.Handler     = (*paperExchange)(nil)
+	_ kucoin.Requester = (*paperExchange)(nil)
+)
+
+// NewPaperExchange is a function.
+// Market data still comes from the exchanger, while orders and accounts are
+// served by an in-process simulator that fills against the quoter's top of
+// book. The simulator is reached through a kucoin.ApiService, so the responses
+// are read exactly like the live ones. When the exchanger serves several
+// accounts, each of them gets a book of its own that starts from the balances.
+func NewPaperExchange(
+	exchanger Exchanger,
+	paperQuoter PaperQuoter,
+	objectTimer object.Timer,
+	balances map[string]string,
+	makerFeeRate float64,
+	takerFeeRate float64,
+) (*paperExchange, error) {
+	values := make(map[string]float64, len(balances))
+
+	for currency, balance := range balances {
+		value, err := strconv.ParseFloat(balance, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", object.ErrPaperBalanceParse, err)
+		}
+
+		values[strings.ToUpper(currency)] = value
+	}
+
+	accounts := []string{object.URIEmpty}
+	if accountExchanger, ok := exchanger.(AccountExchanger); ok {
+		accounts = accountExchanger.GetAccounts()
+	}
+
+	paperBooks := make(map[string]*paperBook, len(accounts))
+
+	for _, account := range accounts {
+		paperBooks[account] = newPaperBook(values)
+	}
+
+	paperExchange := &paperExchange{
+		paperBook:    paperBooks[accounts[0]],
+		apiService:   nil,
+		exchanger:    exchanger,
+		objectTimer:  objectTimer,
+		paperBooks:   paperBooks,
+		paperQuoter:  paperQuoter,
+		makerFeeRate: makerFeeRate,
+		takerFeeRate: takerFeeRate,
+	}
+
+	paperExchange.apiService = kucoin.NewApiService(kucoin.ApiRequesterOption(paperExchange))
+
+	return paperExchange, nil
+}
+
+func newPaperBook(
+	balances map[string]float64,
+) *paperBook {
+	paperBook := &paperBook{
+		accounts:   make(map[string]*paperAccount, len(balances)),
+		clientOIDs: map[string]*paperOrder{},
+		fills:      []*kucoin.FillModel{},
+		orders:     map[string]*paperOrder{},
+		stopOrders: map[string]*paperOrder{},
+		sequence:   0,
+		mutex:      sync.Mutex{},
+	}
+
+	for currency, balance := range balances {
+		paperBook.accounts[currency] = &paperAccount{
+			balance: balance,
+			holds:   0,
+		}
+	}
+
+	return paperBook
+}
+
+// GetAccount is a function.
+// It is the default account of the exchanger, or empty when the exchanger
+// serves only one.
+func (exchange *paperExchange) GetAccount() string {
+	if accountExchanger, ok := exchange.GetExchanger().(AccountExchanger); ok {
+		return accountExchanger.GetAccount()
+	}
+
+	return object.URIEmpty
+}
+
+// GetAccountExchanger is a function.
+// It trades against the book of the account and reads the market data through
+// the exchanger of the account. An empty account is the default one, and an
+// account the exchanger does not serve gets the exchanger's own answer, which
+// fails the calls.
+func (exchange *paperExchange) GetAccountExchanger(
+	account string,
+) Exchanger {
+	accountExchanger, ok := exchange.GetExchanger().(AccountExchanger)
+	if !ok || account == object.URIEmpty {
+		return exchange
+	}
+
+	paperBook, ok := exchange.paperBooks[account]
+	if !ok {
+		return accountExchanger.GetAccountExchanger(account)
+	}
+
+	return exchange.with(accountExchanger.GetAccountExchanger(account), paperBook)
+}
+
+// GetAccounts is a function.
+func (exchange *paperExchange) GetAccounts() []string {
+	if accountExchanger, ok := exchange.GetExchanger().(AccountExchanger); ok {
+		return accountExchanger.GetAccounts()
+	}
+
+	return []string{exchange.GetAccount()}
+}
+
+// GetExchanger is a function.
+func (exchange *paperExchange) GetExchanger() Exchanger {
+	return exchange.exchanger
+}
+
+// GetPaperQuoter is a function.
+func (exchange *paperExchange) GetPaperQuoter() PaperQuoter {
+	return exchange.paperQuoter
+}
+
+// GetTimer is a function.
+func (exchange *paperExchange) GetTimer() object.Timer {
+	return exchange.objectTimer
+}
+
+// WithContext is a function.
+// The market data calls of the returned exchanger are bound to ctx, while the
+// simulated calls keep trading against the same book.
+func (exchange *paperExchange) WithContext(
+	ctx context.Context,
+) Exchanger {
+	contextExchanger, ok := exchange.GetExchanger().(ContextExchanger)
+	if !ok {
+		return exchange
+	}
+
+	return exchange.with(contextExchanger.WithContext(ctx), exchange.paperBook)
+}
+
+// Accounts is a function.
+func (exchange *paperExchange) Accounts(
+	currency string,
+	typo string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.Accounts(currency, typo)
+}
+
+// AggregatedFullOrderBookV3 is a function.
+func (exchange *paperExchange) AggregatedFullOrderBookV3(
+	symbol string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.GetExchanger().AggregatedFullOrderBookV3(symbol)
+}
+
+// BorrowOutstandingRecords is a function.
+// Margin is not simulated, so the simulator answers that the url is not found.
+func (exchange *paperExchange) BorrowOutstandingRecords(
+	currency string,
+	kucoinPaginationParam *kucoin.PaginationParam,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.BorrowOutstandingRecords(currency, kucoinPaginationParam)
+}
+
+// BorrowRepaidRecords is a function.
+func (exchange *paperExchange) BorrowRepaidRecords(
+	currency string,
+	kucoinPaginationParam *kucoin.PaginationParam,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.BorrowRepaidRecords(currency, kucoinPaginationParam)
+}
+
+// CancelOrder is a function.
+func (exchange *paperExchange) CancelOrder(
+	orderID string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.CancelOrder(orderID)
+}
+
+// CancelOrderByClient is a function.
+func (exchange *paperExchange) CancelOrderByClient(
+	clientOID string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.CancelOrderByClient(clientOID)
+}
+
+// CancelOrders is a function.
+func (exchange *paperExchange) CancelOrders(
+	params map[string]string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.CancelOrders(params)
+}
+
+// CancelStopOrder is a function.
+func (exchange *paperExchange) CancelStopOrder(
+	orderID string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.CancelStopOrder(orderID)
+}
+
+// CancelStopOrderByClient is a function.
+func (exchange *paperExchange) CancelStopOrderByClient(
+	clientOID string,
+	params map[string]string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.CancelStopOrderByClient(clientOID, params)
+}
+
+// CreateBorrowOrder is a function.
+func (exchange *paperExchange) CreateBorrowOrder(
+	params map[string]string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.CreateBorrowOrder(params)
+}
+
+// CreateMarginOrder is a function.
+func (exchange *paperExchange) CreateMarginOrder(
+	kucoinCreateOrderModel *kucoin.CreateOrderModel,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.CreateMarginOrder(kucoinCreateOrderModel)
+}
+
+// CreateMultiOrder is a function.
+func (exchange *paperExchange) CreateMultiOrder(
+	symbol string,
+	kucoinCreateOrderModels []*kucoin.CreateOrderModel,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.CreateMultiOrder(symbol, kucoinCreateOrderModels)
+}
+
+// CreateOrder is a function.
+func (exchange *paperExchange) CreateOrder(
+	kucoinCreateOrderModel *kucoin.CreateOrderModel,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.CreateOrder(kucoinCreateOrderModel)
+}
+
+// CreateStopOrder is a function.
+func (exchange *paperExchange) CreateStopOrder(
+	kucoinCreateOrderModel *kucoin.CreateOrderModel,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.CreateStopOrder(kucoinCreateOrderModel)
+}
+
+// Fills is a function.
+func (exchange *paperExchange) Fills(
+	params map[string]string,
+	kucoinPaginationParam *kucoin.PaginationParam,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.Fills(params, kucoinPaginationParam)
+}
+
+// KLines is a function.
+func (exchange *paperExchange) KLines(
+	symbol string,
+	typo string,
+	startAt int64,
+	endAt int64,
+) (*kucoin.ApiResponse, error) {
+	return exchange.GetExchanger().KLines(symbol, typo, startAt, endAt)
+}
+
+// MarginAccount is a function.
+func (exchange *paperExchange) MarginAccount() (*kucoin.ApiResponse, error) {
+	return exchange.apiService.MarginAccount()
+}
+
+// MarginRiskLimit is a function.
+func (exchange *paperExchange) MarginRiskLimit(
+	marginModel string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.MarginRiskLimit(marginModel)
+}
+
+// NewWebSocketClient is a function.
+func (exchange *paperExchange) NewWebSocketClient(
+	kucoinWebSocketTokenModel *kucoin.WebSocketTokenModel,
+) *kucoin.WebSocketClient {
+	return exchange.GetExchanger().NewWebSocketClient(kucoinWebSocketTokenModel)
+}
+
+// Order is a function.
+func (exchange *paperExchange) Order(
+	orderID string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.Order(orderID)
+}
+
+// OrderByClient is a function.
+func (exchange *paperExchange) OrderByClient(
+	clientOID string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.OrderByClient(clientOID)
+}
+
+// Orders is a function.
+func (exchange *paperExchange) Orders(
+	params map[string]string,
+	kucoinPaginationParam *kucoin.PaginationParam,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.Orders(params, kucoinPaginationParam)
+}
+
+// RecentFills is a function.
+func (exchange *paperExchange) RecentFills() (*kucoin.ApiResponse, error) {
+	return exchange.apiService.RecentFills()
+}
+
+// RecentOrders is a function.
+func (exchange *paperExchange) RecentOrders() (*kucoin.ApiResponse, error) {
+	return exchange.apiService.RecentOrders()
+}
+
+// RepayAll is a function.
+func (exchange *paperExchange) RepayAll(
+	params map[string]string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.RepayAll(params)
+}
+
+// RepaySingle is a function.
+func (exchange *paperExchange) RepaySingle(
+	params map[string]string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.RepaySingle(params)
+}
+
+// ServiceStatus is a function.
+// The status is the one of the live exchange, which the quotes come from.
+func (exchange *paperExchange) ServiceStatus() (*kucoin.ApiResponse, error) {
+	return exchange.GetExchanger().ServiceStatus()
+}
+
+// StopOrder is a function.
+func (exchange *paperExchange) StopOrder(
+	orderID string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.StopOrder(orderID)
+}
+
+// StopOrderByClient is a function.
+func (exchange *paperExchange) StopOrderByClient(
+	clientOID string,
+	params map[string]string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.StopOrderByClient(clientOID, params)
+}
+
+// StopOrders is a function.
+func (exchange *paperExchange) StopOrders(
+	params map[string]string,
+	kucoinPaginationParam *kucoin.PaginationParam,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.StopOrders(params, kucoinPaginationParam)
+}
+
+// SubAccounts is a function.
+// Sub-accounts are not simulated, so the simulator answers that the url is not
+// found.
+func (exchange *paperExchange) SubAccounts() (*kucoin.ApiResponse, error) {
+	return exchange.apiService.SubAccounts()
+}
+
+// SubTransferV2 is a function.
+func (exchange *paperExchange) SubTransferV2(
+	params map[string]string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.apiService.SubTransferV2(params)
+}
+
+// Symbols is a function.
+func (exchange *paperExchange) Symbols(
+	market string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.GetExchanger().Symbols(market)
+}
+
+// TickerLevel1 is a function.
+func (exchange *paperExchange) TickerLevel1(
+	symbol string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.GetExchanger().TickerLevel1(symbol)
+}
+
+// Tickers is a function.
+func (exchange *paperExchange) Tickers() (*kucoin.ApiResponse, error) {
+	return exchange.GetExchanger().Tickers()
+}
+
+// WebSocketPrivateToken is a function.
+func (exchange *paperExchange) WebSocketPrivateToken() (*kucoin.ApiResponse, error) {
+	return exchange.GetExchanger().WebSocketPrivateToken()
+}
+
+// WebSocketPublicToken is a function.
+func (exchange *paperExchange) WebSocketPublicToken() (*kucoin.ApiResponse, error) {
+	return exchange.GetExchanger().WebSocketPublicToken()
+}
+
+// Match is a function.
+// It runs one matching pass over the resting orders of every book. The pass
+// also runs before every simulated request, so calling it is only needed to
+// let the resting orders fill while nothing else talks to the simulator.
+func (exchange *paperExchange) Match() error {
+	accounts := make([]string, 0, len(exchange.paperBooks))
+
+	for account := range exchange.paperBooks {
+		accounts = append(accounts, account)
+	}
+
+	sort.Strings(accounts)
+
+	for _, account := range accounts {
+		paperExchange := exchange.with(exchange.GetExchanger(), exchange.paperBooks[account])
+
+		if err := paperExchange.matchBook(); err != nil {
+			return fmt.Errorf("%w: %w", object.ErrPaperExchangeMatch, err)
+		}
+	}
+
+	return nil
+}
+
+// Request is a function.
+// read more https://pkg.go.dev/github.com/Kucoin/kucoin-go-sdk#Requester
+func (exchange *paperExchange) Request(
+	kucoinRequest *kucoin.Request,
+	_ time.Duration,
+) (*kucoin.Response, error) {
+	httpRequest, err := kucoinRequest.HttpRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	responseRecorder := httptest.NewRecorder()
+	exchange.ServeHTTP(responseRecorder, httpRequest)
+
+	return kucoin.NewResponse(
+		kucoinRequest,
+		responseRecorder.Result(),
+		responseRecorder.Body.Bytes(),
+	), nil
+}
+
+// ServeHTTP is a function.
+// read more https://pkg.go.dev/net/http#Handler
+func (exchange *paperExchange) ServeHTTP(
+	responseWriter http.ResponseWriter,
+	request *http.Request,
+) {
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		writePaperResponse(responseWriter, paperInvalid(err.Error()))
+
+		return
+	}
+
+	exchange.mutex.Lock()
+	defer exchange.mutex.Unlock()
+
+	// A quote that cannot be read only delays the fills of its symbol.
+	_ = exchange.match()
+
+	path := request.URL.Path
+	query := request.URL.Query()
+
+	var paperResponse *paperResponse
+
+	switch {
+	case path == object.URIKucoinPathAccounts && request.Method == http.MethodGet:
+		paperResponse = exchange.serveAccounts(query)
+	case path == object.URIKucoinPathFills && request.Method == http.MethodGet:
+		paperResponse = exchange.serveFills(query)
+	case path == object.URIKucoinPathLimitFills && request.Method == http.MethodGet:
+		paperResponse = exchange.serveRecentFills()
+	case path == object.URIKucoinPathLimitOrders && request.Method == http.MethodGet:
+		paperResponse = exchange.serveRecentOrders()
+	case path == object.URIKucoinPathOrders && request.Method == http.MethodDelete:
+		paperResponse = exchange.serveCancelOrders(query)
+	case path == object.URIKucoinPathOrders && request.Method == http.MethodGet:
+		paperResponse = exchange.serveOrders(query)
+	case path == object.URIKucoinPathOrders && request.Method == http.MethodPost:
+		paperResponse = exchange.serveCreateOrder(body)
+	case path == object.URIKucoinPathOrdersMulti && request.Method == http.MethodPost:
+		paperResponse = exchange.serveCreateMultiOrder(body)
+	case path == object.URIKucoinPathStopOrder && request.Method == http.MethodGet:
+		paperResponse = exchange.serveStopOrders(query)
+	case path == object.URIKucoinPathStopOrder && request.Method == http.MethodPost:
+		paperResponse = exchange.serveCreateStopOrder(body)
+	case path == object.URIKucoinPathStopOrderCancelByClientOID:
+		paperResponse = exchange.serveStopOrder(
+			request.Method,
+			exchange.stopOrderByClientOID(query.Get("clientOid")),
+		)
+	case path == object.URIKucoinPathStopOrderQueryByClientOID &&
+		request.Method == http.MethodGet:
+		paperResponse = exchange.serveStopOrderByClient(query.Get("clientOid"))
+	case strings.HasPrefix(path, object.URIKucoinPathStopOrder+object.URIKucoinPathSeparator):
+		paperResponse = exchange.serveStopOrder(
+			request.Method,
+			exchange.stopOrders[strings.TrimPrefix(
+				path,
+				object.URIKucoinPathStopOrder+object.URIKucoinPathSeparator,
+			)],
+		)
+	case strings.HasPrefix(path, object.URIKucoinPathOrderClientOrder):
+		paperResponse = exchange.serveOrder(
+			request.Method,
+			exchange.clientOIDs[strings.TrimPrefix(path, object.URIKucoinPathOrderClientOrder)],
+		)
+	case strings.HasPrefix(path, object.URIKucoinPathOrders+object.URIKucoinPathSeparator):
+		paperResponse = exchange.serveOrder(
+			request.Method,
+			exchange.orders[strings.TrimPrefix(
+				path,
+				object.URIKucoinPathOrders+object.URIKucoinPathSeparator,
+			)],
+		)
+	}
+
+	if paperResponse == nil {
+		paperResponse = newPaperErrorResponse(
+			http.StatusNotFound,
+			object.URIKucoinCodeNotFound,
+			fmt.Sprintf("Url Not Found %s %s", request.Method, path),
+		)
+	}
+
+	writePaperResponse(responseWriter, paperResponse)
+}
+
+func (exchange *paperExchange) serveAccounts(
+	query url.Values,
+) *paperResponse {
+	typo := query.Get("type")
+	if typo != object.URIEmpty && typo != object.URIKucoinAccountTypeTrade {
+		return newPaperResponse(kucoin.AccountsModel{})
+	}
+
+	currencies := make([]string, 0, len(exchange.accounts))
+
+	for currency := range exchange.accounts {
+		if query.Get("currency") == object.URIEmpty || query.Get("currency") == currency {
+			currencies = append(currencies, currency)
+		}
+	}
+
+	sort.Strings(currencies)
+
+	kucoinAccountsModel := make(kucoin.AccountsModel, 0, len(currencies))
+	for _, currency := range currencies {
+		paperAccount := exchange.accounts[currency]
+		kucoinAccountsModel = append(kucoinAccountsModel, &kucoin.AccountModel{
+			Id:        currency,
+			Currency:  currency,
+			Type:      object.URIKucoinAccountTypeTrade,
+			Balance:   paperFormat(paperAccount.balance),
+			Available: paperFormat(paperAccount.balance - paperAccount.holds),
+			Holds:     paperFormat(paperAccount.holds),
+		})
+	}
+
+	return newPaperResponse(kucoinAccountsModel)
+}
+
+func (exchange *paperExchange) serveCancelOrders(
+	query url.Values,
+) *paperResponse {
+	paperOrders := exchange.list(func(paperOrder *paperOrder) bool {
+		return paperOrder.isActive &&
+			(query.Get("symbol") == object.URIEmpty ||
+				query.Get("symbol") == paperOrder.symbol) &&
+			(query.Get("tradeType") == object.URIEmpty ||
+				query.Get("tradeType") == paperOrder.tradeType)
+	})
+
+	cancelledOrderIDs := make([]string, 0, len(paperOrders))
+	for _, paperOrder := range paperOrders {
+		exchange.done(paperOrder)
+		cancelledOrderIDs = append(cancelledOrderIDs, paperOrder.id)
+	}
+
+	return newPaperResponse(map[string]any{
+		"cancelledOrderIds": cancelledOrderIDs,
+	})
+}
+
+func (exchange *paperExchange) serveCreateMultiOrder(
+	body []byte,
+) *paperResponse {
+	params := struct {
+		Symbol    string                     `json:"symbol"`
+		OrderList []*kucoin.CreateOrderModel `json:"orderList"`
+	}{
+		Symbol:    object.URIEmpty,
+		OrderList: []*kucoin.CreateOrderModel{},
+	}
+	if err := json.Unmarshal(body, &params); err != nil {
+		return paperInvalid(err.Error())
+	}
+
+	if len(params.OrderList) > object.NUMKucoinMultiOrderCount {
+		return paperInvalid(fmt.Sprintf("orderList exceeds %d", object.NUMKucoinMultiOrderCount))
+	}
+
+	data := make([]*CreateMultiOrderModel, 0, len(params.OrderList))
+
+	for _, kucoinCreateOrderModel := range params.OrderList {
+		kucoinCreateOrderModel.Symbol = params.Symbol
+		createMultiOrderModel := &CreateMultiOrderModel{
+			ClientOid: kucoinCreateOrderModel.ClientOid,
+			FailMsg:   object.URIEmpty,
+			ID:        object.URIEmpty,
+			Status:    object.URIKucoinOrderStatusSuccess,
+			Symbol:    params.Symbol,
+		}
+
+		paperOrder, paperResponse := exchange.place(kucoinCreateOrderModel)
+		if paperResponse != nil {
+			createMultiOrderModel.FailMsg = paperResponse.message
+			createMultiOrderModel.Status = object.URIKucoinOrderStatusFail
+		} else {
+			createMultiOrderModel.ID = paperOrder.id
+		}
+
+		data = append(data, createMultiOrderModel)
+	}
+
+	return newPaperResponse(&CreateMultiOrderResultModel{
+		Data: data,
+	})
+}
+
+func (exchange *paperExchange) serveCreateOrder(
+	body []byte,
+) *paperResponse {
+	kucoinCreateOrderModel := &kucoin.CreateOrderModel{}
+	if err := json.Unmarshal(body, kucoinCreateOrderModel); err != nil {
+		return paperInvalid(err.Error())
+	}
+
+	paperOrder, paperResponse := exchange.place(kucoinCreateOrderModel)
+	if paperResponse != nil {
+		return paperResponse
+	}
+
+	return newPaperResponse(map[string]any{
+		"orderId": paperOrder.id,
+	})
+}
+
+func (exchange *paperExchange) serveCreateStopOrder(
+	body []byte,
+) *paperResponse {
+	kucoinCreateOrderModel := &kucoin.CreateOrderModel{}
+	if err := json.Unmarshal(body, kucoinCreateOrderModel); err != nil {
+		return paperInvalid(err.Error())
+	}
+
+	if kucoinCreateOrderModel.Stop == object.URIEmpty {
+		return paperInvalid("stop order needs stop")
+	}
+
+	if exchange.clientOIDTaken(kucoinCreateOrderModel.ClientOid) {
+		return paperInvalid(object.URIKucoinMessageClientOIDDuplicated)
+	}
+
+	paperOrder, err := newPaperOrder(
+		kucoinCreateOrderModel,
+		exchange.nextID(),
+		exchange.GetTimer().NowUTC().UnixMilli(),
+	)
+	if err != nil {
+		return paperInvalid(err.Error())
+	}
+
+	exchange.stopOrders[paperOrder.id] = paperOrder
+
+	return newPaperResponse(map[string]any{
+		"orderId": paperOrder.id,
+	})
+}
+
+func (exchange *paperExchange) serveFills(
+	query url.Values,
+) *paperResponse {
+	items := make([]any, 0, len(exchange.fills))
+
+	for index := len(exchange.fills) - 1; index >= 0; index-- {
+		kucoinFillModel := exchange.fills[index]
+		if (query.Get("orderId") == object.URIEmpty ||
+			query.Get("orderId") == kucoinFillModel.OrderId) &&
+			(query.Get("symbol") == object.URIEmpty ||
+				query.Get("symbol") == kucoinFillModel.Symbol) &&
+			(query.Get("side") == object.URIEmpty ||
+				query.Get("side") == kucoinFillModel.Side) &&
+			(query.Get("type") == object.URIEmpty ||
+				query.Get("type") == kucoinFillModel.Type) &&
+			(query.Get("tradeType") == object.URIEmpty ||
+				query.Get("tradeType") == kucoinFillModel.TradeType) {
+			items = append(items, kucoinFillModel)
+		}
+	}
+
+	return newPaperPageResponse(query, items)
+}
+
+func (exchange *paperExchange) serveOrder(
+	method string,
+	paperOrder *paperOrder,
+) *paperResponse {
+	switch method {
+	case http.MethodDelete:
+		if paperOrder == nil || !paperOrder.isActive {
+			return paperInvalid(object.URIKucoinMessageOrderNotExist)
+		}
+
+		exchange.done(paperOrder)
+
+		return newPaperResponse(map[string]any{
+			"cancelledOrderId":  paperOrder.id,
+			"cancelledOrderIds": []string{paperOrder.id},
+			"clientOid":         paperOrder.clientOID,
+		})
+	case http.MethodGet:
+		if paperOrder == nil {
+			return paperInvalid(object.URIKucoinMessageOrderNotExist)
+		}
+
+		return newPaperResponse(paperOrder.model())
+	}
+
+	return nil
+}
+
+func (exchange *paperExchange) serveOrders(
+	query url.Values,
+) *paperResponse {
+	paperOrders := exchange.list(func(paperOrder *paperOrder) bool {
+		status := object.OrderStateType(query.Get("status"))
+
+		return (status == object.OrderStateType(object.URIEmpty) ||
+			(status == object.OrderStateTypeActive) == paperOrder.isActive) &&
+			(query.Get("symbol") == object.URIEmpty ||
+				query.Get("symbol") == paperOrder.symbol) &&
+			(query.Get("side") == object.URIEmpty ||
+				query.Get("side") == string(paperOrder.side)) &&
+			(query.Get("type") == object.URIEmpty ||
+				query.Get("type") == string(paperOrder.kucoinType)) &&
+			(query.Get("tradeType") == object.URIEmpty ||
+				query.Get("tradeType") == paperOrder.tradeType)
+	})
+
+	items := make([]any, 0, len(paperOrders))
+	for index := len(paperOrders) - 1; index >= 0; index-- {
+		items = append(items, paperOrders[index].model())
+	}
+
+	return newPaperPageResponse(query, items)
+}
+
+func (exchange *paperExchange) serveStopOrder(
+	method string,
+	paperOrder *paperOrder,
+) *paperResponse {
+	if paperOrder == nil {
+		return paperInvalid(object.URIKucoinMessageOrderNotExist)
+	}
+
+	switch method {
+	case http.MethodDelete:
+		delete(exchange.stopOrders, paperOrder.id)
+
+		return newPaperResponse(map[string]any{
+			"cancelledOrderId":  paperOrder.id,
+			"cancelledOrderIds": []string{paperOrder.id},
+			"clientOid":         paperOrder.clientOID,
+		})
+	case http.MethodGet:
+		return newPaperResponse(paperOrder.stopModel())
+	}
+
+	return nil
+}
+
+func (exchange *paperExchange) serveStopOrderByClient(
+	clientOID string,
+) *paperResponse {
+	kucoinStopOrderListModel := kucoin.StopOrderListModel{}
+	if paperOrder := exchange.stopOrderByClientOID(clientOID); paperOrder != nil {
+		kucoinStopOrderListModel = append(kucoinStopOrderListModel, paperOrder.stopModel())
+	}
+
+	return newPaperResponse(kucoinStopOrderListModel)
+}
+
+func (exchange *paperExchange) serveStopOrders(
+	query url.Values,
+) *paperResponse {
+	paperOrders := exchange.listStop(func(paperOrder *paperOrder) bool {
+		return (query.Get("symbol") == object.URIEmpty ||
+			query.Get("symbol") == paperOrder.symbol) &&
+			(query.Get("side") == object.URIEmpty ||
+				query.Get("side") == string(paperOrder.side)) &&
+			(query.Get("type") == object.URIEmpty ||
+				query.Get("type") == string(paperOrder.kucoinType)) &&
+			(query.Get("tradeType") == object.URIEmpty ||
+				query.Get("tradeType") == paperOrder.tradeType)
+	})
+
+	items := make([]any, 0, len(paperOrders))
+	for index := len(paperOrders) - 1; index >= 0; index-- {
+		items = append(items, paperOrders[index].stopModel())
+	}
+
+	return newPaperPageResponse(query, items)
+}
+
+func (exchange *paperExchange) serveRecentFills() *paperResponse {
+	startAt := exchange.GetTimer().NowUTC().UnixMilli() - object.NUM1DayToSecond*1000
+
+	kucoinFillsModel := make(kucoin.FillsModel, 0)
+	for index := len(exchange.fills) - 1; index >= 0; index-- {
+		if len(kucoinFillsModel) == object.NUMKucoinRecentFillCount ||
+			exchange.fills[index].CreatedAt < startAt {
+			break
+		}
+
+		kucoinFillsModel = append(kucoinFillsModel, exchange.fills[index])
+	}
+
+	return newPaperResponse(kucoinFillsModel)
+}
+
+func (exchange *paperExchange) serveRecentOrders() *paperResponse {
+	startAt := exchange.GetTimer().NowUTC().UnixMilli() - object.NUM1DayToSecond*1000
+	paperOrders := exchange.list(func(paperOrder *paperOrder) bool {
+		return paperOrder.createdAt >= startAt
+	})
+
+	kucoinOrdersModel := make(kucoin.OrdersModel, 0, len(paperOrders))
+	for index := len(paperOrders) - 1; index >= 0; index-- {
+		if len(kucoinOrdersModel) == object.NUMKucoinRecentOrderCount {
+			break
+		}
+
+		kucoinOrdersModel = append(kucoinOrdersModel, paperOrders[index].model())
+	}
+
+	return newPaperResponse(kucoinOrdersModel)
+}
+
+// place holds the funds of a new order and runs it against the top of book.
+// A non-nil response means the order was refused and nothing was held.
+func (exchange *paperExchange) place(
+	kucoinCreateOrderModel *kucoin.CreateOrderModel,
+) (*paperOrder, *paperResponse) {
+	if exchange.clientOIDTaken(kucoinCreateOrderModel.ClientOid) {
+		return nil, paperInvalid(object.URIKucoinMessageClientOIDDuplicated)
+	}
+
+	kucoinCreateOrderModel.Stop = object.URIEmpty
+
+	paperOrder, err := newPaperOrder(
+		kucoinCreateOrderModel,
+		exchange.nextID(),
+		exchange.GetTimer().NowUTC().UnixMilli(),
+	)
+	if err != nil {
+		return nil, paperInvalid(err.Error())
+	}
+
+	if paperResponse := exchange.admit(paperOrder); paperResponse != nil {
+		return nil, paperResponse
+	}
+
+	return paperOrder, nil
+}
+
+// admit holds the funds of an order and runs it against the top of book.
+// A non-nil response means the order was refused and nothing was held.
+func (exchange *paperExchange) admit(
+	paperOrder *paperOrder,
+) *paperResponse {
+	kucoinTickerLevel1Model, err := exchange.GetPaperQuoter().Quote(paperOrder.symbol)
+	if err != nil {
+		return paperInvalid(err.Error())
+	}
+
+	hold, holdCurrency, err := exchange.hold(paperOrder, kucoinTickerLevel1Model)
+	if err != nil {
+		return paperInvalid(err.Error())
+	}
+
+	paperAccount := exchange.account(holdCurrency)
+	if paperAccount.balance-paperAccount.holds+object.NUMPaperEpsilon < hold {
+		return newPaperErrorResponse(
+			http.StatusOK,
+			object.URIKucoinCodeBalanceInsufficient,
+			object.URIKucoinMessageBalanceInsufficient,
+		)
+	}
+
+	paperAccount.holds += hold
+	paperOrder.hold = hold
+	paperOrder.holdCurrency = holdCurrency
+	exchange.orders[paperOrder.id] = paperOrder
+	exchange.clientOIDs[paperOrder.clientOID] = paperOrder
+
+	// The order is accepted even when the top of book vanished in between; it is
+	// then closed without a fill, like an order the exchange cancels at once.
+	_ = exchange.take(paperOrder, kucoinTickerLevel1Model)
+
+	return nil
+}
+
+func (exchange *paperExchange) account(
+	currency string,
+) *paperAccount {
+	if _, ok := exchange.accounts[currency]; !ok {
+		exchange.accounts[currency] = &paperAccount{
+			balance: 0,
+			holds:   0,
+		}
+	}
+
+	return exchange.accounts[currency]
+}
+
+// clientOIDTaken reports whether an order or a stop order already uses the clientOid.
+func (exchange *paperExchange) clientOIDTaken(
+	clientOID string,
+) bool {
+	if _, ok := exchange.clientOIDs[clientOID]; ok {
+		return true
+	}
+
+	return exchange.stopOrderByClientOID(clientOID) != nil
+}
+
+// list returns the matching orders oldest first.
+func (exchange *paperExchange) list(
+	filter func(*paperOrder) bool,
+) []*paperOrder {
+	return paperList(exchange.orders, filter)
+}
+
+// listStop returns the matching stop orders that have not triggered, oldest first.
+func (exchange *paperExchange) listStop(
+	filter func(*paperOrder) bool,
+) []*paperOrder {
+	return paperList(exchange.stopOrders, filter)
+}
+
+// stopOrderByClientOID returns the stop order that has not triggered for the clientOid.
+func (exchange *paperExchange) stopOrderByClientOID(
+	clientOID string,
+) *paperOrder {
+	for _, paperOrder := range exchange.stopOrders {
+		if clientOID != object.URIEmpty && paperOrder.clientOID == clientOID {
+			return paperOrder
+		}
+	}
+
+	return nil
+}
+
+// trigger turns a reached stop order into an order under the same id.
+// An order that cannot be admitted is kept as cancelled, like the exchange does.
+func (exchange *paperExchange) trigger(
+	paperOrder *paperOrder,
+) {
+	delete(exchange.stopOrders, paperOrder.id)
+	paperOrder.triggered = true
+
+	if paperResponse := exchange.admit(paperOrder); paperResponse != nil {
+		paperOrder.isActive = false
+		paperOrder.cancelExist = true
+		exchange.orders[paperOrder.id] = paperOrder
+		exchange.clientOIDs[paperOrder.clientOID] = paperOrder
+	}
+}
+
+// nextID returns 24 hex characters like the exchange, ordered by creation.
+func (exchange *paperExchange) nextID() string {
+	exchange.sequence++
+
+	return fmt.Sprintf("%08x%016x", exchange.GetTimer().NowUTC().Unix(), exchange.sequence)
+}
+
+// matchBook runs one matching pass over the book of the exchanger.
+func (exchange *paperExchange) matchBook() error {
+	exchange.mutex.Lock()
+	defer exchange.mutex.Unlock()
+
+	return exchange.match()
+}
+
+// with is a copy of the exchanger that reads the market data through the
+// exchanger and trades against the book.
+func (exchange *paperExchange) with(
+	exchanger Exchanger,
+	paperBook *paperBook,
+) *paperExchange {
+	newExchange := *exchange
+	newExchange.paperBook = paperBook
+	newExchange.exchanger = exchanger
+	newExchange.apiService = kucoin.NewApiService(kucoin.ApiRequesterOption(&newExchange))
+
+	return &newExchange
+}
+
+func paperList(
+	paperOrders map[string]*paperOrder,
+	filter func(*paperOrder) bool,
+) []*paperOrder {
+	list := make([]*paperOrder, 0, len(paperOrders))
+
+	for _, paperOrder := range paperOrders {
+		if filter(paperOrder) {
+			list = append(list, paperOrder)
+		}
+	}
+
+	sort.Slice(list, func(first, second int) bool {
+		return list[first].id < list[second].id
+	})
+
+	return list
+}
+
+func paperFormat(
+	value float64,
+) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func paperInvalid(
+	message string,
+) *paperResponse {
+	return newPaperErrorResponse(http.StatusOK, object.URIKucoinCodeInvalidParameter, message)
+}
+
+func paperParse(
+	value string,
+) (float64, error) {
+	if value == object.URIEmpty {
+		return 0, nil
+	}
+
+	return strconv.ParseFloat(value, 64)
+}
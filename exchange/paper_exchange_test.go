@@ -0,0 +1,173 @@
+package exchange
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	paperExchangeTestContextKey struct{}
+
+	// paperExchangeTestExchanger answers ServiceStatus with its name and the
+	// value of the context it is bound to.
+	paperExchangeTestExchanger struct {
+		Exchanger
+		ctx  context.Context
+		name string
+	}
+)
+
+// WithContext is a function.
+func (exchange *paperExchangeTestExchanger) WithContext(
+	ctx context.Context,
+) Exchanger {
+	return &paperExchangeTestExchanger{
+		Exchanger: exchange.Exchanger,
+		ctx:       ctx,
+		name:      exchange.name,
+	}
+}
+
+// ServiceStatus is a function.
+func (exchange *paperExchangeTestExchanger) ServiceStatus() (*kucoin.ApiResponse, error) {
+	message := object.URIEmpty
+	if exchange.ctx != nil {
+		message, _ = exchange.ctx.Value(paperExchangeTestContextKey{}).(string)
+	}
+
+	return &kucoin.ApiResponse{
+		Code:    exchange.name,
+		RawData: nil,
+		Message: message,
+	}, nil
+}
+
+func newPaperExchangeTestExchanger(
+	name string,
+) *paperExchangeTestExchanger {
+	return &paperExchangeTestExchanger{
+		Exchanger: nil,
+		ctx:       nil,
+		name:      name,
+	}
+}
+
+func paperExchangeTestOrders(
+	t *testing.T,
+	exchanger Exchanger,
+) int64 {
+	t.Helper()
+
+	kucoinAPIResponse, err := exchanger.Orders(
+		map[string]string{"status": string(object.OrderStateTypeActive)},
+		&kucoin.PaginationParam{CurrentPage: 1, PageSize: 50},
+	)
+	if err != nil {
+		t.Fatalf("Orders() error = %v", err)
+	}
+
+	kucoinOrdersModel := kucoin.OrdersModel{}
+
+	kucoinPaginationModel, err := kucoinAPIResponse.ReadPaginationData(&kucoinOrdersModel)
+	if err != nil {
+		t.Fatalf("ReadPaginationData() error = %v", err)
+	}
+
+	return kucoinPaginationModel.TotalNum
+}
+
+func TestPaperExchangeAccountExchanger(t *testing.T) {
+	t.Parallel()
+
+	paperExchange, err := NewPaperExchange(
+		NewAccountExchange(
+			"main",
+			newPaperExchangeTestExchanger("main"),
+			map[string]Exchanger{"sub": newPaperExchangeTestExchanger("sub")},
+		),
+		NewPaperRecordedQuote(object.NewTime(), map[string][]*kucoin.TickerLevel1Model{
+			"BTC-USDT": {{
+				Sequence:    "1",
+				Price:       "100",
+				Size:        "1",
+				BestBid:     "99",
+				BestBidSize: "1",
+				BestAsk:     "101",
+				BestAskSize: "1",
+				Time:        0,
+			}},
+		}),
+		object.NewTime(),
+		map[string]string{"USDT": "1000"},
+		0,
+		0,
+	)
+	if err != nil {
+		t.Fatalf("NewPaperExchange() error = %v", err)
+	}
+
+	if got := paperExchange.GetAccount(); got != "main" {
+		t.Errorf("GetAccount() = %q, want %q", got, "main")
+	}
+
+	if got := paperExchange.GetAccounts(); !reflect.DeepEqual(got, []string{"main", "sub"}) {
+		t.Errorf("GetAccounts() = %v, want %v", got, []string{"main", "sub"})
+	}
+
+	ctx := context.WithValue(context.Background(), paperExchangeTestContextKey{}, "bound")
+	contextExchanger, ok := paperExchange.GetAccountExchanger("sub").(ContextExchanger)
+	if !ok {
+		t.Fatal("GetAccountExchanger() is not a ContextExchanger")
+	}
+
+	exchanger := contextExchanger.WithContext(ctx)
+
+	// The market data is read through the exchanger of the account, bound to ctx.
+	kucoinAPIResponse, err := exchanger.ServiceStatus()
+	if err != nil {
+		t.Fatalf("ServiceStatus() error = %v", err)
+	}
+
+	if kucoinAPIResponse.Code != "sub" || kucoinAPIResponse.Message != "bound" {
+		t.Errorf(
+			"ServiceStatus() = %q, %q, want %q, %q",
+			kucoinAPIResponse.Code,
+			kucoinAPIResponse.Message,
+			"sub",
+			"bound",
+		)
+	}
+
+	if _, err = exchanger.CreateOrder(&kucoin.CreateOrderModel{
+		ClientOid: "client",
+		Side:      string(object.OrderSideTypeBuy),
+		Symbol:    "BTC-USDT",
+		Type:      string(object.OrderTypeTypeLimit),
+		Price:     "90",
+		Size:      "1",
+	}); err != nil {
+		t.Fatalf("CreateOrder() error = %v", err)
+	}
+
+	// Each account trades against a book of its own.
+	if got := paperExchangeTestOrders(t, paperExchange.GetAccountExchanger("sub")); got != 1 {
+		t.Errorf("Orders() of sub = %d, want 1", got)
+	}
+
+	if got := paperExchangeTestOrders(t, paperExchange); got != 0 {
+		t.Errorf("Orders() of main = %d, want 0", got)
+	}
+
+	if err = paperExchange.Match(); err != nil {
+		t.Errorf("Match() error = %v", err)
+	}
+
+	// An account that is not served fails rather than trade on another book.
+	if _, err = paperExchange.GetAccountExchanger("other").ServiceStatus(); err == nil {
+		t.Errorf("ServiceStatus() of other error = nil, want %v", object.ErrExchangeAccountUnknown)
+	}
+}
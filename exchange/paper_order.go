@@ -0,0 +1,582 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	paperOrder struct {
+		base         string
+		clientOID    string
+		holdCurrency string
+		id           string
+		quote        string
+		remark       string
+		stp          string
+		symbol       string
+		tradeType    string
+		kucoinType   object.OrderTypeType
+		side         object.OrderSideType
+		stop         object.OrderStopType
+		timeInForce  object.TimeInForceType
+		cancelAfter  int64
+		createdAt    int64
+		dealFunds    float64
+		dealSize     float64
+		fee          float64
+		funds        float64
+		hold         float64
+		price        float64
+		size         float64
+		stopPrice    float64
+		visibleSize  float64
+		cancelExist  bool
+		hidden       bool
+		iceBerg      bool
+		isActive     bool
+		postOnly     bool
+		triggered    bool
+	}
+)
+
+// newPaperOrder validates the request the way the exchange does before anything is held.
+func newPaperOrder(
+	kucoinCreateOrderModel *kucoin.CreateOrderModel,
+	id string,
+	createdAt int64,
+) (*paperOrder, error) {
+	base, quote, ok := strings.Cut(kucoinCreateOrderModel.Symbol, object.URIKucoinSymbolSeparator)
+	if !ok || kucoinCreateOrderModel.ClientOid == object.URIEmpty {
+		return nil, fmt.Errorf(
+			"invalid symbol %q or clientOid %q",
+			kucoinCreateOrderModel.Symbol,
+			kucoinCreateOrderModel.ClientOid,
+		)
+	}
+
+	side := object.OrderSideType(kucoinCreateOrderModel.Side)
+	if side != object.OrderSideTypeBuy && side != object.OrderSideTypeSell {
+		return nil, fmt.Errorf("invalid side %q", kucoinCreateOrderModel.Side)
+	}
+
+	kucoinType := object.OrderTypeType(kucoinCreateOrderModel.Type)
+	if kucoinType == object.OrderTypeType(object.URIEmpty) {
+		kucoinType = object.OrderTypeTypeLimit
+	}
+
+	timeInForce := object.TimeInForceType(kucoinCreateOrderModel.TimeInForce)
+	if timeInForce == object.TimeInForceType(object.URIEmpty) {
+		timeInForce = object.TimeInForceTypeGTC
+	}
+
+	tradeType := kucoinCreateOrderModel.TradeType
+	if tradeType == object.URIEmpty {
+		tradeType = string(object.OrderTypeTypeTrade)
+	}
+
+	numbers := make([]float64, 0, 5)
+
+	for _, value := range []string{
+		kucoinCreateOrderModel.Funds,
+		kucoinCreateOrderModel.Price,
+		kucoinCreateOrderModel.Size,
+		kucoinCreateOrderModel.StopPrice,
+		kucoinCreateOrderModel.VisibleSize,
+	} {
+		number, err := paperParse(value)
+		if err != nil || number < 0 {
+			return nil, fmt.Errorf("invalid number %q", value)
+		}
+
+		numbers = append(numbers, number)
+	}
+
+	funds, price, size, stopPrice, visibleSize := numbers[0], numbers[1], numbers[2], numbers[3],
+		numbers[4]
+
+	stop := object.OrderStopType(kucoinCreateOrderModel.Stop)
+	switch stop {
+	case object.OrderStopType(object.URIEmpty):
+		stopPrice = 0
+	case object.OrderStopTypeEntry, object.OrderStopTypeLoss:
+		if stopPrice == 0 {
+			return nil, errors.New("stop order needs stopPrice")
+		}
+	default:
+		return nil, fmt.Errorf("invalid stop %q", kucoinCreateOrderModel.Stop)
+	}
+
+	switch kucoinType {
+	case object.OrderTypeTypeLimit:
+		if price == 0 || size == 0 {
+			return nil, errors.New("limit order needs price and size")
+		}
+
+		switch timeInForce {
+		case object.TimeInForceTypeFOK,
+			object.TimeInForceTypeGTC,
+			object.TimeInForceTypeGTT,
+			object.TimeInForceTypeIOC:
+		default:
+			return nil, fmt.Errorf("invalid timeInForce %q", timeInForce)
+		}
+	case object.OrderTypeTypeMarket:
+		if (funds == 0) == (size == 0) {
+			return nil, errors.New("market order needs either funds or size")
+		}
+
+		price = 0
+		timeInForce = object.TimeInForceTypeGTC
+	default:
+		return nil, fmt.Errorf("invalid type %q", kucoinCreateOrderModel.Type)
+	}
+
+	return &paperOrder{
+		base:         base,
+		clientOID:    kucoinCreateOrderModel.ClientOid,
+		holdCurrency: object.URIEmpty,
+		id:           id,
+		quote:        quote,
+		remark:       kucoinCreateOrderModel.Remark,
+		stp:          kucoinCreateOrderModel.STP,
+		symbol:       kucoinCreateOrderModel.Symbol,
+		tradeType:    tradeType,
+		kucoinType:   kucoinType,
+		side:         side,
+		stop:         stop,
+		timeInForce:  timeInForce,
+		cancelAfter:  kucoinCreateOrderModel.CancelAfter,
+		createdAt:    createdAt,
+		dealFunds:    0,
+		dealSize:     0,
+		fee:          0,
+		funds:        funds,
+		hold:         0,
+		price:        price,
+		size:         size,
+		stopPrice:    stopPrice,
+		visibleSize:  visibleSize,
+		cancelExist:  false,
+		hidden:       kucoinCreateOrderModel.Hidden,
+		iceBerg:      kucoinCreateOrderModel.IceBerg,
+		isActive:     true,
+		postOnly:     kucoinCreateOrderModel.PostOnly,
+		triggered:    false,
+	}, nil
+}
+
+// model renders the order the way the exchange reports it.
+func (order *paperOrder) model() *kucoin.OrderModel {
+	return &kucoin.OrderModel{
+		Id:            order.id,
+		Symbol:        order.symbol,
+		OpType:        object.URIKucoinOrderOPTypeDeal,
+		Type:          string(order.kucoinType),
+		Side:          string(order.side),
+		Price:         paperFormat(order.price),
+		Size:          paperFormat(order.size),
+		Funds:         paperFormat(order.funds),
+		DealFunds:     paperFormat(order.dealFunds),
+		DealSize:      paperFormat(order.dealSize),
+		Fee:           paperFormat(order.fee),
+		FeeCurrency:   order.quote,
+		Stp:           order.stp,
+		Stop:          string(order.stop),
+		StopTriggered: order.triggered,
+		StopPrice:     paperFormat(order.stopPrice),
+		TimeInForce:   string(order.timeInForce),
+		PostOnly:      order.postOnly,
+		Hidden:        order.hidden,
+		IceBerg:       order.iceBerg,
+		VisibleSize:   paperFormat(order.visibleSize),
+		CancelAfter:   order.cancelAfter,
+		Channel:       object.URIKucoinOrderChannelAPI,
+		ClientOid:     order.clientOID,
+		Remark:        order.remark,
+		Tags:          object.URIEmpty,
+		IsActive:      order.isActive,
+		CancelExist:   order.cancelExist,
+		CreatedAt:     order.createdAt,
+		TradeType:     order.tradeType,
+	}
+}
+
+// stopModel renders the stop order the way the exchange reports it before it triggers.
+func (order *paperOrder) stopModel() *kucoin.StopOrderModel {
+	return &kucoin.StopOrderModel{
+		Id:              order.id,
+		Symbol:          order.symbol,
+		UserId:          object.URIEmpty,
+		Status:          object.URIKucoinStopOrderStatusNew,
+		Type:            string(order.kucoinType),
+		Side:            string(order.side),
+		Price:           paperFormat(order.price),
+		Size:            paperFormat(order.size),
+		Funds:           paperFormat(order.funds),
+		Stp:             order.stp,
+		TimeInForce:     string(order.timeInForce),
+		CancelAfter:     order.cancelAfter,
+		PostOnly:        order.postOnly,
+		Hidden:          order.hidden,
+		IceBerg:         order.iceBerg,
+		VisibleSize:     paperFormat(order.visibleSize),
+		Channel:         object.URIKucoinOrderChannelAPI,
+		ClientOid:       order.clientOID,
+		Remark:          order.remark,
+		Tags:            object.URIEmpty,
+		OrderTime:       order.createdAt * int64(time.Millisecond),
+		DomainId:        object.URIEmpty,
+		TradeSource:     object.URIEmpty,
+		TradeType:       order.tradeType,
+		FeeCurrency:     order.quote,
+		TakerFeeRate:    object.URIEmpty,
+		MakerFeeRate:    object.URIEmpty,
+		CreatedAt:       order.createdAt,
+		Stop:            string(order.stop),
+		StopTriggerTime: object.URIEmpty,
+		StopPrice:       paperFormat(order.stopPrice),
+	}
+}
+
+// remaining is the size left to fill, or the funds left to spend for a market order by funds.
+func (order *paperOrder) remaining() float64 {
+	if order.funds != 0 && order.side == object.OrderSideTypeBuy {
+		return order.funds - order.dealFunds - order.fee
+	}
+
+	if order.funds != 0 {
+		return order.funds - order.dealFunds
+	}
+
+	return order.size - order.dealSize
+}
+
+// done closes the order and releases what is left of its hold.
+func (exchange *paperExchange) done(
+	paperOrder *paperOrder,
+) {
+	paperOrder.isActive = false
+	paperOrder.cancelExist = paperOrder.remaining() > object.NUMPaperEpsilon
+	exchange.account(paperOrder.holdCurrency).holds -= paperOrder.hold
+	paperOrder.hold = 0
+}
+
+// fill trades the size at the price, charges the fee in the quote currency,
+// releases the matching part of the hold and records the trade.
+func (exchange *paperExchange) fill(
+	paperOrder *paperOrder,
+	price float64,
+	size float64,
+	feeRate float64,
+	liquidity string,
+) {
+	if size <= object.NUMPaperEpsilon {
+		return
+	}
+
+	funds := price * size
+	fee := funds * feeRate
+	baseAccount := exchange.account(paperOrder.base)
+	quoteAccount := exchange.account(paperOrder.quote)
+	release := size
+
+	if paperOrder.side == object.OrderSideTypeBuy {
+		quoteAccount.balance -= funds + fee
+		baseAccount.balance += size
+		release = funds + fee
+	} else {
+		baseAccount.balance -= size
+		quoteAccount.balance += funds - fee
+	}
+
+	release = math.Min(release, paperOrder.hold)
+	exchange.account(paperOrder.holdCurrency).holds -= release
+	paperOrder.hold -= release
+	paperOrder.dealFunds += funds
+	paperOrder.dealSize += size
+	paperOrder.fee += fee
+	exchange.fills = append(exchange.fills, &kucoin.FillModel{
+		Symbol:         paperOrder.symbol,
+		TradeId:        exchange.nextID(),
+		OrderId:        paperOrder.id,
+		CounterOrderId: object.URIEmpty,
+		Side:           string(paperOrder.side),
+		Liquidity:      liquidity,
+		ForceTaker:     false,
+		Price:          paperFormat(price),
+		Size:           paperFormat(size),
+		Funds:          paperFormat(funds),
+		Fee:            paperFormat(fee),
+		FeeRate:        paperFormat(feeRate),
+		FeeCurrency:    paperOrder.quote,
+		Stop:           string(paperOrder.stop),
+		Type:           string(paperOrder.kucoinType),
+		CreatedAt:      exchange.GetTimer().NowUTC().UnixMilli(),
+		TradeType:      paperOrder.tradeType,
+	})
+
+	if paperOrder.remaining() <= object.NUMPaperEpsilon {
+		exchange.done(paperOrder)
+	}
+}
+
+// hold returns the amount and the currency an order locks while it is open.
+// A buy locks its quote cost with the taker fee on top, and a sell locks its size,
+// both priced at the top of book for a market order.
+func (exchange *paperExchange) hold(
+	paperOrder *paperOrder,
+	kucoinTickerLevel1Model *kucoin.TickerLevel1Model,
+) (float64, string, error) {
+	price := paperOrder.price
+	if paperOrder.kucoinType == object.OrderTypeTypeMarket {
+		top, _, err := paperTop(paperOrder.side, kucoinTickerLevel1Model)
+		if err != nil {
+			return 0, object.URIEmpty, err
+		}
+
+		price = top
+	}
+
+	switch {
+	case paperOrder.side == object.OrderSideTypeSell && paperOrder.funds != 0:
+		return paperOrder.funds / price, paperOrder.base, nil
+	case paperOrder.side == object.OrderSideTypeSell:
+		return paperOrder.size, paperOrder.base, nil
+	case paperOrder.funds != 0:
+		return paperOrder.funds, paperOrder.quote, nil
+	}
+
+	return price * paperOrder.size * (1 + exchange.takerFeeRate), paperOrder.quote, nil
+}
+
+// match triggers the stop orders whose stop price was reached, then fills the
+// resting orders as makers at their own price once the top of book trades
+// through it, and expires the good-till-time ones.
+// An iceberg fills at most its visible size per pass; hidden orders fill like
+// any other since the simulator has no book to hide them from.
+func (exchange *paperExchange) match() error {
+	nowUnixMilli := exchange.GetTimer().NowUTC().UnixMilli()
+	quotes := map[string]*kucoin.TickerLevel1Model{}
+	errs := []error{}
+
+	for _, paperOrder := range exchange.listStop(func(*paperOrder) bool {
+		return true
+	}) {
+		kucoinTickerLevel1Model, ok := quotes[paperOrder.symbol]
+		if !ok {
+			var err error
+
+			kucoinTickerLevel1Model, err = exchange.GetPaperQuoter().Quote(paperOrder.symbol)
+			if err != nil {
+				errs = append(errs, err)
+
+				continue
+			}
+
+			quotes[paperOrder.symbol] = kucoinTickerLevel1Model
+		}
+
+		reached, err := paperStopReached(paperOrder, kucoinTickerLevel1Model)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		if reached {
+			exchange.trigger(paperOrder)
+		}
+	}
+
+	for _, paperOrder := range exchange.list(func(paperOrder *paperOrder) bool {
+		return paperOrder.isActive
+	}) {
+		if paperOrder.timeInForce == object.TimeInForceTypeGTT &&
+			paperOrder.cancelAfter > 0 &&
+			nowUnixMilli >= paperOrder.createdAt+paperOrder.cancelAfter*1000 {
+			exchange.done(paperOrder)
+
+			continue
+		}
+
+		kucoinTickerLevel1Model, ok := quotes[paperOrder.symbol]
+		if !ok {
+			var err error
+
+			kucoinTickerLevel1Model, err = exchange.GetPaperQuoter().Quote(paperOrder.symbol)
+			if err != nil {
+				errs = append(errs, err)
+
+				continue
+			}
+
+			quotes[paperOrder.symbol] = kucoinTickerLevel1Model
+		}
+
+		price, topSize, err := paperTop(paperOrder.side, kucoinTickerLevel1Model)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		if !paperCrossed(paperOrder, price) {
+			continue
+		}
+
+		size := math.Min(paperOrder.remaining(), topSize)
+		if paperOrder.iceBerg && paperOrder.visibleSize != 0 {
+			size = math.Min(size, paperOrder.visibleSize)
+		}
+
+		exchange.fill(
+			paperOrder,
+			paperOrder.price,
+			size,
+			exchange.makerFeeRate,
+			object.URIKucoinFillLiquidityMaker,
+		)
+	}
+
+	return errors.Join(errs...)
+}
+
+// take runs a new order against the top of book as a taker. Market orders and
+// immediate-or-cancel orders never rest, fill-or-kill orders need the whole size
+// at the top, and post-only orders that would take are cancelled instead.
+func (exchange *paperExchange) take(
+	paperOrder *paperOrder,
+	kucoinTickerLevel1Model *kucoin.TickerLevel1Model,
+) error {
+	price, topSize, err := paperTop(paperOrder.side, kucoinTickerLevel1Model)
+	if err != nil {
+		exchange.done(paperOrder)
+
+		return err
+	}
+
+	if paperOrder.kucoinType == object.OrderTypeTypeMarket {
+		size := paperOrder.size
+
+		switch {
+		case paperOrder.funds != 0 && paperOrder.side == object.OrderSideTypeBuy:
+			size = paperOrder.funds / (price * (1 + exchange.takerFeeRate))
+		case paperOrder.funds != 0:
+			size = paperOrder.funds / price
+		}
+
+		exchange.fill(
+			paperOrder,
+			price,
+			math.Min(size, topSize),
+			exchange.takerFeeRate,
+			object.URIKucoinFillLiquidityTaker,
+		)
+
+		if paperOrder.isActive {
+			exchange.done(paperOrder)
+		}
+
+		return nil
+	}
+
+	switch {
+	case !paperCrossed(paperOrder, price):
+		if paperOrder.timeInForce == object.TimeInForceTypeFOK ||
+			paperOrder.timeInForce == object.TimeInForceTypeIOC {
+			exchange.done(paperOrder)
+		}
+	case paperOrder.postOnly:
+		exchange.done(paperOrder)
+	case paperOrder.timeInForce == object.TimeInForceTypeFOK &&
+		topSize+object.NUMPaperEpsilon < paperOrder.remaining():
+		exchange.done(paperOrder)
+	default:
+		exchange.fill(
+			paperOrder,
+			price,
+			math.Min(paperOrder.remaining(), topSize),
+			exchange.takerFeeRate,
+			object.URIKucoinFillLiquidityTaker,
+		)
+
+		if paperOrder.isActive && paperOrder.timeInForce == object.TimeInForceTypeIOC {
+			exchange.done(paperOrder)
+		}
+	}
+
+	return nil
+}
+
+// paperCrossed reports whether the opposite top of book reaches the limit price.
+func paperCrossed(
+	paperOrder *paperOrder,
+	price float64,
+) bool {
+	if paperOrder.side == object.OrderSideTypeBuy {
+		return price <= paperOrder.price
+	}
+
+	return price >= paperOrder.price
+}
+
+// paperStopReached reports whether the last trade price reached the stop price.
+// A loss stop triggers at or below the stop price and an entry stop at or above
+// it; without a last price the opposite top of book is used.
+func paperStopReached(
+	paperOrder *paperOrder,
+	kucoinTickerLevel1Model *kucoin.TickerLevel1Model,
+) (bool, error) {
+	price, err := paperParse(kucoinTickerLevel1Model.Price)
+	if err != nil {
+		return false, err
+	}
+
+	if price <= 0 {
+		price, _, err = paperTop(paperOrder.side, kucoinTickerLevel1Model)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if paperOrder.stop == object.OrderStopTypeLoss {
+		return price <= paperOrder.stopPrice, nil
+	}
+
+	return price >= paperOrder.stopPrice, nil
+}
+
+// paperTop returns the opposite side of the book: the best ask for a buy and
+// the best bid for a sell.
+func paperTop(
+	side object.OrderSideType,
+	kucoinTickerLevel1Model *kucoin.TickerLevel1Model,
+) (float64, float64, error) {
+	price, size := kucoinTickerLevel1Model.BestBid, kucoinTickerLevel1Model.BestBidSize
+	if side == object.OrderSideTypeBuy {
+		price, size = kucoinTickerLevel1Model.BestAsk, kucoinTickerLevel1Model.BestAskSize
+	}
+
+	topPrice, err := paperParse(price)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	topSize, err := paperParse(size)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if topPrice <= 0 || topSize <= 0 {
+		return 0, 0, object.ErrPaperQuoteNotFound
+	}
+
+	return topPrice, topSize, nil
+}
@@ -0,0 +1,437 @@
+package exchange
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// paperOrderTestQuoter quotes one top of book that the test moves.
+	paperOrderTestQuoter struct {
+		kucoinTickerLevel1Model *kucoin.TickerLevel1Model
+		mutex                   sync.Mutex
+	}
+)
+
+// Quote is a function.
+func (quoter *paperOrderTestQuoter) Quote(
+	_ string,
+) (*kucoin.TickerLevel1Model, error) {
+	quoter.mutex.Lock()
+	defer quoter.mutex.Unlock()
+
+	kucoinTickerLevel1Model := *quoter.kucoinTickerLevel1Model
+
+	return &kucoinTickerLevel1Model, nil
+}
+
+func (quoter *paperOrderTestQuoter) set(
+	price string,
+	bestBid string,
+	bestAsk string,
+) {
+	quoter.mutex.Lock()
+	defer quoter.mutex.Unlock()
+
+	quoter.kucoinTickerLevel1Model = &kucoin.TickerLevel1Model{
+		Sequence:    "1",
+		Price:       price,
+		Size:        "1",
+		BestBid:     bestBid,
+		BestBidSize: "10",
+		BestAsk:     bestAsk,
+		BestAskSize: "10",
+		Time:        0,
+	}
+}
+
+func newPaperOrderTest(
+	t *testing.T,
+) (*paperExchange, *paperOrderTestQuoter) {
+	t.Helper()
+
+	paperOrderTestQuoter := &paperOrderTestQuoter{
+		kucoinTickerLevel1Model: nil,
+		mutex:                   sync.Mutex{},
+	}
+	paperOrderTestQuoter.set("100", "99", "101")
+
+	paperExchange, err := NewPaperExchange(
+		nil,
+		paperOrderTestQuoter,
+		object.NewTime(),
+		map[string]string{"BTC": "1", "USDT": "10000"},
+		0.001,
+		0.002,
+	)
+	if err != nil {
+		t.Fatalf("NewPaperExchange() error = %v", err)
+	}
+
+	return paperExchange, paperOrderTestQuoter
+}
+
+func newPaperOrderTestCreateOrderModel(
+	side object.OrderSideType,
+	kucoinType object.OrderTypeType,
+	price string,
+	size string,
+) *kucoin.CreateOrderModel {
+	return &kucoin.CreateOrderModel{
+		ClientOid: "client",
+		Side:      string(side),
+		Symbol:    "BTC-USDT",
+		Type:      string(kucoinType),
+		Price:     price,
+		Size:      size,
+	}
+}
+
+func paperOrderTestCreateOrder(
+	t *testing.T,
+	exchanger Exchanger,
+	kucoinCreateOrderModel *kucoin.CreateOrderModel,
+) *kucoin.OrderModel {
+	t.Helper()
+
+	kucoinAPIResponse, err := exchanger.CreateOrder(kucoinCreateOrderModel)
+	if err = NewResponseError(kucoinAPIResponse, err); err != nil {
+		t.Fatalf("CreateOrder() error = %v", err)
+	}
+
+	kucoinCreateOrderResultModel := kucoin.CreateOrderResultModel{}
+	if err = kucoinAPIResponse.ReadData(&kucoinCreateOrderResultModel); err != nil {
+		t.Fatalf("ReadData() error = %v", err)
+	}
+
+	return paperOrderTestOrder(t, exchanger, kucoinCreateOrderResultModel.OrderId)
+}
+
+func paperOrderTestOrder(
+	t *testing.T,
+	exchanger Exchanger,
+	orderID string,
+) *kucoin.OrderModel {
+	t.Helper()
+
+	kucoinAPIResponse, err := exchanger.Order(orderID)
+	if err = NewResponseError(kucoinAPIResponse, err); err != nil {
+		t.Fatalf("Order() error = %v", err)
+	}
+
+	kucoinOrderModel := &kucoin.OrderModel{}
+	if err = kucoinAPIResponse.ReadData(kucoinOrderModel); err != nil {
+		t.Fatalf("ReadData() error = %v", err)
+	}
+
+	return kucoinOrderModel
+}
+
+func paperOrderTestAccount(
+	t *testing.T,
+	exchanger Exchanger,
+	currency string,
+) (float64, float64) {
+	t.Helper()
+
+	kucoinAPIResponse, err := exchanger.Accounts(currency, object.URIKucoinAccountTypeTrade)
+	if err = NewResponseError(kucoinAPIResponse, err); err != nil {
+		t.Fatalf("Accounts() error = %v", err)
+	}
+
+	kucoinAccountsModel := kucoin.AccountsModel{}
+	if err = kucoinAPIResponse.ReadData(&kucoinAccountsModel); err != nil {
+		t.Fatalf("ReadData() error = %v", err)
+	}
+
+	if len(kucoinAccountsModel) != 1 {
+		t.Fatalf("Accounts() = %d, want 1", len(kucoinAccountsModel))
+	}
+
+	balance, _ := paperParse(kucoinAccountsModel[0].Balance)
+	holds, _ := paperParse(kucoinAccountsModel[0].Holds)
+
+	return balance, holds
+}
+
+func paperOrderTestEqual(
+	first float64,
+	second string,
+) bool {
+	value, err := paperParse(second)
+
+	return err == nil && math.Abs(first-value) < object.NUMPaperEpsilon
+}
+
+func TestPaperExchangeCreateOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                   string
+		kucoinCreateOrderModel *kucoin.CreateOrderModel
+		dealSize               float64
+		price                  float64
+		isActive               bool
+		cancelExist            bool
+	}{
+		{
+			name: "market buy takes the ask",
+			kucoinCreateOrderModel: newPaperOrderTestCreateOrderModel(
+				object.OrderSideTypeBuy,
+				object.OrderTypeTypeMarket,
+				object.URIEmpty,
+				"2",
+			),
+			dealSize:    2,
+			price:       101,
+			isActive:    false,
+			cancelExist: false,
+		},
+		{
+			name: "limit sell through the bid takes it",
+			kucoinCreateOrderModel: newPaperOrderTestCreateOrderModel(
+				object.OrderSideTypeSell,
+				object.OrderTypeTypeLimit,
+				"98",
+				"0.5",
+			),
+			dealSize:    0.5,
+			price:       99,
+			isActive:    false,
+			cancelExist: false,
+		},
+		{
+			name: "limit buy below the ask rests",
+			kucoinCreateOrderModel: newPaperOrderTestCreateOrderModel(
+				object.OrderSideTypeBuy,
+				object.OrderTypeTypeLimit,
+				"95",
+				"1",
+			),
+			dealSize:    0,
+			price:       0,
+			isActive:    true,
+			cancelExist: false,
+		},
+		{
+			name: "post only that would take is cancelled",
+			kucoinCreateOrderModel: func() *kucoin.CreateOrderModel {
+				kucoinCreateOrderModel := newPaperOrderTestCreateOrderModel(
+					object.OrderSideTypeBuy,
+					object.OrderTypeTypeLimit,
+					"101",
+					"1",
+				)
+				kucoinCreateOrderModel.PostOnly = true
+
+				return kucoinCreateOrderModel
+			}(),
+			dealSize:    0,
+			price:       0,
+			isActive:    false,
+			cancelExist: true,
+		},
+		{
+			name: "fill or kill larger than the top is cancelled",
+			kucoinCreateOrderModel: func() *kucoin.CreateOrderModel {
+				kucoinCreateOrderModel := newPaperOrderTestCreateOrderModel(
+					object.OrderSideTypeBuy,
+					object.OrderTypeTypeLimit,
+					"101",
+					"11",
+				)
+				kucoinCreateOrderModel.TimeInForce = string(object.TimeInForceTypeFOK)
+
+				return kucoinCreateOrderModel
+			}(),
+			dealSize:    0,
+			price:       0,
+			isActive:    false,
+			cancelExist: true,
+		},
+		{
+			name: "immediate or cancel fills the top and cancels the rest",
+			kucoinCreateOrderModel: func() *kucoin.CreateOrderModel {
+				kucoinCreateOrderModel := newPaperOrderTestCreateOrderModel(
+					object.OrderSideTypeBuy,
+					object.OrderTypeTypeLimit,
+					"101",
+					"12",
+				)
+				kucoinCreateOrderModel.TimeInForce = string(object.TimeInForceTypeIOC)
+
+				return kucoinCreateOrderModel
+			}(),
+			dealSize:    10,
+			price:       101,
+			isActive:    false,
+			cancelExist: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			paperExchange, _ := newPaperOrderTest(t)
+			kucoinOrderModel := paperOrderTestCreateOrder(t, paperExchange, test.kucoinCreateOrderModel)
+
+			if kucoinOrderModel.IsActive != test.isActive ||
+				kucoinOrderModel.CancelExist != test.cancelExist ||
+				!paperOrderTestEqual(test.dealSize, kucoinOrderModel.DealSize) {
+				t.Errorf(
+					"Order() = active %v, cancelExist %v, dealSize %s, want %v, %v, %v",
+					kucoinOrderModel.IsActive,
+					kucoinOrderModel.CancelExist,
+					kucoinOrderModel.DealSize,
+					test.isActive,
+					test.cancelExist,
+					test.dealSize,
+				)
+			}
+
+			// A taker pays the taker fee on what it traded.
+			dealFunds := test.dealSize * test.price
+			if !paperOrderTestEqual(dealFunds, kucoinOrderModel.DealFunds) ||
+				!paperOrderTestEqual(dealFunds*0.002, kucoinOrderModel.Fee) {
+				t.Errorf(
+					"Order() = dealFunds %s, fee %s, want %v, %v",
+					kucoinOrderModel.DealFunds,
+					kucoinOrderModel.Fee,
+					dealFunds,
+					dealFunds*0.002,
+				)
+			}
+		})
+	}
+}
+
+func TestPaperExchangeMatch(t *testing.T) {
+	t.Parallel()
+
+	paperExchange, paperOrderTestQuoter := newPaperOrderTest(t)
+	kucoinOrderModel := paperOrderTestCreateOrder(t, paperExchange, newPaperOrderTestCreateOrderModel(
+		object.OrderSideTypeBuy,
+		object.OrderTypeTypeLimit,
+		"95",
+		"2",
+	))
+
+	// The resting buy holds its cost with the taker fee on top.
+	balance, holds := paperOrderTestAccount(t, paperExchange, "USDT")
+	if balance != 10000 || math.Abs(holds-95*2*1.002) > object.NUMPaperEpsilon {
+		t.Errorf("Accounts() = %v, %v, want %v, %v", balance, holds, 10000, 95*2*1.002)
+	}
+
+	paperOrderTestQuoter.set("94", "93", "94")
+
+	if err := paperExchange.Match(); err != nil {
+		t.Fatalf("Match() error = %v", err)
+	}
+
+	// It fills as a maker at its own price once the ask trades through it.
+	kucoinOrderModel = paperOrderTestOrder(t, paperExchange, kucoinOrderModel.Id)
+	if kucoinOrderModel.IsActive ||
+		!paperOrderTestEqual(2, kucoinOrderModel.DealSize) ||
+		!paperOrderTestEqual(190, kucoinOrderModel.DealFunds) ||
+		!paperOrderTestEqual(190*0.001, kucoinOrderModel.Fee) {
+		t.Errorf("Order() = %+v, want filled at 95 as a maker", kucoinOrderModel)
+	}
+
+	balance, holds = paperOrderTestAccount(t, paperExchange, "USDT")
+	if math.Abs(balance-(10000-190*1.001)) > object.NUMPaperEpsilon || holds > object.NUMPaperEpsilon {
+		t.Errorf("Accounts() of USDT = %v, %v, want %v, 0", balance, holds, 10000-190*1.001)
+	}
+
+	if balance, _ = paperOrderTestAccount(t, paperExchange, "BTC"); balance != 3 {
+		t.Errorf("Accounts() of BTC = %v, want 3", balance)
+	}
+}
+
+func TestPaperExchangeCreateOrderBalanceInsufficient(t *testing.T) {
+	t.Parallel()
+
+	paperExchange, _ := newPaperOrderTest(t)
+
+	err := NewResponseError(paperExchange.CreateOrder(newPaperOrderTestCreateOrderModel(
+		object.OrderSideTypeSell,
+		object.OrderTypeTypeLimit,
+		"200",
+		"2",
+	)))
+	if !errors.Is(err, object.ErrKucoinBalanceInsufficient) {
+		t.Fatalf("CreateOrder() error = %v, want %v", err, object.ErrKucoinBalanceInsufficient)
+	}
+
+	// Nothing is held for a refused order, and its clientOid stays free.
+	if _, holds := paperOrderTestAccount(t, paperExchange, "BTC"); holds != 0 {
+		t.Errorf("Accounts() holds = %v, want 0", holds)
+	}
+
+	paperOrderTestCreateOrder(t, paperExchange, newPaperOrderTestCreateOrderModel(
+		object.OrderSideTypeSell,
+		object.OrderTypeTypeLimit,
+		"200",
+		"1",
+	))
+
+	err = NewResponseError(paperExchange.CreateOrder(newPaperOrderTestCreateOrderModel(
+		object.OrderSideTypeSell,
+		object.OrderTypeTypeLimit,
+		"200",
+		"1",
+	)))
+	if !errors.Is(err, object.ErrKucoinClientOIDDuplicated) {
+		t.Errorf("CreateOrder() error = %v, want %v", err, object.ErrKucoinClientOIDDuplicated)
+	}
+}
+
+func TestPaperExchangeCreateStopOrder(t *testing.T) {
+	t.Parallel()
+
+	paperExchange, paperOrderTestQuoter := newPaperOrderTest(t)
+	kucoinCreateOrderModel := newPaperOrderTestCreateOrderModel(
+		object.OrderSideTypeSell,
+		object.OrderTypeTypeMarket,
+		object.URIEmpty,
+		"1",
+	)
+	kucoinCreateOrderModel.Stop = string(object.OrderStopTypeLoss)
+	kucoinCreateOrderModel.StopPrice = "90"
+
+	kucoinAPIResponse, err := paperExchange.CreateStopOrder(kucoinCreateOrderModel)
+	if err = NewResponseError(kucoinAPIResponse, err); err != nil {
+		t.Fatalf("CreateStopOrder() error = %v", err)
+	}
+
+	kucoinCreateOrderResultModel := kucoin.CreateOrderResultModel{}
+	if err = kucoinAPIResponse.ReadData(&kucoinCreateOrderResultModel); err != nil {
+		t.Fatalf("ReadData() error = %v", err)
+	}
+
+	// The stop is not an order until the last price reaches it.
+	if err = NewResponseError(paperExchange.Order(kucoinCreateOrderResultModel.OrderId)); err == nil {
+		t.Errorf("Order() error = nil before the stop price is reached")
+	}
+
+	paperOrderTestQuoter.set("90", "89", "91")
+
+	if err = paperExchange.Match(); err != nil {
+		t.Fatalf("Match() error = %v", err)
+	}
+
+	kucoinOrderModel := paperOrderTestOrder(t, paperExchange, kucoinCreateOrderResultModel.OrderId)
+	if !kucoinOrderModel.StopTriggered ||
+		kucoinOrderModel.IsActive ||
+		!paperOrderTestEqual(1, kucoinOrderModel.DealSize) ||
+		!paperOrderTestEqual(89, kucoinOrderModel.DealFunds) {
+		t.Errorf("Order() = %+v, want triggered and sold at the bid 89", kucoinOrderModel)
+	}
+}
@@ -0,0 +1,133 @@
+package exchange
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// PaperQuoter is an interface.
+	PaperQuoter interface {
+		// Quote is a function.
+		Quote(
+			string,
+		) (*kucoin.TickerLevel1Model, error)
+	}
+
+	paperLiveQuote struct {
+		exchanger Exchanger
+	}
+
+	paperRecordedQuote struct {
+		objectTimer object.Timer
+		quotes      map[string][]*kucoin.TickerLevel1Model
+	}
+)
+
+var (
+	_ PaperQuoter     = (*paperLiveQuote)(nil)
+	_ PaperQuoter     = (*paperRecordedQuote)(nil)
+	_ GetExchanger    = (*paperLiveQuote)(nil)
+	_ object.GetTimer = (*paperRecordedQuote)(nil)
+)
+
+// NewPaperLiveQuote is a function.
+// It reads the top of book from the level1 ticker of the exchange.
+func NewPaperLiveQuote(
+	exchanger Exchanger,
+) *paperLiveQuote {
+	return &paperLiveQuote{
+		exchanger: exchanger,
+	}
+}
+
+// GetExchanger is a function.
+func (quote *paperLiveQuote) GetExchanger() Exchanger {
+	return quote.exchanger
+}
+
+// Quote is a function.
+func (quote *paperLiveQuote) Quote(
+	symbol string,
+) (*kucoin.TickerLevel1Model, error) {
+	response, err := quote.GetExchanger().TickerLevel1(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	kucoinTickerLevel1Model := &kucoin.TickerLevel1Model{}
+	if err = response.ReadData(kucoinTickerLevel1Model); err != nil {
+		return nil, err
+	}
+
+	if kucoinTickerLevel1Model.BestAsk == object.URIEmpty &&
+		kucoinTickerLevel1Model.BestBid == object.URIEmpty {
+		return nil, object.ErrPaperQuoteNotFound
+	}
+
+	return kucoinTickerLevel1Model, nil
+}
+
+// NewPaperRecordedQuote is a function.
+// The quotes of every symbol are sorted by time, and the last one taken at or
+// before the timer is returned.
+func NewPaperRecordedQuote(
+	objectTimer object.Timer,
+	quotes map[string][]*kucoin.TickerLevel1Model,
+) *paperRecordedQuote {
+	for _, kucoinTickerLevel1Models := range quotes {
+		sort.SliceStable(kucoinTickerLevel1Models, func(first, second int) bool {
+			return kucoinTickerLevel1Models[first].Time < kucoinTickerLevel1Models[second].Time
+		})
+	}
+
+	return &paperRecordedQuote{
+		objectTimer: objectTimer,
+		quotes:      quotes,
+	}
+}
+
+// LoadPaperRecordedQuotes is a function.
+// The file is a JSON object of level1 tickers keyed by symbol.
+func LoadPaperRecordedQuotes(
+	path string,
+) (map[string][]*kucoin.TickerLevel1Model, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", object.ErrPaperQuoteLoad, err)
+	}
+
+	quotes := map[string][]*kucoin.TickerLevel1Model{}
+	if err = json.Unmarshal(content, &quotes); err != nil {
+		return nil, fmt.Errorf("%w: %w", object.ErrPaperQuoteLoad, err)
+	}
+
+	return quotes, nil
+}
+
+// GetTimer is a function.
+func (quote *paperRecordedQuote) GetTimer() object.Timer {
+	return quote.objectTimer
+}
+
+// Quote is a function.
+func (quote *paperRecordedQuote) Quote(
+	symbol string,
+) (*kucoin.TickerLevel1Model, error) {
+	kucoinTickerLevel1Models := quote.quotes[symbol]
+	nowUnixMilli := quote.GetTimer().NowUTC().UnixMilli()
+
+	index := sort.Search(len(kucoinTickerLevel1Models), func(index int) bool {
+		return kucoinTickerLevel1Models[index].Time > nowUnixMilli
+	})
+	if index == 0 {
+		return nil, object.ErrPaperQuoteNotFound
+	}
+
+	return kucoinTickerLevel1Models[index-1], nil
+}
@@ -0,0 +1,101 @@
+package exchange
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	paperResponse struct {
+		data       any
+		code       string
+		message    string
+		statusCode int
+	}
+)
+
+// newPaperResponse is a successful response of the simulator.
+func newPaperResponse(
+	data any,
+) *paperResponse {
+	return &paperResponse{
+		data:       data,
+		code:       kucoin.ApiSuccess,
+		message:    object.URIEmpty,
+		statusCode: http.StatusOK,
+	}
+}
+
+// newPaperErrorResponse is a response of the simulator that carries a KuCoin
+// error code.
+func newPaperErrorResponse(
+	statusCode int,
+	code string,
+	message string,
+) *paperResponse {
+	return &paperResponse{
+		data:       nil,
+		code:       code,
+		message:    message,
+		statusCode: statusCode,
+	}
+}
+
+// newPaperPageResponse splits the items into pages by the currentPage and
+// pageSize query parameters, the way KuCoin pages its lists.
+func newPaperPageResponse(
+	query url.Values,
+	items []any,
+) *paperResponse {
+	currentPage, err := strconv.ParseInt(query.Get("currentPage"), 10, 64)
+	if err != nil || currentPage < 1 {
+		currentPage = 1
+	}
+
+	pageSize, err := strconv.ParseInt(query.Get("pageSize"), 10, 64)
+	if err != nil || pageSize < 1 {
+		pageSize = object.NUMFakeServerDefaultPageSize
+	}
+
+	totalNum := int64(len(items))
+	totalPage := (totalNum + pageSize - 1) / pageSize
+	start := (currentPage - 1) * pageSize
+	end := start + pageSize
+
+	if start > totalNum {
+		start = totalNum
+	}
+
+	if end > totalNum {
+		end = totalNum
+	}
+
+	return newPaperResponse(map[string]any{
+		"currentPage": currentPage,
+		"pageSize":    pageSize,
+		"totalNum":    totalNum,
+		"totalPage":   totalPage,
+		"items":       items[start:end],
+	})
+}
+
+// writePaperResponse writes the response the way KuCoin does, as a JSON
+// envelope under its status code.
+func writePaperResponse(
+	responseWriter http.ResponseWriter,
+	paperResponse *paperResponse,
+) {
+	responseWriter.Header().Set(object.URIHTTPHeaderContentType, "application/json")
+	responseWriter.WriteHeader(paperResponse.statusCode)
+
+	_ = json.NewEncoder(responseWriter).Encode(map[string]any{
+		"code": paperResponse.code,
+		"msg":  paperResponse.message,
+		"data": paperResponse.data,
+	})
+}
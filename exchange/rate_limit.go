@@ -0,0 +1,120 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// RateLimiter is an interface.
+	RateLimiter interface {
+		// Wait is a function.
+		Wait(
+			context.Context,
+			uint32,
+		) error
+	}
+
+	tokenBucket struct {
+		objectTimer object.Timer
+		updatedAt   time.Time
+		capacity    float64
+		rate        float64
+		tokens      float64
+		mutex       sync.Mutex
+	}
+)
+
+var _ RateLimiter = (*tokenBucket)(nil)
+
+// NewTokenBucket is a function.
+// The bucket starts full with the limit and refills it evenly over the window,
+// so a burst may spend the whole limit at once but no more. A zero limit or
+// window does not limit at all.
+func NewTokenBucket(
+	objectTimer object.Timer,
+	limit uint32,
+	window time.Duration,
+) *tokenBucket {
+	tokenBucket := &tokenBucket{
+		objectTimer: objectTimer,
+		updatedAt:   objectTimer.NowUTC(),
+		capacity:    0,
+		rate:        0,
+		tokens:      0,
+		mutex:       sync.Mutex{},
+	}
+
+	if limit > 0 && window > 0 {
+		tokenBucket.capacity = float64(limit)
+		tokenBucket.rate = float64(limit) / window.Seconds()
+		tokenBucket.tokens = float64(limit)
+	}
+
+	return tokenBucket
+}
+
+// Wait is a function.
+// It blocks until the bucket holds the weight and takes it, or until ctx is
+// done. A weight over the limit takes the full bucket, since it could never be
+// held.
+func (bucket *tokenBucket) Wait(
+	ctx context.Context,
+	weight uint32,
+) error {
+	if bucket.capacity == 0 {
+		return nil
+	}
+
+	tokens := float64(weight)
+	if tokens > bucket.capacity {
+		tokens = bucket.capacity
+	}
+
+	for {
+		wait := bucket.take(tokens)
+		if wait == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", object.ErrExchangeRateLimitWait, ctx.Err())
+		case <-bucket.objectTimer.After(wait):
+		}
+	}
+}
+
+// take takes the weight when the bucket holds it, or else returns how long the
+// bucket needs to refill it.
+func (bucket *tokenBucket) take(
+	weight float64,
+) time.Duration {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+
+	now := bucket.objectTimer.NowUTC()
+	bucket.tokens += now.Sub(bucket.updatedAt).Seconds() * bucket.rate
+	bucket.updatedAt = now
+
+	if bucket.tokens > bucket.capacity {
+		bucket.tokens = bucket.capacity
+	}
+
+	if bucket.tokens >= weight {
+		bucket.tokens -= weight
+
+		return 0
+	}
+
+	wait := time.Duration((weight - bucket.tokens) / bucket.rate * float64(time.Second))
+	if wait <= 0 {
+		wait = time.Millisecond
+	}
+
+	return wait
+}
@@ -0,0 +1,38 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+// NewResponseError is a function.
+// It is nil when KuCoin answered with success, and otherwise the kucoin error
+// of the answer, or of the failure to read one. An answer without a code was
+// not read either. A request the circuit or the rate limit held back was never
+// sent, so it is no transport failure and is returned as it is.
+func NewResponseError(
+	response *kucoin.ApiResponse,
+	err error,
+) error {
+	if errors.Is(err, object.ErrExchangeCircuitOpen) ||
+		errors.Is(err, object.ErrExchangeRateLimitWait) {
+		return fmt.Errorf("%w", err)
+	}
+
+	if err != nil {
+		return object.WrapKucoinTransportError(err)
+	}
+
+	if response == nil || response.Code == object.URIEmpty {
+		return object.NewKucoinTransportError(object.URIEmpty)
+	}
+
+	if response.Code != object.URIKucoinCodeSuccess {
+		return object.NewKucoinError(response.Code, response.Message)
+	}
+
+	return nil
+}
@@ -0,0 +1,243 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+func newResponseErrorTestResponse(
+	code string,
+	message string,
+) *kucoin.ApiResponse {
+	return &kucoin.ApiResponse{
+		Code:    code,
+		RawData: nil,
+		Message: message,
+	}
+}
+
+func TestNewResponseError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		response      *kucoin.ApiResponse
+		err           error
+		wantErr       error
+		wantCode      string
+		wantRetryable bool
+	}{
+		{
+			name:          "success",
+			response:      newResponseErrorTestResponse(object.URIKucoinCodeSuccess, object.URIEmpty),
+			err:           nil,
+			wantErr:       nil,
+			wantCode:      object.URIEmpty,
+			wantRetryable: false,
+		},
+		{
+			name: "auth",
+			response: newResponseErrorTestResponse(
+				object.URIKucoinCodeSignatureInvalid,
+				"Invalid KC-API-SIGN",
+			),
+			err:           nil,
+			wantErr:       object.ErrKucoinAuth,
+			wantCode:      object.URIKucoinCodeSignatureInvalid,
+			wantRetryable: false,
+		},
+		{
+			name: "balance insufficient",
+			response: newResponseErrorTestResponse(
+				object.URIKucoinCodeBalanceInsufficient,
+				object.URIKucoinMessageBalanceInsufficient,
+			),
+			err:           nil,
+			wantErr:       object.ErrKucoinBalanceInsufficient,
+			wantCode:      object.URIKucoinCodeBalanceInsufficient,
+			wantRetryable: false,
+		},
+		{
+			name: "margin balance insufficient",
+			response: newResponseErrorTestResponse(
+				object.URIKucoinCodeMarginBalanceInsufficient,
+				object.URIKucoinMessageBalanceInsufficient,
+			),
+			err:           nil,
+			wantErr:       object.ErrKucoinBalanceInsufficient,
+			wantCode:      object.URIKucoinCodeMarginBalanceInsufficient,
+			wantRetryable: false,
+		},
+		{
+			name: "order not found",
+			response: newResponseErrorTestResponse(
+				object.URIKucoinCodeInvalidParameter,
+				object.URIKucoinMessageOrderNotExist,
+			),
+			err:           nil,
+			wantErr:       object.ErrKucoinOrderNotFound,
+			wantCode:      object.URIKucoinCodeInvalidParameter,
+			wantRetryable: false,
+		},
+		{
+			name: "client oid duplicated",
+			response: newResponseErrorTestResponse(
+				object.URIKucoinCodeInvalidParameter,
+				object.URIKucoinMessageClientOIDDuplicated,
+			),
+			err:           nil,
+			wantErr:       object.ErrKucoinClientOIDDuplicated,
+			wantCode:      object.URIKucoinCodeInvalidParameter,
+			wantRetryable: false,
+		},
+		{
+			name: "precision",
+			response: newResponseErrorTestResponse(
+				object.URIKucoinCodeInvalidParameter,
+				"Price increment invalid",
+			),
+			err:           nil,
+			wantErr:       object.ErrKucoinInvalidPrecision,
+			wantCode:      object.URIKucoinCodeInvalidParameter,
+			wantRetryable: false,
+		},
+		{
+			name:          "invalid parameter",
+			response:      newResponseErrorTestResponse(object.URIKucoinCodeInvalidParameter, "size"),
+			err:           nil,
+			wantErr:       object.ErrKucoinInvalidParameter,
+			wantCode:      object.URIKucoinCodeInvalidParameter,
+			wantRetryable: false,
+		},
+		{
+			name:          "not found",
+			response:      newResponseErrorTestResponse(object.URIKucoinCodeSymbolNotExist, "symbol"),
+			err:           nil,
+			wantErr:       object.ErrKucoinNotFound,
+			wantCode:      object.URIKucoinCodeSymbolNotExist,
+			wantRetryable: false,
+		},
+		{
+			name: "rate limit",
+			response: newResponseErrorTestResponse(
+				object.URIKucoinCodeTooManyRequests,
+				"Too Many Requests",
+			),
+			err:           nil,
+			wantErr:       object.ErrKucoinRateLimit,
+			wantCode:      object.URIKucoinCodeTooManyRequests,
+			wantRetryable: true,
+		},
+		{
+			name:          "system busy",
+			response:      newResponseErrorTestResponse("500000", "Internal Server Error"),
+			err:           nil,
+			wantErr:       object.ErrKucoinSystemBusy,
+			wantCode:      "500000",
+			wantRetryable: true,
+		},
+		{
+			name:          "unknown code",
+			response:      newResponseErrorTestResponse("300000", "Unknown"),
+			err:           nil,
+			wantErr:       object.ErrKucoinRequest,
+			wantCode:      "300000",
+			wantRetryable: false,
+		},
+		{
+			name:          "no code",
+			response:      newResponseErrorTestResponse(object.URIEmpty, object.URIEmpty),
+			err:           nil,
+			wantErr:       object.ErrKucoinTransport,
+			wantCode:      object.URIEmpty,
+			wantRetryable: true,
+		},
+		{
+			name:          "no response",
+			response:      nil,
+			err:           nil,
+			wantErr:       object.ErrKucoinTransport,
+			wantCode:      object.URIEmpty,
+			wantRetryable: true,
+		},
+		{
+			name:          "transport",
+			response:      nil,
+			err:           fmt.Errorf("read: %w", context.Canceled),
+			wantErr:       object.ErrKucoinTransport,
+			wantCode:      object.URIEmpty,
+			wantRetryable: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := NewResponseError(test.response, test.err)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("NewResponseError() error = %v, want %v", err, test.wantErr)
+			}
+
+			if err == nil {
+				return
+			}
+
+			var objectKucoinErrorer object.KucoinErrorer
+			if !errors.As(err, &objectKucoinErrorer) {
+				t.Fatalf("NewResponseError() error = %T, want a KucoinErrorer", err)
+			}
+
+			if got := objectKucoinErrorer.GetCode(); got != test.wantCode {
+				t.Errorf("GetCode() = %q, want %q", got, test.wantCode)
+			}
+
+			if got := objectKucoinErrorer.GetRetryable(); got != test.wantRetryable {
+				t.Errorf("GetRetryable() = %v, want %v", got, test.wantRetryable)
+			}
+		})
+	}
+}
+
+func TestNewResponseErrorCause(t *testing.T) {
+	t.Parallel()
+
+	// A canceled request is a transport failure that still tells it was canceled.
+	err := NewResponseError(nil, fmt.Errorf("read: %w", context.Canceled))
+	if !errors.Is(err, object.ErrKucoinTransport) || !errors.Is(err, context.Canceled) {
+		t.Errorf(
+			"NewResponseError() error = %v, want %v and %v",
+			err,
+			object.ErrKucoinTransport,
+			context.Canceled,
+		)
+	}
+
+	// A request held back was never sent, so it is returned as it is.
+	for _, errHeld := range []error{object.ErrExchangeCircuitOpen, object.ErrExchangeRateLimitWait} {
+		err = NewResponseError(nil, fmt.Errorf("orders: %w", errHeld))
+		if !errors.Is(err, errHeld) || errors.Is(err, object.ErrKucoinTransport) {
+			t.Errorf("NewResponseError() error = %v, want %v", err, errHeld)
+		}
+	}
+
+	err = NewResponseError(
+		newResponseErrorTestResponse(object.URIKucoinCodeTooManyRequests, "Too Many Requests"),
+		nil,
+	)
+	if want := fmt.Sprintf(
+		"%s: code %s: %s",
+		object.ErrKucoinRateLimit,
+		object.URIKucoinCodeTooManyRequests,
+		"Too Many Requests",
+	); err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
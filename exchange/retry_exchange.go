@@ -0,0 +1,538 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/object"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// ContextExchanger is an interface.
+	ContextExchanger interface {
+		Exchanger
+		// WithContext is a function.
+		WithContext(
+			context.Context,
+		) Exchanger
+	}
+
+	retryExchange struct {
+		Exchanger
+		ctx             context.Context
+		objectTimer     object.Timer
+		circuitBreakers map[object.EndpointGroupType]CircuitBreaker
+		rateLimiters    map[object.EndpointGroupType]RateLimiter
+		maxBackoff      time.Duration
+		minBackoff      time.Duration
+		maxAttempts     uint32
+	}
+
+	retryExchangeEndpoint struct {
+		group      object.EndpointGroupType
+		weight     uint32
+		idempotent bool
+	}
+)
+
+var _ ContextExchanger = (*retryExchange)(nil)
+
+// retryExchangeEndpoints are the endpoint group and the weight of every call,
+// after the rate limit tables of KuCoin. A call that is not idempotent places,
+// borrows, repays or moves funds, so sending it twice could do it twice.
+var retryExchangeEndpoints = map[string]retryExchangeEndpoint{
+	"Accounts":                  {group: object.EndpointGroupTypeManagement, weight: 5, idempotent: true},
+	"AggregatedFullOrderBookV3": {group: object.EndpointGroupTypeSpot, weight: 3, idempotent: true},
+	"BorrowOutstandingRecords":  {group: object.EndpointGroupTypeSpot, weight: 20, idempotent: true},
+	"BorrowRepaidRecords":       {group: object.EndpointGroupTypeSpot, weight: 20, idempotent: true},
+	"CancelOrder":               {group: object.EndpointGroupTypeSpot, weight: 3, idempotent: true},
+	"CancelOrderByClient":       {group: object.EndpointGroupTypeSpot, weight: 5, idempotent: true},
+	"CancelOrders":              {group: object.EndpointGroupTypeSpot, weight: 20, idempotent: true},
+	"CancelStopOrder":           {group: object.EndpointGroupTypeSpot, weight: 3, idempotent: true},
+	"CancelStopOrderByClient":   {group: object.EndpointGroupTypeSpot, weight: 5, idempotent: true},
+	"CreateBorrowOrder":         {group: object.EndpointGroupTypeSpot, weight: 15, idempotent: false},
+	"CreateMarginOrder":         {group: object.EndpointGroupTypeSpot, weight: 5, idempotent: false},
+	"CreateMultiOrder":          {group: object.EndpointGroupTypeSpot, weight: 3, idempotent: false},
+	"CreateOrder":               {group: object.EndpointGroupTypeSpot, weight: 2, idempotent: false},
+	"CreateStopOrder":           {group: object.EndpointGroupTypeSpot, weight: 2, idempotent: false},
+	"Fills":                     {group: object.EndpointGroupTypeSpot, weight: 10, idempotent: true},
+	"KLines":                    {group: object.EndpointGroupTypePublic, weight: 3, idempotent: true},
+	"MarginAccount":             {group: object.EndpointGroupTypeManagement, weight: 40, idempotent: true},
+	"MarginRiskLimit":           {group: object.EndpointGroupTypePublic, weight: 20, idempotent: true},
+	"Order":                     {group: object.EndpointGroupTypeSpot, weight: 2, idempotent: true},
+	"OrderByClient":             {group: object.EndpointGroupTypeSpot, weight: 3, idempotent: true},
+	"Orders":                    {group: object.EndpointGroupTypeSpot, weight: 2, idempotent: true},
+	"RecentFills":               {group: object.EndpointGroupTypeSpot, weight: 20, idempotent: true},
+	"RecentOrders":              {group: object.EndpointGroupTypeSpot, weight: 3, idempotent: true},
+	"RepayAll":                  {group: object.EndpointGroupTypeSpot, weight: 10, idempotent: false},
+	"RepaySingle":               {group: object.EndpointGroupTypeSpot, weight: 10, idempotent: false},
+	"ServiceStatus":             {group: object.EndpointGroupTypePublic, weight: 3, idempotent: true},
+	"StopOrder":                 {group: object.EndpointGroupTypeSpot, weight: 3, idempotent: true},
+	"StopOrderByClient":         {group: object.EndpointGroupTypeSpot, weight: 3, idempotent: true},
+	"StopOrders":                {group: object.EndpointGroupTypeSpot, weight: 8, idempotent: true},
+	"SubAccounts":               {group: object.EndpointGroupTypeManagement, weight: 20, idempotent: true},
+	"SubTransferV2":             {group: object.EndpointGroupTypeManagement, weight: 30, idempotent: false},
+	"Symbols":                   {group: object.EndpointGroupTypePublic, weight: 4, idempotent: true},
+	"TickerLevel1":              {group: object.EndpointGroupTypePublic, weight: 2, idempotent: true},
+	"Tickers":                   {group: object.EndpointGroupTypePublic, weight: 15, idempotent: true},
+	"WebSocketPrivateToken":     {group: object.EndpointGroupTypeSpot, weight: 10, idempotent: true},
+	"WebSocketPublicToken":      {group: object.EndpointGroupTypePublic, weight: 10, idempotent: true},
+}
+
+// NewRetryExchange is a function.
+// Every call first waits for the rate limiter of its endpoint group and is then
+// refused while the circuit breaker of the group is open. The rate limiters
+// and the circuit breakers are shared with the exchangers of the other
+// accounts where KuCoin shares the endpoints, as it does for the public ones.
+func NewRetryExchange(
+	exchanger Exchanger,
+	objectTimer object.Timer,
+	circuitBreakers map[object.EndpointGroupType]CircuitBreaker,
+	rateLimiters map[object.EndpointGroupType]RateLimiter,
+	maxAttempts uint32,
+	minBackoff time.Duration,
+	maxBackoff time.Duration,
+) *retryExchange {
+	return &retryExchange{
+		Exchanger:       exchanger,
+		ctx:             context.Background(),
+		objectTimer:     objectTimer,
+		circuitBreakers: circuitBreakers,
+		rateLimiters:    rateLimiters,
+		maxBackoff:      maxBackoff,
+		minBackoff:      minBackoff,
+		maxAttempts:     maxAttempts,
+	}
+}
+
+// WithContext is a function.
+// The calls of the returned exchanger stop waiting once ctx is done and add
+// their retries as events to the span of ctx.
+func (exchange *retryExchange) WithContext(
+	ctx context.Context,
+) Exchanger {
+	newExchange := *exchange
+	newExchange.ctx = ctx
+
+	return &newExchange
+}
+
+// Accounts is a function.
+func (exchange *retryExchange) Accounts(
+	currency string,
+	typo string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("Accounts", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.Accounts(currency, typo)
+	})
+}
+
+// AggregatedFullOrderBookV3 is a function.
+func (exchange *retryExchange) AggregatedFullOrderBookV3(
+	symbol string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("AggregatedFullOrderBookV3", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.AggregatedFullOrderBookV3(symbol)
+	})
+}
+
+// BorrowOutstandingRecords is a function.
+func (exchange *retryExchange) BorrowOutstandingRecords(
+	currency string,
+	kucoinPaginationParam *kucoin.PaginationParam,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("BorrowOutstandingRecords", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.BorrowOutstandingRecords(currency, kucoinPaginationParam)
+	})
+}
+
+// BorrowRepaidRecords is a function.
+func (exchange *retryExchange) BorrowRepaidRecords(
+	currency string,
+	kucoinPaginationParam *kucoin.PaginationParam,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("BorrowRepaidRecords", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.BorrowRepaidRecords(currency, kucoinPaginationParam)
+	})
+}
+
+// CancelOrder is a function.
+func (exchange *retryExchange) CancelOrder(
+	orderID string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("CancelOrder", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.CancelOrder(orderID)
+	})
+}
+
+// CancelOrderByClient is a function.
+func (exchange *retryExchange) CancelOrderByClient(
+	clientOID string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("CancelOrderByClient", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.CancelOrderByClient(clientOID)
+	})
+}
+
+// CancelOrders is a function.
+func (exchange *retryExchange) CancelOrders(
+	params map[string]string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("CancelOrders", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.CancelOrders(params)
+	})
+}
+
+// CancelStopOrder is a function.
+func (exchange *retryExchange) CancelStopOrder(
+	orderID string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("CancelStopOrder", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.CancelStopOrder(orderID)
+	})
+}
+
+// CancelStopOrderByClient is a function.
+func (exchange *retryExchange) CancelStopOrderByClient(
+	clientOID string,
+	params map[string]string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("CancelStopOrderByClient", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.CancelStopOrderByClient(clientOID, params)
+	})
+}
+
+// CreateBorrowOrder is a function.
+func (exchange *retryExchange) CreateBorrowOrder(
+	params map[string]string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("CreateBorrowOrder", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.CreateBorrowOrder(params)
+	})
+}
+
+// CreateMarginOrder is a function.
+func (exchange *retryExchange) CreateMarginOrder(
+	kucoinCreateOrderModel *kucoin.CreateOrderModel,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("CreateMarginOrder", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.CreateMarginOrder(kucoinCreateOrderModel)
+	})
+}
+
+// CreateMultiOrder is a function.
+func (exchange *retryExchange) CreateMultiOrder(
+	symbol string,
+	kucoinCreateOrderModels []*kucoin.CreateOrderModel,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("CreateMultiOrder", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.CreateMultiOrder(symbol, kucoinCreateOrderModels)
+	})
+}
+
+// CreateOrder is a function.
+func (exchange *retryExchange) CreateOrder(
+	kucoinCreateOrderModel *kucoin.CreateOrderModel,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("CreateOrder", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.CreateOrder(kucoinCreateOrderModel)
+	})
+}
+
+// CreateStopOrder is a function.
+func (exchange *retryExchange) CreateStopOrder(
+	kucoinCreateOrderModel *kucoin.CreateOrderModel,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("CreateStopOrder", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.CreateStopOrder(kucoinCreateOrderModel)
+	})
+}
+
+// Fills is a function.
+func (exchange *retryExchange) Fills(
+	params map[string]string,
+	kucoinPaginationParam *kucoin.PaginationParam,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("Fills", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.Fills(params, kucoinPaginationParam)
+	})
+}
+
+// KLines is a function.
+func (exchange *retryExchange) KLines(
+	symbol string,
+	typo string,
+	startAt int64,
+	endAt int64,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("KLines", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.KLines(symbol, typo, startAt, endAt)
+	})
+}
+
+// MarginAccount is a function.
+func (exchange *retryExchange) MarginAccount() (*kucoin.ApiResponse, error) {
+	return exchange.call("MarginAccount", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.MarginAccount()
+	})
+}
+
+// MarginRiskLimit is a function.
+func (exchange *retryExchange) MarginRiskLimit(
+	marginModel string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("MarginRiskLimit", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.MarginRiskLimit(marginModel)
+	})
+}
+
+// Order is a function.
+func (exchange *retryExchange) Order(
+	orderID string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("Order", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.Order(orderID)
+	})
+}
+
+// OrderByClient is a function.
+func (exchange *retryExchange) OrderByClient(
+	clientOID string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("OrderByClient", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.OrderByClient(clientOID)
+	})
+}
+
+// Orders is a function.
+func (exchange *retryExchange) Orders(
+	params map[string]string,
+	kucoinPaginationParam *kucoin.PaginationParam,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("Orders", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.Orders(params, kucoinPaginationParam)
+	})
+}
+
+// RecentFills is a function.
+func (exchange *retryExchange) RecentFills() (*kucoin.ApiResponse, error) {
+	return exchange.call("RecentFills", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.RecentFills()
+	})
+}
+
+// RecentOrders is a function.
+func (exchange *retryExchange) RecentOrders() (*kucoin.ApiResponse, error) {
+	return exchange.call("RecentOrders", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.RecentOrders()
+	})
+}
+
+// RepayAll is a function.
+func (exchange *retryExchange) RepayAll(
+	params map[string]string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("RepayAll", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.RepayAll(params)
+	})
+}
+
+// RepaySingle is a function.
+func (exchange *retryExchange) RepaySingle(
+	params map[string]string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("RepaySingle", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.RepaySingle(params)
+	})
+}
+
+// ServiceStatus is a function.
+func (exchange *retryExchange) ServiceStatus() (*kucoin.ApiResponse, error) {
+	return exchange.call("ServiceStatus", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.ServiceStatus()
+	})
+}
+
+// StopOrder is a function.
+func (exchange *retryExchange) StopOrder(
+	orderID string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("StopOrder", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.StopOrder(orderID)
+	})
+}
+
+// StopOrderByClient is a function.
+func (exchange *retryExchange) StopOrderByClient(
+	clientOID string,
+	params map[string]string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("StopOrderByClient", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.StopOrderByClient(clientOID, params)
+	})
+}
+
+// StopOrders is a function.
+func (exchange *retryExchange) StopOrders(
+	params map[string]string,
+	kucoinPaginationParam *kucoin.PaginationParam,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("StopOrders", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.StopOrders(params, kucoinPaginationParam)
+	})
+}
+
+// SubAccounts is a function.
+func (exchange *retryExchange) SubAccounts() (*kucoin.ApiResponse, error) {
+	return exchange.call("SubAccounts", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.SubAccounts()
+	})
+}
+
+// SubTransferV2 is a function.
+func (exchange *retryExchange) SubTransferV2(
+	params map[string]string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("SubTransferV2", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.SubTransferV2(params)
+	})
+}
+
+// Symbols is a function.
+func (exchange *retryExchange) Symbols(
+	market string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("Symbols", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.Symbols(market)
+	})
+}
+
+// TickerLevel1 is a function.
+func (exchange *retryExchange) TickerLevel1(
+	symbol string,
+) (*kucoin.ApiResponse, error) {
+	return exchange.call("TickerLevel1", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.TickerLevel1(symbol)
+	})
+}
+
+// Tickers is a function.
+func (exchange *retryExchange) Tickers() (*kucoin.ApiResponse, error) {
+	return exchange.call("Tickers", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.Tickers()
+	})
+}
+
+// WebSocketPrivateToken is a function.
+func (exchange *retryExchange) WebSocketPrivateToken() (*kucoin.ApiResponse, error) {
+	return exchange.call("WebSocketPrivateToken", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.WebSocketPrivateToken()
+	})
+}
+
+// WebSocketPublicToken is a function.
+func (exchange *retryExchange) WebSocketPublicToken() (*kucoin.ApiResponse, error) {
+	return exchange.call("WebSocketPublicToken", func() (*kucoin.ApiResponse, error) {
+		return exchange.Exchanger.WebSocketPublicToken()
+	})
+}
+
+// call sends the request until it succeeds, fails for good or runs out of
+// attempts, backing off exponentially with jitter between the attempts. Every
+// attempt is recorded by the circuit breaker of the endpoint group, and an
+// open circuit ends the call without reaching the exchange.
+func (exchange *retryExchange) call(
+	name string,
+	request func() (*kucoin.ApiResponse, error),
+) (*kucoin.ApiResponse, error) {
+	endpoint := retryExchangeEndpoints[name]
+	backoff := exchange.minBackoff
+	circuitBreaker, breaks := exchange.circuitBreakers[endpoint.group]
+
+	for attempt := uint32(1); ; attempt++ {
+		if rateLimiter, ok := exchange.rateLimiters[endpoint.group]; ok {
+			if err := rateLimiter.Wait(exchange.ctx, endpoint.weight); err != nil {
+				return nil, fmt.Errorf("%w", err)
+			}
+		}
+
+		if breaks {
+			if err := circuitBreaker.Allow(); err != nil {
+				return nil, fmt.Errorf("%w: %s", err, endpoint.group)
+			}
+		}
+
+		response, err := request()
+
+		errResponse := NewResponseError(response, err)
+		if breaks && circuitBreaker.Record(errResponse) == object.CircuitStateTypeOpen {
+			trace.SpanFromContext(exchange.ctx).AddEvent(
+				object.URITraceEventExchangeCircuitOpen,
+				trace.WithAttributes(
+					attribute.String(object.URIFieldMethod, name),
+					attribute.String(object.URIFieldEndpointGroup, string(endpoint.group)),
+					attribute.String(object.URIFieldError, errResponse.Error()),
+				),
+			)
+
+			return response, err
+		}
+
+		if attempt >= exchange.maxAttempts || !retryExchangeRetryable(endpoint, errResponse) {
+			return response, err
+		}
+
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+
+		trace.SpanFromContext(exchange.ctx).AddEvent(
+			object.URITraceEventExchangeRetry,
+			trace.WithAttributes(
+				attribute.String(object.URIFieldMethod, name),
+				attribute.String(object.URIFieldEndpointGroup, string(endpoint.group)),
+				attribute.Int64(object.URIFieldAttempt, int64(attempt)),
+				attribute.String(object.URIFieldError, errResponse.Error()),
+				attribute.String(object.URIFieldBackoff, wait.String()),
+			),
+		)
+
+		select {
+		case <-exchange.ctx.Done():
+			return response, err
+		case <-exchange.objectTimer.After(wait):
+		}
+
+		backoff *= 2
+		if backoff > exchange.maxBackoff {
+			backoff = exchange.maxBackoff
+		}
+	}
+}
+
+// retryExchangeRetryable tells whether a failed call may be sent again.
+// A refusal for the rate limit means the request was not processed, so any
+// call may be retried after it. Any other retryable failure may have left the
+// request processed, so only an idempotent call may be retried after it.
+func retryExchangeRetryable(
+	endpoint retryExchangeEndpoint,
+	errResponse error,
+) bool {
+	if errResponse == nil {
+		return false
+	}
+
+	if errors.Is(errResponse, object.ErrKucoinRateLimit) {
+		return true
+	}
+
+	var objectKucoinErrorer object.KucoinErrorer
+
+	return endpoint.idempotent &&
+		errors.As(errResponse, &objectKucoinErrorer) &&
+		objectKucoinErrorer.GetRetryable()
+}
@@ -0,0 +1,66 @@
+package indicator
+
+import "math"
+
+type atrIndicator struct {
+	period int
+	count  int
+	value  float64
+	close  float64
+}
+
+var _ Indicatorer = (*atrIndicator)(nil)
+
+// NewATRIndicator is a function.
+// True ranges are smoothed the way Wilder does it.
+func NewATRIndicator(
+	periodValue uint32,
+) *atrIndicator {
+	return &atrIndicator{
+		period: period(periodValue),
+		count:  0,
+		value:  0,
+		close:  0,
+	}
+}
+
+// ATR is a function.
+func ATR(
+	candles []Candle,
+	periodValue uint32,
+) []float64 {
+	return firstSeries(Batch(NewATRIndicator(periodValue), candles))
+}
+
+// Reset is a function.
+func (indicator *atrIndicator) Reset() {
+	indicator.count = 0
+	indicator.value = 0
+	indicator.close = 0
+}
+
+// Update is a function.
+func (indicator *atrIndicator) Update(
+	candle Candle,
+) ([]float64, bool) {
+	trueRange := candle.High - candle.Low
+	if indicator.count != 0 {
+		trueRange = math.Max(
+			trueRange,
+			math.Max(math.Abs(candle.High-indicator.close), math.Abs(candle.Low-indicator.close)),
+		)
+	}
+
+	indicator.count++
+	indicator.close = candle.Close
+
+	periodValue := float64(indicator.period)
+
+	if indicator.count <= indicator.period {
+		indicator.value += trueRange / periodValue
+	} else {
+		indicator.value = (indicator.value*(periodValue-1) + trueRange) / periodValue
+	}
+
+	return []float64{indicator.value}, indicator.count >= indicator.period
+}
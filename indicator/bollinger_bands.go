@@ -0,0 +1,77 @@
+package indicator
+
+import "math"
+
+type bollingerBandsIndicator struct {
+	window     []float64
+	count      int
+	index      int
+	multiplier float64
+}
+
+var _ Indicatorer = (*bollingerBandsIndicator)(nil)
+
+// NewBollingerBandsIndicator is a function.
+// Update returns the middle, upper and lower bands, using the population standard deviation.
+func NewBollingerBandsIndicator(
+	periodValue uint32,
+	multiplier float64,
+) *bollingerBandsIndicator {
+	return &bollingerBandsIndicator{
+		window:     make([]float64, period(periodValue)),
+		count:      0,
+		index:      0,
+		multiplier: multiplier,
+	}
+}
+
+// BollingerBands is a function.
+func BollingerBands(
+	values []float64,
+	periodValue uint32,
+	multiplier float64,
+) ([]float64, []float64, []float64) {
+	series := Batch(NewBollingerBandsIndicator(periodValue, multiplier), closeCandles(values))
+	if len(series) == 0 {
+		return []float64{}, []float64{}, []float64{}
+	}
+
+	return series[0], series[1], series[2]
+}
+
+// Reset is a function.
+func (indicator *bollingerBandsIndicator) Reset() {
+	indicator.window = make([]float64, len(indicator.window))
+	indicator.count = 0
+	indicator.index = 0
+}
+
+// Update is a function.
+func (indicator *bollingerBandsIndicator) Update(
+	candle Candle,
+) ([]float64, bool) {
+	indicator.window[indicator.index] = candle.Close
+	indicator.index = (indicator.index + 1) % len(indicator.window)
+
+	if indicator.count < len(indicator.window) {
+		indicator.count++
+	}
+
+	var sum float64
+	for _, value := range indicator.window[:indicator.count] {
+		sum += value
+	}
+
+	middle := sum / float64(indicator.count)
+
+	var variance float64
+	for _, value := range indicator.window[:indicator.count] {
+		variance += (value - middle) * (value - middle)
+	}
+
+	deviation := indicator.multiplier * math.Sqrt(variance/float64(indicator.count))
+
+	ready := indicator.count == len(indicator.window)
+
+	return []float64{middle, middle + deviation, middle - deviation}, ready
+}
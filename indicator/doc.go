@@ -0,0 +1,4 @@
+/*
+Package indicator is a package.
+*/
+package indicator
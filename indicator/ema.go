@@ -0,0 +1,55 @@
+package indicator
+
+type emaIndicator struct {
+	smaIndicator *smaIndicator
+	alpha        float64
+	value        float64
+	ready        bool
+}
+
+var _ Indicatorer = (*emaIndicator)(nil)
+
+// NewEMAIndicator is a function.
+// The first value is seeded with the SMA of the first period candles.
+func NewEMAIndicator(
+	periodValue uint32,
+) *emaIndicator {
+	return &emaIndicator{
+		smaIndicator: NewSMAIndicator(periodValue),
+		alpha:        2 / float64(period(periodValue)+1),
+		value:        0,
+		ready:        false,
+	}
+}
+
+// EMA is a function.
+func EMA(
+	values []float64,
+	periodValue uint32,
+) []float64 {
+	return firstSeries(Batch(NewEMAIndicator(periodValue), closeCandles(values)))
+}
+
+// Reset is a function.
+func (indicator *emaIndicator) Reset() {
+	indicator.smaIndicator.Reset()
+	indicator.value = 0
+	indicator.ready = false
+}
+
+// Update is a function.
+func (indicator *emaIndicator) Update(
+	candle Candle,
+) ([]float64, bool) {
+	if indicator.ready {
+		indicator.value += indicator.alpha * (candle.Close - indicator.value)
+
+		return []float64{indicator.value}, true
+	}
+
+	values, ok := indicator.smaIndicator.Update(candle)
+	indicator.value = values[0]
+	indicator.ready = ok
+
+	return []float64{indicator.value}, indicator.ready
+}
@@ -0,0 +1,136 @@
+package indicator
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/ShahoBashoki/kucoin/object/om"
+)
+
+type (
+	// Indicatorer is an interface.
+	Indicatorer interface {
+		// Reset is a function.
+		Reset()
+		// Update is a function.
+		Update(
+			Candle,
+		) ([]float64, bool)
+	}
+
+	// Candle is a struct.
+	Candle struct {
+		Close  float64
+		High   float64
+		Low    float64
+		Open   float64
+		Volume float64
+	}
+)
+
+// NewCandles is a function.
+func NewCandles(
+	omKliners []om.Kliner,
+) ([]Candle, error) {
+	candles := make([]Candle, 0, len(omKliners))
+
+	for _, omKliner := range omKliners {
+		values := make([]float64, 0, 5)
+
+		for _, value := range []string{
+			omKliner.GetClose(),
+			omKliner.GetHigh(),
+			omKliner.GetLow(),
+			omKliner.GetOpen(),
+			omKliner.GetVolume(),
+		} {
+			floatValue, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w", err)
+			}
+
+			values = append(values, floatValue)
+		}
+
+		candles = append(candles, Candle{
+			Close:  values[0],
+			High:   values[1],
+			Low:    values[2],
+			Open:   values[3],
+			Volume: values[4],
+		})
+	}
+
+	return candles, nil
+}
+
+// Batch is a function.
+// Every returned series is aligned with candles and holds NaN until the indicator is ready.
+func Batch(
+	indicatorer Indicatorer,
+	candles []Candle,
+) [][]float64 {
+	indicatorer.Reset()
+
+	var series [][]float64
+
+	for key, candle := range candles {
+		values, ok := indicatorer.Update(candle)
+
+		if series == nil {
+			series = make([][]float64, len(values))
+			for index := range series {
+				series[index] = make([]float64, len(candles))
+			}
+		}
+
+		for index := range series {
+			series[index][key] = math.NaN()
+
+			if ok {
+				series[index][key] = values[index]
+			}
+		}
+	}
+
+	return series
+}
+
+func closeCandles(
+	values []float64,
+) []Candle {
+	candles := make([]Candle, 0, len(values))
+
+	for _, value := range values {
+		candles = append(candles, Candle{
+			Close:  value,
+			High:   value,
+			Low:    value,
+			Open:   value,
+			Volume: 0,
+		})
+	}
+
+	return candles
+}
+
+func firstSeries(
+	series [][]float64,
+) []float64 {
+	if len(series) == 0 {
+		return []float64{}
+	}
+
+	return series[0]
+}
+
+func period(
+	value uint32,
+) int {
+	if value == 0 {
+		return 1
+	}
+
+	return int(value)
+}
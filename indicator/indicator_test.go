@@ -0,0 +1,334 @@
+package indicator
+
+import (
+	"math"
+	"testing"
+)
+
+// The datasets are the worked examples of the StockCharts ChartSchool articles:
+// the 10-day moving average closes, the 14-day RSI closes, the 14-day ATR
+// candles and the OBV candles. The expected SMA, EMA, RSI, ATR and OBV values
+// are those of the articles carried at full precision, since the articles
+// round every intermediate step. The other indicators are checked on the same
+// datasets against values worked out by hand from their definitions, with
+// volumes added to the ATR candles for VWAP. Values are given from the first
+// candle the indicator is ready on.
+const indicatorTestTolerance = 1e-4
+
+type indicatorTestCase struct {
+	name        string
+	batch       func() [][]float64
+	indicatorer Indicatorer
+	candles     []Candle
+	want        [][]float64
+}
+
+var (
+	indicatorTestMovingAverageCloses = []float64{
+		22.27, 22.19, 22.08, 22.17, 22.18, 22.13, 22.23, 22.43, 22.24, 22.29,
+		22.15, 22.39, 22.38, 22.61, 23.36, 24.05, 23.75, 23.83, 23.95, 23.63,
+		23.82, 23.87, 23.65, 23.19, 23.10, 23.33, 22.68, 23.10, 22.40, 22.17,
+	}
+
+	indicatorTestRSICloses = []float64{
+		44.34, 44.09, 44.15, 43.61, 44.33, 44.83, 45.10, 45.42, 45.84, 46.08,
+		45.89, 46.03, 45.61, 46.28, 46.28, 46.00, 46.03, 46.41, 46.22, 45.64,
+		46.21, 46.25, 45.71, 46.45, 45.78, 45.35, 44.03, 44.18, 44.22, 44.57,
+		43.42, 42.66, 43.13,
+	}
+
+	indicatorTestATRCandles = indicatorTestCandles(
+		[]float64{
+			48.70, 48.72, 48.90, 48.87, 48.82, 49.05, 49.20, 49.35, 49.92, 50.19,
+			50.12, 49.66, 49.88, 50.19, 50.36, 50.57, 50.65, 50.43, 49.63, 50.33,
+		},
+		[]float64{
+			47.79, 48.14, 48.39, 48.37, 48.24, 48.64, 48.94, 48.86, 49.50, 49.87,
+			49.20, 48.90, 49.43, 49.73, 49.26, 50.09, 50.30, 49.21, 48.98, 49.61,
+		},
+		[]float64{
+			48.16, 48.61, 48.75, 48.63, 48.74, 49.03, 49.07, 49.32, 49.91, 50.13,
+			49.53, 49.50, 49.75, 50.03, 50.31, 50.52, 50.41, 49.34, 49.37, 50.23,
+		},
+		[]float64{
+			1000, 1200, 900, 1500, 1100, 1300, 800, 1600, 2000, 1700,
+			1400, 1250, 900, 1100, 1800, 2100, 1500, 2400, 1900, 1300,
+		},
+	)
+
+	indicatorTestOBVCandles = indicatorTestCandles(
+		[]float64{
+			53.26, 53.30, 53.32, 53.72, 54.19, 53.92, 54.65, 54.60,
+			54.21, 54.53, 53.79, 53.66, 53.56, 53.57, 53.94, 53.27,
+		},
+		[]float64{
+			53.26, 53.30, 53.32, 53.72, 54.19, 53.92, 54.65, 54.60,
+			54.21, 54.53, 53.79, 53.66, 53.56, 53.57, 53.94, 53.27,
+		},
+		[]float64{
+			53.26, 53.30, 53.32, 53.72, 54.19, 53.92, 54.65, 54.60,
+			54.21, 54.53, 53.79, 53.66, 53.56, 53.57, 53.94, 53.27,
+		},
+		[]float64{
+			0, 8200, 8100, 8300, 8900, 9200, 13300, 10300,
+			9900, 10100, 11300, 12600, 10700, 11500, 23800, 14600,
+		},
+	)
+)
+
+func indicatorTestCandles(
+	highs []float64,
+	lows []float64,
+	closes []float64,
+	volumes []float64,
+) []Candle {
+	candles := make([]Candle, 0, len(closes))
+
+	for index := range closes {
+		candles = append(candles, Candle{
+			Close:  closes[index],
+			High:   highs[index],
+			Low:    lows[index],
+			Open:   closes[index],
+			Volume: volumes[index],
+		})
+	}
+
+	return candles
+}
+
+func indicatorTestCases() []indicatorTestCase {
+	return []indicatorTestCase{
+		{
+			name: "SMA",
+			batch: func() [][]float64 {
+				return [][]float64{SMA(indicatorTestMovingAverageCloses, 10)}
+			},
+			indicatorer: NewSMAIndicator(10),
+			candles:     closeCandles(indicatorTestMovingAverageCloses),
+			want: [][]float64{{
+				22.221, 22.209, 22.229, 22.259, 22.303, 22.421, 22.613, 22.765, 22.905, 23.076,
+				23.21, 23.377, 23.525, 23.652, 23.71, 23.684, 23.612, 23.505, 23.432, 23.277,
+				23.131,
+			}},
+		},
+		{
+			name: "EMA",
+			batch: func() [][]float64 {
+				return [][]float64{EMA(indicatorTestMovingAverageCloses, 10)}
+			},
+			indicatorer: NewEMAIndicator(10),
+			candles:     closeCandles(indicatorTestMovingAverageCloses),
+			want: [][]float64{{
+				22.221, 22.2081, 22.2412, 22.2664, 22.3289, 22.5164, 22.7952, 22.9688, 23.1254,
+				23.2753, 23.3398, 23.4271, 23.5076, 23.5335, 23.4711, 23.4036, 23.3902, 23.2611,
+				23.2318, 23.0806, 22.915,
+			}},
+		},
+		{
+			name: "WMA",
+			batch: func() [][]float64 {
+				return [][]float64{WMA(indicatorTestMovingAverageCloses, 10)}
+			},
+			indicatorer: NewWMAIndicator(10),
+			candles:     closeCandles(indicatorTestMovingAverageCloses),
+			want: [][]float64{{
+				22.2429, 22.23, 22.2629, 22.2904, 22.3542, 22.5464, 22.8425, 23.0493, 23.2429,
+				23.4329, 23.5336, 23.6445, 23.7342, 23.7569, 23.6729, 23.562, 23.4976, 23.3282,
+				23.2545, 23.0669, 22.8656,
+			}},
+		},
+		{
+			name: "RSI",
+			batch: func() [][]float64 {
+				return [][]float64{RSI(indicatorTestRSICloses, 14)}
+			},
+			indicatorer: NewRSIIndicator(14),
+			candles:     closeCandles(indicatorTestRSICloses),
+			want: [][]float64{{
+				70.4641, 66.2496, 66.4809, 69.3469, 66.2947, 57.915, 62.8807, 63.2088, 56.0116,
+				62.3399, 54.671, 50.3868, 40.0194, 41.4926, 41.9024, 45.4995, 37.3228, 33.0905,
+				37.7888,
+			}},
+		},
+		{
+			name: "MACD",
+			batch: func() [][]float64 {
+				macd, signal, histogram := MACD(indicatorTestMovingAverageCloses, 5, 10, 4)
+
+				return [][]float64{macd, signal, histogram}
+			},
+			indicatorer: NewMACDIndicator(5, 10, 4),
+			candles:     closeCandles(indicatorTestMovingAverageCloses),
+			want: [][]float64{
+				{
+					0.0487, 0.0845, 0.2126, 0.3741, 0.3941, 0.3932, 0.3871, 0.3118, 0.2806,
+					0.2542, 0.191, 0.0753, -0.006, -0.0152, -0.1177, -0.1029, -0.1946, -0.2677,
+				},
+				{
+					0.0396, 0.0576, 0.1196, 0.2214, 0.2904, 0.3315, 0.3538, 0.337, 0.3144,
+					0.2903, 0.2506, 0.1805, 0.1059, 0.0575, -0.0126, -0.0487, -0.1071, -0.1713,
+				},
+				{
+					0.0091, 0.0269, 0.093, 0.1527, 0.1036, 0.0616, 0.0333, -0.0252, -0.0338,
+					-0.0361, -0.0596, -0.1052, -0.1119, -0.0726, -0.1051, -0.0542, -0.0875, -0.0964,
+				},
+			},
+		},
+		{
+			name: "BollingerBands",
+			batch: func() [][]float64 {
+				middle, upper, lower := BollingerBands(indicatorTestMovingAverageCloses, 20, 2)
+
+				return [][]float64{middle, upper, lower}
+			},
+			indicatorer: NewBollingerBandsIndicator(20, 2),
+			candles:     closeCandles(indicatorTestMovingAverageCloses),
+			want: [][]float64{
+				{
+					22.7155, 22.793, 22.877, 22.9555, 23.0065, 23.0525, 23.1125, 23.135, 23.1685,
+					23.1765, 23.1705,
+				},
+				{
+					24.1261, 24.2661, 24.3939, 24.4617, 24.4714, 24.4676, 24.4665, 24.4438, 24.4371,
+					24.4234, 24.4355,
+				},
+				{
+					21.3049, 21.3199, 21.3601, 21.4493, 21.5416, 21.6374, 21.7585, 21.8262, 21.8999,
+					21.9296, 21.9055,
+				},
+			},
+		},
+		{
+			name: "ATR",
+			batch: func() [][]float64 {
+				return [][]float64{ATR(indicatorTestATRCandles, 14)}
+			},
+			indicatorer: NewATRIndicator(14),
+			candles:     indicatorTestATRCandles,
+			want:        [][]float64{{0.5543, 0.5933, 0.5852, 0.5684, 0.6149, 0.6174, 0.6419}},
+		},
+		{
+			name: "VWAP",
+			batch: func() [][]float64 {
+				return [][]float64{VWAP(indicatorTestATRCandles)}
+			},
+			indicatorer: NewVWAPIndicator(),
+			candles:     indicatorTestATRCandles,
+			want: [][]float64{{
+				48.2167, 48.3658, 48.457, 48.5112, 48.5284, 48.5986, 48.647, 48.7371, 48.9195,
+				49.0679, 49.1209, 49.1394, 49.1689, 49.2194, 49.2891, 49.3962, 49.4647, 49.4831,
+				49.4723, 49.4987,
+			}},
+		},
+		{
+			name: "OBV",
+			batch: func() [][]float64 {
+				return [][]float64{OBV(indicatorTestOBVCandles)}
+			},
+			indicatorer: NewOBVIndicator(),
+			candles:     indicatorTestOBVCandles,
+			want: [][]float64{{
+				0, 8200, 16300, 24600, 33500, 24300, 37600, 27300,
+				17400, 27500, 16200, 3600, -7100, 4400, 28200, 13600,
+			}},
+		},
+		{
+			name: "Stochastic",
+			batch: func() [][]float64 {
+				k, d := Stochastic(indicatorTestATRCandles, 14, 3)
+
+				return [][]float64{k, d}
+			},
+			indicatorer: NewStochasticIndicator(14, 3),
+			candles:     indicatorTestATRCandles,
+			want: [][]float64{
+				{97.8541, 90.0415, 45.6432, 36.3184, 76.5363},
+				{96.3117, 95.2144, 77.8462, 57.3344, 52.8326},
+			},
+		},
+	}
+}
+
+func TestIndicatorBatch(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range indicatorTestCases() {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			series := testCase.batch()
+			if len(series) != len(testCase.want) {
+				t.Fatalf("series = %d, want %d", len(series), len(testCase.want))
+			}
+
+			for index, values := range series {
+				if len(values) != len(testCase.candles) {
+					t.Fatalf("series[%d] = %d values, want %d", index, len(values), len(testCase.candles))
+				}
+
+				readyAt := len(values) - len(testCase.want[index])
+
+				for key, value := range values {
+					if key < readyAt {
+						if !math.IsNaN(value) {
+							t.Errorf("series[%d][%d] = %v, want NaN", index, key, value)
+						}
+
+						continue
+					}
+
+					if want := testCase.want[index][key-readyAt]; math.Abs(value-want) > indicatorTestTolerance {
+						t.Errorf("series[%d][%d] = %v, want %v", index, key, value, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestIndicatorUpdate(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range indicatorTestCases() {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			series := testCase.batch()
+			readyAt := len(testCase.candles) - len(testCase.want[0])
+
+			// The second pass checks that Reset leaves nothing of the first one.
+			for pass := 0; pass < 2; pass++ {
+				testCase.indicatorer.Reset()
+
+				for key, candle := range testCase.candles {
+					values, ok := testCase.indicatorer.Update(candle)
+					if ok != (key >= readyAt) {
+						t.Fatalf("pass %d: Update()[%d] ok = %v, want %v", pass, key, ok, key >= readyAt)
+					}
+
+					if !ok {
+						continue
+					}
+
+					for index, value := range values {
+						if value != series[index][key] {
+							t.Errorf(
+								"pass %d: Update()[%d][%d] = %v, batch = %v",
+								pass,
+								key,
+								index,
+								value,
+								series[index][key],
+							)
+						}
+					}
+				}
+			}
+		})
+	}
+}
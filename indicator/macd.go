@@ -0,0 +1,68 @@
+package indicator
+
+type macdIndicator struct {
+	fastEMAIndicator   *emaIndicator
+	slowEMAIndicator   *emaIndicator
+	signalEMAIndicator *emaIndicator
+}
+
+var _ Indicatorer = (*macdIndicator)(nil)
+
+// NewMACDIndicator is a function.
+// Update returns the MACD line, the signal line and the histogram.
+func NewMACDIndicator(
+	fastPeriod uint32,
+	slowPeriod uint32,
+	signalPeriod uint32,
+) *macdIndicator {
+	return &macdIndicator{
+		fastEMAIndicator:   NewEMAIndicator(fastPeriod),
+		slowEMAIndicator:   NewEMAIndicator(slowPeriod),
+		signalEMAIndicator: NewEMAIndicator(signalPeriod),
+	}
+}
+
+// MACD is a function.
+func MACD(
+	values []float64,
+	fastPeriod uint32,
+	slowPeriod uint32,
+	signalPeriod uint32,
+) ([]float64, []float64, []float64) {
+	series := Batch(NewMACDIndicator(fastPeriod, slowPeriod, signalPeriod), closeCandles(values))
+	if len(series) == 0 {
+		return []float64{}, []float64{}, []float64{}
+	}
+
+	return series[0], series[1], series[2]
+}
+
+// Reset is a function.
+func (indicator *macdIndicator) Reset() {
+	indicator.fastEMAIndicator.Reset()
+	indicator.slowEMAIndicator.Reset()
+	indicator.signalEMAIndicator.Reset()
+}
+
+// Update is a function.
+func (indicator *macdIndicator) Update(
+	candle Candle,
+) ([]float64, bool) {
+	fastValues, fastOK := indicator.fastEMAIndicator.Update(candle)
+	slowValues, slowOK := indicator.slowEMAIndicator.Update(candle)
+
+	if !fastOK || !slowOK {
+		return []float64{0, 0, 0}, false
+	}
+
+	macd := fastValues[0] - slowValues[0]
+	signalValues, ok := indicator.signalEMAIndicator.Update(Candle{
+		Close:  macd,
+		High:   macd,
+		Low:    macd,
+		Open:   macd,
+		Volume: 0,
+	})
+
+	return []float64{macd, signalValues[0], macd - signalValues[0]}, ok
+}
@@ -0,0 +1,51 @@
+package indicator
+
+type obvIndicator struct {
+	count int
+	value float64
+	close float64
+}
+
+var _ Indicatorer = (*obvIndicator)(nil)
+
+// NewOBVIndicator is a function.
+func NewOBVIndicator() *obvIndicator {
+	return &obvIndicator{
+		count: 0,
+		value: 0,
+		close: 0,
+	}
+}
+
+// OBV is a function.
+func OBV(
+	candles []Candle,
+) []float64 {
+	return firstSeries(Batch(NewOBVIndicator(), candles))
+}
+
+// Reset is a function.
+func (indicator *obvIndicator) Reset() {
+	indicator.count = 0
+	indicator.value = 0
+	indicator.close = 0
+}
+
+// Update is a function.
+func (indicator *obvIndicator) Update(
+	candle Candle,
+) ([]float64, bool) {
+	if indicator.count != 0 {
+		switch {
+		case candle.Close > indicator.close:
+			indicator.value += candle.Volume
+		case candle.Close < indicator.close:
+			indicator.value -= candle.Volume
+		}
+	}
+
+	indicator.count++
+	indicator.close = candle.Close
+
+	return []float64{indicator.value}, true
+}
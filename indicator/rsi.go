@@ -0,0 +1,85 @@
+package indicator
+
+type rsiIndicator struct {
+	period      int
+	count       int
+	averageGain float64
+	averageLoss float64
+	close       float64
+}
+
+var _ Indicatorer = (*rsiIndicator)(nil)
+
+// NewRSIIndicator is a function.
+// Gains and losses are smoothed the way Wilder does it.
+func NewRSIIndicator(
+	periodValue uint32,
+) *rsiIndicator {
+	return &rsiIndicator{
+		period:      period(periodValue),
+		count:       0,
+		averageGain: 0,
+		averageLoss: 0,
+		close:       0,
+	}
+}
+
+// RSI is a function.
+func RSI(
+	values []float64,
+	periodValue uint32,
+) []float64 {
+	return firstSeries(Batch(NewRSIIndicator(periodValue), closeCandles(values)))
+}
+
+// Reset is a function.
+func (indicator *rsiIndicator) Reset() {
+	indicator.count = 0
+	indicator.averageGain = 0
+	indicator.averageLoss = 0
+	indicator.close = 0
+}
+
+// Update is a function.
+func (indicator *rsiIndicator) Update(
+	candle Candle,
+) ([]float64, bool) {
+	indicator.count++
+	change := candle.Close - indicator.close
+	indicator.close = candle.Close
+
+	if indicator.count == 1 {
+		return []float64{0}, false
+	}
+
+	var gain, loss float64
+	if change > 0 {
+		gain = change
+	} else {
+		loss = -change
+	}
+
+	periodValue := float64(indicator.period)
+
+	if indicator.count <= indicator.period+1 {
+		indicator.averageGain += gain / periodValue
+		indicator.averageLoss += loss / periodValue
+	} else {
+		indicator.averageGain = (indicator.averageGain*(periodValue-1) + gain) / periodValue
+		indicator.averageLoss = (indicator.averageLoss*(periodValue-1) + loss) / periodValue
+	}
+
+	return []float64{indicator.value()}, indicator.count > indicator.period
+}
+
+func (indicator *rsiIndicator) value() float64 {
+	if indicator.averageLoss == 0 {
+		if indicator.averageGain == 0 {
+			return 50
+		}
+
+		return 100
+	}
+
+	return 100 - 100/(1+indicator.averageGain/indicator.averageLoss)
+}
@@ -0,0 +1,55 @@
+package indicator
+
+type smaIndicator struct {
+	window []float64
+	count  int
+	index  int
+	sum    float64
+}
+
+var _ Indicatorer = (*smaIndicator)(nil)
+
+// NewSMAIndicator is a function.
+func NewSMAIndicator(
+	periodValue uint32,
+) *smaIndicator {
+	return &smaIndicator{
+		window: make([]float64, period(periodValue)),
+		count:  0,
+		index:  0,
+		sum:    0,
+	}
+}
+
+// SMA is a function.
+func SMA(
+	values []float64,
+	periodValue uint32,
+) []float64 {
+	return firstSeries(Batch(NewSMAIndicator(periodValue), closeCandles(values)))
+}
+
+// Reset is a function.
+func (indicator *smaIndicator) Reset() {
+	indicator.window = make([]float64, len(indicator.window))
+	indicator.count = 0
+	indicator.index = 0
+	indicator.sum = 0
+}
+
+// Update is a function.
+func (indicator *smaIndicator) Update(
+	candle Candle,
+) ([]float64, bool) {
+	indicator.sum += candle.Close - indicator.window[indicator.index]
+	indicator.window[indicator.index] = candle.Close
+	indicator.index = (indicator.index + 1) % len(indicator.window)
+
+	if indicator.count < len(indicator.window) {
+		indicator.count++
+	}
+
+	ready := indicator.count == len(indicator.window)
+
+	return []float64{indicator.sum / float64(indicator.count)}, ready
+}
@@ -0,0 +1,92 @@
+package indicator
+
+type stochasticIndicator struct {
+	highs        []float64
+	lows         []float64
+	count        int
+	index        int
+	smaIndicator *smaIndicator
+}
+
+var _ Indicatorer = (*stochasticIndicator)(nil)
+
+// NewStochasticIndicator is a function.
+// Update returns %K and %D, where %D is the SMA of %K over dPeriod.
+func NewStochasticIndicator(
+	kPeriod uint32,
+	dPeriod uint32,
+) *stochasticIndicator {
+	return &stochasticIndicator{
+		highs:        make([]float64, period(kPeriod)),
+		lows:         make([]float64, period(kPeriod)),
+		count:        0,
+		index:        0,
+		smaIndicator: NewSMAIndicator(dPeriod),
+	}
+}
+
+// Stochastic is a function.
+func Stochastic(
+	candles []Candle,
+	kPeriod uint32,
+	dPeriod uint32,
+) ([]float64, []float64) {
+	series := Batch(NewStochasticIndicator(kPeriod, dPeriod), candles)
+	if len(series) == 0 {
+		return []float64{}, []float64{}
+	}
+
+	return series[0], series[1]
+}
+
+// Reset is a function.
+func (indicator *stochasticIndicator) Reset() {
+	indicator.highs = make([]float64, len(indicator.highs))
+	indicator.lows = make([]float64, len(indicator.lows))
+	indicator.count = 0
+	indicator.index = 0
+	indicator.smaIndicator.Reset()
+}
+
+// Update is a function.
+func (indicator *stochasticIndicator) Update(
+	candle Candle,
+) ([]float64, bool) {
+	indicator.highs[indicator.index] = candle.High
+	indicator.lows[indicator.index] = candle.Low
+	indicator.index = (indicator.index + 1) % len(indicator.highs)
+
+	if indicator.count < len(indicator.highs) {
+		indicator.count++
+	}
+
+	if indicator.count < len(indicator.highs) {
+		return []float64{0, 0}, false
+	}
+
+	highest, lowest := indicator.highs[0], indicator.lows[0]
+	for index := range indicator.highs {
+		if indicator.highs[index] > highest {
+			highest = indicator.highs[index]
+		}
+
+		if indicator.lows[index] < lowest {
+			lowest = indicator.lows[index]
+		}
+	}
+
+	k := float64(50)
+	if highest != lowest {
+		k = 100 * (candle.Close - lowest) / (highest - lowest)
+	}
+
+	values, ok := indicator.smaIndicator.Update(Candle{
+		Close:  k,
+		High:   k,
+		Low:    k,
+		Open:   k,
+		Volume: 0,
+	})
+
+	return []float64{k, values[0]}, ok
+}
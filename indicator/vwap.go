@@ -0,0 +1,44 @@
+package indicator
+
+type vwapIndicator struct {
+	priceVolume float64
+	volume      float64
+}
+
+var _ Indicatorer = (*vwapIndicator)(nil)
+
+// NewVWAPIndicator is a function.
+// It accumulates from the last Reset, so a session VWAP is reset at the start of every session.
+func NewVWAPIndicator() *vwapIndicator {
+	return &vwapIndicator{
+		priceVolume: 0,
+		volume:      0,
+	}
+}
+
+// VWAP is a function.
+func VWAP(
+	candles []Candle,
+) []float64 {
+	return firstSeries(Batch(NewVWAPIndicator(), candles))
+}
+
+// Reset is a function.
+func (indicator *vwapIndicator) Reset() {
+	indicator.priceVolume = 0
+	indicator.volume = 0
+}
+
+// Update is a function.
+func (indicator *vwapIndicator) Update(
+	candle Candle,
+) ([]float64, bool) {
+	indicator.priceVolume += (candle.High + candle.Low + candle.Close) / 3 * candle.Volume
+	indicator.volume += candle.Volume
+
+	if indicator.volume == 0 {
+		return []float64{0}, false
+	}
+
+	return []float64{indicator.priceVolume / indicator.volume}, true
+}
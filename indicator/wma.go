@@ -0,0 +1,60 @@
+package indicator
+
+type wmaIndicator struct {
+	window []float64
+	count  int
+	index  int
+}
+
+var _ Indicatorer = (*wmaIndicator)(nil)
+
+// NewWMAIndicator is a function.
+func NewWMAIndicator(
+	periodValue uint32,
+) *wmaIndicator {
+	return &wmaIndicator{
+		window: make([]float64, period(periodValue)),
+		count:  0,
+		index:  0,
+	}
+}
+
+// WMA is a function.
+func WMA(
+	values []float64,
+	periodValue uint32,
+) []float64 {
+	return firstSeries(Batch(NewWMAIndicator(periodValue), closeCandles(values)))
+}
+
+// Reset is a function.
+func (indicator *wmaIndicator) Reset() {
+	indicator.window = make([]float64, len(indicator.window))
+	indicator.count = 0
+	indicator.index = 0
+}
+
+// Update is a function.
+func (indicator *wmaIndicator) Update(
+	candle Candle,
+) ([]float64, bool) {
+	indicator.window[indicator.index] = candle.Close
+	indicator.index = (indicator.index + 1) % len(indicator.window)
+
+	if indicator.count < len(indicator.window) {
+		indicator.count++
+	}
+
+	var sum, weightSum float64
+
+	length := len(indicator.window)
+
+	// The oldest value gets weight 1 and the newest gets weight count.
+	for weight := 1; weight <= indicator.count; weight++ {
+		index := (indicator.index - indicator.count + weight - 1 + 2*length) % length
+		sum += float64(weight) * indicator.window[index]
+		weightSum += float64(weight)
+	}
+
+	return []float64{sum / weightSum}, indicator.count == len(indicator.window)
+}
@@ -5,14 +5,16 @@ import (
 	"time"
 
 	kucoin "github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/backtest"
 	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
 	"github.com/ShahoBashoki/kucoin/log"
 	"github.com/ShahoBashoki/kucoin/object"
-	"github.com/ShahoBashoki/kucoin/object/dao"
-	"github.com/ShahoBashoki/kucoin/object/dto"
 	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/scheduler"
 	"github.com/ShahoBashoki/kucoin/server"
 	"github.com/ShahoBashoki/kucoin/service"
+	"github.com/ShahoBashoki/kucoin/strategy"
 	"github.com/ShahoBashoki/kucoin/util"
 	"github.com/spf13/viper"
 	"go.opentelemetry.io/otel/codes"
@@ -27,7 +29,25 @@ func main() {
 	ctx := context.Background()
 
 	viper.AutomaticEnv()
+	viper.SetDefault("ALGO_VWAP_LOOKBACK", object.NUMAlgoConfigDefaultVWAPLookback)
+	viper.SetDefault("BACKTEST_ENABLED", false)
+	viper.SetDefault("BACKTEST_END_AT", 0)
+	viper.SetDefault("BACKTEST_INITIAL_BALANCE", object.NUMBacktestConfigDefaultInitialBalance)
+	viper.SetDefault("BACKTEST_KLINE_TYPE", string(object.KlineTypeType5min))
+	viper.SetDefault(
+		"BACKTEST_MAX_HOLD_KLINE_COUNT",
+		object.NUMBacktestConfigDefaultMaxHoldKlineCount,
+	)
+	viper.SetDefault("BACKTEST_ORDER_BOOK_FILE", object.URIEmpty)
+	viper.SetDefault("BACKTEST_ORDER_FUNDS", object.NUMBacktestConfigDefaultOrderFunds)
+	viper.SetDefault("BACKTEST_OUTPUT_DIR", "backtest")
+	viper.SetDefault("BACKTEST_SLIPPAGE", object.NUMBacktestConfigDefaultSlippage)
+	viper.SetDefault("BACKTEST_START_AT", 0)
+	viper.SetDefault("BACKTEST_SYMBOLS", []string{})
 	viper.SetDefault("DATABASE_DSN", "postgresql://root@127.0.0.1:26257/defaultdb?sslmode=disable")
+	viper.SetDefault("KLINE_BACKFILL_LOOKBACK", object.NUMKlineConfigDefaultBackfillLookback)
+	viper.SetDefault("KUCOIN_ACCOUNT", "master")
+	viper.SetDefault("KUCOIN_ACCOUNTS", `{}`)
 	viper.SetDefault("KUCOIN_KEY", "key")
 	viper.SetDefault("KUCOIN_PASS_PHRASE", "passPhrase")
 	viper.SetDefault("KUCOIN_SECRET", "secret")
@@ -50,22 +70,142 @@ func main() {
 	viper.SetDefault("OTEL_SERVICE_NAME", "kucoin")
 	viper.SetDefault("OTEL_SERVICE_NAMESPACE", "kucoin")
 	viper.SetDefault("OTEL_SERVICE_VERSION", "v0.1.0")
+	viper.SetDefault("PAPER_BALANCES", map[string]string{
+		object.URIPaperConfigDefaultBalanceCurrency: object.URIPaperConfigDefaultBalance,
+	})
+	viper.SetDefault("PAPER_ENABLED", false)
+	viper.SetDefault("PAPER_MAKER_FEE_RATE", object.NUMPaperConfigDefaultFeeRate)
+	viper.SetDefault("PAPER_MATCH_INTERVAL", object.NUMPaperConfigDefaultMatchInterval)
+	viper.SetDefault("PAPER_QUOTE_FILE", object.URIEmpty)
+	viper.SetDefault("PAPER_TAKER_FEE_RATE", object.NUMPaperConfigDefaultFeeRate)
+	viper.SetDefault("PORTFOLIO_CURRENCY", object.URIPortfolioConfigDefaultCurrency)
 	viper.SetDefault("REDPANDA_PROXY_URL", "http://redpanda:8082")
 	viper.SetDefault("REDPANDA_TOPIC", "kucoin")
 	viper.SetDefault(
 		"RUNTIME_KUCOIN_PAGINATION_REQUEST_SIZE",
 		object.NUMRuntimeConfigDefaultRuntimeKucoinPaginationRequestSize,
 	)
+	viper.SetDefault("REQUEST_BREAKER_COOLDOWN", object.NUMRequestConfigDefaultBreakerCooldown)
+	viper.SetDefault("REQUEST_BREAKER_THRESHOLD", object.NUMRequestConfigDefaultBreakerThreshold)
+	viper.SetDefault("REQUEST_LIMIT_WINDOW", object.NUMRequestConfigDefaultLimitWindow)
+	viper.SetDefault("REQUEST_MANAGEMENT_LIMIT", object.NUMRequestConfigDefaultManagementLimit)
+	viper.SetDefault("REQUEST_MAX_ATTEMPTS", object.NUMRequestConfigDefaultMaxAttempts)
+	viper.SetDefault("REQUEST_MAX_BACKOFF", object.NUMRequestConfigDefaultMaxBackoff)
+	viper.SetDefault("REQUEST_MIN_BACKOFF", object.NUMRequestConfigDefaultMinBackoff)
+	viper.SetDefault("REQUEST_PUBLIC_LIMIT", object.NUMRequestConfigDefaultPublicLimit)
+	viper.SetDefault("REQUEST_SPOT_LIMIT", object.NUMRequestConfigDefaultSpotLimit)
+	viper.SetDefault("RISK_LIMITS", `{}`)
 	viper.SetDefault("RUNTIME_NODE", "kucoin")
 	viper.SetDefault("RUNTIME_VALIDATE_MAP_RULES", `{"rules":[{"version":"1"}]}`)
+	viper.SetDefault(
+		"SCHEDULER_ALGO_ORDER_SYNC_INTERVAL",
+		object.NUMSchedulerConfigDefaultAlgoOrderSyncInterval,
+	)
+	viper.SetDefault(
+		"SCHEDULER_BRACKET_SYNC_INTERVAL",
+		object.NUMSchedulerConfigDefaultBracketSyncInterval,
+	)
+	viper.SetDefault(
+		"SCHEDULER_EXCHANGE_STATUS_SYNC_INTERVAL",
+		object.NUMSchedulerConfigDefaultExchangeStatusSyncInterval,
+	)
+	viper.SetDefault(
+		"SCHEDULER_FILL_SYNC_INTERVAL",
+		object.NUMSchedulerConfigDefaultFillSyncInterval,
+	)
+	viper.SetDefault(
+		"SCHEDULER_MARGIN_SYNC_INTERVAL",
+		object.NUMSchedulerConfigDefaultMarginSyncInterval,
+	)
+	viper.SetDefault(
+		"SCHEDULER_ORDER_RECONCILE_INTERVAL",
+		object.NUMSchedulerConfigDefaultOrderReconcileInterval,
+	)
+	viper.SetDefault("SCHEDULER_ORDER_SYNC_CRON", object.URISchedulerConfigDefaultOrderSyncCron)
+	viper.SetDefault(
+		"SCHEDULER_PORTFOLIO_SNAPSHOT_INTERVAL",
+		object.NUMSchedulerConfigDefaultPortfolioSnapshotInterval,
+	)
+	viper.SetDefault(
+		"SCHEDULER_STOP_ORDER_SYNC_INTERVAL",
+		object.NUMSchedulerConfigDefaultStopOrderSyncInterval,
+	)
+	viper.SetDefault(
+		"SCHEDULER_STRATEGY_EVALUATION_DELAY",
+		object.NUMSchedulerConfigDefaultStrategyEvaluationDelay,
+	)
+	viper.SetDefault(
+		"SCHEDULER_STRATEGY_EVALUATION_KLINE_TYPE",
+		string(object.KlineTypeType5min),
+	)
+	viper.SetDefault(
+		"SCHEDULER_SYMBOL_REFRESH_INTERVAL",
+		object.NUMSchedulerConfigDefaultSymbolRefreshInterval,
+	)
+	viper.SetDefault(
+		"SCHEDULER_TICKER_REFRESH_INTERVAL",
+		object.NUMSchedulerConfigDefaultTickerRefreshInterval,
+	)
 	viper.SetDefault("SERVER_ENDPOINT_ADDR", ":8080")
 	viper.SetDefault("SERVER_ENDPOINT_NETWORK", "tcp")
+	viper.SetDefault("SIZING_PARAMETERS", map[string]string{
+		object.URISizingParameterModel:       string(object.SizingModelTypeFixedQuote),
+		object.URISizingParameterQuoteAmount: object.URISizingConfigDefaultQuoteAmount,
+	})
+	viper.SetDefault("STRATEGY_ACCOUNTS", `{}`)
+	viper.SetDefault("STRATEGY_NAMES", []string{object.URIStrategyOpenLowMarketRatio})
+	viper.SetDefault("STRATEGY_PARAMETERS", `{}`)
+	viper.SetDefault("STREAM_KLINE_TYPES", []string{string(object.KlineTypeType1min)})
+	viper.SetDefault("STREAM_ORDER_BOOK", false)
+	viper.SetDefault("STREAM_PRIVATE", false)
+	viper.SetDefault(
+		"STREAM_RECONNECT_MAX_BACKOFF",
+		object.NUMStreamConfigDefaultReconnectMaxBackoff,
+	)
+	viper.SetDefault(
+		"STREAM_RECONNECT_MIN_BACKOFF",
+		object.NUMStreamConfigDefaultReconnectMinBackoff,
+	)
+	viper.SetDefault("STREAM_SYMBOLS", []string{})
+
+	kucoinAccountConfiggers := map[string]config.KucoinAccountConfigger{}
+
+	for name, credentials := range util.CastStringMapString(viper.GetStringMap("KUCOIN_ACCOUNTS")) {
+		kucoinAccountConfiggers[name] = config.NewKucoinAccountConfig(
+			config.WithKucoinAccountConfigKey(credentials["key"]),
+			config.WithKucoinAccountConfigPassPhrase(credentials["pass_phrase"]),
+			config.WithKucoinAccountConfigSecret(credentials["secret"]),
+		)
+	}
 
 	configConfig := config.NewConfig(
+		config.WithAlgoConfigger(
+			config.WithAlgoConfigVWAPLookback(viper.GetDuration("ALGO_VWAP_LOOKBACK")),
+		),
+		config.WithBacktestConfigger(
+			config.WithBacktestConfigEnabled(viper.GetBool("BACKTEST_ENABLED")),
+			config.WithBacktestConfigEndAt(viper.GetInt64("BACKTEST_END_AT")),
+			config.WithBacktestConfigInitialBalance(viper.GetFloat64("BACKTEST_INITIAL_BALANCE")),
+			config.WithBacktestConfigKlineType(viper.GetString("BACKTEST_KLINE_TYPE")),
+			config.WithBacktestConfigMaxHoldKlineCount(
+				viper.GetUint32("BACKTEST_MAX_HOLD_KLINE_COUNT"),
+			),
+			config.WithBacktestConfigOrderBookFile(viper.GetString("BACKTEST_ORDER_BOOK_FILE")),
+			config.WithBacktestConfigOrderFunds(viper.GetFloat64("BACKTEST_ORDER_FUNDS")),
+			config.WithBacktestConfigOutputDir(viper.GetString("BACKTEST_OUTPUT_DIR")),
+			config.WithBacktestConfigSlippage(viper.GetFloat64("BACKTEST_SLIPPAGE")),
+			config.WithBacktestConfigStartAt(viper.GetInt64("BACKTEST_START_AT")),
+			config.WithBacktestConfigSymbols(viper.GetStringSlice("BACKTEST_SYMBOLS")),
+		),
 		config.WithDatabaseConfigger(
 			config.WithDatabaseConfigDSN(viper.GetString("DATABASE_DSN")),
 		),
+		config.WithKlineConfigger(
+			config.WithKlineConfigBackfillLookback(viper.GetDuration("KLINE_BACKFILL_LOOKBACK")),
+		),
 		config.WithKucoinConfigger(
+			config.WithKucoinConfigAccount(viper.GetString("KUCOIN_ACCOUNT")),
+			config.WithKucoinConfigAccounts(kucoinAccountConfiggers),
 			config.WithKucoinConfigKey(viper.GetString("KUCOIN_KEY")),
 			config.WithKucoinConfigPassPhrase(viper.GetString("KUCOIN_PASS_PHRASE")),
 			config.WithKucoinConfigSecret(viper.GetString("KUCOIN_SECRET")),
@@ -99,10 +239,35 @@ func main() {
 			config.WithOtelConfigServiceNamespace(viper.GetString("OTEL_SERVICE_NAMESPACE")),
 			config.WithOtelConfigServiceVersion(viper.GetString("OTEL_SERVICE_VERSION")),
 		),
+		config.WithPaperConfigger(
+			config.WithPaperConfigBalances(viper.GetStringMapString("PAPER_BALANCES")),
+			config.WithPaperConfigEnabled(viper.GetBool("PAPER_ENABLED")),
+			config.WithPaperConfigMakerFeeRate(viper.GetFloat64("PAPER_MAKER_FEE_RATE")),
+			config.WithPaperConfigMatchInterval(viper.GetDuration("PAPER_MATCH_INTERVAL")),
+			config.WithPaperConfigQuoteFile(viper.GetString("PAPER_QUOTE_FILE")),
+			config.WithPaperConfigTakerFeeRate(viper.GetFloat64("PAPER_TAKER_FEE_RATE")),
+		),
+		config.WithPortfolioConfigger(
+			config.WithPortfolioConfigCurrency(viper.GetString("PORTFOLIO_CURRENCY")),
+		),
 		config.WithRedpandaConfigger(
 			config.WithRedpandaConfigProxyURL(viper.GetString("REDPANDA_PROXY_URL")),
 			config.WithRedpandaConfigTopic(viper.GetString("REDPANDA_TOPIC")),
 		),
+		config.WithRequestConfigger(
+			config.WithRequestConfigBreakerCooldown(viper.GetDuration("REQUEST_BREAKER_COOLDOWN")),
+			config.WithRequestConfigBreakerThreshold(viper.GetUint32("REQUEST_BREAKER_THRESHOLD")),
+			config.WithRequestConfigLimitWindow(viper.GetDuration("REQUEST_LIMIT_WINDOW")),
+			config.WithRequestConfigManagementLimit(viper.GetUint32("REQUEST_MANAGEMENT_LIMIT")),
+			config.WithRequestConfigMaxAttempts(viper.GetUint32("REQUEST_MAX_ATTEMPTS")),
+			config.WithRequestConfigMaxBackoff(viper.GetDuration("REQUEST_MAX_BACKOFF")),
+			config.WithRequestConfigMinBackoff(viper.GetDuration("REQUEST_MIN_BACKOFF")),
+			config.WithRequestConfigPublicLimit(viper.GetUint32("REQUEST_PUBLIC_LIMIT")),
+			config.WithRequestConfigSpotLimit(viper.GetUint32("REQUEST_SPOT_LIMIT")),
+		),
+		config.WithRiskConfigger(
+			config.WithRiskConfigLimits(viper.GetStringMapString("RISK_LIMITS")),
+		),
 		config.WithRuntimeConfigger(
 			config.WithRuntimeConfigKucoinPaginationRequestSize(
 				viper.GetInt64("RUNTIME_KUCOIN_PAGINATION_REQUEST_SIZE"),
@@ -112,6 +277,45 @@ func main() {
 				util.Cast(viper.GetStringMap("RUNTIME_VALIDATE_MAP_RULES")),
 			),
 		),
+		config.WithSchedulerConfigger(
+			config.WithSchedulerConfigAlgoOrderSyncInterval(
+				viper.GetDuration("SCHEDULER_ALGO_ORDER_SYNC_INTERVAL"),
+			),
+			config.WithSchedulerConfigBracketSyncInterval(
+				viper.GetDuration("SCHEDULER_BRACKET_SYNC_INTERVAL"),
+			),
+			config.WithSchedulerConfigExchangeStatusSyncInterval(
+				viper.GetDuration("SCHEDULER_EXCHANGE_STATUS_SYNC_INTERVAL"),
+			),
+			config.WithSchedulerConfigFillSyncInterval(
+				viper.GetDuration("SCHEDULER_FILL_SYNC_INTERVAL"),
+			),
+			config.WithSchedulerConfigMarginSyncInterval(
+				viper.GetDuration("SCHEDULER_MARGIN_SYNC_INTERVAL"),
+			),
+			config.WithSchedulerConfigOrderReconcileInterval(
+				viper.GetDuration("SCHEDULER_ORDER_RECONCILE_INTERVAL"),
+			),
+			config.WithSchedulerConfigOrderSyncCron(viper.GetString("SCHEDULER_ORDER_SYNC_CRON")),
+			config.WithSchedulerConfigPortfolioSnapshotInterval(
+				viper.GetDuration("SCHEDULER_PORTFOLIO_SNAPSHOT_INTERVAL"),
+			),
+			config.WithSchedulerConfigStopOrderSyncInterval(
+				viper.GetDuration("SCHEDULER_STOP_ORDER_SYNC_INTERVAL"),
+			),
+			config.WithSchedulerConfigStrategyEvaluationDelay(
+				viper.GetDuration("SCHEDULER_STRATEGY_EVALUATION_DELAY"),
+			),
+			config.WithSchedulerConfigStrategyEvaluationKlineType(
+				viper.GetString("SCHEDULER_STRATEGY_EVALUATION_KLINE_TYPE"),
+			),
+			config.WithSchedulerConfigSymbolRefreshInterval(
+				viper.GetDuration("SCHEDULER_SYMBOL_REFRESH_INTERVAL"),
+			),
+			config.WithSchedulerConfigTickerRefreshInterval(
+				viper.GetDuration("SCHEDULER_TICKER_REFRESH_INTERVAL"),
+			),
+		),
 		config.WithServerConfigger(
 			config.WithServerConfigEndpointConfigger(
 				config.NewEndpointConfig(
@@ -120,6 +324,26 @@ func main() {
 				),
 			),
 		),
+		config.WithSizingConfigger(
+			config.WithSizingConfigParameters(viper.GetStringMapString("SIZING_PARAMETERS")),
+		),
+		config.WithStrategyConfigger(
+			config.WithStrategyConfigAccounts(viper.GetStringMapString("STRATEGY_ACCOUNTS")),
+			config.WithStrategyConfigNames(viper.GetStringSlice("STRATEGY_NAMES")),
+			config.WithStrategyConfigParameters(viper.GetStringMapString("STRATEGY_PARAMETERS")),
+		),
+		config.WithStreamConfigger(
+			config.WithStreamConfigKlineTypes(viper.GetStringSlice("STREAM_KLINE_TYPES")),
+			config.WithStreamConfigOrderBook(viper.GetBool("STREAM_ORDER_BOOK")),
+			config.WithStreamConfigPrivate(viper.GetBool("STREAM_PRIVATE")),
+			config.WithStreamConfigReconnectMaxBackoff(
+				viper.GetDuration("STREAM_RECONNECT_MAX_BACKOFF"),
+			),
+			config.WithStreamConfigReconnectMinBackoff(
+				viper.GetDuration("STREAM_RECONNECT_MIN_BACKOFF"),
+			),
+			config.WithStreamConfigSymbols(viper.GetStringSlice("STREAM_SYMBOLS")),
+		),
 	)
 
 	logZapLogger := log.NewZapLogger(configConfig)
@@ -189,6 +413,70 @@ func main() {
 	}
 
 	repositoryRepository := repository.NewRepository(
+		repository.WithAlgoOrderRepositorier(
+			configConfig,
+			logRuntimeLog,
+			traceTracer,
+			utilUUID,
+			repository.WithAlgoOrderRepositoryDB(gormDB),
+			repository.WithAlgoOrderRepositoryTimer(objectTime),
+		),
+		repository.WithBracketRepositorier(
+			configConfig,
+			logRuntimeLog,
+			traceTracer,
+			utilUUID,
+			repository.WithBracketRepositoryDB(gormDB),
+			repository.WithBracketRepositoryTimer(objectTime),
+		),
+		repository.WithBalanceRepositorier(
+			configConfig,
+			logRuntimeLog,
+			traceTracer,
+			utilUUID,
+			repository.WithBalanceRepositoryDB(gormDB),
+			repository.WithBalanceRepositoryTimer(objectTime),
+		),
+		repository.WithFillRepositorier(
+			configConfig,
+			logRuntimeLog,
+			traceTracer,
+			utilUUID,
+			repository.WithFillRepositoryDB(gormDB),
+			repository.WithFillRepositoryTimer(objectTime),
+		),
+		repository.WithBorrowRepositorier(
+			configConfig,
+			logRuntimeLog,
+			traceTracer,
+			utilUUID,
+			repository.WithBorrowRepositoryDB(gormDB),
+			repository.WithBorrowRepositoryTimer(objectTime),
+		),
+		repository.WithEquityRepositorier(
+			configConfig,
+			logRuntimeLog,
+			traceTracer,
+			utilUUID,
+			repository.WithEquityRepositoryDB(gormDB),
+			repository.WithEquityRepositoryTimer(objectTime),
+		),
+		repository.WithKlineRepositorier(
+			configConfig,
+			logRuntimeLog,
+			traceTracer,
+			utilUUID,
+			repository.WithKlineRepositoryDB(gormDB),
+			repository.WithKlineRepositoryTimer(objectTime),
+		),
+		repository.WithKillSwitchRepositorier(
+			configConfig,
+			logRuntimeLog,
+			traceTracer,
+			utilUUID,
+			repository.WithKillSwitchRepositoryDB(gormDB),
+			repository.WithKillSwitchRepositoryTimer(objectTime),
+		),
 		repository.WithOrderRepositorier(
 			configConfig,
 			logRuntimeLog,
@@ -197,6 +485,38 @@ func main() {
 			repository.WithOrderRepositoryDB(gormDB),
 			repository.WithOrderRepositoryTimer(objectTime),
 		),
+		repository.WithMarginBalanceRepositorier(
+			configConfig,
+			logRuntimeLog,
+			traceTracer,
+			utilUUID,
+			repository.WithMarginBalanceRepositoryDB(gormDB),
+			repository.WithMarginBalanceRepositoryTimer(objectTime),
+		),
+		repository.WithStopOrderRepositorier(
+			configConfig,
+			logRuntimeLog,
+			traceTracer,
+			utilUUID,
+			repository.WithStopOrderRepositoryDB(gormDB),
+			repository.WithStopOrderRepositoryTimer(objectTime),
+		),
+		repository.WithMarginRiskLimitRepositorier(
+			configConfig,
+			logRuntimeLog,
+			traceTracer,
+			utilUUID,
+			repository.WithMarginRiskLimitRepositoryDB(gormDB),
+			repository.WithMarginRiskLimitRepositoryTimer(objectTime),
+		),
+		repository.WithSymbolRepositorier(
+			configConfig,
+			logRuntimeLog,
+			traceTracer,
+			utilUUID,
+			repository.WithSymbolRepositoryDB(gormDB),
+			repository.WithSymbolRepositoryTimer(objectTime),
+		),
 		repository.WithTickerRepositorier(
 			configConfig,
 			logRuntimeLog,
@@ -207,188 +527,348 @@ func main() {
 		),
 	)
 
-	kucoinAPIService := kucoin.NewApiService(
+	requestConfigger := configConfig.GetRequestConfigger()
+	exchangePublicRateLimiter := exchange.NewTokenBucket(
+		objectTime,
+		requestConfigger.GetPublicLimit(),
+		requestConfigger.GetLimitWindow(),
+	)
+	exchangePublicCircuitBreaker := exchange.NewCircuitBreaker(
+		objectTime,
+		requestConfigger.GetBreakerThreshold(),
+		requestConfigger.GetBreakerCooldown(),
+	)
+	newExchanger := func(optioners ...kucoin.ApiServiceOption) exchange.Exchanger {
+		return exchange.NewRetryExchange(
+			exchange.NewExchanger(optioners...),
+			objectTime,
+			map[object.EndpointGroupType]exchange.CircuitBreaker{
+				object.EndpointGroupTypeManagement: exchange.NewCircuitBreaker(
+					objectTime,
+					requestConfigger.GetBreakerThreshold(),
+					requestConfigger.GetBreakerCooldown(),
+				),
+				object.EndpointGroupTypePublic: exchangePublicCircuitBreaker,
+				object.EndpointGroupTypeSpot: exchange.NewCircuitBreaker(
+					objectTime,
+					requestConfigger.GetBreakerThreshold(),
+					requestConfigger.GetBreakerCooldown(),
+				),
+			},
+			map[object.EndpointGroupType]exchange.RateLimiter{
+				object.EndpointGroupTypeManagement: exchange.NewTokenBucket(
+					objectTime,
+					requestConfigger.GetManagementLimit(),
+					requestConfigger.GetLimitWindow(),
+				),
+				object.EndpointGroupTypePublic: exchangePublicRateLimiter,
+				object.EndpointGroupTypeSpot: exchange.NewTokenBucket(
+					objectTime,
+					requestConfigger.GetSpotLimit(),
+					requestConfigger.GetLimitWindow(),
+				),
+			},
+			requestConfigger.GetMaxAttempts(),
+			requestConfigger.GetMinBackoff(),
+			requestConfigger.GetMaxBackoff(),
+		)
+	}
+
+	exchangeExchanger := newExchanger(
 		kucoin.ApiKeyOption(configConfig.GetKucoinConfigger().GetKey()),
 		kucoin.ApiSecretOption(configConfig.GetKucoinConfigger().GetSecret()),
 		kucoin.ApiPassPhraseOption(configConfig.GetKucoinConfigger().GetPassPhrase()),
 		kucoin.ApiKeyVersionOption(kucoin.ApiKeyVersionV2),
 	)
 
-	servicer := service.NewServicer(
-		configConfig,
-		repositoryRepository,
-		logRuntimeLog,
-		traceTracer,
-		utilUUID,
-		kucoinAPIService,
-	)
-	serverer := server.NewServerrer(
-		configConfig,
-		logRuntimeLog,
-		servicer,
-		traceTracer,
-		utilUUID,
-	)
+	kucoinConfigger := configConfig.GetKucoinConfigger()
+	exchangeExchangers := make(map[string]exchange.Exchanger, len(kucoinConfigger.GetAccounts()))
 
-	for {
-		deletedAt, errTickerDeleteAll := servicer.GetTickerServicer().DeleteAll(ctx)
-		if errTickerDeleteAll != nil {
-			logRuntimeLog.
-				WithFields(fields).
-				WithField(object.URIFieldError, errTickerDeleteAll).
-				Error(object.ErrTickerServiceDeleteAll.Error())
-			traceSpan.RecordError(errTickerDeleteAll)
-			traceSpan.SetStatus(codes.Error, object.ErrTickerServiceDeleteAll.Error())
+	for name, kucoinAccountConfigger := range kucoinConfigger.GetAccounts() {
+		exchangeExchangers[name] = newExchanger(
+			kucoin.ApiKeyOption(kucoinAccountConfigger.GetKey()),
+			kucoin.ApiSecretOption(kucoinAccountConfigger.GetSecret()),
+			kucoin.ApiPassPhraseOption(kucoinAccountConfigger.GetPassPhrase()),
+			kucoin.ApiKeyVersionOption(kucoin.ApiKeyVersionV2),
+		)
+	}
+
+	for name, account := range configConfig.GetStrategyConfigger().GetAccounts() {
+		if _, ok := exchangeExchangers[account]; ok || account == kucoinConfigger.GetAccount() {
+			continue
 		}
 
 		logRuntimeLog.
 			WithFields(fields).
-			WithField(object.URIFieldDeletedAt, deletedAt).
-			Debug(object.URIEmpty)
+			WithField(object.URIFieldStrategy, name).
+			WithField(object.URIFieldAccount, account).
+			Error(object.ErrStrategyAccountUnknown.Error())
+		traceSpan.RecordError(object.ErrStrategyAccountUnknown)
+		traceSpan.SetStatus(codes.Error, object.ErrStrategyAccountUnknown.Error())
 
-		if err = servicer.GetTickerServicer().GetListFromRemote(
-			ctx,
-		); err != nil {
-			logRuntimeLog.
-				WithFields(fields).
-				WithField(object.URIFieldError, err).
-				Error(object.ErrTickerKucoinServiceGetList.Error())
-			traceSpan.RecordError(err)
-			traceSpan.SetStatus(codes.Error, object.ErrTickerKucoinServiceGetList.Error())
-		}
+		return
+	}
 
-		daoCursor := dao.NewCursor(0)
+	exchangeExchanger = exchange.NewAccountExchange(
+		kucoinConfigger.GetAccount(),
+		exchangeExchanger,
+		exchangeExchangers,
+	)
 
-		logRuntimeLog.
-			WithFields(fields).
-			WithField(object.URIFieldDAOCursor, daoCursor).
-			Debug(object.URIEmpty)
+	var exchangePaperExchanger exchange.PaperExchanger
 
-		daoPagination := dao.NewPagination(daoCursor, object.NUMTopTickerChangeRateCount)
+	if paperConfigger := configConfig.GetPaperConfigger(); paperConfigger.GetEnabled() {
+		var exchangePaperQuoter exchange.PaperQuoter = exchange.NewPaperLiveQuote(exchangeExchanger)
 
-		logRuntimeLog.
-			WithFields(fields).
-			WithField(object.URIFieldDAOPagination, daoPagination).
-			Debug(object.URIEmpty)
+		if paperConfigger.GetQuoteFile() != object.URIEmpty {
+			quotes, errLoad := exchange.LoadPaperRecordedQuotes(paperConfigger.GetQuoteFile())
+			if errLoad != nil {
+				logRuntimeLog.
+					WithFields(fields).
+					WithField(object.URIFieldError, errLoad).
+					Error(object.ErrPaperQuoteLoad.Error())
+				traceSpan.RecordError(errLoad)
+				traceSpan.SetStatus(codes.Error, object.ErrPaperQuoteLoad.Error())
 
-		daoTickerFilter := dao.NewTickerFilter(
-			object.URIEmpty,
-			true,
-		)
+				return
+			}
 
-		logRuntimeLog.
-			WithFields(fields).
-			WithField(object.URIFieldDAOTickerFilter, daoTickerFilter).
-			Debug(object.URIEmpty)
+			exchangePaperQuoter = exchange.NewPaperRecordedQuote(objectTime, quotes)
+		}
 
-		omTickers, daoCursorer, errTickerGetListFromRepository := servicer.GetTickerServicer().
-			GetListFromRepository(ctx, daoPagination, daoTickerFilter)
-		if errTickerGetListFromRepository != nil {
+		exchangePaperExchanger, err = exchange.NewPaperExchange(
+			exchangeExchanger,
+			exchangePaperQuoter,
+			objectTime,
+			paperConfigger.GetBalances(),
+			paperConfigger.GetMakerFeeRate(),
+			paperConfigger.GetTakerFeeRate(),
+		)
+		if err != nil {
 			logRuntimeLog.
 				WithFields(fields).
-				WithField(object.URIFieldError, errTickerGetListFromRepository).
-				Error(object.ErrTickerServiceGetListFromRepository.Error())
-			traceSpan.RecordError(errTickerGetListFromRepository)
-			traceSpan.SetStatus(codes.Error, object.ErrTickerServiceGetListFromRepository.Error())
+				WithField(object.URIFieldError, err).
+				Error(object.ErrPaperBalanceParse.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrPaperBalanceParse.Error())
+
+			return
 		}
 
+		exchangeExchanger = exchangePaperExchanger
+	}
+
+	accounts := []string{kucoinConfigger.GetAccount()}
+	if exchangeAccountExchanger, ok := exchangeExchanger.(exchange.AccountExchanger); ok {
+		accounts = exchangeAccountExchanger.GetAccounts()
+	}
+
+	servicer := service.NewServicer(
+		configConfig,
+		repositoryRepository,
+		logRuntimeLog,
+		traceTracer,
+		utilUUID,
+		objectTime,
+		exchangeExchanger,
+	)
+	strategiers, err := strategy.NewRegistry(
+		configConfig,
+		logRuntimeLog,
+		traceTracer,
+		utilUUID,
+	).NewList()
+	if err != nil {
 		logRuntimeLog.
 			WithFields(fields).
-			WithField(object.URIFieldOMTickers, omTickers).
-			WithField(object.URIFieldDAOCursorer, daoCursorer).
-			Debug(object.URIEmpty)
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStrategyRegistryNew.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStrategyRegistryNew.Error())
+	}
 
-		for key, omTicker := range omTickers {
+	if configConfig.GetBacktestConfigger().GetEnabled() {
+		backtestResult, errBacktestRun := backtest.NewBacktest(
+			configConfig,
+			logRuntimeLog,
+			objectTime,
+			servicer,
+			traceTracer,
+			utilUUID,
+		).Run(ctx, strategiers)
+		if errBacktestRun != nil {
 			logRuntimeLog.
 				WithFields(fields).
-				WithField(object.URIFieldKey, key).
-				WithField(object.URIFieldOMTicker, omTicker).
-				Debug(object.URIEmpty)
+				WithField(object.URIFieldError, errBacktestRun).
+				Error(object.ErrBacktestRun.Error())
+			traceSpan.RecordError(errBacktestRun)
+			traceSpan.SetStatus(codes.Error, object.ErrBacktestRun.Error())
 
-			timeNowUnix := time.Now().Unix()
-			klineType := object.KlineTypeType5min
-			secondKlineType := util.KlineTypeToSecond(klineType)
-			modTime := timeNowUnix % secondKlineType
-			startAt := timeNowUnix - modTime - secondKlineType - object.NUMKlineDifference
+			return
+		}
 
+		if err = backtest.WriteResult(
+			configConfig.GetBacktestConfigger().GetOutputDir(),
+			backtestResult,
+		); err != nil {
 			logRuntimeLog.
 				WithFields(fields).
-				WithField(object.URIFieldTimeNowUnix, timeNowUnix).
-				WithField(object.URIFieldKlineType, klineType).
-				WithField(object.URIFieldSecondKlineType, secondKlineType).
-				WithField(object.URIFieldModTime, modTime).
-				WithField(object.URIFieldStartAt, startAt).
-				Debug(object.URIEmpty)
-
-			dtoKlineRequest := dto.NewKlineRequest(
-				klineType,
-				omTicker.GetSymbol(),
-				0,
-				startAt,
-			)
+				WithField(object.URIFieldError, err).
+				Error(object.ErrBacktestWrite.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrBacktestWrite.Error())
+		}
 
-			logRuntimeLog.
-				WithFields(fields).
-				WithField(object.URIFieldDTOKlineRequest, dtoKlineRequest).
-				Debug(object.URIEmpty)
+		return
+	}
 
-			openLowEquality, errKlineGetOpenLowEqualityFromRemote := servicer.GetKlineServicer().
-				GetOpenLowEqualityFromRemote(ctx, dtoKlineRequest)
-			if errKlineGetOpenLowEqualityFromRemote != nil {
-				logRuntimeLog.
-					WithFields(fields).
-					WithField(object.URIFieldError, errKlineGetOpenLowEqualityFromRemote).
-					Error(object.ErrKlineServiceGetOpenLowEqualityFromRemote.Error())
-				traceSpan.RecordError(errKlineGetOpenLowEqualityFromRemote)
-				traceSpan.SetStatus(
-					codes.Error,
-					object.ErrKlineServiceGetOpenLowEqualityFromRemote.Error(),
-				)
-			}
+	strategyMarket := strategy.NewMarket(objectTime, servicer)
 
+	go func() {
+		if errStreamRun := servicer.GetStreamServicer().Run(ctx); errStreamRun != nil {
 			logRuntimeLog.
 				WithFields(fields).
-				WithField(object.URIFieldOpenLowEquality, openLowEquality).
-				Debug(object.URIEmpty)
-
-			if !openLowEquality {
-				logRuntimeLog.
-					WithFields(fields).
-					Debug(`!openLowEquality`)
+				WithField(object.URIFieldError, errStreamRun).
+				Error(object.ErrStreamServiceRun.Error())
+			traceSpan.RecordError(errStreamRun)
+			traceSpan.SetStatus(codes.Error, object.ErrStreamServiceRun.Error())
+		}
+	}()
 
-				continue
-			}
+	for _, account := range accounts {
+		go func(account string) {
+			ctxAccount := util.WithRuntimeContextValue(ctx, object.URIRuntimeContextAccount, account)
 
-			marketRatio, errOrderBookGetMarketRatioFromRemote := servicer.GetOrderBookServicer().
-				GetMarketRatioFromRemote(ctx, omTicker.GetSymbol(), 2)
-			if errOrderBookGetMarketRatioFromRemote != nil {
+			if errPrivateStreamRun := servicer.
+				GetPrivateStreamServicer().
+				Run(ctxAccount); errPrivateStreamRun != nil {
 				logRuntimeLog.
 					WithFields(fields).
-					WithField(object.URIFieldError, errOrderBookGetMarketRatioFromRemote).
-					Error(object.ErrOrderBookServiceGetListFromRepository.Error())
-				traceSpan.RecordError(errOrderBookGetMarketRatioFromRemote)
-				traceSpan.SetStatus(
-					codes.Error,
-					object.ErrOrderBookServiceGetListFromRepository.Error(),
-				)
+					WithField(object.URIFieldAccount, account).
+					WithField(object.URIFieldError, errPrivateStreamRun).
+					Error(object.ErrPrivateStreamServiceRun.Error())
+				traceSpan.RecordError(errPrivateStreamRun)
+				traceSpan.SetStatus(codes.Error, object.ErrPrivateStreamServiceRun.Error())
 			}
+		}(account)
+	}
 
-			logRuntimeLog.
-				WithFields(fields).
-				WithField(object.URIFieldMarketRatio, marketRatio).
-				Debug(object.URIEmpty)
+	schedulerConfigger := configConfig.GetSchedulerConfigger()
+	schedulerScheduler := scheduler.NewScheduler(
+		configConfig,
+		logRuntimeLog,
+		objectTime,
+		traceTracer,
+		utilUUID,
+	)
+	schedulerScheduler.Register(
+		object.URISchedulerJobAlgoOrderSync,
+		scheduler.NewIntervalTrigger(schedulerConfigger.GetAlgoOrderSyncInterval()),
+		scheduler.NewAccountJob(accounts, scheduler.NewAlgoOrderSyncJob(servicer)),
+	)
+	schedulerScheduler.Register(
+		object.URISchedulerJobBracketSync,
+		scheduler.NewIntervalTrigger(schedulerConfigger.GetBracketSyncInterval()),
+		scheduler.NewAccountJob(accounts, scheduler.NewBracketSyncJob(servicer)),
+	)
+	schedulerScheduler.Register(
+		object.URISchedulerJobExchangeStatusSync,
+		scheduler.NewIntervalTrigger(schedulerConfigger.GetExchangeStatusSyncInterval()),
+		scheduler.NewExchangeStatusSyncJob(servicer),
+	)
+	schedulerScheduler.Register(
+		object.URISchedulerJobFillSync,
+		scheduler.NewIntervalTrigger(schedulerConfigger.GetFillSyncInterval()),
+		scheduler.NewAccountJob(accounts, scheduler.NewFillSyncJob(servicer)),
+	)
+	schedulerScheduler.Register(
+		object.URISchedulerJobMarginSync,
+		scheduler.NewIntervalTrigger(schedulerConfigger.GetMarginSyncInterval()),
+		scheduler.NewAccountJob(accounts, scheduler.NewMarginSyncJob(servicer)),
+	)
+	schedulerScheduler.Register(
+		object.URISchedulerJobOrderReconcile,
+		scheduler.NewIntervalTrigger(schedulerConfigger.GetOrderReconcileInterval()),
+		scheduler.NewAccountJob(accounts, scheduler.NewOrderReconcileJob(servicer)),
+	)
+	schedulerScheduler.Register(
+		object.URISchedulerJobPortfolioSnapshot,
+		scheduler.NewIntervalTrigger(schedulerConfigger.GetPortfolioSnapshotInterval()),
+		scheduler.NewPortfolioSnapshotJob(servicer, accounts),
+	)
+	schedulerScheduler.Register(
+		object.URISchedulerJobSymbolRefresh,
+		scheduler.NewIntervalTrigger(schedulerConfigger.GetSymbolRefreshInterval()),
+		scheduler.NewSymbolRefreshJob(servicer),
+	)
+	schedulerScheduler.Register(
+		object.URISchedulerJobTickerRefresh,
+		scheduler.NewIntervalTrigger(schedulerConfigger.GetTickerRefreshInterval()),
+		scheduler.NewTickerRefreshJob(servicer),
+	)
+	schedulerScheduler.Register(
+		object.URISchedulerJobStopOrderSync,
+		scheduler.NewIntervalTrigger(schedulerConfigger.GetStopOrderSyncInterval()),
+		scheduler.NewAccountJob(accounts, scheduler.NewStopOrderSyncJob(servicer)),
+	)
+	schedulerScheduler.Register(
+		object.URISchedulerJobStrategyEvaluation,
+		scheduler.NewKlineTrigger(
+			object.KlineTypeType(schedulerConfigger.GetStrategyEvaluationKlineType()),
+			schedulerConfigger.GetStrategyEvaluationDelay(),
+		),
+		scheduler.NewStrategyEvaluationJob(
+			servicer,
+			logRuntimeLog,
+			strategyMarket,
+			configConfig.GetStrategyConfigger().GetAccounts(),
+			strategiers,
+		),
+	)
 
-			if !marketRatio {
-				logRuntimeLog.
-					WithFields(fields).
-					Debug(`!marketRatio`)
+	schedulerCronTrigger, err := scheduler.NewCronTrigger(schedulerConfigger.GetOrderSyncCron())
+	if err != nil {
+		logRuntimeLog.
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSchedulerCronParse.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSchedulerCronParse.Error())
+	} else {
+		schedulerScheduler.Register(
+			object.URISchedulerJobOrderSync,
+			schedulerCronTrigger,
+			scheduler.NewAccountJob(accounts, scheduler.NewOrderSyncJob(servicer)),
+		)
+	}
 
-				continue
-			}
+	if exchangePaperExchanger != nil {
+		schedulerScheduler.Register(
+			object.URISchedulerJobPaperMatch,
+			scheduler.NewIntervalTrigger(configConfig.GetPaperConfigger().GetMatchInterval()),
+			scheduler.NewPaperMatchJob(exchangePaperExchanger),
+		)
+	}
+
+	go func() {
+		if errSchedulerRun := schedulerScheduler.Run(ctx); errSchedulerRun != nil {
+			logRuntimeLog.
+				WithFields(fields).
+				WithField(object.URIFieldError, errSchedulerRun).
+				Error(object.ErrSchedulerJobRun.Error())
+			traceSpan.RecordError(errSchedulerRun)
+			traceSpan.SetStatus(codes.Error, object.ErrSchedulerJobRun.Error())
 		}
+	}()
 
-		break
-	}
+	serverer := server.NewServerrer(
+		configConfig,
+		logRuntimeLog,
+		schedulerScheduler,
+		servicer,
+		traceTracer,
+		utilUUID,
+	)
 
 	if err = serverer.Run(ctx); err != nil {
 		logRuntimeLog.
@@ -3,23 +3,151 @@ package object
 //go:generate stringer -output=./const_enum_string.go -type=OrderStateType ./
 
 type (
+	// AlgoOrderReasonType is an enumeration.
+	AlgoOrderReasonType string
+
+	// AlgoOrderStateType is an enumeration.
+	AlgoOrderStateType string
+
+	// AlgoOrderTypeType is an enumeration.
+	AlgoOrderTypeType string
+
+	// BorrowStatusType is an enumeration.
+	BorrowStatusType string
+
+	// BracketReasonType is an enumeration.
+	BracketReasonType string
+
+	// BracketStateType is an enumeration.
+	BracketStateType string
+
+	// CircuitStateType is an enumeration.
+	CircuitStateType string
+
+	// EndpointGroupType is an enumeration.
+	EndpointGroupType string
+
+	// ExchangeModeType is an enumeration.
+	ExchangeModeType string
+
+	// JobStateType is an enumeration.
+	JobStateType string
+
 	// KlineTypeType is an enumeration.
 	KlineTypeType string
 
+	// MarginModeType is an enumeration.
+	MarginModeType string
+
+	// OrderChangeTypeType is an enumeration.
+	OrderChangeTypeType string
+
 	// OrderSideType is an enumeration.
 	OrderSideType string
 
 	// OrderStateType is an enumeration.
 	OrderStateType string
 
+	// OrderStatusType is an enumeration.
+	OrderStatusType string
+
+	// OrderStopType is an enumeration.
+	OrderStopType string
+
 	// OrderTradeTypeType is an enumeration.
 	OrderTradeTypeType string
 
 	// OrderTypeType is an enumeration.
 	OrderTypeType string
+
+	// RiskReasonType is an enumeration.
+	RiskReasonType string
+
+	// SizingModelType is an enumeration.
+	SizingModelType string
+
+	// SubTransferDirectionType is an enumeration.
+	SubTransferDirectionType string
+
+	// TimeInForceType is an enumeration.
+	TimeInForceType string
 )
 
 const (
+	// AlgoOrderReasonTypeCancelled is AlgoOrderReasonType.
+	AlgoOrderReasonTypeCancelled AlgoOrderReasonType = "cancelled"
+	// AlgoOrderReasonTypeExpired is a AlgoOrderReasonType.
+	AlgoOrderReasonTypeExpired AlgoOrderReasonType = "expired"
+	// AlgoOrderReasonTypeFilled is a AlgoOrderReasonType.
+	AlgoOrderReasonTypeFilled AlgoOrderReasonType = "filled"
+	// AlgoOrderReasonTypeRejected is a AlgoOrderReasonType.
+	AlgoOrderReasonTypeRejected AlgoOrderReasonType = "rejected"
+
+	// AlgoOrderStateTypeClosed is AlgoOrderStateType.
+	AlgoOrderStateTypeClosed AlgoOrderStateType = "closed"
+	// AlgoOrderStateTypeWorking is a AlgoOrderStateType.
+	AlgoOrderStateTypeWorking AlgoOrderStateType = "working"
+
+	// AlgoOrderTypeTypeIceberg is AlgoOrderTypeType.
+	AlgoOrderTypeTypeIceberg AlgoOrderTypeType = "iceberg"
+	// AlgoOrderTypeTypeTWAP is a AlgoOrderTypeType.
+	AlgoOrderTypeTypeTWAP AlgoOrderTypeType = "twap"
+	// AlgoOrderTypeTypeVWAP is a AlgoOrderTypeType.
+	AlgoOrderTypeTypeVWAP AlgoOrderTypeType = "vwap"
+
+	// BorrowStatusTypeOutstanding is BorrowStatusType.
+	BorrowStatusTypeOutstanding BorrowStatusType = "outstanding"
+	// BorrowStatusTypeRepaid is a BorrowStatusType.
+	BorrowStatusTypeRepaid BorrowStatusType = "repaid"
+
+	// BracketReasonTypeCancelled is BracketReasonType.
+	BracketReasonTypeCancelled BracketReasonType = "cancelled"
+	// BracketReasonTypeStopLoss is a BracketReasonType.
+	BracketReasonTypeStopLoss BracketReasonType = "stop_loss"
+	// BracketReasonTypeTakeProfit is a BracketReasonType.
+	BracketReasonTypeTakeProfit BracketReasonType = "take_profit"
+	// BracketReasonTypeUnfilled is a BracketReasonType.
+	BracketReasonTypeUnfilled BracketReasonType = "unfilled"
+
+	// BracketStateTypeClosed is BracketStateType.
+	BracketStateTypeClosed BracketStateType = "closed"
+	// BracketStateTypeEntry is a BracketStateType.
+	BracketStateTypeEntry BracketStateType = "entry"
+	// BracketStateTypeExit is a BracketStateType.
+	BracketStateTypeExit BracketStateType = "exit"
+	// BracketStateTypeProtected is a BracketStateType.
+	BracketStateTypeProtected BracketStateType = "protected"
+
+	// CircuitStateTypeClosed is CircuitStateType.
+	CircuitStateTypeClosed CircuitStateType = "closed"
+	// CircuitStateTypeHalfOpen is a CircuitStateType.
+	CircuitStateTypeHalfOpen CircuitStateType = "half-open"
+	// CircuitStateTypeOpen is a CircuitStateType.
+	CircuitStateTypeOpen CircuitStateType = "open"
+
+	// EndpointGroupTypeManagement is EndpointGroupType.
+	EndpointGroupTypeManagement EndpointGroupType = "management"
+	// EndpointGroupTypePublic is a EndpointGroupType.
+	EndpointGroupTypePublic EndpointGroupType = "public"
+	// EndpointGroupTypeSpot is a EndpointGroupType.
+	EndpointGroupTypeSpot EndpointGroupType = "spot"
+
+	// ExchangeModeTypeCancelOnly is ExchangeModeType.
+	ExchangeModeTypeCancelOnly ExchangeModeType = "cancel-only"
+	// ExchangeModeTypeOpen is a ExchangeModeType.
+	ExchangeModeTypeOpen ExchangeModeType = "open"
+	// ExchangeModeTypePaused is a ExchangeModeType.
+	ExchangeModeTypePaused ExchangeModeType = "paused"
+
+	// JobStateTypeFailed is JobStateType.
+	JobStateTypeFailed JobStateType = "failed"
+	// JobStateTypeIdle is a JobStateType.
+	JobStateTypeIdle JobStateType = "idle"
+	// JobStateTypeRunning is a JobStateType.
+	JobStateTypeRunning JobStateType = "running"
+	// JobStateTypeSucceeded is a JobStateType.
+	JobStateTypeSucceeded JobStateType = "succeeded"
+
 	// KlineTypeType1min is KlineTypeType.
 	KlineTypeType1min KlineTypeType = "1min"
 	// KlineTypeType3min is a KlineTypeType.
@@ -47,6 +175,24 @@ const (
 	// KlineTypeType1week is a KlineTypeType.
 	KlineTypeType1week KlineTypeType = "1week"
 
+	// MarginModeTypeCross is MarginModeType.
+	MarginModeTypeCross MarginModeType = "cross"
+	// MarginModeTypeIsolated is a MarginModeType.
+	MarginModeTypeIsolated MarginModeType = "isolated"
+
+	// OrderChangeTypeTypeCanceled is OrderChangeTypeType.
+	OrderChangeTypeTypeCanceled OrderChangeTypeType = "canceled"
+	// OrderChangeTypeTypeFilled is a OrderChangeTypeType.
+	OrderChangeTypeTypeFilled OrderChangeTypeType = "filled"
+	// OrderChangeTypeTypeMatch is a OrderChangeTypeType.
+	OrderChangeTypeTypeMatch OrderChangeTypeType = "match"
+	// OrderChangeTypeTypeOpen is a OrderChangeTypeType.
+	OrderChangeTypeTypeOpen OrderChangeTypeType = "open"
+	// OrderChangeTypeTypeReceived is a OrderChangeTypeType.
+	OrderChangeTypeTypeReceived OrderChangeTypeType = "received"
+	// OrderChangeTypeTypeUpdate is a OrderChangeTypeType.
+	OrderChangeTypeTypeUpdate OrderChangeTypeType = "update"
+
 	// OrderSideTypeBuy is OrderSideType.
 	OrderSideTypeBuy OrderSideType = "buy"
 	// OrderSideTypeSell is a OrderSideType.
@@ -57,6 +203,22 @@ const (
 	// OrderStateTypeDone is a OrderStateType.
 	OrderStateTypeDone OrderStateType = "done"
 
+	// OrderStatusTypeCanceled is OrderStatusType.
+	OrderStatusTypeCanceled OrderStatusType = "canceled"
+	// OrderStatusTypeFilled is a OrderStatusType.
+	OrderStatusTypeFilled OrderStatusType = "filled"
+	// OrderStatusTypeNew is a OrderStatusType.
+	OrderStatusTypeNew OrderStatusType = "new"
+	// OrderStatusTypeOpen is a OrderStatusType.
+	OrderStatusTypeOpen OrderStatusType = "open"
+	// OrderStatusTypePartiallyFilled is a OrderStatusType.
+	OrderStatusTypePartiallyFilled OrderStatusType = "partially_filled"
+
+	// OrderStopTypeEntry is OrderStopType.
+	OrderStopTypeEntry OrderStopType = "entry"
+	// OrderStopTypeLoss is a OrderStopType.
+	OrderStopTypeLoss OrderStopType = "loss"
+
 	// OrderTypeTypeLimit is OrderTypeType.
 	OrderTypeTypeLimit OrderTypeType = "limit"
 	// OrderTypeTypeLimitStop is a OrderTypeType.
@@ -72,4 +234,52 @@ const (
 	OrderTypeTypeMarginIsolatedTrade OrderTypeType = "MARGIN_ISOLATED_TRADE"
 	// OrderTypeTypeMarginTrade is OrderTypeType.
 	OrderTypeTypeMarginTrade OrderTypeType = "MARGIN_TRADE"
+
+	// RiskReasonTypeDailyLoss is RiskReasonType.
+	RiskReasonTypeDailyLoss RiskReasonType = "daily_loss"
+	// RiskReasonTypeExchangeMode is a RiskReasonType.
+	RiskReasonTypeExchangeMode RiskReasonType = "exchange_mode"
+	// RiskReasonTypeKillSwitch is a RiskReasonType.
+	RiskReasonTypeKillSwitch RiskReasonType = "kill_switch"
+	// RiskReasonTypeMaxDebtRatio is a RiskReasonType.
+	RiskReasonTypeMaxDebtRatio RiskReasonType = "max_debt_ratio"
+	// RiskReasonTypeMaxOpenOrders is a RiskReasonType.
+	RiskReasonTypeMaxOpenOrders RiskReasonType = "max_open_orders"
+	// RiskReasonTypeMaxOrderNotional is a RiskReasonType.
+	RiskReasonTypeMaxOrderNotional RiskReasonType = "max_order_notional"
+	// RiskReasonTypeMaxOrdersPerMinute is a RiskReasonType.
+	RiskReasonTypeMaxOrdersPerMinute RiskReasonType = "max_orders_per_minute"
+	// RiskReasonTypeMaxSymbolNotional is a RiskReasonType.
+	RiskReasonTypeMaxSymbolNotional RiskReasonType = "max_symbol_notional"
+	// RiskReasonTypeMaxTotalExposure is a RiskReasonType.
+	RiskReasonTypeMaxTotalExposure RiskReasonType = "max_total_exposure"
+	// RiskReasonTypeNoEquity is a RiskReasonType.
+	RiskReasonTypeNoEquity RiskReasonType = "no_equity"
+	// RiskReasonTypeNoPrice is a RiskReasonType.
+	RiskReasonTypeNoPrice RiskReasonType = "no_price"
+	// RiskReasonTypePriceBand is a RiskReasonType.
+	RiskReasonTypePriceBand RiskReasonType = "price_band"
+
+	// SizingModelTypeFixedFraction is SizingModelType.
+	SizingModelTypeFixedFraction SizingModelType = "fixed_fraction"
+	// SizingModelTypeFixedQuote is a SizingModelType.
+	SizingModelTypeFixedQuote SizingModelType = "fixed_quote"
+	// SizingModelTypeKelly is a SizingModelType.
+	SizingModelTypeKelly SizingModelType = "kelly"
+	// SizingModelTypeVolatility is a SizingModelType.
+	SizingModelTypeVolatility SizingModelType = "volatility"
+
+	// SubTransferDirectionTypeIn is SubTransferDirectionType.
+	SubTransferDirectionTypeIn SubTransferDirectionType = "IN"
+	// SubTransferDirectionTypeOut is a SubTransferDirectionType.
+	SubTransferDirectionTypeOut SubTransferDirectionType = "OUT"
+
+	// TimeInForceTypeFOK is TimeInForceType.
+	TimeInForceTypeFOK TimeInForceType = "FOK"
+	// TimeInForceTypeGTC is a TimeInForceType.
+	TimeInForceTypeGTC TimeInForceType = "GTC"
+	// TimeInForceTypeGTT is a TimeInForceType.
+	TimeInForceTypeGTT TimeInForceType = "GTT"
+	// TimeInForceTypeIOC is a TimeInForceType.
+	TimeInForceTypeIOC TimeInForceType = "IOC"
 )
@@ -3,8 +3,190 @@ package object
 import "errors"
 
 var (
+	// ErrAlgoOrderInvalid is an error.
+	ErrAlgoOrderInvalid = errors.New("failed to algo order invalid")
+	// ErrAlgoOrderNotFound is an error.
+	ErrAlgoOrderNotFound = errors.New("failed to algo order not found")
+	// ErrAlgoOrderRepositoryCreate is an error.
+	ErrAlgoOrderRepositoryCreate = errors.New("failed to algo order repository create")
+	// ErrAlgoOrderRepositoryDelete is an error.
+	ErrAlgoOrderRepositoryDelete = errors.New("failed to algo order repository delete")
+	// ErrAlgoOrderRepositoryDeleteAll is an error.
+	ErrAlgoOrderRepositoryDeleteAll = errors.New("failed to algo order repository delete all")
+	// ErrAlgoOrderRepositoryRead is an error.
+	ErrAlgoOrderRepositoryRead = errors.New("failed to algo order repository read")
+	// ErrAlgoOrderRepositoryReadList is an error.
+	ErrAlgoOrderRepositoryReadList = errors.New("failed to algo order repository read list")
+	// ErrAlgoOrderRepositoryUpdate is an error.
+	ErrAlgoOrderRepositoryUpdate = errors.New("failed to algo order repository update")
+	// ErrAlgoOrderServiceCancel is an error.
+	ErrAlgoOrderServiceCancel = errors.New("failed to algo order service cancel")
+	// ErrAlgoOrderServiceCreate is an error.
+	ErrAlgoOrderServiceCreate = errors.New("failed to algo order service create")
+	// ErrAlgoOrderServiceGet is an error.
+	ErrAlgoOrderServiceGet = errors.New("failed to algo order service get")
+	// ErrAlgoOrderServiceGetByClientOID is an error.
+	ErrAlgoOrderServiceGetByClientOID = errors.New("failed to algo order service get by client oid")
+	// ErrAlgoOrderServiceGetListFromRepository is an error.
+	ErrAlgoOrderServiceGetListFromRepository = errors.New(
+		"failed to algo order service get list from repository",
+	)
+	// ErrAlgoOrderServicePlace is an error.
+	ErrAlgoOrderServicePlace = errors.New("failed to algo order service place")
+	// ErrAlgoOrderServiceReconcile is an error.
+	ErrAlgoOrderServiceReconcile = errors.New("failed to algo order service reconcile")
+	// ErrAlgoOrderServiceSync is an error.
+	ErrAlgoOrderServiceSync = errors.New("failed to algo order service sync")
+	// ErrAlgoOrderServiceUpdate is an error.
+	ErrAlgoOrderServiceUpdate = errors.New("failed to algo order service update")
+	// ErrBacktestKlineNotFound is an error.
+	ErrBacktestKlineNotFound = errors.New("failed to backtest kline not found")
+	// ErrBacktestOrderBookLoad is an error.
+	ErrBacktestOrderBookLoad = errors.New("failed to backtest order book load")
+	// ErrBacktestOrderBookNotFound is an error.
+	ErrBacktestOrderBookNotFound = errors.New("failed to backtest order book not found")
+	// ErrBacktestRun is an error.
+	ErrBacktestRun = errors.New("failed to backtest run")
+	// ErrBacktestWrite is an error.
+	ErrBacktestWrite = errors.New("failed to backtest write")
+	// ErrBalanceKucoinServiceGetList is an error.
+	ErrBalanceKucoinServiceGetList = errors.New("failed to balance kucoin service get list")
+	// ErrBalanceRepositoryCreate is an error.
+	ErrBalanceRepositoryCreate = errors.New("failed to balance repository create")
+	// ErrBalanceRepositoryDelete is an error.
+	ErrBalanceRepositoryDelete = errors.New("failed to balance repository delete")
+	// ErrBalanceRepositoryDeleteAll is an error.
+	ErrBalanceRepositoryDeleteAll = errors.New("failed to balance repository delete all")
+	// ErrBalanceRepositoryRead is an error.
+	ErrBalanceRepositoryRead = errors.New("failed to balance repository read")
+	// ErrBalanceRepositoryReadList is an error.
+	ErrBalanceRepositoryReadList = errors.New("failed to balance repository read list")
+	// ErrBalanceRepositoryUpdate is an error.
+	ErrBalanceRepositoryUpdate = errors.New("failed to balance repository update")
+	// ErrBalanceServiceGetListFromRemote is an error.
+	ErrBalanceServiceGetListFromRemote = errors.New(
+		"failed to balance service get list from remote",
+	)
+	// ErrBalanceServiceGetListFromRepository is an error.
+	ErrBalanceServiceGetListFromRepository = errors.New(
+		"failed to balance service get list from repository",
+	)
+	// ErrBalanceServiceUpsert is an error.
+	ErrBalanceServiceUpsert = errors.New("failed to balance service upsert")
 	// ErrBase64Decode2 is an error.
 	ErrBase64Decode2 = errors.New("unrecognized level")
+	// ErrBorrowKucoinServiceGetList is an error.
+	ErrBorrowKucoinServiceGetList = errors.New("failed to borrow kucoin service get list")
+	// ErrBorrowRepositoryCreate is an error.
+	ErrBorrowRepositoryCreate = errors.New("failed to borrow repository create")
+	// ErrBorrowRepositoryDelete is an error.
+	ErrBorrowRepositoryDelete = errors.New("failed to borrow repository delete")
+	// ErrBorrowRepositoryDeleteAll is an error.
+	ErrBorrowRepositoryDeleteAll = errors.New("failed to borrow repository delete all")
+	// ErrBorrowRepositoryRead is an error.
+	ErrBorrowRepositoryRead = errors.New("failed to borrow repository read")
+	// ErrBorrowRepositoryReadList is an error.
+	ErrBorrowRepositoryReadList = errors.New("failed to borrow repository read list")
+	// ErrBorrowRepositoryUpdate is an error.
+	ErrBorrowRepositoryUpdate = errors.New("failed to borrow repository update")
+	// ErrBorrowServiceGetListFromRemote is an error.
+	ErrBorrowServiceGetListFromRemote = errors.New("failed to borrow service get list from remote")
+	// ErrBorrowServiceUpsert is an error.
+	ErrBorrowServiceUpsert = errors.New("failed to borrow service upsert")
+	// ErrBracketNotFound is an error.
+	ErrBracketNotFound = errors.New("failed to bracket not found")
+	// ErrBracketRepositoryCreate is an error.
+	ErrBracketRepositoryCreate = errors.New("failed to bracket repository create")
+	// ErrBracketRepositoryDelete is an error.
+	ErrBracketRepositoryDelete = errors.New("failed to bracket repository delete")
+	// ErrBracketRepositoryDeleteAll is an error.
+	ErrBracketRepositoryDeleteAll = errors.New("failed to bracket repository delete all")
+	// ErrBracketRepositoryRead is an error.
+	ErrBracketRepositoryRead = errors.New("failed to bracket repository read")
+	// ErrBracketRepositoryReadList is an error.
+	ErrBracketRepositoryReadList = errors.New("failed to bracket repository read list")
+	// ErrBracketRepositoryUpdate is an error.
+	ErrBracketRepositoryUpdate = errors.New("failed to bracket repository update")
+	// ErrBracketServiceCancel is an error.
+	ErrBracketServiceCancel = errors.New("failed to bracket service cancel")
+	// ErrBracketServiceCreate is an error.
+	ErrBracketServiceCreate = errors.New("failed to bracket service create")
+	// ErrBracketServiceGet is an error.
+	ErrBracketServiceGet = errors.New("failed to bracket service get")
+	// ErrBracketServiceGetByEntryClientOID is an error.
+	ErrBracketServiceGetByEntryClientOID = errors.New(
+		"failed to bracket service get by entry client oid",
+	)
+	// ErrBracketServiceGetListFromRepository is an error.
+	ErrBracketServiceGetListFromRepository = errors.New(
+		"failed to bracket service get list from repository",
+	)
+	// ErrBracketServicePlace is an error.
+	ErrBracketServicePlace = errors.New("failed to bracket service place")
+	// ErrBracketServiceReconcile is an error.
+	ErrBracketServiceReconcile = errors.New("failed to bracket service reconcile")
+	// ErrBracketServiceSync is an error.
+	ErrBracketServiceSync = errors.New("failed to bracket service sync")
+	// ErrBracketServiceUpdate is an error.
+	ErrBracketServiceUpdate = errors.New("failed to bracket service update")
+	// ErrDecimalParse is an error.
+	ErrDecimalParse = errors.New("failed to decimal parse")
+	// ErrEquityRepositoryCreate is an error.
+	ErrEquityRepositoryCreate = errors.New("failed to equity repository create")
+	// ErrEquityRepositoryDelete is an error.
+	ErrEquityRepositoryDelete = errors.New("failed to equity repository delete")
+	// ErrEquityRepositoryDeleteAll is an error.
+	ErrEquityRepositoryDeleteAll = errors.New("failed to equity repository delete all")
+	// ErrEquityRepositoryRead is an error.
+	ErrEquityRepositoryRead = errors.New("failed to equity repository read")
+	// ErrEquityRepositoryReadList is an error.
+	ErrEquityRepositoryReadList = errors.New("failed to equity repository read list")
+	// ErrEquityRepositoryUpdate is an error.
+	ErrEquityRepositoryUpdate = errors.New("failed to equity repository update")
+	// ErrEquityServiceCreate is an error.
+	ErrEquityServiceCreate = errors.New("failed to equity service create")
+	// ErrEquityServiceGetListFromRepository is an error.
+	ErrEquityServiceGetListFromRepository = errors.New(
+		"failed to equity service get list from repository",
+	)
+	// ErrExchangeAccountUnknown is an error.
+	ErrExchangeAccountUnknown = errors.New("account is not configured")
+	// ErrExchangeCircuitOpen is an error.
+	ErrExchangeCircuitOpen = errors.New("circuit of the endpoint group is open")
+	// ErrExchangeRateLimitWait is an error.
+	ErrExchangeRateLimitWait = errors.New("rate limit of the endpoint group was not waited for")
+	// ErrExchangeStatusKucoinServiceGet is an error.
+	ErrExchangeStatusKucoinServiceGet = errors.New("failed to exchange status kucoin service get")
+	// ErrExchangeStatusServiceSync is an error.
+	ErrExchangeStatusServiceSync = errors.New("failed to exchange status service sync")
+	// ErrFillKucoinServiceGetList is an error.
+	ErrFillKucoinServiceGetList = errors.New("failed to fill kucoin service get list")
+	// ErrFillKucoinServiceGetRecentList is an error.
+	ErrFillKucoinServiceGetRecentList = errors.New("failed to fill kucoin service get recent list")
+	// ErrFillRepositoryCreate is an error.
+	ErrFillRepositoryCreate = errors.New("failed to fill repository create")
+	// ErrFillRepositoryDelete is an error.
+	ErrFillRepositoryDelete = errors.New("failed to fill repository delete")
+	// ErrFillRepositoryDeleteAll is an error.
+	ErrFillRepositoryDeleteAll = errors.New("failed to fill repository delete all")
+	// ErrFillRepositoryRead is an error.
+	ErrFillRepositoryRead = errors.New("failed to fill repository read")
+	// ErrFillRepositoryReadList is an error.
+	ErrFillRepositoryReadList = errors.New("failed to fill repository read list")
+	// ErrFillRepositoryUpdate is an error.
+	ErrFillRepositoryUpdate = errors.New("failed to fill repository update")
+	// ErrFillServiceGetListFromRemote is an error.
+	ErrFillServiceGetListFromRemote = errors.New("failed to fill service get list from remote")
+	// ErrFillServiceGetListFromRepository is an error.
+	ErrFillServiceGetListFromRepository = errors.New(
+		"failed to fill service get list from repository",
+	)
+	// ErrFillServiceGetRecentListFromRemote is an error.
+	ErrFillServiceGetRecentListFromRemote = errors.New(
+		"failed to fill service get recent list from remote",
+	)
+	// ErrFillServiceUpsert is an error.
+	ErrFillServiceUpsert = errors.New("failed to fill service upsert")
 	// ErrGormOpen is an error.
 	ErrGormOpen = errors.New("failed to open gorm")
 	// ErrHTTPClientDo is an error.
@@ -15,22 +197,192 @@ var (
 	ErrHTTPResponseBodyClose = errors.New("failed to close http response body")
 	// ErrJaegerNew is an error.
 	ErrJaegerNew = errors.New("failed to create a jaeger exporter")
+	// ErrKillSwitchRepositoryCreate is an error.
+	ErrKillSwitchRepositoryCreate = errors.New("failed to kill switch repository create")
+	// ErrKillSwitchRepositoryDelete is an error.
+	ErrKillSwitchRepositoryDelete = errors.New("failed to kill switch repository delete")
+	// ErrKillSwitchRepositoryDeleteAll is an error.
+	ErrKillSwitchRepositoryDeleteAll = errors.New("failed to kill switch repository delete all")
+	// ErrKillSwitchRepositoryRead is an error.
+	ErrKillSwitchRepositoryRead = errors.New("failed to kill switch repository read")
+	// ErrKillSwitchRepositoryReadList is an error.
+	ErrKillSwitchRepositoryReadList = errors.New("failed to kill switch repository read list")
+	// ErrKillSwitchRepositoryUpdate is an error.
+	ErrKillSwitchRepositoryUpdate = errors.New("failed to kill switch repository update")
+	// ErrKillSwitchServiceGetListFromRepository is an error.
+	ErrKillSwitchServiceGetListFromRepository = errors.New(
+		"failed to kill switch service get list from repository",
+	)
+	// ErrKillSwitchServiceUpsert is an error.
+	ErrKillSwitchServiceUpsert = errors.New("failed to kill switch service upsert")
 	// ErrKlineKucoinServiceGetList is an error.
 	ErrKlineKucoinServiceGetList = errors.New("failed to kline kucoin service get list")
+	// ErrKlineRepositoryCreate is an error.
+	ErrKlineRepositoryCreate = errors.New("failed to kline repository create")
+	// ErrKlineRepositoryDelete is an error.
+	ErrKlineRepositoryDelete = errors.New("failed to kline repository delete")
+	// ErrKlineRepositoryDeleteAll is an error.
+	ErrKlineRepositoryDeleteAll = errors.New("failed to kline repository delete all")
+	// ErrKlineRepositoryRead is an error.
+	ErrKlineRepositoryRead = errors.New("failed to kline repository read")
+	// ErrKlineRepositoryReadList is an error.
+	ErrKlineRepositoryReadList = errors.New("failed to kline repository read list")
+	// ErrKlineRepositoryUpdate is an error.
+	ErrKlineRepositoryUpdate = errors.New("failed to kline repository update")
+	// ErrKlineServiceBackfill is an error.
+	ErrKlineServiceBackfill = errors.New("failed to kline service backfill")
+	// ErrKlineServiceGetIndicatorNotReady is an error.
+	ErrKlineServiceGetIndicatorNotReady = errors.New(
+		"failed to kline service get indicator not ready",
+	)
 	// ErrKlineServiceGetOpenLowEqualityFromRemote is an error.
 	ErrKlineServiceGetOpenLowEqualityFromRemote = errors.New(
 		"failed to kline service get open low equality from remote",
 	)
+	// ErrKlineServiceResample is an error.
+	ErrKlineServiceResample = errors.New("failed to kline service resample")
+	// ErrKlineServiceResampleGap is an error.
+	ErrKlineServiceResampleGap = errors.New(
+		"failed to kline service resample bucket with missing klines",
+	)
+	// ErrKlineServiceResampleKlineType is an error.
+	ErrKlineServiceResampleKlineType = errors.New("failed to kline service resample kline type")
+	// ErrKlineServiceUpsert is an error.
+	ErrKlineServiceUpsert = errors.New("failed to kline service upsert")
+	// ErrKucoinAuth is an error.
+	ErrKucoinAuth = errors.New("kucoin authentication failed")
+	// ErrKucoinBalanceInsufficient is an error.
+	ErrKucoinBalanceInsufficient = errors.New("kucoin balance is insufficient")
+	// ErrKucoinClientOIDDuplicated is an error.
+	ErrKucoinClientOIDDuplicated = errors.New("kucoin clientOid is duplicated")
+	// ErrKucoinInvalidParameter is an error.
+	ErrKucoinInvalidParameter = errors.New("kucoin parameter is invalid")
+	// ErrKucoinInvalidPrecision is an error.
+	ErrKucoinInvalidPrecision = errors.New("kucoin precision is invalid")
+	// ErrKucoinNotFound is an error.
+	ErrKucoinNotFound = errors.New("kucoin resource is not found")
+	// ErrKucoinOrderNotFound is an error.
+	ErrKucoinOrderNotFound = errors.New("kucoin order is not found")
+	// ErrKucoinRateLimit is an error.
+	ErrKucoinRateLimit = errors.New("kucoin rate limit is exceeded")
+	// ErrKucoinRequest is an error.
+	ErrKucoinRequest = errors.New("kucoin request failed")
+	// ErrKucoinServiceReadData is an error.
+	ErrKucoinServiceReadData = errors.New("failed to kucoin service read data")
 	// ErrKucoinServiceReadPaginationData is an error.
 	ErrKucoinServiceReadPaginationData = errors.New("failed to kucoin service read pagination data")
+	// ErrKucoinSystemBusy is an error.
+	ErrKucoinSystemBusy = errors.New("kucoin system is busy")
+	// ErrKucoinTransport is an error.
+	ErrKucoinTransport = errors.New("kucoin transport failed")
+	// ErrMarginBalanceKucoinServiceGetList is an error.
+	ErrMarginBalanceKucoinServiceGetList = errors.New(
+		"failed to margin balance kucoin service get list",
+	)
+	// ErrMarginBalanceRepositoryCreate is an error.
+	ErrMarginBalanceRepositoryCreate = errors.New("failed to margin balance repository create")
+	// ErrMarginBalanceRepositoryDelete is an error.
+	ErrMarginBalanceRepositoryDelete = errors.New("failed to margin balance repository delete")
+	// ErrMarginBalanceRepositoryDeleteAll is an error.
+	ErrMarginBalanceRepositoryDeleteAll = errors.New(
+		"failed to margin balance repository delete all",
+	)
+	// ErrMarginBalanceRepositoryRead is an error.
+	ErrMarginBalanceRepositoryRead = errors.New("failed to margin balance repository read")
+	// ErrMarginBalanceRepositoryReadList is an error.
+	ErrMarginBalanceRepositoryReadList = errors.New("failed to margin balance repository read list")
+	// ErrMarginBalanceRepositoryUpdate is an error.
+	ErrMarginBalanceRepositoryUpdate = errors.New("failed to margin balance repository update")
+	// ErrMarginBalanceServiceGetListFromRemote is an error.
+	ErrMarginBalanceServiceGetListFromRemote = errors.New(
+		"failed to margin balance service get list from remote",
+	)
+	// ErrMarginBalanceServiceUpsert is an error.
+	ErrMarginBalanceServiceUpsert = errors.New("failed to margin balance service upsert")
+	// ErrMarginKucoinServiceBorrow is an error.
+	ErrMarginKucoinServiceBorrow = errors.New("failed to margin kucoin service borrow")
+	// ErrMarginKucoinServiceGetAccount is an error.
+	ErrMarginKucoinServiceGetAccount = errors.New("failed to margin kucoin service get account")
+	// ErrMarginKucoinServiceRepay is an error.
+	ErrMarginKucoinServiceRepay = errors.New("failed to margin kucoin service repay")
+	// ErrMarginPaperUnsupported is an error.
+	ErrMarginPaperUnsupported = errors.New("margin trading is not simulated in paper mode")
+	// ErrMarginRiskLimitKucoinServiceGetList is an error.
+	ErrMarginRiskLimitKucoinServiceGetList = errors.New(
+		"failed to margin risk limit kucoin service get list",
+	)
+	// ErrMarginRiskLimitRepositoryCreate is an error.
+	ErrMarginRiskLimitRepositoryCreate = errors.New("failed to margin risk limit repository create")
+	// ErrMarginRiskLimitRepositoryDelete is an error.
+	ErrMarginRiskLimitRepositoryDelete = errors.New("failed to margin risk limit repository delete")
+	// ErrMarginRiskLimitRepositoryDeleteAll is an error.
+	ErrMarginRiskLimitRepositoryDeleteAll = errors.New(
+		"failed to margin risk limit repository delete all",
+	)
+	// ErrMarginRiskLimitRepositoryRead is an error.
+	ErrMarginRiskLimitRepositoryRead = errors.New("failed to margin risk limit repository read")
+	// ErrMarginRiskLimitRepositoryReadList is an error.
+	ErrMarginRiskLimitRepositoryReadList = errors.New(
+		"failed to margin risk limit repository read list",
+	)
+	// ErrMarginRiskLimitRepositoryUpdate is an error.
+	ErrMarginRiskLimitRepositoryUpdate = errors.New("failed to margin risk limit repository update")
+	// ErrMarginRiskLimitServiceGetListFromRemote is an error.
+	ErrMarginRiskLimitServiceGetListFromRemote = errors.New(
+		"failed to margin risk limit service get list from remote",
+	)
+	// ErrMarginRiskLimitServiceUpsert is an error.
+	ErrMarginRiskLimitServiceUpsert = errors.New("failed to margin risk limit service upsert")
+	// ErrMarginServiceBorrow is an error.
+	ErrMarginServiceBorrow = errors.New("failed to margin service borrow")
+	// ErrMarginServiceGetDebtRatio is an error.
+	ErrMarginServiceGetDebtRatio = errors.New("failed to margin service get debt ratio")
+	// ErrMarginServicePlace is an error.
+	ErrMarginServicePlace = errors.New("failed to margin service place")
+	// ErrMarginServiceRepayAll is an error.
+	ErrMarginServiceRepayAll = errors.New("failed to margin service repay all")
+	// ErrMarginServiceRepaySingle is an error.
+	ErrMarginServiceRepaySingle = errors.New("failed to margin service repay single")
+	// ErrMarginServiceSync is an error.
+	ErrMarginServiceSync = errors.New("failed to margin service sync")
+	// ErrMarginTradeTypeInvalid is an error.
+	ErrMarginTradeTypeInvalid = errors.New("trade type is not a margin trade type")
 	// ErrOrderBookKucoinServiceGetList is an error.
 	ErrOrderBookKucoinServiceGetList = errors.New("failed to order book kucoin service get list")
+	// ErrOrderBookServiceApplyLevel2 is an error.
+	ErrOrderBookServiceApplyLevel2 = errors.New("failed to order book service apply level2")
+	// ErrOrderBookServiceGetBestBidAsk is an error.
+	ErrOrderBookServiceGetBestBidAsk = errors.New("failed to order book service get best bid ask")
 	// ErrOrderBookServiceGetListFromRepository is an error.
 	ErrOrderBookServiceGetListFromRepository = errors.New(
 		"failed to order book service get list from repository",
 	)
+	// ErrOrderBookServiceGetOrderBook is an error.
+	ErrOrderBookServiceGetOrderBook = errors.New("failed to order book service get order book")
+	// ErrOrderBookServiceGetSnapshotFromRemote is an error.
+	ErrOrderBookServiceGetSnapshotFromRemote = errors.New(
+		"failed to order book service get snapshot from remote",
+	)
+	// ErrOrderBookServiceSequence is an error.
+	ErrOrderBookServiceSequence = errors.New("failed to order book service validate sequence")
+	// ErrOrderClientOIDRequired is an error.
+	ErrOrderClientOIDRequired = errors.New("failed to order client oid required")
+	// ErrOrderFundsBelowMinimum is an error.
+	ErrOrderFundsBelowMinimum = errors.New("failed to order funds below minimum")
+	// ErrOrderKucoinServiceCancel is an error.
+	ErrOrderKucoinServiceCancel = errors.New("failed to order kucoin service cancel")
+	// ErrOrderKucoinServiceCreate is an error.
+	ErrOrderKucoinServiceCreate = errors.New("failed to order kucoin service create")
+	// ErrOrderKucoinServiceGet is an error.
+	ErrOrderKucoinServiceGet = errors.New("failed to order kucoin service get")
 	// ErrOrderKucoinServiceGetList is an error.
 	ErrOrderKucoinServiceGetList = errors.New("failed to order kucoin service get list")
+	// ErrOrderNotFound is an error.
+	ErrOrderNotFound = errors.New("failed to order not found")
+	// ErrOrderPriceInvalid is an error.
+	ErrOrderPriceInvalid = errors.New("failed to order price invalid")
+	// ErrOrderRejected is an error.
+	ErrOrderRejected = errors.New("failed to order rejected by exchange")
 	// ErrOrderRepositoryCreate is an error.
 	ErrOrderRepositoryCreate = errors.New("failed to order repository create")
 	// ErrOrderRepositoryDelete is an error.
@@ -43,14 +395,82 @@ var (
 	ErrOrderRepositoryReadList = errors.New("failed to order repository read list")
 	// ErrOrderRepositoryUpdate is an error.
 	ErrOrderRepositoryUpdate = errors.New("failed to order repository update")
+	// ErrOrderServiceApply is an error.
+	ErrOrderServiceApply = errors.New("failed to order service apply")
+	// ErrOrderServiceCancel is an error.
+	ErrOrderServiceCancel = errors.New("failed to order service cancel")
+	// ErrOrderServiceCancelAllForSymbol is an error.
+	ErrOrderServiceCancelAllForSymbol = errors.New("failed to order service cancel all for symbol")
+	// ErrOrderServiceCancelByClientOID is an error.
+	ErrOrderServiceCancelByClientOID = errors.New("failed to order service cancel by client oid")
 	// ErrOrderServiceCreate is an error.
 	ErrOrderServiceCreate = errors.New("failed to order service create")
 	// ErrOrderServiceDeleteAll is an error.
 	ErrOrderServiceDeleteAll = errors.New("failed to order service delete all")
+	// ErrOrderServiceFind is an error.
+	ErrOrderServiceFind = errors.New("failed to order service find")
+	// ErrOrderServiceGet is an error.
+	ErrOrderServiceGet = errors.New("failed to order service get")
+	// ErrOrderServiceGetByClientOID is an error.
+	ErrOrderServiceGetByClientOID = errors.New("failed to order service get by client oid")
 	// ErrOrderServiceGetListFromRemote is an error.
 	ErrOrderServiceGetListFromRemote = errors.New("failed to order service get list from remote")
+	// ErrOrderServiceGetListFromRepository is an error.
+	ErrOrderServiceGetListFromRepository = errors.New(
+		"failed to order service get list from repository",
+	)
+	// ErrOrderServicePlace is an error.
+	ErrOrderServicePlace = errors.New("failed to order service place")
+	// ErrOrderServiceReconcile is an error.
+	ErrOrderServiceReconcile = errors.New("failed to order service reconcile")
+	// ErrOrderServiceSync is an error.
+	ErrOrderServiceSync = errors.New("failed to order service sync")
+	// ErrOrderServiceUpdate is an error.
+	ErrOrderServiceUpdate = errors.New("failed to order service update")
+	// ErrOrderServiceUpsert is an error.
+	ErrOrderServiceUpsert = errors.New("failed to order service upsert")
+	// ErrOrderSizeBelowMinimum is an error.
+	ErrOrderSizeBelowMinimum = errors.New("failed to order size below minimum")
+	// ErrOrderUnknown is an error.
+	ErrOrderUnknown = errors.New("failed to order with unknown outcome")
+	// ErrPaperBalanceParse is an error.
+	ErrPaperBalanceParse = errors.New("failed to paper balance parse")
+	// ErrPaperExchangeMatch is an error.
+	ErrPaperExchangeMatch = errors.New("failed to paper exchange match")
+	// ErrPaperQuoteLoad is an error.
+	ErrPaperQuoteLoad = errors.New("failed to paper quote load")
+	// ErrPaperQuoteNotFound is an error.
+	ErrPaperQuoteNotFound = errors.New("failed to paper quote not found")
+	// ErrPortfolioServiceGetEquity is an error.
+	ErrPortfolioServiceGetEquity = errors.New("failed to portfolio service get equity")
+	// ErrPortfolioServiceGetPosition is an error.
+	ErrPortfolioServiceGetPosition = errors.New("failed to portfolio service get position")
+	// ErrPortfolioServiceGetPositions is an error.
+	ErrPortfolioServiceGetPositions = errors.New("failed to portfolio service get positions")
+	// ErrPortfolioServiceSnapshot is an error.
+	ErrPortfolioServiceSnapshot = errors.New("failed to portfolio service snapshot")
+	// ErrPortfolioServiceSync is an error.
+	ErrPortfolioServiceSync = errors.New("failed to portfolio service sync")
+	// ErrPrivateStreamServiceConnect is an error.
+	ErrPrivateStreamServiceConnect = errors.New("failed to private stream service connect")
+	// ErrPrivateStreamServiceHandle is an error.
+	ErrPrivateStreamServiceHandle = errors.New("failed to private stream service handle")
+	// ErrPrivateStreamServiceRun is an error.
+	ErrPrivateStreamServiceRun = errors.New("failed to private stream service run")
 	// ErrRecordsMarshalJSON is an error.
 	ErrRecordsMarshalJSON = errors.New("failed to marshall to byte array")
+	// ErrRiskRejected is an error.
+	ErrRiskRejected = errors.New("failed to risk rejected")
+	// ErrRiskServiceCheck is an error.
+	ErrRiskServiceCheck = errors.New("failed to risk service check")
+	// ErrRiskServiceCheckStop is an error.
+	ErrRiskServiceCheckStop = errors.New("failed to risk service check stop")
+	// ErrRiskServiceEngage is an error.
+	ErrRiskServiceEngage = errors.New("failed to risk service engage")
+	// ErrRiskServiceGetKillSwitch is an error.
+	ErrRiskServiceGetKillSwitch = errors.New("failed to risk service get kill switch")
+	// ErrRiskServiceRelease is an error.
+	ErrRiskServiceRelease = errors.New("failed to risk service release")
 	// ErrRouterRun is an error.
 	ErrRouterRun = errors.New("failed to router run")
 	// ErrSDKResourceMerge is an error.
@@ -61,8 +481,148 @@ var (
 	ErrSQL = errors.New("sql error")
 	// ErrSTRCONVParseFloat is an error.
 	ErrSTRCONVParseFloat = errors.New("failed to strconv parse float")
+	// ErrSTRCONVParseInt is an error.
+	ErrSTRCONVParseInt = errors.New("failed to strconv parse int")
+	// ErrSchedulerCronParse is an error.
+	ErrSchedulerCronParse = errors.New("failed to scheduler cron parse")
+	// ErrSchedulerJobNotFound is an error.
+	ErrSchedulerJobNotFound = errors.New("failed to scheduler job not found")
+	// ErrSchedulerJobRun is an error.
+	ErrSchedulerJobRun = errors.New("failed to scheduler job run")
+	// ErrServerQueryParse is an error.
+	ErrServerQueryParse = errors.New("failed to server query parse")
 	// ErrServerRun is an error.
 	ErrServerRun = errors.New("failed to run http server")
+	// ErrSizingServiceBelowMinSize is an error.
+	ErrSizingServiceBelowMinSize = errors.New("failed to sizing service below min size")
+	// ErrSizingServiceKlineNotFound is an error.
+	ErrSizingServiceKlineNotFound = errors.New("failed to sizing service kline not found")
+	// ErrSizingServiceModelNotFound is an error.
+	ErrSizingServiceModelNotFound = errors.New("failed to sizing service model not found")
+	// ErrSizingServiceParameterInvalid is an error.
+	ErrSizingServiceParameterInvalid = errors.New("failed to sizing service parameter invalid")
+	// ErrSizingServicePriceNotFound is an error.
+	ErrSizingServicePriceNotFound = errors.New("failed to sizing service price not found")
+	// ErrSizingServiceSize is an error.
+	ErrSizingServiceSize = errors.New("failed to sizing service size")
+	// ErrStopOrderKucoinServiceCancel is an error.
+	ErrStopOrderKucoinServiceCancel = errors.New("failed to stop order kucoin service cancel")
+	// ErrStopOrderKucoinServiceCreate is an error.
+	ErrStopOrderKucoinServiceCreate = errors.New("failed to stop order kucoin service create")
+	// ErrStopOrderKucoinServiceGet is an error.
+	ErrStopOrderKucoinServiceGet = errors.New("failed to stop order kucoin service get")
+	// ErrStopOrderKucoinServiceGetList is an error.
+	ErrStopOrderKucoinServiceGetList = errors.New("failed to stop order kucoin service get list")
+	// ErrStopOrderNotFound is an error.
+	ErrStopOrderNotFound = errors.New("failed to stop order not found")
+	// ErrStopOrderRepositoryCreate is an error.
+	ErrStopOrderRepositoryCreate = errors.New("failed to stop order repository create")
+	// ErrStopOrderRepositoryDelete is an error.
+	ErrStopOrderRepositoryDelete = errors.New("failed to stop order repository delete")
+	// ErrStopOrderRepositoryDeleteAll is an error.
+	ErrStopOrderRepositoryDeleteAll = errors.New("failed to stop order repository delete all")
+	// ErrStopOrderRepositoryRead is an error.
+	ErrStopOrderRepositoryRead = errors.New("failed to stop order repository read")
+	// ErrStopOrderRepositoryReadList is an error.
+	ErrStopOrderRepositoryReadList = errors.New("failed to stop order repository read list")
+	// ErrStopOrderRepositoryUpdate is an error.
+	ErrStopOrderRepositoryUpdate = errors.New("failed to stop order repository update")
+	// ErrStopOrderServiceCancel is an error.
+	ErrStopOrderServiceCancel = errors.New("failed to stop order service cancel")
+	// ErrStopOrderServiceCreate is an error.
+	ErrStopOrderServiceCreate = errors.New("failed to stop order service create")
+	// ErrStopOrderServiceGet is an error.
+	ErrStopOrderServiceGet = errors.New("failed to stop order service get")
+	// ErrStopOrderServiceGetByClientOID is an error.
+	ErrStopOrderServiceGetByClientOID = errors.New(
+		"failed to stop order service get by client oid",
+	)
+	// ErrStopOrderServiceGetListFromRemote is an error.
+	ErrStopOrderServiceGetListFromRemote = errors.New(
+		"failed to stop order service get list from remote",
+	)
+	// ErrStopOrderServiceGetListFromRepository is an error.
+	ErrStopOrderServiceGetListFromRepository = errors.New(
+		"failed to stop order service get list from repository",
+	)
+	// ErrStopOrderServicePlace is an error.
+	ErrStopOrderServicePlace = errors.New("failed to stop order service place")
+	// ErrStopOrderServiceReconcile is an error.
+	ErrStopOrderServiceReconcile = errors.New("failed to stop order service reconcile")
+	// ErrStopOrderServiceSync is an error.
+	ErrStopOrderServiceSync = errors.New("failed to stop order service sync")
+	// ErrStopOrderServiceUpdate is an error.
+	ErrStopOrderServiceUpdate = errors.New("failed to stop order service update")
+	// ErrStopOrderServiceUpsert is an error.
+	ErrStopOrderServiceUpsert = errors.New("failed to stop order service upsert")
+	// ErrStrategyAccountUnknown is an error.
+	ErrStrategyAccountUnknown = errors.New("strategy account is not configured")
+	// ErrStrategyEvaluate is an error.
+	ErrStrategyEvaluate = errors.New("failed to strategy evaluate")
+	// ErrStrategyRegistryNew is an error.
+	ErrStrategyRegistryNew = errors.New("failed to strategy registry new")
+	// ErrStrategyRegistryNotFound is an error.
+	ErrStrategyRegistryNotFound = errors.New("failed to strategy registry not found")
+	// ErrStreamKucoinServiceConnect is an error.
+	ErrStreamKucoinServiceConnect = errors.New("failed to stream kucoin service connect")
+	// ErrStreamKucoinServiceRead is an error.
+	ErrStreamKucoinServiceRead = errors.New("failed to stream kucoin service read")
+	// ErrStreamKucoinServiceSubscribe is an error.
+	ErrStreamKucoinServiceSubscribe = errors.New("failed to stream kucoin service subscribe")
+	// ErrStreamKucoinServiceToken is an error.
+	ErrStreamKucoinServiceToken = errors.New("failed to stream kucoin service token")
+	// ErrStreamServiceConnect is an error.
+	ErrStreamServiceConnect = errors.New("failed to stream service connect")
+	// ErrStreamServiceHandle is an error.
+	ErrStreamServiceHandle = errors.New("failed to stream service handle")
+	// ErrStreamServiceRun is an error.
+	ErrStreamServiceRun = errors.New("failed to stream service run")
+	// ErrSubAccountKucoinServiceGetList is an error.
+	ErrSubAccountKucoinServiceGetList = errors.New("failed to sub account kucoin service get list")
+	// ErrSubAccountKucoinServiceTransfer is an error.
+	ErrSubAccountKucoinServiceTransfer = errors.New("failed to sub account kucoin service transfer")
+	// ErrSubAccountPaperUnsupported is an error.
+	ErrSubAccountPaperUnsupported = errors.New("sub-accounts are not simulated in paper mode")
+	// ErrSubAccountServiceGetList is an error.
+	ErrSubAccountServiceGetList = errors.New("failed to sub account service get list")
+	// ErrSubAccountServiceTransfer is an error.
+	ErrSubAccountServiceTransfer = errors.New("failed to sub account service transfer")
+	// ErrSymbolKucoinServiceGetList is an error.
+	ErrSymbolKucoinServiceGetList = errors.New("failed to symbol kucoin service get list")
+	// ErrSymbolNotFound is an error.
+	ErrSymbolNotFound = errors.New("failed to symbol not found")
+	// ErrSymbolRepositoryCreate is an error.
+	ErrSymbolRepositoryCreate = errors.New("failed to symbol repository create")
+	// ErrSymbolRepositoryDelete is an error.
+	ErrSymbolRepositoryDelete = errors.New("failed to symbol repository delete")
+	// ErrSymbolRepositoryDeleteAll is an error.
+	ErrSymbolRepositoryDeleteAll = errors.New("failed to symbol repository delete all")
+	// ErrSymbolRepositoryRead is an error.
+	ErrSymbolRepositoryRead = errors.New("failed to symbol repository read")
+	// ErrSymbolRepositoryReadList is an error.
+	ErrSymbolRepositoryReadList = errors.New("failed to symbol repository read list")
+	// ErrSymbolRepositoryUpdate is an error.
+	ErrSymbolRepositoryUpdate = errors.New("failed to symbol repository update")
+	// ErrSymbolServiceCreate is an error.
+	ErrSymbolServiceCreate = errors.New("failed to symbol service create")
+	// ErrSymbolServiceDeleteAll is an error.
+	ErrSymbolServiceDeleteAll = errors.New("failed to symbol service delete all")
+	// ErrSymbolServiceGetBySymbol is an error.
+	ErrSymbolServiceGetBySymbol = errors.New("failed to symbol service get by symbol")
+	// ErrSymbolServiceGetListFromRemote is an error.
+	ErrSymbolServiceGetListFromRemote = errors.New("failed to symbol service get list from remote")
+	// ErrSymbolServiceGetListFromRepository is an error.
+	ErrSymbolServiceGetListFromRepository = errors.New(
+		"failed to symbol service get list from repository",
+	)
+	// ErrSymbolServiceUpsert is an error.
+	ErrSymbolServiceUpsert = errors.New("failed to symbol service upsert")
+	// ErrSymbolServiceValidate is an error.
+	ErrSymbolServiceValidate = errors.New("failed to symbol service validate")
+	// ErrSymbolServiceValidateStop is an error.
+	ErrSymbolServiceValidateStop = errors.New("failed to symbol service validate stop")
+	// ErrSymbolTradingDisabled is an error.
+	ErrSymbolTradingDisabled = errors.New("failed to symbol trading disabled")
 	// ErrTickerKucoinServiceGetList is an error.
 	ErrTickerKucoinServiceGetList = errors.New("failed to ticker kucoin service get list")
 	// ErrTickerRepositoryCreate is an error.
@@ -81,12 +641,16 @@ var (
 	ErrTickerServiceCreate = errors.New("failed to ticker service create")
 	// ErrTickerServiceDeleteAll is an error.
 	ErrTickerServiceDeleteAll = errors.New("failed to ticker service delete all")
+	// ErrTickerServiceGetBySymbol is an error.
+	ErrTickerServiceGetBySymbol = errors.New("failed to ticker service get by symbol")
 	// ErrTickerServiceGetListFromRepository is an error.
 	ErrTickerServiceGetListFromRepository = errors.New(
 		"failed to ticker service get list from repository",
 	)
 	// ErrTickerServiceGetListFromRemote is an error.
 	ErrTickerServiceGetListFromRemote = errors.New("failed to ticker service get list from remote")
+	// ErrTickerServiceUpsert is an error.
+	ErrTickerServiceUpsert = errors.New("failed to ticker service upsert")
 	// ErrTracerProviderShutdown is an error.
 	ErrTracerProviderShutdown = errors.New("failed to shutdown traceTracer provider")
 	// ErrTypeAssertion is an error.
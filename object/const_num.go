@@ -15,6 +15,8 @@ const (
 	NUM1MinToSecond = 60
 	// NUM1WeekToSecond is a variable.
 	NUM1WeekToSecond = 604800
+	// NUM1YearToSecond is a variable.
+	NUM1YearToSecond = 31536000
 	// NUM2HourToSecond is a variable.
 	NUM2HourToSecond = 7200
 	// NUM30MinToSecond is a variable.
@@ -29,16 +31,120 @@ const (
 	NUM6HourToSecond = 21600
 	// NUM8HourToSecond is a variable.
 	NUM8HourToSecond = 28800
+	// NUMAlgoConfigDefaultVWAPLookback is a variable.
+	NUMAlgoConfigDefaultVWAPLookback = 7 * 24 * time.Hour
+	// NUMBacktestConfigDefaultInitialBalance is a variable.
+	NUMBacktestConfigDefaultInitialBalance = 1000
+	// NUMBacktestConfigDefaultMaxHoldKlineCount is a variable.
+	NUMBacktestConfigDefaultMaxHoldKlineCount = 1
+	// NUMBacktestConfigDefaultOrderFunds is a variable.
+	NUMBacktestConfigDefaultOrderFunds = 100
+	// NUMBacktestConfigDefaultSlippage is a variable.
+	NUMBacktestConfigDefaultSlippage = 0.0005
+	// NUMBacktestLookbackToSecond is a variable.
+	NUMBacktestLookbackToSecond = NUM1WeekToSecond
+	// NUMClientOIDLength is a variable.
+	NUMClientOIDLength = 32
+	// NUMFakeServerDefaultPageSize is a variable.
+	NUMFakeServerDefaultPageSize = 50
 	// NUMHTTPClientTimeout is a variable.
 	NUMHTTPClientTimeout = 1500 * time.Millisecond
+	// NUMKlineCandleLength is a variable.
+	NUMKlineCandleLength = 7
+	// NUMKlineConfigDefaultBackfillLookback is a variable.
+	NUMKlineConfigDefaultBackfillLookback = 30 * 24 * time.Hour
 	// NUMKlineDifference is a variable.
 	NUMKlineDifference = 30
+	// NUMKlineRequestCandleLimit is a variable.
+	NUMKlineRequestCandleLimit = 1500
+	// NUMKucoinMultiOrderCount is a variable.
+	NUMKucoinMultiOrderCount = 5
+	// NUMKucoinRecentFillCount is a variable.
+	NUMKucoinRecentFillCount = 1000
+	// NUMKucoinRecentOrderCount is a variable.
+	NUMKucoinRecentOrderCount = 1000
 	// NUMLogConfigDefaultLogMaxSize is a variable.
 	NUMLogConfigDefaultLogMaxSize = 100
+	// NUMOrderBookBufferLength is a variable.
+	NUMOrderBookBufferLength = 1000
+	// NUMOrderBookChangeLength is a variable.
+	NUMOrderBookChangeLength = 3
+	// NUMOrderBookResyncInterval is a variable.
+	NUMOrderBookResyncInterval = 5 * time.Second
+	// NUMPaperConfigDefaultFeeRate is a variable.
+	NUMPaperConfigDefaultFeeRate = 0.001
+	// NUMPaperConfigDefaultMatchInterval is a variable.
+	NUMPaperConfigDefaultMatchInterval = 5 * time.Second
+	// NUMPaperEpsilon is a variable.
+	NUMPaperEpsilon = 1e-9
+	// NUMPortfolioPageSize is a variable.
+	NUMPortfolioPageSize = 1000
+	// NUMRequestConfigDefaultBreakerCooldown is a variable.
+	NUMRequestConfigDefaultBreakerCooldown = 30 * time.Second
+	// NUMRequestConfigDefaultBreakerThreshold is a variable.
+	NUMRequestConfigDefaultBreakerThreshold = 5
+	// NUMRequestConfigDefaultLimitWindow is a variable.
+	NUMRequestConfigDefaultLimitWindow = 30 * time.Second
+	// NUMRequestConfigDefaultManagementLimit is a variable.
+	NUMRequestConfigDefaultManagementLimit = 2000
+	// NUMRequestConfigDefaultMaxAttempts is a variable.
+	NUMRequestConfigDefaultMaxAttempts = 4
+	// NUMRequestConfigDefaultMaxBackoff is a variable.
+	NUMRequestConfigDefaultMaxBackoff = 8 * time.Second
+	// NUMRequestConfigDefaultMinBackoff is a variable.
+	NUMRequestConfigDefaultMinBackoff = 250 * time.Millisecond
+	// NUMRequestConfigDefaultPublicLimit is a variable.
+	NUMRequestConfigDefaultPublicLimit = 2000
+	// NUMRequestConfigDefaultSpotLimit is a variable.
+	NUMRequestConfigDefaultSpotLimit = 4000
+	// NUMRiskOrderWindow is a variable.
+	NUMRiskOrderWindow = time.Minute
+	// NUMRiskPageSize is a variable.
+	NUMRiskPageSize = 1000
 	// NUMRuntimeConfigDefaultRuntimeKucoinPaginationRequestSize is a variable.
 	NUMRuntimeConfigDefaultRuntimeKucoinPaginationRequestSize = 500
+	// NUMSchedulerConfigDefaultAlgoOrderSyncInterval is a variable.
+	NUMSchedulerConfigDefaultAlgoOrderSyncInterval = 10 * time.Second
+	// NUMSchedulerConfigDefaultBracketSyncInterval is a variable.
+	NUMSchedulerConfigDefaultBracketSyncInterval = 10 * time.Second
+	// NUMSchedulerConfigDefaultExchangeStatusSyncInterval is a variable.
+	NUMSchedulerConfigDefaultExchangeStatusSyncInterval = 15 * time.Second
+	// NUMSchedulerConfigDefaultFillSyncInterval is a variable.
+	NUMSchedulerConfigDefaultFillSyncInterval = time.Minute
+	// NUMSchedulerConfigDefaultMarginSyncInterval is a variable.
+	NUMSchedulerConfigDefaultMarginSyncInterval = time.Minute
+	// NUMSchedulerConfigDefaultOrderReconcileInterval is a variable.
+	NUMSchedulerConfigDefaultOrderReconcileInterval = time.Minute
+	// NUMSchedulerConfigDefaultPortfolioSnapshotInterval is a variable.
+	NUMSchedulerConfigDefaultPortfolioSnapshotInterval = 5 * time.Minute
+	// NUMSchedulerConfigDefaultStopOrderSyncInterval is a variable.
+	NUMSchedulerConfigDefaultStopOrderSyncInterval = time.Minute
+	// NUMSchedulerConfigDefaultStrategyEvaluationDelay is a variable.
+	NUMSchedulerConfigDefaultStrategyEvaluationDelay = 5 * time.Second
+	// NUMSchedulerConfigDefaultSymbolRefreshInterval is a variable.
+	NUMSchedulerConfigDefaultSymbolRefreshInterval = time.Hour
+	// NUMSchedulerConfigDefaultTickerRefreshInterval is a variable.
+	NUMSchedulerConfigDefaultTickerRefreshInterval = time.Minute
+	// NUMSchedulerCronFieldCount is a variable.
+	NUMSchedulerCronFieldCount = 5
+	// NUMSchedulerCronSearchDayCount is a variable.
+	NUMSchedulerCronSearchDayCount = 1464
+	// NUMSizingATRWarmup is a variable.
+	NUMSizingATRWarmup = 3
+	// NUMStrategyOpenLowMarketRatioDefaultRatio is a variable.
+	NUMStrategyOpenLowMarketRatioDefaultRatio = 2
+	// NUMStreamConfigDefaultReconnectMaxBackoff is a variable.
+	NUMStreamConfigDefaultReconnectMaxBackoff = time.Minute
+	// NUMStreamConfigDefaultReconnectMinBackoff is a variable.
+	NUMStreamConfigDefaultReconnectMinBackoff = time.Second
+	// NUMStreamTopicPerSymbolCount is a variable.
+	NUMStreamTopicPerSymbolCount = 2
+	// NUMStreamTopicSymbolCount is a variable.
+	NUMStreamTopicSymbolCount = 100
 	// NUMSystemGracefulShutdown is a variable.
 	NUMSystemGracefulShutdown = 5 * time.Second
 	// NUMTopTickerChangeRateCount is a variable.
 	NUMTopTickerChangeRateCount = 10
+	// NUMUnixEpochToFirstMondayToSecond is a variable.
+	NUMUnixEpochToFirstMondayToSecond = 345600
 )
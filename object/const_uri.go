@@ -1,18 +1,134 @@
 package object
 
 const (
+	// URIAlgoOrderScheduleSeparator is an uri.
+	URIAlgoOrderScheduleSeparator = ","
+	// URIBacktestExitReasonEnd is an uri.
+	URIBacktestExitReasonEnd = "end"
+	// URIBacktestExitReasonHold is an uri.
+	URIBacktestExitReasonHold = "hold"
+	// URIBacktestExitReasonSignal is an uri.
+	URIBacktestExitReasonSignal = "signal"
+	// URIBacktestFileEquities is an uri.
+	URIBacktestFileEquities = "backtest_equities.csv"
+	// URIBacktestFileResult is an uri.
+	URIBacktestFileResult = "backtest.json"
+	// URIBacktestFileStatistic is an uri.
+	URIBacktestFileStatistic = "backtest_statistic.csv"
+	// URIBacktestFileTrades is an uri.
+	URIBacktestFileTrades = "backtest_trades.csv"
+	// URIBracketLegExit is an uri.
+	URIBracketLegExit = "exit"
+	// URIBracketLegStop is an uri.
+	URIBracketLegStop = "stop"
+	// URIBracketLegTakeProfit is an uri.
+	URIBracketLegTakeProfit = "take_profit"
+	// URIClientOIDSeparator is an uri.
+	URIClientOIDSeparator = "|"
 	// URIEmpty is an uri.
 	URIEmpty = ""
+	// URIFieldATR is an uri.
+	URIFieldATR = "atr"
+	// URIFieldAccount is an uri.
+	URIFieldAccount = "account"
+	// URIFieldAlgoOrderID is an uri.
+	URIFieldAlgoOrderID = "algo_order_id"
 	// URIFieldAsksValue is an uri.
 	URIFieldAsksValue = "asks_value"
+	// URIFieldAttempt is an uri.
+	URIFieldAttempt = "attempt"
+	// URIFieldBackoff is an uri.
+	URIFieldBackoff = "backoff"
+	// URIFieldBalanceID is an uri.
+	URIFieldBalanceID = "balance_id"
 	// URIFieldBidsValue is an uri.
 	URIFieldBidsValue = "bids_value"
 	// URIFieldBody is an uri.
 	URIFieldBody = "body"
+	// URIFieldBorrowID is an uri.
+	URIFieldBorrowID = "borrow_id"
+	// URIFieldBracketID is an uri.
+	URIFieldBracketID = "bracket_id"
+	// URIFieldBucketStartAt is an uri.
+	URIFieldBucketStartAt = "bucket_start_at"
+	// URIFieldBufferLength is an uri.
+	URIFieldBufferLength = "buffer_length"
+	// URIFieldCapital is an uri.
+	URIFieldCapital = "capital"
+	// URIFieldClientOID is an uri.
+	URIFieldClientOID = "client_oid"
+	// URIFieldClientOIDs is an uri.
+	URIFieldClientOIDs = "client_oids"
+	// URIFieldCount is an uri.
+	URIFieldCount = "count"
+	// URIFieldCreateMultiOrderResultModel is an uri.
+	URIFieldCreateMultiOrderResultModel = "create_multi_order_result_model"
+	// URIFieldCurrency is an uri.
+	URIFieldCurrency = "currency"
+	// URIFieldDAOAlgoOrder is an uri.
+	URIFieldDAOAlgoOrder = "dao_algo_order"
+	// URIFieldDAOAlgoOrderers is an uri.
+	URIFieldDAOAlgoOrderers = "dao_algo_orderers"
+	// URIFieldDAOAlgoOrders is an uri.
+	URIFieldDAOAlgoOrders = "dao_algo_orders"
+	// URIFieldDAOBalance is an uri.
+	URIFieldDAOBalance = "dao_balance"
+	// URIFieldDAOBalancers is an uri.
+	URIFieldDAOBalancers = "dao_balancers"
+	// URIFieldDAOBalances is an uri.
+	URIFieldDAOBalances = "dao_balances"
+	// URIFieldDAOBorrow is an uri.
+	URIFieldDAOBorrow = "dao_borrow"
+	// URIFieldDAOBorrowers is an uri.
+	URIFieldDAOBorrowers = "dao_borrowers"
+	// URIFieldDAOBorrows is an uri.
+	URIFieldDAOBorrows = "dao_borrows"
+	// URIFieldDAOBracket is an uri.
+	URIFieldDAOBracket = "dao_bracket"
+	// URIFieldDAOBracketers is an uri.
+	URIFieldDAOBracketers = "dao_bracketers"
+	// URIFieldDAOBrackets is an uri.
+	URIFieldDAOBrackets = "dao_brackets"
 	// URIFieldDAOCursor is an uri.
 	URIFieldDAOCursor = "dao_cursor"
 	// URIFieldDAOCursorer is an uri.
 	URIFieldDAOCursorer = "dao_cursorer"
+	// URIFieldDAOEquitiers is an uri.
+	URIFieldDAOEquitiers = "dao_equitiers"
+	// URIFieldDAOEquities is an uri.
+	URIFieldDAOEquities = "dao_equities"
+	// URIFieldDAOEquity is an uri.
+	URIFieldDAOEquity = "dao_equity"
+	// URIFieldDAOFill is an uri.
+	URIFieldDAOFill = "dao_fill"
+	// URIFieldDAOFillers is an uri.
+	URIFieldDAOFillers = "dao_fillers"
+	// URIFieldDAOFills is an uri.
+	URIFieldDAOFills = "dao_fills"
+	// URIFieldDAOKillSwitch is an uri.
+	URIFieldDAOKillSwitch = "dao_kill_switch"
+	// URIFieldDAOKillSwitchers is an uri.
+	URIFieldDAOKillSwitchers = "dao_kill_switchers"
+	// URIFieldDAOKillSwitches is an uri.
+	URIFieldDAOKillSwitches = "dao_kill_switches"
+	// URIFieldDAOKline is an uri.
+	URIFieldDAOKline = "dao_kline"
+	// URIFieldDAOKliners is an uri.
+	URIFieldDAOKliners = "dao_kliners"
+	// URIFieldDAOKlines is an uri.
+	URIFieldDAOKlines = "dao_klines"
+	// URIFieldDAOMarginBalance is an uri.
+	URIFieldDAOMarginBalance = "dao_margin_balance"
+	// URIFieldDAOMarginBalancers is an uri.
+	URIFieldDAOMarginBalancers = "dao_margin_balancers"
+	// URIFieldDAOMarginBalances is an uri.
+	URIFieldDAOMarginBalances = "dao_margin_balances"
+	// URIFieldDAOMarginRiskLimit is an uri.
+	URIFieldDAOMarginRiskLimit = "dao_margin_risk_limit"
+	// URIFieldDAOMarginRiskLimiters is an uri.
+	URIFieldDAOMarginRiskLimiters = "dao_margin_risk_limiters"
+	// URIFieldDAOMarginRiskLimits is an uri.
+	URIFieldDAOMarginRiskLimits = "dao_margin_risk_limits"
 	// URIFieldDAOOrder is an uri.
 	URIFieldDAOOrder = "dao_order"
 	// URIFieldDAOOrders is an uri.
@@ -21,6 +137,18 @@ const (
 	URIFieldDAOOrderers = "dao_orderers"
 	// URIFieldDAOPagination is an uri.
 	URIFieldDAOPagination = "dao_pagination"
+	// URIFieldDAOStopOrder is an uri.
+	URIFieldDAOStopOrder = "dao_stop_order"
+	// URIFieldDAOStopOrderers is an uri.
+	URIFieldDAOStopOrderers = "dao_stop_orderers"
+	// URIFieldDAOStopOrders is an uri.
+	URIFieldDAOStopOrders = "dao_stop_orders"
+	// URIFieldDAOSymbol is an uri.
+	URIFieldDAOSymbol = "dao_symbol"
+	// URIFieldDAOSymbolers is an uri.
+	URIFieldDAOSymbolers = "dao_symbolers"
+	// URIFieldDAOSymbols is an uri.
+	URIFieldDAOSymbols = "dao_symbols"
 	// URIFieldDAOTicker is an uri.
 	URIFieldDAOTicker = "dao_ticker"
 	// URIFieldDAOTickerFilter is an uri.
@@ -33,22 +161,80 @@ const (
 	URIFieldDTOKlineRequest = "dto_kline_request"
 	// URIFieldDTOOrderRequest is an uri.
 	URIFieldDTOOrderRequest = "dto_order_request"
+	// URIFieldDTOPlaceAlgoOrderRequest is an uri.
+	URIFieldDTOPlaceAlgoOrderRequest = "dto_place_algo_order_request"
+	// URIFieldDTOPlaceBracketRequest is an uri.
+	URIFieldDTOPlaceBracketRequest = "dto_place_bracket_request"
+	// URIFieldDTOPlaceOrderRequest is an uri.
+	URIFieldDTOPlaceOrderRequest = "dto_place_order_request"
+	// URIFieldDTOPlaceStopOrderRequest is an uri.
+	URIFieldDTOPlaceStopOrderRequest = "dto_place_stop_order_request"
+	// URIFieldDebtRatio is an uri.
+	URIFieldDebtRatio = "debt_ratio"
 	// URIFieldDeletedAt is an uri.
 	URIFieldDeletedAt = "deleted_at"
+	// URIFieldEndAt is an uri.
+	URIFieldEndAt = "end_at"
+	// URIFieldEndpointGroup is an uri.
+	URIFieldEndpointGroup = "endpoint_group"
+	// URIFieldEquityID is an uri.
+	URIFieldEquityID = "equity_id"
 	// URIFieldError is an uri.
 	URIFieldError = "error"
+	// URIFieldExchangeAccountBalanceModel is an uri.
+	URIFieldExchangeAccountBalanceModel = "exchange_account_balance_model"
+	// URIFieldExchangeMarketCandlesModel is an uri.
+	URIFieldExchangeMarketCandlesModel = "exchange_market_candles_model"
+	// URIFieldExchangeMarketLevel2Model is an uri.
+	URIFieldExchangeMarketLevel2Model = "exchange_market_level2_model"
+	// URIFieldExchangeMarketSnapshotModel is an uri.
+	URIFieldExchangeMarketSnapshotModel = "exchange_market_snapshot_model"
+	// URIFieldExchangeMode is an uri.
+	URIFieldExchangeMode = "exchange_mode"
+	// URIFieldExchangeOrderChangeModel is an uri.
+	URIFieldExchangeOrderChangeModel = "exchange_order_change_model"
+	// URIFieldFillID is an uri.
+	URIFieldFillID = "fill_id"
 	// URIFieldHTTPResponse is an uri.
 	URIFieldHTTPResponse = "http_response"
 	// URIFieldID is an uri.
 	URIFieldID = "id"
 	// URIFieldJaegerExporter is an uri.
 	URIFieldJaegerExporter = "jaeger_exporter"
+	// URIFieldJob is an uri.
+	URIFieldJob = "job"
+	// URIFieldJobs is an uri.
+	URIFieldJobs = "jobs"
 	// URIFieldKey is an uri.
 	URIFieldKey = "key"
+	// URIFieldKillSwitchID is an uri.
+	URIFieldKillSwitchID = "kill_switch_id"
+	// URIFieldKlineID is an uri.
+	URIFieldKlineID = "kline_id"
+	// URIFieldKliners is an uri.
+	URIFieldKliners = "kliners"
+	// URIFieldKucoinAccountsModel is an uri.
+	URIFieldKucoinAccountsModel = "kucoin_accounts_model"
+	// URIFieldKucoinBorrowOrderResultModel is an uri.
+	URIFieldKucoinBorrowOrderResultModel = "kucoin_borrow_order_result_model"
+	// URIFieldKucoinCancelOrderResultModel is an uri.
+	URIFieldKucoinCancelOrderResultModel = "kucoin_cancel_order_result_model"
+	// URIFieldKucoinCancelStopOrderByClientModel is an uri.
+	URIFieldKucoinCancelStopOrderByClientModel = "kucoin_cancel_stop_order_by_client_model"
+	// URIFieldKucoinCreateOrderModel is an uri.
+	URIFieldKucoinCreateOrderModel = "kucoin_create_order_model"
+	// URIFieldKucoinCreateOrderResultModel is an uri.
+	URIFieldKucoinCreateOrderResultModel = "kucoin_create_order_result_model"
 	// URIFieldKucoinFullOrderBookModel is an uri.
 	URIFieldKucoinFullOrderBookModel = "kucoin_full_order_book_model"
 	// URIFieldKucoinKLinesModel is an uri.
 	URIFieldKucoinKLinesModel = "kucoin_kline_model"
+	// URIFieldKucoinMarginAccountModel is an uri.
+	URIFieldKucoinMarginAccountModel = "kucoin_margin_account_model"
+	// URIFieldKucoinMarginRiskLimitModel is an uri.
+	URIFieldKucoinMarginRiskLimitModel = "kucoin_margin_risk_limit_model"
+	// URIFieldKucoinOrderModel is an uri.
+	URIFieldKucoinOrderModel = "kucoin_order_model"
 	// URIFieldKucoinOrdersModel is an uri.
 	URIFieldKucoinOrdersModel = "kucoin_orders_model"
 	// URIFieldKucoinPaginationModel is an uri.
@@ -57,18 +243,112 @@ const (
 	URIFieldKucoinPaginationParam = "kucoin_pagination_param"
 	// URIFieldKlineType is an uri.
 	URIFieldKlineType = "kucoin_type"
+	// URIFieldKucoinServiceStatusModel is an uri.
+	URIFieldKucoinServiceStatusModel = "kucoin_service_status_model"
+	// URIFieldKucoinStopOrderListModel is an uri.
+	URIFieldKucoinStopOrderListModel = "kucoin_stop_order_list_model"
+	// URIFieldKucoinStopOrderModel is an uri.
+	URIFieldKucoinStopOrderModel = "kucoin_stop_order_model"
+	// URIFieldKucoinSubAccountModels is an uri.
+	URIFieldKucoinSubAccountModels = "kucoin_sub_account_models"
+	// URIFieldKucoinSubTransferResultModel is an uri.
+	URIFieldKucoinSubTransferResultModel = "kucoin_sub_transfer_result_model"
+	// URIFieldKucoinTickerLevel1Model is an uri.
+	URIFieldKucoinTickerLevel1Model = "kucoin_ticker_level1_model"
 	// URIFieldKucoinTickersModel is an uri.
 	URIFieldKucoinTickersModel = "kucoin_tickers_model"
+	// URIFieldLimit is an uri.
+	URIFieldLimit = "limit"
+	// URIFieldMarginBalanceID is an uri.
+	URIFieldMarginBalanceID = "margin_balance_id"
+	// URIFieldMarginRiskLimitID is an uri.
+	URIFieldMarginRiskLimitID = "margin_risk_limit_id"
 	// URIFieldMarketRatio is an uri.
 	URIFieldMarketRatio = "market_ratio"
+	// URIFieldMessage is an uri.
+	URIFieldMessage = "message"
+	// URIFieldMethod is an uri.
+	URIFieldMethod = "method"
 	// URIFieldModTime is an uri.
 	URIFieldModTime = "mod_time"
+	// URIFieldNextRunAt is an uri.
+	URIFieldNextRunAt = "next_run_at"
+	// URIFieldNotional is an uri.
+	URIFieldNotional = "notional"
 	// URIFieldNowUTC is an uri.
 	URIFieldNowUTC = "now_utc"
+	// URIFieldOMAlgoOrder is an uri.
+	URIFieldOMAlgoOrder = "om_algo_order"
+	// URIFieldOMAlgoOrders is an uri.
+	URIFieldOMAlgoOrders = "om_algo_orders"
+	// URIFieldOMBacktestEquity is an uri.
+	URIFieldOMBacktestEquity = "om_backtest_equity"
+	// URIFieldOMBacktestStatistic is an uri.
+	URIFieldOMBacktestStatistic = "om_backtest_statistic"
+	// URIFieldOMBacktestTrade is an uri.
+	URIFieldOMBacktestTrade = "om_backtest_trade"
+	// URIFieldOMBalance is an uri.
+	URIFieldOMBalance = "om_balance"
+	// URIFieldOMBalances is an uri.
+	URIFieldOMBalances = "om_balances"
+	// URIFieldOMBorrow is an uri.
+	URIFieldOMBorrow = "om_borrow"
+	// URIFieldOMBorrows is an uri.
+	URIFieldOMBorrows = "om_borrows"
+	// URIFieldOMBracket is an uri.
+	URIFieldOMBracket = "om_bracket"
+	// URIFieldOMBrackets is an uri.
+	URIFieldOMBrackets = "om_brackets"
+	// URIFieldOMEquities is an uri.
+	URIFieldOMEquities = "om_equities"
+	// URIFieldOMEquity is an uri.
+	URIFieldOMEquity = "om_equity"
+	// URIFieldOMFill is an uri.
+	URIFieldOMFill = "om_fill"
+	// URIFieldOMFills is an uri.
+	URIFieldOMFills = "om_fills"
+	// URIFieldOMJobStatus is an uri.
+	URIFieldOMJobStatus = "om_job_status"
+	// URIFieldOMJobStatuses is an uri.
+	URIFieldOMJobStatuses = "om_job_statuses"
+	// URIFieldOMKillSwitch is an uri.
+	URIFieldOMKillSwitch = "om_kill_switch"
+	// URIFieldOMKillSwitches is an uri.
+	URIFieldOMKillSwitches = "om_kill_switches"
+	// URIFieldOMKline is an uri.
+	URIFieldOMKline = "om_kline"
+	// URIFieldOMKlines is an uri.
+	URIFieldOMKlines = "om_klines"
+	// URIFieldOMMarginBalance is an uri.
+	URIFieldOMMarginBalance = "om_margin_balance"
+	// URIFieldOMMarginBalances is an uri.
+	URIFieldOMMarginBalances = "om_margin_balances"
+	// URIFieldOMMarginRiskLimit is an uri.
+	URIFieldOMMarginRiskLimit = "om_margin_risk_limit"
+	// URIFieldOMMarginRiskLimits is an uri.
+	URIFieldOMMarginRiskLimits = "om_margin_risk_limits"
 	// URIFieldOMOrder is an uri.
 	URIFieldOMOrder = "om_order"
+	// URIFieldOMOrderBook is an uri.
+	URIFieldOMOrderBook = "om_order_book"
 	// URIFieldOMOrders is an uri.
 	URIFieldOMOrders = "om_orders"
+	// URIFieldOMPosition is an uri.
+	URIFieldOMPosition = "om_position"
+	// URIFieldOMPositions is an uri.
+	URIFieldOMPositions = "om_positions"
+	// URIFieldOMSignal is an uri.
+	URIFieldOMSignal = "om_signal"
+	// URIFieldOMSignals is an uri.
+	URIFieldOMSignals = "om_signals"
+	// URIFieldOMStopOrder is an uri.
+	URIFieldOMStopOrder = "om_stop_order"
+	// URIFieldOMStopOrders is an uri.
+	URIFieldOMStopOrders = "om_stop_orders"
+	// URIFieldOMSymbol is an uri.
+	URIFieldOMSymbol = "om_symbol"
+	// URIFieldOMSymbols is an uri.
+	URIFieldOMSymbols = "om_symbols"
 	// URIFieldOMTicker is an uri.
 	URIFieldOMTicker = "om_ticker"
 	// URIFieldOMTickers is an uri.
@@ -79,46 +359,362 @@ const (
 	URIFieldOrderID = "order_id"
 	// URIFieldParams is an uri.
 	URIFieldParams = "params"
+	// URIFieldPrice is an uri.
+	URIFieldPrice = "price"
+	// URIFieldReady is an uri.
+	URIFieldReady = "ready"
+	// URIFieldReason is an uri.
+	URIFieldReason = "reason"
 	// URIFieldResponse is an uri.
 	URIFieldResponse = "response"
 	// URIFieldResult is an uri.
 	URIFieldResult = "result"
+	// URIFieldRiskReason is an uri.
+	URIFieldRiskReason = "risk_reason"
 	// URIFieldRows is an uri.
 	URIFieldRows = "rows"
 	// URIFieldSDKResourceResource is an uri.
 	URIFieldSDKResourceResource = "sdk_resource_resource"
+	// URIFieldSchedule is an uri.
+	URIFieldSchedule = "schedule"
 	// URIFieldSecondKlineType is an uri.
 	URIFieldSecondKlineType = "second_kline_type"
+	// URIFieldSequence is an uri.
+	URIFieldSequence = "sequence"
+	// URIFieldSize is an uri.
+	URIFieldSize = "size"
+	// URIFieldSizingModel is an uri.
+	URIFieldSizingModel = "sizing_model"
 	// URIFieldStartAt is an uri.
 	URIFieldStartAt = "start_at"
+	// URIFieldStatus is an uri.
+	URIFieldStatus = "status"
+	// URIFieldStopOrderID is an uri.
+	URIFieldStopOrderID = "stop_order_id"
+	// URIFieldStopPrice is an uri.
+	URIFieldStopPrice = "stop_price"
+	// URIFieldStrategy is an uri.
+	URIFieldStrategy = "strategy"
+	// URIFieldSymbol is an uri.
+	URIFieldSymbol = "symbol"
+	// URIFieldSymbolID is an uri.
+	URIFieldSymbolID = "symbol_id"
+	// URIFieldSymbolModels is an uri.
+	URIFieldSymbolModels = "symbol_models"
 	// URIFieldTickerID is an uri.
 	URIFieldTickerID = "ticker_id"
 	// URIFieldTimeNowUnix is an uri.
 	URIFieldTimeNowUnix = "time_now_unix"
+	// URIFieldTopics is an uri.
+	URIFieldTopics = "topics"
 	// URIFieldTracer is an uri.
 	URIFieldTracer = "traceTracer"
 	// URIFieldTracerProvider is an uri.
 	URIFieldTracerProvider = "tracer_provider"
+	// URIFieldTrailingPrice is an uri.
+	URIFieldTrailingPrice = "trailing_price"
+	// URIFieldType is an uri.
+	URIFieldType = "type"
+	// URIFieldUpdatedAt is an uri.
+	URIFieldUpdatedAt = "updated_at"
 	// URIFieldValue is an uri.
 	URIFieldValue = "value"
+	// URIFieldValues is an uri.
+	URIFieldValues = "values"
+	// URIFieldWeights is an uri.
+	URIFieldWeights = "weights"
 	// URIHTTPHeaderContentType is an uri.
 	URIHTTPHeaderContentType = "Content-Type"
 	// URIHTTPHeaderContentTypeAppKafka is an uri.
 	URIHTTPHeaderContentTypeAppKafka = "application/vnd.kafka.json.v2+json"
+	// URIKucoinAccountTypeTrade is an uri.
+	URIKucoinAccountTypeTrade = "trade"
+	// URIKucoinBorrowTypeFOK is an uri.
+	URIKucoinBorrowTypeFOK = "FOK"
+	// URIKucoinCodeAPIKeyNotExist is an uri.
+	URIKucoinCodeAPIKeyNotExist = "400003"
+	// URIKucoinCodeAccessDenied is an uri.
+	URIKucoinCodeAccessDenied = "400007"
+	// URIKucoinCodeBalanceInsufficient is an uri.
+	URIKucoinCodeBalanceInsufficient = "200004"
+	// URIKucoinCodeHeaderMissing is an uri.
+	URIKucoinCodeHeaderMissing = "400001"
+	// URIKucoinCodeIPForbidden is an uri.
+	URIKucoinCodeIPForbidden = "400006"
+	// URIKucoinCodeInvalidParameter is an uri.
+	URIKucoinCodeInvalidParameter = "400100"
+	// URIKucoinCodeMarginBalanceInsufficient is an uri.
+	URIKucoinCodeMarginBalanceInsufficient = "230003"
+	// URIKucoinCodeNotFound is an uri.
+	URIKucoinCodeNotFound = "404000"
+	// URIKucoinCodePassPhraseInvalid is an uri.
+	URIKucoinCodePassPhraseInvalid = "400004"
+	// URIKucoinCodeServerPrefix is an uri.
+	URIKucoinCodeServerPrefix = "5"
+	// URIKucoinCodeSignatureInvalid is an uri.
+	URIKucoinCodeSignatureInvalid = "400005"
+	// URIKucoinCodeSuccess is an uri.
+	URIKucoinCodeSuccess = "200000"
+	// URIKucoinCodeSymbolNotExist is an uri.
+	URIKucoinCodeSymbolNotExist = "900001"
+	// URIKucoinCodeTimestampInvalid is an uri.
+	URIKucoinCodeTimestampInvalid = "400002"
+	// URIKucoinCodeTooManyRequests is an uri.
+	URIKucoinCodeTooManyRequests = "429000"
+	// URIKucoinCodeUserFrozen is an uri.
+	URIKucoinCodeUserFrozen = "411100"
+	// URIKucoinFillLiquidityMaker is an uri.
+	URIKucoinFillLiquidityMaker = "maker"
+	// URIKucoinFillLiquidityTaker is an uri.
+	URIKucoinFillLiquidityTaker = "taker"
+	// URIKucoinMessageBalanceInsufficient is an uri.
+	URIKucoinMessageBalanceInsufficient = "Balance insufficient!"
+	// URIKucoinMessageClientOIDDuplicated is an uri.
+	URIKucoinMessageClientOIDDuplicated = "clientOid duplicated"
+	// URIKucoinMessageOrderNotExist is an uri.
+	URIKucoinMessageOrderNotExist = "order_not_exist_or_not_allow_to_cancel"
+	// URIKucoinMessagePrecision is an uri.
+	URIKucoinMessagePrecision = "increment"
+	// URIKucoinOrderChannelAPI is an uri.
+	URIKucoinOrderChannelAPI = "API"
+	// URIKucoinOrderOPTypeDeal is an uri.
+	URIKucoinOrderOPTypeDeal = "DEAL"
+	// URIKucoinOrderStatusFail is an uri.
+	URIKucoinOrderStatusFail = "fail"
+	// URIKucoinOrderStatusSuccess is an uri.
+	URIKucoinOrderStatusSuccess = "success"
+	// URIKucoinParamAccountType is an uri.
+	URIKucoinParamAccountType = "accountType"
+	// URIKucoinParamAmount is an uri.
+	URIKucoinParamAmount = "amount"
+	// URIKucoinParamClientOID is an uri.
+	URIKucoinParamClientOID = "clientOid"
+	// URIKucoinParamDirection is an uri.
+	URIKucoinParamDirection = "direction"
+	// URIKucoinParamSubAccountType is an uri.
+	URIKucoinParamSubAccountType = "subAccountType"
+	// URIKucoinParamSubUserID is an uri.
+	URIKucoinParamSubUserID = "subUserId"
+	// URIKucoinParamTradeID is an uri.
+	URIKucoinParamTradeID = "tradeId"
+	// URIKucoinPathAccounts is an uri.
+	URIKucoinPathAccounts = "/api/v1/accounts"
+	// URIKucoinPathFills is an uri.
+	URIKucoinPathFills = "/api/v1/fills"
+	// URIKucoinPathLimitFills is an uri.
+	URIKucoinPathLimitFills = "/api/v1/limit/fills"
+	// URIKucoinPathLimitOrders is an uri.
+	URIKucoinPathLimitOrders = "/api/v1/limit/orders"
+	// URIKucoinPathOrderClientOrder is an uri.
+	URIKucoinPathOrderClientOrder = "/api/v1/order/client-order/"
+	// URIKucoinPathOrders is an uri.
+	URIKucoinPathOrders = "/api/v1/orders"
+	// URIKucoinPathOrdersMulti is an uri.
+	URIKucoinPathOrdersMulti = "/api/v1/orders/multi"
+	// URIKucoinPathSeparator is an uri.
+	URIKucoinPathSeparator = "/"
+	// URIKucoinPathStopOrder is an uri.
+	URIKucoinPathStopOrder = "/api/v1/stop-order"
+	// URIKucoinPathStopOrderCancelByClientOID is an uri.
+	URIKucoinPathStopOrderCancelByClientOID = "/api/v1/stop-order/cancelOrderByClientOid"
+	// URIKucoinPathStopOrderQueryByClientOID is an uri.
+	URIKucoinPathStopOrderQueryByClientOID = "/api/v1/stop-order/queryOrderByClientOid"
+	// URIKucoinRepaySequenceRecentlyExpireFirst is an uri.
+	URIKucoinRepaySequenceRecentlyExpireFirst = "RECENTLY_EXPIRE_FIRST"
+	// URIKucoinServiceStatusCancelOnly is an uri.
+	URIKucoinServiceStatusCancelOnly = "cancelonly"
+	// URIKucoinServiceStatusOpen is an uri.
+	URIKucoinServiceStatusOpen = "open"
+	// URIKucoinStopOrderStatusNew is an uri.
+	URIKucoinStopOrderStatusNew = "NEW"
+	// URIKucoinStopOrderStatusTriggered is an uri.
+	URIKucoinStopOrderStatusTriggered = "TRIGGERED"
+	// URIKucoinSubTransferAccountTypeTrade is an uri.
+	URIKucoinSubTransferAccountTypeTrade = "TRADE"
+	// URIKucoinSymbolSeparator is an uri.
+	URIKucoinSymbolSeparator = "-"
+	// URIOrderBookPriceZero is an uri.
+	URIOrderBookPriceZero = "0"
+	// URIPaperConfigDefaultBalance is an uri.
+	URIPaperConfigDefaultBalance = "1000"
+	// URIPaperConfigDefaultBalanceCurrency is an uri.
+	URIPaperConfigDefaultBalanceCurrency = "USDT"
+	// URIPortfolioAverageCostIncrement is an uri.
+	URIPortfolioAverageCostIncrement = "0.000000000001"
+	// URIPortfolioConfigDefaultCurrency is an uri.
+	URIPortfolioConfigDefaultCurrency = "USDT"
 	// URIRedpandaTopic is an uri.
 	URIRedpandaTopic = "/topics/%s"
+	// URIRiskLimitScopeAccount is an uri.
+	URIRiskLimitScopeAccount = "account"
+	// URIRiskLimitScopeStrategy is an uri.
+	URIRiskLimitScopeStrategy = "strategy"
+	// URIRiskLimitSeparator is an uri.
+	URIRiskLimitSeparator = "."
+	// URIRiskPriceBandIncrement is an uri.
+	URIRiskPriceBandIncrement = "0.000000000001"
+	// URIRuntimeContextAccount is an uri.
+	URIRuntimeContextAccount = "account"
 	// URIRuntimeContextClientHost is an uri.
 	URIRuntimeContextClientHost = "client_host"
 	// URIRuntimeContextClientPort is an uri.
 	URIRuntimeContextClientPort = "client_port"
+	// URIRuntimeContextIntentAt is an uri.
+	URIRuntimeContextIntentAt = "intent_at"
 	// URIRuntimeContextMetadata is an uri.
 	URIRuntimeContextMetadata = "metadata"
+	// URIRuntimeContextReduceOnly is an uri.
+	URIRuntimeContextReduceOnly = "reduce_only"
+	// URIRuntimeContextStrategy is an uri.
+	URIRuntimeContextStrategy = "strategy"
 	// URIRuntimeContextUserID is an uri.
 	URIRuntimeContextUserID = "user_id"
+	// URISchedulerConfigDefaultOrderSyncCron is an uri.
+	URISchedulerConfigDefaultOrderSyncCron = "*/5 * * * *"
+	// URISchedulerCronAny is an uri.
+	URISchedulerCronAny = "*"
+	// URISchedulerCronListSeparator is an uri.
+	URISchedulerCronListSeparator = ","
+	// URISchedulerCronRangeSeparator is an uri.
+	URISchedulerCronRangeSeparator = "-"
+	// URISchedulerCronStepSeparator is an uri.
+	URISchedulerCronStepSeparator = "/"
+	// URISchedulerJobAlgoOrderSync is an uri.
+	URISchedulerJobAlgoOrderSync = "algo_order_sync"
+	// URISchedulerJobBracketSync is an uri.
+	URISchedulerJobBracketSync = "bracket_sync"
+	// URISchedulerJobExchangeStatusSync is an uri.
+	URISchedulerJobExchangeStatusSync = "exchange_status_sync"
+	// URISchedulerJobFillSync is an uri.
+	URISchedulerJobFillSync = "fill_sync"
+	// URISchedulerJobMarginSync is an uri.
+	URISchedulerJobMarginSync = "margin_sync"
+	// URISchedulerJobOrderReconcile is an uri.
+	URISchedulerJobOrderReconcile = "order_reconcile"
+	// URISchedulerJobOrderSync is an uri.
+	URISchedulerJobOrderSync = "order_sync"
+	// URISchedulerJobPaperMatch is an uri.
+	URISchedulerJobPaperMatch = "paper_match"
+	// URISchedulerJobPortfolioSnapshot is an uri.
+	URISchedulerJobPortfolioSnapshot = "portfolio_snapshot"
+	// URISchedulerJobStopOrderSync is an uri.
+	URISchedulerJobStopOrderSync = "stop_order_sync"
+	// URISchedulerJobStrategyEvaluation is an uri.
+	URISchedulerJobStrategyEvaluation = "strategy_evaluation"
+	// URISchedulerJobSymbolRefresh is an uri.
+	URISchedulerJobSymbolRefresh = "symbol_refresh"
+	// URISchedulerJobTickerRefresh is an uri.
+	URISchedulerJobTickerRefresh = "ticker_refresh"
+	// URIServerParamName is an uri.
+	URIServerParamName = "name"
+	// URIServerPathHealth is an uri.
+	URIServerPathHealth = "/health"
+	// URIServerPathRiskKillSwitch is an uri.
+	URIServerPathRiskKillSwitch = "/risk/kill_switch"
+	// URIServerPathSchedulerJob is an uri.
+	URIServerPathSchedulerJob = "/scheduler/jobs/:name"
+	// URIServerQueryCancel is an uri.
+	URIServerQueryCancel = "cancel"
+	// URIServerQueryReason is an uri.
+	URIServerQueryReason = "reason"
+	// URISizingConfigDefaultQuoteAmount is an uri.
+	URISizingConfigDefaultQuoteAmount = "10"
+	// URISizingDefaultATRMultiplier is an uri.
+	URISizingDefaultATRMultiplier = "2"
+	// URISizingDefaultATRPeriod is an uri.
+	URISizingDefaultATRPeriod = "14"
+	// URISizingDefaultKellyCap is an uri.
+	URISizingDefaultKellyCap = "0.25"
+	// URISizingIncrement is an uri.
+	URISizingIncrement = "0.000000000001"
+	// URISizingParameterATRMultiplier is an uri.
+	URISizingParameterATRMultiplier = "atr_multiplier"
+	// URISizingParameterATRPeriod is an uri.
+	URISizingParameterATRPeriod = "atr_period"
+	// URISizingParameterFraction is an uri.
+	URISizingParameterFraction = "fraction"
+	// URISizingParameterKellyCap is an uri.
+	URISizingParameterKellyCap = "kelly_cap"
+	// URISizingParameterKlineType is an uri.
+	URISizingParameterKlineType = "kline_type"
+	// URISizingParameterModel is an uri.
+	URISizingParameterModel = "model"
+	// URISizingParameterPayoff is an uri.
+	URISizingParameterPayoff = "payoff"
+	// URISizingParameterQuoteAmount is an uri.
+	URISizingParameterQuoteAmount = "quote_amount"
+	// URISizingParameterWinRate is an uri.
+	URISizingParameterWinRate = "win_rate"
+	// URIStrategyOpenLowMarketRatio is an uri.
+	URIStrategyOpenLowMarketRatio = "open_low_market_ratio"
+	// URIStrategyParameterKlineType is an uri.
+	URIStrategyParameterKlineType = "kline_type"
+	// URIStrategyParameterRatio is an uri.
+	URIStrategyParameterRatio = "ratio"
+	// URIStrategyParameterSeparator is an uri.
+	URIStrategyParameterSeparator = "."
+	// URIStrategyParameterTickerCount is an uri.
+	URIStrategyParameterTickerCount = "ticker_count"
+	// URIStrategyReasonOpenLowMarketRatio is an uri.
+	URIStrategyReasonOpenLowMarketRatio = "open equals low and bids outweigh asks"
+	// URIStreamSubjectAccountBalance is an uri.
+	URIStreamSubjectAccountBalance = "account.balance"
+	// URIStreamSubjectCandlesAdd is an uri.
+	URIStreamSubjectCandlesAdd = "trade.candles.add"
+	// URIStreamSubjectCandlesUpdate is an uri.
+	URIStreamSubjectCandlesUpdate = "trade.candles.update"
+	// URIStreamSubjectLevel2 is an uri.
+	URIStreamSubjectLevel2 = "trade.l2update"
+	// URIStreamSubjectOrderChange is an uri.
+	URIStreamSubjectOrderChange = "orderChange"
+	// URIStreamSubjectSnapshot is an uri.
+	URIStreamSubjectSnapshot = "trade.snapshot"
+	// URIStreamSubjectTicker is an uri.
+	URIStreamSubjectTicker = "trade.ticker"
+	// URIStreamTopicAccountBalance is an uri.
+	URIStreamTopicAccountBalance = "/account/balance"
+	// URIStreamTopicCandles is an uri.
+	URIStreamTopicCandles = "/market/candles:"
+	// URIStreamTopicLevel2 is an uri.
+	URIStreamTopicLevel2 = "/market/level2:"
+	// URIStreamTopicSnapshot is an uri.
+	URIStreamTopicSnapshot = "/market/snapshot:"
+	// URIStreamTopicTicker is an uri.
+	URIStreamTopicTicker = "/market/ticker:"
+	// URIStreamTopicTradeOrders is an uri.
+	URIStreamTopicTradeOrders = "/spotMarket/tradeOrders"
+	// URITableKline is an uri.
+	URITableKline = "kline"
+	// URITableKucoinAlgoOrder is an uri.
+	URITableKucoinAlgoOrder = "kucoin_algo_order"
+	// URITableKucoinBalance is an uri.
+	URITableKucoinBalance = "kucoin_balance"
+	// URITableKucoinBorrow is an uri.
+	URITableKucoinBorrow = "kucoin_borrow"
+	// URITableKucoinBracket is an uri.
+	URITableKucoinBracket = "kucoin_bracket"
+	// URITableKucoinEquity is an uri.
+	URITableKucoinEquity = "kucoin_equity"
+	// URITableKucoinFill is an uri.
+	URITableKucoinFill = "kucoin_fill"
+	// URITableKucoinKillSwitch is an uri.
+	URITableKucoinKillSwitch = "kucoin_kill_switch"
+	// URITableKucoinMarginBalance is an uri.
+	URITableKucoinMarginBalance = "kucoin_margin_balance"
+	// URITableKucoinMarginRiskLimit is an uri.
+	URITableKucoinMarginRiskLimit = "kucoin_margin_risk_limit"
 	// URITableKucoinOrder is an uri.
 	URITableKucoinOrder = "kucoin_order"
+	// URITableKucoinStopOrder is an uri.
+	URITableKucoinStopOrder = "kucoin_stop_order"
+	// URITableSymbol is an uri.
+	URITableSymbol = "symbol"
 	// URITableTicker is an uri.
 	URITableTicker = "ticker"
+	// URITraceEventExchangeCircuitOpen is an uri.
+	URITraceEventExchangeCircuitOpen = "exchange.circuit_open"
+	// URITraceEventExchangeRetry is an uri.
+	URITraceEventExchangeRetry = "exchange.retry"
 	// URIURLPath is an uri.
 	URIURLPath = "%s%s"
 )
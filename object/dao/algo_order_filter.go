@@ -0,0 +1,111 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"gorm.io/gorm"
+)
+
+type (
+
+	// AlgoOrderFilterer is an interface.
+	AlgoOrderFilterer interface {
+		Filterer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetClientOID is a function.
+		GetClientOID() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetIsActive is a function.
+		GetIsActive() bool
+	}
+
+	algoOrderFilter struct {
+		account   string
+		clientOID string
+		symbol    string
+		isActive  bool
+	}
+)
+
+var (
+	_ AlgoOrderFilterer = (*algoOrderFilter)(nil)
+	_ json.Marshaler    = (*algoOrderFilter)(nil)
+	_ object.GetMap     = (*algoOrderFilter)(nil)
+)
+
+// NewAlgoOrderFilter is a function.
+func NewAlgoOrderFilter(
+	account string,
+	clientOID string,
+	symbol string,
+	isActive bool,
+) *algoOrderFilter {
+	return &algoOrderFilter{
+		account:   account,
+		clientOID: clientOID,
+		symbol:    symbol,
+		isActive:  isActive,
+	}
+}
+
+// GetAccount is a function.
+func (filter *algoOrderFilter) GetAccount() string {
+	return filter.account
+}
+
+// GetClientOID is a function.
+func (filter *algoOrderFilter) GetClientOID() string {
+	return filter.clientOID
+}
+
+// GetSymbol is a function.
+func (filter *algoOrderFilter) GetSymbol() string {
+	return filter.symbol
+}
+
+// GetIsActive is a function.
+func (filter *algoOrderFilter) GetIsActive() bool {
+	return filter.isActive
+}
+
+// GetMap is a function.
+func (filter *algoOrderFilter) GetMap() map[string]any {
+	return map[string]any{
+		"account":    filter.GetAccount(),
+		"client_oid": filter.GetClientOID(),
+		"symbol":     filter.GetSymbol(),
+		"is_active":  filter.GetIsActive(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (filter *algoOrderFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(filter.GetMap())
+}
+
+// Filter is a function.
+func (filter *algoOrderFilter) Filter(
+	gormDB *gorm.DB,
+) *gorm.DB {
+	if filter.GetAccount() != object.URIEmpty {
+		gormDB.Where("account = ?", filter.GetAccount())
+	}
+
+	if filter.GetClientOID() != object.URIEmpty {
+		gormDB.Where("client_oid = ?", filter.GetClientOID())
+	}
+
+	if filter.GetSymbol() != object.URIEmpty {
+		gormDB.Where("symbol = ?", filter.GetSymbol())
+	}
+
+	if filter.GetIsActive() {
+		gormDB.Where("is_active = ?", filter.GetIsActive())
+	}
+
+	return gormDB
+}
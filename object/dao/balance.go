@@ -0,0 +1,186 @@
+package dao
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/google/uuid"
+)
+
+type (
+	// Balancer is an interface.
+	Balancer interface {
+		DAOer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetAvailable is a function.
+		GetAvailable() string
+		// GetBalance is a function.
+		GetBalance() string
+		// GetCurrency is a function.
+		GetCurrency() string
+		// GetHolds is a function.
+		GetHolds() string
+		// GetKucoinID is a function.
+		GetKucoinID() string
+		// GetKucoinType is a function.
+		GetKucoinType() string
+		// GetPaper is a function.
+		GetPaper() bool
+	}
+
+	balance struct {
+		account    string
+		available  string
+		balance    string
+		currency   string
+		holds      string
+		kucoinID   string
+		kucoinType string
+		dao
+		paper bool
+	}
+)
+
+var (
+	_ Balancer       = (*balance)(nil)
+	_ json.Marshaler = (*balance)(nil)
+	_ object.GetMap  = (*balance)(nil)
+)
+
+// NewBalance is a function.
+func NewBalance(
+	createdAt time.Time,
+	updatedAt time.Time,
+	deletedAt sql.NullTime,
+	id uuid.UUID,
+	account string,
+	available string,
+	balanceValue string,
+	currency string,
+	holds string,
+	kucoinID string,
+	kucoinType string,
+	paper bool,
+) *balance {
+	return &balance{
+		dao: dao{
+			daoJoin: daoJoin{
+				createdAt: createdAt,
+				updatedAt: updatedAt,
+				deletedAt: deletedAt,
+			},
+			id: id,
+		},
+		account:    account,
+		available:  available,
+		balance:    balanceValue,
+		currency:   currency,
+		holds:      holds,
+		kucoinID:   kucoinID,
+		kucoinType: kucoinType,
+		paper:      paper,
+	}
+}
+
+// BalancerComparer is a function.
+func BalancerComparer(
+	first Balancer,
+	second Balancer,
+) bool {
+	return DAOerComparer(first, second) &&
+		first.GetAccount() == second.GetAccount() &&
+		first.GetAvailable() == second.GetAvailable() &&
+		first.GetBalance() == second.GetBalance() &&
+		first.GetCurrency() == second.GetCurrency() &&
+		first.GetHolds() == second.GetHolds() &&
+		first.GetKucoinID() == second.GetKucoinID() &&
+		first.GetKucoinType() == second.GetKucoinType() &&
+		first.GetPaper() == second.GetPaper()
+}
+
+// GetCreatedAt is a function.
+func (balance *balance) GetCreatedAt() time.Time {
+	return balance.createdAt
+}
+
+// GetUpdatedAt is a function.
+func (balance *balance) GetUpdatedAt() time.Time {
+	return balance.updatedAt
+}
+
+// GetDeletedAt is a function.
+func (balance *balance) GetDeletedAt() sql.NullTime {
+	return balance.deletedAt
+}
+
+// GetID is a function.
+func (balance *balance) GetID() uuid.UUID {
+	return balance.id
+}
+
+// GetAccount is a function.
+func (balance *balance) GetAccount() string {
+	return balance.account
+}
+
+// GetAvailable is a function.
+func (balance *balance) GetAvailable() string {
+	return balance.available
+}
+
+// GetBalance is a function.
+func (balance *balance) GetBalance() string {
+	return balance.balance
+}
+
+// GetCurrency is a function.
+func (balance *balance) GetCurrency() string {
+	return balance.currency
+}
+
+// GetHolds is a function.
+func (balance *balance) GetHolds() string {
+	return balance.holds
+}
+
+// GetKucoinID is a function.
+func (balance *balance) GetKucoinID() string {
+	return balance.kucoinID
+}
+
+// GetKucoinType is a function.
+func (balance *balance) GetKucoinType() string {
+	return balance.kucoinType
+}
+
+// GetPaper is a function.
+func (balance *balance) GetPaper() bool {
+	return balance.paper
+}
+
+// GetMap is a function.
+func (balance *balance) GetMap() map[string]any {
+	return map[string]any{
+		"created_at":  balance.GetCreatedAt(),
+		"updated_at":  balance.GetUpdatedAt(),
+		"deleted_at":  balance.GetDeletedAt(),
+		"id":          balance.GetID(),
+		"account":     balance.GetAccount(),
+		"available":   balance.GetAvailable(),
+		"balance":     balance.GetBalance(),
+		"currency":    balance.GetCurrency(),
+		"holds":       balance.GetHolds(),
+		"kucoin_id":   balance.GetKucoinID(),
+		"kucoin_type": balance.GetKucoinType(),
+		"paper":       balance.GetPaper(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (balance *balance) MarshalJSON() ([]byte, error) {
+	return json.Marshal(balance.GetMap())
+}
@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"gorm.io/gorm"
+)
+
+type (
+	// BalanceFilterer is an interface.
+	BalanceFilterer interface {
+		Filterer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetCurrency is a function.
+		GetCurrency() string
+		// GetKucoinID is a function.
+		GetKucoinID() string
+		// GetKucoinType is a function.
+		GetKucoinType() string
+	}
+
+	balanceFilter struct {
+		account    string
+		currency   string
+		kucoinID   string
+		kucoinType string
+	}
+)
+
+var (
+	_ BalanceFilterer = (*balanceFilter)(nil)
+	_ json.Marshaler  = (*balanceFilter)(nil)
+	_ object.GetMap   = (*balanceFilter)(nil)
+)
+
+// NewBalanceFilter is a function.
+func NewBalanceFilter(
+	account string,
+	currency string,
+	kucoinID string,
+	kucoinType string,
+) *balanceFilter {
+	return &balanceFilter{
+		account:    account,
+		currency:   currency,
+		kucoinID:   kucoinID,
+		kucoinType: kucoinType,
+	}
+}
+
+// GetAccount is a function.
+func (filter *balanceFilter) GetAccount() string {
+	return filter.account
+}
+
+// GetCurrency is a function.
+func (filter *balanceFilter) GetCurrency() string {
+	return filter.currency
+}
+
+// GetKucoinID is a function.
+func (filter *balanceFilter) GetKucoinID() string {
+	return filter.kucoinID
+}
+
+// GetKucoinType is a function.
+func (filter *balanceFilter) GetKucoinType() string {
+	return filter.kucoinType
+}
+
+// GetMap is a function.
+func (filter *balanceFilter) GetMap() map[string]any {
+	return map[string]any{
+		"account":     filter.GetAccount(),
+		"currency":    filter.GetCurrency(),
+		"kucoin_id":   filter.GetKucoinID(),
+		"kucoin_type": filter.GetKucoinType(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (filter *balanceFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(filter.GetMap())
+}
+
+// Filter is a function.
+func (filter *balanceFilter) Filter(
+	gormDB *gorm.DB,
+) *gorm.DB {
+	if filter.GetAccount() != object.URIEmpty {
+		gormDB.Where("account = ?", filter.GetAccount())
+	}
+
+	if filter.GetCurrency() != object.URIEmpty {
+		gormDB.Where("currency = ?", filter.GetCurrency())
+	}
+
+	if filter.GetKucoinID() != object.URIEmpty {
+		gormDB.Where("kucoin_id = ?", filter.GetKucoinID())
+	}
+
+	if filter.GetKucoinType() != object.URIEmpty {
+		gormDB.Where("kucoin_type = ?", filter.GetKucoinType())
+	}
+
+	return gormDB
+}
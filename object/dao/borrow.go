@@ -0,0 +1,258 @@
+package dao
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/google/uuid"
+)
+
+type (
+	// Borrower is an interface.
+	Borrower interface {
+		DAOer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetAccruedInterest is a function.
+		GetAccruedInterest() string
+		// GetCurrency is a function.
+		GetCurrency() string
+		// GetDailyIntRate is a function.
+		GetDailyIntRate() string
+		// GetInterest is a function.
+		GetInterest() string
+		// GetLiability is a function.
+		GetLiability() string
+		// GetPrincipal is a function.
+		GetPrincipal() string
+		// GetRepaidSize is a function.
+		GetRepaidSize() string
+		// GetStatus is a function.
+		GetStatus() string
+		// GetTradeID is a function.
+		GetTradeID() string
+		// GetKucoinCreatedAt is a function.
+		GetKucoinCreatedAt() int64
+		// GetMaturityTime is a function.
+		GetMaturityTime() int64
+		// GetRepaidAt is a function.
+		GetRepaidAt() int64
+		// GetTerm is a function.
+		GetTerm() int64
+	}
+
+	borrow struct {
+		account         string
+		accruedInterest string
+		currency        string
+		dailyIntRate    string
+		interest        string
+		liability       string
+		principal       string
+		repaidSize      string
+		status          string
+		tradeID         string
+		dao
+		kucoinCreatedAt int64
+		maturityTime    int64
+		repaidAt        int64
+		term            int64
+	}
+)
+
+var (
+	_ Borrower       = (*borrow)(nil)
+	_ json.Marshaler = (*borrow)(nil)
+	_ object.GetMap  = (*borrow)(nil)
+)
+
+// NewBorrow is a function.
+func NewBorrow(
+	createdAt time.Time,
+	updatedAt time.Time,
+	deletedAt sql.NullTime,
+	id uuid.UUID,
+	account string,
+	accruedInterest string,
+	currency string,
+	dailyIntRate string,
+	interest string,
+	liability string,
+	principal string,
+	repaidSize string,
+	status string,
+	tradeID string,
+	kucoinCreatedAt int64,
+	maturityTime int64,
+	repaidAt int64,
+	term int64,
+) *borrow {
+	return &borrow{
+		dao: dao{
+			daoJoin: daoJoin{
+				createdAt: createdAt,
+				updatedAt: updatedAt,
+				deletedAt: deletedAt,
+			},
+			id: id,
+		},
+		account:         account,
+		accruedInterest: accruedInterest,
+		currency:        currency,
+		dailyIntRate:    dailyIntRate,
+		interest:        interest,
+		liability:       liability,
+		principal:       principal,
+		repaidSize:      repaidSize,
+		status:          status,
+		tradeID:         tradeID,
+		kucoinCreatedAt: kucoinCreatedAt,
+		maturityTime:    maturityTime,
+		repaidAt:        repaidAt,
+		term:            term,
+	}
+}
+
+// BorrowerComparer is a function.
+func BorrowerComparer(
+	first Borrower,
+	second Borrower,
+) bool {
+	return DAOerComparer(first, second) &&
+		first.GetAccount() == second.GetAccount() &&
+		first.GetAccruedInterest() == second.GetAccruedInterest() &&
+		first.GetCurrency() == second.GetCurrency() &&
+		first.GetDailyIntRate() == second.GetDailyIntRate() &&
+		first.GetInterest() == second.GetInterest() &&
+		first.GetLiability() == second.GetLiability() &&
+		first.GetPrincipal() == second.GetPrincipal() &&
+		first.GetRepaidSize() == second.GetRepaidSize() &&
+		first.GetStatus() == second.GetStatus() &&
+		first.GetTradeID() == second.GetTradeID() &&
+		first.GetKucoinCreatedAt() == second.GetKucoinCreatedAt() &&
+		first.GetMaturityTime() == second.GetMaturityTime() &&
+		first.GetRepaidAt() == second.GetRepaidAt() &&
+		first.GetTerm() == second.GetTerm()
+}
+
+// GetCreatedAt is a function.
+func (borrow *borrow) GetCreatedAt() time.Time {
+	return borrow.createdAt
+}
+
+// GetUpdatedAt is a function.
+func (borrow *borrow) GetUpdatedAt() time.Time {
+	return borrow.updatedAt
+}
+
+// GetDeletedAt is a function.
+func (borrow *borrow) GetDeletedAt() sql.NullTime {
+	return borrow.deletedAt
+}
+
+// GetID is a function.
+func (borrow *borrow) GetID() uuid.UUID {
+	return borrow.id
+}
+
+// GetAccount is a function.
+func (borrow *borrow) GetAccount() string {
+	return borrow.account
+}
+
+// GetAccruedInterest is a function.
+func (borrow *borrow) GetAccruedInterest() string {
+	return borrow.accruedInterest
+}
+
+// GetCurrency is a function.
+func (borrow *borrow) GetCurrency() string {
+	return borrow.currency
+}
+
+// GetDailyIntRate is a function.
+func (borrow *borrow) GetDailyIntRate() string {
+	return borrow.dailyIntRate
+}
+
+// GetInterest is a function.
+func (borrow *borrow) GetInterest() string {
+	return borrow.interest
+}
+
+// GetLiability is a function.
+func (borrow *borrow) GetLiability() string {
+	return borrow.liability
+}
+
+// GetPrincipal is a function.
+func (borrow *borrow) GetPrincipal() string {
+	return borrow.principal
+}
+
+// GetRepaidSize is a function.
+func (borrow *borrow) GetRepaidSize() string {
+	return borrow.repaidSize
+}
+
+// GetStatus is a function.
+func (borrow *borrow) GetStatus() string {
+	return borrow.status
+}
+
+// GetTradeID is a function.
+func (borrow *borrow) GetTradeID() string {
+	return borrow.tradeID
+}
+
+// GetKucoinCreatedAt is a function.
+func (borrow *borrow) GetKucoinCreatedAt() int64 {
+	return borrow.kucoinCreatedAt
+}
+
+// GetMaturityTime is a function.
+func (borrow *borrow) GetMaturityTime() int64 {
+	return borrow.maturityTime
+}
+
+// GetRepaidAt is a function.
+func (borrow *borrow) GetRepaidAt() int64 {
+	return borrow.repaidAt
+}
+
+// GetTerm is a function.
+func (borrow *borrow) GetTerm() int64 {
+	return borrow.term
+}
+
+// GetMap is a function.
+func (borrow *borrow) GetMap() map[string]any {
+	return map[string]any{
+		"created_at":        borrow.GetCreatedAt(),
+		"updated_at":        borrow.GetUpdatedAt(),
+		"deleted_at":        borrow.GetDeletedAt(),
+		"id":                borrow.GetID(),
+		"account":           borrow.GetAccount(),
+		"accrued_interest":  borrow.GetAccruedInterest(),
+		"currency":          borrow.GetCurrency(),
+		"daily_int_rate":    borrow.GetDailyIntRate(),
+		"interest":          borrow.GetInterest(),
+		"liability":         borrow.GetLiability(),
+		"principal":         borrow.GetPrincipal(),
+		"repaid_size":       borrow.GetRepaidSize(),
+		"status":            borrow.GetStatus(),
+		"trade_id":          borrow.GetTradeID(),
+		"kucoin_created_at": borrow.GetKucoinCreatedAt(),
+		"maturity_time":     borrow.GetMaturityTime(),
+		"repaid_at":         borrow.GetRepaidAt(),
+		"term":              borrow.GetTerm(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (borrow *borrow) MarshalJSON() ([]byte, error) {
+	return json.Marshal(borrow.GetMap())
+}
@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"gorm.io/gorm"
+)
+
+type (
+	// BorrowFilterer is an interface.
+	BorrowFilterer interface {
+		Filterer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetCurrency is a function.
+		GetCurrency() string
+		// GetStatus is a function.
+		GetStatus() string
+		// GetTradeID is a function.
+		GetTradeID() string
+	}
+
+	borrowFilter struct {
+		account  string
+		currency string
+		status   string
+		tradeID  string
+	}
+)
+
+var (
+	_ BorrowFilterer = (*borrowFilter)(nil)
+	_ json.Marshaler = (*borrowFilter)(nil)
+	_ object.GetMap  = (*borrowFilter)(nil)
+)
+
+// NewBorrowFilter is a function.
+func NewBorrowFilter(
+	account string,
+	currency string,
+	status string,
+	tradeID string,
+) *borrowFilter {
+	return &borrowFilter{
+		account:  account,
+		currency: currency,
+		status:   status,
+		tradeID:  tradeID,
+	}
+}
+
+// GetAccount is a function.
+func (filter *borrowFilter) GetAccount() string {
+	return filter.account
+}
+
+// GetCurrency is a function.
+func (filter *borrowFilter) GetCurrency() string {
+	return filter.currency
+}
+
+// GetStatus is a function.
+func (filter *borrowFilter) GetStatus() string {
+	return filter.status
+}
+
+// GetTradeID is a function.
+func (filter *borrowFilter) GetTradeID() string {
+	return filter.tradeID
+}
+
+// GetMap is a function.
+func (filter *borrowFilter) GetMap() map[string]any {
+	return map[string]any{
+		"account":  filter.GetAccount(),
+		"currency": filter.GetCurrency(),
+		"status":   filter.GetStatus(),
+		"trade_id": filter.GetTradeID(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (filter *borrowFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(filter.GetMap())
+}
+
+// Filter is a function.
+func (filter *borrowFilter) Filter(
+	gormDB *gorm.DB,
+) *gorm.DB {
+	if filter.GetAccount() != object.URIEmpty {
+		gormDB.Where("account = ?", filter.GetAccount())
+	}
+
+	if filter.GetCurrency() != object.URIEmpty {
+		gormDB.Where("currency = ?", filter.GetCurrency())
+	}
+
+	if filter.GetStatus() != object.URIEmpty {
+		gormDB.Where("status = ?", filter.GetStatus())
+	}
+
+	if filter.GetTradeID() != object.URIEmpty {
+		gormDB.Where("trade_id = ?", filter.GetTradeID())
+	}
+
+	return gormDB
+}
@@ -0,0 +1,111 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"gorm.io/gorm"
+)
+
+type (
+
+	// BracketFilterer is an interface.
+	BracketFilterer interface {
+		Filterer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetEntryClientOID is a function.
+		GetEntryClientOID() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetIsActive is a function.
+		GetIsActive() bool
+	}
+
+	bracketFilter struct {
+		account        string
+		entryClientOID string
+		symbol         string
+		isActive       bool
+	}
+)
+
+var (
+	_ BracketFilterer = (*bracketFilter)(nil)
+	_ json.Marshaler  = (*bracketFilter)(nil)
+	_ object.GetMap   = (*bracketFilter)(nil)
+)
+
+// NewBracketFilter is a function.
+func NewBracketFilter(
+	account string,
+	entryClientOID string,
+	symbol string,
+	isActive bool,
+) *bracketFilter {
+	return &bracketFilter{
+		account:        account,
+		entryClientOID: entryClientOID,
+		symbol:         symbol,
+		isActive:       isActive,
+	}
+}
+
+// GetAccount is a function.
+func (filter *bracketFilter) GetAccount() string {
+	return filter.account
+}
+
+// GetEntryClientOID is a function.
+func (filter *bracketFilter) GetEntryClientOID() string {
+	return filter.entryClientOID
+}
+
+// GetSymbol is a function.
+func (filter *bracketFilter) GetSymbol() string {
+	return filter.symbol
+}
+
+// GetIsActive is a function.
+func (filter *bracketFilter) GetIsActive() bool {
+	return filter.isActive
+}
+
+// GetMap is a function.
+func (filter *bracketFilter) GetMap() map[string]any {
+	return map[string]any{
+		"account":          filter.GetAccount(),
+		"entry_client_oid": filter.GetEntryClientOID(),
+		"symbol":           filter.GetSymbol(),
+		"is_active":        filter.GetIsActive(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (filter *bracketFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(filter.GetMap())
+}
+
+// Filter is a function.
+func (filter *bracketFilter) Filter(
+	gormDB *gorm.DB,
+) *gorm.DB {
+	if filter.GetAccount() != object.URIEmpty {
+		gormDB.Where("account = ?", filter.GetAccount())
+	}
+
+	if filter.GetEntryClientOID() != object.URIEmpty {
+		gormDB.Where("entry_client_oid = ?", filter.GetEntryClientOID())
+	}
+
+	if filter.GetSymbol() != object.URIEmpty {
+		gormDB.Where("symbol = ?", filter.GetSymbol())
+	}
+
+	if filter.GetIsActive() {
+		gormDB.Where("is_active = ?", filter.GetIsActive())
+	}
+
+	return gormDB
+}
@@ -0,0 +1,127 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"gorm.io/gorm"
+)
+
+type (
+	// EquityFilterer is an interface.
+	EquityFilterer interface {
+		Filterer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetCurrency is a function.
+		GetCurrency() string
+		// GetSnapshotAtFrom is a function.
+		GetSnapshotAtFrom() int64
+		// GetSnapshotAtTo is a function.
+		GetSnapshotAtTo() int64
+		// GetSortSnapshotAtDesc is a function.
+		GetSortSnapshotAtDesc() bool
+	}
+
+	equityFilter struct {
+		account            string
+		currency           string
+		snapshotAtFrom     int64
+		snapshotAtTo       int64
+		sortSnapshotAtDesc bool
+	}
+)
+
+var (
+	_ EquityFilterer = (*equityFilter)(nil)
+	_ json.Marshaler = (*equityFilter)(nil)
+	_ object.GetMap  = (*equityFilter)(nil)
+)
+
+// NewEquityFilter is a function.
+func NewEquityFilter(
+	account string,
+	currency string,
+	snapshotAtFrom int64,
+	snapshotAtTo int64,
+	sortSnapshotAtDesc bool,
+) *equityFilter {
+	return &equityFilter{
+		account:            account,
+		currency:           currency,
+		snapshotAtFrom:     snapshotAtFrom,
+		snapshotAtTo:       snapshotAtTo,
+		sortSnapshotAtDesc: sortSnapshotAtDesc,
+	}
+}
+
+// GetAccount is a function.
+func (filter *equityFilter) GetAccount() string {
+	return filter.account
+}
+
+// GetCurrency is a function.
+func (filter *equityFilter) GetCurrency() string {
+	return filter.currency
+}
+
+// GetSnapshotAtFrom is a function.
+func (filter *equityFilter) GetSnapshotAtFrom() int64 {
+	return filter.snapshotAtFrom
+}
+
+// GetSnapshotAtTo is a function.
+func (filter *equityFilter) GetSnapshotAtTo() int64 {
+	return filter.snapshotAtTo
+}
+
+// GetSortSnapshotAtDesc is a function.
+func (filter *equityFilter) GetSortSnapshotAtDesc() bool {
+	return filter.sortSnapshotAtDesc
+}
+
+// GetMap is a function.
+func (filter *equityFilter) GetMap() map[string]any {
+	return map[string]any{
+		"account":               filter.GetAccount(),
+		"currency":              filter.GetCurrency(),
+		"snapshot_at_from":      filter.GetSnapshotAtFrom(),
+		"snapshot_at_to":        filter.GetSnapshotAtTo(),
+		"sort_snapshot_at_desc": filter.GetSortSnapshotAtDesc(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (filter *equityFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(filter.GetMap())
+}
+
+// Filter is a function.
+func (filter *equityFilter) Filter(
+	gormDB *gorm.DB,
+) *gorm.DB {
+	if filter.GetAccount() != object.URIEmpty {
+		gormDB.Where("account = ?", filter.GetAccount())
+	}
+
+	if filter.GetCurrency() != object.URIEmpty {
+		gormDB.Where("currency = ?", filter.GetCurrency())
+	}
+
+	if filter.GetSnapshotAtFrom() != 0 {
+		gormDB.Where("snapshot_at >= ?", filter.GetSnapshotAtFrom())
+	}
+
+	if filter.GetSnapshotAtTo() != 0 {
+		gormDB.Where("snapshot_at < ?", filter.GetSnapshotAtTo())
+	}
+
+	if filter.GetSortSnapshotAtDesc() {
+		gormDB.Order("snapshot_at DESC")
+	} else {
+		gormDB.Order("snapshot_at ASC")
+	}
+
+	return gormDB
+}
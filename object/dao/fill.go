@@ -0,0 +1,318 @@
+package dao
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/google/uuid"
+)
+
+type (
+	// Filler is an interface.
+	Filler interface {
+		DAOer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetCounterOrderID is a function.
+		GetCounterOrderID() string
+		// GetFee is a function.
+		GetFee() string
+		// GetFeeCurrency is a function.
+		GetFeeCurrency() string
+		// GetFeeRate is a function.
+		GetFeeRate() string
+		// GetFunds is a function.
+		GetFunds() string
+		// GetKucoinOrderID is a function.
+		GetKucoinOrderID() string
+		// GetKucoinType is a function.
+		GetKucoinType() string
+		// GetLiquidity is a function.
+		GetLiquidity() string
+		// GetPrice is a function.
+		GetPrice() string
+		// GetSide is a function.
+		GetSide() string
+		// GetSize is a function.
+		GetSize() string
+		// GetStop is a function.
+		GetStop() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetTradeID is a function.
+		GetTradeID() string
+		// GetTradeType is a function.
+		GetTradeType() string
+		// GetKucoinCreatedAt is a function.
+		GetKucoinCreatedAt() int64
+		// GetForceTaker is a function.
+		GetForceTaker() bool
+		// GetPaper is a function.
+		GetPaper() bool
+	}
+
+	fill struct {
+		account        string
+		counterOrderID string
+		fee            string
+		feeCurrency    string
+		feeRate        string
+		funds          string
+		kucoinOrderID  string
+		kucoinType     string
+		liquidity      string
+		price          string
+		side           string
+		size           string
+		stop           string
+		symbol         string
+		tradeID        string
+		tradeType      string
+		dao
+		kucoinCreatedAt int64
+		forceTaker      bool
+		paper           bool
+	}
+)
+
+var (
+	_ Filler         = (*fill)(nil)
+	_ json.Marshaler = (*fill)(nil)
+	_ object.GetMap  = (*fill)(nil)
+)
+
+// NewFill is a function.
+func NewFill(
+	createdAt time.Time,
+	updatedAt time.Time,
+	deletedAt sql.NullTime,
+	id uuid.UUID,
+	account string,
+	counterOrderID string,
+	fee string,
+	feeCurrency string,
+	feeRate string,
+	funds string,
+	kucoinOrderID string,
+	kucoinType string,
+	liquidity string,
+	price string,
+	side string,
+	size string,
+	stop string,
+	symbol string,
+	tradeID string,
+	tradeType string,
+	kucoinCreatedAt int64,
+	forceTaker bool,
+	paper bool,
+) *fill {
+	return &fill{
+		dao: dao{
+			daoJoin: daoJoin{
+				createdAt: createdAt,
+				updatedAt: updatedAt,
+				deletedAt: deletedAt,
+			},
+			id: id,
+		},
+		account:         account,
+		counterOrderID:  counterOrderID,
+		fee:             fee,
+		feeCurrency:     feeCurrency,
+		feeRate:         feeRate,
+		funds:           funds,
+		kucoinOrderID:   kucoinOrderID,
+		kucoinType:      kucoinType,
+		liquidity:       liquidity,
+		price:           price,
+		side:            side,
+		size:            size,
+		stop:            stop,
+		symbol:          symbol,
+		tradeID:         tradeID,
+		tradeType:       tradeType,
+		kucoinCreatedAt: kucoinCreatedAt,
+		forceTaker:      forceTaker,
+		paper:           paper,
+	}
+}
+
+// FillerComparer is a function.
+func FillerComparer(
+	first Filler,
+	second Filler,
+) bool {
+	return DAOerComparer(first, second) &&
+		first.GetAccount() == second.GetAccount() &&
+		first.GetCounterOrderID() == second.GetCounterOrderID() &&
+		first.GetFee() == second.GetFee() &&
+		first.GetFeeCurrency() == second.GetFeeCurrency() &&
+		first.GetFeeRate() == second.GetFeeRate() &&
+		first.GetFunds() == second.GetFunds() &&
+		first.GetKucoinOrderID() == second.GetKucoinOrderID() &&
+		first.GetKucoinType() == second.GetKucoinType() &&
+		first.GetLiquidity() == second.GetLiquidity() &&
+		first.GetPrice() == second.GetPrice() &&
+		first.GetSide() == second.GetSide() &&
+		first.GetSize() == second.GetSize() &&
+		first.GetStop() == second.GetStop() &&
+		first.GetSymbol() == second.GetSymbol() &&
+		first.GetTradeID() == second.GetTradeID() &&
+		first.GetTradeType() == second.GetTradeType() &&
+		first.GetKucoinCreatedAt() == second.GetKucoinCreatedAt() &&
+		first.GetForceTaker() == second.GetForceTaker() &&
+		first.GetPaper() == second.GetPaper()
+}
+
+// GetCreatedAt is a function.
+func (fill *fill) GetCreatedAt() time.Time {
+	return fill.createdAt
+}
+
+// GetUpdatedAt is a function.
+func (fill *fill) GetUpdatedAt() time.Time {
+	return fill.updatedAt
+}
+
+// GetDeletedAt is a function.
+func (fill *fill) GetDeletedAt() sql.NullTime {
+	return fill.deletedAt
+}
+
+// GetID is a function.
+func (fill *fill) GetID() uuid.UUID {
+	return fill.id
+}
+
+// GetAccount is a function.
+func (fill *fill) GetAccount() string {
+	return fill.account
+}
+
+// GetCounterOrderID is a function.
+func (fill *fill) GetCounterOrderID() string {
+	return fill.counterOrderID
+}
+
+// GetFee is a function.
+func (fill *fill) GetFee() string {
+	return fill.fee
+}
+
+// GetFeeCurrency is a function.
+func (fill *fill) GetFeeCurrency() string {
+	return fill.feeCurrency
+}
+
+// GetFeeRate is a function.
+func (fill *fill) GetFeeRate() string {
+	return fill.feeRate
+}
+
+// GetFunds is a function.
+func (fill *fill) GetFunds() string {
+	return fill.funds
+}
+
+// GetKucoinOrderID is a function.
+func (fill *fill) GetKucoinOrderID() string {
+	return fill.kucoinOrderID
+}
+
+// GetKucoinType is a function.
+func (fill *fill) GetKucoinType() string {
+	return fill.kucoinType
+}
+
+// GetLiquidity is a function.
+func (fill *fill) GetLiquidity() string {
+	return fill.liquidity
+}
+
+// GetPrice is a function.
+func (fill *fill) GetPrice() string {
+	return fill.price
+}
+
+// GetSide is a function.
+func (fill *fill) GetSide() string {
+	return fill.side
+}
+
+// GetSize is a function.
+func (fill *fill) GetSize() string {
+	return fill.size
+}
+
+// GetStop is a function.
+func (fill *fill) GetStop() string {
+	return fill.stop
+}
+
+// GetSymbol is a function.
+func (fill *fill) GetSymbol() string {
+	return fill.symbol
+}
+
+// GetTradeID is a function.
+func (fill *fill) GetTradeID() string {
+	return fill.tradeID
+}
+
+// GetTradeType is a function.
+func (fill *fill) GetTradeType() string {
+	return fill.tradeType
+}
+
+// GetKucoinCreatedAt is a function.
+func (fill *fill) GetKucoinCreatedAt() int64 {
+	return fill.kucoinCreatedAt
+}
+
+// GetForceTaker is a function.
+func (fill *fill) GetForceTaker() bool {
+	return fill.forceTaker
+}
+
+// GetPaper is a function.
+func (fill *fill) GetPaper() bool {
+	return fill.paper
+}
+
+// GetMap is a function.
+func (fill *fill) GetMap() map[string]any {
+	return map[string]any{
+		"created_at":        fill.GetCreatedAt(),
+		"updated_at":        fill.GetUpdatedAt(),
+		"deleted_at":        fill.GetDeletedAt(),
+		"id":                fill.GetID(),
+		"account":           fill.GetAccount(),
+		"counter_order_id":  fill.GetCounterOrderID(),
+		"fee":               fill.GetFee(),
+		"fee_currency":      fill.GetFeeCurrency(),
+		"fee_rate":          fill.GetFeeRate(),
+		"funds":             fill.GetFunds(),
+		"kucoin_order_id":   fill.GetKucoinOrderID(),
+		"kucoin_type":       fill.GetKucoinType(),
+		"liquidity":         fill.GetLiquidity(),
+		"price":             fill.GetPrice(),
+		"side":              fill.GetSide(),
+		"size":              fill.GetSize(),
+		"stop":              fill.GetStop(),
+		"symbol":            fill.GetSymbol(),
+		"trade_id":          fill.GetTradeID(),
+		"trade_type":        fill.GetTradeType(),
+		"kucoin_created_at": fill.GetKucoinCreatedAt(),
+		"force_taker":       fill.GetForceTaker(),
+		"paper":             fill.GetPaper(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (fill *fill) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fill.GetMap())
+}
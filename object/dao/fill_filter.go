@@ -0,0 +1,111 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"gorm.io/gorm"
+)
+
+type (
+
+	// FillFilterer is an interface.
+	FillFilterer interface {
+		Filterer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetKucoinOrderID is a function.
+		GetKucoinOrderID() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetTradeID is a function.
+		GetTradeID() string
+	}
+
+	fillFilter struct {
+		account       string
+		kucoinOrderID string
+		symbol        string
+		tradeID       string
+	}
+)
+
+var (
+	_ FillFilterer   = (*fillFilter)(nil)
+	_ json.Marshaler = (*fillFilter)(nil)
+	_ object.GetMap  = (*fillFilter)(nil)
+)
+
+// NewFillFilter is a function.
+func NewFillFilter(
+	account string,
+	kucoinOrderID string,
+	symbol string,
+	tradeID string,
+) *fillFilter {
+	return &fillFilter{
+		account:       account,
+		kucoinOrderID: kucoinOrderID,
+		symbol:        symbol,
+		tradeID:       tradeID,
+	}
+}
+
+// GetAccount is a function.
+func (filter *fillFilter) GetAccount() string {
+	return filter.account
+}
+
+// GetKucoinOrderID is a function.
+func (filter *fillFilter) GetKucoinOrderID() string {
+	return filter.kucoinOrderID
+}
+
+// GetSymbol is a function.
+func (filter *fillFilter) GetSymbol() string {
+	return filter.symbol
+}
+
+// GetTradeID is a function.
+func (filter *fillFilter) GetTradeID() string {
+	return filter.tradeID
+}
+
+// GetMap is a function.
+func (filter *fillFilter) GetMap() map[string]any {
+	return map[string]any{
+		"account":         filter.GetAccount(),
+		"kucoin_order_id": filter.GetKucoinOrderID(),
+		"symbol":          filter.GetSymbol(),
+		"trade_id":        filter.GetTradeID(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (filter *fillFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(filter.GetMap())
+}
+
+// Filter is a function.
+func (filter *fillFilter) Filter(
+	gormDB *gorm.DB,
+) *gorm.DB {
+	if filter.GetAccount() != object.URIEmpty {
+		gormDB.Where("account = ?", filter.GetAccount())
+	}
+
+	if filter.GetKucoinOrderID() != object.URIEmpty {
+		gormDB.Where("kucoin_order_id = ?", filter.GetKucoinOrderID())
+	}
+
+	if filter.GetSymbol() != object.URIEmpty {
+		gormDB.Where("symbol = ?", filter.GetSymbol())
+	}
+
+	if filter.GetTradeID() != object.URIEmpty {
+		gormDB.Where("trade_id = ?", filter.GetTradeID())
+	}
+
+	return gormDB
+}
@@ -0,0 +1,126 @@
+package dao
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/google/uuid"
+)
+
+type (
+	// KillSwitcher is an interface.
+	KillSwitcher interface {
+		DAOer
+		// GetReason is a function.
+		GetReason() string
+		// GetEnabled is a function.
+		GetEnabled() bool
+		// GetPaper is a function.
+		GetPaper() bool
+	}
+
+	killSwitch struct {
+		reason string
+		dao
+		enabled bool
+		paper   bool
+	}
+)
+
+var (
+	_ KillSwitcher   = (*killSwitch)(nil)
+	_ json.Marshaler = (*killSwitch)(nil)
+	_ object.GetMap  = (*killSwitch)(nil)
+)
+
+// NewKillSwitch is a function.
+func NewKillSwitch(
+	createdAt time.Time,
+	updatedAt time.Time,
+	deletedAt sql.NullTime,
+	id uuid.UUID,
+	reason string,
+	enabled bool,
+	paper bool,
+) *killSwitch {
+	return &killSwitch{
+		dao: dao{
+			daoJoin: daoJoin{
+				createdAt: createdAt,
+				updatedAt: updatedAt,
+				deletedAt: deletedAt,
+			},
+			id: id,
+		},
+		reason:  reason,
+		enabled: enabled,
+		paper:   paper,
+	}
+}
+
+// KillSwitcherComparer is a function.
+func KillSwitcherComparer(
+	first KillSwitcher,
+	second KillSwitcher,
+) bool {
+	return DAOerComparer(first, second) &&
+		first.GetReason() == second.GetReason() &&
+		first.GetEnabled() == second.GetEnabled() &&
+		first.GetPaper() == second.GetPaper()
+}
+
+// GetCreatedAt is a function.
+func (killSwitch *killSwitch) GetCreatedAt() time.Time {
+	return killSwitch.createdAt
+}
+
+// GetUpdatedAt is a function.
+func (killSwitch *killSwitch) GetUpdatedAt() time.Time {
+	return killSwitch.updatedAt
+}
+
+// GetDeletedAt is a function.
+func (killSwitch *killSwitch) GetDeletedAt() sql.NullTime {
+	return killSwitch.deletedAt
+}
+
+// GetID is a function.
+func (killSwitch *killSwitch) GetID() uuid.UUID {
+	return killSwitch.id
+}
+
+// GetReason is a function.
+func (killSwitch *killSwitch) GetReason() string {
+	return killSwitch.reason
+}
+
+// GetEnabled is a function.
+func (killSwitch *killSwitch) GetEnabled() bool {
+	return killSwitch.enabled
+}
+
+// GetPaper is a function.
+func (killSwitch *killSwitch) GetPaper() bool {
+	return killSwitch.paper
+}
+
+// GetMap is a function.
+func (killSwitch *killSwitch) GetMap() map[string]any {
+	return map[string]any{
+		"created_at": killSwitch.GetCreatedAt(),
+		"updated_at": killSwitch.GetUpdatedAt(),
+		"deleted_at": killSwitch.GetDeletedAt(),
+		"id":         killSwitch.GetID(),
+		"reason":     killSwitch.GetReason(),
+		"enabled":    killSwitch.GetEnabled(),
+		"paper":      killSwitch.GetPaper(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (killSwitch *killSwitch) MarshalJSON() ([]byte, error) {
+	return json.Marshal(killSwitch.GetMap())
+}
@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"gorm.io/gorm"
+)
+
+type (
+	// KillSwitchFilterer is an interface.
+	KillSwitchFilterer interface {
+		Filterer
+		// GetPaper is a function.
+		GetPaper() bool
+	}
+
+	killSwitchFilter struct {
+		paper bool
+	}
+)
+
+var (
+	_ KillSwitchFilterer = (*killSwitchFilter)(nil)
+	_ json.Marshaler     = (*killSwitchFilter)(nil)
+	_ object.GetMap      = (*killSwitchFilter)(nil)
+)
+
+// NewKillSwitchFilter is a function.
+// Paper and live trading have a kill switch each.
+func NewKillSwitchFilter(
+	paper bool,
+) *killSwitchFilter {
+	return &killSwitchFilter{
+		paper: paper,
+	}
+}
+
+// GetPaper is a function.
+func (filter *killSwitchFilter) GetPaper() bool {
+	return filter.paper
+}
+
+// GetMap is a function.
+func (filter *killSwitchFilter) GetMap() map[string]any {
+	return map[string]any{
+		"paper": filter.GetPaper(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (filter *killSwitchFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(filter.GetMap())
+}
+
+// Filter is a function.
+func (filter *killSwitchFilter) Filter(
+	gormDB *gorm.DB,
+) *gorm.DB {
+	gormDB.Where("paper = ?", filter.GetPaper())
+
+	return gormDB
+}
@@ -0,0 +1,127 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"gorm.io/gorm"
+)
+
+type (
+	// KlineFilterer is an interface.
+	KlineFilterer interface {
+		Filterer
+		// GetKlineType is a function.
+		GetKlineType() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetStartAtFrom is a function.
+		GetStartAtFrom() int64
+		// GetStartAtTo is a function.
+		GetStartAtTo() int64
+		// GetSortStartAtDesc is a function.
+		GetSortStartAtDesc() bool
+	}
+
+	klineFilter struct {
+		klineType       string
+		symbol          string
+		startAtFrom     int64
+		startAtTo       int64
+		sortStartAtDesc bool
+	}
+)
+
+var (
+	_ KlineFilterer  = (*klineFilter)(nil)
+	_ json.Marshaler = (*klineFilter)(nil)
+	_ object.GetMap  = (*klineFilter)(nil)
+)
+
+// NewKlineFilter is a function.
+func NewKlineFilter(
+	klineType string,
+	symbol string,
+	startAtFrom int64,
+	startAtTo int64,
+	sortStartAtDesc bool,
+) *klineFilter {
+	return &klineFilter{
+		klineType:       klineType,
+		symbol:          symbol,
+		startAtFrom:     startAtFrom,
+		startAtTo:       startAtTo,
+		sortStartAtDesc: sortStartAtDesc,
+	}
+}
+
+// GetKlineType is a function.
+func (filter *klineFilter) GetKlineType() string {
+	return filter.klineType
+}
+
+// GetSymbol is a function.
+func (filter *klineFilter) GetSymbol() string {
+	return filter.symbol
+}
+
+// GetStartAtFrom is a function.
+func (filter *klineFilter) GetStartAtFrom() int64 {
+	return filter.startAtFrom
+}
+
+// GetStartAtTo is a function.
+func (filter *klineFilter) GetStartAtTo() int64 {
+	return filter.startAtTo
+}
+
+// GetSortStartAtDesc is a function.
+func (filter *klineFilter) GetSortStartAtDesc() bool {
+	return filter.sortStartAtDesc
+}
+
+// GetMap is a function.
+func (filter *klineFilter) GetMap() map[string]any {
+	return map[string]any{
+		"kline_type":         filter.GetKlineType(),
+		"symbol":             filter.GetSymbol(),
+		"start_at_from":      filter.GetStartAtFrom(),
+		"start_at_to":        filter.GetStartAtTo(),
+		"sort_start_at_desc": filter.GetSortStartAtDesc(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (filter *klineFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(filter.GetMap())
+}
+
+// Filter is a function.
+func (filter *klineFilter) Filter(
+	gormDB *gorm.DB,
+) *gorm.DB {
+	if filter.GetKlineType() != object.URIEmpty {
+		gormDB.Where("kline_type = ?", filter.GetKlineType())
+	}
+
+	if filter.GetSymbol() != object.URIEmpty {
+		gormDB.Where("symbol = ?", filter.GetSymbol())
+	}
+
+	if filter.GetStartAtFrom() != 0 {
+		gormDB.Where("start_at >= ?", filter.GetStartAtFrom())
+	}
+
+	if filter.GetStartAtTo() != 0 {
+		gormDB.Where("start_at < ?", filter.GetStartAtTo())
+	}
+
+	if filter.GetSortStartAtDesc() {
+		gormDB.Order("start_at DESC")
+	} else {
+		gormDB.Order("start_at ASC")
+	}
+
+	return gormDB
+}
@@ -0,0 +1,174 @@
+package dao
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/google/uuid"
+)
+
+type (
+	// MarginBalancer is an interface.
+	MarginBalancer interface {
+		DAOer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetAvailable is a function.
+		GetAvailable() string
+		// GetCurrency is a function.
+		GetCurrency() string
+		// GetHolds is a function.
+		GetHolds() string
+		// GetLiability is a function.
+		GetLiability() string
+		// GetMaxBorrowSize is a function.
+		GetMaxBorrowSize() string
+		// GetTotal is a function.
+		GetTotal() string
+	}
+
+	marginBalance struct {
+		account       string
+		available     string
+		currency      string
+		holds         string
+		liability     string
+		maxBorrowSize string
+		total         string
+		dao
+	}
+)
+
+var (
+	_ MarginBalancer = (*marginBalance)(nil)
+	_ json.Marshaler = (*marginBalance)(nil)
+	_ object.GetMap  = (*marginBalance)(nil)
+)
+
+// NewMarginBalance is a function.
+func NewMarginBalance(
+	createdAt time.Time,
+	updatedAt time.Time,
+	deletedAt sql.NullTime,
+	id uuid.UUID,
+	account string,
+	available string,
+	currency string,
+	holds string,
+	liability string,
+	maxBorrowSize string,
+	total string,
+) *marginBalance {
+	return &marginBalance{
+		dao: dao{
+			daoJoin: daoJoin{
+				createdAt: createdAt,
+				updatedAt: updatedAt,
+				deletedAt: deletedAt,
+			},
+			id: id,
+		},
+		account:       account,
+		available:     available,
+		currency:      currency,
+		holds:         holds,
+		liability:     liability,
+		maxBorrowSize: maxBorrowSize,
+		total:         total,
+	}
+}
+
+// MarginBalancerComparer is a function.
+func MarginBalancerComparer(
+	first MarginBalancer,
+	second MarginBalancer,
+) bool {
+	return DAOerComparer(first, second) &&
+		first.GetAccount() == second.GetAccount() &&
+		first.GetAvailable() == second.GetAvailable() &&
+		first.GetCurrency() == second.GetCurrency() &&
+		first.GetHolds() == second.GetHolds() &&
+		first.GetLiability() == second.GetLiability() &&
+		first.GetMaxBorrowSize() == second.GetMaxBorrowSize() &&
+		first.GetTotal() == second.GetTotal()
+}
+
+// GetCreatedAt is a function.
+func (marginBalance *marginBalance) GetCreatedAt() time.Time {
+	return marginBalance.createdAt
+}
+
+// GetUpdatedAt is a function.
+func (marginBalance *marginBalance) GetUpdatedAt() time.Time {
+	return marginBalance.updatedAt
+}
+
+// GetDeletedAt is a function.
+func (marginBalance *marginBalance) GetDeletedAt() sql.NullTime {
+	return marginBalance.deletedAt
+}
+
+// GetID is a function.
+func (marginBalance *marginBalance) GetID() uuid.UUID {
+	return marginBalance.id
+}
+
+// GetAccount is a function.
+func (marginBalance *marginBalance) GetAccount() string {
+	return marginBalance.account
+}
+
+// GetAvailable is a function.
+func (marginBalance *marginBalance) GetAvailable() string {
+	return marginBalance.available
+}
+
+// GetCurrency is a function.
+func (marginBalance *marginBalance) GetCurrency() string {
+	return marginBalance.currency
+}
+
+// GetHolds is a function.
+func (marginBalance *marginBalance) GetHolds() string {
+	return marginBalance.holds
+}
+
+// GetLiability is a function.
+func (marginBalance *marginBalance) GetLiability() string {
+	return marginBalance.liability
+}
+
+// GetMaxBorrowSize is a function.
+func (marginBalance *marginBalance) GetMaxBorrowSize() string {
+	return marginBalance.maxBorrowSize
+}
+
+// GetTotal is a function.
+func (marginBalance *marginBalance) GetTotal() string {
+	return marginBalance.total
+}
+
+// GetMap is a function.
+func (marginBalance *marginBalance) GetMap() map[string]any {
+	return map[string]any{
+		"created_at":      marginBalance.GetCreatedAt(),
+		"updated_at":      marginBalance.GetUpdatedAt(),
+		"deleted_at":      marginBalance.GetDeletedAt(),
+		"id":              marginBalance.GetID(),
+		"account":         marginBalance.GetAccount(),
+		"available":       marginBalance.GetAvailable(),
+		"currency":        marginBalance.GetCurrency(),
+		"holds":           marginBalance.GetHolds(),
+		"liability":       marginBalance.GetLiability(),
+		"max_borrow_size": marginBalance.GetMaxBorrowSize(),
+		"total":           marginBalance.GetTotal(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (marginBalance *marginBalance) MarshalJSON() ([]byte, error) {
+	return json.Marshal(marginBalance.GetMap())
+}
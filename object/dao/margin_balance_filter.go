@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"gorm.io/gorm"
+)
+
+type (
+	// MarginBalanceFilterer is an interface.
+	MarginBalanceFilterer interface {
+		Filterer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetCurrency is a function.
+		GetCurrency() string
+	}
+
+	marginBalanceFilter struct {
+		account  string
+		currency string
+	}
+)
+
+var (
+	_ MarginBalanceFilterer = (*marginBalanceFilter)(nil)
+	_ json.Marshaler        = (*marginBalanceFilter)(nil)
+	_ object.GetMap         = (*marginBalanceFilter)(nil)
+)
+
+// NewMarginBalanceFilter is a function.
+func NewMarginBalanceFilter(
+	account string,
+	currency string,
+) *marginBalanceFilter {
+	return &marginBalanceFilter{
+		account:  account,
+		currency: currency,
+	}
+}
+
+// GetAccount is a function.
+func (filter *marginBalanceFilter) GetAccount() string {
+	return filter.account
+}
+
+// GetCurrency is a function.
+func (filter *marginBalanceFilter) GetCurrency() string {
+	return filter.currency
+}
+
+// GetMap is a function.
+func (filter *marginBalanceFilter) GetMap() map[string]any {
+	return map[string]any{
+		"account":  filter.GetAccount(),
+		"currency": filter.GetCurrency(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (filter *marginBalanceFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(filter.GetMap())
+}
+
+// Filter is a function.
+func (filter *marginBalanceFilter) Filter(
+	gormDB *gorm.DB,
+) *gorm.DB {
+	if filter.GetAccount() != object.URIEmpty {
+		gormDB.Where("account = ?", filter.GetAccount())
+	}
+
+	if filter.GetCurrency() != object.URIEmpty {
+		gormDB.Where("currency = ?", filter.GetCurrency())
+	}
+
+	return gormDB
+}
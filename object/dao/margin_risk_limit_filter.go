@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"gorm.io/gorm"
+)
+
+type (
+	// MarginRiskLimitFilterer is an interface.
+	MarginRiskLimitFilterer interface {
+		Filterer
+		// GetCurrency is a function.
+		GetCurrency() string
+		// GetMarginModel is a function.
+		GetMarginModel() string
+	}
+
+	marginRiskLimitFilter struct {
+		currency    string
+		marginModel string
+	}
+)
+
+var (
+	_ MarginRiskLimitFilterer = (*marginRiskLimitFilter)(nil)
+	_ json.Marshaler          = (*marginRiskLimitFilter)(nil)
+	_ object.GetMap           = (*marginRiskLimitFilter)(nil)
+)
+
+// NewMarginRiskLimitFilter is a function.
+func NewMarginRiskLimitFilter(
+	currency string,
+	marginModel string,
+) *marginRiskLimitFilter {
+	return &marginRiskLimitFilter{
+		currency:    currency,
+		marginModel: marginModel,
+	}
+}
+
+// GetCurrency is a function.
+func (filter *marginRiskLimitFilter) GetCurrency() string {
+	return filter.currency
+}
+
+// GetMarginModel is a function.
+func (filter *marginRiskLimitFilter) GetMarginModel() string {
+	return filter.marginModel
+}
+
+// GetMap is a function.
+func (filter *marginRiskLimitFilter) GetMap() map[string]any {
+	return map[string]any{
+		"currency":     filter.GetCurrency(),
+		"margin_model": filter.GetMarginModel(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (filter *marginRiskLimitFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(filter.GetMap())
+}
+
+// Filter is a function.
+func (filter *marginRiskLimitFilter) Filter(
+	gormDB *gorm.DB,
+) *gorm.DB {
+	if filter.GetCurrency() != object.URIEmpty {
+		gormDB.Where("currency = ?", filter.GetCurrency())
+	}
+
+	if filter.GetMarginModel() != object.URIEmpty {
+		gormDB.Where("margin_model = ?", filter.GetMarginModel())
+	}
+
+	return gormDB
+}
@@ -13,6 +13,8 @@ type (
 	// Orderer is an interface.
 	Orderer interface {
 		DAOer
+		// GetAccount is a function.
+		GetAccount() string
 		// GetChannel is a function.
 		GetChannel() string
 		// GetClientOID is a function.
@@ -41,6 +43,8 @@ type (
 		GetSide() string
 		// GetSize is a function.
 		GetSize() string
+		// GetStatus is a function.
+		GetStatus() string
 		// GetStop is a function.
 		GetStop() string
 		// GetStopPrice is a function.
@@ -69,6 +73,8 @@ type (
 		GetIceBerg() bool
 		// GetIsActive is a function.
 		GetIsActive() bool
+		// GetPaper is a function.
+		GetPaper() bool
 		// GetPostOnly is a function.
 		GetPostOnly() bool
 		// GetStopTriggered is a function.
@@ -76,6 +82,7 @@ type (
 	}
 
 	order struct {
+		account     string
 		channel     string
 		clientOID   string
 		dealFunds   string
@@ -90,6 +97,7 @@ type (
 		remark      string
 		side        string
 		size        string
+		status      string
 		stop        string
 		stopPrice   string
 		stp         string
@@ -105,6 +113,7 @@ type (
 		hidden          bool
 		iceBerg         bool
 		isActive        bool
+		paper           bool
 		postOnly        bool
 		stopTriggered   bool
 	}
@@ -122,6 +131,7 @@ func NewOrder(
 	updatedAt time.Time,
 	deletedAt sql.NullTime,
 	id uuid.UUID,
+	account string,
 	channel string,
 	clientOID string,
 	dealFunds string,
@@ -136,6 +146,7 @@ func NewOrder(
 	remark string,
 	side string,
 	size string,
+	status string,
 	stop string,
 	stopPrice string,
 	stp string,
@@ -150,6 +161,7 @@ func NewOrder(
 	hidden bool,
 	iceBerg bool,
 	isActive bool,
+	paper bool,
 	postOnly bool,
 	stopTriggered bool,
 ) *order {
@@ -162,6 +174,7 @@ func NewOrder(
 			},
 			id: id,
 		},
+		account:         account,
 		channel:         channel,
 		clientOID:       clientOID,
 		dealFunds:       dealFunds,
@@ -176,6 +189,7 @@ func NewOrder(
 		remark:          remark,
 		side:            side,
 		size:            size,
+		status:          status,
 		stop:            stop,
 		stopPrice:       stopPrice,
 		stp:             stp,
@@ -190,6 +204,7 @@ func NewOrder(
 		hidden:          hidden,
 		iceBerg:         iceBerg,
 		isActive:        isActive,
+		paper:           paper,
 		postOnly:        postOnly,
 		stopTriggered:   stopTriggered,
 	}
@@ -201,6 +216,7 @@ func OrdererComparer(
 	second Orderer,
 ) bool {
 	return DAOerComparer(first, second) &&
+		first.GetAccount() == second.GetAccount() &&
 		first.GetChannel() == second.GetChannel() &&
 		first.GetClientOID() == second.GetClientOID() &&
 		first.GetDealFunds() == second.GetDealFunds() &&
@@ -215,6 +231,7 @@ func OrdererComparer(
 		first.GetRemark() == second.GetRemark() &&
 		first.GetSide() == second.GetSide() &&
 		first.GetSize() == second.GetSize() &&
+		first.GetStatus() == second.GetStatus() &&
 		first.GetStop() == second.GetStop() &&
 		first.GetStopPrice() == second.GetStopPrice() &&
 		first.GetSTP() == second.GetSTP() &&
@@ -229,6 +246,7 @@ func OrdererComparer(
 		first.GetHidden() == second.GetHidden() &&
 		first.GetIceBerg() == second.GetIceBerg() &&
 		first.GetIsActive() == second.GetIsActive() &&
+		first.GetPaper() == second.GetPaper() &&
 		first.GetPostOnly() == second.GetPostOnly() &&
 		first.GetStopTriggered() == second.GetStopTriggered()
 }
@@ -253,6 +271,11 @@ func (order *order) GetID() uuid.UUID {
 	return order.id
 }
 
+// GetAccount is a function.
+func (order *order) GetAccount() string {
+	return order.account
+}
+
 // GetChannel is a function.
 func (order *order) GetChannel() string {
 	return order.channel
@@ -323,6 +346,11 @@ func (order *order) GetSize() string {
 	return order.size
 }
 
+// GetStatus is a function.
+func (order *order) GetStatus() string {
+	return order.status
+}
+
 // GetStop is a function.
 func (order *order) GetStop() string {
 	return order.stop
@@ -393,6 +421,11 @@ func (order *order) GetIsActive() bool {
 	return order.isActive
 }
 
+// GetPaper is a function.
+func (order *order) GetPaper() bool {
+	return order.paper
+}
+
 // GetPostOnly is a function.
 func (order *order) GetPostOnly() bool {
 	return order.postOnly
@@ -410,6 +443,7 @@ func (order *order) GetMap() map[string]any {
 		"updated_at":        order.GetUpdatedAt(),
 		"deleted_at":        order.GetDeletedAt(),
 		"id":                order.GetID(),
+		"account":           order.GetAccount(),
 		"channel":           order.GetChannel(),
 		"client_oid":        order.GetClientOID(),
 		"deal_funds":        order.GetDealFunds(),
@@ -424,6 +458,7 @@ func (order *order) GetMap() map[string]any {
 		"remark":            order.GetRemark(),
 		"side":              order.GetSide(),
 		"size":              order.GetSize(),
+		"status":            order.GetStatus(),
 		"stop":              order.GetStop(),
 		"stop_price":        order.GetStopPrice(),
 		"stp":               order.GetSTP(),
@@ -438,6 +473,7 @@ func (order *order) GetMap() map[string]any {
 		"hidden":            order.GetHidden(),
 		"ice_berg":          order.GetIceBerg(),
 		"is_active":         order.GetIsActive(),
+		"paper":             order.GetPaper(),
 		"post_only":         order.GetPostOnly(),
 		"stop_triggered":    order.GetStopTriggered(),
 	}
@@ -12,12 +12,27 @@ type (
 	// OrderFilterer is an interface.
 	OrderFilterer interface {
 		Filterer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetClientOID is a function.
+		GetClientOID() string
+		// GetKucoinID is a function.
+		GetKucoinID() string
+		// GetRemark is a function.
+		GetRemark() string
+		// GetSymbol is a function.
+		GetSymbol() string
 		// GetIsActive is a function.
 		GetIsActive() bool
 	}
 
 	orderFilter struct {
-		isActive bool
+		account   string
+		clientOID string
+		kucoinID  string
+		remark    string
+		symbol    string
+		isActive  bool
 	}
 )
 
@@ -29,13 +44,48 @@ var (
 
 // NewOrderFilter is a function.
 func NewOrderFilter(
+	account string,
+	clientOID string,
+	kucoinID string,
+	remark string,
+	symbol string,
 	isActive bool,
 ) *orderFilter {
 	return &orderFilter{
-		isActive: isActive,
+		account:   account,
+		clientOID: clientOID,
+		kucoinID:  kucoinID,
+		remark:    remark,
+		symbol:    symbol,
+		isActive:  isActive,
 	}
 }
 
+// GetAccount is a function.
+func (filter *orderFilter) GetAccount() string {
+	return filter.account
+}
+
+// GetClientOID is a function.
+func (filter *orderFilter) GetClientOID() string {
+	return filter.clientOID
+}
+
+// GetKucoinID is a function.
+func (filter *orderFilter) GetKucoinID() string {
+	return filter.kucoinID
+}
+
+// GetRemark is a function.
+func (filter *orderFilter) GetRemark() string {
+	return filter.remark
+}
+
+// GetSymbol is a function.
+func (filter *orderFilter) GetSymbol() string {
+	return filter.symbol
+}
+
 // GetIsActive is a function.
 func (filter *orderFilter) GetIsActive() bool {
 	return filter.isActive
@@ -44,7 +94,12 @@ func (filter *orderFilter) GetIsActive() bool {
 // GetMap is a function.
 func (filter *orderFilter) GetMap() map[string]any {
 	return map[string]any{
-		"is_active": filter.GetIsActive(),
+		"account":    filter.GetAccount(),
+		"client_oid": filter.GetClientOID(),
+		"kucoin_id":  filter.GetKucoinID(),
+		"remark":     filter.GetRemark(),
+		"symbol":     filter.GetSymbol(),
+		"is_active":  filter.GetIsActive(),
 	}
 }
 
@@ -58,6 +113,26 @@ func (filter *orderFilter) MarshalJSON() ([]byte, error) {
 func (filter *orderFilter) Filter(
 	gormDB *gorm.DB,
 ) *gorm.DB {
+	if filter.GetAccount() != object.URIEmpty {
+		gormDB.Where("account = ?", filter.GetAccount())
+	}
+
+	if filter.GetClientOID() != object.URIEmpty {
+		gormDB.Where("client_oid = ?", filter.GetClientOID())
+	}
+
+	if filter.GetKucoinID() != object.URIEmpty {
+		gormDB.Where("kucoin_id = ?", filter.GetKucoinID())
+	}
+
+	if filter.GetRemark() != object.URIEmpty {
+		gormDB.Where("remark = ?", filter.GetRemark())
+	}
+
+	if filter.GetSymbol() != object.URIEmpty {
+		gormDB.Where("symbol = ?", filter.GetSymbol())
+	}
+
 	if filter.GetIsActive() {
 		gormDB.Where("is_active = ?", filter.GetIsActive())
 	}
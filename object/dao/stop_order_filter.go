@@ -0,0 +1,126 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"gorm.io/gorm"
+)
+
+type (
+
+	// StopOrderFilterer is an interface.
+	StopOrderFilterer interface {
+		Filterer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetClientOID is a function.
+		GetClientOID() string
+		// GetKucoinID is a function.
+		GetKucoinID() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetIsActive is a function.
+		GetIsActive() bool
+	}
+
+	stopOrderFilter struct {
+		account   string
+		clientOID string
+		kucoinID  string
+		symbol    string
+		isActive  bool
+	}
+)
+
+var (
+	_ StopOrderFilterer = (*stopOrderFilter)(nil)
+	_ json.Marshaler    = (*stopOrderFilter)(nil)
+	_ object.GetMap     = (*stopOrderFilter)(nil)
+)
+
+// NewStopOrderFilter is a function.
+func NewStopOrderFilter(
+	account string,
+	clientOID string,
+	kucoinID string,
+	symbol string,
+	isActive bool,
+) *stopOrderFilter {
+	return &stopOrderFilter{
+		account:   account,
+		clientOID: clientOID,
+		kucoinID:  kucoinID,
+		symbol:    symbol,
+		isActive:  isActive,
+	}
+}
+
+// GetAccount is a function.
+func (filter *stopOrderFilter) GetAccount() string {
+	return filter.account
+}
+
+// GetClientOID is a function.
+func (filter *stopOrderFilter) GetClientOID() string {
+	return filter.clientOID
+}
+
+// GetKucoinID is a function.
+func (filter *stopOrderFilter) GetKucoinID() string {
+	return filter.kucoinID
+}
+
+// GetSymbol is a function.
+func (filter *stopOrderFilter) GetSymbol() string {
+	return filter.symbol
+}
+
+// GetIsActive is a function.
+func (filter *stopOrderFilter) GetIsActive() bool {
+	return filter.isActive
+}
+
+// GetMap is a function.
+func (filter *stopOrderFilter) GetMap() map[string]any {
+	return map[string]any{
+		"account":    filter.GetAccount(),
+		"client_oid": filter.GetClientOID(),
+		"kucoin_id":  filter.GetKucoinID(),
+		"symbol":     filter.GetSymbol(),
+		"is_active":  filter.GetIsActive(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (filter *stopOrderFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(filter.GetMap())
+}
+
+// Filter is a function.
+func (filter *stopOrderFilter) Filter(
+	gormDB *gorm.DB,
+) *gorm.DB {
+	if filter.GetAccount() != object.URIEmpty {
+		gormDB.Where("account = ?", filter.GetAccount())
+	}
+
+	if filter.GetClientOID() != object.URIEmpty {
+		gormDB.Where("client_oid = ?", filter.GetClientOID())
+	}
+
+	if filter.GetKucoinID() != object.URIEmpty {
+		gormDB.Where("kucoin_id = ?", filter.GetKucoinID())
+	}
+
+	if filter.GetSymbol() != object.URIEmpty {
+		gormDB.Where("symbol = ?", filter.GetSymbol())
+	}
+
+	if filter.GetIsActive() {
+		gormDB.Where("is_active = ?", filter.GetIsActive())
+	}
+
+	return gormDB
+}
@@ -0,0 +1,294 @@
+package dao
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/google/uuid"
+)
+
+type (
+	// Symboler is an interface.
+	Symboler interface {
+		DAOer
+		// GetBaseCurrency is a function.
+		GetBaseCurrency() string
+		// GetBaseIncrement is a function.
+		GetBaseIncrement() string
+		// GetBaseMaxSize is a function.
+		GetBaseMaxSize() string
+		// GetBaseMinSize is a function.
+		GetBaseMinSize() string
+		// GetFeeCurrency is a function.
+		GetFeeCurrency() string
+		// GetMarket is a function.
+		GetMarket() string
+		// GetMinFunds is a function.
+		GetMinFunds() string
+		// GetName is a function.
+		GetName() string
+		// GetPriceIncrement is a function.
+		GetPriceIncrement() string
+		// GetPriceLimitRate is a function.
+		GetPriceLimitRate() string
+		// GetQuoteCurrency is a function.
+		GetQuoteCurrency() string
+		// GetQuoteIncrement is a function.
+		GetQuoteIncrement() string
+		// GetQuoteMaxSize is a function.
+		GetQuoteMaxSize() string
+		// GetQuoteMinSize is a function.
+		GetQuoteMinSize() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetEnableTrading is a function.
+		GetEnableTrading() bool
+		// GetIsMarginEnabled is a function.
+		GetIsMarginEnabled() bool
+	}
+
+	symbol struct {
+		baseCurrency   string
+		baseIncrement  string
+		baseMaxSize    string
+		baseMinSize    string
+		feeCurrency    string
+		market         string
+		minFunds       string
+		name           string
+		priceIncrement string
+		priceLimitRate string
+		quoteCurrency  string
+		quoteIncrement string
+		quoteMaxSize   string
+		quoteMinSize   string
+		symbol         string
+		dao
+		enableTrading   bool
+		isMarginEnabled bool
+	}
+)
+
+var (
+	_ Symboler       = (*symbol)(nil)
+	_ json.Marshaler = (*symbol)(nil)
+	_ object.GetMap  = (*symbol)(nil)
+)
+
+// NewSymbol is a function.
+func NewSymbol(
+	createdAt time.Time,
+	updatedAt time.Time,
+	deletedAt sql.NullTime,
+	id uuid.UUID,
+	baseCurrency string,
+	baseIncrement string,
+	baseMaxSize string,
+	baseMinSize string,
+	feeCurrency string,
+	market string,
+	minFunds string,
+	name string,
+	priceIncrement string,
+	priceLimitRate string,
+	quoteCurrency string,
+	quoteIncrement string,
+	quoteMaxSize string,
+	quoteMinSize string,
+	symbolValue string,
+	enableTrading bool,
+	isMarginEnabled bool,
+) *symbol {
+	return &symbol{
+		dao: dao{
+			daoJoin: daoJoin{
+				createdAt: createdAt,
+				updatedAt: updatedAt,
+				deletedAt: deletedAt,
+			},
+			id: id,
+		},
+		baseCurrency:    baseCurrency,
+		baseIncrement:   baseIncrement,
+		baseMaxSize:     baseMaxSize,
+		baseMinSize:     baseMinSize,
+		feeCurrency:     feeCurrency,
+		market:          market,
+		minFunds:        minFunds,
+		name:            name,
+		priceIncrement:  priceIncrement,
+		priceLimitRate:  priceLimitRate,
+		quoteCurrency:   quoteCurrency,
+		quoteIncrement:  quoteIncrement,
+		quoteMaxSize:    quoteMaxSize,
+		quoteMinSize:    quoteMinSize,
+		symbol:          symbolValue,
+		enableTrading:   enableTrading,
+		isMarginEnabled: isMarginEnabled,
+	}
+}
+
+// SymbolerComparer is a function.
+func SymbolerComparer(
+	first Symboler,
+	second Symboler,
+) bool {
+	return DAOerComparer(first, second) &&
+		first.GetBaseCurrency() == second.GetBaseCurrency() &&
+		first.GetBaseIncrement() == second.GetBaseIncrement() &&
+		first.GetBaseMaxSize() == second.GetBaseMaxSize() &&
+		first.GetBaseMinSize() == second.GetBaseMinSize() &&
+		first.GetFeeCurrency() == second.GetFeeCurrency() &&
+		first.GetMarket() == second.GetMarket() &&
+		first.GetMinFunds() == second.GetMinFunds() &&
+		first.GetName() == second.GetName() &&
+		first.GetPriceIncrement() == second.GetPriceIncrement() &&
+		first.GetPriceLimitRate() == second.GetPriceLimitRate() &&
+		first.GetQuoteCurrency() == second.GetQuoteCurrency() &&
+		first.GetQuoteIncrement() == second.GetQuoteIncrement() &&
+		first.GetQuoteMaxSize() == second.GetQuoteMaxSize() &&
+		first.GetQuoteMinSize() == second.GetQuoteMinSize() &&
+		first.GetSymbol() == second.GetSymbol() &&
+		first.GetEnableTrading() == second.GetEnableTrading() &&
+		first.GetIsMarginEnabled() == second.GetIsMarginEnabled()
+}
+
+// GetCreatedAt is a function.
+func (symbol *symbol) GetCreatedAt() time.Time {
+	return symbol.createdAt
+}
+
+// GetUpdatedAt is a function.
+func (symbol *symbol) GetUpdatedAt() time.Time {
+	return symbol.updatedAt
+}
+
+// GetDeletedAt is a function.
+func (symbol *symbol) GetDeletedAt() sql.NullTime {
+	return symbol.deletedAt
+}
+
+// GetID is a function.
+func (symbol *symbol) GetID() uuid.UUID {
+	return symbol.id
+}
+
+// GetBaseCurrency is a function.
+func (symbol *symbol) GetBaseCurrency() string {
+	return symbol.baseCurrency
+}
+
+// GetBaseIncrement is a function.
+func (symbol *symbol) GetBaseIncrement() string {
+	return symbol.baseIncrement
+}
+
+// GetBaseMaxSize is a function.
+func (symbol *symbol) GetBaseMaxSize() string {
+	return symbol.baseMaxSize
+}
+
+// GetBaseMinSize is a function.
+func (symbol *symbol) GetBaseMinSize() string {
+	return symbol.baseMinSize
+}
+
+// GetFeeCurrency is a function.
+func (symbol *symbol) GetFeeCurrency() string {
+	return symbol.feeCurrency
+}
+
+// GetMarket is a function.
+func (symbol *symbol) GetMarket() string {
+	return symbol.market
+}
+
+// GetMinFunds is a function.
+func (symbol *symbol) GetMinFunds() string {
+	return symbol.minFunds
+}
+
+// GetName is a function.
+func (symbol *symbol) GetName() string {
+	return symbol.name
+}
+
+// GetPriceIncrement is a function.
+func (symbol *symbol) GetPriceIncrement() string {
+	return symbol.priceIncrement
+}
+
+// GetPriceLimitRate is a function.
+func (symbol *symbol) GetPriceLimitRate() string {
+	return symbol.priceLimitRate
+}
+
+// GetQuoteCurrency is a function.
+func (symbol *symbol) GetQuoteCurrency() string {
+	return symbol.quoteCurrency
+}
+
+// GetQuoteIncrement is a function.
+func (symbol *symbol) GetQuoteIncrement() string {
+	return symbol.quoteIncrement
+}
+
+// GetQuoteMaxSize is a function.
+func (symbol *symbol) GetQuoteMaxSize() string {
+	return symbol.quoteMaxSize
+}
+
+// GetQuoteMinSize is a function.
+func (symbol *symbol) GetQuoteMinSize() string {
+	return symbol.quoteMinSize
+}
+
+// GetSymbol is a function.
+func (symbol *symbol) GetSymbol() string {
+	return symbol.symbol
+}
+
+// GetEnableTrading is a function.
+func (symbol *symbol) GetEnableTrading() bool {
+	return symbol.enableTrading
+}
+
+// GetIsMarginEnabled is a function.
+func (symbol *symbol) GetIsMarginEnabled() bool {
+	return symbol.isMarginEnabled
+}
+
+// GetMap is a function.
+func (symbol *symbol) GetMap() map[string]any {
+	return map[string]any{
+		"created_at":        symbol.GetCreatedAt(),
+		"updated_at":        symbol.GetUpdatedAt(),
+		"deleted_at":        symbol.GetDeletedAt(),
+		"id":                symbol.GetID(),
+		"base_currency":     symbol.GetBaseCurrency(),
+		"base_increment":    symbol.GetBaseIncrement(),
+		"base_max_size":     symbol.GetBaseMaxSize(),
+		"base_min_size":     symbol.GetBaseMinSize(),
+		"fee_currency":      symbol.GetFeeCurrency(),
+		"market":            symbol.GetMarket(),
+		"min_funds":         symbol.GetMinFunds(),
+		"name":              symbol.GetName(),
+		"price_increment":   symbol.GetPriceIncrement(),
+		"price_limit_rate":  symbol.GetPriceLimitRate(),
+		"quote_currency":    symbol.GetQuoteCurrency(),
+		"quote_increment":   symbol.GetQuoteIncrement(),
+		"quote_max_size":    symbol.GetQuoteMaxSize(),
+		"quote_min_size":    symbol.GetQuoteMinSize(),
+		"symbol":            symbol.GetSymbol(),
+		"enable_trading":    symbol.GetEnableTrading(),
+		"is_margin_enabled": symbol.GetIsMarginEnabled(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (symbol *symbol) MarshalJSON() ([]byte, error) {
+	return json.Marshal(symbol.GetMap())
+}
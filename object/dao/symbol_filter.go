@@ -0,0 +1,81 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"gorm.io/gorm"
+)
+
+type (
+
+	// SymbolFilterer is an interface.
+	SymbolFilterer interface {
+		Filterer
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetEnableTrading is a function.
+		GetEnableTrading() bool
+	}
+
+	symbolFilter struct {
+		symbol        string
+		enableTrading bool
+	}
+)
+
+var (
+	_ SymbolFilterer = (*symbolFilter)(nil)
+	_ json.Marshaler = (*symbolFilter)(nil)
+	_ object.GetMap  = (*symbolFilter)(nil)
+)
+
+// NewSymbolFilter is a function.
+func NewSymbolFilter(
+	symbol string,
+	enableTrading bool,
+) *symbolFilter {
+	return &symbolFilter{
+		symbol:        symbol,
+		enableTrading: enableTrading,
+	}
+}
+
+// GetSymbol is a function.
+func (filter *symbolFilter) GetSymbol() string {
+	return filter.symbol
+}
+
+// GetEnableTrading is a function.
+func (filter *symbolFilter) GetEnableTrading() bool {
+	return filter.enableTrading
+}
+
+// GetMap is a function.
+func (filter *symbolFilter) GetMap() map[string]any {
+	return map[string]any{
+		"symbol":         filter.GetSymbol(),
+		"enable_trading": filter.GetEnableTrading(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (filter *symbolFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(filter.GetMap())
+}
+
+// Filter is a function.
+func (filter *symbolFilter) Filter(
+	gormDB *gorm.DB,
+) *gorm.DB {
+	if filter.GetSymbol() != object.URIEmpty {
+		gormDB.Where("symbol = ?", filter.GetSymbol())
+	}
+
+	if filter.GetEnableTrading() {
+		gormDB.Where("enable_trading = ?", filter.GetEnableTrading())
+	}
+
+	return gormDB
+}
@@ -0,0 +1,134 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// FillRequester is an interface.
+	FillRequester interface {
+		// GetEndAt is a function.
+		GetEndAt() string
+		// GetMap is a function.
+		GetMap() map[string]any
+		// GetOrderID is a function.
+		GetOrderID() string
+		// GetOrderType is a function.
+		GetOrderType() object.OrderTypeType
+		// GetSide is a function.
+		GetSide() object.OrderSideType
+		// GetStartAt is a function.
+		GetStartAt() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetTradeType is a function.
+		GetTradeType() object.OrderTypeType
+	}
+
+	fillRequest struct {
+		endAt     string
+		orderID   string
+		orderType object.OrderTypeType
+		side      object.OrderSideType
+		startAt   string
+		symbol    string
+		tradeType object.OrderTypeType
+	}
+)
+
+var (
+	_ FillRequester  = (*fillRequest)(nil)
+	_ json.Marshaler = (*fillRequest)(nil)
+	_ object.GetMap  = (*fillRequest)(nil)
+)
+
+// NewFillRequest is a function.
+func NewFillRequest(
+	endAt string,
+	orderID string,
+	orderType object.OrderTypeType,
+	side object.OrderSideType,
+	startAt string,
+	symbol string,
+	tradeType object.OrderTypeType,
+) *fillRequest {
+	return &fillRequest{
+		endAt:     endAt,
+		orderID:   orderID,
+		orderType: orderType,
+		side:      side,
+		startAt:   startAt,
+		symbol:    symbol,
+		tradeType: tradeType,
+	}
+}
+
+// FillRequesterComparer is a function.
+func FillRequesterComparer(
+	first FillRequester,
+	second FillRequester,
+) bool {
+	return first.GetEndAt() == second.GetEndAt() &&
+		first.GetOrderID() == second.GetOrderID() &&
+		first.GetOrderType() == second.GetOrderType() &&
+		first.GetSide() == second.GetSide() &&
+		first.GetStartAt() == second.GetStartAt() &&
+		first.GetSymbol() == second.GetSymbol() &&
+		first.GetTradeType() == second.GetTradeType()
+}
+
+// GetEndAt is a function.
+func (fillRequest *fillRequest) GetEndAt() string {
+	return fillRequest.endAt
+}
+
+// GetOrderID is a function.
+func (fillRequest *fillRequest) GetOrderID() string {
+	return fillRequest.orderID
+}
+
+// GetOrderType is a function.
+func (fillRequest *fillRequest) GetOrderType() object.OrderTypeType {
+	return fillRequest.orderType
+}
+
+// GetSide is a function.
+func (fillRequest *fillRequest) GetSide() object.OrderSideType {
+	return fillRequest.side
+}
+
+// GetStartAt is a function.
+func (fillRequest *fillRequest) GetStartAt() string {
+	return fillRequest.startAt
+}
+
+// GetSymbol is a function.
+func (fillRequest *fillRequest) GetSymbol() string {
+	return fillRequest.symbol
+}
+
+// GetTradeType is a function.
+func (fillRequest *fillRequest) GetTradeType() object.OrderTypeType {
+	return fillRequest.tradeType
+}
+
+// GetMap is a function.
+func (fillRequest *fillRequest) GetMap() map[string]any {
+	return map[string]any{
+		"endAt":     fillRequest.GetEndAt(),
+		"orderId":   fillRequest.GetOrderID(),
+		"type":      string(fillRequest.GetOrderType()),
+		"side":      string(fillRequest.GetSide()),
+		"startAt":   fillRequest.GetStartAt(),
+		"symbol":    fillRequest.GetSymbol(),
+		"tradeType": string(fillRequest.GetTradeType()),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (fillRequest *fillRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fillRequest.GetMap())
+}
@@ -0,0 +1,154 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// PlaceAlgoOrderRequester is an interface.
+	PlaceAlgoOrderRequester interface {
+		// GetAlgoType is a function.
+		GetAlgoType() object.AlgoOrderTypeType
+		// GetClientOID is a function.
+		GetClientOID() string
+		// GetLimitPrice is a function.
+		GetLimitPrice() string
+		// GetSide is a function.
+		GetSide() object.OrderSideType
+		// GetSize is a function.
+		GetSize() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetVisibleSize is a function.
+		GetVisibleSize() string
+		// GetEndAt is a function.
+		GetEndAt() int64
+		// GetSlices is a function.
+		GetSlices() int64
+		// GetStartAt is a function.
+		GetStartAt() int64
+	}
+
+	placeAlgoOrderRequest struct {
+		algoType    object.AlgoOrderTypeType
+		clientOID   string
+		limitPrice  string
+		side        object.OrderSideType
+		size        string
+		symbol      string
+		visibleSize string
+		endAt       int64
+		slices      int64
+		startAt     int64
+	}
+)
+
+var (
+	_ PlaceAlgoOrderRequester = (*placeAlgoOrderRequest)(nil)
+	_ json.Marshaler          = (*placeAlgoOrderRequest)(nil)
+	_ object.GetMap           = (*placeAlgoOrderRequest)(nil)
+)
+
+// NewPlaceAlgoOrderRequest is a function.
+// The parent is sliced between startAt and endAt, in unix seconds, into slices
+// children for TWAP and VWAP, or into visibleSize children for iceberg. An empty
+// limitPrice sends market children; otherwise no child crosses it.
+func NewPlaceAlgoOrderRequest(
+	algoType object.AlgoOrderTypeType,
+	clientOID string,
+	limitPrice string,
+	side object.OrderSideType,
+	size string,
+	symbol string,
+	visibleSize string,
+	endAt int64,
+	slices int64,
+	startAt int64,
+) *placeAlgoOrderRequest {
+	return &placeAlgoOrderRequest{
+		algoType:    algoType,
+		clientOID:   clientOID,
+		limitPrice:  limitPrice,
+		side:        side,
+		size:        size,
+		symbol:      symbol,
+		visibleSize: visibleSize,
+		endAt:       endAt,
+		slices:      slices,
+		startAt:     startAt,
+	}
+}
+
+// GetAlgoType is a function.
+func (placeAlgoOrderRequest *placeAlgoOrderRequest) GetAlgoType() object.AlgoOrderTypeType {
+	return placeAlgoOrderRequest.algoType
+}
+
+// GetClientOID is a function.
+func (placeAlgoOrderRequest *placeAlgoOrderRequest) GetClientOID() string {
+	return placeAlgoOrderRequest.clientOID
+}
+
+// GetLimitPrice is a function.
+func (placeAlgoOrderRequest *placeAlgoOrderRequest) GetLimitPrice() string {
+	return placeAlgoOrderRequest.limitPrice
+}
+
+// GetSide is a function.
+func (placeAlgoOrderRequest *placeAlgoOrderRequest) GetSide() object.OrderSideType {
+	return placeAlgoOrderRequest.side
+}
+
+// GetSize is a function.
+func (placeAlgoOrderRequest *placeAlgoOrderRequest) GetSize() string {
+	return placeAlgoOrderRequest.size
+}
+
+// GetSymbol is a function.
+func (placeAlgoOrderRequest *placeAlgoOrderRequest) GetSymbol() string {
+	return placeAlgoOrderRequest.symbol
+}
+
+// GetVisibleSize is a function.
+func (placeAlgoOrderRequest *placeAlgoOrderRequest) GetVisibleSize() string {
+	return placeAlgoOrderRequest.visibleSize
+}
+
+// GetEndAt is a function.
+func (placeAlgoOrderRequest *placeAlgoOrderRequest) GetEndAt() int64 {
+	return placeAlgoOrderRequest.endAt
+}
+
+// GetSlices is a function.
+func (placeAlgoOrderRequest *placeAlgoOrderRequest) GetSlices() int64 {
+	return placeAlgoOrderRequest.slices
+}
+
+// GetStartAt is a function.
+func (placeAlgoOrderRequest *placeAlgoOrderRequest) GetStartAt() int64 {
+	return placeAlgoOrderRequest.startAt
+}
+
+// GetMap is a function.
+func (placeAlgoOrderRequest *placeAlgoOrderRequest) GetMap() map[string]any {
+	return map[string]any{
+		"algoType":    string(placeAlgoOrderRequest.GetAlgoType()),
+		"clientOid":   placeAlgoOrderRequest.GetClientOID(),
+		"limitPrice":  placeAlgoOrderRequest.GetLimitPrice(),
+		"side":        string(placeAlgoOrderRequest.GetSide()),
+		"size":        placeAlgoOrderRequest.GetSize(),
+		"symbol":      placeAlgoOrderRequest.GetSymbol(),
+		"visibleSize": placeAlgoOrderRequest.GetVisibleSize(),
+		"endAt":       placeAlgoOrderRequest.GetEndAt(),
+		"slices":      placeAlgoOrderRequest.GetSlices(),
+		"startAt":     placeAlgoOrderRequest.GetStartAt(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (placeAlgoOrderRequest *placeAlgoOrderRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(placeAlgoOrderRequest.GetMap())
+}
@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// PlaceBracketRequester is an interface.
+	PlaceBracketRequester interface {
+		// GetEntry is a function.
+		GetEntry() PlaceOrderRequester
+		// GetStopPrice is a function.
+		GetStopPrice() string
+		// GetTakeProfitPrice is a function.
+		GetTakeProfitPrice() string
+		// GetTrailingDistance is a function.
+		GetTrailingDistance() string
+	}
+
+	placeBracketRequest struct {
+		entry            PlaceOrderRequester
+		stopPrice        string
+		takeProfitPrice  string
+		trailingDistance string
+	}
+)
+
+var (
+	_ PlaceBracketRequester = (*placeBracketRequest)(nil)
+	_ json.Marshaler        = (*placeBracketRequest)(nil)
+	_ object.GetMap         = (*placeBracketRequest)(nil)
+)
+
+// NewPlaceBracketRequest is a function.
+// An empty trailingDistance keeps the stop price fixed; otherwise the stop follows
+// the last price at that distance.
+func NewPlaceBracketRequest(
+	entry PlaceOrderRequester,
+	stopPrice string,
+	takeProfitPrice string,
+	trailingDistance string,
+) *placeBracketRequest {
+	return &placeBracketRequest{
+		entry:            entry,
+		stopPrice:        stopPrice,
+		takeProfitPrice:  takeProfitPrice,
+		trailingDistance: trailingDistance,
+	}
+}
+
+// GetEntry is a function.
+func (placeBracketRequest *placeBracketRequest) GetEntry() PlaceOrderRequester {
+	return placeBracketRequest.entry
+}
+
+// GetStopPrice is a function.
+func (placeBracketRequest *placeBracketRequest) GetStopPrice() string {
+	return placeBracketRequest.stopPrice
+}
+
+// GetTakeProfitPrice is a function.
+func (placeBracketRequest *placeBracketRequest) GetTakeProfitPrice() string {
+	return placeBracketRequest.takeProfitPrice
+}
+
+// GetTrailingDistance is a function.
+func (placeBracketRequest *placeBracketRequest) GetTrailingDistance() string {
+	return placeBracketRequest.trailingDistance
+}
+
+// GetMap is a function.
+func (placeBracketRequest *placeBracketRequest) GetMap() map[string]any {
+	return map[string]any{
+		"entry":            placeBracketRequest.GetEntry(),
+		"stopPrice":        placeBracketRequest.GetStopPrice(),
+		"takeProfitPrice":  placeBracketRequest.GetTakeProfitPrice(),
+		"trailingDistance": placeBracketRequest.GetTrailingDistance(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (placeBracketRequest *placeBracketRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(placeBracketRequest.GetMap())
+}
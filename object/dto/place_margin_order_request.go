@@ -0,0 +1,255 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// PlaceMarginOrderRequester is an interface.
+	PlaceMarginOrderRequester interface {
+		// GetClientOID is a function.
+		GetClientOID() string
+		// GetFunds is a function.
+		GetFunds() string
+		// GetOrderType is a function.
+		GetOrderType() object.OrderTypeType
+		// GetPrice is a function.
+		GetPrice() string
+		// GetRemark is a function.
+		GetRemark() string
+		// GetSide is a function.
+		GetSide() object.OrderSideType
+		// GetSize is a function.
+		GetSize() string
+		// GetSTP is a function.
+		GetSTP() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetTimeInForce is a function.
+		GetTimeInForce() object.TimeInForceType
+		// GetTradeType is a function.
+		GetTradeType() object.OrderTypeType
+		// GetVisibleSize is a function.
+		GetVisibleSize() string
+		// GetCancelAfter is a function.
+		GetCancelAfter() int64
+		// GetAutoBorrow is a function.
+		GetAutoBorrow() bool
+		// GetHidden is a function.
+		GetHidden() bool
+		// GetIceBerg is a function.
+		GetIceBerg() bool
+		// GetPostOnly is a function.
+		GetPostOnly() bool
+	}
+
+	placeMarginOrderRequest struct {
+		clientOID   string
+		funds       string
+		orderType   object.OrderTypeType
+		price       string
+		remark      string
+		side        object.OrderSideType
+		size        string
+		stp         string
+		symbol      string
+		timeInForce object.TimeInForceType
+		tradeType   object.OrderTypeType
+		visibleSize string
+		cancelAfter int64
+		autoBorrow  bool
+		hidden      bool
+		iceBerg     bool
+		postOnly    bool
+	}
+)
+
+var (
+	_ PlaceOrderRequester       = (*placeMarginOrderRequest)(nil)
+	_ PlaceMarginOrderRequester = (*placeMarginOrderRequest)(nil)
+	_ json.Marshaler            = (*placeMarginOrderRequest)(nil)
+	_ object.GetMap             = (*placeMarginOrderRequest)(nil)
+)
+
+// NewPlaceMarginOrderRequest is a function.
+// The tradeType picks the cross or the isolated margin account, and with
+// autoBorrow the exchange borrows whatever the account lacks to fill the order.
+func NewPlaceMarginOrderRequest(
+	clientOID string,
+	funds string,
+	orderType object.OrderTypeType,
+	price string,
+	remark string,
+	side object.OrderSideType,
+	size string,
+	stp string,
+	symbol string,
+	timeInForce object.TimeInForceType,
+	tradeType object.OrderTypeType,
+	visibleSize string,
+	cancelAfter int64,
+	autoBorrow bool,
+	hidden bool,
+	iceBerg bool,
+	postOnly bool,
+) *placeMarginOrderRequest {
+	return &placeMarginOrderRequest{
+		clientOID:   clientOID,
+		funds:       funds,
+		orderType:   orderType,
+		price:       price,
+		remark:      remark,
+		side:        side,
+		size:        size,
+		stp:         stp,
+		symbol:      symbol,
+		timeInForce: timeInForce,
+		tradeType:   tradeType,
+		visibleSize: visibleSize,
+		cancelAfter: cancelAfter,
+		autoBorrow:  autoBorrow,
+		hidden:      hidden,
+		iceBerg:     iceBerg,
+		postOnly:    postOnly,
+	}
+}
+
+// PlaceMarginOrderRequesterComparer is a function.
+func PlaceMarginOrderRequesterComparer(
+	first PlaceMarginOrderRequester,
+	second PlaceMarginOrderRequester,
+) bool {
+	return first.GetClientOID() == second.GetClientOID() &&
+		first.GetFunds() == second.GetFunds() &&
+		first.GetOrderType() == second.GetOrderType() &&
+		first.GetPrice() == second.GetPrice() &&
+		first.GetRemark() == second.GetRemark() &&
+		first.GetSide() == second.GetSide() &&
+		first.GetSize() == second.GetSize() &&
+		first.GetSTP() == second.GetSTP() &&
+		first.GetSymbol() == second.GetSymbol() &&
+		first.GetTimeInForce() == second.GetTimeInForce() &&
+		first.GetTradeType() == second.GetTradeType() &&
+		first.GetVisibleSize() == second.GetVisibleSize() &&
+		first.GetCancelAfter() == second.GetCancelAfter() &&
+		first.GetAutoBorrow() == second.GetAutoBorrow() &&
+		first.GetHidden() == second.GetHidden() &&
+		first.GetIceBerg() == second.GetIceBerg() &&
+		first.GetPostOnly() == second.GetPostOnly()
+}
+
+// GetClientOID is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetClientOID() string {
+	return placeMarginOrderRequest.clientOID
+}
+
+// GetFunds is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetFunds() string {
+	return placeMarginOrderRequest.funds
+}
+
+// GetOrderType is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetOrderType() object.OrderTypeType {
+	return placeMarginOrderRequest.orderType
+}
+
+// GetPrice is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetPrice() string {
+	return placeMarginOrderRequest.price
+}
+
+// GetRemark is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetRemark() string {
+	return placeMarginOrderRequest.remark
+}
+
+// GetSide is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetSide() object.OrderSideType {
+	return placeMarginOrderRequest.side
+}
+
+// GetSize is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetSize() string {
+	return placeMarginOrderRequest.size
+}
+
+// GetSTP is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetSTP() string {
+	return placeMarginOrderRequest.stp
+}
+
+// GetSymbol is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetSymbol() string {
+	return placeMarginOrderRequest.symbol
+}
+
+// GetTimeInForce is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetTimeInForce() object.TimeInForceType {
+	return placeMarginOrderRequest.timeInForce
+}
+
+// GetTradeType is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetTradeType() object.OrderTypeType {
+	return placeMarginOrderRequest.tradeType
+}
+
+// GetVisibleSize is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetVisibleSize() string {
+	return placeMarginOrderRequest.visibleSize
+}
+
+// GetCancelAfter is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetCancelAfter() int64 {
+	return placeMarginOrderRequest.cancelAfter
+}
+
+// GetAutoBorrow is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetAutoBorrow() bool {
+	return placeMarginOrderRequest.autoBorrow
+}
+
+// GetHidden is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetHidden() bool {
+	return placeMarginOrderRequest.hidden
+}
+
+// GetIceBerg is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetIceBerg() bool {
+	return placeMarginOrderRequest.iceBerg
+}
+
+// GetPostOnly is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetPostOnly() bool {
+	return placeMarginOrderRequest.postOnly
+}
+
+// GetMap is a function.
+func (placeMarginOrderRequest *placeMarginOrderRequest) GetMap() map[string]any {
+	return map[string]any{
+		"clientOid":   placeMarginOrderRequest.GetClientOID(),
+		"funds":       placeMarginOrderRequest.GetFunds(),
+		"type":        string(placeMarginOrderRequest.GetOrderType()),
+		"price":       placeMarginOrderRequest.GetPrice(),
+		"remark":      placeMarginOrderRequest.GetRemark(),
+		"side":        string(placeMarginOrderRequest.GetSide()),
+		"size":        placeMarginOrderRequest.GetSize(),
+		"stp":         placeMarginOrderRequest.GetSTP(),
+		"symbol":      placeMarginOrderRequest.GetSymbol(),
+		"timeInForce": string(placeMarginOrderRequest.GetTimeInForce()),
+		"tradeType":   string(placeMarginOrderRequest.GetTradeType()),
+		"visibleSize": placeMarginOrderRequest.GetVisibleSize(),
+		"cancelAfter": placeMarginOrderRequest.GetCancelAfter(),
+		"autoBorrow":  placeMarginOrderRequest.GetAutoBorrow(),
+		"hidden":      placeMarginOrderRequest.GetHidden(),
+		"iceberg":     placeMarginOrderRequest.GetIceBerg(),
+		"postOnly":    placeMarginOrderRequest.GetPostOnly(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (placeMarginOrderRequest *placeMarginOrderRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(placeMarginOrderRequest.GetMap())
+}
@@ -0,0 +1,242 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// PlaceOrderRequester is an interface.
+	PlaceOrderRequester interface {
+		// GetClientOID is a function.
+		GetClientOID() string
+		// GetFunds is a function.
+		GetFunds() string
+		// GetOrderType is a function.
+		GetOrderType() object.OrderTypeType
+		// GetPrice is a function.
+		GetPrice() string
+		// GetRemark is a function.
+		GetRemark() string
+		// GetSide is a function.
+		GetSide() object.OrderSideType
+		// GetSize is a function.
+		GetSize() string
+		// GetSTP is a function.
+		GetSTP() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetTimeInForce is a function.
+		GetTimeInForce() object.TimeInForceType
+		// GetTradeType is a function.
+		GetTradeType() object.OrderTypeType
+		// GetVisibleSize is a function.
+		GetVisibleSize() string
+		// GetCancelAfter is a function.
+		GetCancelAfter() int64
+		// GetHidden is a function.
+		GetHidden() bool
+		// GetIceBerg is a function.
+		GetIceBerg() bool
+		// GetPostOnly is a function.
+		GetPostOnly() bool
+	}
+
+	placeOrderRequest struct {
+		clientOID   string
+		funds       string
+		orderType   object.OrderTypeType
+		price       string
+		remark      string
+		side        object.OrderSideType
+		size        string
+		stp         string
+		symbol      string
+		timeInForce object.TimeInForceType
+		tradeType   object.OrderTypeType
+		visibleSize string
+		cancelAfter int64
+		hidden      bool
+		iceBerg     bool
+		postOnly    bool
+	}
+)
+
+var (
+	_ PlaceOrderRequester = (*placeOrderRequest)(nil)
+	_ json.Marshaler      = (*placeOrderRequest)(nil)
+	_ object.GetMap       = (*placeOrderRequest)(nil)
+)
+
+// NewPlaceOrderRequest is a function.
+// The clientOID should come from util.ClientOID, so that submitting the same intent twice
+// is recognised as a retry.
+func NewPlaceOrderRequest(
+	clientOID string,
+	funds string,
+	orderType object.OrderTypeType,
+	price string,
+	remark string,
+	side object.OrderSideType,
+	size string,
+	stp string,
+	symbol string,
+	timeInForce object.TimeInForceType,
+	tradeType object.OrderTypeType,
+	visibleSize string,
+	cancelAfter int64,
+	hidden bool,
+	iceBerg bool,
+	postOnly bool,
+) *placeOrderRequest {
+	return &placeOrderRequest{
+		clientOID:   clientOID,
+		funds:       funds,
+		orderType:   orderType,
+		price:       price,
+		remark:      remark,
+		side:        side,
+		size:        size,
+		stp:         stp,
+		symbol:      symbol,
+		timeInForce: timeInForce,
+		tradeType:   tradeType,
+		visibleSize: visibleSize,
+		cancelAfter: cancelAfter,
+		hidden:      hidden,
+		iceBerg:     iceBerg,
+		postOnly:    postOnly,
+	}
+}
+
+// PlaceOrderRequesterComparer is a function.
+func PlaceOrderRequesterComparer(
+	first PlaceOrderRequester,
+	second PlaceOrderRequester,
+) bool {
+	return first.GetClientOID() == second.GetClientOID() &&
+		first.GetFunds() == second.GetFunds() &&
+		first.GetOrderType() == second.GetOrderType() &&
+		first.GetPrice() == second.GetPrice() &&
+		first.GetRemark() == second.GetRemark() &&
+		first.GetSide() == second.GetSide() &&
+		first.GetSize() == second.GetSize() &&
+		first.GetSTP() == second.GetSTP() &&
+		first.GetSymbol() == second.GetSymbol() &&
+		first.GetTimeInForce() == second.GetTimeInForce() &&
+		first.GetTradeType() == second.GetTradeType() &&
+		first.GetVisibleSize() == second.GetVisibleSize() &&
+		first.GetCancelAfter() == second.GetCancelAfter() &&
+		first.GetHidden() == second.GetHidden() &&
+		first.GetIceBerg() == second.GetIceBerg() &&
+		first.GetPostOnly() == second.GetPostOnly()
+}
+
+// GetClientOID is a function.
+func (placeOrderRequest *placeOrderRequest) GetClientOID() string {
+	return placeOrderRequest.clientOID
+}
+
+// GetFunds is a function.
+func (placeOrderRequest *placeOrderRequest) GetFunds() string {
+	return placeOrderRequest.funds
+}
+
+// GetOrderType is a function.
+func (placeOrderRequest *placeOrderRequest) GetOrderType() object.OrderTypeType {
+	return placeOrderRequest.orderType
+}
+
+// GetPrice is a function.
+func (placeOrderRequest *placeOrderRequest) GetPrice() string {
+	return placeOrderRequest.price
+}
+
+// GetRemark is a function.
+func (placeOrderRequest *placeOrderRequest) GetRemark() string {
+	return placeOrderRequest.remark
+}
+
+// GetSide is a function.
+func (placeOrderRequest *placeOrderRequest) GetSide() object.OrderSideType {
+	return placeOrderRequest.side
+}
+
+// GetSize is a function.
+func (placeOrderRequest *placeOrderRequest) GetSize() string {
+	return placeOrderRequest.size
+}
+
+// GetSTP is a function.
+func (placeOrderRequest *placeOrderRequest) GetSTP() string {
+	return placeOrderRequest.stp
+}
+
+// GetSymbol is a function.
+func (placeOrderRequest *placeOrderRequest) GetSymbol() string {
+	return placeOrderRequest.symbol
+}
+
+// GetTimeInForce is a function.
+func (placeOrderRequest *placeOrderRequest) GetTimeInForce() object.TimeInForceType {
+	return placeOrderRequest.timeInForce
+}
+
+// GetTradeType is a function.
+func (placeOrderRequest *placeOrderRequest) GetTradeType() object.OrderTypeType {
+	return placeOrderRequest.tradeType
+}
+
+// GetVisibleSize is a function.
+func (placeOrderRequest *placeOrderRequest) GetVisibleSize() string {
+	return placeOrderRequest.visibleSize
+}
+
+// GetCancelAfter is a function.
+func (placeOrderRequest *placeOrderRequest) GetCancelAfter() int64 {
+	return placeOrderRequest.cancelAfter
+}
+
+// GetHidden is a function.
+func (placeOrderRequest *placeOrderRequest) GetHidden() bool {
+	return placeOrderRequest.hidden
+}
+
+// GetIceBerg is a function.
+func (placeOrderRequest *placeOrderRequest) GetIceBerg() bool {
+	return placeOrderRequest.iceBerg
+}
+
+// GetPostOnly is a function.
+func (placeOrderRequest *placeOrderRequest) GetPostOnly() bool {
+	return placeOrderRequest.postOnly
+}
+
+// GetMap is a function.
+func (placeOrderRequest *placeOrderRequest) GetMap() map[string]any {
+	return map[string]any{
+		"clientOid":   placeOrderRequest.GetClientOID(),
+		"funds":       placeOrderRequest.GetFunds(),
+		"type":        string(placeOrderRequest.GetOrderType()),
+		"price":       placeOrderRequest.GetPrice(),
+		"remark":      placeOrderRequest.GetRemark(),
+		"side":        string(placeOrderRequest.GetSide()),
+		"size":        placeOrderRequest.GetSize(),
+		"stp":         placeOrderRequest.GetSTP(),
+		"symbol":      placeOrderRequest.GetSymbol(),
+		"timeInForce": string(placeOrderRequest.GetTimeInForce()),
+		"tradeType":   string(placeOrderRequest.GetTradeType()),
+		"visibleSize": placeOrderRequest.GetVisibleSize(),
+		"cancelAfter": placeOrderRequest.GetCancelAfter(),
+		"hidden":      placeOrderRequest.GetHidden(),
+		"iceberg":     placeOrderRequest.GetIceBerg(),
+		"postOnly":    placeOrderRequest.GetPostOnly(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (placeOrderRequest *placeOrderRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(placeOrderRequest.GetMap())
+}
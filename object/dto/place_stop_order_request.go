@@ -0,0 +1,268 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	// PlaceStopOrderRequester is an interface.
+	PlaceStopOrderRequester interface {
+		// GetClientOID is a function.
+		GetClientOID() string
+		// GetFunds is a function.
+		GetFunds() string
+		// GetOrderType is a function.
+		GetOrderType() object.OrderTypeType
+		// GetPrice is a function.
+		GetPrice() string
+		// GetRemark is a function.
+		GetRemark() string
+		// GetSide is a function.
+		GetSide() object.OrderSideType
+		// GetSize is a function.
+		GetSize() string
+		// GetStop is a function.
+		GetStop() object.OrderStopType
+		// GetStopPrice is a function.
+		GetStopPrice() string
+		// GetSTP is a function.
+		GetSTP() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetTimeInForce is a function.
+		GetTimeInForce() object.TimeInForceType
+		// GetTradeType is a function.
+		GetTradeType() object.OrderTypeType
+		// GetVisibleSize is a function.
+		GetVisibleSize() string
+		// GetCancelAfter is a function.
+		GetCancelAfter() int64
+		// GetHidden is a function.
+		GetHidden() bool
+		// GetIceBerg is a function.
+		GetIceBerg() bool
+		// GetPostOnly is a function.
+		GetPostOnly() bool
+	}
+
+	placeStopOrderRequest struct {
+		clientOID   string
+		funds       string
+		orderType   object.OrderTypeType
+		price       string
+		remark      string
+		side        object.OrderSideType
+		size        string
+		stop        object.OrderStopType
+		stopPrice   string
+		stp         string
+		symbol      string
+		timeInForce object.TimeInForceType
+		tradeType   object.OrderTypeType
+		visibleSize string
+		cancelAfter int64
+		hidden      bool
+		iceBerg     bool
+		postOnly    bool
+	}
+)
+
+var (
+	_ PlaceOrderRequester     = (*placeStopOrderRequest)(nil)
+	_ PlaceStopOrderRequester = (*placeStopOrderRequest)(nil)
+	_ json.Marshaler          = (*placeStopOrderRequest)(nil)
+	_ object.GetMap           = (*placeStopOrderRequest)(nil)
+)
+
+// NewPlaceStopOrderRequest is a function.
+// The order is sent to the book once the last trade price reaches stopPrice: a
+// loss stop waits for the price to move against the side, an entry stop for it to
+// move with the side.
+func NewPlaceStopOrderRequest(
+	clientOID string,
+	funds string,
+	orderType object.OrderTypeType,
+	price string,
+	remark string,
+	side object.OrderSideType,
+	size string,
+	stop object.OrderStopType,
+	stopPrice string,
+	stp string,
+	symbol string,
+	timeInForce object.TimeInForceType,
+	tradeType object.OrderTypeType,
+	visibleSize string,
+	cancelAfter int64,
+	hidden bool,
+	iceBerg bool,
+	postOnly bool,
+) *placeStopOrderRequest {
+	return &placeStopOrderRequest{
+		clientOID:   clientOID,
+		funds:       funds,
+		orderType:   orderType,
+		price:       price,
+		remark:      remark,
+		side:        side,
+		size:        size,
+		stop:        stop,
+		stopPrice:   stopPrice,
+		stp:         stp,
+		symbol:      symbol,
+		timeInForce: timeInForce,
+		tradeType:   tradeType,
+		visibleSize: visibleSize,
+		cancelAfter: cancelAfter,
+		hidden:      hidden,
+		iceBerg:     iceBerg,
+		postOnly:    postOnly,
+	}
+}
+
+// PlaceStopOrderRequesterComparer is a function.
+func PlaceStopOrderRequesterComparer(
+	first PlaceStopOrderRequester,
+	second PlaceStopOrderRequester,
+) bool {
+	return first.GetClientOID() == second.GetClientOID() &&
+		first.GetFunds() == second.GetFunds() &&
+		first.GetOrderType() == second.GetOrderType() &&
+		first.GetPrice() == second.GetPrice() &&
+		first.GetRemark() == second.GetRemark() &&
+		first.GetSide() == second.GetSide() &&
+		first.GetSize() == second.GetSize() &&
+		first.GetStop() == second.GetStop() &&
+		first.GetStopPrice() == second.GetStopPrice() &&
+		first.GetSTP() == second.GetSTP() &&
+		first.GetSymbol() == second.GetSymbol() &&
+		first.GetTimeInForce() == second.GetTimeInForce() &&
+		first.GetTradeType() == second.GetTradeType() &&
+		first.GetVisibleSize() == second.GetVisibleSize() &&
+		first.GetCancelAfter() == second.GetCancelAfter() &&
+		first.GetHidden() == second.GetHidden() &&
+		first.GetIceBerg() == second.GetIceBerg() &&
+		first.GetPostOnly() == second.GetPostOnly()
+}
+
+// GetClientOID is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetClientOID() string {
+	return placeStopOrderRequest.clientOID
+}
+
+// GetFunds is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetFunds() string {
+	return placeStopOrderRequest.funds
+}
+
+// GetOrderType is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetOrderType() object.OrderTypeType {
+	return placeStopOrderRequest.orderType
+}
+
+// GetPrice is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetPrice() string {
+	return placeStopOrderRequest.price
+}
+
+// GetRemark is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetRemark() string {
+	return placeStopOrderRequest.remark
+}
+
+// GetSide is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetSide() object.OrderSideType {
+	return placeStopOrderRequest.side
+}
+
+// GetSize is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetSize() string {
+	return placeStopOrderRequest.size
+}
+
+// GetStop is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetStop() object.OrderStopType {
+	return placeStopOrderRequest.stop
+}
+
+// GetStopPrice is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetStopPrice() string {
+	return placeStopOrderRequest.stopPrice
+}
+
+// GetSTP is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetSTP() string {
+	return placeStopOrderRequest.stp
+}
+
+// GetSymbol is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetSymbol() string {
+	return placeStopOrderRequest.symbol
+}
+
+// GetTimeInForce is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetTimeInForce() object.TimeInForceType {
+	return placeStopOrderRequest.timeInForce
+}
+
+// GetTradeType is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetTradeType() object.OrderTypeType {
+	return placeStopOrderRequest.tradeType
+}
+
+// GetVisibleSize is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetVisibleSize() string {
+	return placeStopOrderRequest.visibleSize
+}
+
+// GetCancelAfter is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetCancelAfter() int64 {
+	return placeStopOrderRequest.cancelAfter
+}
+
+// GetHidden is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetHidden() bool {
+	return placeStopOrderRequest.hidden
+}
+
+// GetIceBerg is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetIceBerg() bool {
+	return placeStopOrderRequest.iceBerg
+}
+
+// GetPostOnly is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetPostOnly() bool {
+	return placeStopOrderRequest.postOnly
+}
+
+// GetMap is a function.
+func (placeStopOrderRequest *placeStopOrderRequest) GetMap() map[string]any {
+	return map[string]any{
+		"clientOid":   placeStopOrderRequest.GetClientOID(),
+		"funds":       placeStopOrderRequest.GetFunds(),
+		"type":        string(placeStopOrderRequest.GetOrderType()),
+		"price":       placeStopOrderRequest.GetPrice(),
+		"remark":      placeStopOrderRequest.GetRemark(),
+		"side":        string(placeStopOrderRequest.GetSide()),
+		"size":        placeStopOrderRequest.GetSize(),
+		"stop":        string(placeStopOrderRequest.GetStop()),
+		"stopPrice":   placeStopOrderRequest.GetStopPrice(),
+		"stp":         placeStopOrderRequest.GetSTP(),
+		"symbol":      placeStopOrderRequest.GetSymbol(),
+		"timeInForce": string(placeStopOrderRequest.GetTimeInForce()),
+		"tradeType":   string(placeStopOrderRequest.GetTradeType()),
+		"visibleSize": placeStopOrderRequest.GetVisibleSize(),
+		"cancelAfter": placeStopOrderRequest.GetCancelAfter(),
+		"hidden":      placeStopOrderRequest.GetHidden(),
+		"iceberg":     placeStopOrderRequest.GetIceBerg(),
+		"postOnly":    placeStopOrderRequest.GetPostOnly(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (placeStopOrderRequest *placeStopOrderRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(placeStopOrderRequest.GetMap())
+}
@@ -0,0 +1,167 @@
+package object
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type (
+	// KucoinErrorer is an interface.
+	KucoinErrorer interface {
+		error
+		// GetCode is a function.
+		GetCode() string
+		// GetMessage is a function.
+		GetMessage() string
+		// GetRetryable is a function.
+		GetRetryable() bool
+		// Unwrap is a function.
+		Unwrap() error
+	}
+
+	kucoinError struct {
+		cause   error
+		err     error
+		code    string
+		message string
+	}
+)
+
+var _ KucoinErrorer = (*kucoinError)(nil)
+
+// kucoinErrorCodes are the kinds of the codes KuCoin answers with. The codes
+// missing from them are told apart by their message or their prefix.
+var kucoinErrorCodes = map[string]error{
+	URIKucoinCodeAPIKeyNotExist:            ErrKucoinAuth,
+	URIKucoinCodeAccessDenied:              ErrKucoinAuth,
+	URIKucoinCodeBalanceInsufficient:       ErrKucoinBalanceInsufficient,
+	URIKucoinCodeHeaderMissing:             ErrKucoinAuth,
+	URIKucoinCodeIPForbidden:               ErrKucoinAuth,
+	URIKucoinCodeMarginBalanceInsufficient: ErrKucoinBalanceInsufficient,
+	URIKucoinCodeNotFound:                  ErrKucoinNotFound,
+	URIKucoinCodePassPhraseInvalid:         ErrKucoinAuth,
+	URIKucoinCodeSignatureInvalid:          ErrKucoinAuth,
+	URIKucoinCodeSymbolNotExist:            ErrKucoinNotFound,
+	URIKucoinCodeTimestampInvalid:          ErrKucoinAuth,
+	URIKucoinCodeTooManyRequests:           ErrKucoinRateLimit,
+	URIKucoinCodeUserFrozen:                ErrKucoinAuth,
+}
+
+// NewKucoinError is a function.
+// It sorts the code and the message KuCoin answered with into one of the kinds
+// of failure, which errors.Is matches. The invalid parameter code also covers
+// a missing order, a duplicated clientOid and an invalid increment, so those
+// are told apart by the message.
+func NewKucoinError(
+	code string,
+	message string,
+) *kucoinError {
+	err, ok := kucoinErrorCodes[code]
+
+	switch {
+	case ok:
+	case code == URIKucoinCodeInvalidParameter &&
+		strings.Contains(message, URIKucoinMessageOrderNotExist):
+		err = ErrKucoinOrderNotFound
+	case code == URIKucoinCodeInvalidParameter &&
+		strings.Contains(message, URIKucoinMessageClientOIDDuplicated):
+		err = ErrKucoinClientOIDDuplicated
+	case code == URIKucoinCodeInvalidParameter &&
+		strings.Contains(message, URIKucoinMessagePrecision):
+		err = ErrKucoinInvalidPrecision
+	case code == URIKucoinCodeInvalidParameter:
+		err = ErrKucoinInvalidParameter
+	case strings.HasPrefix(code, URIKucoinCodeServerPrefix):
+		err = ErrKucoinSystemBusy
+	default:
+		err = ErrKucoinRequest
+	}
+
+	return &kucoinError{
+		cause:   nil,
+		err:     err,
+		code:    code,
+		message: message,
+	}
+}
+
+// NewKucoinTransportError is a function.
+// No answer was read, so the request may or may not have been processed.
+func NewKucoinTransportError(
+	message string,
+) *kucoinError {
+	return &kucoinError{
+		cause:   nil,
+		err:     ErrKucoinTransport,
+		code:    URIEmpty,
+		message: message,
+	}
+}
+
+// WrapKucoinTransportError is a function.
+// It is the transport failure that cause led to, which errors.Is and errors.As
+// still match, so a canceled context is not lost behind it.
+func WrapKucoinTransportError(
+	cause error,
+) *kucoinError {
+	return &kucoinError{
+		cause:   cause,
+		err:     ErrKucoinTransport,
+		code:    URIEmpty,
+		message: cause.Error(),
+	}
+}
+
+// Error is a function.
+func (err *kucoinError) Error() string {
+	return fmt.Sprintf(
+		"%s: code %s: %s",
+		err.Unwrap().Error(),
+		err.GetCode(),
+		err.GetMessage(),
+	)
+}
+
+// GetCode is a function.
+func (err *kucoinError) GetCode() string {
+	return err.code
+}
+
+// GetMessage is a function.
+func (err *kucoinError) GetMessage() string {
+	return err.message
+}
+
+// GetRetryable is a function.
+// The same request may succeed later only when the exchange was busy, limited
+// the rate or was not reached at all. Whether it is safe to send it again is
+// up to the caller, since a request that was not answered may have been
+// processed.
+func (err *kucoinError) GetRetryable() bool {
+	return errors.Is(err, ErrKucoinRateLimit) ||
+		errors.Is(err, ErrKucoinSystemBusy) ||
+		errors.Is(err, ErrKucoinTransport)
+}
+
+// Is is a function.
+// read more https://pkg.go.dev/errors
+func (err *kucoinError) Is(
+	target error,
+) bool {
+	return err.cause != nil && errors.Is(err.cause, target)
+}
+
+// As is a function.
+// read more https://pkg.go.dev/errors
+func (err *kucoinError) As(
+	target any,
+) bool {
+	return err.cause != nil && errors.As(err.cause, target)
+}
+
+// Unwrap is a function.
+// read more https://pkg.go.dev/errors
+func (err *kucoinError) Unwrap() error {
+	return err.err
+}
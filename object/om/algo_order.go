@@ -0,0 +1,343 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// AlgoOrderer is an interface.
+	AlgoOrderer interface {
+		OMer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetAlgoType is a function.
+		GetAlgoType() string
+		// GetAveragePrice is a function.
+		GetAveragePrice() string
+		// GetChildClientOID is a function.
+		GetChildClientOID() string
+		// GetChildDealFunds is a function.
+		GetChildDealFunds() string
+		// GetChildDealSize is a function.
+		GetChildDealSize() string
+		// GetClientOID is a function.
+		GetClientOID() string
+		// GetDealFunds is a function.
+		GetDealFunds() string
+		// GetDealSize is a function.
+		GetDealSize() string
+		// GetLimitPrice is a function.
+		GetLimitPrice() string
+		// GetReason is a function.
+		GetReason() string
+		// GetSchedule is a function.
+		GetSchedule() string
+		// GetSide is a function.
+		GetSide() string
+		// GetSize is a function.
+		GetSize() string
+		// GetState is a function.
+		GetState() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetVisibleSize is a function.
+		GetVisibleSize() string
+		// GetChildAt is a function.
+		GetChildAt() int64
+		// GetChildCount is a function.
+		GetChildCount() int64
+		// GetEndAt is a function.
+		GetEndAt() int64
+		// GetSlices is a function.
+		GetSlices() int64
+		// GetStartAt is a function.
+		GetStartAt() int64
+		// GetIsActive is a function.
+		GetIsActive() bool
+		// GetPaper is a function.
+		GetPaper() bool
+	}
+
+	algoOrder struct {
+		account        string
+		algoType       string
+		averagePrice   string
+		childClientOID string
+		childDealFunds string
+		childDealSize  string
+		clientOID      string
+		dealFunds      string
+		dealSize       string
+		limitPrice     string
+		reason         string
+		schedule       string
+		side           string
+		size           string
+		state          string
+		symbol         string
+		visibleSize    string
+		childAt        int64
+		childCount     int64
+		endAt          int64
+		slices         int64
+		startAt        int64
+		isActive       bool
+		paper          bool
+		id             uuid.UUID
+	}
+)
+
+var _ AlgoOrderer = (*algoOrder)(nil)
+
+// NewAlgoOrder is a function.
+func NewAlgoOrder(
+	account string,
+	algoType string,
+	averagePrice string,
+	childClientOID string,
+	childDealFunds string,
+	childDealSize string,
+	clientOID string,
+	dealFunds string,
+	dealSize string,
+	limitPrice string,
+	reason string,
+	schedule string,
+	side string,
+	size string,
+	state string,
+	symbol string,
+	visibleSize string,
+	childAt int64,
+	childCount int64,
+	endAt int64,
+	slices int64,
+	startAt int64,
+	isActive bool,
+	paper bool,
+	id uuid.UUID,
+) *algoOrder {
+	return &algoOrder{
+		account:        account,
+		algoType:       algoType,
+		averagePrice:   averagePrice,
+		childClientOID: childClientOID,
+		childDealFunds: childDealFunds,
+		childDealSize:  childDealSize,
+		clientOID:      clientOID,
+		dealFunds:      dealFunds,
+		dealSize:       dealSize,
+		limitPrice:     limitPrice,
+		reason:         reason,
+		schedule:       schedule,
+		side:           side,
+		size:           size,
+		state:          state,
+		symbol:         symbol,
+		visibleSize:    visibleSize,
+		childAt:        childAt,
+		childCount:     childCount,
+		endAt:          endAt,
+		slices:         slices,
+		startAt:        startAt,
+		isActive:       isActive,
+		paper:          paper,
+		id:             id,
+	}
+}
+
+// AlgoOrdererComparer is a function.
+func AlgoOrdererComparer(
+	first AlgoOrderer,
+	second AlgoOrderer,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetAccount() == second.GetAccount() &&
+		first.GetAlgoType() == second.GetAlgoType() &&
+		first.GetAveragePrice() == second.GetAveragePrice() &&
+		first.GetChildClientOID() == second.GetChildClientOID() &&
+		first.GetChildDealFunds() == second.GetChildDealFunds() &&
+		first.GetChildDealSize() == second.GetChildDealSize() &&
+		first.GetClientOID() == second.GetClientOID() &&
+		first.GetDealFunds() == second.GetDealFunds() &&
+		first.GetDealSize() == second.GetDealSize() &&
+		first.GetLimitPrice() == second.GetLimitPrice() &&
+		first.GetReason() == second.GetReason() &&
+		first.GetSchedule() == second.GetSchedule() &&
+		first.GetSide() == second.GetSide() &&
+		first.GetSize() == second.GetSize() &&
+		first.GetState() == second.GetState() &&
+		first.GetSymbol() == second.GetSymbol() &&
+		first.GetVisibleSize() == second.GetVisibleSize() &&
+		first.GetChildAt() == second.GetChildAt() &&
+		first.GetChildCount() == second.GetChildCount() &&
+		first.GetEndAt() == second.GetEndAt() &&
+		first.GetSlices() == second.GetSlices() &&
+		first.GetStartAt() == second.GetStartAt() &&
+		first.GetIsActive() == second.GetIsActive() &&
+		first.GetPaper() == second.GetPaper()
+}
+
+// GetID is a function.
+func (algoOrder *algoOrder) GetID() uuid.UUID {
+	return algoOrder.id
+}
+
+// GetAccount is a function.
+func (algoOrder *algoOrder) GetAccount() string {
+	return algoOrder.account
+}
+
+// GetAlgoType is a function.
+func (algoOrder *algoOrder) GetAlgoType() string {
+	return algoOrder.algoType
+}
+
+// GetAveragePrice is a function.
+func (algoOrder *algoOrder) GetAveragePrice() string {
+	return algoOrder.averagePrice
+}
+
+// GetChildClientOID is a function.
+func (algoOrder *algoOrder) GetChildClientOID() string {
+	return algoOrder.childClientOID
+}
+
+// GetChildDealFunds is a function.
+func (algoOrder *algoOrder) GetChildDealFunds() string {
+	return algoOrder.childDealFunds
+}
+
+// GetChildDealSize is a function.
+func (algoOrder *algoOrder) GetChildDealSize() string {
+	return algoOrder.childDealSize
+}
+
+// GetClientOID is a function.
+func (algoOrder *algoOrder) GetClientOID() string {
+	return algoOrder.clientOID
+}
+
+// GetDealFunds is a function.
+func (algoOrder *algoOrder) GetDealFunds() string {
+	return algoOrder.dealFunds
+}
+
+// GetDealSize is a function.
+func (algoOrder *algoOrder) GetDealSize() string {
+	return algoOrder.dealSize
+}
+
+// GetLimitPrice is a function.
+func (algoOrder *algoOrder) GetLimitPrice() string {
+	return algoOrder.limitPrice
+}
+
+// GetReason is a function.
+func (algoOrder *algoOrder) GetReason() string {
+	return algoOrder.reason
+}
+
+// GetSchedule is a function.
+func (algoOrder *algoOrder) GetSchedule() string {
+	return algoOrder.schedule
+}
+
+// GetSide is a function.
+func (algoOrder *algoOrder) GetSide() string {
+	return algoOrder.side
+}
+
+// GetSize is a function.
+func (algoOrder *algoOrder) GetSize() string {
+	return algoOrder.size
+}
+
+// GetState is a function.
+func (algoOrder *algoOrder) GetState() string {
+	return algoOrder.state
+}
+
+// GetSymbol is a function.
+func (algoOrder *algoOrder) GetSymbol() string {
+	return algoOrder.symbol
+}
+
+// GetVisibleSize is a function.
+func (algoOrder *algoOrder) GetVisibleSize() string {
+	return algoOrder.visibleSize
+}
+
+// GetChildAt is a function.
+func (algoOrder *algoOrder) GetChildAt() int64 {
+	return algoOrder.childAt
+}
+
+// GetChildCount is a function.
+func (algoOrder *algoOrder) GetChildCount() int64 {
+	return algoOrder.childCount
+}
+
+// GetEndAt is a function.
+func (algoOrder *algoOrder) GetEndAt() int64 {
+	return algoOrder.endAt
+}
+
+// GetSlices is a function.
+func (algoOrder *algoOrder) GetSlices() int64 {
+	return algoOrder.slices
+}
+
+// GetStartAt is a function.
+func (algoOrder *algoOrder) GetStartAt() int64 {
+	return algoOrder.startAt
+}
+
+// GetIsActive is a function.
+func (algoOrder *algoOrder) GetIsActive() bool {
+	return algoOrder.isActive
+}
+
+// GetPaper is a function.
+func (algoOrder *algoOrder) GetPaper() bool {
+	return algoOrder.paper
+}
+
+// GetMap is a function.
+func (algoOrder *algoOrder) GetMap() map[string]any {
+	return map[string]any{
+		"id":               algoOrder.GetID(),
+		"account":          algoOrder.GetAccount(),
+		"algo_type":        algoOrder.GetAlgoType(),
+		"average_price":    algoOrder.GetAveragePrice(),
+		"child_client_oid": algoOrder.GetChildClientOID(),
+		"child_deal_funds": algoOrder.GetChildDealFunds(),
+		"child_deal_size":  algoOrder.GetChildDealSize(),
+		"client_oid":       algoOrder.GetClientOID(),
+		"deal_funds":       algoOrder.GetDealFunds(),
+		"deal_size":        algoOrder.GetDealSize(),
+		"limit_price":      algoOrder.GetLimitPrice(),
+		"reason":           algoOrder.GetReason(),
+		"schedule":         algoOrder.GetSchedule(),
+		"side":             algoOrder.GetSide(),
+		"size":             algoOrder.GetSize(),
+		"state":            algoOrder.GetState(),
+		"symbol":           algoOrder.GetSymbol(),
+		"visible_size":     algoOrder.GetVisibleSize(),
+		"child_at":         algoOrder.GetChildAt(),
+		"child_count":      algoOrder.GetChildCount(),
+		"end_at":           algoOrder.GetEndAt(),
+		"slices":           algoOrder.GetSlices(),
+		"start_at":         algoOrder.GetStartAt(),
+		"is_active":        algoOrder.GetIsActive(),
+		"paper":            algoOrder.GetPaper(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (algoOrder *algoOrder) MarshalJSON() ([]byte, error) {
+	return json.Marshal(algoOrder.GetMap())
+}
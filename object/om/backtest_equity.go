@@ -0,0 +1,103 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// BacktestEquitier is an interface.
+	BacktestEquitier interface {
+		OMer
+		// GetTime is a function.
+		GetTime() int64
+		// GetCash is a function.
+		GetCash() float64
+		// GetEquity is a function.
+		GetEquity() float64
+		// GetPositionValue is a function.
+		GetPositionValue() float64
+	}
+
+	backtestEquity struct {
+		time          int64
+		cash          float64
+		equity        float64
+		positionValue float64
+		id            uuid.UUID
+	}
+)
+
+var _ BacktestEquitier = (*backtestEquity)(nil)
+
+// NewBacktestEquity is a function.
+func NewBacktestEquity(
+	time int64,
+	cash float64,
+	equity float64,
+	positionValue float64,
+	id uuid.UUID,
+) *backtestEquity {
+	return &backtestEquity{
+		time:          time,
+		cash:          cash,
+		equity:        equity,
+		positionValue: positionValue,
+		id:            id,
+	}
+}
+
+// BacktestEquitierComparer is a function.
+func BacktestEquitierComparer(
+	first BacktestEquitier,
+	second BacktestEquitier,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetTime() == second.GetTime() &&
+		first.GetCash() == second.GetCash() &&
+		first.GetEquity() == second.GetEquity() &&
+		first.GetPositionValue() == second.GetPositionValue()
+}
+
+// GetID is a function.
+func (backtestEquity *backtestEquity) GetID() uuid.UUID {
+	return backtestEquity.id
+}
+
+// GetTime is a function.
+func (backtestEquity *backtestEquity) GetTime() int64 {
+	return backtestEquity.time
+}
+
+// GetCash is a function.
+func (backtestEquity *backtestEquity) GetCash() float64 {
+	return backtestEquity.cash
+}
+
+// GetEquity is a function.
+func (backtestEquity *backtestEquity) GetEquity() float64 {
+	return backtestEquity.equity
+}
+
+// GetPositionValue is a function.
+func (backtestEquity *backtestEquity) GetPositionValue() float64 {
+	return backtestEquity.positionValue
+}
+
+// GetMap is a function.
+func (backtestEquity *backtestEquity) GetMap() map[string]any {
+	return map[string]any{
+		"id":             backtestEquity.GetID(),
+		"time":           backtestEquity.GetTime(),
+		"cash":           backtestEquity.GetCash(),
+		"equity":         backtestEquity.GetEquity(),
+		"position_value": backtestEquity.GetPositionValue(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (backtestEquity *backtestEquity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(backtestEquity.GetMap())
+}
@@ -0,0 +1,151 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// BacktestStatisticer is an interface.
+	BacktestStatisticer interface {
+		OMer
+		// GetExposure is a function.
+		GetExposure() float64
+		// GetFinalEquity is a function.
+		GetFinalEquity() float64
+		// GetInitialEquity is a function.
+		GetInitialEquity() float64
+		// GetMaxDrawdown is a function.
+		GetMaxDrawdown() float64
+		// GetTotalReturn is a function.
+		GetTotalReturn() float64
+		// GetSharpe is a function.
+		GetSharpe() float64
+		// GetWinRate is a function.
+		GetWinRate() float64
+		// GetTradeCount is a function.
+		GetTradeCount() uint32
+	}
+
+	backtestStatistic struct {
+		exposure      float64
+		finalEquity   float64
+		initialEquity float64
+		maxDrawdown   float64
+		totalReturn   float64
+		sharpe        float64
+		winRate       float64
+		tradeCount    uint32
+		id            uuid.UUID
+	}
+)
+
+var _ BacktestStatisticer = (*backtestStatistic)(nil)
+
+// NewBacktestStatistic is a function.
+func NewBacktestStatistic(
+	exposure float64,
+	finalEquity float64,
+	initialEquity float64,
+	maxDrawdown float64,
+	totalReturn float64,
+	sharpe float64,
+	winRate float64,
+	tradeCount uint32,
+	id uuid.UUID,
+) *backtestStatistic {
+	return &backtestStatistic{
+		exposure:      exposure,
+		finalEquity:   finalEquity,
+		initialEquity: initialEquity,
+		maxDrawdown:   maxDrawdown,
+		totalReturn:   totalReturn,
+		sharpe:        sharpe,
+		winRate:       winRate,
+		tradeCount:    tradeCount,
+		id:            id,
+	}
+}
+
+// BacktestStatisticerComparer is a function.
+func BacktestStatisticerComparer(
+	first BacktestStatisticer,
+	second BacktestStatisticer,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetExposure() == second.GetExposure() &&
+		first.GetFinalEquity() == second.GetFinalEquity() &&
+		first.GetInitialEquity() == second.GetInitialEquity() &&
+		first.GetMaxDrawdown() == second.GetMaxDrawdown() &&
+		first.GetTotalReturn() == second.GetTotalReturn() &&
+		first.GetSharpe() == second.GetSharpe() &&
+		first.GetWinRate() == second.GetWinRate() &&
+		first.GetTradeCount() == second.GetTradeCount()
+}
+
+// GetID is a function.
+func (backtestStatistic *backtestStatistic) GetID() uuid.UUID {
+	return backtestStatistic.id
+}
+
+// GetExposure is a function.
+func (backtestStatistic *backtestStatistic) GetExposure() float64 {
+	return backtestStatistic.exposure
+}
+
+// GetFinalEquity is a function.
+func (backtestStatistic *backtestStatistic) GetFinalEquity() float64 {
+	return backtestStatistic.finalEquity
+}
+
+// GetInitialEquity is a function.
+func (backtestStatistic *backtestStatistic) GetInitialEquity() float64 {
+	return backtestStatistic.initialEquity
+}
+
+// GetMaxDrawdown is a function.
+func (backtestStatistic *backtestStatistic) GetMaxDrawdown() float64 {
+	return backtestStatistic.maxDrawdown
+}
+
+// GetTotalReturn is a function.
+func (backtestStatistic *backtestStatistic) GetTotalReturn() float64 {
+	return backtestStatistic.totalReturn
+}
+
+// GetSharpe is a function.
+func (backtestStatistic *backtestStatistic) GetSharpe() float64 {
+	return backtestStatistic.sharpe
+}
+
+// GetWinRate is a function.
+func (backtestStatistic *backtestStatistic) GetWinRate() float64 {
+	return backtestStatistic.winRate
+}
+
+// GetTradeCount is a function.
+func (backtestStatistic *backtestStatistic) GetTradeCount() uint32 {
+	return backtestStatistic.tradeCount
+}
+
+// GetMap is a function.
+func (backtestStatistic *backtestStatistic) GetMap() map[string]any {
+	return map[string]any{
+		"id":             backtestStatistic.GetID(),
+		"exposure":       backtestStatistic.GetExposure(),
+		"final_equity":   backtestStatistic.GetFinalEquity(),
+		"initial_equity": backtestStatistic.GetInitialEquity(),
+		"max_drawdown":   backtestStatistic.GetMaxDrawdown(),
+		"total_return":   backtestStatistic.GetTotalReturn(),
+		"sharpe":         backtestStatistic.GetSharpe(),
+		"win_rate":       backtestStatistic.GetWinRate(),
+		"trade_count":    backtestStatistic.GetTradeCount(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (backtestStatistic *backtestStatistic) MarshalJSON() ([]byte, error) {
+	return json.Marshal(backtestStatistic.GetMap())
+}
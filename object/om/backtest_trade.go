@@ -0,0 +1,199 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// BacktestTrader is an interface.
+	BacktestTrader interface {
+		OMer
+		// GetExitReason is a function.
+		GetExitReason() string
+		// GetReason is a function.
+		GetReason() string
+		// GetStrategy is a function.
+		GetStrategy() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetEntryAt is a function.
+		GetEntryAt() int64
+		// GetExitAt is a function.
+		GetExitAt() int64
+		// GetEntryPrice is a function.
+		GetEntryPrice() float64
+		// GetExitPrice is a function.
+		GetExitPrice() float64
+		// GetFee is a function.
+		GetFee() float64
+		// GetProfit is a function.
+		GetProfit() float64
+		// GetReturnRate is a function.
+		GetReturnRate() float64
+		// GetSize is a function.
+		GetSize() float64
+	}
+
+	backtestTrade struct {
+		exitReason string
+		reason     string
+		strategy   string
+		symbol     string
+		entryAt    int64
+		exitAt     int64
+		entryPrice float64
+		exitPrice  float64
+		fee        float64
+		profit     float64
+		returnRate float64
+		size       float64
+		id         uuid.UUID
+	}
+)
+
+var _ BacktestTrader = (*backtestTrade)(nil)
+
+// NewBacktestTrade is a function.
+func NewBacktestTrade(
+	exitReason string,
+	reason string,
+	strategy string,
+	symbol string,
+	entryAt int64,
+	exitAt int64,
+	entryPrice float64,
+	exitPrice float64,
+	fee float64,
+	profit float64,
+	returnRate float64,
+	size float64,
+	id uuid.UUID,
+) *backtestTrade {
+	return &backtestTrade{
+		exitReason: exitReason,
+		reason:     reason,
+		strategy:   strategy,
+		symbol:     symbol,
+		entryAt:    entryAt,
+		exitAt:     exitAt,
+		entryPrice: entryPrice,
+		exitPrice:  exitPrice,
+		fee:        fee,
+		profit:     profit,
+		returnRate: returnRate,
+		size:       size,
+		id:         id,
+	}
+}
+
+// BacktestTraderComparer is a function.
+func BacktestTraderComparer(
+	first BacktestTrader,
+	second BacktestTrader,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetExitReason() == second.GetExitReason() &&
+		first.GetReason() == second.GetReason() &&
+		first.GetStrategy() == second.GetStrategy() &&
+		first.GetSymbol() == second.GetSymbol() &&
+		first.GetEntryAt() == second.GetEntryAt() &&
+		first.GetExitAt() == second.GetExitAt() &&
+		first.GetEntryPrice() == second.GetEntryPrice() &&
+		first.GetExitPrice() == second.GetExitPrice() &&
+		first.GetFee() == second.GetFee() &&
+		first.GetProfit() == second.GetProfit() &&
+		first.GetReturnRate() == second.GetReturnRate() &&
+		first.GetSize() == second.GetSize()
+}
+
+// GetID is a function.
+func (backtestTrade *backtestTrade) GetID() uuid.UUID {
+	return backtestTrade.id
+}
+
+// GetExitReason is a function.
+func (backtestTrade *backtestTrade) GetExitReason() string {
+	return backtestTrade.exitReason
+}
+
+// GetReason is a function.
+func (backtestTrade *backtestTrade) GetReason() string {
+	return backtestTrade.reason
+}
+
+// GetStrategy is a function.
+func (backtestTrade *backtestTrade) GetStrategy() string {
+	return backtestTrade.strategy
+}
+
+// GetSymbol is a function.
+func (backtestTrade *backtestTrade) GetSymbol() string {
+	return backtestTrade.symbol
+}
+
+// GetEntryAt is a function.
+func (backtestTrade *backtestTrade) GetEntryAt() int64 {
+	return backtestTrade.entryAt
+}
+
+// GetExitAt is a function.
+func (backtestTrade *backtestTrade) GetExitAt() int64 {
+	return backtestTrade.exitAt
+}
+
+// GetEntryPrice is a function.
+func (backtestTrade *backtestTrade) GetEntryPrice() float64 {
+	return backtestTrade.entryPrice
+}
+
+// GetExitPrice is a function.
+func (backtestTrade *backtestTrade) GetExitPrice() float64 {
+	return backtestTrade.exitPrice
+}
+
+// GetFee is a function.
+func (backtestTrade *backtestTrade) GetFee() float64 {
+	return backtestTrade.fee
+}
+
+// GetProfit is a function.
+func (backtestTrade *backtestTrade) GetProfit() float64 {
+	return backtestTrade.profit
+}
+
+// GetReturnRate is a function.
+func (backtestTrade *backtestTrade) GetReturnRate() float64 {
+	return backtestTrade.returnRate
+}
+
+// GetSize is a function.
+func (backtestTrade *backtestTrade) GetSize() float64 {
+	return backtestTrade.size
+}
+
+// GetMap is a function.
+func (backtestTrade *backtestTrade) GetMap() map[string]any {
+	return map[string]any{
+		"id":          backtestTrade.GetID(),
+		"exit_reason": backtestTrade.GetExitReason(),
+		"reason":      backtestTrade.GetReason(),
+		"strategy":    backtestTrade.GetStrategy(),
+		"symbol":      backtestTrade.GetSymbol(),
+		"entry_at":    backtestTrade.GetEntryAt(),
+		"exit_at":     backtestTrade.GetExitAt(),
+		"entry_price": backtestTrade.GetEntryPrice(),
+		"exit_price":  backtestTrade.GetExitPrice(),
+		"fee":         backtestTrade.GetFee(),
+		"profit":      backtestTrade.GetProfit(),
+		"return_rate": backtestTrade.GetReturnRate(),
+		"size":        backtestTrade.GetSize(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (backtestTrade *backtestTrade) MarshalJSON() ([]byte, error) {
+	return json.Marshal(backtestTrade.GetMap())
+}
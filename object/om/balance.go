@@ -0,0 +1,151 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Balancer is an interface.
+	Balancer interface {
+		OMer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetAvailable is a function.
+		GetAvailable() string
+		// GetBalance is a function.
+		GetBalance() string
+		// GetCurrency is a function.
+		GetCurrency() string
+		// GetHolds is a function.
+		GetHolds() string
+		// GetKucoinID is a function.
+		GetKucoinID() string
+		// GetKucoinType is a function.
+		GetKucoinType() string
+		// GetPaper is a function.
+		GetPaper() bool
+	}
+
+	balance struct {
+		account    string
+		available  string
+		balance    string
+		currency   string
+		holds      string
+		kucoinID   string
+		kucoinType string
+		paper      bool
+		id         uuid.UUID
+	}
+)
+
+var _ Balancer = (*balance)(nil)
+
+// NewBalance is a function.
+func NewBalance(
+	account string,
+	available string,
+	balanceValue string,
+	currency string,
+	holds string,
+	kucoinID string,
+	kucoinType string,
+	paper bool,
+	id uuid.UUID,
+) *balance {
+	return &balance{
+		account:    account,
+		available:  available,
+		balance:    balanceValue,
+		currency:   currency,
+		holds:      holds,
+		kucoinID:   kucoinID,
+		kucoinType: kucoinType,
+		paper:      paper,
+		id:         id,
+	}
+}
+
+// BalancerComparer is a function.
+func BalancerComparer(
+	first Balancer,
+	second Balancer,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetAccount() == second.GetAccount() &&
+		first.GetAvailable() == second.GetAvailable() &&
+		first.GetBalance() == second.GetBalance() &&
+		first.GetCurrency() == second.GetCurrency() &&
+		first.GetHolds() == second.GetHolds() &&
+		first.GetKucoinID() == second.GetKucoinID() &&
+		first.GetKucoinType() == second.GetKucoinType() &&
+		first.GetPaper() == second.GetPaper()
+}
+
+// GetID is a function.
+func (balance *balance) GetID() uuid.UUID {
+	return balance.id
+}
+
+// GetAccount is a function.
+func (balance *balance) GetAccount() string {
+	return balance.account
+}
+
+// GetAvailable is a function.
+func (balance *balance) GetAvailable() string {
+	return balance.available
+}
+
+// GetBalance is a function.
+func (balance *balance) GetBalance() string {
+	return balance.balance
+}
+
+// GetCurrency is a function.
+func (balance *balance) GetCurrency() string {
+	return balance.currency
+}
+
+// GetHolds is a function.
+func (balance *balance) GetHolds() string {
+	return balance.holds
+}
+
+// GetKucoinID is a function.
+func (balance *balance) GetKucoinID() string {
+	return balance.kucoinID
+}
+
+// GetKucoinType is a function.
+func (balance *balance) GetKucoinType() string {
+	return balance.kucoinType
+}
+
+// GetPaper is a function.
+func (balance *balance) GetPaper() bool {
+	return balance.paper
+}
+
+// GetMap is a function.
+func (balance *balance) GetMap() map[string]any {
+	return map[string]any{
+		"id":          balance.GetID(),
+		"account":     balance.GetAccount(),
+		"available":   balance.GetAvailable(),
+		"balance":     balance.GetBalance(),
+		"currency":    balance.GetCurrency(),
+		"holds":       balance.GetHolds(),
+		"kucoin_id":   balance.GetKucoinID(),
+		"kucoin_type": balance.GetKucoinType(),
+		"paper":       balance.GetPaper(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (balance *balance) MarshalJSON() ([]byte, error) {
+	return json.Marshal(balance.GetMap())
+}
@@ -0,0 +1,247 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Bracketer is an interface.
+	Bracketer interface {
+		OMer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetEntryClientOID is a function.
+		GetEntryClientOID() string
+		// GetExitClientOID is a function.
+		GetExitClientOID() string
+		// GetReason is a function.
+		GetReason() string
+		// GetSide is a function.
+		GetSide() string
+		// GetSize is a function.
+		GetSize() string
+		// GetState is a function.
+		GetState() string
+		// GetStopClientOID is a function.
+		GetStopClientOID() string
+		// GetStopPrice is a function.
+		GetStopPrice() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetTakeProfitClientOID is a function.
+		GetTakeProfitClientOID() string
+		// GetTakeProfitPrice is a function.
+		GetTakeProfitPrice() string
+		// GetTrailingDistance is a function.
+		GetTrailingDistance() string
+		// GetTrailingPrice is a function.
+		GetTrailingPrice() string
+		// GetIsActive is a function.
+		GetIsActive() bool
+		// GetPaper is a function.
+		GetPaper() bool
+	}
+
+	bracket struct {
+		account             string
+		entryClientOID      string
+		exitClientOID       string
+		reason              string
+		side                string
+		size                string
+		state               string
+		stopClientOID       string
+		stopPrice           string
+		symbol              string
+		takeProfitClientOID string
+		takeProfitPrice     string
+		trailingDistance    string
+		trailingPrice       string
+		isActive            bool
+		paper               bool
+		id                  uuid.UUID
+	}
+)
+
+var _ Bracketer = (*bracket)(nil)
+
+// NewBracket is a function.
+func NewBracket(
+	account string,
+	entryClientOID string,
+	exitClientOID string,
+	reason string,
+	side string,
+	size string,
+	state string,
+	stopClientOID string,
+	stopPrice string,
+	symbol string,
+	takeProfitClientOID string,
+	takeProfitPrice string,
+	trailingDistance string,
+	trailingPrice string,
+	isActive bool,
+	paper bool,
+	id uuid.UUID,
+) *bracket {
+	return &bracket{
+		account:             account,
+		entryClientOID:      entryClientOID,
+		exitClientOID:       exitClientOID,
+		reason:              reason,
+		side:                side,
+		size:                size,
+		state:               state,
+		stopClientOID:       stopClientOID,
+		stopPrice:           stopPrice,
+		symbol:              symbol,
+		takeProfitClientOID: takeProfitClientOID,
+		takeProfitPrice:     takeProfitPrice,
+		trailingDistance:    trailingDistance,
+		trailingPrice:       trailingPrice,
+		isActive:            isActive,
+		paper:               paper,
+		id:                  id,
+	}
+}
+
+// BracketerComparer is a function.
+func BracketerComparer(
+	first Bracketer,
+	second Bracketer,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetAccount() == second.GetAccount() &&
+		first.GetEntryClientOID() == second.GetEntryClientOID() &&
+		first.GetExitClientOID() == second.GetExitClientOID() &&
+		first.GetReason() == second.GetReason() &&
+		first.GetSide() == second.GetSide() &&
+		first.GetSize() == second.GetSize() &&
+		first.GetState() == second.GetState() &&
+		first.GetStopClientOID() == second.GetStopClientOID() &&
+		first.GetStopPrice() == second.GetStopPrice() &&
+		first.GetSymbol() == second.GetSymbol() &&
+		first.GetTakeProfitClientOID() == second.GetTakeProfitClientOID() &&
+		first.GetTakeProfitPrice() == second.GetTakeProfitPrice() &&
+		first.GetTrailingDistance() == second.GetTrailingDistance() &&
+		first.GetTrailingPrice() == second.GetTrailingPrice() &&
+		first.GetIsActive() == second.GetIsActive() &&
+		first.GetPaper() == second.GetPaper()
+}
+
+// GetID is a function.
+func (bracket *bracket) GetID() uuid.UUID {
+	return bracket.id
+}
+
+// GetAccount is a function.
+func (bracket *bracket) GetAccount() string {
+	return bracket.account
+}
+
+// GetEntryClientOID is a function.
+func (bracket *bracket) GetEntryClientOID() string {
+	return bracket.entryClientOID
+}
+
+// GetExitClientOID is a function.
+func (bracket *bracket) GetExitClientOID() string {
+	return bracket.exitClientOID
+}
+
+// GetReason is a function.
+func (bracket *bracket) GetReason() string {
+	return bracket.reason
+}
+
+// GetSide is a function.
+func (bracket *bracket) GetSide() string {
+	return bracket.side
+}
+
+// GetSize is a function.
+func (bracket *bracket) GetSize() string {
+	return bracket.size
+}
+
+// GetState is a function.
+func (bracket *bracket) GetState() string {
+	return bracket.state
+}
+
+// GetStopClientOID is a function.
+func (bracket *bracket) GetStopClientOID() string {
+	return bracket.stopClientOID
+}
+
+// GetStopPrice is a function.
+func (bracket *bracket) GetStopPrice() string {
+	return bracket.stopPrice
+}
+
+// GetSymbol is a function.
+func (bracket *bracket) GetSymbol() string {
+	return bracket.symbol
+}
+
+// GetTakeProfitClientOID is a function.
+func (bracket *bracket) GetTakeProfitClientOID() string {
+	return bracket.takeProfitClientOID
+}
+
+// GetTakeProfitPrice is a function.
+func (bracket *bracket) GetTakeProfitPrice() string {
+	return bracket.takeProfitPrice
+}
+
+// GetTrailingDistance is a function.
+func (bracket *bracket) GetTrailingDistance() string {
+	return bracket.trailingDistance
+}
+
+// GetTrailingPrice is a function.
+func (bracket *bracket) GetTrailingPrice() string {
+	return bracket.trailingPrice
+}
+
+// GetIsActive is a function.
+func (bracket *bracket) GetIsActive() bool {
+	return bracket.isActive
+}
+
+// GetPaper is a function.
+func (bracket *bracket) GetPaper() bool {
+	return bracket.paper
+}
+
+// GetMap is a function.
+func (bracket *bracket) GetMap() map[string]any {
+	return map[string]any{
+		"id":                     bracket.GetID(),
+		"account":                bracket.GetAccount(),
+		"entry_client_oid":       bracket.GetEntryClientOID(),
+		"exit_client_oid":        bracket.GetExitClientOID(),
+		"reason":                 bracket.GetReason(),
+		"side":                   bracket.GetSide(),
+		"size":                   bracket.GetSize(),
+		"state":                  bracket.GetState(),
+		"stop_client_oid":        bracket.GetStopClientOID(),
+		"stop_price":             bracket.GetStopPrice(),
+		"symbol":                 bracket.GetSymbol(),
+		"take_profit_client_oid": bracket.GetTakeProfitClientOID(),
+		"take_profit_price":      bracket.GetTakeProfitPrice(),
+		"trailing_distance":      bracket.GetTrailingDistance(),
+		"trailing_price":         bracket.GetTrailingPrice(),
+		"is_active":              bracket.GetIsActive(),
+		"paper":                  bracket.GetPaper(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (bracket *bracket) MarshalJSON() ([]byte, error) {
+	return json.Marshal(bracket.GetMap())
+}
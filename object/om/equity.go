@@ -0,0 +1,151 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Equitier is an interface.
+	Equitier interface {
+		OMer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetCurrency is a function.
+		GetCurrency() string
+		// GetEquity is a function.
+		GetEquity() string
+		// GetFee is a function.
+		GetFee() string
+		// GetRealizedPnL is a function.
+		GetRealizedPnL() string
+		// GetUnrealizedPnL is a function.
+		GetUnrealizedPnL() string
+		// GetSnapshotAt is a function.
+		GetSnapshotAt() int64
+		// GetPaper is a function.
+		GetPaper() bool
+	}
+
+	equity struct {
+		account       string
+		currency      string
+		equity        string
+		fee           string
+		realizedPnL   string
+		unrealizedPnL string
+		snapshotAt    int64
+		paper         bool
+		id            uuid.UUID
+	}
+)
+
+var _ Equitier = (*equity)(nil)
+
+// NewEquity is a function.
+func NewEquity(
+	account string,
+	currency string,
+	equityValue string,
+	fee string,
+	realizedPnL string,
+	unrealizedPnL string,
+	snapshotAt int64,
+	paper bool,
+	id uuid.UUID,
+) *equity {
+	return &equity{
+		account:       account,
+		currency:      currency,
+		equity:        equityValue,
+		fee:           fee,
+		realizedPnL:   realizedPnL,
+		unrealizedPnL: unrealizedPnL,
+		snapshotAt:    snapshotAt,
+		paper:         paper,
+		id:            id,
+	}
+}
+
+// EquitierComparer is a function.
+func EquitierComparer(
+	first Equitier,
+	second Equitier,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetAccount() == second.GetAccount() &&
+		first.GetCurrency() == second.GetCurrency() &&
+		first.GetEquity() == second.GetEquity() &&
+		first.GetFee() == second.GetFee() &&
+		first.GetRealizedPnL() == second.GetRealizedPnL() &&
+		first.GetUnrealizedPnL() == second.GetUnrealizedPnL() &&
+		first.GetSnapshotAt() == second.GetSnapshotAt() &&
+		first.GetPaper() == second.GetPaper()
+}
+
+// GetID is a function.
+func (equity *equity) GetID() uuid.UUID {
+	return equity.id
+}
+
+// GetAccount is a function.
+func (equity *equity) GetAccount() string {
+	return equity.account
+}
+
+// GetCurrency is a function.
+func (equity *equity) GetCurrency() string {
+	return equity.currency
+}
+
+// GetEquity is a function.
+func (equity *equity) GetEquity() string {
+	return equity.equity
+}
+
+// GetFee is a function.
+func (equity *equity) GetFee() string {
+	return equity.fee
+}
+
+// GetRealizedPnL is a function.
+func (equity *equity) GetRealizedPnL() string {
+	return equity.realizedPnL
+}
+
+// GetUnrealizedPnL is a function.
+func (equity *equity) GetUnrealizedPnL() string {
+	return equity.unrealizedPnL
+}
+
+// GetSnapshotAt is a function.
+func (equity *equity) GetSnapshotAt() int64 {
+	return equity.snapshotAt
+}
+
+// GetPaper is a function.
+func (equity *equity) GetPaper() bool {
+	return equity.paper
+}
+
+// GetMap is a function.
+func (equity *equity) GetMap() map[string]any {
+	return map[string]any{
+		"id":             equity.GetID(),
+		"account":        equity.GetAccount(),
+		"currency":       equity.GetCurrency(),
+		"equity":         equity.GetEquity(),
+		"fee":            equity.GetFee(),
+		"realized_pnl":   equity.GetRealizedPnL(),
+		"unrealized_pnl": equity.GetUnrealizedPnL(),
+		"snapshot_at":    equity.GetSnapshotAt(),
+		"paper":          equity.GetPaper(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (equity *equity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(equity.GetMap())
+}
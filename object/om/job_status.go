@@ -0,0 +1,152 @@
+package om
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// JobStatuser is an interface.
+	JobStatuser interface {
+		OMer
+		// GetError is a function.
+		GetError() string
+		// GetName is a function.
+		GetName() string
+		// GetState is a function.
+		GetState() string
+		// GetFinishedAt is a function.
+		GetFinishedAt() time.Time
+		// GetNextRunAt is a function.
+		GetNextRunAt() time.Time
+		// GetStartedAt is a function.
+		GetStartedAt() time.Time
+		// GetRunCount is a function.
+		GetRunCount() uint32
+		// GetSkipCount is a function.
+		GetSkipCount() uint32
+	}
+
+	jobStatus struct {
+		err        string
+		name       string
+		state      string
+		finishedAt time.Time
+		nextRunAt  time.Time
+		startedAt  time.Time
+		runCount   uint32
+		skipCount  uint32
+		id         uuid.UUID
+	}
+)
+
+var _ JobStatuser = (*jobStatus)(nil)
+
+// NewJobStatus is a function.
+func NewJobStatus(
+	errValue string,
+	name string,
+	state string,
+	finishedAt time.Time,
+	nextRunAt time.Time,
+	startedAt time.Time,
+	runCount uint32,
+	skipCount uint32,
+	id uuid.UUID,
+) *jobStatus {
+	return &jobStatus{
+		err:        errValue,
+		name:       name,
+		state:      state,
+		finishedAt: finishedAt,
+		nextRunAt:  nextRunAt,
+		startedAt:  startedAt,
+		runCount:   runCount,
+		skipCount:  skipCount,
+		id:         id,
+	}
+}
+
+// JobStatuserComparer is a function.
+func JobStatuserComparer(
+	first JobStatuser,
+	second JobStatuser,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetError() == second.GetError() &&
+		first.GetName() == second.GetName() &&
+		first.GetState() == second.GetState() &&
+		first.GetFinishedAt().Equal(second.GetFinishedAt()) &&
+		first.GetNextRunAt().Equal(second.GetNextRunAt()) &&
+		first.GetStartedAt().Equal(second.GetStartedAt()) &&
+		first.GetRunCount() == second.GetRunCount() &&
+		first.GetSkipCount() == second.GetSkipCount()
+}
+
+// GetID is a function.
+func (jobStatus *jobStatus) GetID() uuid.UUID {
+	return jobStatus.id
+}
+
+// GetError is a function.
+func (jobStatus *jobStatus) GetError() string {
+	return jobStatus.err
+}
+
+// GetName is a function.
+func (jobStatus *jobStatus) GetName() string {
+	return jobStatus.name
+}
+
+// GetState is a function.
+func (jobStatus *jobStatus) GetState() string {
+	return jobStatus.state
+}
+
+// GetFinishedAt is a function.
+func (jobStatus *jobStatus) GetFinishedAt() time.Time {
+	return jobStatus.finishedAt
+}
+
+// GetNextRunAt is a function.
+func (jobStatus *jobStatus) GetNextRunAt() time.Time {
+	return jobStatus.nextRunAt
+}
+
+// GetStartedAt is a function.
+func (jobStatus *jobStatus) GetStartedAt() time.Time {
+	return jobStatus.startedAt
+}
+
+// GetRunCount is a function.
+func (jobStatus *jobStatus) GetRunCount() uint32 {
+	return jobStatus.runCount
+}
+
+// GetSkipCount is a function.
+func (jobStatus *jobStatus) GetSkipCount() uint32 {
+	return jobStatus.skipCount
+}
+
+// GetMap is a function.
+func (jobStatus *jobStatus) GetMap() map[string]any {
+	return map[string]any{
+		"id":          jobStatus.GetID(),
+		"error":       jobStatus.GetError(),
+		"name":        jobStatus.GetName(),
+		"state":       jobStatus.GetState(),
+		"finished_at": jobStatus.GetFinishedAt(),
+		"next_run_at": jobStatus.GetNextRunAt(),
+		"started_at":  jobStatus.GetStartedAt(),
+		"run_count":   jobStatus.GetRunCount(),
+		"skip_count":  jobStatus.GetSkipCount(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (jobStatus *jobStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jobStatus.GetMap())
+}
@@ -0,0 +1,91 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// KillSwitcher is an interface.
+	KillSwitcher interface {
+		OMer
+		// GetReason is a function.
+		GetReason() string
+		// GetEnabled is a function.
+		GetEnabled() bool
+		// GetPaper is a function.
+		GetPaper() bool
+	}
+
+	killSwitch struct {
+		reason  string
+		enabled bool
+		paper   bool
+		id      uuid.UUID
+	}
+)
+
+var _ KillSwitcher = (*killSwitch)(nil)
+
+// NewKillSwitch is a function.
+func NewKillSwitch(
+	reason string,
+	enabled bool,
+	paper bool,
+	id uuid.UUID,
+) *killSwitch {
+	return &killSwitch{
+		reason:  reason,
+		enabled: enabled,
+		paper:   paper,
+		id:      id,
+	}
+}
+
+// KillSwitcherComparer is a function.
+func KillSwitcherComparer(
+	first KillSwitcher,
+	second KillSwitcher,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetReason() == second.GetReason() &&
+		first.GetEnabled() == second.GetEnabled() &&
+		first.GetPaper() == second.GetPaper()
+}
+
+// GetID is a function.
+func (killSwitch *killSwitch) GetID() uuid.UUID {
+	return killSwitch.id
+}
+
+// GetReason is a function.
+func (killSwitch *killSwitch) GetReason() string {
+	return killSwitch.reason
+}
+
+// GetEnabled is a function.
+func (killSwitch *killSwitch) GetEnabled() bool {
+	return killSwitch.enabled
+}
+
+// GetPaper is a function.
+func (killSwitch *killSwitch) GetPaper() bool {
+	return killSwitch.paper
+}
+
+// GetMap is a function.
+func (killSwitch *killSwitch) GetMap() map[string]any {
+	return map[string]any{
+		"id":      killSwitch.GetID(),
+		"reason":  killSwitch.GetReason(),
+		"enabled": killSwitch.GetEnabled(),
+		"paper":   killSwitch.GetPaper(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (killSwitch *killSwitch) MarshalJSON() ([]byte, error) {
+	return json.Marshal(killSwitch.GetMap())
+}
@@ -0,0 +1,163 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Kliner is an interface.
+	Kliner interface {
+		OMer
+		// GetClose is a function.
+		GetClose() string
+		// GetHigh is a function.
+		GetHigh() string
+		// GetKlineType is a function.
+		GetKlineType() string
+		// GetLow is a function.
+		GetLow() string
+		// GetOpen is a function.
+		GetOpen() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetTurnover is a function.
+		GetTurnover() string
+		// GetVolume is a function.
+		GetVolume() string
+		// GetStartAt is a function.
+		GetStartAt() int64
+	}
+
+	kline struct {
+		close     string
+		high      string
+		klineType string
+		low       string
+		open      string
+		symbol    string
+		turnover  string
+		volume    string
+		startAt   int64
+		id        uuid.UUID
+	}
+)
+
+var _ Kliner = (*kline)(nil)
+
+// NewKline is a function.
+func NewKline(
+	closeValue string,
+	high string,
+	klineType string,
+	low string,
+	open string,
+	symbol string,
+	turnover string,
+	volume string,
+	startAt int64,
+	id uuid.UUID,
+) *kline {
+	return &kline{
+		close:     closeValue,
+		high:      high,
+		klineType: klineType,
+		low:       low,
+		open:      open,
+		symbol:    symbol,
+		turnover:  turnover,
+		volume:    volume,
+		startAt:   startAt,
+		id:        id,
+	}
+}
+
+// KlinerComparer is a function.
+func KlinerComparer(
+	first Kliner,
+	second Kliner,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetClose() == second.GetClose() &&
+		first.GetHigh() == second.GetHigh() &&
+		first.GetKlineType() == second.GetKlineType() &&
+		first.GetLow() == second.GetLow() &&
+		first.GetOpen() == second.GetOpen() &&
+		first.GetSymbol() == second.GetSymbol() &&
+		first.GetTurnover() == second.GetTurnover() &&
+		first.GetVolume() == second.GetVolume() &&
+		first.GetStartAt() == second.GetStartAt()
+}
+
+// GetID is a function.
+func (kline *kline) GetID() uuid.UUID {
+	return kline.id
+}
+
+// GetClose is a function.
+func (kline *kline) GetClose() string {
+	return kline.close
+}
+
+// GetHigh is a function.
+func (kline *kline) GetHigh() string {
+	return kline.high
+}
+
+// GetKlineType is a function.
+func (kline *kline) GetKlineType() string {
+	return kline.klineType
+}
+
+// GetLow is a function.
+func (kline *kline) GetLow() string {
+	return kline.low
+}
+
+// GetOpen is a function.
+func (kline *kline) GetOpen() string {
+	return kline.open
+}
+
+// GetSymbol is a function.
+func (kline *kline) GetSymbol() string {
+	return kline.symbol
+}
+
+// GetTurnover is a function.
+func (kline *kline) GetTurnover() string {
+	return kline.turnover
+}
+
+// GetVolume is a function.
+func (kline *kline) GetVolume() string {
+	return kline.volume
+}
+
+// GetStartAt is a function.
+func (kline *kline) GetStartAt() int64 {
+	return kline.startAt
+}
+
+// GetMap is a function.
+func (kline *kline) GetMap() map[string]any {
+	return map[string]any{
+		"id":         kline.GetID(),
+		"close":      kline.GetClose(),
+		"high":       kline.GetHigh(),
+		"kline_type": kline.GetKlineType(),
+		"low":        kline.GetLow(),
+		"open":       kline.GetOpen(),
+		"symbol":     kline.GetSymbol(),
+		"turnover":   kline.GetTurnover(),
+		"volume":     kline.GetVolume(),
+		"start_at":   kline.GetStartAt(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (kline *kline) MarshalJSON() ([]byte, error) {
+	return json.Marshal(kline.GetMap())
+}
@@ -0,0 +1,139 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// MarginBalancer is an interface.
+	MarginBalancer interface {
+		OMer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetAvailable is a function.
+		GetAvailable() string
+		// GetCurrency is a function.
+		GetCurrency() string
+		// GetHolds is a function.
+		GetHolds() string
+		// GetLiability is a function.
+		GetLiability() string
+		// GetMaxBorrowSize is a function.
+		GetMaxBorrowSize() string
+		// GetTotal is a function.
+		GetTotal() string
+	}
+
+	marginBalance struct {
+		account       string
+		available     string
+		currency      string
+		holds         string
+		liability     string
+		maxBorrowSize string
+		total         string
+		id            uuid.UUID
+	}
+)
+
+var _ MarginBalancer = (*marginBalance)(nil)
+
+// NewMarginBalance is a function.
+func NewMarginBalance(
+	account string,
+	available string,
+	currency string,
+	holds string,
+	liability string,
+	maxBorrowSize string,
+	total string,
+	id uuid.UUID,
+) *marginBalance {
+	return &marginBalance{
+		account:       account,
+		available:     available,
+		currency:      currency,
+		holds:         holds,
+		liability:     liability,
+		maxBorrowSize: maxBorrowSize,
+		total:         total,
+		id:            id,
+	}
+}
+
+// MarginBalancerComparer is a function.
+func MarginBalancerComparer(
+	first MarginBalancer,
+	second MarginBalancer,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetAccount() == second.GetAccount() &&
+		first.GetAvailable() == second.GetAvailable() &&
+		first.GetCurrency() == second.GetCurrency() &&
+		first.GetHolds() == second.GetHolds() &&
+		first.GetLiability() == second.GetLiability() &&
+		first.GetMaxBorrowSize() == second.GetMaxBorrowSize() &&
+		first.GetTotal() == second.GetTotal()
+}
+
+// GetID is a function.
+func (marginBalance *marginBalance) GetID() uuid.UUID {
+	return marginBalance.id
+}
+
+// GetAccount is a function.
+func (marginBalance *marginBalance) GetAccount() string {
+	return marginBalance.account
+}
+
+// GetAvailable is a function.
+func (marginBalance *marginBalance) GetAvailable() string {
+	return marginBalance.available
+}
+
+// GetCurrency is a function.
+func (marginBalance *marginBalance) GetCurrency() string {
+	return marginBalance.currency
+}
+
+// GetHolds is a function.
+func (marginBalance *marginBalance) GetHolds() string {
+	return marginBalance.holds
+}
+
+// GetLiability is a function.
+func (marginBalance *marginBalance) GetLiability() string {
+	return marginBalance.liability
+}
+
+// GetMaxBorrowSize is a function.
+func (marginBalance *marginBalance) GetMaxBorrowSize() string {
+	return marginBalance.maxBorrowSize
+}
+
+// GetTotal is a function.
+func (marginBalance *marginBalance) GetTotal() string {
+	return marginBalance.total
+}
+
+// GetMap is a function.
+func (marginBalance *marginBalance) GetMap() map[string]any {
+	return map[string]any{
+		"id":              marginBalance.GetID(),
+		"account":         marginBalance.GetAccount(),
+		"available":       marginBalance.GetAvailable(),
+		"currency":        marginBalance.GetCurrency(),
+		"holds":           marginBalance.GetHolds(),
+		"liability":       marginBalance.GetLiability(),
+		"max_borrow_size": marginBalance.GetMaxBorrowSize(),
+		"total":           marginBalance.GetTotal(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (marginBalance *marginBalance) MarshalJSON() ([]byte, error) {
+	return json.Marshal(marginBalance.GetMap())
+}
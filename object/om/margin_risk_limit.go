@@ -0,0 +1,115 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// MarginRiskLimiter is an interface.
+	MarginRiskLimiter interface {
+		OMer
+		// GetBorrowMaxAmount is a function.
+		GetBorrowMaxAmount() string
+		// GetBuyMaxAmount is a function.
+		GetBuyMaxAmount() string
+		// GetCurrency is a function.
+		GetCurrency() string
+		// GetMarginModel is a function.
+		GetMarginModel() string
+		// GetPrecision is a function.
+		GetPrecision() string
+	}
+
+	marginRiskLimit struct {
+		borrowMaxAmount string
+		buyMaxAmount    string
+		currency        string
+		marginModel     string
+		precision       string
+		id              uuid.UUID
+	}
+)
+
+var _ MarginRiskLimiter = (*marginRiskLimit)(nil)
+
+// NewMarginRiskLimit is a function.
+func NewMarginRiskLimit(
+	borrowMaxAmount string,
+	buyMaxAmount string,
+	currency string,
+	marginModel string,
+	precision string,
+	id uuid.UUID,
+) *marginRiskLimit {
+	return &marginRiskLimit{
+		borrowMaxAmount: borrowMaxAmount,
+		buyMaxAmount:    buyMaxAmount,
+		currency:        currency,
+		marginModel:     marginModel,
+		precision:       precision,
+		id:              id,
+	}
+}
+
+// MarginRiskLimiterComparer is a function.
+func MarginRiskLimiterComparer(
+	first MarginRiskLimiter,
+	second MarginRiskLimiter,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetBorrowMaxAmount() == second.GetBorrowMaxAmount() &&
+		first.GetBuyMaxAmount() == second.GetBuyMaxAmount() &&
+		first.GetCurrency() == second.GetCurrency() &&
+		first.GetMarginModel() == second.GetMarginModel() &&
+		first.GetPrecision() == second.GetPrecision()
+}
+
+// GetID is a function.
+func (marginRiskLimit *marginRiskLimit) GetID() uuid.UUID {
+	return marginRiskLimit.id
+}
+
+// GetBorrowMaxAmount is a function.
+func (marginRiskLimit *marginRiskLimit) GetBorrowMaxAmount() string {
+	return marginRiskLimit.borrowMaxAmount
+}
+
+// GetBuyMaxAmount is a function.
+func (marginRiskLimit *marginRiskLimit) GetBuyMaxAmount() string {
+	return marginRiskLimit.buyMaxAmount
+}
+
+// GetCurrency is a function.
+func (marginRiskLimit *marginRiskLimit) GetCurrency() string {
+	return marginRiskLimit.currency
+}
+
+// GetMarginModel is a function.
+func (marginRiskLimit *marginRiskLimit) GetMarginModel() string {
+	return marginRiskLimit.marginModel
+}
+
+// GetPrecision is a function.
+func (marginRiskLimit *marginRiskLimit) GetPrecision() string {
+	return marginRiskLimit.precision
+}
+
+// GetMap is a function.
+func (marginRiskLimit *marginRiskLimit) GetMap() map[string]any {
+	return map[string]any{
+		"id":                marginRiskLimit.GetID(),
+		"borrow_max_amount": marginRiskLimit.GetBorrowMaxAmount(),
+		"buy_max_amount":    marginRiskLimit.GetBuyMaxAmount(),
+		"currency":          marginRiskLimit.GetCurrency(),
+		"margin_model":      marginRiskLimit.GetMarginModel(),
+		"precision":         marginRiskLimit.GetPrecision(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (marginRiskLimit *marginRiskLimit) MarshalJSON() ([]byte, error) {
+	return json.Marshal(marginRiskLimit.GetMap())
+}
@@ -10,6 +10,8 @@ type (
 	// Orderer is an interface.
 	Orderer interface {
 		OMer
+		// GetAccount is a function.
+		GetAccount() string
 		// GetChannel is a function.
 		GetChannel() string
 		// GetClientOID is a function.
@@ -38,6 +40,8 @@ type (
 		GetSide() string
 		// GetSize is a function.
 		GetSize() string
+		// GetStatus is a function.
+		GetStatus() string
 		// GetStop is a function.
 		GetStop() string
 		// GetStopPrice is a function.
@@ -66,6 +70,8 @@ type (
 		GetIceBerg() bool
 		// GetIsActive is a function.
 		GetIsActive() bool
+		// GetPaper is a function.
+		GetPaper() bool
 		// GetPostOnly is a function.
 		GetPostOnly() bool
 		// GetStopTriggered is a function.
@@ -73,6 +79,7 @@ type (
 	}
 
 	order struct {
+		account         string
 		channel         string
 		clientOID       string
 		dealFunds       string
@@ -87,6 +94,7 @@ type (
 		remark          string
 		side            string
 		size            string
+		status          string
 		stop            string
 		stopPrice       string
 		stp             string
@@ -101,6 +109,7 @@ type (
 		hidden          bool
 		iceBerg         bool
 		isActive        bool
+		paper           bool
 		postOnly        bool
 		stopTriggered   bool
 		id              uuid.UUID
@@ -111,6 +120,7 @@ var _ Orderer = (*order)(nil)
 
 // NewOrder is a function.
 func NewOrder(
+	account string,
 	channel string,
 	clientOID string,
 	dealFunds string,
@@ -125,6 +135,7 @@ func NewOrder(
 	remark string,
 	side string,
 	size string,
+	status string,
 	stop string,
 	stopPrice string,
 	stp string,
@@ -139,11 +150,13 @@ func NewOrder(
 	hidden bool,
 	iceBerg bool,
 	isActive bool,
+	paper bool,
 	postOnly bool,
 	stopTriggered bool,
 	id uuid.UUID,
 ) *order {
 	return &order{
+		account:         account,
 		channel:         channel,
 		clientOID:       clientOID,
 		dealFunds:       dealFunds,
@@ -158,6 +171,7 @@ func NewOrder(
 		remark:          remark,
 		side:            side,
 		size:            size,
+		status:          status,
 		stop:            stop,
 		stopPrice:       stopPrice,
 		stp:             stp,
@@ -172,6 +186,7 @@ func NewOrder(
 		hidden:          hidden,
 		iceBerg:         iceBerg,
 		isActive:        isActive,
+		paper:           paper,
 		postOnly:        postOnly,
 		stopTriggered:   stopTriggered,
 		id:              id,
@@ -184,6 +199,7 @@ func OrdererComparer(
 	second Orderer,
 ) bool {
 	return OMerComparer(first, second) &&
+		first.GetAccount() == second.GetAccount() &&
 		first.GetChannel() == second.GetChannel() &&
 		first.GetClientOID() == second.GetClientOID() &&
 		first.GetDealFunds() == second.GetDealFunds() &&
@@ -198,6 +214,7 @@ func OrdererComparer(
 		first.GetRemark() == second.GetRemark() &&
 		first.GetSide() == second.GetSide() &&
 		first.GetSize() == second.GetSize() &&
+		first.GetStatus() == second.GetStatus() &&
 		first.GetStop() == second.GetStop() &&
 		first.GetStopPrice() == second.GetStopPrice() &&
 		first.GetSTP() == second.GetSTP() &&
@@ -212,6 +229,7 @@ func OrdererComparer(
 		first.GetHidden() == second.GetHidden() &&
 		first.GetIceBerg() == second.GetIceBerg() &&
 		first.GetIsActive() == second.GetIsActive() &&
+		first.GetPaper() == second.GetPaper() &&
 		first.GetPostOnly() == second.GetPostOnly() &&
 		first.GetStopTriggered() == second.GetStopTriggered()
 }
@@ -221,6 +239,11 @@ func (order *order) GetID() uuid.UUID {
 	return order.id
 }
 
+// GetAccount is a function.
+func (order *order) GetAccount() string {
+	return order.account
+}
+
 // GetChannel is a function.
 func (order *order) GetChannel() string {
 	return order.channel
@@ -291,6 +314,11 @@ func (order *order) GetSize() string {
 	return order.size
 }
 
+// GetStatus is a function.
+func (order *order) GetStatus() string {
+	return order.status
+}
+
 // GetStop is a function.
 func (order *order) GetStop() string {
 	return order.stop
@@ -361,6 +389,11 @@ func (order *order) GetIsActive() bool {
 	return order.isActive
 }
 
+// GetPaper is a function.
+func (order *order) GetPaper() bool {
+	return order.paper
+}
+
 // GetPostOnly is a function.
 func (order *order) GetPostOnly() bool {
 	return order.postOnly
@@ -375,6 +408,7 @@ func (order *order) GetStopTriggered() bool {
 func (order *order) GetMap() map[string]any {
 	return map[string]any{
 		"id":                order.GetID(),
+		"account":           order.GetAccount(),
 		"channel":           order.GetChannel(),
 		"client_oid":        order.GetClientOID(),
 		"deal_funds":        order.GetDealFunds(),
@@ -389,6 +423,7 @@ func (order *order) GetMap() map[string]any {
 		"remark":            order.GetRemark(),
 		"side":              order.GetSide(),
 		"size":              order.GetSize(),
+		"status":            order.GetStatus(),
 		"stop":              order.GetStop(),
 		"stop_price":        order.GetStopPrice(),
 		"stp":               order.GetSTP(),
@@ -403,6 +438,7 @@ func (order *order) GetMap() map[string]any {
 		"hidden":            order.GetHidden(),
 		"ice_berg":          order.GetIceBerg(),
 		"is_active":         order.GetIsActive(),
+		"paper":             order.GetPaper(),
 		"post_only":         order.GetPostOnly(),
 		"stop_triggered":    order.GetStopTriggered(),
 	}
@@ -0,0 +1,116 @@
+package om
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// OrderBooker is an interface.
+	OrderBooker interface {
+		OMer
+		// GetAsks is a function.
+		GetAsks() [][]string
+		// GetBids is a function.
+		GetBids() [][]string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetSequence is a function.
+		GetSequence() int64
+		// GetTime is a function.
+		GetTime() int64
+	}
+
+	orderBook struct {
+		asks     [][]string
+		bids     [][]string
+		symbol   string
+		sequence int64
+		time     int64
+		id       uuid.UUID
+	}
+)
+
+var _ OrderBooker = (*orderBook)(nil)
+
+// NewOrderBook is a function.
+func NewOrderBook(
+	asks [][]string,
+	bids [][]string,
+	symbol string,
+	sequence int64,
+	time int64,
+	id uuid.UUID,
+) *orderBook {
+	return &orderBook{
+		asks:     asks,
+		bids:     bids,
+		symbol:   symbol,
+		sequence: sequence,
+		time:     time,
+		id:       id,
+	}
+}
+
+// OrderBookerComparer is a function.
+func OrderBookerComparer(
+	first OrderBooker,
+	second OrderBooker,
+) bool {
+	return OMerComparer(first, second) &&
+		reflect.DeepEqual(first.GetAsks(), second.GetAsks()) &&
+		reflect.DeepEqual(first.GetBids(), second.GetBids()) &&
+		first.GetSymbol() == second.GetSymbol() &&
+		first.GetSequence() == second.GetSequence() &&
+		first.GetTime() == second.GetTime()
+}
+
+// GetID is a function.
+func (orderBook *orderBook) GetID() uuid.UUID {
+	return orderBook.id
+}
+
+// GetAsks is a function.
+func (orderBook *orderBook) GetAsks() [][]string {
+	return orderBook.asks
+}
+
+// GetBids is a function.
+func (orderBook *orderBook) GetBids() [][]string {
+	return orderBook.bids
+}
+
+// GetSymbol is a function.
+func (orderBook *orderBook) GetSymbol() string {
+	return orderBook.symbol
+}
+
+// GetSequence is a function.
+func (orderBook *orderBook) GetSequence() int64 {
+	return orderBook.sequence
+}
+
+// GetTime is a function.
+func (orderBook *orderBook) GetTime() int64 {
+	return orderBook.time
+}
+
+// GetMap is a function.
+func (orderBook *orderBook) GetMap() map[string]any {
+	return map[string]any{
+		"id":       orderBook.GetID(),
+		"asks":     orderBook.GetAsks(),
+		"bids":     orderBook.GetBids(),
+		"symbol":   orderBook.GetSymbol(),
+		"sequence": orderBook.GetSequence(),
+		"time":     orderBook.GetTime(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (orderBook *orderBook) MarshalJSON() ([]byte, error) {
+	return json.Marshal(orderBook.GetMap())
+}
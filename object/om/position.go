@@ -0,0 +1,151 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Positioner is an interface.
+	Positioner interface {
+		OMer
+		// GetAverageCost is a function.
+		GetAverageCost() string
+		// GetFee is a function.
+		GetFee() string
+		// GetLastPrice is a function.
+		GetLastPrice() string
+		// GetMarketValue is a function.
+		GetMarketValue() string
+		// GetQuantity is a function.
+		GetQuantity() string
+		// GetRealizedPnL is a function.
+		GetRealizedPnL() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetUnrealizedPnL is a function.
+		GetUnrealizedPnL() string
+	}
+
+	position struct {
+		averageCost   string
+		fee           string
+		lastPrice     string
+		marketValue   string
+		quantity      string
+		realizedPnL   string
+		symbol        string
+		unrealizedPnL string
+		id            uuid.UUID
+	}
+)
+
+var _ Positioner = (*position)(nil)
+
+// NewPosition is a function.
+func NewPosition(
+	averageCost string,
+	fee string,
+	lastPrice string,
+	marketValue string,
+	quantity string,
+	realizedPnL string,
+	symbol string,
+	unrealizedPnL string,
+	id uuid.UUID,
+) *position {
+	return &position{
+		averageCost:   averageCost,
+		fee:           fee,
+		lastPrice:     lastPrice,
+		marketValue:   marketValue,
+		quantity:      quantity,
+		realizedPnL:   realizedPnL,
+		symbol:        symbol,
+		unrealizedPnL: unrealizedPnL,
+		id:            id,
+	}
+}
+
+// PositionerComparer is a function.
+func PositionerComparer(
+	first Positioner,
+	second Positioner,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetAverageCost() == second.GetAverageCost() &&
+		first.GetFee() == second.GetFee() &&
+		first.GetLastPrice() == second.GetLastPrice() &&
+		first.GetMarketValue() == second.GetMarketValue() &&
+		first.GetQuantity() == second.GetQuantity() &&
+		first.GetRealizedPnL() == second.GetRealizedPnL() &&
+		first.GetSymbol() == second.GetSymbol() &&
+		first.GetUnrealizedPnL() == second.GetUnrealizedPnL()
+}
+
+// GetID is a function.
+func (position *position) GetID() uuid.UUID {
+	return position.id
+}
+
+// GetAverageCost is a function.
+func (position *position) GetAverageCost() string {
+	return position.averageCost
+}
+
+// GetFee is a function.
+func (position *position) GetFee() string {
+	return position.fee
+}
+
+// GetLastPrice is a function.
+func (position *position) GetLastPrice() string {
+	return position.lastPrice
+}
+
+// GetMarketValue is a function.
+func (position *position) GetMarketValue() string {
+	return position.marketValue
+}
+
+// GetQuantity is a function.
+func (position *position) GetQuantity() string {
+	return position.quantity
+}
+
+// GetRealizedPnL is a function.
+func (position *position) GetRealizedPnL() string {
+	return position.realizedPnL
+}
+
+// GetSymbol is a function.
+func (position *position) GetSymbol() string {
+	return position.symbol
+}
+
+// GetUnrealizedPnL is a function.
+func (position *position) GetUnrealizedPnL() string {
+	return position.unrealizedPnL
+}
+
+// GetMap is a function.
+func (position *position) GetMap() map[string]any {
+	return map[string]any{
+		"id":             position.GetID(),
+		"average_cost":   position.GetAverageCost(),
+		"fee":            position.GetFee(),
+		"last_price":     position.GetLastPrice(),
+		"market_value":   position.GetMarketValue(),
+		"quantity":       position.GetQuantity(),
+		"realized_pnl":   position.GetRealizedPnL(),
+		"symbol":         position.GetSymbol(),
+		"unrealized_pnl": position.GetUnrealizedPnL(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (position *position) MarshalJSON() ([]byte, error) {
+	return json.Marshal(position.GetMap())
+}
@@ -0,0 +1,115 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Signaler is an interface.
+	Signaler interface {
+		OMer
+		// GetReason is a function.
+		GetReason() string
+		// GetSide is a function.
+		GetSide() string
+		// GetStrategy is a function.
+		GetStrategy() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetTime is a function.
+		GetTime() int64
+	}
+
+	signal struct {
+		reason   string
+		side     string
+		strategy string
+		symbol   string
+		time     int64
+		id       uuid.UUID
+	}
+)
+
+var _ Signaler = (*signal)(nil)
+
+// NewSignal is a function.
+func NewSignal(
+	reason string,
+	side string,
+	strategy string,
+	symbol string,
+	time int64,
+	id uuid.UUID,
+) *signal {
+	return &signal{
+		reason:   reason,
+		side:     side,
+		strategy: strategy,
+		symbol:   symbol,
+		time:     time,
+		id:       id,
+	}
+}
+
+// SignalerComparer is a function.
+func SignalerComparer(
+	first Signaler,
+	second Signaler,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetReason() == second.GetReason() &&
+		first.GetSide() == second.GetSide() &&
+		first.GetStrategy() == second.GetStrategy() &&
+		first.GetSymbol() == second.GetSymbol() &&
+		first.GetTime() == second.GetTime()
+}
+
+// GetID is a function.
+func (signal *signal) GetID() uuid.UUID {
+	return signal.id
+}
+
+// GetReason is a function.
+func (signal *signal) GetReason() string {
+	return signal.reason
+}
+
+// GetSide is a function.
+func (signal *signal) GetSide() string {
+	return signal.side
+}
+
+// GetStrategy is a function.
+func (signal *signal) GetStrategy() string {
+	return signal.strategy
+}
+
+// GetSymbol is a function.
+func (signal *signal) GetSymbol() string {
+	return signal.symbol
+}
+
+// GetTime is a function.
+func (signal *signal) GetTime() int64 {
+	return signal.time
+}
+
+// GetMap is a function.
+func (signal *signal) GetMap() map[string]any {
+	return map[string]any{
+		"id":       signal.GetID(),
+		"reason":   signal.GetReason(),
+		"side":     signal.GetSide(),
+		"strategy": signal.GetStrategy(),
+		"symbol":   signal.GetSymbol(),
+		"time":     signal.GetTime(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (signal *signal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(signal.GetMap())
+}
@@ -0,0 +1,427 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// StopOrderer is an interface.
+	StopOrderer interface {
+		OMer
+		// GetAccount is a function.
+		GetAccount() string
+		// GetChannel is a function.
+		GetChannel() string
+		// GetClientOID is a function.
+		GetClientOID() string
+		// GetFeeCurrency is a function.
+		GetFeeCurrency() string
+		// GetFunds is a function.
+		GetFunds() string
+		// GetKucoinID is a function.
+		GetKucoinID() string
+		// GetKucoinType is a function.
+		GetKucoinType() string
+		// GetMakerFeeRate is a function.
+		GetMakerFeeRate() string
+		// GetPrice is a function.
+		GetPrice() string
+		// GetRemark is a function.
+		GetRemark() string
+		// GetSide is a function.
+		GetSide() string
+		// GetSize is a function.
+		GetSize() string
+		// GetStatus is a function.
+		GetStatus() string
+		// GetStop is a function.
+		GetStop() string
+		// GetStopPrice is a function.
+		GetStopPrice() string
+		// GetStopTriggerTime is a function.
+		GetStopTriggerTime() string
+		// GetSTP is a function.
+		GetSTP() string
+		// GetSymbol is a function.
+		GetSymbol() string
+		// GetTags is a function.
+		GetTags() string
+		// GetTakerFeeRate is a function.
+		GetTakerFeeRate() string
+		// GetTimeInForce is a function.
+		GetTimeInForce() string
+		// GetTradeType is a function.
+		GetTradeType() string
+		// GetVisibleSize is a function.
+		GetVisibleSize() string
+		// GetCancelAfter is a function.
+		GetCancelAfter() int64
+		// GetKucoinCreatedAt is a function.
+		GetKucoinCreatedAt() int64
+		// GetOrderTime is a function.
+		GetOrderTime() int64
+		// GetHidden is a function.
+		GetHidden() bool
+		// GetIceBerg is a function.
+		GetIceBerg() bool
+		// GetIsActive is a function.
+		GetIsActive() bool
+		// GetPaper is a function.
+		GetPaper() bool
+		// GetPostOnly is a function.
+		GetPostOnly() bool
+	}
+
+	stopOrder struct {
+		account         string
+		channel         string
+		clientOID       string
+		feeCurrency     string
+		funds           string
+		kucoinID        string
+		kucoinType      string
+		makerFeeRate    string
+		price           string
+		remark          string
+		side            string
+		size            string
+		status          string
+		stop            string
+		stopPrice       string
+		stopTriggerTime string
+		stp             string
+		symbol          string
+		tags            string
+		takerFeeRate    string
+		timeInForce     string
+		tradeType       string
+		visibleSize     string
+		cancelAfter     int64
+		kucoinCreatedAt int64
+		orderTime       int64
+		hidden          bool
+		iceBerg         bool
+		isActive        bool
+		paper           bool
+		postOnly        bool
+		id              uuid.UUID
+	}
+)
+
+var _ StopOrderer = (*stopOrder)(nil)
+
+// NewStopOrder is a function.
+func NewStopOrder(
+	account string,
+	channel string,
+	clientOID string,
+	feeCurrency string,
+	funds string,
+	kucoinID string,
+	kucoinType string,
+	makerFeeRate string,
+	price string,
+	remark string,
+	side string,
+	size string,
+	status string,
+	stop string,
+	stopPrice string,
+	stopTriggerTime string,
+	stp string,
+	symbol string,
+	tags string,
+	takerFeeRate string,
+	timeInForce string,
+	tradeType string,
+	visibleSize string,
+	cancelAfter int64,
+	kucoinCreatedAt int64,
+	orderTime int64,
+	hidden bool,
+	iceBerg bool,
+	isActive bool,
+	paper bool,
+	postOnly bool,
+	id uuid.UUID,
+) *stopOrder {
+	return &stopOrder{
+		account:         account,
+		channel:         channel,
+		clientOID:       clientOID,
+		feeCurrency:     feeCurrency,
+		funds:           funds,
+		kucoinID:        kucoinID,
+		kucoinType:      kucoinType,
+		makerFeeRate:    makerFeeRate,
+		price:           price,
+		remark:          remark,
+		side:            side,
+		size:            size,
+		status:          status,
+		stop:            stop,
+		stopPrice:       stopPrice,
+		stopTriggerTime: stopTriggerTime,
+		stp:             stp,
+		symbol:          symbol,
+		tags:            tags,
+		takerFeeRate:    takerFeeRate,
+		timeInForce:     timeInForce,
+		tradeType:       tradeType,
+		visibleSize:     visibleSize,
+		cancelAfter:     cancelAfter,
+		kucoinCreatedAt: kucoinCreatedAt,
+		orderTime:       orderTime,
+		hidden:          hidden,
+		iceBerg:         iceBerg,
+		isActive:        isActive,
+		paper:           paper,
+		postOnly:        postOnly,
+		id:              id,
+	}
+}
+
+// StopOrdererComparer is a function.
+func StopOrdererComparer(
+	first StopOrderer,
+	second StopOrderer,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetAccount() == second.GetAccount() &&
+		first.GetChannel() == second.GetChannel() &&
+		first.GetClientOID() == second.GetClientOID() &&
+		first.GetFeeCurrency() == second.GetFeeCurrency() &&
+		first.GetFunds() == second.GetFunds() &&
+		first.GetKucoinID() == second.GetKucoinID() &&
+		first.GetKucoinType() == second.GetKucoinType() &&
+		first.GetMakerFeeRate() == second.GetMakerFeeRate() &&
+		first.GetPrice() == second.GetPrice() &&
+		first.GetRemark() == second.GetRemark() &&
+		first.GetSide() == second.GetSide() &&
+		first.GetSize() == second.GetSize() &&
+		first.GetStatus() == second.GetStatus() &&
+		first.GetStop() == second.GetStop() &&
+		first.GetStopPrice() == second.GetStopPrice() &&
+		first.GetStopTriggerTime() == second.GetStopTriggerTime() &&
+		first.GetSTP() == second.GetSTP() &&
+		first.GetSymbol() == second.GetSymbol() &&
+		first.GetTags() == second.GetTags() &&
+		first.GetTakerFeeRate() == second.GetTakerFeeRate() &&
+		first.GetTimeInForce() == second.GetTimeInForce() &&
+		first.GetTradeType() == second.GetTradeType() &&
+		first.GetVisibleSize() == second.GetVisibleSize() &&
+		first.GetCancelAfter() == second.GetCancelAfter() &&
+		first.GetKucoinCreatedAt() == second.GetKucoinCreatedAt() &&
+		first.GetOrderTime() == second.GetOrderTime() &&
+		first.GetHidden() == second.GetHidden() &&
+		first.GetIceBerg() == second.GetIceBerg() &&
+		first.GetIsActive() == second.GetIsActive() &&
+		first.GetPaper() == second.GetPaper() &&
+		first.GetPostOnly() == second.GetPostOnly()
+}
+
+// GetID is a function.
+func (stopOrder *stopOrder) GetID() uuid.UUID {
+	return stopOrder.id
+}
+
+// GetAccount is a function.
+func (stopOrder *stopOrder) GetAccount() string {
+	return stopOrder.account
+}
+
+// GetChannel is a function.
+func (stopOrder *stopOrder) GetChannel() string {
+	return stopOrder.channel
+}
+
+// GetClientOID is a function.
+func (stopOrder *stopOrder) GetClientOID() string {
+	return stopOrder.clientOID
+}
+
+// GetFeeCurrency is a function.
+func (stopOrder *stopOrder) GetFeeCurrency() string {
+	return stopOrder.feeCurrency
+}
+
+// GetFunds is a function.
+func (stopOrder *stopOrder) GetFunds() string {
+	return stopOrder.funds
+}
+
+// GetKucoinID is a function.
+func (stopOrder *stopOrder) GetKucoinID() string {
+	return stopOrder.kucoinID
+}
+
+// GetKucoinType is a function.
+func (stopOrder *stopOrder) GetKucoinType() string {
+	return stopOrder.kucoinType
+}
+
+// GetMakerFeeRate is a function.
+func (stopOrder *stopOrder) GetMakerFeeRate() string {
+	return stopOrder.makerFeeRate
+}
+
+// GetPrice is a function.
+func (stopOrder *stopOrder) GetPrice() string {
+	return stopOrder.price
+}
+
+// GetRemark is a function.
+func (stopOrder *stopOrder) GetRemark() string {
+	return stopOrder.remark
+}
+
+// GetSide is a function.
+func (stopOrder *stopOrder) GetSide() string {
+	return stopOrder.side
+}
+
+// GetSize is a function.
+func (stopOrder *stopOrder) GetSize() string {
+	return stopOrder.size
+}
+
+// GetStatus is a function.
+func (stopOrder *stopOrder) GetStatus() string {
+	return stopOrder.status
+}
+
+// GetStop is a function.
+func (stopOrder *stopOrder) GetStop() string {
+	return stopOrder.stop
+}
+
+// GetStopPrice is a function.
+func (stopOrder *stopOrder) GetStopPrice() string {
+	return stopOrder.stopPrice
+}
+
+// GetStopTriggerTime is a function.
+func (stopOrder *stopOrder) GetStopTriggerTime() string {
+	return stopOrder.stopTriggerTime
+}
+
+// GetSTP is a function.
+func (stopOrder *stopOrder) GetSTP() string {
+	return stopOrder.stp
+}
+
+// GetSymbol is a function.
+func (stopOrder *stopOrder) GetSymbol() string {
+	return stopOrder.symbol
+}
+
+// GetTags is a function.
+func (stopOrder *stopOrder) GetTags() string {
+	return stopOrder.tags
+}
+
+// GetTakerFeeRate is a function.
+func (stopOrder *stopOrder) GetTakerFeeRate() string {
+	return stopOrder.takerFeeRate
+}
+
+// GetTimeInForce is a function.
+func (stopOrder *stopOrder) GetTimeInForce() string {
+	return stopOrder.timeInForce
+}
+
+// GetTradeType is a function.
+func (stopOrder *stopOrder) GetTradeType() string {
+	return stopOrder.tradeType
+}
+
+// GetVisibleSize is a function.
+func (stopOrder *stopOrder) GetVisibleSize() string {
+	return stopOrder.visibleSize
+}
+
+// GetCancelAfter is a function.
+func (stopOrder *stopOrder) GetCancelAfter() int64 {
+	return stopOrder.cancelAfter
+}
+
+// GetKucoinCreatedAt is a function.
+func (stopOrder *stopOrder) GetKucoinCreatedAt() int64 {
+	return stopOrder.kucoinCreatedAt
+}
+
+// GetOrderTime is a function.
+func (stopOrder *stopOrder) GetOrderTime() int64 {
+	return stopOrder.orderTime
+}
+
+// GetHidden is a function.
+func (stopOrder *stopOrder) GetHidden() bool {
+	return stopOrder.hidden
+}
+
+// GetIceBerg is a function.
+func (stopOrder *stopOrder) GetIceBerg() bool {
+	return stopOrder.iceBerg
+}
+
+// GetIsActive is a function.
+func (stopOrder *stopOrder) GetIsActive() bool {
+	return stopOrder.isActive
+}
+
+// GetPaper is a function.
+func (stopOrder *stopOrder) GetPaper() bool {
+	return stopOrder.paper
+}
+
+// GetPostOnly is a function.
+func (stopOrder *stopOrder) GetPostOnly() bool {
+	return stopOrder.postOnly
+}
+
+// GetMap is a function.
+func (stopOrder *stopOrder) GetMap() map[string]any {
+	return map[string]any{
+		"id":                stopOrder.GetID(),
+		"account":           stopOrder.GetAccount(),
+		"channel":           stopOrder.GetChannel(),
+		"client_oid":        stopOrder.GetClientOID(),
+		"fee_currency":      stopOrder.GetFeeCurrency(),
+		"funds":             stopOrder.GetFunds(),
+		"kucoin_id":         stopOrder.GetKucoinID(),
+		"kucoin_type":       stopOrder.GetKucoinType(),
+		"maker_fee_rate":    stopOrder.GetMakerFeeRate(),
+		"price":             stopOrder.GetPrice(),
+		"remark":            stopOrder.GetRemark(),
+		"side":              stopOrder.GetSide(),
+		"size":              stopOrder.GetSize(),
+		"status":            stopOrder.GetStatus(),
+		"stop":              stopOrder.GetStop(),
+		"stop_price":        stopOrder.GetStopPrice(),
+		"stop_trigger_time": stopOrder.GetStopTriggerTime(),
+		"stp":               stopOrder.GetSTP(),
+		"symbol":            stopOrder.GetSymbol(),
+		"tags":              stopOrder.GetTags(),
+		"taker_fee_rate":    stopOrder.GetTakerFeeRate(),
+		"time_in_force":     stopOrder.GetTimeInForce(),
+		"trade_type":        stopOrder.GetTradeType(),
+		"visible_size":      stopOrder.GetVisibleSize(),
+		"cancel_after":      stopOrder.GetCancelAfter(),
+		"kucoin_created_at": stopOrder.GetKucoinCreatedAt(),
+		"order_time":        stopOrder.GetOrderTime(),
+		"hidden":            stopOrder.GetHidden(),
+		"ice_berg":          stopOrder.GetIceBerg(),
+		"is_active":         stopOrder.GetIsActive(),
+		"paper":             stopOrder.GetPaper(),
+		"post_only":         stopOrder.GetPostOnly(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (stopOrder *stopOrder) MarshalJSON() ([]byte, error) {
+	return json.Marshal(stopOrder.GetMap())
+}
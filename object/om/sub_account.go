@@ -0,0 +1,79 @@
+package om
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// SubAccounter is an interface.
+	SubAccounter interface {
+		OMer
+		// GetSubName is a function.
+		GetSubName() string
+		// GetSubUserID is a function.
+		GetSubUserID() string
+	}
+
+	subAccount struct {
+		subName   string
+		subUserID string
+		id        uuid.UUID
+	}
+)
+
+var _ SubAccounter = (*subAccount)(nil)
+
+// NewSubAccount is a function.
+func NewSubAccount(
+	subName string,
+	subUserID string,
+	id uuid.UUID,
+) *subAccount {
+	return &subAccount{
+		subName:   subName,
+		subUserID: subUserID,
+		id:        id,
+	}
+}
+
+// SubAccounterComparer is a function.
+func SubAccounterComparer(
+	first SubAccounter,
+	second SubAccounter,
+) bool {
+	return OMerComparer(first, second) &&
+		first.GetSubName() == second.GetSubName() &&
+		first.GetSubUserID() == second.GetSubUserID()
+}
+
+// GetID is a function.
+func (subAccount *subAccount) GetID() uuid.UUID {
+	return subAccount.id
+}
+
+// GetSubName is a function.
+func (subAccount *subAccount) GetSubName() string {
+	return subAccount.subName
+}
+
+// GetSubUserID is a function.
+func (subAccount *subAccount) GetSubUserID() string {
+	return subAccount.subUserID
+}
+
+// GetMap is a function.
+func (subAccount *subAccount) GetMap() map[string]any {
+	return map[string]any{
+		"id":          subAccount.GetID(),
+		"sub_name":    subAccount.GetSubName(),
+		"sub_user_id": subAccount.GetSubUserID(),
+	}
+}
+
+// MarshalJSON is a function.
+// read more https://pkg.go.dev/encoding/json#Marshaler
+func (subAccount *subAccount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(subAccount.GetMap())
+}
@@ -0,0 +1,103 @@
+package object
+
+import (
+	"fmt"
+)
+
+type (
+	// OrderErrorer is an interface.
+	OrderErrorer interface {
+		error
+		// GetClientOID is a function.
+		GetClientOID() string
+		// GetCode is a function.
+		GetCode() string
+		// GetMessage is a function.
+		GetMessage() string
+		// Unwrap is a function.
+		Unwrap() []error
+	}
+
+	orderError struct {
+		err       error
+		kucoinErr error
+		clientOID string
+		code      string
+		message   string
+	}
+)
+
+var _ OrderErrorer = (*orderError)(nil)
+
+// NewOrderRejectedError is a function.
+// The exchange answered and refused the order, so nothing was placed and the
+// same clientOid may be submitted again once the cause is fixed.
+func NewOrderRejectedError(
+	clientOID string,
+	code string,
+	message string,
+) *orderError {
+	return &orderError{
+		err:       ErrOrderRejected,
+		kucoinErr: NewKucoinError(code, message),
+		clientOID: clientOID,
+		code:      code,
+		message:   message,
+	}
+}
+
+// NewOrderUnknownError is a function.
+// The request may or may not have reached the exchange, so the order must be
+// reconciled by its clientOid before anything is submitted again. Without a
+// code the exchange was not heard from at all.
+func NewOrderUnknownError(
+	clientOID string,
+	code string,
+	message string,
+) *orderError {
+	var kucoinErr error = NewKucoinTransportError(message)
+	if code != URIEmpty {
+		kucoinErr = NewKucoinError(code, message)
+	}
+
+	return &orderError{
+		err:       ErrOrderUnknown,
+		kucoinErr: kucoinErr,
+		clientOID: clientOID,
+		code:      code,
+		message:   message,
+	}
+}
+
+// Error is a function.
+func (err *orderError) Error() string {
+	return fmt.Sprintf(
+		"%s: client oid %s: code %s: %s",
+		err.err.Error(),
+		err.GetClientOID(),
+		err.GetCode(),
+		err.GetMessage(),
+	)
+}
+
+// GetClientOID is a function.
+func (err *orderError) GetClientOID() string {
+	return err.clientOID
+}
+
+// GetCode is a function.
+func (err *orderError) GetCode() string {
+	return err.code
+}
+
+// GetMessage is a function.
+func (err *orderError) GetMessage() string {
+	return err.message
+}
+
+// Unwrap is a function.
+// The error is both an order error and the kucoin error behind it.
+// read more https://pkg.go.dev/errors
+func (err *orderError) Unwrap() []error {
+	return []error{err.err, err.kucoinErr}
+}
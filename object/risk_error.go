@@ -0,0 +1,77 @@
+package object
+
+import (
+	"fmt"
+)
+
+type (
+	// RiskErrorer is an interface.
+	RiskErrorer interface {
+		error
+		// GetClientOID is a function.
+		GetClientOID() string
+		// GetMessage is a function.
+		GetMessage() string
+		// GetReason is a function.
+		GetReason() RiskReasonType
+		// Unwrap is a function.
+		Unwrap() error
+	}
+
+	riskError struct {
+		err       error
+		clientOID string
+		message   string
+		reason    RiskReasonType
+	}
+)
+
+var _ RiskErrorer = (*riskError)(nil)
+
+// NewRiskRejectedError is a function.
+// The order was refused before it reached the exchange, so nothing was placed
+// and the same clientOid may be submitted again once the limit allows it.
+func NewRiskRejectedError(
+	clientOID string,
+	reason RiskReasonType,
+	message string,
+) *riskError {
+	return &riskError{
+		err:       ErrRiskRejected,
+		clientOID: clientOID,
+		message:   message,
+		reason:    reason,
+	}
+}
+
+// Error is a function.
+func (err *riskError) Error() string {
+	return fmt.Sprintf(
+		"%s: client oid %s: reason %s: %s",
+		err.Unwrap().Error(),
+		err.GetClientOID(),
+		err.GetReason(),
+		err.GetMessage(),
+	)
+}
+
+// GetClientOID is a function.
+func (err *riskError) GetClientOID() string {
+	return err.clientOID
+}
+
+// GetMessage is a function.
+func (err *riskError) GetMessage() string {
+	return err.message
+}
+
+// GetReason is a function.
+func (err *riskError) GetReason() RiskReasonType {
+	return err.reason
+}
+
+// Unwrap is a function.
+// read more https://pkg.go.dev/errors
+func (err *riskError) Unwrap() error {
+	return err.err
+}
@@ -5,6 +5,10 @@ import "time"
 type (
 	// Timer is an interface.
 	Timer interface {
+		// After is a function.
+		After(
+			time.Duration,
+		) <-chan time.Time
 		// NowUTC is a function.
 		NowUTC() time.Time
 		// Since is a function.
@@ -33,6 +37,13 @@ func NewTime() *timeV2 {
 	}
 }
 
+// After is a function.
+func (*timeV2) After(
+	timeDuration time.Duration,
+) <-chan time.Time {
+	return time.After(timeDuration)
+}
+
 // NowUTC is a function.
 func (*timeV2) NowUTC() time.Time {
 	return time.Now().UTC()
@@ -0,0 +1,1387 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+type (
+	// AlgoOrderRepositorier is a interface.
+	AlgoOrderRepositorier interface {
+		DAORepositorier[dao.AlgoOrderer, dao.AlgoOrderFilterer]
+	}
+
+	// GetAlgoOrderRepositorier is an interface.
+	GetAlgoOrderRepositorier interface {
+		// GetAlgoOrderRepositorier is a function.
+		GetAlgoOrderRepositorier() AlgoOrderRepositorier
+	}
+
+	algoOrderRepository struct {
+		configConfigger  config.Configger
+		gormDB           *gorm.DB
+		logRuntimeLogger log.RuntimeLogger
+		objectTimer      object.Timer
+		traceTracer      trace.Tracer
+		utilUUIDer       util.UUIDer
+	}
+
+	algoOrderRepositoryOptioner interface {
+		apply(*algoOrderRepository)
+	}
+
+	algoOrderRepositoryOptionerFunc func(*algoOrderRepository)
+)
+
+var (
+	_ AlgoOrderRepositorier = (*algoOrderRepository)(nil)
+	_ GetDB                 = (*algoOrderRepository)(nil)
+	_ config.GetConfigger   = (*algoOrderRepository)(nil)
+	_ log.GetRuntimeLogger  = (*algoOrderRepository)(nil)
+	_ object.GetTimer       = (*algoOrderRepository)(nil)
+	_ util.GetTracer        = (*algoOrderRepository)(nil)
+	_ util.GetUUIDer        = (*algoOrderRepository)(nil)
+)
+
+// NewAlgoOrderRepository is a function.
+func NewAlgoOrderRepository(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...algoOrderRepositoryOptioner,
+) *algoOrderRepository {
+	algoOrderRepository := &algoOrderRepository{
+		configConfigger:  configConfigger,
+		gormDB:           nil,
+		logRuntimeLogger: logRuntimeLogger,
+		objectTimer:      nil,
+		traceTracer:      traceTracer,
+		utilUUIDer:       utilUUIDer,
+	}
+
+	return algoOrderRepository.WithOptioners(optioners...)
+}
+
+// WithAlgoOrderRepositoryTimer is a function.
+func WithAlgoOrderRepositoryTimer(
+	objectTimer object.Timer,
+) algoOrderRepositoryOptioner {
+	return algoOrderRepositoryOptionerFunc(func(
+		config *algoOrderRepository,
+	) {
+		config.objectTimer = objectTimer
+	})
+}
+
+// WithAlgoOrderRepositoryDB is a function.
+func WithAlgoOrderRepositoryDB(
+	gormDB *gorm.DB,
+) algoOrderRepositoryOptioner {
+	return algoOrderRepositoryOptionerFunc(func(
+		config *algoOrderRepository,
+	) {
+		config.gormDB = gormDB.
+			Table(object.URITableKucoinAlgoOrder).
+			Session(&gorm.Session{
+				DryRun:                   false,
+				PrepareStmt:              true,
+				NewDB:                    true,
+				Initialized:              false,
+				SkipHooks:                true,
+				SkipDefaultTransaction:   true,
+				DisableNestedTransaction: true,
+				AllowGlobalUpdate:        false,
+				FullSaveAssociations:     false,
+				QueryFields:              true,
+				Context:                  nil,
+				Logger:                   nil,
+				NowFunc:                  nil,
+				CreateBatchSize:          0,
+			})
+	})
+}
+
+// GetDB is a function.
+func (repository *algoOrderRepository) GetDB() *gorm.DB {
+	return repository.gormDB
+}
+
+// GetConfigger is a function.
+func (repository *algoOrderRepository) GetConfigger() config.Configger {
+	return repository.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (repository *algoOrderRepository) GetRuntimeLogger() log.RuntimeLogger {
+	return repository.logRuntimeLogger
+}
+
+// GetTimer is a function.
+func (repository *algoOrderRepository) GetTimer() object.Timer {
+	return repository.objectTimer
+}
+
+// GetTracer is a function.
+func (repository *algoOrderRepository) GetTracer() trace.Tracer {
+	return repository.traceTracer
+}
+
+// GetUUIDer is a function.
+func (repository *algoOrderRepository) GetUUIDer() util.UUIDer {
+	return repository.utilUUIDer
+}
+
+// Create is a function.
+func (repository *algoOrderRepository) Create(
+	ctx context.Context,
+	daoAlgoOrderer dao.AlgoOrderer,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":             "Create",
+		"rt_ctx":           utilRuntimeContext,
+		"sp_ctx":           utilSpanContext,
+		"config":           repository.GetConfigger(),
+		"dao_algo_orderer": daoAlgoOrderer,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	id, err := repository.GetUUIDer().NewRandom()
+	if err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrUUIDerNewRandom.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrUUIDerNewRandom.Error())
+
+		return uuid.Nil, err
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldID, id).
+		Debug(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	daoAlgoOrder := dao.NewAlgoOrder(
+		nowUTC,
+		nowUTC,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		id,
+		daoAlgoOrderer.GetAccount(),
+		daoAlgoOrderer.GetAlgoType(),
+		daoAlgoOrderer.GetAveragePrice(),
+		daoAlgoOrderer.GetChildClientOID(),
+		daoAlgoOrderer.GetChildDealFunds(),
+		daoAlgoOrderer.GetChildDealSize(),
+		daoAlgoOrderer.GetClientOID(),
+		daoAlgoOrderer.GetDealFunds(),
+		daoAlgoOrderer.GetDealSize(),
+		daoAlgoOrderer.GetLimitPrice(),
+		daoAlgoOrderer.GetReason(),
+		daoAlgoOrderer.GetSchedule(),
+		daoAlgoOrderer.GetSide(),
+		daoAlgoOrderer.GetSize(),
+		daoAlgoOrderer.GetState(),
+		daoAlgoOrderer.GetSymbol(),
+		daoAlgoOrderer.GetVisibleSize(),
+		daoAlgoOrderer.GetChildAt(),
+		daoAlgoOrderer.GetChildCount(),
+		daoAlgoOrderer.GetEndAt(),
+		daoAlgoOrderer.GetSlices(),
+		daoAlgoOrderer.GetStartAt(),
+		daoAlgoOrderer.GetIsActive(),
+		daoAlgoOrderer.GetPaper(),
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOAlgoOrder, daoAlgoOrder).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Create(daoAlgoOrder.GetMap())
+	if err = gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	return daoAlgoOrder.GetID(), nil
+}
+
+// Delete is a function.
+func (repository *algoOrderRepository) Delete(
+	ctx context.Context,
+	id uuid.UUID,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Delete",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Delete",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+		"id":     id,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id": id,
+		}).
+		Updates(map[string]any{
+			"deleted_at": sql.NullTime{
+				Time:  nowUTC,
+				Valid: true,
+			},
+		})
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderRepositoryDelete.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryDelete.Error())
+
+		return time.Time{}, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrAlgoOrderRepositoryDelete).
+			Error(object.ErrAlgoOrderRepositoryDelete.Error())
+		traceSpan.RecordError(object.ErrAlgoOrderRepositoryDelete)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryDelete.Error())
+
+		return time.Time{}, object.ErrAlgoOrderRepositoryDelete
+	}
+
+	return nowUTC, nil
+}
+
+// DeleteAll is a function.
+func (repository *algoOrderRepository) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Exec(fmt.Sprintf("DELETE FROM %s", object.URITableKucoinAlgoOrder))
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	return nowUTC, nil
+}
+
+// Read is a function.
+func (repository *algoOrderRepository) Read(
+	ctx context.Context,
+	id uuid.UUID,
+) (dao.AlgoOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Read",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Read",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+		"id":     id,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	result := map[string]any{}
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id":         id,
+			"deleted_at": nil,
+		}).
+		Select(fmt.Sprintf("%s.*", object.URITableKucoinAlgoOrder)).
+		Find(result)
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrAlgoOrderRepositoryRead).
+			Error(object.ErrAlgoOrderRepositoryRead.Error())
+		traceSpan.RecordError(object.ErrAlgoOrderRepositoryRead)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryRead.Error())
+
+		return nil, object.ErrAlgoOrderRepositoryRead
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResult, result).
+		Debug(object.URIEmpty)
+
+	createdAT, ok := result["created_at"].(time.Time)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	updatedAT, ok := result["updated_at"].(time.Time)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	account, ok := result["account"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	algoType, ok := result["algo_type"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	averagePrice, ok := result["average_price"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	childClientOID, ok := result["child_client_oid"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	childDealFunds, ok := result["child_deal_funds"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	childDealSize, ok := result["child_deal_size"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	clientOID, ok := result["client_oid"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	dealFunds, ok := result["deal_funds"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	dealSize, ok := result["deal_size"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	limitPrice, ok := result["limit_price"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	reason, ok := result["reason"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	schedule, ok := result["schedule"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	side, ok := result["side"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	size, ok := result["size"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	state, ok := result["state"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	symbol, ok := result["symbol"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	visibleSize, ok := result["visible_size"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	childAt, ok := result["child_at"].(int64)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	childCount, ok := result["child_count"].(int64)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	endAt, ok := result["end_at"].(int64)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	slices, ok := result["slices"].(int64)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	startAt, ok := result["start_at"].(int64)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	isActive, ok := result["is_active"].(bool)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	paper, ok := result["paper"].(bool)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	daoAlgoOrder := dao.NewAlgoOrder(
+		createdAT,
+		updatedAT,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		id,
+		account,
+		algoType,
+		averagePrice,
+		childClientOID,
+		childDealFunds,
+		childDealSize,
+		clientOID,
+		dealFunds,
+		dealSize,
+		limitPrice,
+		reason,
+		schedule,
+		side,
+		size,
+		state,
+		symbol,
+		visibleSize,
+		childAt,
+		childCount,
+		endAt,
+		slices,
+		startAt,
+		isActive,
+		paper,
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOAlgoOrder, daoAlgoOrder).
+		Debug(object.URIEmpty)
+
+	return daoAlgoOrder, nil
+}
+
+// ReadList is a function.
+func (repository *algoOrderRepository) ReadList(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoAlgoOrderFilterer dao.AlgoOrderFilterer,
+) ([]dao.AlgoOrderer, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"ReadList",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                    "ReadList",
+		"rt_ctx":                  utilRuntimeContext,
+		"sp_ctx":                  utilSpanContext,
+		"config":                  repository.GetConfigger(),
+		"dao_paginationer":        daoPaginationer,
+		"dao_algo_order_filterer": daoAlgoOrderFilterer,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	result := make([]map[string]any, 0, daoPaginationer.GetLimit()+1)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Scopes(
+			daoAlgoOrderFilterer.Filter,
+			daoPaginationer.Pagination(object.URITableKucoinAlgoOrder),
+		).
+		Where(map[string]any{
+			"deleted_at": nil,
+		}).
+		Select(fmt.Sprintf("%s.*", object.URITableKucoinAlgoOrder)).
+		Find(&result)
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResult, result).
+		Debug(object.URIEmpty)
+
+	daoAlgoOrderers := make([]dao.AlgoOrderer, 0, daoPaginationer.GetLimit())
+
+	for key, value := range result {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		if uint32(key) == daoPaginationer.GetLimit() {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`uint32(key) == daoPaginationer.GetLimit()`)
+
+			break
+		}
+
+		id, err := repository.GetUUIDer().Parse(value["id"].(string))
+		if err != nil {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrUUIDerParse.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrUUIDerParse.Error())
+
+			return nil, nil, err
+		}
+
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldID, id).
+			Debug(object.URIEmpty)
+
+		createdAT, ok := value["created_at"].(time.Time)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		updatedAT, ok := value["updated_at"].(time.Time)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		account, ok := value["account"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		algoType, ok := value["algo_type"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		averagePrice, ok := value["average_price"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		childClientOID, ok := value["child_client_oid"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		childDealFunds, ok := value["child_deal_funds"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		childDealSize, ok := value["child_deal_size"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		clientOID, ok := value["client_oid"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		dealFunds, ok := value["deal_funds"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		dealSize, ok := value["deal_size"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		limitPrice, ok := value["limit_price"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		reason, ok := value["reason"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		schedule, ok := value["schedule"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		side, ok := value["side"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		size, ok := value["size"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		state, ok := value["state"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		symbol, ok := value["symbol"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		visibleSize, ok := value["visible_size"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		childAt, ok := value["child_at"].(int64)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		childCount, ok := value["child_count"].(int64)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		endAt, ok := value["end_at"].(int64)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		slices, ok := value["slices"].(int64)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		startAt, ok := value["start_at"].(int64)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		isActive, ok := value["is_active"].(bool)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		paper, ok := value["paper"].(bool)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		daoAlgoOrderers = append(daoAlgoOrderers, dao.NewAlgoOrder(
+			createdAT,
+			updatedAT,
+			sql.NullTime{
+				Time:  time.Time{},
+				Valid: false,
+			},
+			id,
+			account,
+			algoType,
+			averagePrice,
+			childClientOID,
+			childDealFunds,
+			childDealSize,
+			clientOID,
+			dealFunds,
+			dealSize,
+			limitPrice,
+			reason,
+			schedule,
+			side,
+			size,
+			state,
+			symbol,
+			visibleSize,
+			childAt,
+			childCount,
+			endAt,
+			slices,
+			startAt,
+			isActive,
+			paper,
+		))
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOAlgoOrderers, daoAlgoOrderers).
+		Debug(object.URIEmpty)
+
+	var daoCursorer dao.Cursorer
+
+	if daoPaginationer.GetLimit() < uint32(len(result)) {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`daoPaginationer.GetLimit() < uint32(len(result))`)
+
+		daoCursorer = dao.NewCursor(
+			daoPaginationer.GetCursorer().GetOffset() + daoPaginationer.GetLimit(),
+		)
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	return daoAlgoOrderers, daoCursorer, nil
+}
+
+// Update is a function.
+func (repository *algoOrderRepository) Update(
+	ctx context.Context,
+	daoAlgoOrderer dao.AlgoOrderer,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Update",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":             "Update",
+		"rt_ctx":           utilRuntimeContext,
+		"sp_ctx":           utilSpanContext,
+		"config":           repository.GetConfigger(),
+		"dao_algo_orderer": daoAlgoOrderer,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	daoAlgoOrder := dao.NewAlgoOrder(
+		daoAlgoOrderer.GetCreatedAt(),
+		nowUTC,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		daoAlgoOrderer.GetID(),
+		daoAlgoOrderer.GetAccount(),
+		daoAlgoOrderer.GetAlgoType(),
+		daoAlgoOrderer.GetAveragePrice(),
+		daoAlgoOrderer.GetChildClientOID(),
+		daoAlgoOrderer.GetChildDealFunds(),
+		daoAlgoOrderer.GetChildDealSize(),
+		daoAlgoOrderer.GetClientOID(),
+		daoAlgoOrderer.GetDealFunds(),
+		daoAlgoOrderer.GetDealSize(),
+		daoAlgoOrderer.GetLimitPrice(),
+		daoAlgoOrderer.GetReason(),
+		daoAlgoOrderer.GetSchedule(),
+		daoAlgoOrderer.GetSide(),
+		daoAlgoOrderer.GetSize(),
+		daoAlgoOrderer.GetState(),
+		daoAlgoOrderer.GetSymbol(),
+		daoAlgoOrderer.GetVisibleSize(),
+		daoAlgoOrderer.GetChildAt(),
+		daoAlgoOrderer.GetChildCount(),
+		daoAlgoOrderer.GetEndAt(),
+		daoAlgoOrderer.GetSlices(),
+		daoAlgoOrderer.GetStartAt(),
+		daoAlgoOrderer.GetIsActive(),
+		daoAlgoOrderer.GetPaper(),
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOAlgoOrder, daoAlgoOrder).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id": daoAlgoOrderer.GetID(),
+		}).
+		Updates(daoAlgoOrder.GetMap())
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryUpdate.Error())
+
+		return time.Time{}, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrAlgoOrderRepositoryUpdate).
+			Error(object.ErrAlgoOrderRepositoryUpdate.Error())
+		traceSpan.RecordError(object.ErrAlgoOrderRepositoryUpdate)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryUpdate.Error())
+
+		return time.Time{}, object.ErrAlgoOrderRepositoryUpdate
+	}
+
+	return daoAlgoOrder.GetUpdatedAt(), nil
+}
+
+// WithOptioners is a function.
+func (repository *algoOrderRepository) WithOptioners(
+	optioners ...algoOrderRepositoryOptioner,
+) *algoOrderRepository {
+	newRepository := repository.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newRepository)
+	}
+
+	return newRepository
+}
+
+func (repository *algoOrderRepository) clone() *algoOrderRepository {
+	newRepository := repository
+
+	return newRepository
+}
+
+func (optionerFunc algoOrderRepositoryOptionerFunc) apply(
+	repository *algoOrderRepository,
+) {
+	optionerFunc(repository)
+}
@@ -0,0 +1,939 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+type (
+	// BalanceRepositorier is a interface.
+	BalanceRepositorier interface {
+		DAORepositorier[dao.Balancer, dao.BalanceFilterer]
+	}
+
+	// GetBalanceRepositorier is an interface.
+	GetBalanceRepositorier interface {
+		// GetBalanceRepositorier is a function.
+		GetBalanceRepositorier() BalanceRepositorier
+	}
+
+	balanceRepository struct {
+		configConfigger  config.Configger
+		gormDB           *gorm.DB
+		logRuntimeLogger log.RuntimeLogger
+		objectTimer      object.Timer
+		traceTracer      trace.Tracer
+		utilUUIDer       util.UUIDer
+	}
+
+	balanceRepositoryOptioner interface {
+		apply(*balanceRepository)
+	}
+
+	balanceRepositoryOptionerFunc func(*balanceRepository)
+)
+
+var (
+	_ BalanceRepositorier  = (*balanceRepository)(nil)
+	_ GetDB                = (*balanceRepository)(nil)
+	_ config.GetConfigger  = (*balanceRepository)(nil)
+	_ log.GetRuntimeLogger = (*balanceRepository)(nil)
+	_ object.GetTimer      = (*balanceRepository)(nil)
+	_ util.GetTracer       = (*balanceRepository)(nil)
+	_ util.GetUUIDer       = (*balanceRepository)(nil)
+)
+
+// NewBalanceRepository is a function.
+func NewBalanceRepository(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...balanceRepositoryOptioner,
+) *balanceRepository {
+	balanceRepository := &balanceRepository{
+		configConfigger:  configConfigger,
+		gormDB:           nil,
+		logRuntimeLogger: logRuntimeLogger,
+		objectTimer:      nil,
+		traceTracer:      traceTracer,
+		utilUUIDer:       utilUUIDer,
+	}
+
+	return balanceRepository.WithOptioners(optioners...)
+}
+
+// WithBalanceRepositoryTimer is a function.
+func WithBalanceRepositoryTimer(
+	objectTimer object.Timer,
+) balanceRepositoryOptioner {
+	return balanceRepositoryOptionerFunc(func(
+		config *balanceRepository,
+	) {
+		config.objectTimer = objectTimer
+	})
+}
+
+// WithBalanceRepositoryDB is a function.
+func WithBalanceRepositoryDB(
+	gormDB *gorm.DB,
+) balanceRepositoryOptioner {
+	return balanceRepositoryOptionerFunc(func(
+		config *balanceRepository,
+	) {
+		config.gormDB = gormDB.
+			Table(object.URITableKucoinBalance).
+			Session(&gorm.Session{
+				DryRun:                   false,
+				PrepareStmt:              true,
+				NewDB:                    true,
+				Initialized:              false,
+				SkipHooks:                true,
+				SkipDefaultTransaction:   true,
+				DisableNestedTransaction: true,
+				AllowGlobalUpdate:        false,
+				FullSaveAssociations:     false,
+				QueryFields:              true,
+				Context:                  nil,
+				Logger:                   nil,
+				NowFunc:                  nil,
+				CreateBatchSize:          0,
+			})
+	})
+}
+
+// GetDB is a function.
+func (repository *balanceRepository) GetDB() *gorm.DB {
+	return repository.gormDB
+}
+
+// GetConfigger is a function.
+func (repository *balanceRepository) GetConfigger() config.Configger {
+	return repository.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (repository *balanceRepository) GetRuntimeLogger() log.RuntimeLogger {
+	return repository.logRuntimeLogger
+}
+
+// GetTimer is a function.
+func (repository *balanceRepository) GetTimer() object.Timer {
+	return repository.objectTimer
+}
+
+// GetTracer is a function.
+func (repository *balanceRepository) GetTracer() trace.Tracer {
+	return repository.traceTracer
+}
+
+// GetUUIDer is a function.
+func (repository *balanceRepository) GetUUIDer() util.UUIDer {
+	return repository.utilUUIDer
+}
+
+// Create is a function.
+func (repository *balanceRepository) Create(
+	ctx context.Context,
+	daoBalancer dao.Balancer,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "Create",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       repository.GetConfigger(),
+		"dao_balancer": daoBalancer,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	id, err := repository.GetUUIDer().NewRandom()
+	if err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrUUIDerNewRandom.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrUUIDerNewRandom.Error())
+
+		return uuid.Nil, err
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldID, id).
+		Debug(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	daoBalance := dao.NewBalance(
+		nowUTC,
+		nowUTC,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		id,
+		daoBalancer.GetAccount(),
+		daoBalancer.GetAvailable(),
+		daoBalancer.GetBalance(),
+		daoBalancer.GetCurrency(),
+		daoBalancer.GetHolds(),
+		daoBalancer.GetKucoinID(),
+		daoBalancer.GetKucoinType(),
+		daoBalancer.GetPaper(),
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBalance, daoBalance).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Create(daoBalance.GetMap())
+	if err = gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBalanceRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	return daoBalance.GetID(), nil
+}
+
+// Delete is a function.
+func (repository *balanceRepository) Delete(
+	ctx context.Context,
+	id uuid.UUID,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Delete",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Delete",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+		"id":     id,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id": id,
+		}).
+		Updates(map[string]any{
+			"deleted_at": sql.NullTime{
+				Time:  nowUTC,
+				Valid: true,
+			},
+		})
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBalanceRepositoryDelete.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryDelete.Error())
+
+		return time.Time{}, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrBalanceRepositoryDelete).
+			Error(object.ErrBalanceRepositoryDelete.Error())
+		traceSpan.RecordError(object.ErrBalanceRepositoryDelete)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryDelete.Error())
+
+		return time.Time{}, object.ErrBalanceRepositoryDelete
+	}
+
+	return nowUTC, nil
+}
+
+// DeleteAll is a function.
+func (repository *balanceRepository) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Exec(fmt.Sprintf("DELETE FROM %s", object.URITableKucoinBalance))
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBalanceRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	return nowUTC, nil
+}
+
+// Read is a function.
+func (repository *balanceRepository) Read(
+	ctx context.Context,
+	id uuid.UUID,
+) (dao.Balancer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Read",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Read",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+		"id":     id,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	result := map[string]any{}
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id":         id,
+			"deleted_at": nil,
+		}).
+		Select(fmt.Sprintf("%s.*", object.URITableKucoinBalance)).
+		Find(result)
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBalanceRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrBalanceRepositoryRead).
+			Error(object.ErrBalanceRepositoryRead.Error())
+		traceSpan.RecordError(object.ErrBalanceRepositoryRead)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryRead.Error())
+
+		return nil, object.ErrBalanceRepositoryRead
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResult, result).
+		Debug(object.URIEmpty)
+
+	createdAT, ok := result["created_at"].(time.Time)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	updatedAT, ok := result["updated_at"].(time.Time)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	account, ok := result["account"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	available, ok := result["available"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	balanceValue, ok := result["balance"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	currency, ok := result["currency"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	holds, ok := result["holds"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	kucoinID, ok := result["kucoin_id"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	kucoinType, ok := result["kucoin_type"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	paper, ok := result["paper"].(bool)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	daoBalance := dao.NewBalance(
+		createdAT,
+		updatedAT,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		id,
+		account,
+		available,
+		balanceValue,
+		currency,
+		holds,
+		kucoinID,
+		kucoinType,
+		paper,
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBalance, daoBalance).
+		Debug(object.URIEmpty)
+
+	return daoBalance, nil
+}
+
+// ReadList is a function.
+func (repository *balanceRepository) ReadList(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoBalanceFilterer dao.BalanceFilterer,
+) ([]dao.Balancer, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"ReadList",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                 "ReadList",
+		"rt_ctx":               utilRuntimeContext,
+		"sp_ctx":               utilSpanContext,
+		"config":               repository.GetConfigger(),
+		"dao_paginationer":     daoPaginationer,
+		"dao_balance_filterer": daoBalanceFilterer,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	result := make([]map[string]any, 0, daoPaginationer.GetLimit()+1)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Scopes(
+			daoBalanceFilterer.Filter,
+			daoPaginationer.Pagination(object.URITableKucoinBalance),
+		).
+		Where(map[string]any{
+			"deleted_at": nil,
+		}).
+		Select(fmt.Sprintf("%s.*", object.URITableKucoinBalance)).
+		Find(&result)
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBalanceRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResult, result).
+		Debug(object.URIEmpty)
+
+	daoBalancers := make([]dao.Balancer, 0, daoPaginationer.GetLimit())
+
+	for key, value := range result {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		if uint32(key) == daoPaginationer.GetLimit() {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`uint32(key) == daoPaginationer.GetLimit()`)
+
+			break
+		}
+
+		id, err := repository.GetUUIDer().Parse(value["id"].(string))
+		if err != nil {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrUUIDerParse.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrUUIDerParse.Error())
+
+			return nil, nil, err
+		}
+
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldID, id).
+			Debug(object.URIEmpty)
+
+		createdAT, ok := value["created_at"].(time.Time)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		updatedAT, ok := value["updated_at"].(time.Time)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		account, ok := value["account"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		available, ok := value["available"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		balanceValue, ok := value["balance"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		currency, ok := value["currency"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		holds, ok := value["holds"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		kucoinID, ok := value["kucoin_id"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		kucoinType, ok := value["kucoin_type"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		paper, ok := value["paper"].(bool)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		daoBalancers = append(daoBalancers, dao.NewBalance(
+			createdAT,
+			updatedAT,
+			sql.NullTime{
+				Time:  time.Time{},
+				Valid: false,
+			},
+			id,
+			account,
+			available,
+			balanceValue,
+			currency,
+			holds,
+			kucoinID,
+			kucoinType,
+			paper,
+		))
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBalancers, daoBalancers).
+		Debug(object.URIEmpty)
+
+	var daoCursorer dao.Cursorer
+
+	if daoPaginationer.GetLimit() < uint32(len(result)) {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`daoPaginationer.GetLimit() < uint32(len(result))`)
+
+		daoCursorer = dao.NewCursor(
+			daoPaginationer.GetCursorer().GetOffset() + daoPaginationer.GetLimit(),
+		)
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	return daoBalancers, daoCursorer, nil
+}
+
+// Update is a function.
+func (repository *balanceRepository) Update(
+	ctx context.Context,
+	daoBalancer dao.Balancer,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Update",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "Update",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       repository.GetConfigger(),
+		"dao_balancer": daoBalancer,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	daoBalance := dao.NewBalance(
+		daoBalancer.GetCreatedAt(),
+		nowUTC,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		daoBalancer.GetID(),
+		daoBalancer.GetAccount(),
+		daoBalancer.GetAvailable(),
+		daoBalancer.GetBalance(),
+		daoBalancer.GetCurrency(),
+		daoBalancer.GetHolds(),
+		daoBalancer.GetKucoinID(),
+		daoBalancer.GetKucoinType(),
+		daoBalancer.GetPaper(),
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBalance, daoBalance).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id": daoBalancer.GetID(),
+		}).
+		Updates(daoBalance.GetMap())
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBalanceRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryUpdate.Error())
+
+		return time.Time{}, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrBalanceRepositoryUpdate).
+			Error(object.ErrBalanceRepositoryUpdate.Error())
+		traceSpan.RecordError(object.ErrBalanceRepositoryUpdate)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryUpdate.Error())
+
+		return time.Time{}, object.ErrBalanceRepositoryUpdate
+	}
+
+	return daoBalance.GetUpdatedAt(), nil
+}
+
+// WithOptioners is a function.
+func (repository *balanceRepository) WithOptioners(
+	optioners ...balanceRepositoryOptioner,
+) *balanceRepository {
+	newRepository := repository.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newRepository)
+	}
+
+	return newRepository
+}
+
+func (repository *balanceRepository) clone() *balanceRepository {
+	newRepository := repository
+
+	return newRepository
+}
+
+func (optionerFunc balanceRepositoryOptionerFunc) apply(
+	repository *balanceRepository,
+) {
+	optionerFunc(repository)
+}
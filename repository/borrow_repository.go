@@ -0,0 +1,1107 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+type (
+	// BorrowRepositorier is a interface.
+	BorrowRepositorier interface {
+		DAORepositorier[dao.Borrower, dao.BorrowFilterer]
+	}
+
+	// GetBorrowRepositorier is an interface.
+	GetBorrowRepositorier interface {
+		// GetBorrowRepositorier is a function.
+		GetBorrowRepositorier() BorrowRepositorier
+	}
+
+	borrowRepository struct {
+		configConfigger  config.Configger
+		gormDB           *gorm.DB
+		logRuntimeLogger log.RuntimeLogger
+		objectTimer      object.Timer
+		traceTracer      trace.Tracer
+		utilUUIDer       util.UUIDer
+	}
+
+	borrowRepositoryOptioner interface {
+		apply(*borrowRepository)
+	}
+
+	borrowRepositoryOptionerFunc func(*borrowRepository)
+)
+
+var (
+	_ BorrowRepositorier   = (*borrowRepository)(nil)
+	_ GetDB                = (*borrowRepository)(nil)
+	_ config.GetConfigger  = (*borrowRepository)(nil)
+	_ log.GetRuntimeLogger = (*borrowRepository)(nil)
+	_ object.GetTimer      = (*borrowRepository)(nil)
+	_ util.GetTracer       = (*borrowRepository)(nil)
+	_ util.GetUUIDer       = (*borrowRepository)(nil)
+)
+
+// NewBorrowRepository is a function.
+func NewBorrowRepository(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...borrowRepositoryOptioner,
+) *borrowRepository {
+	borrowRepository := &borrowRepository{
+		configConfigger:  configConfigger,
+		gormDB:           nil,
+		logRuntimeLogger: logRuntimeLogger,
+		objectTimer:      nil,
+		traceTracer:      traceTracer,
+		utilUUIDer:       utilUUIDer,
+	}
+
+	return borrowRepository.WithOptioners(optioners...)
+}
+
+// WithBorrowRepositoryTimer is a function.
+func WithBorrowRepositoryTimer(
+	objectTimer object.Timer,
+) borrowRepositoryOptioner {
+	return borrowRepositoryOptionerFunc(func(
+		config *borrowRepository,
+	) {
+		config.objectTimer = objectTimer
+	})
+}
+
+// WithBorrowRepositoryDB is a function.
+func WithBorrowRepositoryDB(
+	gormDB *gorm.DB,
+) borrowRepositoryOptioner {
+	return borrowRepositoryOptionerFunc(func(
+		config *borrowRepository,
+	) {
+		config.gormDB = gormDB.
+			Table(object.URITableKucoinBorrow).
+			Session(&gorm.Session{
+				DryRun:                   false,
+				PrepareStmt:              true,
+				NewDB:                    true,
+				Initialized:              false,
+				SkipHooks:                true,
+				SkipDefaultTransaction:   true,
+				DisableNestedTransaction: true,
+				AllowGlobalUpdate:        false,
+				FullSaveAssociations:     false,
+				QueryFields:              true,
+				Context:                  nil,
+				Logger:                   nil,
+				NowFunc:                  nil,
+				CreateBatchSize:          0,
+			})
+	})
+}
+
+// GetDB is a function.
+func (repository *borrowRepository) GetDB() *gorm.DB {
+	return repository.gormDB
+}
+
+// GetConfigger is a function.
+func (repository *borrowRepository) GetConfigger() config.Configger {
+	return repository.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (repository *borrowRepository) GetRuntimeLogger() log.RuntimeLogger {
+	return repository.logRuntimeLogger
+}
+
+// GetTimer is a function.
+func (repository *borrowRepository) GetTimer() object.Timer {
+	return repository.objectTimer
+}
+
+// GetTracer is a function.
+func (repository *borrowRepository) GetTracer() trace.Tracer {
+	return repository.traceTracer
+}
+
+// GetUUIDer is a function.
+func (repository *borrowRepository) GetUUIDer() util.UUIDer {
+	return repository.utilUUIDer
+}
+
+// Create is a function.
+func (repository *borrowRepository) Create(
+	ctx context.Context,
+	daoBorrower dao.Borrower,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "Create",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       repository.GetConfigger(),
+		"dao_borrower": daoBorrower,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	id, err := repository.GetUUIDer().NewRandom()
+	if err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrUUIDerNewRandom.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrUUIDerNewRandom.Error())
+
+		return uuid.Nil, err
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldID, id).
+		Debug(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	daoBorrow := dao.NewBorrow(
+		nowUTC,
+		nowUTC,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		id,
+		daoBorrower.GetAccount(),
+		daoBorrower.GetAccruedInterest(),
+		daoBorrower.GetCurrency(),
+		daoBorrower.GetDailyIntRate(),
+		daoBorrower.GetInterest(),
+		daoBorrower.GetLiability(),
+		daoBorrower.GetPrincipal(),
+		daoBorrower.GetRepaidSize(),
+		daoBorrower.GetStatus(),
+		daoBorrower.GetTradeID(),
+		daoBorrower.GetKucoinCreatedAt(),
+		daoBorrower.GetMaturityTime(),
+		daoBorrower.GetRepaidAt(),
+		daoBorrower.GetTerm(),
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBorrow, daoBorrow).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Create(daoBorrow.GetMap())
+	if err = gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBorrowRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	return daoBorrow.GetID(), nil
+}
+
+// Delete is a function.
+func (repository *borrowRepository) Delete(
+	ctx context.Context,
+	id uuid.UUID,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Delete",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Delete",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+		"id":     id,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id": id,
+		}).
+		Updates(map[string]any{
+			"deleted_at": sql.NullTime{
+				Time:  nowUTC,
+				Valid: true,
+			},
+		})
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBorrowRepositoryDelete.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryDelete.Error())
+
+		return time.Time{}, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrBorrowRepositoryDelete).
+			Error(object.ErrBorrowRepositoryDelete.Error())
+		traceSpan.RecordError(object.ErrBorrowRepositoryDelete)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryDelete.Error())
+
+		return time.Time{}, object.ErrBorrowRepositoryDelete
+	}
+
+	return nowUTC, nil
+}
+
+// DeleteAll is a function.
+func (repository *borrowRepository) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Exec(fmt.Sprintf("DELETE FROM %s", object.URITableKucoinBorrow))
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBorrowRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	return nowUTC, nil
+}
+
+// Read is a function.
+func (repository *borrowRepository) Read(
+	ctx context.Context,
+	id uuid.UUID,
+) (dao.Borrower, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Read",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Read",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+		"id":     id,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	result := map[string]any{}
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id":         id,
+			"deleted_at": nil,
+		}).
+		Select(fmt.Sprintf("%s.*", object.URITableKucoinBorrow)).
+		Find(result)
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBorrowRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrBorrowRepositoryRead).
+			Error(object.ErrBorrowRepositoryRead.Error())
+		traceSpan.RecordError(object.ErrBorrowRepositoryRead)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryRead.Error())
+
+		return nil, object.ErrBorrowRepositoryRead
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResult, result).
+		Debug(object.URIEmpty)
+
+	createdAT, ok := result["created_at"].(time.Time)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	updatedAT, ok := result["updated_at"].(time.Time)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	account, ok := result["account"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	accruedInterest, ok := result["accrued_interest"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	currency, ok := result["currency"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	dailyIntRate, ok := result["daily_int_rate"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	interest, ok := result["interest"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	liability, ok := result["liability"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	principal, ok := result["principal"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	repaidSize, ok := result["repaid_size"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	status, ok := result["status"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	tradeID, ok := result["trade_id"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	kucoinCreatedAt, ok := result["kucoin_created_at"].(int64)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	maturityTime, ok := result["maturity_time"].(int64)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	repaidAt, ok := result["repaid_at"].(int64)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	term, ok := result["term"].(int64)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	daoBorrow := dao.NewBorrow(
+		createdAT,
+		updatedAT,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		id,
+		account,
+		accruedInterest,
+		currency,
+		dailyIntRate,
+		interest,
+		liability,
+		principal,
+		repaidSize,
+		status,
+		tradeID,
+		kucoinCreatedAt,
+		maturityTime,
+		repaidAt,
+		term,
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBorrow, daoBorrow).
+		Debug(object.URIEmpty)
+
+	return daoBorrow, nil
+}
+
+// ReadList is a function.
+func (repository *borrowRepository) ReadList(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoBorrowFilterer dao.BorrowFilterer,
+) ([]dao.Borrower, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"ReadList",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                "ReadList",
+		"rt_ctx":              utilRuntimeContext,
+		"sp_ctx":              utilSpanContext,
+		"config":              repository.GetConfigger(),
+		"dao_paginationer":    daoPaginationer,
+		"dao_borrow_filterer": daoBorrowFilterer,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	result := make([]map[string]any, 0, daoPaginationer.GetLimit()+1)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Scopes(
+			daoBorrowFilterer.Filter,
+			daoPaginationer.Pagination(object.URITableKucoinBorrow),
+		).
+		Where(map[string]any{
+			"deleted_at": nil,
+		}).
+		Select(fmt.Sprintf("%s.*", object.URITableKucoinBorrow)).
+		Find(&result)
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBorrowRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResult, result).
+		Debug(object.URIEmpty)
+
+	daoBorrowers := make([]dao.Borrower, 0, daoPaginationer.GetLimit())
+
+	for key, value := range result {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		if uint32(key) == daoPaginationer.GetLimit() {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`uint32(key) == daoPaginationer.GetLimit()`)
+
+			break
+		}
+
+		id, err := repository.GetUUIDer().Parse(value["id"].(string))
+		if err != nil {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrUUIDerParse.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrUUIDerParse.Error())
+
+			return nil, nil, err
+		}
+
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldID, id).
+			Debug(object.URIEmpty)
+
+		createdAT, ok := value["created_at"].(time.Time)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		updatedAT, ok := value["updated_at"].(time.Time)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		account, ok := value["account"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		accruedInterest, ok := value["accrued_interest"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		currency, ok := value["currency"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		dailyIntRate, ok := value["daily_int_rate"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		interest, ok := value["interest"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		liability, ok := value["liability"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		principal, ok := value["principal"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		repaidSize, ok := value["repaid_size"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		status, ok := value["status"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		tradeID, ok := value["trade_id"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		kucoinCreatedAt, ok := value["kucoin_created_at"].(int64)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		maturityTime, ok := value["maturity_time"].(int64)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		repaidAt, ok := value["repaid_at"].(int64)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		term, ok := value["term"].(int64)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		daoBorrowers = append(daoBorrowers, dao.NewBorrow(
+			createdAT,
+			updatedAT,
+			sql.NullTime{
+				Time:  time.Time{},
+				Valid: false,
+			},
+			id,
+			account,
+			accruedInterest,
+			currency,
+			dailyIntRate,
+			interest,
+			liability,
+			principal,
+			repaidSize,
+			status,
+			tradeID,
+			kucoinCreatedAt,
+			maturityTime,
+			repaidAt,
+			term,
+		))
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBorrowers, daoBorrowers).
+		Debug(object.URIEmpty)
+
+	var daoCursorer dao.Cursorer
+
+	if daoPaginationer.GetLimit() < uint32(len(result)) {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`daoPaginationer.GetLimit() < uint32(len(result))`)
+
+		daoCursorer = dao.NewCursor(
+			daoPaginationer.GetCursorer().GetOffset() + daoPaginationer.GetLimit(),
+		)
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	return daoBorrowers, daoCursorer, nil
+}
+
+// Update is a function.
+func (repository *borrowRepository) Update(
+	ctx context.Context,
+	daoBorrower dao.Borrower,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Update",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "Update",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       repository.GetConfigger(),
+		"dao_borrower": daoBorrower,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	daoBorrow := dao.NewBorrow(
+		daoBorrower.GetCreatedAt(),
+		nowUTC,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		daoBorrower.GetID(),
+		daoBorrower.GetAccount(),
+		daoBorrower.GetAccruedInterest(),
+		daoBorrower.GetCurrency(),
+		daoBorrower.GetDailyIntRate(),
+		daoBorrower.GetInterest(),
+		daoBorrower.GetLiability(),
+		daoBorrower.GetPrincipal(),
+		daoBorrower.GetRepaidSize(),
+		daoBorrower.GetStatus(),
+		daoBorrower.GetTradeID(),
+		daoBorrower.GetKucoinCreatedAt(),
+		daoBorrower.GetMaturityTime(),
+		daoBorrower.GetRepaidAt(),
+		daoBorrower.GetTerm(),
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBorrow, daoBorrow).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id": daoBorrower.GetID(),
+		}).
+		Updates(daoBorrow.GetMap())
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBorrowRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryUpdate.Error())
+
+		return time.Time{}, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrBorrowRepositoryUpdate).
+			Error(object.ErrBorrowRepositoryUpdate.Error())
+		traceSpan.RecordError(object.ErrBorrowRepositoryUpdate)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryUpdate.Error())
+
+		return time.Time{}, object.ErrBorrowRepositoryUpdate
+	}
+
+	return daoBorrow.GetUpdatedAt(), nil
+}
+
+// WithOptioners is a function.
+func (repository *borrowRepository) WithOptioners(
+	optioners ...borrowRepositoryOptioner,
+) *borrowRepository {
+	newRepository := repository.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newRepository)
+	}
+
+	return newRepository
+}
+
+func (repository *borrowRepository) clone() *borrowRepository {
+	newRepository := repository
+
+	return newRepository
+}
+
+func (optionerFunc borrowRepositoryOptionerFunc) apply(
+	repository *borrowRepository,
+) {
+	optionerFunc(repository)
+}
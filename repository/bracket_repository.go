@@ -0,0 +1,1163 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+type (
+	// BracketRepositorier is a interface.
+	BracketRepositorier interface {
+		DAORepositorier[dao.Bracketer, dao.BracketFilterer]
+	}
+
+	// GetBracketRepositorier is an interface.
+	GetBracketRepositorier interface {
+		// GetBracketRepositorier is a function.
+		GetBracketRepositorier() BracketRepositorier
+	}
+
+	bracketRepository struct {
+		configConfigger  config.Configger
+		gormDB           *gorm.DB
+		logRuntimeLogger log.RuntimeLogger
+		objectTimer      object.Timer
+		traceTracer      trace.Tracer
+		utilUUIDer       util.UUIDer
+	}
+
+	bracketRepositoryOptioner interface {
+		apply(*bracketRepository)
+	}
+
+	bracketRepositoryOptionerFunc func(*bracketRepository)
+)
+
+var (
+	_ BracketRepositorier  = (*bracketRepository)(nil)
+	_ GetDB                = (*bracketRepository)(nil)
+	_ config.GetConfigger  = (*bracketRepository)(nil)
+	_ log.GetRuntimeLogger = (*bracketRepository)(nil)
+	_ object.GetTimer      = (*bracketRepository)(nil)
+	_ util.GetTracer       = (*bracketRepository)(nil)
+	_ util.GetUUIDer       = (*bracketRepository)(nil)
+)
+
+// NewBracketRepository is a function.
+func NewBracketRepository(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...bracketRepositoryOptioner,
+) *bracketRepository {
+	bracketRepository := &bracketRepository{
+		configConfigger:  configConfigger,
+		gormDB:           nil,
+		logRuntimeLogger: logRuntimeLogger,
+		objectTimer:      nil,
+		traceTracer:      traceTracer,
+		utilUUIDer:       utilUUIDer,
+	}
+
+	return bracketRepository.WithOptioners(optioners...)
+}
+
+// WithBracketRepositoryTimer is a function.
+func WithBracketRepositoryTimer(
+	objectTimer object.Timer,
+) bracketRepositoryOptioner {
+	return bracketRepositoryOptionerFunc(func(
+		config *bracketRepository,
+	) {
+		config.objectTimer = objectTimer
+	})
+}
+
+// WithBracketRepositoryDB is a function.
+func WithBracketRepositoryDB(
+	gormDB *gorm.DB,
+) bracketRepositoryOptioner {
+	return bracketRepositoryOptionerFunc(func(
+		config *bracketRepository,
+	) {
+		config.gormDB = gormDB.
+			Table(object.URITableKucoinBracket).
+			Session(&gorm.Session{
+				DryRun:                   false,
+				PrepareStmt:              true,
+				NewDB:                    true,
+				Initialized:              false,
+				SkipHooks:                true,
+				SkipDefaultTransaction:   true,
+				DisableNestedTransaction: true,
+				AllowGlobalUpdate:        false,
+				FullSaveAssociations:     false,
+				QueryFields:              true,
+				Context:                  nil,
+				Logger:                   nil,
+				NowFunc:                  nil,
+				CreateBatchSize:          0,
+			})
+	})
+}
+
+// GetDB is a function.
+func (repository *bracketRepository) GetDB() *gorm.DB {
+	return repository.gormDB
+}
+
+// GetConfigger is a function.
+func (repository *bracketRepository) GetConfigger() config.Configger {
+	return repository.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (repository *bracketRepository) GetRuntimeLogger() log.RuntimeLogger {
+	return repository.logRuntimeLogger
+}
+
+// GetTimer is a function.
+func (repository *bracketRepository) GetTimer() object.Timer {
+	return repository.objectTimer
+}
+
+// GetTracer is a function.
+func (repository *bracketRepository) GetTracer() trace.Tracer {
+	return repository.traceTracer
+}
+
+// GetUUIDer is a function.
+func (repository *bracketRepository) GetUUIDer() util.UUIDer {
+	return repository.utilUUIDer
+}
+
+// Create is a function.
+func (repository *bracketRepository) Create(
+	ctx context.Context,
+	daoBracketer dao.Bracketer,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":          "Create",
+		"rt_ctx":        utilRuntimeContext,
+		"sp_ctx":        utilSpanContext,
+		"config":        repository.GetConfigger(),
+		"dao_bracketer": daoBracketer,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	id, err := repository.GetUUIDer().NewRandom()
+	if err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrUUIDerNewRandom.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrUUIDerNewRandom.Error())
+
+		return uuid.Nil, err
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldID, id).
+		Debug(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	daoBracket := dao.NewBracket(
+		nowUTC,
+		nowUTC,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		id,
+		daoBracketer.GetAccount(),
+		daoBracketer.GetEntryClientOID(),
+		daoBracketer.GetExitClientOID(),
+		daoBracketer.GetReason(),
+		daoBracketer.GetSide(),
+		daoBracketer.GetSize(),
+		daoBracketer.GetState(),
+		daoBracketer.GetStopClientOID(),
+		daoBracketer.GetStopPrice(),
+		daoBracketer.GetSymbol(),
+		daoBracketer.GetTakeProfitClientOID(),
+		daoBracketer.GetTakeProfitPrice(),
+		daoBracketer.GetTrailingDistance(),
+		daoBracketer.GetTrailingPrice(),
+		daoBracketer.GetIsActive(),
+		daoBracketer.GetPaper(),
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBracket, daoBracket).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Create(daoBracket.GetMap())
+	if err = gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	return daoBracket.GetID(), nil
+}
+
+// Delete is a function.
+func (repository *bracketRepository) Delete(
+	ctx context.Context,
+	id uuid.UUID,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Delete",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Delete",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+		"id":     id,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id": id,
+		}).
+		Updates(map[string]any{
+			"deleted_at": sql.NullTime{
+				Time:  nowUTC,
+				Valid: true,
+			},
+		})
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketRepositoryDelete.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryDelete.Error())
+
+		return time.Time{}, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrBracketRepositoryDelete).
+			Error(object.ErrBracketRepositoryDelete.Error())
+		traceSpan.RecordError(object.ErrBracketRepositoryDelete)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryDelete.Error())
+
+		return time.Time{}, object.ErrBracketRepositoryDelete
+	}
+
+	return nowUTC, nil
+}
+
+// DeleteAll is a function.
+func (repository *bracketRepository) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Exec(fmt.Sprintf("DELETE FROM %s", object.URITableKucoinBracket))
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	return nowUTC, nil
+}
+
+// Read is a function.
+func (repository *bracketRepository) Read(
+	ctx context.Context,
+	id uuid.UUID,
+) (dao.Bracketer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Read",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Read",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+		"id":     id,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	result := map[string]any{}
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id":         id,
+			"deleted_at": nil,
+		}).
+		Select(fmt.Sprintf("%s.*", object.URITableKucoinBracket)).
+		Find(result)
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrBracketRepositoryRead).
+			Error(object.ErrBracketRepositoryRead.Error())
+		traceSpan.RecordError(object.ErrBracketRepositoryRead)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryRead.Error())
+
+		return nil, object.ErrBracketRepositoryRead
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResult, result).
+		Debug(object.URIEmpty)
+
+	createdAT, ok := result["created_at"].(time.Time)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	updatedAT, ok := result["updated_at"].(time.Time)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	account, ok := result["account"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	entryClientOID, ok := result["entry_client_oid"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	exitClientOID, ok := result["exit_client_oid"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	reason, ok := result["reason"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	side, ok := result["side"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	size, ok := result["size"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	state, ok := result["state"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	stopClientOID, ok := result["stop_client_oid"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	stopPrice, ok := result["stop_price"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	symbol, ok := result["symbol"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	takeProfitClientOID, ok := result["take_profit_client_oid"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	takeProfitPrice, ok := result["take_profit_price"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	trailingDistance, ok := result["trailing_distance"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	trailingPrice, ok := result["trailing_price"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	isActive, ok := result["is_active"].(bool)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	paper, ok := result["paper"].(bool)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	daoBracket := dao.NewBracket(
+		createdAT,
+		updatedAT,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		id,
+		account,
+		entryClientOID,
+		exitClientOID,
+		reason,
+		side,
+		size,
+		state,
+		stopClientOID,
+		stopPrice,
+		symbol,
+		takeProfitClientOID,
+		takeProfitPrice,
+		trailingDistance,
+		trailingPrice,
+		isActive,
+		paper,
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBracket, daoBracket).
+		Debug(object.URIEmpty)
+
+	return daoBracket, nil
+}
+
+// ReadList is a function.
+func (repository *bracketRepository) ReadList(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoBracketFilterer dao.BracketFilterer,
+) ([]dao.Bracketer, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"ReadList",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                 "ReadList",
+		"rt_ctx":               utilRuntimeContext,
+		"sp_ctx":               utilSpanContext,
+		"config":               repository.GetConfigger(),
+		"dao_paginationer":     daoPaginationer,
+		"dao_bracket_filterer": daoBracketFilterer,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	result := make([]map[string]any, 0, daoPaginationer.GetLimit()+1)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Scopes(
+			daoBracketFilterer.Filter,
+			daoPaginationer.Pagination(object.URITableKucoinBracket),
+		).
+		Where(map[string]any{
+			"deleted_at": nil,
+		}).
+		Select(fmt.Sprintf("%s.*", object.URITableKucoinBracket)).
+		Find(&result)
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResult, result).
+		Debug(object.URIEmpty)
+
+	daoBracketers := make([]dao.Bracketer, 0, daoPaginationer.GetLimit())
+
+	for key, value := range result {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		if uint32(key) == daoPaginationer.GetLimit() {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`uint32(key) == daoPaginationer.GetLimit()`)
+
+			break
+		}
+
+		id, err := repository.GetUUIDer().Parse(value["id"].(string))
+		if err != nil {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrUUIDerParse.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrUUIDerParse.Error())
+
+			return nil, nil, err
+		}
+
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldID, id).
+			Debug(object.URIEmpty)
+
+		createdAT, ok := value["created_at"].(time.Time)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		updatedAT, ok := value["updated_at"].(time.Time)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		account, ok := value["account"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		entryClientOID, ok := value["entry_client_oid"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		exitClientOID, ok := value["exit_client_oid"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		reason, ok := value["reason"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		side, ok := value["side"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		size, ok := value["size"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		state, ok := value["state"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		stopClientOID, ok := value["stop_client_oid"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		stopPrice, ok := value["stop_price"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		symbol, ok := value["symbol"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		takeProfitClientOID, ok := value["take_profit_client_oid"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		takeProfitPrice, ok := value["take_profit_price"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		trailingDistance, ok := value["trailing_distance"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		trailingPrice, ok := value["trailing_price"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		isActive, ok := value["is_active"].(bool)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		paper, ok := value["paper"].(bool)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		daoBracketers = append(daoBracketers, dao.NewBracket(
+			createdAT,
+			updatedAT,
+			sql.NullTime{
+				Time:  time.Time{},
+				Valid: false,
+			},
+			id,
+			account,
+			entryClientOID,
+			exitClientOID,
+			reason,
+			side,
+			size,
+			state,
+			stopClientOID,
+			stopPrice,
+			symbol,
+			takeProfitClientOID,
+			takeProfitPrice,
+			trailingDistance,
+			trailingPrice,
+			isActive,
+			paper,
+		))
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBracketers, daoBracketers).
+		Debug(object.URIEmpty)
+
+	var daoCursorer dao.Cursorer
+
+	if daoPaginationer.GetLimit() < uint32(len(result)) {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`daoPaginationer.GetLimit() < uint32(len(result))`)
+
+		daoCursorer = dao.NewCursor(
+			daoPaginationer.GetCursorer().GetOffset() + daoPaginationer.GetLimit(),
+		)
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	return daoBracketers, daoCursorer, nil
+}
+
+// Update is a function.
+func (repository *bracketRepository) Update(
+	ctx context.Context,
+	daoBracketer dao.Bracketer,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Update",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":          "Update",
+		"rt_ctx":        utilRuntimeContext,
+		"sp_ctx":        utilSpanContext,
+		"config":        repository.GetConfigger(),
+		"dao_bracketer": daoBracketer,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	daoBracket := dao.NewBracket(
+		daoBracketer.GetCreatedAt(),
+		nowUTC,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		daoBracketer.GetID(),
+		daoBracketer.GetAccount(),
+		daoBracketer.GetEntryClientOID(),
+		daoBracketer.GetExitClientOID(),
+		daoBracketer.GetReason(),
+		daoBracketer.GetSide(),
+		daoBracketer.GetSize(),
+		daoBracketer.GetState(),
+		daoBracketer.GetStopClientOID(),
+		daoBracketer.GetStopPrice(),
+		daoBracketer.GetSymbol(),
+		daoBracketer.GetTakeProfitClientOID(),
+		daoBracketer.GetTakeProfitPrice(),
+		daoBracketer.GetTrailingDistance(),
+		daoBracketer.GetTrailingPrice(),
+		daoBracketer.GetIsActive(),
+		daoBracketer.GetPaper(),
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBracket, daoBracket).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id": daoBracketer.GetID(),
+		}).
+		Updates(daoBracket.GetMap())
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryUpdate.Error())
+
+		return time.Time{}, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrBracketRepositoryUpdate).
+			Error(object.ErrBracketRepositoryUpdate.Error())
+		traceSpan.RecordError(object.ErrBracketRepositoryUpdate)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryUpdate.Error())
+
+		return time.Time{}, object.ErrBracketRepositoryUpdate
+	}
+
+	return daoBracket.GetUpdatedAt(), nil
+}
+
+// WithOptioners is a function.
+func (repository *bracketRepository) WithOptioners(
+	optioners ...bracketRepositoryOptioner,
+) *bracketRepository {
+	newRepository := repository.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newRepository)
+	}
+
+	return newRepository
+}
+
+func (repository *bracketRepository) clone() *bracketRepository {
+	newRepository := repository
+
+	return newRepository
+}
+
+func (optionerFunc bracketRepositoryOptionerFunc) apply(
+	repository *bracketRepository,
+) {
+	optionerFunc(repository)
+}
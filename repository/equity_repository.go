@@ -0,0 +1,939 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+type (
+	// EquityRepositorier is a interface.
+	EquityRepositorier interface {
+		DAORepositorier[dao.Equitier, dao.EquityFilterer]
+	}
+
+	// GetEquityRepositorier is an interface.
+	GetEquityRepositorier interface {
+		// GetEquityRepositorier is a function.
+		GetEquityRepositorier() EquityRepositorier
+	}
+
+	equityRepository struct {
+		configConfigger  config.Configger
+		gormDB           *gorm.DB
+		logRuntimeLogger log.RuntimeLogger
+		objectTimer      object.Timer
+		traceTracer      trace.Tracer
+		utilUUIDer       util.UUIDer
+	}
+
+	equityRepositoryOptioner interface {
+		apply(*equityRepository)
+	}
+
+	equityRepositoryOptionerFunc func(*equityRepository)
+)
+
+var (
+	_ EquityRepositorier   = (*equityRepository)(nil)
+	_ GetDB                = (*equityRepository)(nil)
+	_ config.GetConfigger  = (*equityRepository)(nil)
+	_ log.GetRuntimeLogger = (*equityRepository)(nil)
+	_ object.GetTimer      = (*equityRepository)(nil)
+	_ util.GetTracer       = (*equityRepository)(nil)
+	_ util.GetUUIDer       = (*equityRepository)(nil)
+)
+
+// NewEquityRepository is a function.
+func NewEquityRepository(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...equityRepositoryOptioner,
+) *equityRepository {
+	equityRepository := &equityRepository{
+		configConfigger:  configConfigger,
+		gormDB:           nil,
+		logRuntimeLogger: logRuntimeLogger,
+		objectTimer:      nil,
+		traceTracer:      traceTracer,
+		utilUUIDer:       utilUUIDer,
+	}
+
+	return equityRepository.WithOptioners(optioners...)
+}
+
+// WithEquityRepositoryTimer is a function.
+func WithEquityRepositoryTimer(
+	objectTimer object.Timer,
+) equityRepositoryOptioner {
+	return equityRepositoryOptionerFunc(func(
+		config *equityRepository,
+	) {
+		config.objectTimer = objectTimer
+	})
+}
+
+// WithEquityRepositoryDB is a function.
+func WithEquityRepositoryDB(
+	gormDB *gorm.DB,
+) equityRepositoryOptioner {
+	return equityRepositoryOptionerFunc(func(
+		config *equityRepository,
+	) {
+		config.gormDB = gormDB.
+			Table(object.URITableKucoinEquity).
+			Session(&gorm.Session{
+				DryRun:                   false,
+				PrepareStmt:              true,
+				NewDB:                    true,
+				Initialized:              false,
+				SkipHooks:                true,
+				SkipDefaultTransaction:   true,
+				DisableNestedTransaction: true,
+				AllowGlobalUpdate:        false,
+				FullSaveAssociations:     false,
+				QueryFields:              true,
+				Context:                  nil,
+				Logger:                   nil,
+				NowFunc:                  nil,
+				CreateBatchSize:          0,
+			})
+	})
+}
+
+// GetDB is a function.
+func (repository *equityRepository) GetDB() *gorm.DB {
+	return repository.gormDB
+}
+
+// GetConfigger is a function.
+func (repository *equityRepository) GetConfigger() config.Configger {
+	return repository.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (repository *equityRepository) GetRuntimeLogger() log.RuntimeLogger {
+	return repository.logRuntimeLogger
+}
+
+// GetTimer is a function.
+func (repository *equityRepository) GetTimer() object.Timer {
+	return repository.objectTimer
+}
+
+// GetTracer is a function.
+func (repository *equityRepository) GetTracer() trace.Tracer {
+	return repository.traceTracer
+}
+
+// GetUUIDer is a function.
+func (repository *equityRepository) GetUUIDer() util.UUIDer {
+	return repository.utilUUIDer
+}
+
+// Create is a function.
+func (repository *equityRepository) Create(
+	ctx context.Context,
+	daoEquitier dao.Equitier,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "Create",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       repository.GetConfigger(),
+		"dao_equitier": daoEquitier,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	id, err := repository.GetUUIDer().NewRandom()
+	if err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrUUIDerNewRandom.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrUUIDerNewRandom.Error())
+
+		return uuid.Nil, err
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldID, id).
+		Debug(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	daoEquity := dao.NewEquity(
+		nowUTC,
+		nowUTC,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		id,
+		daoEquitier.GetAccount(),
+		daoEquitier.GetCurrency(),
+		daoEquitier.GetEquity(),
+		daoEquitier.GetFee(),
+		daoEquitier.GetRealizedPnL(),
+		daoEquitier.GetUnrealizedPnL(),
+		daoEquitier.GetSnapshotAt(),
+		daoEquitier.GetPaper(),
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOEquity, daoEquity).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Create(daoEquity.GetMap())
+	if err = gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrEquityRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrEquityRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	return daoEquity.GetID(), nil
+}
+
+// Delete is a function.
+func (repository *equityRepository) Delete(
+	ctx context.Context,
+	id uuid.UUID,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Delete",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Delete",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+		"id":     id,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id": id,
+		}).
+		Updates(map[string]any{
+			"deleted_at": sql.NullTime{
+				Time:  nowUTC,
+				Valid: true,
+			},
+		})
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrEquityRepositoryDelete.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrEquityRepositoryDelete.Error())
+
+		return time.Time{}, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrEquityRepositoryDelete).
+			Error(object.ErrEquityRepositoryDelete.Error())
+		traceSpan.RecordError(object.ErrEquityRepositoryDelete)
+		traceSpan.SetStatus(codes.Error, object.ErrEquityRepositoryDelete.Error())
+
+		return time.Time{}, object.ErrEquityRepositoryDelete
+	}
+
+	return nowUTC, nil
+}
+
+// DeleteAll is a function.
+func (repository *equityRepository) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Exec(fmt.Sprintf("DELETE FROM %s", object.URITableKucoinEquity))
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrEquityRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrEquityRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	return nowUTC, nil
+}
+
+// Read is a function.
+func (repository *equityRepository) Read(
+	ctx context.Context,
+	id uuid.UUID,
+) (dao.Equitier, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Read",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Read",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": repository.GetConfigger(),
+		"id":     id,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	result := map[string]any{}
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id":         id,
+			"deleted_at": nil,
+		}).
+		Select(fmt.Sprintf("%s.*", object.URITableKucoinEquity)).
+		Find(result)
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrEquityRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrEquityRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrEquityRepositoryRead).
+			Error(object.ErrEquityRepositoryRead.Error())
+		traceSpan.RecordError(object.ErrEquityRepositoryRead)
+		traceSpan.SetStatus(codes.Error, object.ErrEquityRepositoryRead.Error())
+
+		return nil, object.ErrEquityRepositoryRead
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResult, result).
+		Debug(object.URIEmpty)
+
+	createdAT, ok := result["created_at"].(time.Time)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	updatedAT, ok := result["updated_at"].(time.Time)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	account, ok := result["account"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	currency, ok := result["currency"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	equityValue, ok := result["equity"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	fee, ok := result["fee"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	realizedPnL, ok := result["realized_pnl"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	unrealizedPnL, ok := result["unrealized_pnl"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	snapshotAt, ok := result["snapshot_at"].(int64)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	paper, ok := result["paper"].(bool)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
+	daoEquity := dao.NewEquity(
+		createdAT,
+		updatedAT,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		id,
+		account,
+		currency,
+		equityValue,
+		fee,
+		realizedPnL,
+		unrealizedPnL,
+		snapshotAt,
+		paper,
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOEquity, daoEquity).
+		Debug(object.URIEmpty)
+
+	return daoEquity, nil
+}
+
+// ReadList is a function.
+func (repository *equityRepository) ReadList(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoEquityFilterer dao.EquityFilterer,
+) ([]dao.Equitier, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"ReadList",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                "ReadList",
+		"rt_ctx":              utilRuntimeContext,
+		"sp_ctx":              utilSpanContext,
+		"config":              repository.GetConfigger(),
+		"dao_paginationer":    daoPaginationer,
+		"dao_equity_filterer": daoEquityFilterer,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	result := make([]map[string]any, 0, daoPaginationer.GetLimit()+1)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Scopes(
+			daoEquityFilterer.Filter,
+			daoPaginationer.Pagination(object.URITableKucoinEquity),
+		).
+		Where(map[string]any{
+			"deleted_at": nil,
+		}).
+		Select(fmt.Sprintf("%s.*", object.URITableKucoinEquity)).
+		Find(&result)
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrEquityRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrEquityRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResult, result).
+		Debug(object.URIEmpty)
+
+	daoEquitiers := make([]dao.Equitier, 0, daoPaginationer.GetLimit())
+
+	for key, value := range result {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		if uint32(key) == daoPaginationer.GetLimit() {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`uint32(key) == daoPaginationer.GetLimit()`)
+
+			break
+		}
+
+		id, err := repository.GetUUIDer().Parse(value["id"].(string))
+		if err != nil {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrUUIDerParse.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrUUIDerParse.Error())
+
+			return nil, nil, err
+		}
+
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldID, id).
+			Debug(object.URIEmpty)
+
+		createdAT, ok := value["created_at"].(time.Time)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		updatedAT, ok := value["updated_at"].(time.Time)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		account, ok := value["account"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		currency, ok := value["currency"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		equityValue, ok := value["equity"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		fee, ok := value["fee"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		realizedPnL, ok := value["realized_pnl"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		unrealizedPnL, ok := value["unrealized_pnl"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		snapshotAt, ok := value["snapshot_at"].(int64)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		paper, ok := value["paper"].(bool)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
+		daoEquitiers = append(daoEquitiers, dao.NewEquity(
+			createdAT,
+			updatedAT,
+			sql.NullTime{
+				Time:  time.Time{},
+				Valid: false,
+			},
+			id,
+			account,
+			currency,
+			equityValue,
+			fee,
+			realizedPnL,
+			unrealizedPnL,
+			snapshotAt,
+			paper,
+		))
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOEquitiers, daoEquitiers).
+		Debug(object.URIEmpty)
+
+	var daoCursorer dao.Cursorer
+
+	if daoPaginationer.GetLimit() < uint32(len(result)) {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`daoPaginationer.GetLimit() < uint32(len(result))`)
+
+		daoCursorer = dao.NewCursor(
+			daoPaginationer.GetCursorer().GetOffset() + daoPaginationer.GetLimit(),
+		)
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	return daoEquitiers, daoCursorer, nil
+}
+
+// Update is a function.
+func (repository *equityRepository) Update(
+	ctx context.Context,
+	daoEquitier dao.Equitier,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = repository.GetTracer().Start(
+		ctx,
+		"Update",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, repository.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "Update",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       repository.GetConfigger(),
+		"dao_equitier": daoEquitier,
+	}
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	nowUTC := repository.GetTimer().NowUTC()
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNowUTC, nowUTC).
+		Debug(object.URIEmpty)
+
+	daoEquity := dao.NewEquity(
+		daoEquitier.GetCreatedAt(),
+		nowUTC,
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		daoEquitier.GetID(),
+		daoEquitier.GetAccount(),
+		daoEquitier.GetCurrency(),
+		daoEquitier.GetEquity(),
+		daoEquitier.GetFee(),
+		daoEquitier.GetRealizedPnL(),
+		daoEquitier.GetUnrealizedPnL(),
+		daoEquitier.GetSnapshotAt(),
+		daoEquitier.GetPaper(),
+	)
+
+	repository.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOEquity, daoEquity).
+		Debug(object.URIEmpty)
+
+	gormDB := repository.GetDB().
+		WithContext(ctx).
+		Where(map[string]any{
+			"id": daoEquitier.GetID(),
+		}).
+		Updates(daoEquity.GetMap())
+	if err := gormDB.Error; err != nil {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrEquityRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrEquityRepositoryUpdate.Error())
+
+		return time.Time{}, err
+	}
+
+	if gormDB.RowsAffected == 0 {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrEquityRepositoryUpdate).
+			Error(object.ErrEquityRepositoryUpdate.Error())
+		traceSpan.RecordError(object.ErrEquityRepositoryUpdate)
+		traceSpan.SetStatus(codes.Error, object.ErrEquityRepositoryUpdate.Error())
+
+		return time.Time{}, object.ErrEquityRepositoryUpdate
+	}
+
+	return daoEquity.GetUpdatedAt(), nil
+}
+
+// WithOptioners is a function.
+func (repository *equityRepository) WithOptioners(
+	optioners ...equityRepositoryOptioner,
+) *equityRepository {
+	newRepository := repository.clone()
+	for _, optioner := range optioners {
+		optioner.apply(newRepository)
+	}
+
+	return newRepository
+}
+
+func (repository *equityRepository) clone() *equityRepository {
+	newRepository := repository
+
+	return newRepository
+}
+
+func (optionerFunc equityRepositoryOptionerFunc) apply(
+	repository *equityRepository,
+) {
+	optionerFunc(repository)
+}
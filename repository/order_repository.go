@@ -204,6 +204,7 @@ func (repository *orderRepository) Create(
 			Valid: false,
 		},
 		id,
+		daoOrderer.GetAccount(),
 		daoOrderer.GetChannel(),
 		daoOrderer.GetClientOID(),
 		daoOrderer.GetDealFunds(),
@@ -218,6 +219,7 @@ func (repository *orderRepository) Create(
 		daoOrderer.GetRemark(),
 		daoOrderer.GetSide(),
 		daoOrderer.GetSize(),
+		daoOrderer.GetStatus(),
 		daoOrderer.GetStop(),
 		daoOrderer.GetStopPrice(),
 		daoOrderer.GetSTP(),
@@ -232,6 +234,7 @@ func (repository *orderRepository) Create(
 		daoOrderer.GetHidden(),
 		daoOrderer.GetIceBerg(),
 		daoOrderer.GetIsActive(),
+		daoOrderer.GetPaper(),
 		daoOrderer.GetPostOnly(),
 		daoOrderer.GetStopTriggered(),
 	)
@@ -479,6 +482,18 @@ func (repository *orderRepository) Read(
 		return nil, object.ErrTypeAssertion
 	}
 
+	account, ok := result["account"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
 	channel, ok := result["channel"].(string)
 	if !ok {
 		repository.GetRuntimeLogger().
@@ -647,6 +662,18 @@ func (repository *orderRepository) Read(
 		return nil, object.ErrTypeAssertion
 	}
 
+	status, ok := result["status"].(string)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
 	stop, ok := result["stop"].(string)
 	if !ok {
 		repository.GetRuntimeLogger().
@@ -815,6 +842,18 @@ func (repository *orderRepository) Read(
 		return nil, object.ErrTypeAssertion
 	}
 
+	paper, ok := result["paper"].(bool)
+	if !ok {
+		repository.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return nil, object.ErrTypeAssertion
+	}
+
 	postOnly, ok := result["post_only"].(bool)
 	if !ok {
 		repository.GetRuntimeLogger().
@@ -847,6 +886,7 @@ func (repository *orderRepository) Read(
 			Valid: false,
 		},
 		id,
+		account,
 		channel,
 		clientOID,
 		dealFunds,
@@ -861,6 +901,7 @@ func (repository *orderRepository) Read(
 		remark,
 		side,
 		size,
+		status,
 		stop,
 		stopPrice,
 		stp,
@@ -875,6 +916,7 @@ func (repository *orderRepository) Read(
 		hidden,
 		iceBerg,
 		isActive,
+		paper,
 		postOnly,
 		stopTriggered,
 	)
@@ -1004,6 +1046,18 @@ func (repository *orderRepository) ReadList(
 			return nil, nil, object.ErrTypeAssertion
 		}
 
+		account, ok := value["account"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
 		channel, ok := value["channel"].(string)
 		if !ok {
 			repository.GetRuntimeLogger().
@@ -1172,6 +1226,18 @@ func (repository *orderRepository) ReadList(
 			return nil, nil, object.ErrTypeAssertion
 		}
 
+		status, ok := value["status"].(string)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
 		stop, ok := value["stop"].(string)
 		if !ok {
 			repository.GetRuntimeLogger().
@@ -1340,6 +1406,18 @@ func (repository *orderRepository) ReadList(
 			return nil, nil, object.ErrTypeAssertion
 		}
 
+		paper, ok := value["paper"].(bool)
+		if !ok {
+			repository.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrTypeAssertion).
+				Error(object.ErrTypeAssertion.Error())
+			traceSpan.RecordError(object.ErrTypeAssertion)
+			traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+			return nil, nil, object.ErrTypeAssertion
+		}
+
 		postOnly, ok := value["post_only"].(bool)
 		if !ok {
 			repository.GetRuntimeLogger().
@@ -1372,6 +1450,7 @@ func (repository *orderRepository) ReadList(
 				Valid: false,
 			},
 			id,
+			account,
 			channel,
 			clientOID,
 			dealFunds,
@@ -1386,6 +1465,7 @@ func (repository *orderRepository) ReadList(
 			remark,
 			side,
 			size,
+			status,
 			stop,
 			stopPrice,
 			stp,
@@ -1400,6 +1480,7 @@ func (repository *orderRepository) ReadList(
 			hidden,
 			iceBerg,
 			isActive,
+			paper,
 			postOnly,
 			stopTriggered,
 		))
@@ -1473,6 +1554,7 @@ func (repository *orderRepository) Update(
 			Valid: false,
 		},
 		daoOrderer.GetID(),
+		daoOrderer.GetAccount(),
 		daoOrderer.GetChannel(),
 		daoOrderer.GetClientOID(),
 		daoOrderer.GetDealFunds(),
@@ -1487,6 +1569,7 @@ func (repository *orderRepository) Update(
 		daoOrderer.GetRemark(),
 		daoOrderer.GetSide(),
 		daoOrderer.GetSize(),
+		daoOrderer.GetStatus(),
 		daoOrderer.GetStop(),
 		daoOrderer.GetStopPrice(),
 		daoOrderer.GetSTP(),
@@ -1501,6 +1584,7 @@ func (repository *orderRepository) Update(
 		daoOrderer.GetHidden(),
 		daoOrderer.GetIceBerg(),
 		daoOrderer.GetIsActive(),
+		daoOrderer.GetPaper(),
 		daoOrderer.GetPostOnly(),
 		daoOrderer.GetStopTriggered(),
 	)
@@ -93,7 +93,19 @@ type (
 
 	// Repositorier is an interface.
 	Repositorier interface {
+		GetAlgoOrderRepositorier
+		GetBracketRepositorier
+		GetBalanceRepositorier
+		GetFillRepositorier
+		GetBorrowRepositorier
+		GetEquityRepositorier
+		GetKlineRepositorier
+		GetKillSwitchRepositorier
 		GetOrderRepositorier
+		GetMarginBalanceRepositorier
+		GetStopOrderRepositorier
+		GetMarginRiskLimitRepositorier
+		GetSymbolRepositorier
 		GetTickerRepositorier
 	}
 
@@ -104,8 +116,20 @@ type (
 	}
 
 	repository struct {
-		orderRepositorier  OrderRepositorier
-		tickerRepositorier TickerRepositorier
+		algoOrderRepositorier       AlgoOrderRepositorier
+		bracketRepositorier         BracketRepositorier
+		balanceRepositorier         BalanceRepositorier
+		fillRepositorier            FillRepositorier
+		borrowRepositorier          BorrowRepositorier
+		equityRepositorier          EquityRepositorier
+		klineRepositorier           KlineRepositorier
+		killSwitchRepositorier      KillSwitchRepositorier
+		orderRepositorier           OrderRepositorier
+		marginBalanceRepositorier   MarginBalanceRepositorier
+		stopOrderRepositorier       StopOrderRepositorier
+		marginRiskLimitRepositorier MarginRiskLimitRepositorier
+		symbolRepositorier          SymbolRepositorier
+		tickerRepositorier          TickerRepositorier
 	}
 
 	optionRepositorier interface {
@@ -116,9 +140,21 @@ type (
 )
 
 var (
-	_ GetOrderRepositorier  = (*repository)(nil)
-	_ GetTickerRepositorier = (*repository)(nil)
-	_ Repositorier          = (*repository)(nil)
+	_ GetAlgoOrderRepositorier       = (*repository)(nil)
+	_ GetBracketRepositorier         = (*repository)(nil)
+	_ GetBalanceRepositorier         = (*repository)(nil)
+	_ GetFillRepositorier            = (*repository)(nil)
+	_ GetBorrowRepositorier          = (*repository)(nil)
+	_ GetEquityRepositorier          = (*repository)(nil)
+	_ GetKlineRepositorier           = (*repository)(nil)
+	_ GetKillSwitchRepositorier      = (*repository)(nil)
+	_ GetOrderRepositorier           = (*repository)(nil)
+	_ GetMarginBalanceRepositorier   = (*repository)(nil)
+	_ GetStopOrderRepositorier       = (*repository)(nil)
+	_ GetMarginRiskLimitRepositorier = (*repository)(nil)
+	_ GetSymbolRepositorier          = (*repository)(nil)
+	_ GetTickerRepositorier          = (*repository)(nil)
+	_ Repositorier                   = (*repository)(nil)
 )
 
 // NewRepository is a function.
@@ -126,13 +162,193 @@ func NewRepository(
 	optioners ...optionRepositorier,
 ) *repository {
 	repository := &repository{
-		orderRepositorier:  nil,
-		tickerRepositorier: nil,
+		algoOrderRepositorier:       nil,
+		bracketRepositorier:         nil,
+		balanceRepositorier:         nil,
+		fillRepositorier:            nil,
+		borrowRepositorier:          nil,
+		equityRepositorier:          nil,
+		klineRepositorier:           nil,
+		killSwitchRepositorier:      nil,
+		orderRepositorier:           nil,
+		marginBalanceRepositorier:   nil,
+		stopOrderRepositorier:       nil,
+		marginRiskLimitRepositorier: nil,
+		symbolRepositorier:          nil,
+		tickerRepositorier:          nil,
 	}
 
 	return repository.WithOptioners(optioners...)
 }
 
+// WithAlgoOrderRepositorier is a function.
+func WithAlgoOrderRepositorier(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...algoOrderRepositoryOptioner,
+) optionRepositorier {
+	return optionRepositorierFunc(func(
+		repository *repository,
+	) {
+		repository.algoOrderRepositorier = NewAlgoOrderRepository(
+			configConfigger,
+			logRuntimeLogger,
+			traceTracer,
+			utilUUIDer,
+			optioners...,
+		)
+	})
+}
+
+// WithBracketRepositorier is a function.
+func WithBracketRepositorier(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...bracketRepositoryOptioner,
+) optionRepositorier {
+	return optionRepositorierFunc(func(
+		repository *repository,
+	) {
+		repository.bracketRepositorier = NewBracketRepository(
+			configConfigger,
+			logRuntimeLogger,
+			traceTracer,
+			utilUUIDer,
+			optioners...,
+		)
+	})
+}
+
+// WithBalanceRepositorier is a function.
+func WithBalanceRepositorier(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...balanceRepositoryOptioner,
+) optionRepositorier {
+	return optionRepositorierFunc(func(
+		repository *repository,
+	) {
+		repository.balanceRepositorier = NewBalanceRepository(
+			configConfigger,
+			logRuntimeLogger,
+			traceTracer,
+			utilUUIDer,
+			optioners...,
+		)
+	})
+}
+
+// WithFillRepositorier is a function.
+func WithFillRepositorier(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...fillRepositoryOptioner,
+) optionRepositorier {
+	return optionRepositorierFunc(func(
+		repository *repository,
+	) {
+		repository.fillRepositorier = NewFillRepository(
+			configConfigger,
+			logRuntimeLogger,
+			traceTracer,
+			utilUUIDer,
+			optioners...,
+		)
+	})
+}
+
+// WithBorrowRepositorier is a function.
+func WithBorrowRepositorier(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...borrowRepositoryOptioner,
+) optionRepositorier {
+	return optionRepositorierFunc(func(
+		repository *repository,
+	) {
+		repository.borrowRepositorier = NewBorrowRepository(
+			configConfigger,
+			logRuntimeLogger,
+			traceTracer,
+			utilUUIDer,
+			optioners...,
+		)
+	})
+}
+
+// WithEquityRepositorier is a function.
+func WithEquityRepositorier(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...equityRepositoryOptioner,
+) optionRepositorier {
+	return optionRepositorierFunc(func(
+		repository *repository,
+	) {
+		repository.equityRepositorier = NewEquityRepository(
+			configConfigger,
+			logRuntimeLogger,
+			traceTracer,
+			utilUUIDer,
+			optioners...,
+		)
+	})
+}
+
+// WithKlineRepositorier is a function.
+func WithKlineRepositorier(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...klineRepositoryOptioner,
+) optionRepositorier {
+	return optionRepositorierFunc(func(
+		repository *repository,
+	) {
+		repository.klineRepositorier = NewKlineRepository(
+			configConfigger,
+			logRuntimeLogger,
+			traceTracer,
+			utilUUIDer,
+			optioners...,
+		)
+	})
+}
+
+// WithKillSwitchRepositorier is a function.
+func WithKillSwitchRepositorier(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...killSwitchRepositoryOptioner,
+) optionRepositorier {
+	return optionRepositorierFunc(func(
+		repository *repository,
+	) {
+		repository.killSwitchRepositorier = NewKillSwitchRepository(
+			configConfigger,
+			logRuntimeLogger,
+			traceTracer,
+			utilUUIDer,
+			optioners...,
+		)
+	})
+}
+
 // WithOrderRepositorier is a function.
 func WithOrderRepositorier(
 	configConfigger config.Configger,
@@ -154,6 +370,90 @@ func WithOrderRepositorier(
 	})
 }
 
+// WithMarginBalanceRepositorier is a function.
+func WithMarginBalanceRepositorier(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...marginBalanceRepositoryOptioner,
+) optionRepositorier {
+	return optionRepositorierFunc(func(
+		repository *repository,
+	) {
+		repository.marginBalanceRepositorier = NewMarginBalanceRepository(
+			configConfigger,
+			logRuntimeLogger,
+			traceTracer,
+			utilUUIDer,
+			optioners...,
+		)
+	})
+}
+
+// WithStopOrderRepositorier is a function.
+func WithStopOrderRepositorier(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...stopOrderRepositoryOptioner,
+) optionRepositorier {
+	return optionRepositorierFunc(func(
+		repository *repository,
+	) {
+		repository.stopOrderRepositorier = NewStopOrderRepository(
+			configConfigger,
+			logRuntimeLogger,
+			traceTracer,
+			utilUUIDer,
+			optioners...,
+		)
+	})
+}
+
+// WithMarginRiskLimitRepositorier is a function.
+func WithMarginRiskLimitRepositorier(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...marginRiskLimitRepositoryOptioner,
+) optionRepositorier {
+	return optionRepositorierFunc(func(
+		repository *repository,
+	) {
+		repository.marginRiskLimitRepositorier = NewMarginRiskLimitRepository(
+			configConfigger,
+			logRuntimeLogger,
+			traceTracer,
+			utilUUIDer,
+			optioners...,
+		)
+	})
+}
+
+// WithSymbolRepositorier is a function.
+func WithSymbolRepositorier(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	optioners ...symbolRepositoryOptioner,
+) optionRepositorier {
+	return optionRepositorierFunc(func(
+		repository *repository,
+	) {
+		repository.symbolRepositorier = NewSymbolRepository(
+			configConfigger,
+			logRuntimeLogger,
+			traceTracer,
+			utilUUIDer,
+			optioners...,
+		)
+	})
+}
+
 // WithTickerRepositorier is a function.
 func WithTickerRepositorier(
 	configConfigger config.Configger,
@@ -175,11 +475,71 @@ func WithTickerRepositorier(
 	})
 }
 
+// GetAlgoOrderRepositorier is a function.
+func (repository *repository) GetAlgoOrderRepositorier() AlgoOrderRepositorier {
+	return repository.algoOrderRepositorier
+}
+
+// GetBracketRepositorier is a function.
+func (repository *repository) GetBracketRepositorier() BracketRepositorier {
+	return repository.bracketRepositorier
+}
+
+// GetBalanceRepositorier is a function.
+func (repository *repository) GetBalanceRepositorier() BalanceRepositorier {
+	return repository.balanceRepositorier
+}
+
+// GetFillRepositorier is a function.
+func (repository *repository) GetFillRepositorier() FillRepositorier {
+	return repository.fillRepositorier
+}
+
+// GetBorrowRepositorier is a function.
+func (repository *repository) GetBorrowRepositorier() BorrowRepositorier {
+	return repository.borrowRepositorier
+}
+
+// GetEquityRepositorier is a function.
+func (repository *repository) GetEquityRepositorier() EquityRepositorier {
+	return repository.equityRepositorier
+}
+
+// GetKlineRepositorier is a function.
+func (repository *repository) GetKlineRepositorier() KlineRepositorier {
+	return repository.klineRepositorier
+}
+
+// GetKillSwitchRepositorier is a function.
+func (repository *repository) GetKillSwitchRepositorier() KillSwitchRepositorier {
+	return repository.killSwitchRepositorier
+}
+
 // GetOrderRepositorier is a function.
 func (repository *repository) GetOrderRepositorier() OrderRepositorier {
 	return repository.orderRepositorier
 }
 
+// GetMarginBalanceRepositorier is a function.
+func (repository *repository) GetMarginBalanceRepositorier() MarginBalanceRepositorier {
+	return repository.marginBalanceRepositorier
+}
+
+// GetStopOrderRepositorier is a function.
+func (repository *repository) GetStopOrderRepositorier() StopOrderRepositorier {
+	return repository.stopOrderRepositorier
+}
+
+// GetMarginRiskLimitRepositorier is a function.
+func (repository *repository) GetMarginRiskLimitRepositorier() MarginRiskLimitRepositorier {
+	return repository.marginRiskLimitRepositorier
+}
+
+// GetSymbolRepositorier is a function.
+func (repository *repository) GetSymbolRepositorier() SymbolRepositorier {
+	return repository.symbolRepositorier
+}
+
 // GetTickerRepositorier is a function.
 func (repository *repository) GetTickerRepositorier() TickerRepositorier {
 	return repository.tickerRepositorier
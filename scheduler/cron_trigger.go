@@ -0,0 +1,191 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+type (
+	cronTrigger struct {
+		dayOfMonth    uint64
+		dayOfMonthAny bool
+		dayOfWeek     uint64
+		dayOfWeekAny  bool
+		hour          uint64
+		minute        uint64
+		month         uint64
+	}
+
+	cronTriggerField struct {
+		maximum uint64
+		minimum uint64
+	}
+)
+
+var _ Triggerer = (*cronTrigger)(nil)
+
+var (
+	cronTriggerFieldMinute     = cronTriggerField{maximum: 59, minimum: 0}
+	cronTriggerFieldHour       = cronTriggerField{maximum: 23, minimum: 0}
+	cronTriggerFieldDayOfMonth = cronTriggerField{maximum: 31, minimum: 1}
+	cronTriggerFieldMonth      = cronTriggerField{maximum: 12, minimum: 1}
+	cronTriggerFieldDayOfWeek  = cronTriggerField{maximum: 7, minimum: 0}
+)
+
+// NewCronTrigger is a function.
+// It parses the five standard fields, minute hour day-of-month month day-of-week,
+// each a list of *, values, ranges and steps, and evaluates them in UTC.
+// As in cron, when both day fields are restricted a day matching either one fires.
+func NewCronTrigger(
+	expression string,
+) (*cronTrigger, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != object.NUMSchedulerCronFieldCount {
+		return nil, fmt.Errorf("%w: %q", object.ErrSchedulerCronParse, expression)
+	}
+
+	cronTriggerFields := []cronTriggerField{
+		cronTriggerFieldMinute,
+		cronTriggerFieldHour,
+		cronTriggerFieldDayOfMonth,
+		cronTriggerFieldMonth,
+		cronTriggerFieldDayOfWeek,
+	}
+	bits := make([]uint64, 0, len(fields))
+
+	for key, field := range fields {
+		bit, err := cronTriggerFields[key].parse(field)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", err, expression)
+		}
+
+		bits = append(bits, bit)
+	}
+
+	// Sunday is both 0 and 7.
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+
+	return &cronTrigger{
+		dayOfMonth:    bits[2],
+		dayOfMonthAny: strings.HasPrefix(fields[2], object.URISchedulerCronAny),
+		dayOfWeek:     bits[4],
+		dayOfWeekAny:  strings.HasPrefix(fields[4], object.URISchedulerCronAny),
+		hour:          bits[1],
+		minute:        bits[0],
+		month:         bits[3],
+	}, nil
+}
+
+// Next is a function.
+func (trigger *cronTrigger) Next(
+	timeTime time.Time,
+) time.Time {
+	next := timeTime.UTC().Truncate(time.Minute).Add(time.Minute)
+	last := next.AddDate(0, 0, object.NUMSchedulerCronSearchDayCount)
+
+	for next.Before(last) {
+		if trigger.month&(1<<uint(next.Month())) == 0 {
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+
+			continue
+		}
+
+		if !trigger.matchDay(next) {
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, time.UTC)
+
+			continue
+		}
+
+		if trigger.hour&(1<<uint(next.Hour())) == 0 {
+			next = next.Truncate(time.Hour).Add(time.Hour)
+
+			continue
+		}
+
+		if trigger.minute&(1<<uint(next.Minute())) == 0 {
+			next = next.Add(time.Minute)
+
+			continue
+		}
+
+		return next
+	}
+
+	return time.Time{}
+}
+
+func (trigger *cronTrigger) matchDay(
+	timeTime time.Time,
+) bool {
+	dayOfMonth := trigger.dayOfMonth&(1<<uint(timeTime.Day())) != 0
+	dayOfWeek := trigger.dayOfWeek&(1<<uint(timeTime.Weekday())) != 0
+
+	if trigger.dayOfMonthAny || trigger.dayOfWeekAny {
+		return dayOfMonth && dayOfWeek
+	}
+
+	return dayOfMonth || dayOfWeek
+}
+
+func (field cronTriggerField) parse(
+	value string,
+) (uint64, error) {
+	bits := uint64(0)
+
+	for _, part := range strings.Split(value, object.URISchedulerCronListSeparator) {
+		rangePart, stepPart, hasStep := strings.Cut(part, object.URISchedulerCronStepSeparator)
+		step := uint64(1)
+
+		if hasStep {
+			newStep, err := strconv.ParseUint(stepPart, 10, 64)
+			if err != nil || newStep == 0 {
+				return 0, object.ErrSchedulerCronParse
+			}
+
+			step = newStep
+		}
+
+		first, last := field.minimum, field.maximum
+
+		if rangePart != object.URISchedulerCronAny {
+			firstPart, lastPart, hasRange := strings.Cut(
+				rangePart,
+				object.URISchedulerCronRangeSeparator,
+			)
+
+			newFirst, err := strconv.ParseUint(firstPart, 10, 64)
+			if err != nil {
+				return 0, object.ErrSchedulerCronParse
+			}
+
+			first, last = newFirst, newFirst
+
+			if hasRange {
+				newLast, errLast := strconv.ParseUint(lastPart, 10, 64)
+				if errLast != nil {
+					return 0, object.ErrSchedulerCronParse
+				}
+
+				last = newLast
+			} else if hasStep {
+				last = field.maximum
+			}
+		}
+
+		if first < field.minimum || last > field.maximum || first > last {
+			return 0, object.ErrSchedulerCronParse
+		}
+
+		for current := first; current <= last; current += step {
+			bits |= 1 << current
+		}
+	}
+
+	return bits, nil
+}
@@ -0,0 +1,91 @@
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+func TestCronTriggerNext(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		expression string
+		timeTime   time.Time
+		want       time.Time
+	}{
+		{
+			name:       "step",
+			expression: "*/15 * * * *",
+			timeTime:   time.Date(2024, time.January, 1, 10, 7, 0, 0, time.UTC),
+			want:       time.Date(2024, time.January, 1, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			name:       "on a fire time",
+			expression: "*/15 * * * *",
+			timeTime:   time.Date(2024, time.January, 1, 10, 15, 0, 0, time.UTC),
+			want:       time.Date(2024, time.January, 1, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:       "day of week",
+			expression: "0 9 * * 1",
+			timeTime:   time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC),
+			want:       time.Date(2024, time.January, 8, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "day of month",
+			expression: "0 0 1 * *",
+			timeTime:   time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC),
+			want:       time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "either day",
+			expression: "0 0 13 * 5",
+			timeTime:   time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			want:       time.Date(2024, time.January, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "sunday as seven",
+			expression: "30 12 * * 7",
+			timeTime:   time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			want:       time.Date(2024, time.January, 7, 12, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cronTrigger, err := NewCronTrigger(test.expression)
+			if err != nil {
+				t.Fatalf("NewCronTrigger() error = %v", err)
+			}
+
+			if got := cronTrigger.Next(test.timeTime); !got.Equal(test.want) {
+				t.Errorf("Next() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNewCronTriggerInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, expression := range []string{
+		"* * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"a * * * *",
+	} {
+		if _, err := NewCronTrigger(expression); !errors.Is(err, object.ErrSchedulerCronParse) {
+			t.Errorf("NewCronTrigger(%q) error = %v, want %v", expression, err, object.ErrSchedulerCronParse)
+		}
+	}
+}
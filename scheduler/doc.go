@@ -0,0 +1,4 @@
+/*
+Package scheduler is a package.
+*/
+package scheduler
@@ -0,0 +1,347 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/service"
+	"github.com/ShahoBashoki/kucoin/strategy"
+	"github.com/ShahoBashoki/kucoin/util"
+)
+
+// NewAccountJob is a function.
+// It runs the job once for each of the accounts, with the account in the
+// runtime context, and an account failing does not stop the next one.
+func NewAccountJob(
+	accounts []string,
+	job Job,
+) Job {
+	return func(ctx context.Context) error {
+		errs := make([]error, 0, len(accounts))
+
+		for _, account := range accounts {
+			if err := job(util.WithRuntimeContextValue(
+				ctx,
+				object.URIRuntimeContextAccount,
+				account,
+			)); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", account, err))
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+}
+
+// NewAlgoOrderSyncJob is a function.
+// It moves every active algo order forward, settling its resting child and
+// placing the next one.
+func NewAlgoOrderSyncJob(
+	servicer service.Servicer,
+) Job {
+	return func(ctx context.Context) error {
+		if err := servicer.GetAlgoOrderServicer().Sync(ctx); err != nil {
+			return fmt.Errorf("%w: %w", object.ErrAlgoOrderServiceSync, err)
+		}
+
+		return nil
+	}
+}
+
+// NewBracketSyncJob is a function.
+// It moves every active bracket forward, placing, cancelling and trailing its
+// legs.
+func NewBracketSyncJob(
+	servicer service.Servicer,
+) Job {
+	return func(ctx context.Context) error {
+		if err := servicer.GetBracketServicer().Sync(ctx); err != nil {
+			return fmt.Errorf("%w: %w", object.ErrBracketServiceSync, err)
+		}
+
+		return nil
+	}
+}
+
+// NewExchangeStatusSyncJob is a function.
+// It keeps the mode of the exchange current, so new orders stop while the
+// exchange only cancels or is paused.
+func NewExchangeStatusSyncJob(
+	servicer service.Servicer,
+) Job {
+	return func(ctx context.Context) error {
+		if err := servicer.GetExchangeStatusServicer().Sync(ctx); err != nil {
+			return fmt.Errorf("%w: %w", object.ErrExchangeStatusServiceSync, err)
+		}
+
+		return nil
+	}
+}
+
+// NewFillSyncJob is a function.
+// It stores the fills of the last 24 hours, skipping the trades already stored.
+func NewFillSyncJob(
+	servicer service.Servicer,
+) Job {
+	return func(ctx context.Context) error {
+		if err := servicer.GetFillServicer().GetRecentListFromRemote(ctx); err != nil {
+			return fmt.Errorf("%w: %w", object.ErrFillServiceGetRecentListFromRemote, err)
+		}
+
+		return nil
+	}
+}
+
+// NewMarginSyncJob is a function.
+// It stores the margin balances, liabilities, risk limits and borrow records.
+func NewMarginSyncJob(
+	servicer service.Servicer,
+) Job {
+	return func(ctx context.Context) error {
+		if err := servicer.GetMarginServicer().Sync(ctx); err != nil {
+			return fmt.Errorf("%w: %w", object.ErrMarginServiceSync, err)
+		}
+
+		return nil
+	}
+}
+
+// NewOrderReconcileJob is a function.
+// It reconciles the active orders with the exchange, repairing what the order
+// change events missed.
+func NewOrderReconcileJob(
+	servicer service.Servicer,
+) Job {
+	return func(ctx context.Context) error {
+		if err := servicer.GetOrderServicer().Sync(ctx); err != nil {
+			return fmt.Errorf("%w: %w", object.ErrOrderServiceSync, err)
+		}
+
+		return nil
+	}
+}
+
+// NewOrderSyncJob is a function.
+// It upserts the remote list into the stored orders, which keep their ids.
+func NewOrderSyncJob(
+	servicer service.Servicer,
+) Job {
+	return func(ctx context.Context) error {
+		if err := servicer.GetOrderServicer().GetListFromRemote(
+			ctx,
+			dto.NewOrderRequest(
+				object.URIEmpty,
+				object.OrderTypeType(object.URIEmpty),
+				object.OrderSideType(object.URIEmpty),
+				object.URIEmpty,
+				object.OrderStateType(object.URIEmpty),
+				object.URIEmpty,
+				object.OrderTypeType(object.URIEmpty),
+			),
+			1,
+		); err != nil {
+			return fmt.Errorf("%w: %w", object.ErrOrderServiceGetListFromRemote, err)
+		}
+
+		return nil
+	}
+}
+
+// NewPaperMatchJob is a function.
+// It lets the resting paper orders fill while nothing else talks to the simulator.
+func NewPaperMatchJob(
+	exchangePaperExchanger exchange.PaperExchanger,
+) Job {
+	return func(context.Context) error {
+		return exchangePaperExchanger.Match()
+	}
+}
+
+// NewPortfolioSnapshotJob is a function.
+// It refreshes the balances of every account and stores a snapshot of the
+// equity of each, so the daily loss of an account is measured on its own.
+func NewPortfolioSnapshotJob(
+	servicer service.Servicer,
+	accounts []string,
+) Job {
+	return NewAccountJob(accounts, func(ctx context.Context) error {
+		if err := servicer.GetPortfolioServicer().Sync(ctx); err != nil {
+			return fmt.Errorf("%w: %w", object.ErrPortfolioServiceSync, err)
+		}
+
+		if _, err := servicer.GetPortfolioServicer().Snapshot(ctx); err != nil {
+			return fmt.Errorf("%w: %w", object.ErrPortfolioServiceSnapshot, err)
+		}
+
+		return nil
+	})
+}
+
+// NewStopOrderSyncJob is a function.
+// It reconciles the stored stop orders, moving the triggered ones into the
+// orders.
+func NewStopOrderSyncJob(
+	servicer service.Servicer,
+) Job {
+	return func(ctx context.Context) error {
+		if err := servicer.GetStopOrderServicer().Sync(ctx); err != nil {
+			return fmt.Errorf("%w: %w", object.ErrStopOrderServiceSync, err)
+		}
+
+		return nil
+	}
+}
+
+// NewStrategyEvaluationJob is a function.
+// Every strategy is evaluated even when an earlier one fails, with its name in
+// the runtime context so the risk limits of the strategy apply to its orders,
+// and with its account from the accounts, when it has one, so it sizes and
+// trades on that account. Each signal is sized by the sizing service and
+// placed as a market order, with the time of the signal as the intent time so
+// evaluating the same signal again places nothing new; a signal too small to
+// trade is only logged.
+func NewStrategyEvaluationJob(
+	servicer service.Servicer,
+	logRuntimeLogger log.RuntimeLogger,
+	marketer strategy.Marketer,
+	accounts map[string]string,
+	strategiers []strategy.Strategier,
+) Job {
+	return func(ctx context.Context) error {
+		errs := make([]error, 0, len(strategiers))
+
+		for _, strategier := range strategiers {
+			ctxStrategy := util.WithRuntimeContextValue(
+				ctx,
+				object.URIRuntimeContextStrategy,
+				strategier.GetName(),
+			)
+
+			if account, ok := accounts[strategier.GetName()]; ok {
+				ctxStrategy = util.WithRuntimeContextValue(
+					ctxStrategy,
+					object.URIRuntimeContextAccount,
+					account,
+				)
+			}
+
+			omSignals, err := strategier.Evaluate(ctxStrategy, marketer)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%w: %w", object.ErrStrategyEvaluate, err))
+
+				continue
+			}
+
+			logRuntimeLogger.
+				WithField(object.URIFieldStrategy, strategier.GetName()).
+				WithField(object.URIFieldOMSignals, omSignals).
+				Debug(object.URIEmpty)
+
+			for _, omSignal := range omSignals {
+				size, err := servicer.GetSizingServicer().Size(ctxStrategy, omSignal)
+				if errors.Is(err, object.ErrSizingServiceBelowMinSize) {
+					logRuntimeLogger.
+						WithField(object.URIFieldOMSignal, omSignal).
+						Debug(object.ErrSizingServiceBelowMinSize.Error())
+
+					continue
+				}
+
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%w: %w", object.ErrSizingServiceSize, err))
+
+					continue
+				}
+
+				logRuntimeLogger.
+					WithField(object.URIFieldOMSignal, omSignal).
+					WithField(object.URIFieldSize, size).
+					Debug(object.URIEmpty)
+
+				omOrderers, err := servicer.GetOrderServicer().Place(
+					util.WithRuntimeContextValue(
+						ctxStrategy,
+						object.URIRuntimeContextIntentAt,
+						omSignal.GetTime(),
+					),
+					strategyEvaluationJobPlaceOrderRequest(omSignal, size),
+				)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%w: %w", object.ErrOrderServicePlace, err))
+
+					continue
+				}
+
+				logRuntimeLogger.
+					WithField(object.URIFieldOMSignal, omSignal).
+					WithField(object.URIFieldOMOrders, omOrderers).
+					Debug(object.URIEmpty)
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+}
+
+// NewSymbolRefreshJob is a function.
+// It upserts the remote symbol list, so order validation never sees an empty
+// table while it runs.
+func NewSymbolRefreshJob(
+	servicer service.Servicer,
+) Job {
+	return func(ctx context.Context) error {
+		if err := servicer.GetSymbolServicer().GetListFromRemote(ctx); err != nil {
+			return fmt.Errorf("%w: %w", object.ErrSymbolServiceGetListFromRemote, err)
+		}
+
+		return nil
+	}
+}
+
+// NewTickerRefreshJob is a function.
+// It upserts the remote snapshot by symbol, so the stored tickers stay readable
+// while it runs and are kept when it fails.
+func NewTickerRefreshJob(
+	servicer service.Servicer,
+) Job {
+	return func(ctx context.Context) error {
+		if err := servicer.GetTickerServicer().GetListFromRemote(ctx); err != nil {
+			return fmt.Errorf("%w: %w", object.ErrTickerKucoinServiceGetList, err)
+		}
+
+		return nil
+	}
+}
+
+// strategyEvaluationJobPlaceOrderRequest is the market order of a sized signal.
+// Its clientOid is left empty, so the order service derives it from the
+// account, the strategy and the time of the signal.
+func strategyEvaluationJobPlaceOrderRequest(
+	omSignaler om.Signaler,
+	size string,
+) dto.PlaceOrderRequester {
+	return dto.NewPlaceOrderRequest(
+		object.URIEmpty,
+		object.URIEmpty,
+		object.OrderTypeTypeMarket,
+		object.URIEmpty,
+		omSignaler.GetReason(),
+		object.OrderSideType(omSignaler.GetSide()),
+		size,
+		object.URIEmpty,
+		omSignaler.GetSymbol(),
+		object.TimeInForceType(object.URIEmpty),
+		object.OrderTypeTypeTrade,
+		object.URIEmpty,
+		0,
+		false,
+		false,
+		false,
+	)
+}
@@ -0,0 +1,65 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/service"
+)
+
+type (
+	jobTestServicer struct {
+		service.Servicer
+		tickerServicer service.TickerServicer
+	}
+
+	jobTestTickerServicer struct {
+		service.TickerServicer
+		err       error
+		deleteAll uint64
+	}
+)
+
+// GetTickerServicer is a function.
+func (servicer *jobTestServicer) GetTickerServicer() service.TickerServicer {
+	return servicer.tickerServicer
+}
+
+// DeleteAll is a function.
+func (servicer *jobTestTickerServicer) DeleteAll(
+	_ context.Context,
+) (time.Time, error) {
+	servicer.deleteAll++
+
+	return time.Time{}, nil
+}
+
+// GetListFromRemote is a function.
+func (servicer *jobTestTickerServicer) GetListFromRemote(
+	_ context.Context,
+) error {
+	return servicer.err
+}
+
+func TestNewTickerRefreshJob(t *testing.T) {
+	t.Parallel()
+
+	errRemote := errors.New("remote failed")
+	tickerServicer := &jobTestTickerServicer{TickerServicer: nil, err: errRemote, deleteAll: 0}
+
+	err := NewTickerRefreshJob(&jobTestServicer{
+		Servicer:       nil,
+		tickerServicer: tickerServicer,
+	})(context.Background())
+	if !errors.Is(err, object.ErrTickerKucoinServiceGetList) || !errors.Is(err, errRemote) {
+		t.Errorf("job() error = %v, want %v", err, object.ErrTickerKucoinServiceGetList)
+	}
+
+	// A failed refresh leaves the stored tickers as they were.
+	if tickerServicer.deleteAll != 0 {
+		t.Errorf("DeleteAll() calls = %d, want 0", tickerServicer.deleteAll)
+	}
+}
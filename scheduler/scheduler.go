@@ -0,0 +1,392 @@
+package scheduler
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// Job is a function.
+	Job func(
+		context.Context,
+	) error
+
+	// Scheduler is an interface.
+	Scheduler interface {
+		// GetStatus is a function.
+		GetStatus(
+			string,
+		) (om.JobStatuser, error)
+		// GetStatuses is a function.
+		GetStatuses() []om.JobStatuser
+		// Register is a function.
+		Register(
+			string,
+			Triggerer,
+			Job,
+		)
+		// Run is a function.
+		Run(
+			context.Context,
+		) error
+	}
+
+	// GetScheduler is an interface.
+	GetScheduler interface {
+		// GetScheduler is a function.
+		GetScheduler() Scheduler
+	}
+
+	scheduler struct {
+		configConfigger  config.Configger
+		jobs             map[string]*schedulerJob
+		logRuntimeLogger log.RuntimeLogger
+		mutex            sync.RWMutex
+		objectTimer      object.Timer
+		traceTracer      trace.Tracer
+		utilUUIDer       util.UUIDer
+	}
+
+	schedulerJob struct {
+		err        string
+		finishedAt time.Time
+		job        Job
+		mutex      sync.Mutex
+		name       string
+		nextRunAt  time.Time
+		runCount   uint32
+		skipCount  uint32
+		startedAt  time.Time
+		state      object.JobStateType
+		triggerer  Triggerer
+	}
+)
+
+var (
+	_ Scheduler            = (*scheduler)(nil)
+	_ config.GetConfigger  = (*scheduler)(nil)
+	_ log.GetRuntimeLogger = (*scheduler)(nil)
+	_ object.GetTimer      = (*scheduler)(nil)
+	_ util.GetTracer       = (*scheduler)(nil)
+	_ util.GetUUIDer       = (*scheduler)(nil)
+)
+
+// NewScheduler is a function.
+func NewScheduler(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	objectTimer object.Timer,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+) *scheduler {
+	return &scheduler{
+		configConfigger:  configConfigger,
+		jobs:             map[string]*schedulerJob{},
+		logRuntimeLogger: logRuntimeLogger,
+		mutex:            sync.RWMutex{},
+		objectTimer:      objectTimer,
+		traceTracer:      traceTracer,
+		utilUUIDer:       utilUUIDer,
+	}
+}
+
+// GetConfigger is a function.
+func (scheduler *scheduler) GetConfigger() config.Configger {
+	return scheduler.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (scheduler *scheduler) GetRuntimeLogger() log.RuntimeLogger {
+	return scheduler.logRuntimeLogger
+}
+
+// GetTimer is a function.
+func (scheduler *scheduler) GetTimer() object.Timer {
+	return scheduler.objectTimer
+}
+
+// GetTracer is a function.
+func (scheduler *scheduler) GetTracer() trace.Tracer {
+	return scheduler.traceTracer
+}
+
+// GetUUIDer is a function.
+func (scheduler *scheduler) GetUUIDer() util.UUIDer {
+	return scheduler.utilUUIDer
+}
+
+// GetStatus is a function.
+func (scheduler *scheduler) GetStatus(
+	name string,
+) (om.JobStatuser, error) {
+	scheduler.mutex.RLock()
+	job, ok := scheduler.jobs[name]
+	scheduler.mutex.RUnlock()
+
+	if !ok {
+		return nil, object.ErrSchedulerJobNotFound
+	}
+
+	return job.status(), nil
+}
+
+// GetStatuses is a function.
+// The statuses are sorted by job name.
+func (scheduler *scheduler) GetStatuses() []om.JobStatuser {
+	scheduler.mutex.RLock()
+	defer scheduler.mutex.RUnlock()
+
+	omJobStatusers := make([]om.JobStatuser, 0, len(scheduler.jobs))
+	for _, job := range scheduler.jobs {
+		omJobStatusers = append(omJobStatusers, job.status())
+	}
+
+	sort.Slice(omJobStatusers, func(first, second int) bool {
+		return omJobStatusers[first].GetName() < omJobStatusers[second].GetName()
+	})
+
+	return omJobStatusers
+}
+
+// Register is a function.
+// Jobs registered after Run has started are not scheduled.
+func (scheduler *scheduler) Register(
+	name string,
+	triggerer Triggerer,
+	job Job,
+) {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	scheduler.jobs[name] = &schedulerJob{
+		err:        object.URIEmpty,
+		finishedAt: time.Time{},
+		job:        job,
+		mutex:      sync.Mutex{},
+		name:       name,
+		nextRunAt:  time.Time{},
+		runCount:   0,
+		skipCount:  0,
+		startedAt:  time.Time{},
+		state:      object.JobStateTypeIdle,
+		triggerer:  triggerer,
+	}
+}
+
+// Run is a function.
+// It blocks until the context is done and every running job has returned.
+// A job whose previous run is still in progress skips the fire time.
+func (scheduler *scheduler) Run(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = scheduler.GetTracer().Start(
+		ctx,
+		"Run",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, scheduler.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Run",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": scheduler.configConfigger,
+	}
+
+	scheduler.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	scheduler.mutex.RLock()
+
+	jobs := make([]*schedulerJob, 0, len(scheduler.jobs))
+	for _, job := range scheduler.jobs {
+		jobs = append(jobs, job)
+	}
+
+	scheduler.mutex.RUnlock()
+
+	waitGroup := &sync.WaitGroup{}
+
+	for _, job := range jobs {
+		scheduler.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldJob, job.name).
+			Debug(object.URIEmpty)
+
+		waitGroup.Add(1)
+
+		go func(job *schedulerJob) {
+			defer waitGroup.Done()
+
+			scheduler.loop(ctx, job, waitGroup)
+		}(job)
+	}
+
+	<-ctx.Done()
+	waitGroup.Wait()
+
+	return nil
+}
+
+func (scheduler *scheduler) loop(
+	ctx context.Context,
+	job *schedulerJob,
+	waitGroup *sync.WaitGroup,
+) {
+	for {
+		nowUTC := scheduler.GetTimer().NowUTC()
+		nextRunAt := job.triggerer.Next(nowUTC)
+
+		job.setNextRunAt(nextRunAt)
+
+		if nextRunAt.IsZero() {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-scheduler.GetTimer().After(nextRunAt.Sub(nowUTC)):
+		}
+
+		if !job.start(scheduler.GetTimer().NowUTC()) {
+			continue
+		}
+
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+
+			scheduler.run(ctx, job)
+		}()
+	}
+}
+
+func (scheduler *scheduler) run(
+	ctx context.Context,
+	job *schedulerJob,
+) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = scheduler.GetTracer().Start(
+		ctx,
+		"run",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, scheduler.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "run",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": scheduler.configConfigger,
+		"job":    job.name,
+	}
+
+	scheduler.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	err := job.job(ctx)
+
+	job.finish(scheduler.GetTimer().NowUTC(), err)
+
+	if err != nil {
+		scheduler.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSchedulerJobRun.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSchedulerJobRun.Error())
+
+		return
+	}
+
+	scheduler.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMJobStatus, job.status()).
+		Debug(object.URIEmpty)
+}
+
+func (job *schedulerJob) finish(
+	finishedAt time.Time,
+	err error,
+) {
+	job.mutex.Lock()
+	defer job.mutex.Unlock()
+
+	job.err = object.URIEmpty
+	job.finishedAt = finishedAt
+	job.state = object.JobStateTypeSucceeded
+
+	if err != nil {
+		job.err = err.Error()
+		job.state = object.JobStateTypeFailed
+	}
+}
+
+func (job *schedulerJob) setNextRunAt(
+	nextRunAt time.Time,
+) {
+	job.mutex.Lock()
+	defer job.mutex.Unlock()
+
+	job.nextRunAt = nextRunAt
+}
+
+// start marks the job as running, or counts a skip when the previous run has not finished.
+func (job *schedulerJob) start(
+	startedAt time.Time,
+) bool {
+	job.mutex.Lock()
+	defer job.mutex.Unlock()
+
+	if job.state == object.JobStateTypeRunning {
+		job.skipCount++
+
+		return false
+	}
+
+	job.runCount++
+	job.startedAt = startedAt
+	job.state = object.JobStateTypeRunning
+
+	return true
+}
+
+func (job *schedulerJob) status() om.JobStatuser {
+	job.mutex.Lock()
+	defer job.mutex.Unlock()
+
+	return om.NewJobStatus(
+		job.err,
+		job.name,
+		string(job.state),
+		job.finishedAt,
+		job.nextRunAt,
+		job.startedAt,
+		job.runCount,
+		job.skipCount,
+		uuid.Nil,
+	)
+}
@@ -0,0 +1,289 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type (
+	// schedulerTestTimer is a timer whose now only moves when a test advances
+	// it. Every call to After is announced on registered, so a test knows the
+	// loop is waiting before it advances.
+	schedulerTestTimer struct {
+		object.Timer
+		mutex      sync.Mutex
+		now        time.Time
+		registered chan struct{}
+		waiters    []schedulerTestTimerWaiter
+	}
+
+	schedulerTestTimerWaiter struct {
+		at      time.Time
+		channel chan time.Time
+	}
+)
+
+// After is a function.
+func (timer *schedulerTestTimer) After(
+	duration time.Duration,
+) <-chan time.Time {
+	timer.mutex.Lock()
+
+	channel := make(chan time.Time, 1)
+	if duration <= 0 {
+		channel <- timer.now
+	} else {
+		timer.waiters = append(timer.waiters, schedulerTestTimerWaiter{
+			at:      timer.now.Add(duration),
+			channel: channel,
+		})
+	}
+
+	timer.mutex.Unlock()
+
+	timer.registered <- struct{}{}
+
+	return channel
+}
+
+// NowUTC is a function.
+func (timer *schedulerTestTimer) NowUTC() time.Time {
+	timer.mutex.Lock()
+	defer timer.mutex.Unlock()
+
+	return timer.now
+}
+
+// advance moves now by the duration and fires every waiter that is due.
+func (timer *schedulerTestTimer) advance(
+	duration time.Duration,
+) {
+	timer.mutex.Lock()
+	defer timer.mutex.Unlock()
+
+	timer.now = timer.now.Add(duration)
+	waiters := make([]schedulerTestTimerWaiter, 0, len(timer.waiters))
+
+	for _, waiter := range timer.waiters {
+		if waiter.at.After(timer.now) {
+			waiters = append(waiters, waiter)
+
+			continue
+		}
+
+		waiter.channel <- timer.now
+	}
+
+	timer.waiters = waiters
+}
+
+func newSchedulerTest(
+	startAt time.Time,
+) (*scheduler, *schedulerTestTimer) {
+	configConfigger := config.NewConfig(config.WithLogConfigger())
+	timer := &schedulerTestTimer{
+		Timer:      nil,
+		mutex:      sync.Mutex{},
+		now:        startAt,
+		registered: make(chan struct{}, 64),
+		waiters:    []schedulerTestTimerWaiter{},
+	}
+
+	return NewScheduler(
+		configConfigger,
+		log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+		timer,
+		trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+		util.NewUUID(),
+	), timer
+}
+
+func schedulerTestWait(
+	t *testing.T,
+	channel <-chan struct{},
+) {
+	t.Helper()
+
+	select {
+	case <-channel:
+	case <-time.After(time.Second):
+		t.Fatal("timed out")
+	}
+}
+
+// schedulerTestStatus waits until the job has left the running state.
+func schedulerTestStatus(
+	t *testing.T,
+	scheduler *scheduler,
+	name string,
+) om.JobStatuser {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+
+	for {
+		omJobStatuser, err := scheduler.GetStatus(name)
+		if err != nil {
+			t.Fatalf("GetStatus() error = %v", err)
+		}
+
+		if omJobStatuser.GetState() != string(object.JobStateTypeRunning) {
+			return omJobStatuser
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSchedulerRunSkipsOverlap(t *testing.T) {
+	t.Parallel()
+
+	scheduler, timer := newSchedulerTest(time.Date(2024, time.January, 1, 0, 0, 30, 0, time.UTC))
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+
+	scheduler.Register("slow", NewIntervalTrigger(time.Minute), func(context.Context) error {
+		started <- struct{}{}
+		<-release
+
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_ = scheduler.Run(ctx)
+	}()
+
+	schedulerTestWait(t, timer.registered)
+
+	omJobStatuser, err := scheduler.GetStatus("slow")
+	if err != nil {
+		t.Fatalf("GetStatus() error = %v", err)
+	}
+
+	if want := time.Date(2024, time.January, 1, 0, 1, 0, 0, time.UTC); !omJobStatuser.GetNextRunAt().Equal(want) {
+		t.Errorf("GetNextRunAt() = %v, want %v", omJobStatuser.GetNextRunAt(), want)
+	}
+
+	timer.advance(30 * time.Second)
+	schedulerTestWait(t, started)
+	schedulerTestWait(t, timer.registered)
+
+	// The first run is still in progress, so the second fire time is skipped.
+	timer.advance(time.Minute)
+	schedulerTestWait(t, timer.registered)
+
+	omJobStatuser, err = scheduler.GetStatus("slow")
+	if err != nil {
+		t.Fatalf("GetStatus() error = %v", err)
+	}
+
+	if omJobStatuser.GetState() != string(object.JobStateTypeRunning) {
+		t.Errorf("GetState() = %q, want %q", omJobStatuser.GetState(), object.JobStateTypeRunning)
+	}
+
+	if omJobStatuser.GetRunCount() != 1 || omJobStatuser.GetSkipCount() != 1 {
+		t.Errorf(
+			"GetRunCount(), GetSkipCount() = %d, %d, want 1, 1",
+			omJobStatuser.GetRunCount(),
+			omJobStatuser.GetSkipCount(),
+		)
+	}
+
+	close(release)
+
+	omJobStatuser = schedulerTestStatus(t, scheduler, "slow")
+	if omJobStatuser.GetState() != string(object.JobStateTypeSucceeded) {
+		t.Errorf("GetState() = %q, want %q", omJobStatuser.GetState(), object.JobStateTypeSucceeded)
+	}
+
+	// The next fire time runs again once the first run has finished.
+	timer.advance(time.Minute)
+	schedulerTestWait(t, started)
+	schedulerTestWait(t, timer.registered)
+
+	omJobStatuser = schedulerTestStatus(t, scheduler, "slow")
+	if omJobStatuser.GetRunCount() != 2 || omJobStatuser.GetSkipCount() != 1 {
+		t.Errorf(
+			"GetRunCount(), GetSkipCount() = %d, %d, want 2, 1",
+			omJobStatuser.GetRunCount(),
+			omJobStatuser.GetSkipCount(),
+		)
+	}
+
+	cancel()
+	schedulerTestWait(t, done)
+}
+
+func TestSchedulerRunFailed(t *testing.T) {
+	t.Parallel()
+
+	scheduler, timer := newSchedulerTest(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	errJob := errors.New("job failed")
+
+	scheduler.Register("failing", NewIntervalTrigger(time.Minute), func(context.Context) error {
+		return errJob
+	})
+	scheduler.Register("idle", NewIntervalTrigger(0), func(context.Context) error {
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_ = scheduler.Run(ctx)
+	}()
+
+	schedulerTestWait(t, timer.registered)
+	timer.advance(time.Minute)
+	schedulerTestWait(t, timer.registered)
+
+	omJobStatuser := schedulerTestStatus(t, scheduler, "failing")
+	if omJobStatuser.GetState() != string(object.JobStateTypeFailed) {
+		t.Errorf("GetState() = %q, want %q", omJobStatuser.GetState(), object.JobStateTypeFailed)
+	}
+
+	if omJobStatuser.GetError() != errJob.Error() {
+		t.Errorf("GetError() = %q, want %q", omJobStatuser.GetError(), errJob.Error())
+	}
+
+	omJobStatusers := scheduler.GetStatuses()
+	if len(omJobStatusers) != 2 ||
+		omJobStatusers[0].GetName() != "failing" ||
+		omJobStatusers[1].GetName() != "idle" {
+		t.Errorf("GetStatuses() = %v, want failing and idle", omJobStatusers)
+	}
+
+	if omJobStatusers[1].GetState() != string(object.JobStateTypeIdle) {
+		t.Errorf("GetState() = %q, want %q", omJobStatusers[1].GetState(), object.JobStateTypeIdle)
+	}
+
+	if _, err := scheduler.GetStatus("missing"); !errors.Is(err, object.ErrSchedulerJobNotFound) {
+		t.Errorf("GetStatus() error = %v, want %v", err, object.ErrSchedulerJobNotFound)
+	}
+
+	cancel()
+	schedulerTestWait(t, done)
+}
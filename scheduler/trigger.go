@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/util"
+)
+
+type (
+	// Triggerer is an interface.
+	Triggerer interface {
+		// Next is a function.
+		// It returns the first fire time strictly after the given time,
+		// or the zero time when the trigger never fires again.
+		Next(
+			time.Time,
+		) time.Time
+	}
+
+	intervalTrigger struct {
+		interval time.Duration
+	}
+
+	klineTrigger struct {
+		delay     time.Duration
+		klineType object.KlineTypeType
+	}
+)
+
+var (
+	_ Triggerer = (*intervalTrigger)(nil)
+	_ Triggerer = (*klineTrigger)(nil)
+)
+
+// NewIntervalTrigger is a function.
+// It fires on multiples of the interval since the Unix epoch,
+// a non-positive interval never fires.
+func NewIntervalTrigger(
+	interval time.Duration,
+) *intervalTrigger {
+	return &intervalTrigger{
+		interval: interval,
+	}
+}
+
+// NewKlineTrigger is a function.
+// It fires when a kline of the type closes, shifted by the delay so the exchange
+// has published the closed candle.
+func NewKlineTrigger(
+	klineType object.KlineTypeType,
+	delay time.Duration,
+) *klineTrigger {
+	return &klineTrigger{
+		delay:     delay,
+		klineType: klineType,
+	}
+}
+
+// Next is a function.
+func (trigger *intervalTrigger) Next(
+	timeTime time.Time,
+) time.Time {
+	if trigger.interval <= 0 {
+		return time.Time{}
+	}
+
+	return timeTime.Truncate(trigger.interval).Add(trigger.interval)
+}
+
+// Next is a function.
+func (trigger *klineTrigger) Next(
+	timeTime time.Time,
+) time.Time {
+	secondKlineType := util.KlineTypeToSecond(trigger.klineType)
+	startAt := util.KlineBucketStartAt(trigger.klineType, timeTime.Add(-trigger.delay).Unix())
+	next := time.Unix(startAt, 0).UTC().Add(trigger.delay)
+
+	for !next.After(timeTime) {
+		next = next.Add(time.Duration(secondKlineType) * time.Second)
+	}
+
+	return next
+}
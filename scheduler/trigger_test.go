@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+func TestIntervalTriggerNext(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		interval time.Duration
+		timeTime time.Time
+		want     time.Time
+	}{
+		{
+			name:     "between fire times",
+			interval: time.Minute,
+			timeTime: time.Date(2024, time.January, 1, 0, 0, 30, 0, time.UTC),
+			want:     time.Date(2024, time.January, 1, 0, 1, 0, 0, time.UTC),
+		},
+		{
+			name:     "on a fire time",
+			interval: time.Minute,
+			timeTime: time.Date(2024, time.January, 1, 0, 1, 0, 0, time.UTC),
+			want:     time.Date(2024, time.January, 1, 0, 2, 0, 0, time.UTC),
+		},
+		{
+			name:     "aligned to the epoch",
+			interval: 15 * time.Minute,
+			timeTime: time.Date(2024, time.January, 1, 10, 7, 0, 0, time.UTC),
+			want:     time.Date(2024, time.January, 1, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			name:     "never",
+			interval: 0,
+			timeTime: time.Date(2024, time.January, 1, 0, 0, 30, 0, time.UTC),
+			want:     time.Time{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := NewIntervalTrigger(test.interval).Next(test.timeTime); !got.Equal(test.want) {
+				t.Errorf("Next() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestKlineTriggerNext(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		klineType object.KlineTypeType
+		delay     time.Duration
+		timeTime  time.Time
+		want      time.Time
+	}{
+		{
+			name:      "inside a kline",
+			klineType: object.KlineTypeType1hour,
+			delay:     5 * time.Second,
+			timeTime:  time.Date(2024, time.January, 1, 10, 30, 0, 0, time.UTC),
+			want:      time.Date(2024, time.January, 1, 11, 0, 5, 0, time.UTC),
+		},
+		{
+			name:      "inside the delay",
+			klineType: object.KlineTypeType1hour,
+			delay:     5 * time.Second,
+			timeTime:  time.Date(2024, time.January, 1, 11, 0, 3, 0, time.UTC),
+			want:      time.Date(2024, time.January, 1, 11, 0, 5, 0, time.UTC),
+		},
+		{
+			name:      "on a fire time",
+			klineType: object.KlineTypeType1hour,
+			delay:     5 * time.Second,
+			timeTime:  time.Date(2024, time.January, 1, 11, 0, 5, 0, time.UTC),
+			want:      time.Date(2024, time.January, 1, 12, 0, 5, 0, time.UTC),
+		},
+		{
+			name:      "without delay",
+			klineType: object.KlineTypeType15min,
+			delay:     0,
+			timeTime:  time.Date(2024, time.January, 1, 10, 15, 0, 0, time.UTC),
+			want:      time.Date(2024, time.January, 1, 10, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewKlineTrigger(test.klineType, test.delay).Next(test.timeTime)
+			if !got.Equal(test.want) {
+				t.Errorf("Next() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
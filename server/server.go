@@ -2,11 +2,15 @@ package server
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"os"
+	"strconv"
 
 	"github.com/ShahoBashoki/kucoin/config"
 	"github.com/ShahoBashoki/kucoin/log"
 	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/scheduler"
 	"github.com/ShahoBashoki/kucoin/service"
 	"github.com/ShahoBashoki/kucoin/util"
 	"github.com/gin-gonic/gin"
@@ -24,6 +28,7 @@ type (
 	Serverer interface {
 		config.GetConfigger
 		log.GetRuntimeLogger
+		scheduler.GetScheduler
 		service.GetServicer
 		util.GetTracer
 		// Run is a function.
@@ -49,6 +54,7 @@ type (
 	server struct {
 		configConfigger  config.Configger
 		logRuntimeLogger log.RuntimeLogger
+		scheduler        scheduler.Scheduler
 		servicer         service.Servicer
 		traceTracer      trace.Tracer
 		utilUUIDer       util.UUIDer
@@ -61,6 +67,7 @@ var _ Serverer = (*server)(nil)
 func NewServerrer(
 	configConfigger config.Configger,
 	logRuntimeLogger log.RuntimeLogger,
+	schedulerScheduler scheduler.Scheduler,
 	servicer service.Servicer,
 	traceTracer trace.Tracer,
 	utilUUIDer util.UUIDer,
@@ -68,6 +75,7 @@ func NewServerrer(
 	server := &server{
 		configConfigger:  configConfigger,
 		logRuntimeLogger: logRuntimeLogger,
+		scheduler:        schedulerScheduler,
 		servicer:         servicer,
 		traceTracer:      traceTracer,
 		utilUUIDer:       utilUUIDer,
@@ -86,6 +94,11 @@ func (server *server) GetRuntimeLogger() log.RuntimeLogger {
 	return server.logRuntimeLogger
 }
 
+// GetScheduler is a function.
+func (server *server) GetScheduler() scheduler.Scheduler {
+	return server.scheduler
+}
+
 // GetTracer is a function.
 func (server *server) GetServicer() service.Servicer {
 	return server.servicer
@@ -128,9 +141,8 @@ func (server *server) Run(
 		Info(object.URIEmpty)
 
 	gin.SetMode(os.Getenv("GIN_MODE"))
-	router := gin.Default()
 
-	errRouterRun := router.Run()
+	errRouterRun := server.router().Run()
 	if errRouterRun != nil {
 		server.GetRuntimeLogger().
 			WithFields(fields).
@@ -144,3 +156,150 @@ func (server *server) Run(
 
 	return nil
 }
+
+// engageKillSwitch engages the kill switch with the reason of the query. Open
+// orders are cancelled too when the cancel query is true.
+func (server *server) engageKillSwitch(
+	ginContext *gin.Context,
+) {
+	ctx := ginContext.Request.Context()
+	reason := ginContext.Query(object.URIServerQueryReason)
+	cancel := false
+
+	if value := ginContext.Query(object.URIServerQueryCancel); value != object.URIEmpty {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			server.GetRuntimeLogger().
+				WithField(object.URIFieldError, err).
+				Error(object.ErrServerQueryParse.Error())
+			ginContext.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				object.URIFieldError: object.ErrServerQueryParse.Error(),
+			})
+
+			return
+		}
+
+		cancel = parsed
+	}
+
+	if err := server.GetServicer().GetRiskServicer().Engage(ctx, reason, cancel); err != nil {
+		server.GetRuntimeLogger().
+			WithField(object.URIFieldError, err).
+			Error(object.ErrRiskServiceEngage.Error())
+		ginContext.AbortWithStatusJSON(serverStatus(err), gin.H{
+			object.URIFieldError: err.Error(),
+		})
+
+		return
+	}
+
+	server.getKillSwitch(ginContext)
+}
+
+// getHealth answers with the mode the exchange last reported and its message,
+// and with the status of every scheduled job, so a probe can tell a paused
+// exchange from a failing service.
+func (server *server) getHealth(
+	ginContext *gin.Context,
+) {
+	exchangeStatusServicer := server.GetServicer().GetExchangeStatusServicer()
+
+	ginContext.JSON(http.StatusOK, gin.H{
+		object.URIFieldExchangeMode: exchangeStatusServicer.GetMode(),
+		object.URIFieldJobs:         server.GetScheduler().GetStatuses(),
+		object.URIFieldMessage:      exchangeStatusServicer.GetMessage(),
+	})
+}
+
+// getJob answers with the status of the scheduled job of the name.
+func (server *server) getJob(
+	ginContext *gin.Context,
+) {
+	omJobStatuser, err := server.GetScheduler().GetStatus(ginContext.Param(object.URIServerParamName))
+	if err != nil {
+		server.GetRuntimeLogger().
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSchedulerJobNotFound.Error())
+		ginContext.AbortWithStatusJSON(serverStatus(err), gin.H{
+			object.URIFieldError: err.Error(),
+		})
+
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, omJobStatuser)
+}
+
+// getKillSwitch answers with the stored kill switch.
+func (server *server) getKillSwitch(
+	ginContext *gin.Context,
+) {
+	omKillSwitcher, err := server.GetServicer().
+		GetRiskServicer().
+		GetKillSwitch(ginContext.Request.Context())
+	if err != nil {
+		server.GetRuntimeLogger().
+			WithField(object.URIFieldError, err).
+			Error(object.ErrRiskServiceGetKillSwitch.Error())
+		ginContext.AbortWithStatusJSON(serverStatus(err), gin.H{
+			object.URIFieldError: err.Error(),
+		})
+
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, omKillSwitcher)
+}
+
+// releaseKillSwitch releases the kill switch.
+func (server *server) releaseKillSwitch(
+	ginContext *gin.Context,
+) {
+	err := server.GetServicer().GetRiskServicer().Release(ginContext.Request.Context())
+	if err != nil {
+		server.GetRuntimeLogger().
+			WithField(object.URIFieldError, err).
+			Error(object.ErrRiskServiceRelease.Error())
+		ginContext.AbortWithStatusJSON(serverStatus(err), gin.H{
+			object.URIFieldError: err.Error(),
+		})
+
+		return
+	}
+
+	server.getKillSwitch(ginContext)
+}
+
+// router routes every path the server answers on to its handler.
+func (server *server) router() *gin.Engine {
+	router := gin.Default()
+	router.GET(object.URIServerPathHealth, server.getHealth)
+	router.GET(object.URIServerPathRiskKillSwitch, server.getKillSwitch)
+	router.POST(object.URIServerPathRiskKillSwitch, server.engageKillSwitch)
+	router.DELETE(object.URIServerPathRiskKillSwitch, server.releaseKillSwitch)
+	router.GET(object.URIServerPathSchedulerJob, server.getJob)
+
+	return router
+}
+
+// serverStatus is the status of an answer that failed with err. A failure of
+// KuCoin is told apart from one of the server, so a client knows when to back
+// off and retry.
+func serverStatus(
+	err error,
+) int {
+	switch {
+	case errors.Is(err, object.ErrKucoinRateLimit):
+		return http.StatusTooManyRequests
+	case errors.Is(err, object.ErrExchangeCircuitOpen),
+		errors.Is(err, object.ErrKucoinSystemBusy),
+		errors.Is(err, object.ErrKucoinTransport):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, object.ErrKucoinAuth):
+		return http.StatusBadGateway
+	case errors.Is(err, object.ErrSchedulerJobNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,257 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/exchange/exchangetest"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/scheduler"
+	"github.com/ShahoBashoki/kucoin/service"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type (
+	serverTestExchangeStatusServicer struct {
+		service.ExchangeStatusServicer
+	}
+
+	serverTestServicer struct {
+		service.Servicer
+		exchangeStatusServicer service.ExchangeStatusServicer
+		orderServicer          service.OrderServicer
+		riskServicer           service.RiskServicer
+	}
+
+	serverTestRiskServicer struct {
+		service.RiskServicer
+		orderServicer service.OrderServicer
+	}
+
+	serverTestTimer struct {
+		object.Timer
+		now time.Time
+	}
+)
+
+// GetMessage is a function.
+func (servicer *serverTestExchangeStatusServicer) GetMessage() string {
+	return object.URIEmpty
+}
+
+// GetMode is a function.
+func (servicer *serverTestExchangeStatusServicer) GetMode() object.ExchangeModeType {
+	return object.ExchangeModeTypeOpen
+}
+
+// GetExchangeStatusServicer is a function.
+func (servicer *serverTestServicer) GetExchangeStatusServicer() service.ExchangeStatusServicer {
+	return servicer.exchangeStatusServicer
+}
+
+// GetOrderServicer is a function.
+func (servicer *serverTestServicer) GetOrderServicer() service.OrderServicer {
+	return servicer.orderServicer
+}
+
+// GetRiskServicer is a function.
+func (servicer *serverTestServicer) GetRiskServicer() service.RiskServicer {
+	return servicer.riskServicer
+}
+
+// Engage is a function.
+// It only cancels the open orders of one symbol, which reaches the exchange.
+func (servicer *serverTestRiskServicer) Engage(
+	ctx context.Context,
+	_ string,
+	_ bool,
+) error {
+	_, err := servicer.orderServicer.CancelAllForSymbol(ctx, "BTC-USDT")
+
+	return err
+}
+
+// NowUTC is a function.
+func (timer *serverTestTimer) NowUTC() time.Time {
+	return timer.now
+}
+
+func TestServerEngageKillSwitchCircuitOpen(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	fakeServerer := exchangetest.NewFakeServer(
+		kucoin.ApiKeyOption("key"),
+		kucoin.ApiSecretOption("secret"),
+		kucoin.ApiPassPhraseOption("passphrase"),
+		kucoin.ApiKeyVersionOption(kucoin.ApiKeyVersionV2),
+	)
+	t.Cleanup(fakeServerer.Close)
+
+	objectTimer := &serverTestTimer{
+		Timer: nil,
+		now:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	circuitBreaker := exchange.NewCircuitBreaker(objectTimer, 1, time.Minute)
+	circuitBreaker.Record(object.NewKucoinTransportError(object.URIEmpty))
+
+	configConfigger := config.NewConfig(
+		config.WithKucoinConfigger(),
+		config.WithLogConfigger(),
+	)
+	logRuntimeLogger := log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop())
+	traceTracer := trace.NewNoopTracerProvider().Tracer(object.URIEmpty)
+
+	orderServicer := service.NewOrderServicer(
+		configConfigger,
+		nil,
+		logRuntimeLogger,
+		traceTracer,
+		util.NewUUID(),
+		exchange.NewRetryExchange(
+			fakeServerer.GetExchanger(),
+			objectTimer,
+			map[object.EndpointGroupType]exchange.CircuitBreaker{
+				object.EndpointGroupTypeSpot: circuitBreaker,
+			},
+			map[object.EndpointGroupType]exchange.RateLimiter{},
+			1,
+			time.Millisecond,
+			time.Millisecond,
+		),
+	)
+	servicer := &serverTestServicer{
+		Servicer:               nil,
+		exchangeStatusServicer: &serverTestExchangeStatusServicer{ExchangeStatusServicer: nil},
+		orderServicer:          orderServicer,
+		riskServicer: &serverTestRiskServicer{
+			RiskServicer:  nil,
+			orderServicer: orderServicer,
+		},
+	}
+	orderServicer.(service.WithServicer).WithServicer(servicer)
+
+	serverServerer := NewServerrer(
+		configConfigger,
+		logRuntimeLogger,
+		scheduler.NewScheduler(configConfigger, logRuntimeLogger, objectTimer, traceTracer, util.NewUUID()),
+		servicer,
+		traceTracer,
+		util.NewUUID(),
+	)
+
+	request := httptest.NewRequest(
+		http.MethodPost,
+		object.URIServerPathRiskKillSwitch+"?"+object.URIServerQueryCancel+"=true",
+		nil,
+	)
+	recorder := httptest.NewRecorder()
+
+	serverServerer.(*server).router().ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want %d", recorder.Code, http.StatusServiceUnavailable)
+	}
+
+	if got := len(fakeServerer.GetRequesters(http.MethodDelete, object.URIKucoinPathOrders)); got != 0 {
+		t.Errorf("cancel requests = %d, want 0", got)
+	}
+}
+
+func TestServerGetJobs(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	configConfigger := config.NewConfig(config.WithLogConfigger())
+	logRuntimeLogger := log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop())
+	traceTracer := trace.NewNoopTracerProvider().Tracer(object.URIEmpty)
+
+	schedulerScheduler := scheduler.NewScheduler(
+		configConfigger,
+		logRuntimeLogger,
+		&serverTestTimer{Timer: nil, now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		traceTracer,
+		util.NewUUID(),
+	)
+	schedulerScheduler.Register(
+		object.URISchedulerJobTickerRefresh,
+		scheduler.NewIntervalTrigger(time.Minute),
+		func(context.Context) error { return nil },
+	)
+
+	serverServerer := NewServerrer(
+		configConfigger,
+		logRuntimeLogger,
+		schedulerScheduler,
+		&serverTestServicer{
+			Servicer:               nil,
+			exchangeStatusServicer: &serverTestExchangeStatusServicer{ExchangeStatusServicer: nil},
+			orderServicer:          nil,
+			riskServicer:           nil,
+		},
+		traceTracer,
+		util.NewUUID(),
+	)
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+		want   string
+	}{
+		{
+			name:   "health",
+			path:   object.URIServerPathHealth,
+			status: http.StatusOK,
+			want:   `"jobs":[{`,
+		},
+		{
+			name:   "job",
+			path:   "/scheduler/jobs/" + object.URISchedulerJobTickerRefresh,
+			status: http.StatusOK,
+			want:   `"name":"` + object.URISchedulerJobTickerRefresh + `"`,
+		},
+		{
+			name:   "missing job",
+			path:   "/scheduler/jobs/missing",
+			status: http.StatusNotFound,
+			want:   object.ErrSchedulerJobNotFound.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := httptest.NewRecorder()
+
+			serverServerer.(*server).router().ServeHTTP(
+				recorder,
+				httptest.NewRequest(http.MethodGet, test.path, nil),
+			)
+
+			if recorder.Code != test.status {
+				t.Errorf("status = %d, want %d", recorder.Code, test.status)
+			}
+
+			if !strings.Contains(recorder.Body.String(), test.want) {
+				t.Errorf("body = %s, want %s", recorder.Body.String(), test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,2529 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// AlgoOrderServicer is an interface.
+	AlgoOrderServicer interface {
+		// Cancel is a function.
+		Cancel(
+			context.Context,
+			uuid.UUID,
+		) (om.AlgoOrderer, error)
+		// Create is a function.
+		Create(
+			context.Context,
+			om.AlgoOrderer,
+		) (uuid.UUID, error)
+		// DeleteAll is a function.
+		DeleteAll(
+			context.Context,
+		) (time.Time, error)
+		// Get is a function.
+		Get(
+			context.Context,
+			uuid.UUID,
+		) (om.AlgoOrderer, error)
+		// GetByClientOID is a function.
+		GetByClientOID(
+			context.Context,
+			string,
+		) (om.AlgoOrderer, error)
+		// GetChildren is a function.
+		GetChildren(
+			context.Context,
+			string,
+		) ([]om.Orderer, error)
+		// GetListFromRepository is a function.
+		GetListFromRepository(
+			context.Context,
+			dao.Paginationer,
+			dao.AlgoOrderFilterer,
+		) ([]om.AlgoOrderer, dao.Cursorer, error)
+		// Place is a function.
+		Place(
+			context.Context,
+			dto.PlaceAlgoOrderRequester,
+		) (om.AlgoOrderer, error)
+		// Reconcile is a function.
+		Reconcile(
+			context.Context,
+			om.AlgoOrderer,
+		) (om.AlgoOrderer, error)
+		// Sync is a function.
+		Sync(
+			context.Context,
+		) error
+		// Update is a function.
+		Update(
+			context.Context,
+			om.AlgoOrderer,
+		) (time.Time, error)
+	}
+
+	// GetAlgoOrderServicer is an interface.
+	GetAlgoOrderServicer interface {
+		// GetAlgoOrderServicer is a function.
+		GetAlgoOrderServicer() AlgoOrderServicer
+	}
+
+	algoOrderService struct {
+		configConfigger   config.Configger
+		repositorier      repository.AlgoOrderRepositorier
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+)
+
+var (
+	_ GetServicer                         = (*algoOrderService)(nil)
+	_ AlgoOrderServicer                   = (*algoOrderService)(nil)
+	_ WithServicer                        = (*algoOrderService)(nil)
+	_ config.GetConfigger                 = (*algoOrderService)(nil)
+	_ exchange.GetExchanger               = (*algoOrderService)(nil)
+	_ log.GetRuntimeLogger                = (*algoOrderService)(nil)
+	_ repository.GetAlgoOrderRepositorier = (*algoOrderService)(nil)
+	_ util.GetTracer                      = (*algoOrderService)(nil)
+	_ util.GetUUIDer                      = (*algoOrderService)(nil)
+)
+
+// NewAlgoOrderServicer is a function.
+func NewAlgoOrderServicer(
+	configConfigger config.Configger,
+	repositorier repository.AlgoOrderRepositorier,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) AlgoOrderServicer {
+	return &algoOrderService{
+		configConfigger:   configConfigger,
+		repositorier:      repositorier,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *algoOrderService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *algoOrderService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *algoOrderService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetAlgoOrderRepositorier is a function.
+func (service *algoOrderService) GetAlgoOrderRepositorier() repository.AlgoOrderRepositorier {
+	return service.repositorier
+}
+
+// GetTracer is a function.
+func (service *algoOrderService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *algoOrderService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *algoOrderService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *algoOrderService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// Create is a function.
+func (service *algoOrderService) Create(
+	ctx context.Context,
+	omAlgoOrderer om.AlgoOrderer,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "Create",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_algo_orderer": omAlgoOrderer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoAlgoOrder := dao.NewAlgoOrder(
+		time.Time{},
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		uuid.Nil,
+		omAlgoOrderer.GetAccount(),
+		omAlgoOrderer.GetAlgoType(),
+		omAlgoOrderer.GetAveragePrice(),
+		omAlgoOrderer.GetChildClientOID(),
+		omAlgoOrderer.GetChildDealFunds(),
+		omAlgoOrderer.GetChildDealSize(),
+		omAlgoOrderer.GetClientOID(),
+		omAlgoOrderer.GetDealFunds(),
+		omAlgoOrderer.GetDealSize(),
+		omAlgoOrderer.GetLimitPrice(),
+		omAlgoOrderer.GetReason(),
+		omAlgoOrderer.GetSchedule(),
+		omAlgoOrderer.GetSide(),
+		omAlgoOrderer.GetSize(),
+		omAlgoOrderer.GetState(),
+		omAlgoOrderer.GetSymbol(),
+		omAlgoOrderer.GetVisibleSize(),
+		omAlgoOrderer.GetChildAt(),
+		omAlgoOrderer.GetChildCount(),
+		omAlgoOrderer.GetEndAt(),
+		omAlgoOrderer.GetSlices(),
+		omAlgoOrderer.GetStartAt(),
+		omAlgoOrderer.GetIsActive(),
+		omAlgoOrderer.GetPaper(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOAlgoOrder, daoAlgoOrder).
+		Debug(object.URIEmpty)
+
+	algoOrderID, err := service.GetAlgoOrderRepositorier().Create(ctx, daoAlgoOrder)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldAlgoOrderID, algoOrderID).
+		Debug(object.URIEmpty)
+
+	return algoOrderID, nil
+}
+
+// DeleteAll is a function.
+func (service *algoOrderService) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	deletedAt, err := service.GetAlgoOrderRepositorier().DeleteAll(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDeletedAt, deletedAt).
+		Debug(object.URIEmpty)
+
+	return deletedAt, nil
+}
+
+// Get is a function.
+func (service *algoOrderService) Get(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.AlgoOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Get",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Get",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoAlgoOrder, err := service.GetAlgoOrderRepositorier().Read(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOAlgoOrder, daoAlgoOrder).
+		Debug(object.URIEmpty)
+
+	omAlgoOrder := om.NewAlgoOrder(
+		daoAlgoOrder.GetAccount(),
+		daoAlgoOrder.GetAlgoType(),
+		daoAlgoOrder.GetAveragePrice(),
+		daoAlgoOrder.GetChildClientOID(),
+		daoAlgoOrder.GetChildDealFunds(),
+		daoAlgoOrder.GetChildDealSize(),
+		daoAlgoOrder.GetClientOID(),
+		daoAlgoOrder.GetDealFunds(),
+		daoAlgoOrder.GetDealSize(),
+		daoAlgoOrder.GetLimitPrice(),
+		daoAlgoOrder.GetReason(),
+		daoAlgoOrder.GetSchedule(),
+		daoAlgoOrder.GetSide(),
+		daoAlgoOrder.GetSize(),
+		daoAlgoOrder.GetState(),
+		daoAlgoOrder.GetSymbol(),
+		daoAlgoOrder.GetVisibleSize(),
+		daoAlgoOrder.GetChildAt(),
+		daoAlgoOrder.GetChildCount(),
+		daoAlgoOrder.GetEndAt(),
+		daoAlgoOrder.GetSlices(),
+		daoAlgoOrder.GetStartAt(),
+		daoAlgoOrder.GetIsActive(),
+		daoAlgoOrder.GetPaper(),
+		daoAlgoOrder.GetID(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMAlgoOrder, omAlgoOrder).
+		Debug(object.URIEmpty)
+
+	return omAlgoOrder, nil
+}
+
+// GetListFromRepository is a function.
+func (service *algoOrderService) GetListFromRepository(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoAlgoOrderFilterer dao.AlgoOrderFilterer,
+) ([]om.AlgoOrderer, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRepository",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                    "GetListFromRepository",
+		"rt_ctx":                  utilRuntimeContext,
+		"sp_ctx":                  utilSpanContext,
+		"config":                  service.configConfigger,
+		"dao_paginationer":        daoPaginationer,
+		"dao_algo_order_filterer": daoAlgoOrderFilterer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoAlgoOrders, daoCursorer, err := service.GetAlgoOrderRepositorier().
+		ReadList(ctx, daoPaginationer, daoAlgoOrderFilterer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOAlgoOrders, daoAlgoOrders).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	omAlgoOrders := make([]om.AlgoOrderer, 0, len(daoAlgoOrders))
+
+	for key, daoAlgoOrder := range daoAlgoOrders {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldDAOAlgoOrder, daoAlgoOrder).
+			Debug(object.URIEmpty)
+
+		omAlgoOrders = append(omAlgoOrders, om.NewAlgoOrder(
+			daoAlgoOrder.GetAccount(),
+			daoAlgoOrder.GetAlgoType(),
+			daoAlgoOrder.GetAveragePrice(),
+			daoAlgoOrder.GetChildClientOID(),
+			daoAlgoOrder.GetChildDealFunds(),
+			daoAlgoOrder.GetChildDealSize(),
+			daoAlgoOrder.GetClientOID(),
+			daoAlgoOrder.GetDealFunds(),
+			daoAlgoOrder.GetDealSize(),
+			daoAlgoOrder.GetLimitPrice(),
+			daoAlgoOrder.GetReason(),
+			daoAlgoOrder.GetSchedule(),
+			daoAlgoOrder.GetSide(),
+			daoAlgoOrder.GetSize(),
+			daoAlgoOrder.GetState(),
+			daoAlgoOrder.GetSymbol(),
+			daoAlgoOrder.GetVisibleSize(),
+			daoAlgoOrder.GetChildAt(),
+			daoAlgoOrder.GetChildCount(),
+			daoAlgoOrder.GetEndAt(),
+			daoAlgoOrder.GetSlices(),
+			daoAlgoOrder.GetStartAt(),
+			daoAlgoOrder.GetIsActive(),
+			daoAlgoOrder.GetPaper(),
+			daoAlgoOrder.GetID(),
+		))
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMAlgoOrders, omAlgoOrders).
+		Debug(object.URIEmpty)
+
+	return omAlgoOrders, daoCursorer, nil
+}
+
+// Cancel is a function.
+// The resting child is cancelled and the algo order is closed with what its
+// children filled so far.
+func (service *algoOrderService) Cancel(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.AlgoOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Cancel",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Cancel",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omAlgoOrderer, err := service.GetServicer().GetAlgoOrderServicer().Get(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderServiceGet.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServiceGet.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMAlgoOrder, omAlgoOrderer).
+		Debug(object.URIEmpty)
+
+	if !omAlgoOrderer.GetIsActive() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`!omAlgoOrderer.GetIsActive()`)
+
+		return omAlgoOrderer, nil
+	}
+
+	omOrderer, err := service.child(ctx, omAlgoOrderer.GetChildClientOID())
+	if err == nil && omOrderer != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`err == nil && omOrderer != nil`)
+
+		omOrderer, err = service.cancelChild(ctx, omOrderer)
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceCancel.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceCancel.Error())
+
+		return nil, err
+	}
+
+	if omOrderer != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMOrder, omOrderer).
+			Debug(`omOrderer != nil`)
+
+		omAlgoOrderer, err = service.deal(ctx, omAlgoOrderer, omOrderer)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrAlgoOrderServiceCancel.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServiceCancel.Error())
+
+			return nil, err
+		}
+	}
+
+	omAlgoOrderer, err = service.close(ctx, omAlgoOrderer, object.AlgoOrderReasonTypeCancelled)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServiceUpdate.Error())
+
+		return nil, err
+	}
+
+	return omAlgoOrderer, nil
+}
+
+// GetByClientOID is a function.
+func (service *algoOrderService) GetByClientOID(
+	ctx context.Context,
+	clientOID string,
+) (om.AlgoOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetByClientOID",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "GetByClientOID",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"client_oid": clientOID,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omAlgoOrderers, _, err := service.GetServicer().GetAlgoOrderServicer().GetListFromRepository(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewAlgoOrderFilter(serviceAccount(ctx, service), clientOID, object.URIEmpty, false),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryReadList.Error())
+
+		return nil, err
+	}
+
+	if len(omAlgoOrderers) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrAlgoOrderNotFound).
+			Error(object.ErrAlgoOrderServiceGetByClientOID.Error())
+		traceSpan.RecordError(object.ErrAlgoOrderNotFound)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServiceGetByClientOID.Error())
+
+		return nil, object.ErrAlgoOrderNotFound
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMAlgoOrder, omAlgoOrderers[0]).
+		Debug(object.URIEmpty)
+
+	return omAlgoOrderers[0], nil
+}
+
+// GetChildren is a function.
+// The children are the stored orders whose remark is the clientOid of the algo
+// order.
+func (service *algoOrderService) GetChildren(
+	ctx context.Context,
+	clientOID string,
+) ([]om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetChildren",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "GetChildren",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"client_oid": clientOID,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omOrderers := make([]om.Orderer, 0)
+
+	var daoCursorer dao.Cursorer = dao.NewCursor(0)
+
+	for daoCursorer != nil {
+		omOrderersPage, daoNextCursorer, err := service.GetServicer().
+			GetOrderServicer().
+			GetListFromRepository(
+				ctx,
+				dao.NewPagination(daoCursorer, object.NUMKucoinRecentOrderCount),
+				dao.NewOrderFilter(
+					object.URIEmpty,
+					object.URIEmpty,
+					object.URIEmpty,
+					clientOID,
+					object.URIEmpty,
+					false,
+				),
+			)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrOrderRepositoryReadList.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrOrderRepositoryReadList.Error())
+
+			return nil, err
+		}
+
+		daoCursorer = daoNextCursorer
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMOrders, omOrderersPage).
+			WithField(object.URIFieldDAOCursor, daoCursorer).
+			Debug(object.URIEmpty)
+
+		omOrderers = append(omOrderers, omOrderersPage...)
+	}
+
+	return omOrderers, nil
+}
+
+// Place is a function.
+// The algo order is stored before its first child is placed, and its schedule
+// is fixed when it is stored: TWAP spreads the size evenly over the slices, VWAP
+// by the volume the symbol traded at the same time of day over the lookback.
+// Placing the same algo order again places nothing twice.
+func (service *algoOrderService) Place(
+	ctx context.Context,
+	dtoPlaceAlgoOrderRequester dto.PlaceAlgoOrderRequester,
+) (om.AlgoOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Place",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                           "Place",
+		"rt_ctx":                         utilRuntimeContext,
+		"sp_ctx":                         utilSpanContext,
+		"config":                         service.configConfigger,
+		"dto_place_algo_order_requester": dtoPlaceAlgoOrderRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if dtoPlaceAlgoOrderRequester.GetClientOID() == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`dtoPlaceAlgoOrderRequester.GetClientOID() == object.URIEmpty`)
+
+		dtoPlaceAlgoOrderRequester = algoOrderServicePlaceAlgoOrderRequestWithClientOID(
+			dtoPlaceAlgoOrderRequester,
+		)
+	}
+
+	if err := algoOrderServiceCheck(dtoPlaceAlgoOrderRequester); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderServicePlace.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServicePlace.Error())
+
+		return nil, err
+	}
+
+	omAlgoOrderer, err := service.GetServicer().
+		GetAlgoOrderServicer().
+		GetByClientOID(ctx, dtoPlaceAlgoOrderRequester.GetClientOID())
+	if errors.Is(err, object.ErrAlgoOrderNotFound) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(err, object.ErrAlgoOrderNotFound)`)
+
+		var schedule string
+
+		schedule, err = service.schedule(ctx, dtoPlaceAlgoOrderRequester)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrAlgoOrderServicePlace.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServicePlace.Error())
+
+			return nil, err
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldSchedule, schedule).
+			Debug(object.URIEmpty)
+
+		var algoOrderID uuid.UUID
+
+		algoOrderID, err = service.GetServicer().GetAlgoOrderServicer().Create(
+			ctx,
+			algoOrderServiceAlgoOrderFromPlaceAlgoOrderRequest(
+				serviceAccount(ctx, service),
+				dtoPlaceAlgoOrderRequester,
+				schedule,
+				service.GetConfigger().GetPaperConfigger().GetEnabled(),
+				uuid.Nil,
+			),
+		)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrAlgoOrderServiceCreate.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServiceCreate.Error())
+
+			return nil, err
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldAlgoOrderID, algoOrderID).
+			Debug(object.URIEmpty)
+
+		omAlgoOrderer, err = service.GetServicer().GetAlgoOrderServicer().Get(ctx, algoOrderID)
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderServiceGetByClientOID.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServiceGetByClientOID.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMAlgoOrder, omAlgoOrderer).
+		Debug(object.URIEmpty)
+
+	omAlgoOrderer, err = service.GetServicer().GetAlgoOrderServicer().Reconcile(ctx, omAlgoOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderServiceReconcile.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServiceReconcile.Error())
+
+		return nil, err
+	}
+
+	return omAlgoOrderer, nil
+}
+
+// Reconcile is a function.
+// It brings the fill progress and the average price up to date from the resting
+// child, cancels a child that outlived its slice or left the best price, and
+// places the next child once none rests. Only one child rests at a time.
+func (service *algoOrderService) Reconcile(
+	ctx context.Context,
+	omAlgoOrderer om.AlgoOrderer,
+) (om.AlgoOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Reconcile",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "Reconcile",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_algo_orderer": omAlgoOrderer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if !omAlgoOrderer.GetIsActive() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`!omAlgoOrderer.GetIsActive()`)
+
+		return omAlgoOrderer, nil
+	}
+
+	now := time.Now().Unix()
+
+	omSymboler, err := service.GetServicer().
+		GetSymbolServicer().
+		GetBySymbol(ctx, omAlgoOrderer.GetSymbol())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolServiceGetBySymbol.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceGetBySymbol.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMSymbol, omSymboler).
+		Debug(object.URIEmpty)
+
+	price, err := service.price(ctx, omAlgoOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderBookServiceGetBestBidAsk.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderBookServiceGetBestBidAsk.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldPrice, price).
+		Debug(object.URIEmpty)
+
+	if omAlgoOrderer.GetChildClientOID() != object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omAlgoOrderer.GetChildClientOID() != object.URIEmpty`)
+
+		var resting bool
+
+		omAlgoOrderer, resting, err = service.settle(ctx, omAlgoOrderer, price, now)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrAlgoOrderServiceReconcile.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServiceReconcile.Error())
+
+			return nil, err
+		}
+
+		if resting {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`resting`)
+
+			return omAlgoOrderer, nil
+		}
+	}
+
+	size, reason, err := algoOrderServiceNext(omAlgoOrderer, omSymboler, now)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrDecimalParse.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrDecimalParse.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldSize, size).
+		WithField(object.URIFieldReason, reason).
+		Debug(object.URIEmpty)
+
+	if reason != object.AlgoOrderReasonType(object.URIEmpty) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`reason != object.AlgoOrderReasonType(object.URIEmpty)`)
+
+		return service.close(ctx, omAlgoOrderer, reason)
+	}
+
+	if size == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`size == object.URIEmpty`)
+
+		return omAlgoOrderer, nil
+	}
+
+	return service.place(ctx, omAlgoOrderer, price, size, now)
+}
+
+// Sync is a function.
+// Every stored active algo order is reconciled, even when an earlier one fails.
+func (service *algoOrderService) Sync(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Sync",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Sync",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omActiveAlgoOrderers := make([]om.AlgoOrderer, 0)
+	errs := make([]error, 0)
+
+	// Closed algo orders leave the active filter, so every page is read before
+	// any algo order is reconciled.
+	var daoCursorer dao.Cursorer = dao.NewCursor(0)
+
+	for daoCursorer != nil {
+		omAlgoOrderersPage, daoNextCursorer, errGetList := service.GetServicer().
+			GetAlgoOrderServicer().
+			GetListFromRepository(
+				ctx,
+				dao.NewPagination(daoCursorer, object.NUMKucoinRecentOrderCount),
+				dao.NewAlgoOrderFilter(
+					serviceAccount(ctx, service),
+					object.URIEmpty,
+					object.URIEmpty,
+					true,
+				),
+			)
+		if errGetList != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errGetList).
+				Error(object.ErrAlgoOrderRepositoryReadList.Error())
+			traceSpan.RecordError(errGetList)
+			traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryReadList.Error())
+
+			return errGetList
+		}
+
+		daoCursorer = daoNextCursorer
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMAlgoOrders, omAlgoOrderersPage).
+			WithField(object.URIFieldDAOCursor, daoCursorer).
+			Debug(object.URIEmpty)
+
+		omActiveAlgoOrderers = append(omActiveAlgoOrderers, omAlgoOrderersPage...)
+	}
+
+	for _, omAlgoOrderer := range omActiveAlgoOrderers {
+		omReconciledAlgoOrderer, errReconcile := service.GetServicer().
+			GetAlgoOrderServicer().
+			Reconcile(ctx, omAlgoOrderer)
+		if errReconcile != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errReconcile).
+				Error(object.ErrAlgoOrderServiceReconcile.Error())
+
+			errs = append(errs, errReconcile)
+
+			continue
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMAlgoOrder, omReconciledAlgoOrderer).
+			Debug(object.URIEmpty)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderServiceSync.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServiceSync.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// Update is a function.
+func (service *algoOrderService) Update(
+	ctx context.Context,
+	omAlgoOrderer om.AlgoOrderer,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Update",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "Update",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_algo_orderer": omAlgoOrderer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoAlgoOrderer, err := service.GetAlgoOrderRepositorier().Read(ctx, omAlgoOrderer.GetID())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryRead.Error())
+
+		return time.Time{}, err
+	}
+
+	daoAlgoOrder := dao.NewAlgoOrder(
+		daoAlgoOrderer.GetCreatedAt(),
+		time.Time{},
+		daoAlgoOrderer.GetDeletedAt(),
+		omAlgoOrderer.GetID(),
+		omAlgoOrderer.GetAccount(),
+		omAlgoOrderer.GetAlgoType(),
+		omAlgoOrderer.GetAveragePrice(),
+		omAlgoOrderer.GetChildClientOID(),
+		omAlgoOrderer.GetChildDealFunds(),
+		omAlgoOrderer.GetChildDealSize(),
+		omAlgoOrderer.GetClientOID(),
+		omAlgoOrderer.GetDealFunds(),
+		omAlgoOrderer.GetDealSize(),
+		omAlgoOrderer.GetLimitPrice(),
+		omAlgoOrderer.GetReason(),
+		omAlgoOrderer.GetSchedule(),
+		omAlgoOrderer.GetSide(),
+		omAlgoOrderer.GetSize(),
+		omAlgoOrderer.GetState(),
+		omAlgoOrderer.GetSymbol(),
+		omAlgoOrderer.GetVisibleSize(),
+		omAlgoOrderer.GetChildAt(),
+		omAlgoOrderer.GetChildCount(),
+		omAlgoOrderer.GetEndAt(),
+		omAlgoOrderer.GetSlices(),
+		omAlgoOrderer.GetStartAt(),
+		omAlgoOrderer.GetIsActive(),
+		daoAlgoOrderer.GetPaper(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOAlgoOrder, daoAlgoOrder).
+		Debug(object.URIEmpty)
+
+	updatedAt, err := service.GetAlgoOrderRepositorier().Update(ctx, daoAlgoOrder)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderRepositoryUpdate.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return updatedAt, nil
+}
+
+// cancelChild cancels the child while it rests and returns it as the exchange
+// reports it afterwards.
+func (service *algoOrderService) cancelChild(
+	ctx context.Context,
+	omOrderer om.Orderer,
+) (om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"cancelChild",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "cancelChild",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"om_orderer": omOrderer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	var err error
+
+	if omOrderer.GetIsActive() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omOrderer.GetIsActive()`)
+
+		if _, err = service.GetServicer().
+			GetOrderServicer().
+			Cancel(ctx, omOrderer.GetID()); errors.Is(err, object.ErrOrderRejected) {
+			err = nil
+		}
+	}
+
+	if err == nil {
+		omOrderer, err = service.GetServicer().GetOrderServicer().Reconcile(ctx, omOrderer)
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceCancel.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceCancel.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omOrderer).
+		Debug(object.URIEmpty)
+
+	return omOrderer, nil
+}
+
+// child returns the child as the exchange reports it, or nil when the exchange
+// never received it.
+func (service *algoOrderService) child(
+	ctx context.Context,
+	clientOID string,
+) (om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"child",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "child",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"client_oid": clientOID,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if clientOID == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`clientOID == object.URIEmpty`)
+
+		return nil, nil
+	}
+
+	omOrderer, err := service.GetServicer().GetOrderServicer().GetByClientOID(ctx, clientOID)
+	if err == nil {
+		omOrderer, err = service.GetServicer().GetOrderServicer().Reconcile(ctx, omOrderer)
+	}
+
+	if errors.Is(err, object.ErrOrderNotFound) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(err, object.ErrOrderNotFound)`)
+
+		return nil, nil
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceReconcile.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceReconcile.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omOrderer).
+		Debug(object.URIEmpty)
+
+	return omOrderer, nil
+}
+
+func (service *algoOrderService) close(
+	ctx context.Context,
+	omAlgoOrderer om.AlgoOrderer,
+	reason object.AlgoOrderReasonType,
+) (om.AlgoOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"close",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "close",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_algo_orderer": omAlgoOrderer,
+		"reason":          reason,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omAlgoOrderer = algoOrderServiceAlgoOrderWithState(
+		omAlgoOrderer,
+		object.AlgoOrderStateTypeClosed,
+		reason,
+		false,
+	)
+
+	updatedAt, err := service.GetServicer().GetAlgoOrderServicer().Update(ctx, omAlgoOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServiceUpdate.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return omAlgoOrderer, nil
+}
+
+// deal adds what the child filled since it was last seen to the fill progress
+// of the algo order, and stores it when it changed.
+func (service *algoOrderService) deal(
+	ctx context.Context,
+	omAlgoOrderer om.AlgoOrderer,
+	omOrderer om.Orderer,
+) (om.AlgoOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"deal",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "deal",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_algo_orderer": omAlgoOrderer,
+		"om_orderer":      omOrderer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	childDealFunds := algoOrderServiceDecimal(omOrderer.GetDealFunds())
+	childDealSize := algoOrderServiceDecimal(omOrderer.GetDealSize())
+
+	unchanged := childDealFunds == omAlgoOrderer.GetChildDealFunds() &&
+		childDealSize == omAlgoOrderer.GetChildDealSize()
+	if unchanged {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`unchanged`)
+
+		return omAlgoOrderer, nil
+	}
+
+	omSymboler, err := service.GetServicer().
+		GetSymbolServicer().
+		GetBySymbol(ctx, omAlgoOrderer.GetSymbol())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolServiceGetBySymbol.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceGetBySymbol.Error())
+
+		return nil, err
+	}
+
+	omAlgoOrderer, err = algoOrderServiceAlgoOrderWithDeal(
+		omAlgoOrderer,
+		childDealFunds,
+		childDealSize,
+		omSymboler.GetPriceIncrement(),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrDecimalParse.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrDecimalParse.Error())
+
+		return nil, err
+	}
+
+	updatedAt, err := service.GetServicer().GetAlgoOrderServicer().Update(ctx, omAlgoOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServiceUpdate.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return omAlgoOrderer, nil
+}
+
+// place stores the clientOid of the next child before placing it, so a restart
+// resumes the same child. A child the exchange never received keeps its
+// clientOid and what it is known to have filled.
+func (service *algoOrderService) place(
+	ctx context.Context,
+	omAlgoOrderer om.AlgoOrderer,
+	price string,
+	size string,
+	now int64,
+) (om.AlgoOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"place",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "place",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_algo_orderer": omAlgoOrderer,
+		"price":           price,
+		"size":            size,
+		"now":             now,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if omAlgoOrderer.GetChildClientOID() == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omAlgoOrderer.GetChildClientOID() == object.URIEmpty`)
+
+		omAlgoOrderer = algoOrderServiceAlgoOrderWithChild(
+			omAlgoOrderer,
+			algoOrderServiceClientOID(omAlgoOrderer.GetClientOID(), omAlgoOrderer.GetChildCount()),
+			"0",
+			"0",
+			now,
+			omAlgoOrderer.GetChildCount()+1,
+		)
+	} else {
+		omAlgoOrderer = algoOrderServiceAlgoOrderWithChild(
+			omAlgoOrderer,
+			omAlgoOrderer.GetChildClientOID(),
+			omAlgoOrderer.GetChildDealFunds(),
+			omAlgoOrderer.GetChildDealSize(),
+			now,
+			omAlgoOrderer.GetChildCount(),
+		)
+	}
+
+	updatedAt, err := service.GetServicer().GetAlgoOrderServicer().Update(ctx, omAlgoOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServiceUpdate.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	omOrderers, err := service.GetServicer().
+		GetOrderServicer().
+		Place(ctx, algoOrderServiceChildRequest(omAlgoOrderer, price, size))
+	rejected := errors.Is(err, object.ErrOrderRejected) ||
+		errors.Is(err, object.ErrOrderSizeBelowMinimum)
+	if rejected {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Debug(`rejected`)
+
+		return service.close(ctx, omAlgoOrderer, object.AlgoOrderReasonTypeRejected)
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServicePlace.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServicePlace.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrders, omOrderers).
+		Debug(object.URIEmpty)
+
+	return omAlgoOrderer, nil
+}
+
+// price returns the best price on the side of an iceberg, capped by its limit
+// price, and the limit price of the other algorithms.
+func (service *algoOrderService) price(
+	ctx context.Context,
+	omAlgoOrderer om.AlgoOrderer,
+) (string, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"price",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "price",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_algo_orderer": omAlgoOrderer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if object.AlgoOrderTypeType(omAlgoOrderer.GetAlgoType()) != object.AlgoOrderTypeTypeIceberg {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`object.AlgoOrderTypeType(omAlgoOrderer.GetAlgoType()) != object.AlgoOrderTypeTypeIceberg`)
+
+		return omAlgoOrderer.GetLimitPrice(), nil
+	}
+
+	bid, ask, err := service.GetServicer().
+		GetOrderBookServicer().
+		GetBestBidAsk(ctx, omAlgoOrderer.GetSymbol())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderBookServiceGetBestBidAsk.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderBookServiceGetBestBidAsk.Error())
+
+		return object.URIEmpty, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldBidsValue, bid).
+		WithField(object.URIFieldAsksValue, ask).
+		Debug(object.URIEmpty)
+
+	side := object.OrderSideType(omAlgoOrderer.GetSide())
+
+	price := bid[0]
+	if side == object.OrderSideTypeSell {
+		price = ask[0]
+	}
+
+	if omAlgoOrderer.GetLimitPrice() == object.URIEmpty {
+		return price, nil
+	}
+
+	improved, err := bracketServiceImproved(side, price, omAlgoOrderer.GetLimitPrice())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrDecimalParse.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrDecimalParse.Error())
+
+		return object.URIEmpty, err
+	}
+
+	// A buy never pays more, and a sell never takes less, than the limit price.
+	if improved {
+		return omAlgoOrderer.GetLimitPrice(), nil
+	}
+
+	return price, nil
+}
+
+// profile returns the volume the symbol traded in each slice of the schedule,
+// summed over the same time of day on every day of the lookback.
+func (service *algoOrderService) profile(
+	ctx context.Context,
+	dtoPlaceAlgoOrderRequester dto.PlaceAlgoOrderRequester,
+) ([]string, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"profile",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                           "profile",
+		"rt_ctx":                         utilRuntimeContext,
+		"sp_ctx":                         utilSpanContext,
+		"config":                         service.configConfigger,
+		"dto_place_algo_order_requester": dtoPlaceAlgoOrderRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	days := int64(service.GetConfigger().GetAlgoConfigger().GetVWAPLookback() / (24 * time.Hour))
+	if days < 1 {
+		days = 1
+	}
+
+	slices := dtoPlaceAlgoOrderRequester.GetSlices()
+	duration := algoOrderServiceSliceDuration(
+		dtoPlaceAlgoOrderRequester.GetStartAt(),
+		dtoPlaceAlgoOrderRequester.GetEndAt(),
+		slices,
+	)
+
+	weights := make([]string, slices)
+	for key := range weights {
+		weights[key] = "0"
+	}
+
+	for day := int64(1); day <= days; day++ {
+		startAt := dtoPlaceAlgoOrderRequester.GetStartAt() - day*object.NUM1DayToSecond
+
+		omKliners, err := service.GetServicer().
+			GetKlineServicer().
+			GetResampledListFromRepository(ctx, dto.NewKlineRequest(
+				object.KlineTypeType1min,
+				dtoPlaceAlgoOrderRequester.GetSymbol(),
+				dtoPlaceAlgoOrderRequester.GetEndAt()-day*object.NUM1DayToSecond,
+				startAt,
+			))
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrKlineRepositoryReadList.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrKlineRepositoryReadList.Error())
+
+			return nil, err
+		}
+
+		for _, omKliner := range omKliners {
+			key := (omKliner.GetStartAt() - startAt) / duration
+			if key < 0 || key >= slices {
+				continue
+			}
+
+			weights[key], err = util.DecimalAdd(weights[key], omKliner.GetVolume())
+			if err != nil {
+				service.GetRuntimeLogger().
+					WithFields(fields).
+					WithField(object.URIFieldError, err).
+					Error(object.ErrDecimalParse.Error())
+				traceSpan.RecordError(err)
+				traceSpan.SetStatus(codes.Error, object.ErrDecimalParse.Error())
+
+				return nil, err
+			}
+		}
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldWeights, weights).
+		Debug(object.URIEmpty)
+
+	return weights, nil
+}
+
+// schedule returns the size every slice should have filled by its end, rounded
+// to the base increment of the symbol; the last slice fills the whole size.
+// Iceberg orders have no schedule, and VWAP falls back to TWAP without volume.
+func (service *algoOrderService) schedule(
+	ctx context.Context,
+	dtoPlaceAlgoOrderRequester dto.PlaceAlgoOrderRequester,
+) (string, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"schedule",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                           "schedule",
+		"rt_ctx":                         utilRuntimeContext,
+		"sp_ctx":                         utilSpanContext,
+		"config":                         service.configConfigger,
+		"dto_place_algo_order_requester": dtoPlaceAlgoOrderRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	algoType := dtoPlaceAlgoOrderRequester.GetAlgoType()
+	if algoType == object.AlgoOrderTypeTypeIceberg {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`algoType == object.AlgoOrderTypeTypeIceberg`)
+
+		return object.URIEmpty, nil
+	}
+
+	omSymboler, err := service.GetServicer().
+		GetSymbolServicer().
+		GetBySymbol(ctx, dtoPlaceAlgoOrderRequester.GetSymbol())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolServiceGetBySymbol.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceGetBySymbol.Error())
+
+		return object.URIEmpty, err
+	}
+
+	weights := make([]string, dtoPlaceAlgoOrderRequester.GetSlices())
+	for key := range weights {
+		weights[key] = "1"
+	}
+
+	if algoType == object.AlgoOrderTypeTypeVWAP {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`algoType == object.AlgoOrderTypeTypeVWAP`)
+
+		var volumes []string
+
+		volumes, err = service.profile(ctx, dtoPlaceAlgoOrderRequester)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrAlgoOrderServicePlace.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServicePlace.Error())
+
+			return object.URIEmpty, err
+		}
+
+		if algoOrderServiceTraded(volumes) {
+			weights = volumes
+		}
+	}
+
+	schedule, err := algoOrderServiceSchedule(
+		dtoPlaceAlgoOrderRequester.GetSize(),
+		omSymboler.GetBaseIncrement(),
+		weights,
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrDecimalParse.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrDecimalParse.Error())
+
+		return object.URIEmpty, err
+	}
+
+	return schedule, nil
+}
+
+// settle brings the algo order up to date with its child and reports whether
+// the child still rests. A child that outlived its slice, or an iceberg child
+// that left the price, is cancelled; once no child rests, the next one can be
+// placed.
+func (service *algoOrderService) settle(
+	ctx context.Context,
+	omAlgoOrderer om.AlgoOrderer,
+	price string,
+	now int64,
+) (om.AlgoOrderer, bool, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"settle",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "settle",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_algo_orderer": omAlgoOrderer,
+		"price":           price,
+		"now":             now,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omOrderer, err := service.child(ctx, omAlgoOrderer.GetChildClientOID())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceReconcile.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceReconcile.Error())
+
+		return nil, false, err
+	}
+
+	if omOrderer == nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omOrderer == nil`)
+
+		return omAlgoOrderer, false, nil
+	}
+
+	if omOrderer.GetIsActive() && algoOrderServiceStale(omAlgoOrderer, omOrderer, price, now) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omOrderer.GetIsActive() && algoOrderServiceStale(omAlgoOrderer, omOrderer, price, now)`)
+
+		omOrderer, err = service.cancelChild(ctx, omOrderer)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrOrderServiceCancel.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrOrderServiceCancel.Error())
+
+			return nil, false, err
+		}
+	}
+
+	omAlgoOrderer, err = service.deal(ctx, omAlgoOrderer, omOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServiceUpdate.Error())
+
+		return nil, false, err
+	}
+
+	if omOrderer.GetIsActive() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omOrderer.GetIsActive()`)
+
+		return omAlgoOrderer, true, nil
+	}
+
+	omAlgoOrderer = algoOrderServiceAlgoOrderWithChild(
+		omAlgoOrderer,
+		object.URIEmpty,
+		"0",
+		"0",
+		omAlgoOrderer.GetChildAt(),
+		omAlgoOrderer.GetChildCount(),
+	)
+
+	updatedAt, err := service.GetServicer().GetAlgoOrderServicer().Update(ctx, omAlgoOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrAlgoOrderServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrAlgoOrderServiceUpdate.Error())
+
+		return nil, false, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return omAlgoOrderer, false, nil
+}
+
+func algoOrderServiceAlgoOrderFromPlaceAlgoOrderRequest(
+	account string,
+	dtoPlaceAlgoOrderRequester dto.PlaceAlgoOrderRequester,
+	schedule string,
+	paper bool,
+	id uuid.UUID,
+) om.AlgoOrderer {
+	return om.NewAlgoOrder(
+		account,
+		string(dtoPlaceAlgoOrderRequester.GetAlgoType()),
+		object.URIEmpty,
+		object.URIEmpty,
+		"0",
+		"0",
+		dtoPlaceAlgoOrderRequester.GetClientOID(),
+		"0",
+		"0",
+		dtoPlaceAlgoOrderRequester.GetLimitPrice(),
+		object.URIEmpty,
+		schedule,
+		string(dtoPlaceAlgoOrderRequester.GetSide()),
+		dtoPlaceAlgoOrderRequester.GetSize(),
+		string(object.AlgoOrderStateTypeWorking),
+		dtoPlaceAlgoOrderRequester.GetSymbol(),
+		dtoPlaceAlgoOrderRequester.GetVisibleSize(),
+		0,
+		0,
+		dtoPlaceAlgoOrderRequester.GetEndAt(),
+		dtoPlaceAlgoOrderRequester.GetSlices(),
+		dtoPlaceAlgoOrderRequester.GetStartAt(),
+		true,
+		paper,
+		id,
+	)
+}
+
+func algoOrderServiceAlgoOrderWithChild(
+	omAlgoOrderer om.AlgoOrderer,
+	childClientOID string,
+	childDealFunds string,
+	childDealSize string,
+	childAt int64,
+	childCount int64,
+) om.AlgoOrderer {
+	return om.NewAlgoOrder(
+		omAlgoOrderer.GetAccount(),
+		omAlgoOrderer.GetAlgoType(),
+		omAlgoOrderer.GetAveragePrice(),
+		childClientOID,
+		childDealFunds,
+		childDealSize,
+		omAlgoOrderer.GetClientOID(),
+		omAlgoOrderer.GetDealFunds(),
+		omAlgoOrderer.GetDealSize(),
+		omAlgoOrderer.GetLimitPrice(),
+		omAlgoOrderer.GetReason(),
+		omAlgoOrderer.GetSchedule(),
+		omAlgoOrderer.GetSide(),
+		omAlgoOrderer.GetSize(),
+		omAlgoOrderer.GetState(),
+		omAlgoOrderer.GetSymbol(),
+		omAlgoOrderer.GetVisibleSize(),
+		childAt,
+		childCount,
+		omAlgoOrderer.GetEndAt(),
+		omAlgoOrderer.GetSlices(),
+		omAlgoOrderer.GetStartAt(),
+		omAlgoOrderer.GetIsActive(),
+		omAlgoOrderer.GetPaper(),
+		omAlgoOrderer.GetID(),
+	)
+}
+
+// algoOrderServiceAlgoOrderWithDeal replaces what the child was last seen to
+// fill with what it filled now, in the fill progress and the average price.
+func algoOrderServiceAlgoOrderWithDeal(
+	omAlgoOrderer om.AlgoOrderer,
+	childDealFunds string,
+	childDealSize string,
+	priceIncrement string,
+) (om.AlgoOrderer, error) {
+	dealFunds, err := util.DecimalSubtract(
+		omAlgoOrderer.GetDealFunds(),
+		omAlgoOrderer.GetChildDealFunds(),
+	)
+	if err == nil {
+		dealFunds, err = util.DecimalAdd(dealFunds, childDealFunds)
+	}
+
+	var dealSize string
+
+	if err == nil {
+		dealSize, err = util.DecimalSubtract(
+			omAlgoOrderer.GetDealSize(),
+			omAlgoOrderer.GetChildDealSize(),
+		)
+	}
+
+	if err == nil {
+		dealSize, err = util.DecimalAdd(dealSize, childDealSize)
+	}
+
+	averagePrice := object.URIEmpty
+
+	if err == nil && bracketServicePositive(dealSize) {
+		averagePrice, err = util.DecimalDivide(dealFunds, dealSize, priceIncrement)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return om.NewAlgoOrder(
+		omAlgoOrderer.GetAccount(),
+		omAlgoOrderer.GetAlgoType(),
+		averagePrice,
+		omAlgoOrderer.GetChildClientOID(),
+		childDealFunds,
+		childDealSize,
+		omAlgoOrderer.GetClientOID(),
+		dealFunds,
+		dealSize,
+		omAlgoOrderer.GetLimitPrice(),
+		omAlgoOrderer.GetReason(),
+		omAlgoOrderer.GetSchedule(),
+		omAlgoOrderer.GetSide(),
+		omAlgoOrderer.GetSize(),
+		omAlgoOrderer.GetState(),
+		omAlgoOrderer.GetSymbol(),
+		omAlgoOrderer.GetVisibleSize(),
+		omAlgoOrderer.GetChildAt(),
+		omAlgoOrderer.GetChildCount(),
+		omAlgoOrderer.GetEndAt(),
+		omAlgoOrderer.GetSlices(),
+		omAlgoOrderer.GetStartAt(),
+		omAlgoOrderer.GetIsActive(),
+		omAlgoOrderer.GetPaper(),
+		omAlgoOrderer.GetID(),
+	), nil
+}
+
+func algoOrderServiceAlgoOrderWithState(
+	omAlgoOrderer om.AlgoOrderer,
+	state object.AlgoOrderStateType,
+	reason object.AlgoOrderReasonType,
+	isActive bool,
+) om.AlgoOrderer {
+	return om.NewAlgoOrder(
+		omAlgoOrderer.GetAccount(),
+		omAlgoOrderer.GetAlgoType(),
+		omAlgoOrderer.GetAveragePrice(),
+		omAlgoOrderer.GetChildClientOID(),
+		omAlgoOrderer.GetChildDealFunds(),
+		omAlgoOrderer.GetChildDealSize(),
+		omAlgoOrderer.GetClientOID(),
+		omAlgoOrderer.GetDealFunds(),
+		omAlgoOrderer.GetDealSize(),
+		omAlgoOrderer.GetLimitPrice(),
+		string(reason),
+		omAlgoOrderer.GetSchedule(),
+		omAlgoOrderer.GetSide(),
+		omAlgoOrderer.GetSize(),
+		string(state),
+		omAlgoOrderer.GetSymbol(),
+		omAlgoOrderer.GetVisibleSize(),
+		omAlgoOrderer.GetChildAt(),
+		omAlgoOrderer.GetChildCount(),
+		omAlgoOrderer.GetEndAt(),
+		omAlgoOrderer.GetSlices(),
+		omAlgoOrderer.GetStartAt(),
+		isActive,
+		omAlgoOrderer.GetPaper(),
+		omAlgoOrderer.GetID(),
+	)
+}
+
+// algoOrderServiceCheck requires a positive size, a visible size for iceberg
+// orders, and a window split into at least one slice for TWAP and VWAP orders.
+func algoOrderServiceCheck(
+	dtoPlaceAlgoOrderRequester dto.PlaceAlgoOrderRequester,
+) error {
+	if !bracketServicePositive(dtoPlaceAlgoOrderRequester.GetSize()) {
+		return fmt.Errorf(
+			"%w: size %s",
+			object.ErrAlgoOrderInvalid,
+			dtoPlaceAlgoOrderRequester.GetSize(),
+		)
+	}
+
+	switch dtoPlaceAlgoOrderRequester.GetAlgoType() {
+	case object.AlgoOrderTypeTypeIceberg:
+		if !bracketServicePositive(dtoPlaceAlgoOrderRequester.GetVisibleSize()) {
+			return fmt.Errorf(
+				"%w: visible size %s",
+				object.ErrAlgoOrderInvalid,
+				dtoPlaceAlgoOrderRequester.GetVisibleSize(),
+			)
+		}
+	case object.AlgoOrderTypeTypeTWAP, object.AlgoOrderTypeTypeVWAP:
+		if dtoPlaceAlgoOrderRequester.GetSlices() < 1 ||
+			dtoPlaceAlgoOrderRequester.GetEndAt()-dtoPlaceAlgoOrderRequester.GetStartAt() <
+				dtoPlaceAlgoOrderRequester.GetSlices() {
+			return fmt.Errorf(
+				"%w: %d slices from %d to %d",
+				object.ErrAlgoOrderInvalid,
+				dtoPlaceAlgoOrderRequester.GetSlices(),
+				dtoPlaceAlgoOrderRequester.GetStartAt(),
+				dtoPlaceAlgoOrderRequester.GetEndAt(),
+			)
+		}
+	default:
+		return fmt.Errorf(
+			"%w: algo type %s",
+			object.ErrAlgoOrderInvalid,
+			dtoPlaceAlgoOrderRequester.GetAlgoType(),
+		)
+	}
+
+	return nil
+}
+
+// algoOrderServiceChildRequest builds a limit child at the price, or a market
+// child without one. Its remark is the clientOid of the algo order, which links
+// the child to it in the order repository.
+func algoOrderServiceChildRequest(
+	omAlgoOrderer om.AlgoOrderer,
+	price string,
+	size string,
+) dto.PlaceOrderRequester {
+	orderType := object.OrderTypeTypeMarket
+	timeInForce := object.TimeInForceType(object.URIEmpty)
+
+	if price != object.URIEmpty {
+		orderType = object.OrderTypeTypeLimit
+		timeInForce = object.TimeInForceTypeGTC
+	}
+
+	return dto.NewPlaceOrderRequest(
+		omAlgoOrderer.GetChildClientOID(),
+		object.URIEmpty,
+		orderType,
+		price,
+		omAlgoOrderer.GetClientOID(),
+		object.OrderSideType(omAlgoOrderer.GetSide()),
+		size,
+		object.URIEmpty,
+		omAlgoOrderer.GetSymbol(),
+		timeInForce,
+		object.OrderTypeTypeTrade,
+		object.URIEmpty,
+		0,
+		false,
+		false,
+		false,
+	)
+}
+
+// algoOrderServiceClientOID derives the clientOid of a child from the clientOid
+// of the algo order and the number of children before it.
+func algoOrderServiceClientOID(
+	clientOID string,
+	childCount int64,
+) string {
+	return util.ClientOID(clientOID, strconv.FormatInt(childCount, 10))
+}
+
+func algoOrderServiceDecimal(
+	value string,
+) string {
+	if value == object.URIEmpty {
+		return "0"
+	}
+
+	return value
+}
+
+// algoOrderServiceNext returns the size of the next child, nothing while the
+// algo order waits, or the reason to close it. A TWAP or VWAP child catches up
+// with the schedule of the current slice; an iceberg child shows at most the
+// visible size. Sizes are rounded down to the base increment, and an algo order
+// whose remainder is below the minimum size is filled.
+func algoOrderServiceNext(
+	omAlgoOrderer om.AlgoOrderer,
+	omSymboler om.Symboler,
+	now int64,
+) (string, object.AlgoOrderReasonType, error) {
+	wait := object.AlgoOrderReasonType(object.URIEmpty)
+
+	remaining, err := util.DecimalSubtract(omAlgoOrderer.GetSize(), omAlgoOrderer.GetDealSize())
+	if err == nil {
+		remaining, err = symbolServiceRound(remaining, omSymboler.GetBaseIncrement(), false)
+	}
+
+	var below bool
+
+	if err == nil {
+		below, err = symbolServiceBelow(remaining, omSymboler.GetBaseMinSize(), false)
+	}
+
+	if err != nil {
+		return object.URIEmpty, wait, err
+	}
+
+	if below || !bracketServicePositive(remaining) {
+		return object.URIEmpty, object.AlgoOrderReasonTypeFilled, nil
+	}
+
+	if object.AlgoOrderTypeType(omAlgoOrderer.GetAlgoType()) == object.AlgoOrderTypeTypeIceberg {
+		if omAlgoOrderer.GetEndAt() > 0 && now >= omAlgoOrderer.GetEndAt() {
+			return object.URIEmpty, object.AlgoOrderReasonTypeExpired, nil
+		}
+
+		visibleSize, errRound := symbolServiceRound(
+			omAlgoOrderer.GetVisibleSize(),
+			omSymboler.GetBaseIncrement(),
+			false,
+		)
+		if errRound != nil {
+			return object.URIEmpty, wait, errRound
+		}
+
+		compare, errCompare := util.DecimalCompare(visibleSize, remaining)
+		if errCompare != nil {
+			return object.URIEmpty, wait, errCompare
+		}
+
+		if compare < 0 {
+			return visibleSize, wait, nil
+		}
+
+		return remaining, wait, nil
+	}
+
+	if now < omAlgoOrderer.GetStartAt() {
+		return object.URIEmpty, wait, nil
+	}
+
+	duration := algoOrderServiceSliceDuration(
+		omAlgoOrderer.GetStartAt(),
+		omAlgoOrderer.GetEndAt(),
+		omAlgoOrderer.GetSlices(),
+	)
+	last := omAlgoOrderer.GetSlices() - 1
+
+	// The child of the last slice had its chance once the window is over.
+	if now >= omAlgoOrderer.GetEndAt() &&
+		omAlgoOrderer.GetChildAt() >= omAlgoOrderer.GetStartAt()+last*duration {
+		return object.URIEmpty, object.AlgoOrderReasonTypeExpired, nil
+	}
+
+	key := (now - omAlgoOrderer.GetStartAt()) / duration
+	if key > last {
+		key = last
+	}
+
+	schedule := strings.Split(omAlgoOrderer.GetSchedule(), object.URIAlgoOrderScheduleSeparator)
+	if int64(len(schedule)) != omAlgoOrderer.GetSlices() {
+		return object.URIEmpty, wait, object.ErrAlgoOrderInvalid
+	}
+
+	size, err := util.DecimalSubtract(schedule[key], omAlgoOrderer.GetDealSize())
+	if err == nil {
+		size, err = symbolServiceRound(size, omSymboler.GetBaseIncrement(), false)
+	}
+
+	if err == nil {
+		below, err = symbolServiceBelow(size, omSymboler.GetBaseMinSize(), false)
+	}
+
+	if err != nil {
+		return object.URIEmpty, wait, err
+	}
+
+	if below || !bracketServicePositive(size) {
+		return object.URIEmpty, wait, nil
+	}
+
+	return size, wait, nil
+}
+
+// algoOrderServicePlaceAlgoOrderRequestWithClientOID derives the clientOid from
+// the request, so placing the same algo order twice is recognised as a retry.
+func algoOrderServicePlaceAlgoOrderRequestWithClientOID(
+	dtoPlaceAlgoOrderRequester dto.PlaceAlgoOrderRequester,
+) dto.PlaceAlgoOrderRequester {
+	return dto.NewPlaceAlgoOrderRequest(
+		dtoPlaceAlgoOrderRequester.GetAlgoType(),
+		util.ClientOID(
+			string(dtoPlaceAlgoOrderRequester.GetAlgoType()),
+			dtoPlaceAlgoOrderRequester.GetSymbol(),
+			string(dtoPlaceAlgoOrderRequester.GetSide()),
+			dtoPlaceAlgoOrderRequester.GetSize(),
+			dtoPlaceAlgoOrderRequester.GetLimitPrice(),
+			dtoPlaceAlgoOrderRequester.GetVisibleSize(),
+			strconv.FormatInt(dtoPlaceAlgoOrderRequester.GetStartAt(), 10),
+			strconv.FormatInt(dtoPlaceAlgoOrderRequester.GetEndAt(), 10),
+			strconv.FormatInt(dtoPlaceAlgoOrderRequester.GetSlices(), 10),
+		),
+		dtoPlaceAlgoOrderRequester.GetLimitPrice(),
+		dtoPlaceAlgoOrderRequester.GetSide(),
+		dtoPlaceAlgoOrderRequester.GetSize(),
+		dtoPlaceAlgoOrderRequester.GetSymbol(),
+		dtoPlaceAlgoOrderRequester.GetVisibleSize(),
+		dtoPlaceAlgoOrderRequester.GetEndAt(),
+		dtoPlaceAlgoOrderRequester.GetSlices(),
+		dtoPlaceAlgoOrderRequester.GetStartAt(),
+	)
+}
+
+// algoOrderServiceSchedule returns the cumulative size due by the end of every
+// slice, in proportion to its weight.
+func algoOrderServiceSchedule(
+	size string,
+	baseIncrement string,
+	weights []string,
+) (string, error) {
+	total := "0"
+
+	for _, weight := range weights {
+		var err error
+
+		total, err = util.DecimalAdd(total, weight)
+		if err != nil {
+			return object.URIEmpty, err
+		}
+	}
+
+	schedule := make([]string, len(weights))
+	cumulative := "0"
+
+	for key, weight := range weights {
+		if key == len(weights)-1 {
+			schedule[key] = size
+
+			break
+		}
+
+		var err error
+
+		cumulative, err = util.DecimalAdd(cumulative, weight)
+		if err != nil {
+			return object.URIEmpty, err
+		}
+
+		due, err := util.DecimalMultiply(size, cumulative)
+		if err == nil {
+			due, err = util.DecimalDivide(due, total, baseIncrement)
+		}
+
+		if err == nil {
+			due, err = symbolServiceRound(due, baseIncrement, false)
+		}
+
+		if err != nil {
+			return object.URIEmpty, err
+		}
+
+		schedule[key] = due
+	}
+
+	return strings.Join(schedule, object.URIAlgoOrderScheduleSeparator), nil
+}
+
+func algoOrderServiceSliceDuration(
+	startAt int64,
+	endAt int64,
+	slices int64,
+) int64 {
+	if slices < 1 || endAt-startAt < slices {
+		return 1
+	}
+
+	return (endAt - startAt) / slices
+}
+
+// algoOrderServiceStale reports whether a resting child should make way for the
+// next one: a TWAP or VWAP child once its slice is over, an iceberg child once
+// the best price moved away from it or the window is over.
+func algoOrderServiceStale(
+	omAlgoOrderer om.AlgoOrderer,
+	omOrderer om.Orderer,
+	price string,
+	now int64,
+) bool {
+	if object.AlgoOrderTypeType(omAlgoOrderer.GetAlgoType()) == object.AlgoOrderTypeTypeIceberg {
+		if omAlgoOrderer.GetEndAt() > 0 && now >= omAlgoOrderer.GetEndAt() {
+			return true
+		}
+
+		compare, err := util.DecimalCompare(omOrderer.GetPrice(), price)
+
+		return err == nil && compare != 0
+	}
+
+	return now >= omAlgoOrderer.GetChildAt()+algoOrderServiceSliceDuration(
+		omAlgoOrderer.GetStartAt(),
+		omAlgoOrderer.GetEndAt(),
+		omAlgoOrderer.GetSlices(),
+	)
+}
+
+func algoOrderServiceTraded(
+	volumes []string,
+) bool {
+	for _, volume := range volumes {
+		if bracketServicePositive(volume) {
+			return true
+		}
+	}
+
+	return false
+}
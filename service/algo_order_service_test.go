@@ -0,0 +1,802 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type (
+	algoOrderServiceTestServicer struct {
+		Servicer
+		algoOrderServicer AlgoOrderServicer
+		orderBookServicer OrderBookServicer
+		orderServicer     OrderServicer
+		symbolServicer    SymbolServicer
+	}
+
+	algoOrderServiceTestAlgoOrderServicer struct {
+		AlgoOrderServicer
+		omAlgoOrderers []om.AlgoOrderer
+		mutex          sync.Mutex
+	}
+
+	algoOrderServiceTestOrderBookServicer struct {
+		OrderBookServicer
+		bid string
+		ask string
+	}
+)
+
+// GetAlgoOrderServicer is a function.
+func (servicer *algoOrderServiceTestServicer) GetAlgoOrderServicer() AlgoOrderServicer {
+	return servicer.algoOrderServicer
+}
+
+// GetOrderBookServicer is a function.
+func (servicer *algoOrderServiceTestServicer) GetOrderBookServicer() OrderBookServicer {
+	return servicer.orderBookServicer
+}
+
+// GetOrderServicer is a function.
+func (servicer *algoOrderServiceTestServicer) GetOrderServicer() OrderServicer {
+	return servicer.orderServicer
+}
+
+// GetSymbolServicer is a function.
+func (servicer *algoOrderServiceTestServicer) GetSymbolServicer() SymbolServicer {
+	return servicer.symbolServicer
+}
+
+// Update is a function.
+func (servicer *algoOrderServiceTestAlgoOrderServicer) Update(
+	_ context.Context,
+	omAlgoOrderer om.AlgoOrderer,
+) (time.Time, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	servicer.omAlgoOrderers = append(servicer.omAlgoOrderers, omAlgoOrderer)
+
+	return time.Time{}, nil
+}
+
+// GetBestBidAsk is a function.
+func (servicer *algoOrderServiceTestOrderBookServicer) GetBestBidAsk(
+	_ context.Context,
+	_ string,
+) ([]string, []string, error) {
+	return []string{servicer.bid, "1"}, []string{servicer.ask, "1"}, nil
+}
+
+func newAlgoOrderServiceTest(
+	bid string,
+	ask string,
+) (
+	*algoOrderServiceTestAlgoOrderServicer,
+	*bracketServiceTestOrderServicer,
+) {
+	configConfigger := config.NewConfig(
+		config.WithKucoinConfigger(),
+		config.WithLogConfigger(),
+		config.WithPaperConfigger(),
+	)
+
+	algoOrderServicer := NewAlgoOrderServicer(
+		configConfigger,
+		nil,
+		log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+		trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+		util.NewUUID(),
+		nil,
+	)
+
+	testAlgoOrderServicer := &algoOrderServiceTestAlgoOrderServicer{
+		AlgoOrderServicer: algoOrderServicer,
+		omAlgoOrderers:    []om.AlgoOrderer{},
+		mutex:             sync.Mutex{},
+	}
+
+	testOrderServicer := &bracketServiceTestOrderServicer{
+		OrderServicer:     nil,
+		ids:               map[string]uuid.UUID{},
+		kucoinOrderModels: map[string]*kucoin.OrderModel{},
+		mutex:             sync.Mutex{},
+	}
+
+	algoOrderServicer.(WithServicer).WithServicer(&algoOrderServiceTestServicer{
+		Servicer:          nil,
+		algoOrderServicer: testAlgoOrderServicer,
+		orderBookServicer: &algoOrderServiceTestOrderBookServicer{
+			OrderBookServicer: nil,
+			bid:               bid,
+			ask:               ask,
+		},
+		orderServicer:  testOrderServicer,
+		symbolServicer: &bracketServiceTestSymbolServicer{SymbolServicer: nil},
+	})
+
+	return testAlgoOrderServicer, testOrderServicer
+}
+
+// newAlgoOrderServiceTestAlgoOrder is a buy of one BTC-USDT limited at 105.
+func newAlgoOrderServiceTestAlgoOrder(
+	algoType object.AlgoOrderTypeType,
+	schedule string,
+	childClientOID string,
+	childDealSize string,
+	dealSize string,
+	childAt int64,
+	startAt int64,
+	endAt int64,
+) om.AlgoOrderer {
+	childCount := int64(0)
+	if childClientOID != object.URIEmpty {
+		childCount = 1
+	}
+
+	slices := int64(0)
+	if schedule != object.URIEmpty {
+		slices = 3
+	}
+
+	childDealFunds, _ := util.DecimalMultiply(childDealSize, "99")
+	dealFunds, _ := util.DecimalMultiply(dealSize, "99")
+
+	return om.NewAlgoOrder(
+		object.URIEmpty,
+		string(algoType),
+		object.URIEmpty,
+		childClientOID,
+		childDealFunds,
+		childDealSize,
+		"algo",
+		dealFunds,
+		dealSize,
+		"105",
+		object.URIEmpty,
+		schedule,
+		string(object.OrderSideTypeBuy),
+		"1",
+		string(object.AlgoOrderStateTypeWorking),
+		"BTC-USDT",
+		"0.25",
+		childAt,
+		childCount,
+		endAt,
+		slices,
+		startAt,
+		true,
+		false,
+		uuid.Nil,
+	)
+}
+
+func algoOrderServiceTestEqual(
+	first string,
+	second string,
+) bool {
+	compare, err := util.DecimalCompare(first, second)
+
+	return err == nil && compare == 0
+}
+
+func TestAlgoOrderServiceSchedule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		size    string
+		weights []string
+		want    string
+	}{
+		{
+			name:    "even slices",
+			size:    "1",
+			weights: []string{"1", "1", "1"},
+			want:    "0.3333,0.6667,1",
+		},
+		{
+			name:    "weighted slices",
+			size:    "2",
+			weights: []string{"1", "2", "1"},
+			want:    "0.5000,1.5000,2",
+		},
+		{
+			name:    "one slice",
+			size:    "0.5",
+			weights: []string{"3"},
+			want:    "0.5",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := algoOrderServiceSchedule(test.size, "0.0001", test.weights)
+			if err != nil {
+				t.Fatalf("algoOrderServiceSchedule() error = %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("algoOrderServiceSchedule() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAlgoOrderServiceCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		algoType    object.AlgoOrderTypeType
+		size        string
+		visibleSize string
+		slices      int64
+		wantErr     error
+	}{
+		{
+			name:     "twap",
+			algoType: object.AlgoOrderTypeTypeTWAP,
+			size:     "1",
+			slices:   3,
+			wantErr:  nil,
+		},
+		{
+			name:     "no size",
+			algoType: object.AlgoOrderTypeTypeTWAP,
+			size:     "0",
+			slices:   3,
+			wantErr:  object.ErrAlgoOrderInvalid,
+		},
+		{
+			name:     "vwap without slices",
+			algoType: object.AlgoOrderTypeTypeVWAP,
+			size:     "1",
+			slices:   0,
+			wantErr:  object.ErrAlgoOrderInvalid,
+		},
+		{
+			name:     "more slices than seconds",
+			algoType: object.AlgoOrderTypeTypeVWAP,
+			size:     "1",
+			slices:   301,
+			wantErr:  object.ErrAlgoOrderInvalid,
+		},
+		{
+			name:        "iceberg",
+			algoType:    object.AlgoOrderTypeTypeIceberg,
+			size:        "1",
+			visibleSize: "0.25",
+			wantErr:     nil,
+		},
+		{
+			name:     "iceberg without visible size",
+			algoType: object.AlgoOrderTypeTypeIceberg,
+			size:     "1",
+			wantErr:  object.ErrAlgoOrderInvalid,
+		},
+		{
+			name:     "unknown algo type",
+			algoType: object.AlgoOrderTypeType("unknown"),
+			size:     "1",
+			wantErr:  object.ErrAlgoOrderInvalid,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := algoOrderServiceCheck(dto.NewPlaceAlgoOrderRequest(
+				test.algoType,
+				"algo",
+				object.URIEmpty,
+				object.OrderSideTypeBuy,
+				test.size,
+				"BTC-USDT",
+				test.visibleSize,
+				300,
+				test.slices,
+				0,
+			))
+			if !errors.Is(err, test.wantErr) || (test.wantErr == nil && err != nil) {
+				t.Errorf("algoOrderServiceCheck() error = %v, want %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestAlgoOrderServiceNext(t *testing.T) {
+	t.Parallel()
+
+	omSymboler, _ := (&bracketServiceTestSymbolServicer{SymbolServicer: nil}).
+		GetBySymbol(context.Background(), "BTC-USDT")
+
+	tests := []struct {
+		name          string
+		omAlgoOrderer om.AlgoOrderer
+		now           int64
+		wantSize      string
+		wantReason    object.AlgoOrderReasonType
+	}{
+		{
+			name: "twap waits for the window",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeTWAP,
+				"0.3333,0.6666,1",
+				object.URIEmpty,
+				"0",
+				"0",
+				0,
+				100,
+				400,
+			),
+			now:        50,
+			wantSize:   object.URIEmpty,
+			wantReason: object.AlgoOrderReasonType(object.URIEmpty),
+		},
+		{
+			name: "twap catches up with the schedule",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeTWAP,
+				"0.3333,0.6666,1",
+				object.URIEmpty,
+				"0",
+				"0.2",
+				100,
+				100,
+				400,
+			),
+			now:        250,
+			wantSize:   "0.4666",
+			wantReason: object.AlgoOrderReasonType(object.URIEmpty),
+		},
+		{
+			name: "twap ahead of the schedule",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeTWAP,
+				"0.3333,0.6666,1",
+				object.URIEmpty,
+				"0",
+				"0.5",
+				100,
+				100,
+				400,
+			),
+			now:        150,
+			wantSize:   object.URIEmpty,
+			wantReason: object.AlgoOrderReasonType(object.URIEmpty),
+		},
+		{
+			name: "twap expires after the last slice",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeTWAP,
+				"0.3333,0.6666,1",
+				object.URIEmpty,
+				"0",
+				"0.9",
+				300,
+				100,
+				400,
+			),
+			now:        400,
+			wantSize:   object.URIEmpty,
+			wantReason: object.AlgoOrderReasonTypeExpired,
+		},
+		{
+			name: "iceberg shows the visible size",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeIceberg,
+				object.URIEmpty,
+				object.URIEmpty,
+				"0",
+				"0.5",
+				0,
+				0,
+				0,
+			),
+			now:        100,
+			wantSize:   "0.25",
+			wantReason: object.AlgoOrderReasonType(object.URIEmpty),
+		},
+		{
+			name: "iceberg shows the remainder",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeIceberg,
+				object.URIEmpty,
+				object.URIEmpty,
+				"0",
+				"0.9",
+				0,
+				0,
+				0,
+			),
+			now:        100,
+			wantSize:   "0.1",
+			wantReason: object.AlgoOrderReasonType(object.URIEmpty),
+		},
+		{
+			name: "iceberg expires",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeIceberg,
+				object.URIEmpty,
+				object.URIEmpty,
+				"0",
+				"0.5",
+				0,
+				0,
+				100,
+			),
+			now:        100,
+			wantSize:   object.URIEmpty,
+			wantReason: object.AlgoOrderReasonTypeExpired,
+		},
+		{
+			name: "filled",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeIceberg,
+				object.URIEmpty,
+				object.URIEmpty,
+				"0",
+				"1",
+				0,
+				0,
+				0,
+			),
+			now:        100,
+			wantSize:   object.URIEmpty,
+			wantReason: object.AlgoOrderReasonTypeFilled,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			size, reason, err := algoOrderServiceNext(test.omAlgoOrderer, omSymboler, test.now)
+			if err != nil {
+				t.Fatalf("algoOrderServiceNext() error = %v", err)
+			}
+
+			sizeOK := size == test.wantSize ||
+				(test.wantSize != object.URIEmpty && algoOrderServiceTestEqual(size, test.wantSize))
+			if !sizeOK || reason != test.wantReason {
+				t.Errorf(
+					"algoOrderServiceNext() = %q, %q, want %q, %q",
+					size,
+					reason,
+					test.wantSize,
+					test.wantReason,
+				)
+			}
+		})
+	}
+}
+
+func TestAlgoOrderServiceStale(t *testing.T) {
+	t.Parallel()
+
+	omOrderer := orderServiceOrderFromModel(
+		object.URIEmpty,
+		&kucoin.OrderModel{Price: "99", IsActive: true},
+		false,
+		uuid.Nil,
+	)
+
+	tests := []struct {
+		name          string
+		omAlgoOrderer om.AlgoOrderer
+		price         string
+		now           int64
+		want          bool
+	}{
+		{
+			name: "twap child within its slice",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeTWAP,
+				"0.3333,0.6666,1",
+				"child",
+				"0",
+				"0",
+				100,
+				100,
+				400,
+			),
+			price: "105",
+			now:   199,
+			want:  false,
+		},
+		{
+			name: "twap child after its slice",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeTWAP,
+				"0.3333,0.6666,1",
+				"child",
+				"0",
+				"0",
+				100,
+				100,
+				400,
+			),
+			price: "105",
+			now:   200,
+			want:  true,
+		},
+		{
+			name: "iceberg child at the best price",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeIceberg,
+				object.URIEmpty,
+				"child",
+				"0",
+				"0",
+				0,
+				0,
+				0,
+			),
+			price: "99",
+			now:   100,
+			want:  false,
+		},
+		{
+			name: "iceberg child behind the best price",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeIceberg,
+				object.URIEmpty,
+				"child",
+				"0",
+				"0",
+				0,
+				0,
+				0,
+			),
+			price: "100",
+			now:   100,
+			want:  true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := algoOrderServiceStale(test.omAlgoOrderer, omOrderer, test.price, test.now)
+			if got != test.want {
+				t.Errorf("algoOrderServiceStale() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAlgoOrderServiceReconcile(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Unix()
+	firstClientOID := algoOrderServiceClientOID("algo", 0)
+	secondClientOID := algoOrderServiceClientOID("algo", 1)
+
+	tests := []struct {
+		name               string
+		omAlgoOrderer      om.AlgoOrderer
+		kucoinOrderModel   *kucoin.OrderModel
+		wantState          object.AlgoOrderStateType
+		wantReason         object.AlgoOrderReasonType
+		wantDealSize       string
+		wantChildClientOID string
+		wantChildPrice     string
+		wantChildSize      string
+	}{
+		{
+			name: "twap places the first slice at the limit price",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeTWAP,
+				"0.3333,0.6666,1",
+				object.URIEmpty,
+				"0",
+				"0",
+				0,
+				now-10,
+				now+290,
+			),
+			kucoinOrderModel:   nil,
+			wantState:          object.AlgoOrderStateTypeWorking,
+			wantReason:         object.AlgoOrderReasonType(object.URIEmpty),
+			wantDealSize:       "0",
+			wantChildClientOID: firstClientOID,
+			wantChildPrice:     "105",
+			wantChildSize:      "0.3333",
+		},
+		{
+			name: "twap child rests within its slice",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeTWAP,
+				"0.3333,0.6666,1",
+				firstClientOID,
+				"0",
+				"0",
+				now,
+				now-10,
+				now+290,
+			),
+			kucoinOrderModel: &kucoin.OrderModel{
+				ClientOid: firstClientOID,
+				Price:     "105",
+				Size:      "0.3333",
+				DealSize:  "0",
+				IsActive:  true,
+			},
+			wantState:          object.AlgoOrderStateTypeWorking,
+			wantReason:         object.AlgoOrderReasonType(object.URIEmpty),
+			wantDealSize:       "0",
+			wantChildClientOID: firstClientOID,
+			wantChildPrice:     "105",
+			wantChildSize:      "0.3333",
+		},
+		{
+			name: "iceberg child moves to the best price",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeIceberg,
+				object.URIEmpty,
+				firstClientOID,
+				"0",
+				"0",
+				now,
+				0,
+				0,
+			),
+			kucoinOrderModel: &kucoin.OrderModel{
+				ClientOid: firstClientOID,
+				Price:     "99",
+				Size:      "0.25",
+				DealFunds: "9.9",
+				DealSize:  "0.1",
+				IsActive:  true,
+			},
+			wantState:          object.AlgoOrderStateTypeWorking,
+			wantReason:         object.AlgoOrderReasonType(object.URIEmpty),
+			wantDealSize:       "0.1",
+			wantChildClientOID: secondClientOID,
+			wantChildPrice:     "100",
+			wantChildSize:      "0.25",
+		},
+		{
+			name: "iceberg filled by its last child",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeIceberg,
+				object.URIEmpty,
+				firstClientOID,
+				"0",
+				"0.9",
+				now,
+				0,
+				0,
+			),
+			kucoinOrderModel: &kucoin.OrderModel{
+				ClientOid: firstClientOID,
+				Price:     "100",
+				Size:      "0.1",
+				DealFunds: "10",
+				DealSize:  "0.1",
+				IsActive:  false,
+			},
+			wantState:          object.AlgoOrderStateTypeClosed,
+			wantReason:         object.AlgoOrderReasonTypeFilled,
+			wantDealSize:       "1",
+			wantChildClientOID: object.URIEmpty,
+			wantChildPrice:     object.URIEmpty,
+			wantChildSize:      object.URIEmpty,
+		},
+		{
+			name: "twap expires after the last slice",
+			omAlgoOrderer: newAlgoOrderServiceTestAlgoOrder(
+				object.AlgoOrderTypeTypeTWAP,
+				"0.3333,0.6666,1",
+				object.URIEmpty,
+				"0",
+				"0.9",
+				now-150,
+				now-400,
+				now-100,
+			),
+			kucoinOrderModel:   nil,
+			wantState:          object.AlgoOrderStateTypeClosed,
+			wantReason:         object.AlgoOrderReasonTypeExpired,
+			wantDealSize:       "0.9",
+			wantChildClientOID: object.URIEmpty,
+			wantChildPrice:     object.URIEmpty,
+			wantChildSize:      object.URIEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			testAlgoOrderServicer, testOrderServicer := newAlgoOrderServiceTest("100", "101")
+
+			if test.kucoinOrderModel != nil {
+				testOrderServicer.set(test.kucoinOrderModel)
+			}
+
+			omAlgoOrderer, err := testAlgoOrderServicer.Reconcile(
+				context.Background(),
+				test.omAlgoOrderer,
+			)
+			if err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			if omAlgoOrderer.GetState() != string(test.wantState) ||
+				omAlgoOrderer.GetReason() != string(test.wantReason) ||
+				!algoOrderServiceTestEqual(omAlgoOrderer.GetDealSize(), test.wantDealSize) {
+				t.Errorf(
+					"Reconcile() = %s, %s, %s, want %s, %s, %s",
+					omAlgoOrderer.GetState(),
+					omAlgoOrderer.GetReason(),
+					omAlgoOrderer.GetDealSize(),
+					test.wantState,
+					test.wantReason,
+					test.wantDealSize,
+				)
+			}
+
+			if omAlgoOrderer.GetChildClientOID() != test.wantChildClientOID {
+				t.Errorf(
+					"GetChildClientOID() = %q, want %q",
+					omAlgoOrderer.GetChildClientOID(),
+					test.wantChildClientOID,
+				)
+			}
+
+			if test.wantChildClientOID == object.URIEmpty {
+				return
+			}
+
+			omOrderer, err := testOrderServicer.GetByClientOID(
+				context.Background(),
+				test.wantChildClientOID,
+			)
+			if err != nil {
+				t.Fatalf("GetByClientOID() error = %v", err)
+			}
+
+			if !algoOrderServiceTestEqual(omOrderer.GetPrice(), test.wantChildPrice) ||
+				!algoOrderServiceTestEqual(omOrderer.GetSize(), test.wantChildSize) ||
+				!omOrderer.GetIsActive() {
+				t.Errorf(
+					"GetByClientOID() = %s at %s, want %s at %s",
+					omOrderer.GetSize(),
+					omOrderer.GetPrice(),
+					test.wantChildSize,
+					test.wantChildPrice,
+				)
+			}
+		})
+	}
+}
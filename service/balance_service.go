@@ -0,0 +1,627 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// BalanceServicer is an interface.
+	BalanceServicer interface {
+		// Create is a function.
+		Create(
+			context.Context,
+			om.Balancer,
+		) (uuid.UUID, error)
+		// DeleteAll is a function.
+		DeleteAll(
+			context.Context,
+		) (time.Time, error)
+		// Get is a function.
+		Get(
+			context.Context,
+			uuid.UUID,
+		) (om.Balancer, error)
+		// GetListFromRemote is a function.
+		GetListFromRemote(
+			context.Context,
+		) error
+		// GetListFromRepository is a function.
+		GetListFromRepository(
+			context.Context,
+			dao.Paginationer,
+			dao.BalanceFilterer,
+		) ([]om.Balancer, dao.Cursorer, error)
+		// Upsert is a function.
+		Upsert(
+			context.Context,
+			om.Balancer,
+		) (uuid.UUID, error)
+	}
+
+	// GetBalanceServicer is an interface.
+	GetBalanceServicer interface {
+		// GetBalanceServicer is a function.
+		GetBalanceServicer() BalanceServicer
+	}
+
+	balanceService struct {
+		configConfigger   config.Configger
+		repositorier      repository.BalanceRepositorier
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+)
+
+var (
+	_ BalanceServicer                   = (*balanceService)(nil)
+	_ GetServicer                       = (*balanceService)(nil)
+	_ WithServicer                      = (*balanceService)(nil)
+	_ config.GetConfigger               = (*balanceService)(nil)
+	_ exchange.GetExchanger             = (*balanceService)(nil)
+	_ log.GetRuntimeLogger              = (*balanceService)(nil)
+	_ repository.GetBalanceRepositorier = (*balanceService)(nil)
+	_ util.GetTracer                    = (*balanceService)(nil)
+	_ util.GetUUIDer                    = (*balanceService)(nil)
+)
+
+// NewBalanceServicer is a function.
+func NewBalanceServicer(
+	configConfigger config.Configger,
+	repositorier repository.BalanceRepositorier,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) BalanceServicer {
+	return &balanceService{
+		configConfigger:   configConfigger,
+		repositorier:      repositorier,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *balanceService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *balanceService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *balanceService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetBalanceRepositorier is a function.
+func (service *balanceService) GetBalanceRepositorier() repository.BalanceRepositorier {
+	return service.repositorier
+}
+
+// GetTracer is a function.
+func (service *balanceService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *balanceService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *balanceService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *balanceService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// Create is a function.
+func (service *balanceService) Create(
+	ctx context.Context,
+	omBalancer om.Balancer,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":        "Create",
+		"rt_ctx":      utilRuntimeContext,
+		"sp_ctx":      utilSpanContext,
+		"config":      service.configConfigger,
+		"om_balancer": omBalancer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoBalance := dao.NewBalance(
+		time.Time{},
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		uuid.Nil,
+		omBalancer.GetAccount(),
+		omBalancer.GetAvailable(),
+		omBalancer.GetBalance(),
+		omBalancer.GetCurrency(),
+		omBalancer.GetHolds(),
+		omBalancer.GetKucoinID(),
+		omBalancer.GetKucoinType(),
+		omBalancer.GetPaper(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBalance, daoBalance).
+		Debug(object.URIEmpty)
+
+	balanceID, err := service.GetBalanceRepositorier().Create(ctx, daoBalance)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBalanceRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldBalanceID, balanceID).
+		Debug(object.URIEmpty)
+
+	return balanceID, nil
+}
+
+// DeleteAll is a function.
+func (service *balanceService) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	deletedAt, err := service.GetBalanceRepositorier().DeleteAll(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBalanceRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDeletedAt, deletedAt).
+		Debug(object.URIEmpty)
+
+	return deletedAt, nil
+}
+
+// Get is a function.
+func (service *balanceService) Get(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.Balancer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Get",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Get",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoBalance, err := service.GetBalanceRepositorier().Read(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBalanceRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBalance, daoBalance).
+		Debug(object.URIEmpty)
+
+	omBalance := om.NewBalance(
+		daoBalance.GetAccount(),
+		daoBalance.GetAvailable(),
+		daoBalance.GetBalance(),
+		daoBalance.GetCurrency(),
+		daoBalance.GetHolds(),
+		daoBalance.GetKucoinID(),
+		daoBalance.GetKucoinType(),
+		daoBalance.GetPaper(),
+		daoBalance.GetID(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMBalance, omBalance).
+		Debug(object.URIEmpty)
+
+	return omBalance, nil
+}
+
+// GetListFromRemote is a function.
+// GetListFromRemote stores the balance of every account, one row per account.
+func (service *balanceService) GetListFromRemote(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRemote",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetListFromRemote",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	response, err := serviceExchanger(ctx, service).Accounts(object.URIEmpty, object.URIEmpty)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBalanceKucoinServiceGetList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceKucoinServiceGetList.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, errResponse).
+			Error(object.ErrBalanceKucoinServiceGetList.Error())
+		traceSpan.RecordError(errResponse)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceKucoinServiceGetList.Error())
+
+		return fmt.Errorf("%w: %w", object.ErrBalanceKucoinServiceGetList, errResponse)
+	}
+
+	kucoinAccountsModel := kucoin.AccountsModel{}
+
+	if err = response.ReadData(&kucoinAccountsModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinAccountsModel, kucoinAccountsModel).
+		Debug(object.URIEmpty)
+
+	for key, value := range kucoinAccountsModel {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		omBalance := om.NewBalance(
+			serviceAccount(ctx, service),
+			value.Available,
+			value.Balance,
+			value.Currency,
+			value.Holds,
+			value.Id,
+			value.Type,
+			service.GetConfigger().GetPaperConfigger().GetEnabled(),
+			uuid.Nil,
+		)
+
+		balanceID, errBalanceUpsert := service.GetServicer().
+			GetBalanceServicer().
+			Upsert(ctx, omBalance)
+		if errBalanceUpsert != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errBalanceUpsert).
+				Error(object.ErrBalanceServiceUpsert.Error())
+			traceSpan.RecordError(errBalanceUpsert)
+			traceSpan.SetStatus(codes.Error, object.ErrBalanceServiceUpsert.Error())
+
+			return errBalanceUpsert
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldBalanceID, balanceID).
+			Debug(object.URIEmpty)
+	}
+
+	return nil
+}
+
+// GetListFromRepository is a function.
+func (service *balanceService) GetListFromRepository(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoBalanceFilterer dao.BalanceFilterer,
+) ([]om.Balancer, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRepository",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                 "GetListFromRepository",
+		"rt_ctx":               utilRuntimeContext,
+		"sp_ctx":               utilSpanContext,
+		"config":               service.configConfigger,
+		"dao_paginationer":     daoPaginationer,
+		"dao_balance_filterer": daoBalanceFilterer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoBalances, daoCursorer, err := service.GetBalanceRepositorier().
+		ReadList(ctx, daoPaginationer, daoBalanceFilterer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBalanceRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBalances, daoBalances).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	omBalances := make([]om.Balancer, 0, len(daoBalances))
+
+	for key, daoBalance := range daoBalances {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldDAOBalance, daoBalance).
+			Debug(object.URIEmpty)
+
+		omBalances = append(omBalances, om.NewBalance(
+			daoBalance.GetAccount(),
+			daoBalance.GetAvailable(),
+			daoBalance.GetBalance(),
+			daoBalance.GetCurrency(),
+			daoBalance.GetHolds(),
+			daoBalance.GetKucoinID(),
+			daoBalance.GetKucoinType(),
+			daoBalance.GetPaper(),
+			daoBalance.GetID(),
+		))
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMBalances, omBalances).
+		Debug(object.URIEmpty)
+
+	return omBalances, daoCursorer, nil
+}
+
+// Upsert is a function.
+func (service *balanceService) Upsert(
+	ctx context.Context,
+	omBalancer om.Balancer,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Upsert",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":        "Upsert",
+		"rt_ctx":      utilRuntimeContext,
+		"sp_ctx":      utilSpanContext,
+		"config":      service.configConfigger,
+		"om_balancer": omBalancer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoBalances, _, err := service.GetBalanceRepositorier().ReadList(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewBalanceFilter(
+			omBalancer.GetAccount(),
+			object.URIEmpty,
+			omBalancer.GetKucoinID(),
+			object.URIEmpty,
+		),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBalanceRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryReadList.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBalances, daoBalances).
+		Debug(object.URIEmpty)
+
+	if len(daoBalances) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(daoBalances) == 0`)
+
+		return service.GetServicer().GetBalanceServicer().Create(ctx, omBalancer)
+	}
+
+	daoBalance := dao.NewBalance(
+		daoBalances[0].GetCreatedAt(),
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		daoBalances[0].GetID(),
+		omBalancer.GetAccount(),
+		omBalancer.GetAvailable(),
+		omBalancer.GetBalance(),
+		omBalancer.GetCurrency(),
+		omBalancer.GetHolds(),
+		omBalancer.GetKucoinID(),
+		omBalancer.GetKucoinType(),
+		omBalancer.GetPaper(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBalance, daoBalance).
+		Debug(object.URIEmpty)
+
+	updatedAt, err := service.GetBalanceRepositorier().Update(ctx, daoBalance)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBalanceRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceRepositoryUpdate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return daoBalance.GetID(), nil
+}
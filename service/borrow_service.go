@@ -0,0 +1,820 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// BorrowServicer is an interface.
+	BorrowServicer interface {
+		// Create is a function.
+		Create(
+			context.Context,
+			om.Borrower,
+		) (uuid.UUID, error)
+		// DeleteAll is a function.
+		DeleteAll(
+			context.Context,
+		) (time.Time, error)
+		// Get is a function.
+		Get(
+			context.Context,
+			uuid.UUID,
+		) (om.Borrower, error)
+		// GetListFromRemote is a function.
+		GetListFromRemote(
+			context.Context,
+			object.BorrowStatusType,
+			int64,
+		) error
+		// GetListFromRepository is a function.
+		GetListFromRepository(
+			context.Context,
+			dao.Paginationer,
+			dao.BorrowFilterer,
+		) ([]om.Borrower, dao.Cursorer, error)
+		// Upsert is a function.
+		Upsert(
+			context.Context,
+			om.Borrower,
+		) (uuid.UUID, error)
+	}
+
+	// GetBorrowServicer is an interface.
+	GetBorrowServicer interface {
+		// GetBorrowServicer is a function.
+		GetBorrowServicer() BorrowServicer
+	}
+
+	borrowService struct {
+		configConfigger   config.Configger
+		repositorier      repository.BorrowRepositorier
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+)
+
+var (
+	_ BorrowServicer                   = (*borrowService)(nil)
+	_ GetServicer                      = (*borrowService)(nil)
+	_ WithServicer                     = (*borrowService)(nil)
+	_ config.GetConfigger              = (*borrowService)(nil)
+	_ exchange.GetExchanger            = (*borrowService)(nil)
+	_ log.GetRuntimeLogger             = (*borrowService)(nil)
+	_ repository.GetBorrowRepositorier = (*borrowService)(nil)
+	_ util.GetTracer                   = (*borrowService)(nil)
+	_ util.GetUUIDer                   = (*borrowService)(nil)
+)
+
+// NewBorrowServicer is a function.
+func NewBorrowServicer(
+	configConfigger config.Configger,
+	repositorier repository.BorrowRepositorier,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) BorrowServicer {
+	return &borrowService{
+		configConfigger:   configConfigger,
+		repositorier:      repositorier,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *borrowService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *borrowService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *borrowService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetBorrowRepositorier is a function.
+func (service *borrowService) GetBorrowRepositorier() repository.BorrowRepositorier {
+	return service.repositorier
+}
+
+// GetTracer is a function.
+func (service *borrowService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *borrowService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *borrowService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *borrowService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// Create is a function.
+func (service *borrowService) Create(
+	ctx context.Context,
+	omBorrower om.Borrower,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":        "Create",
+		"rt_ctx":      utilRuntimeContext,
+		"sp_ctx":      utilSpanContext,
+		"config":      service.configConfigger,
+		"om_borrower": omBorrower,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoBorrow := dao.NewBorrow(
+		time.Time{},
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		uuid.Nil,
+		omBorrower.GetAccount(),
+		omBorrower.GetAccruedInterest(),
+		omBorrower.GetCurrency(),
+		omBorrower.GetDailyIntRate(),
+		omBorrower.GetInterest(),
+		omBorrower.GetLiability(),
+		omBorrower.GetPrincipal(),
+		omBorrower.GetRepaidSize(),
+		omBorrower.GetStatus(),
+		omBorrower.GetTradeID(),
+		omBorrower.GetKucoinCreatedAt(),
+		omBorrower.GetMaturityTime(),
+		omBorrower.GetRepaidAt(),
+		omBorrower.GetTerm(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBorrow, daoBorrow).
+		Debug(object.URIEmpty)
+
+	borrowID, err := service.GetBorrowRepositorier().Create(ctx, daoBorrow)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBorrowRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldBorrowID, borrowID).
+		Debug(object.URIEmpty)
+
+	return borrowID, nil
+}
+
+// DeleteAll is a function.
+func (service *borrowService) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	deletedAt, err := service.GetBorrowRepositorier().DeleteAll(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBorrowRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDeletedAt, deletedAt).
+		Debug(object.URIEmpty)
+
+	return deletedAt, nil
+}
+
+// Get is a function.
+func (service *borrowService) Get(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.Borrower, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Get",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Get",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoBorrow, err := service.GetBorrowRepositorier().Read(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBorrowRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBorrow, daoBorrow).
+		Debug(object.URIEmpty)
+
+	omBorrow := om.NewBorrow(
+		daoBorrow.GetAccount(),
+		daoBorrow.GetAccruedInterest(),
+		daoBorrow.GetCurrency(),
+		daoBorrow.GetDailyIntRate(),
+		daoBorrow.GetInterest(),
+		daoBorrow.GetLiability(),
+		daoBorrow.GetPrincipal(),
+		daoBorrow.GetRepaidSize(),
+		daoBorrow.GetStatus(),
+		daoBorrow.GetTradeID(),
+		daoBorrow.GetKucoinCreatedAt(),
+		daoBorrow.GetMaturityTime(),
+		daoBorrow.GetRepaidAt(),
+		daoBorrow.GetTerm(),
+		daoBorrow.GetID(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMBorrow, omBorrow).
+		Debug(object.URIEmpty)
+
+	return omBorrow, nil
+}
+
+// GetListFromRemote is a function.
+// GetListFromRemote stores every page of the outstanding or the repaid borrow
+// records, one row per trade, so a record moves from outstanding to repaid.
+func (service *borrowService) GetListFromRemote(
+	ctx context.Context,
+	status object.BorrowStatusType,
+	currentPage int64,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRemote",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "GetListFromRemote",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       service.configConfigger,
+		"status":       status,
+		"current_page": currentPage,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	kucoinPaginationParam := &kucoin.PaginationParam{
+		CurrentPage: currentPage,
+		PageSize:    service.GetConfigger().GetRuntimeConfigger().GetKucoinPaginationRequestSize(),
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinPaginationParam, kucoinPaginationParam).
+		Debug(object.URIEmpty)
+
+	var (
+		response *kucoin.ApiResponse
+		err      error
+	)
+
+	if status == object.BorrowStatusTypeRepaid {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`status == object.BorrowStatusTypeRepaid`)
+
+		response, err = serviceExchanger(ctx, service).
+			BorrowRepaidRecords(object.URIEmpty, kucoinPaginationParam)
+	} else {
+		response, err = serviceExchanger(ctx, service).
+			BorrowOutstandingRecords(object.URIEmpty, kucoinPaginationParam)
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBorrowKucoinServiceGetList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowKucoinServiceGetList.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, errResponse).
+			Error(object.ErrBorrowKucoinServiceGetList.Error())
+		traceSpan.RecordError(errResponse)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowKucoinServiceGetList.Error())
+
+		return fmt.Errorf("%w: %w", object.ErrBorrowKucoinServiceGetList, errResponse)
+	}
+
+	kucoinPaginationModel, err := response.ReadPaginationData(&[]json.RawMessage{})
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadPaginationData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadPaginationData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinPaginationModel, kucoinPaginationModel).
+		Debug(object.URIEmpty)
+
+	omBorrowers, err := borrowServiceBorrowers(
+		serviceAccount(ctx, service),
+		status,
+		kucoinPaginationModel.RawItems,
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrUnmarshalJSON.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrUnmarshalJSON.Error())
+
+		return err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMBorrows, omBorrowers).
+		Debug(object.URIEmpty)
+
+	for _, omBorrower := range omBorrowers {
+		borrowID, errBorrowUpsert := service.GetServicer().
+			GetBorrowServicer().
+			Upsert(ctx, omBorrower)
+		if errBorrowUpsert != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errBorrowUpsert).
+				Error(object.ErrBorrowServiceUpsert.Error())
+			traceSpan.RecordError(errBorrowUpsert)
+			traceSpan.SetStatus(codes.Error, object.ErrBorrowServiceUpsert.Error())
+
+			return errBorrowUpsert
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldBorrowID, borrowID).
+			Debug(object.URIEmpty)
+	}
+
+	if kucoinPaginationModel.CurrentPage < kucoinPaginationModel.TotalPage {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`kucoinPaginationModel.CurrentPage < kucoinPaginationModel.TotalPage`)
+
+		if err = service.GetServicer().
+			GetBorrowServicer().
+			GetListFromRemote(ctx, status, currentPage+1); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrBorrowServiceGetListFromRemote.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrBorrowServiceGetListFromRemote.Error())
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetListFromRepository is a function.
+func (service *borrowService) GetListFromRepository(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoBorrowFilterer dao.BorrowFilterer,
+) ([]om.Borrower, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRepository",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                "GetListFromRepository",
+		"rt_ctx":              utilRuntimeContext,
+		"sp_ctx":              utilSpanContext,
+		"config":              service.configConfigger,
+		"dao_paginationer":    daoPaginationer,
+		"dao_borrow_filterer": daoBorrowFilterer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoBorrows, daoCursorer, err := service.GetBorrowRepositorier().
+		ReadList(ctx, daoPaginationer, daoBorrowFilterer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBorrowRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBorrows, daoBorrows).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	omBorrows := make([]om.Borrower, 0, len(daoBorrows))
+
+	for key, daoBorrow := range daoBorrows {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldDAOBorrow, daoBorrow).
+			Debug(object.URIEmpty)
+
+		omBorrows = append(omBorrows, om.NewBorrow(
+			daoBorrow.GetAccount(),
+			daoBorrow.GetAccruedInterest(),
+			daoBorrow.GetCurrency(),
+			daoBorrow.GetDailyIntRate(),
+			daoBorrow.GetInterest(),
+			daoBorrow.GetLiability(),
+			daoBorrow.GetPrincipal(),
+			daoBorrow.GetRepaidSize(),
+			daoBorrow.GetStatus(),
+			daoBorrow.GetTradeID(),
+			daoBorrow.GetKucoinCreatedAt(),
+			daoBorrow.GetMaturityTime(),
+			daoBorrow.GetRepaidAt(),
+			daoBorrow.GetTerm(),
+			daoBorrow.GetID(),
+		))
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMBorrows, omBorrows).
+		Debug(object.URIEmpty)
+
+	return omBorrows, daoCursorer, nil
+}
+
+// Upsert is a function.
+func (service *borrowService) Upsert(
+	ctx context.Context,
+	omBorrower om.Borrower,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Upsert",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":        "Upsert",
+		"rt_ctx":      utilRuntimeContext,
+		"sp_ctx":      utilSpanContext,
+		"config":      service.configConfigger,
+		"om_borrower": omBorrower,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoBorrows, _, err := service.GetBorrowRepositorier().ReadList(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewBorrowFilter(
+			omBorrower.GetAccount(),
+			object.URIEmpty,
+			object.URIEmpty,
+			omBorrower.GetTradeID(),
+		),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBorrowRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryReadList.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBorrows, daoBorrows).
+		Debug(object.URIEmpty)
+
+	if len(daoBorrows) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(daoBorrows) == 0`)
+
+		return service.GetServicer().GetBorrowServicer().Create(ctx, omBorrower)
+	}
+
+	// A repaid record does not tell when the borrow was made or was due, so
+	// those are kept from the outstanding record.
+	kucoinCreatedAt := omBorrower.GetKucoinCreatedAt()
+	if kucoinCreatedAt == 0 {
+		kucoinCreatedAt = daoBorrows[0].GetKucoinCreatedAt()
+	}
+
+	maturityTime := omBorrower.GetMaturityTime()
+	if maturityTime == 0 {
+		maturityTime = daoBorrows[0].GetMaturityTime()
+	}
+
+	daoBorrow := dao.NewBorrow(
+		daoBorrows[0].GetCreatedAt(),
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		daoBorrows[0].GetID(),
+		omBorrower.GetAccount(),
+		omBorrower.GetAccruedInterest(),
+		omBorrower.GetCurrency(),
+		omBorrower.GetDailyIntRate(),
+		omBorrower.GetInterest(),
+		omBorrower.GetLiability(),
+		omBorrower.GetPrincipal(),
+		omBorrower.GetRepaidSize(),
+		omBorrower.GetStatus(),
+		omBorrower.GetTradeID(),
+		kucoinCreatedAt,
+		maturityTime,
+		omBorrower.GetRepaidAt(),
+		omBorrower.GetTerm(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBorrow, daoBorrow).
+		Debug(object.URIEmpty)
+
+	updatedAt, err := service.GetBorrowRepositorier().Update(ctx, daoBorrow)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBorrowRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBorrowRepositoryUpdate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return daoBorrow.GetID(), nil
+}
+
+// borrowServiceBorrowers reads a page of borrow records. An outstanding record
+// carries what is still owed, a repaid one the interest that was paid.
+func borrowServiceBorrowers(
+	account string,
+	status object.BorrowStatusType,
+	rawItems json.RawMessage,
+) ([]om.Borrower, error) {
+	if status == object.BorrowStatusTypeRepaid {
+		kucoinBorrowRepaidRecordsModel := kucoin.BorrowRepaidRecordsModel{}
+		if err := json.Unmarshal(rawItems, &kucoinBorrowRepaidRecordsModel); err != nil {
+			return nil, err
+		}
+
+		omBorrowers := make([]om.Borrower, 0, len(kucoinBorrowRepaidRecordsModel))
+
+		for _, value := range kucoinBorrowRepaidRecordsModel {
+			numbers, err := borrowServiceInt64(value.RepayTime, value.Term)
+			if err != nil {
+				return nil, err
+			}
+
+			omBorrowers = append(omBorrowers, om.NewBorrow(
+				account,
+				"0",
+				value.Currency,
+				value.DailyIntRate.String(),
+				value.Interest.String(),
+				"0",
+				value.Principal.String(),
+				value.RepaidSize.String(),
+				string(object.BorrowStatusTypeRepaid),
+				value.TradeId,
+				0,
+				0,
+				numbers[0],
+				numbers[1],
+				uuid.Nil,
+			))
+		}
+
+		return omBorrowers, nil
+	}
+
+	kucoinBorrowOutstandingRecordsModel := kucoin.BorrowOutstandingRecordsModel{}
+	if err := json.Unmarshal(rawItems, &kucoinBorrowOutstandingRecordsModel); err != nil {
+		return nil, err
+	}
+
+	omBorrowers := make([]om.Borrower, 0, len(kucoinBorrowOutstandingRecordsModel))
+
+	for _, value := range kucoinBorrowOutstandingRecordsModel {
+		numbers, err := borrowServiceInt64(value.CreatedAt, value.MaturityTime, value.Term)
+		if err != nil {
+			return nil, err
+		}
+
+		omBorrowers = append(omBorrowers, om.NewBorrow(
+			account,
+			value.AccruedInterest.String(),
+			value.Currency,
+			value.DailyIntRate.String(),
+			"0",
+			value.Liability.String(),
+			value.Principal.String(),
+			value.RepaidSize.String(),
+			string(object.BorrowStatusTypeOutstanding),
+			value.TradeId,
+			numbers[0],
+			numbers[1],
+			0,
+			numbers[2],
+			uuid.Nil,
+		))
+	}
+
+	return omBorrowers, nil
+}
+
+// borrowServiceInt64 reads the integers of a borrow record, a missing one as 0.
+func borrowServiceInt64(
+	jsonNumbers ...json.Number,
+) ([]int64, error) {
+	numbers := make([]int64, 0, len(jsonNumbers))
+
+	for _, jsonNumber := range jsonNumbers {
+		if jsonNumber == object.URIEmpty {
+			numbers = append(numbers, 0)
+
+			continue
+		}
+
+		number, err := jsonNumber.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, jsonNumber)
+		}
+
+		numbers = append(numbers, number)
+	}
+
+	return numbers, nil
+}
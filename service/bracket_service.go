@@ -0,0 +1,2361 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// BracketServicer is an interface.
+	BracketServicer interface {
+		// Cancel is a function.
+		Cancel(
+			context.Context,
+			uuid.UUID,
+		) (om.Bracketer, error)
+		// Create is a function.
+		Create(
+			context.Context,
+			om.Bracketer,
+		) (uuid.UUID, error)
+		// DeleteAll is a function.
+		DeleteAll(
+			context.Context,
+		) (time.Time, error)
+		// Get is a function.
+		Get(
+			context.Context,
+			uuid.UUID,
+		) (om.Bracketer, error)
+		// GetByEntryClientOID is a function.
+		GetByEntryClientOID(
+			context.Context,
+			string,
+		) (om.Bracketer, error)
+		// GetListFromRepository is a function.
+		GetListFromRepository(
+			context.Context,
+			dao.Paginationer,
+			dao.BracketFilterer,
+		) ([]om.Bracketer, dao.Cursorer, error)
+		// Place is a function.
+		Place(
+			context.Context,
+			dto.PlaceBracketRequester,
+		) (om.Bracketer, error)
+		// Reconcile is a function.
+		Reconcile(
+			context.Context,
+			om.Bracketer,
+		) (om.Bracketer, error)
+		// Sync is a function.
+		Sync(
+			context.Context,
+		) error
+		// Update is a function.
+		Update(
+			context.Context,
+			om.Bracketer,
+		) (time.Time, error)
+	}
+
+	// GetBracketServicer is an interface.
+	GetBracketServicer interface {
+		// GetBracketServicer is a function.
+		GetBracketServicer() BracketServicer
+	}
+
+	bracketService struct {
+		configConfigger   config.Configger
+		repositorier      repository.BracketRepositorier
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+)
+
+var (
+	_ GetServicer                       = (*bracketService)(nil)
+	_ BracketServicer                   = (*bracketService)(nil)
+	_ WithServicer                      = (*bracketService)(nil)
+	_ config.GetConfigger               = (*bracketService)(nil)
+	_ exchange.GetExchanger             = (*bracketService)(nil)
+	_ log.GetRuntimeLogger              = (*bracketService)(nil)
+	_ repository.GetBracketRepositorier = (*bracketService)(nil)
+	_ util.GetTracer                    = (*bracketService)(nil)
+	_ util.GetUUIDer                    = (*bracketService)(nil)
+)
+
+// NewBracketServicer is a function.
+func NewBracketServicer(
+	configConfigger config.Configger,
+	repositorier repository.BracketRepositorier,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) BracketServicer {
+	return &bracketService{
+		configConfigger:   configConfigger,
+		repositorier:      repositorier,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *bracketService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *bracketService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *bracketService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetBracketRepositorier is a function.
+func (service *bracketService) GetBracketRepositorier() repository.BracketRepositorier {
+	return service.repositorier
+}
+
+// GetTracer is a function.
+func (service *bracketService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *bracketService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *bracketService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *bracketService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// Create is a function.
+func (service *bracketService) Create(
+	ctx context.Context,
+	omBracketer om.Bracketer,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "Create",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       service.configConfigger,
+		"om_bracketer": omBracketer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoBracket := dao.NewBracket(
+		time.Time{},
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		uuid.Nil,
+		omBracketer.GetAccount(),
+		omBracketer.GetEntryClientOID(),
+		omBracketer.GetExitClientOID(),
+		omBracketer.GetReason(),
+		omBracketer.GetSide(),
+		omBracketer.GetSize(),
+		omBracketer.GetState(),
+		omBracketer.GetStopClientOID(),
+		omBracketer.GetStopPrice(),
+		omBracketer.GetSymbol(),
+		omBracketer.GetTakeProfitClientOID(),
+		omBracketer.GetTakeProfitPrice(),
+		omBracketer.GetTrailingDistance(),
+		omBracketer.GetTrailingPrice(),
+		omBracketer.GetIsActive(),
+		omBracketer.GetPaper(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBracket, daoBracket).
+		Debug(object.URIEmpty)
+
+	bracketID, err := service.GetBracketRepositorier().Create(ctx, daoBracket)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldBracketID, bracketID).
+		Debug(object.URIEmpty)
+
+	return bracketID, nil
+}
+
+// DeleteAll is a function.
+func (service *bracketService) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	deletedAt, err := service.GetBracketRepositorier().DeleteAll(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDeletedAt, deletedAt).
+		Debug(object.URIEmpty)
+
+	return deletedAt, nil
+}
+
+// Get is a function.
+func (service *bracketService) Get(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.Bracketer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Get",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Get",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoBracket, err := service.GetBracketRepositorier().Read(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBracket, daoBracket).
+		Debug(object.URIEmpty)
+
+	omBracket := om.NewBracket(
+		daoBracket.GetAccount(),
+		daoBracket.GetEntryClientOID(),
+		daoBracket.GetExitClientOID(),
+		daoBracket.GetReason(),
+		daoBracket.GetSide(),
+		daoBracket.GetSize(),
+		daoBracket.GetState(),
+		daoBracket.GetStopClientOID(),
+		daoBracket.GetStopPrice(),
+		daoBracket.GetSymbol(),
+		daoBracket.GetTakeProfitClientOID(),
+		daoBracket.GetTakeProfitPrice(),
+		daoBracket.GetTrailingDistance(),
+		daoBracket.GetTrailingPrice(),
+		daoBracket.GetIsActive(),
+		daoBracket.GetPaper(),
+		daoBracket.GetID(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMBracket, omBracket).
+		Debug(object.URIEmpty)
+
+	return omBracket, nil
+}
+
+// GetListFromRepository is a function.
+func (service *bracketService) GetListFromRepository(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoBracketFilterer dao.BracketFilterer,
+) ([]om.Bracketer, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRepository",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                 "GetListFromRepository",
+		"rt_ctx":               utilRuntimeContext,
+		"sp_ctx":               utilSpanContext,
+		"config":               service.configConfigger,
+		"dao_paginationer":     daoPaginationer,
+		"dao_bracket_filterer": daoBracketFilterer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoBrackets, daoCursorer, err := service.GetBracketRepositorier().
+		ReadList(ctx, daoPaginationer, daoBracketFilterer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBrackets, daoBrackets).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	omBrackets := make([]om.Bracketer, 0, len(daoBrackets))
+
+	for key, daoBracket := range daoBrackets {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldDAOBracket, daoBracket).
+			Debug(object.URIEmpty)
+
+		omBrackets = append(omBrackets, om.NewBracket(
+			daoBracket.GetAccount(),
+			daoBracket.GetEntryClientOID(),
+			daoBracket.GetExitClientOID(),
+			daoBracket.GetReason(),
+			daoBracket.GetSide(),
+			daoBracket.GetSize(),
+			daoBracket.GetState(),
+			daoBracket.GetStopClientOID(),
+			daoBracket.GetStopPrice(),
+			daoBracket.GetSymbol(),
+			daoBracket.GetTakeProfitClientOID(),
+			daoBracket.GetTakeProfitPrice(),
+			daoBracket.GetTrailingDistance(),
+			daoBracket.GetTrailingPrice(),
+			daoBracket.GetIsActive(),
+			daoBracket.GetPaper(),
+			daoBracket.GetID(),
+		))
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMBrackets, omBrackets).
+		Debug(object.URIEmpty)
+
+	return omBrackets, daoCursorer, nil
+}
+
+// Cancel is a function.
+// The legs still resting are cancelled and the bracket is closed; a position the
+// entry already opened is left unprotected.
+func (service *bracketService) Cancel(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.Bracketer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Cancel",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Cancel",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omBracketer, err := service.GetServicer().GetBracketServicer().Get(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketServiceGet.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketServiceGet.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMBracket, omBracketer).
+		Debug(object.URIEmpty)
+
+	if !omBracketer.GetIsActive() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`!omBracketer.GetIsActive()`)
+
+		return omBracketer, nil
+	}
+
+	clientOIDs := []string{omBracketer.GetTakeProfitClientOID()}
+	if object.BracketStateType(omBracketer.GetState()) == object.BracketStateTypeEntry {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`object.BracketStateType(omBracketer.GetState()) == object.BracketStateTypeEntry`)
+
+		clientOIDs = append(clientOIDs, omBracketer.GetEntryClientOID())
+	}
+
+	for _, clientOID := range clientOIDs {
+		if _, err = service.cancelOrder(ctx, clientOID); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrOrderServiceCancel.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrOrderServiceCancel.Error())
+
+			return nil, err
+		}
+	}
+
+	if err = service.cancelStopOrder(ctx, omBracketer.GetStopClientOID()); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServiceCancel.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceCancel.Error())
+
+		return nil, err
+	}
+
+	omBracketer, err = service.close(ctx, omBracketer, object.BracketReasonTypeCancelled)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketServiceUpdate.Error())
+
+		return nil, err
+	}
+
+	return omBracketer, nil
+}
+
+// GetByEntryClientOID is a function.
+func (service *bracketService) GetByEntryClientOID(
+	ctx context.Context,
+	entryClientOID string,
+) (om.Bracketer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetByEntryClientOID",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":             "GetByEntryClientOID",
+		"rt_ctx":           utilRuntimeContext,
+		"sp_ctx":           utilSpanContext,
+		"config":           service.configConfigger,
+		"entry_client_oid": entryClientOID,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omBracketers, _, err := service.GetServicer().GetBracketServicer().GetListFromRepository(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewBracketFilter(serviceAccount(ctx, service), entryClientOID, object.URIEmpty, false),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryReadList.Error())
+
+		return nil, err
+	}
+
+	if len(omBracketers) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrBracketNotFound).
+			Error(object.ErrBracketServiceGetByEntryClientOID.Error())
+		traceSpan.RecordError(object.ErrBracketNotFound)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketServiceGetByEntryClientOID.Error())
+
+		return nil, object.ErrBracketNotFound
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMBracket, omBracketers[0]).
+		Debug(object.URIEmpty)
+
+	return omBracketers[0], nil
+}
+
+// Place is a function.
+// The bracket is stored before its entry is placed, so the protective legs
+// follow the entry even across a restart. Placing the same entry again resubmits
+// nothing the exchange already knows. An entry without a clientOid needs an
+// intent time in the runtime context, as for the orders.
+func (service *bracketService) Place(
+	ctx context.Context,
+	dtoPlaceBracketRequester dto.PlaceBracketRequester,
+) (om.Bracketer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Place",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                        "Place",
+		"rt_ctx":                      utilRuntimeContext,
+		"sp_ctx":                      utilSpanContext,
+		"config":                      service.configConfigger,
+		"dto_place_bracket_requester": dtoPlaceBracketRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	dtoPlaceOrderRequester := dtoPlaceBracketRequester.GetEntry()
+	if dtoPlaceOrderRequester.GetClientOID() == object.URIEmpty &&
+		utilRuntimeContext.GetIntentAt() == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`dtoPlaceOrderRequester.GetClientOID() == object.URIEmpty && utilRuntimeContext.GetIntentAt() == 0`)
+
+		err := fmt.Errorf(
+			"%w: symbol %s",
+			object.ErrOrderClientOIDRequired,
+			dtoPlaceOrderRequester.GetSymbol(),
+		)
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderClientOIDRequired.Error())
+
+		return nil, err
+	}
+
+	if dtoPlaceOrderRequester.GetClientOID() == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`dtoPlaceOrderRequester.GetClientOID() == object.URIEmpty`)
+
+		dtoPlaceOrderRequester = orderServicePlaceOrderRequestWithClientOID(
+			serviceAccount(ctx, service),
+			utilRuntimeContext,
+			dtoPlaceOrderRequester,
+		)
+	}
+
+	if err := bracketServiceCheck(
+		dtoPlaceOrderRequester.GetSide(),
+		dtoPlaceBracketRequester.GetStopPrice(),
+		dtoPlaceBracketRequester.GetTakeProfitPrice(),
+	); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketServicePlace.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketServicePlace.Error())
+
+		return nil, err
+	}
+
+	omBracketer, err := service.GetServicer().
+		GetBracketServicer().
+		GetByEntryClientOID(ctx, dtoPlaceOrderRequester.GetClientOID())
+	if errors.Is(err, object.ErrBracketNotFound) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(err, object.ErrBracketNotFound)`)
+
+		omBracketer = bracketServiceBracketFromPlaceBracketRequest(
+			serviceAccount(ctx, service),
+			dtoPlaceOrderRequester,
+			dtoPlaceBracketRequester,
+			service.GetConfigger().GetPaperConfigger().GetEnabled(),
+			uuid.Nil,
+		)
+
+		var bracketID uuid.UUID
+
+		bracketID, err = service.GetServicer().GetBracketServicer().Create(ctx, omBracketer)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrBracketServiceCreate.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrBracketServiceCreate.Error())
+
+			return nil, err
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldBracketID, bracketID).
+			Debug(object.URIEmpty)
+
+		omBracketer, err = service.GetServicer().GetBracketServicer().Get(ctx, bracketID)
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketServiceGetByEntryClientOID.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketServiceGetByEntryClientOID.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMBracket, omBracketer).
+		Debug(object.URIEmpty)
+
+	if object.BracketStateType(omBracketer.GetState()) != object.BracketStateTypeEntry {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`object.BracketStateType(omBracketer.GetState()) != object.BracketStateTypeEntry`)
+
+		return omBracketer, nil
+	}
+
+	omOrderers, err := service.GetServicer().GetOrderServicer().Place(ctx, dtoPlaceOrderRequester)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServicePlace.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServicePlace.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrders, omOrderers).
+		Debug(object.URIEmpty)
+
+	omBracketer, err = service.GetServicer().GetBracketServicer().Reconcile(ctx, omBracketer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketServiceReconcile.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketServiceReconcile.Error())
+
+		return nil, err
+	}
+
+	return omBracketer, nil
+}
+
+// Reconcile is a function.
+// It moves the bracket forward from what the exchange reports: a filled entry
+// gets its take-profit and stop legs, a filled leg cancels the other one, and a
+// resting stop follows the last price when the bracket trails.
+func (service *bracketService) Reconcile(
+	ctx context.Context,
+	omBracketer om.Bracketer,
+) (om.Bracketer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Reconcile",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "Reconcile",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       service.configConfigger,
+		"om_bracketer": omBracketer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	var err error
+
+	switch object.BracketStateType(omBracketer.GetState()) {
+	case object.BracketStateTypeEntry:
+		omBracketer, err = service.fill(ctx, omBracketer)
+	case object.BracketStateTypeProtected:
+		omBracketer, err = service.protect(ctx, omBracketer)
+	case object.BracketStateTypeExit:
+		omBracketer, err = service.exit(ctx, omBracketer)
+	case object.BracketStateTypeClosed:
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketServiceReconcile.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketServiceReconcile.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMBracket, omBracketer).
+		Debug(object.URIEmpty)
+
+	return omBracketer, nil
+}
+
+// Sync is a function.
+// Every stored active bracket is reconciled, even when an earlier one fails.
+func (service *bracketService) Sync(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Sync",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Sync",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omActiveBracketers := make([]om.Bracketer, 0)
+	errs := make([]error, 0)
+
+	// Closed brackets leave the active filter, so every page is read before
+	// any bracket is reconciled.
+	var daoCursorer dao.Cursorer = dao.NewCursor(0)
+
+	for daoCursorer != nil {
+		omBracketersPage, daoNextCursorer, errGetList := service.GetServicer().
+			GetBracketServicer().
+			GetListFromRepository(
+				ctx,
+				dao.NewPagination(daoCursorer, object.NUMKucoinRecentOrderCount),
+				dao.NewBracketFilter(
+					serviceAccount(ctx, service),
+					object.URIEmpty,
+					object.URIEmpty,
+					true,
+				),
+			)
+		if errGetList != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errGetList).
+				Error(object.ErrBracketRepositoryReadList.Error())
+			traceSpan.RecordError(errGetList)
+			traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryReadList.Error())
+
+			return errGetList
+		}
+
+		daoCursorer = daoNextCursorer
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMBrackets, omBracketersPage).
+			WithField(object.URIFieldDAOCursor, daoCursorer).
+			Debug(object.URIEmpty)
+
+		omActiveBracketers = append(omActiveBracketers, omBracketersPage...)
+	}
+
+	for _, omBracketer := range omActiveBracketers {
+		omReconciledBracketer, errReconcile := service.GetServicer().
+			GetBracketServicer().
+			Reconcile(ctx, omBracketer)
+		if errReconcile != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errReconcile).
+				Error(object.ErrBracketServiceReconcile.Error())
+
+			errs = append(errs, errReconcile)
+
+			continue
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMBracket, omReconciledBracketer).
+			Debug(object.URIEmpty)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketServiceSync.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketServiceSync.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// Update is a function.
+func (service *bracketService) Update(
+	ctx context.Context,
+	omBracketer om.Bracketer,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Update",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "Update",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       service.configConfigger,
+		"om_bracketer": omBracketer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoBracketer, err := service.GetBracketRepositorier().Read(ctx, omBracketer.GetID())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryRead.Error())
+
+		return time.Time{}, err
+	}
+
+	daoBracket := dao.NewBracket(
+		daoBracketer.GetCreatedAt(),
+		time.Time{},
+		daoBracketer.GetDeletedAt(),
+		omBracketer.GetID(),
+		omBracketer.GetAccount(),
+		omBracketer.GetEntryClientOID(),
+		omBracketer.GetExitClientOID(),
+		omBracketer.GetReason(),
+		omBracketer.GetSide(),
+		omBracketer.GetSize(),
+		omBracketer.GetState(),
+		omBracketer.GetStopClientOID(),
+		omBracketer.GetStopPrice(),
+		omBracketer.GetSymbol(),
+		omBracketer.GetTakeProfitClientOID(),
+		omBracketer.GetTakeProfitPrice(),
+		omBracketer.GetTrailingDistance(),
+		omBracketer.GetTrailingPrice(),
+		omBracketer.GetIsActive(),
+		daoBracketer.GetPaper(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOBracket, daoBracket).
+		Debug(object.URIEmpty)
+
+	updatedAt, err := service.GetBracketRepositorier().Update(ctx, daoBracket)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketRepositoryUpdate.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return updatedAt, nil
+}
+
+// cancelOrder cancels the order while it rests and returns it as the exchange
+// reports it afterwards, or nil when no order has the clientOid.
+func (service *bracketService) cancelOrder(
+	ctx context.Context,
+	clientOID string,
+) (om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"cancelOrder",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "cancelOrder",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"client_oid": clientOID,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if clientOID == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`clientOID == object.URIEmpty`)
+
+		return nil, nil
+	}
+
+	omOrderer, err := service.GetServicer().GetOrderServicer().GetByClientOID(ctx, clientOID)
+	if errors.Is(err, object.ErrOrderNotFound) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(err, object.ErrOrderNotFound)`)
+
+		return nil, nil
+	}
+
+	if err == nil && omOrderer.GetIsActive() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`err == nil && omOrderer.GetIsActive()`)
+
+		if _, err = service.GetServicer().
+			GetOrderServicer().
+			Cancel(ctx, omOrderer.GetID()); errors.Is(err, object.ErrOrderRejected) {
+			err = nil
+		}
+	}
+
+	if err == nil {
+		omOrderer, err = service.GetServicer().GetOrderServicer().Reconcile(ctx, omOrderer)
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceCancel.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceCancel.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omOrderer).
+		Debug(object.URIEmpty)
+
+	return omOrderer, nil
+}
+
+// cancelStopOrder cancels the stop order while it waits for its stop price.
+func (service *bracketService) cancelStopOrder(
+	ctx context.Context,
+	clientOID string,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"cancelStopOrder",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "cancelStopOrder",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"client_oid": clientOID,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omStopOrderer, err := service.GetServicer().
+		GetStopOrderServicer().
+		GetByClientOID(ctx, clientOID)
+	if errors.Is(err, object.ErrStopOrderNotFound) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(err, object.ErrStopOrderNotFound)`)
+
+		return nil
+	}
+
+	if err == nil && omStopOrderer.GetIsActive() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`err == nil && omStopOrderer.GetIsActive()`)
+
+		if _, err = service.GetServicer().
+			GetStopOrderServicer().
+			Cancel(ctx, omStopOrderer.GetID()); errors.Is(err, object.ErrOrderRejected) {
+			err = nil
+		}
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServiceCancel.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceCancel.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func (service *bracketService) close(
+	ctx context.Context,
+	omBracketer om.Bracketer,
+	reason object.BracketReasonType,
+) (om.Bracketer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"close",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "close",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       service.configConfigger,
+		"om_bracketer": omBracketer,
+		"reason":       reason,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omBracketer = bracketServiceBracketWithState(
+		omBracketer,
+		object.BracketStateTypeClosed,
+		reason,
+		omBracketer.GetSize(),
+		omBracketer.GetExitClientOID(),
+		false,
+	)
+
+	updatedAt, err := service.GetServicer().GetBracketServicer().Update(ctx, omBracketer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketServiceUpdate.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return omBracketer, nil
+}
+
+// exit sells what the legs left, when a triggered stop could not fill or a
+// take-profit only partly filled. In the exit state the size is what is left.
+func (service *bracketService) exit(
+	ctx context.Context,
+	omBracketer om.Bracketer,
+) (om.Bracketer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"exit",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "exit",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       service.configConfigger,
+		"om_bracketer": omBracketer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	reason := object.BracketReasonType(omBracketer.GetReason())
+
+	// Placing the exit again resubmits nothing the exchange already knows.
+	omOrderers, err := service.GetServicer().
+		GetOrderServicer().
+		Place(bracketServiceReduceOnly(ctx), bracketServiceExitRequest(omBracketer))
+	if errors.Is(err, object.ErrOrderSizeBelowMinimum) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(err, object.ErrOrderSizeBelowMinimum)`)
+
+		return service.close(ctx, omBracketer, reason)
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServicePlace.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServicePlace.Error())
+
+		return nil, err
+	}
+
+	omOrderer, err := service.GetServicer().GetOrderServicer().Reconcile(ctx, omOrderers[0])
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceReconcile.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceReconcile.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omOrderer).
+		Debug(object.URIEmpty)
+
+	if omOrderer.GetIsActive() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omOrderer.GetIsActive()`)
+
+		return omBracketer, nil
+	}
+
+	return service.close(ctx, omBracketer, reason)
+}
+
+// fill waits for the entry to finish; whatever it filled is what the legs
+// protect.
+func (service *bracketService) fill(
+	ctx context.Context,
+	omBracketer om.Bracketer,
+) (om.Bracketer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"fill",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "fill",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       service.configConfigger,
+		"om_bracketer": omBracketer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omOrderer, err := service.GetServicer().
+		GetOrderServicer().
+		GetByClientOID(ctx, omBracketer.GetEntryClientOID())
+	if errors.Is(err, object.ErrOrderNotFound) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(err, object.ErrOrderNotFound)`)
+
+		return omBracketer, nil
+	}
+
+	if err == nil {
+		omOrderer, err = service.GetServicer().GetOrderServicer().Reconcile(ctx, omOrderer)
+	}
+
+	// The exchange never received an entry that is still pending.
+	if errors.Is(err, object.ErrOrderNotFound) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(err, object.ErrOrderNotFound)`)
+
+		return service.close(ctx, omBracketer, object.BracketReasonTypeUnfilled)
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceReconcile.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceReconcile.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omOrderer).
+		Debug(object.URIEmpty)
+
+	if omOrderer.GetIsActive() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omOrderer.GetIsActive()`)
+
+		return omBracketer, nil
+	}
+
+	if !bracketServicePositive(omOrderer.GetDealSize()) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`!bracketServicePositive(omOrderer.GetDealSize())`)
+
+		return service.close(ctx, omBracketer, object.BracketReasonTypeUnfilled)
+	}
+
+	omBracketer = bracketServiceBracketWithState(
+		omBracketer,
+		object.BracketStateTypeProtected,
+		object.BracketReasonType(object.URIEmpty),
+		omOrderer.GetDealSize(),
+		object.URIEmpty,
+		true,
+	)
+
+	updatedAt, err := service.GetServicer().GetBracketServicer().Update(ctx, omBracketer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketServiceUpdate.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return service.protect(ctx, omBracketer)
+}
+
+// leave hands what the legs did not fill to exit, or closes the bracket when
+// nothing is left.
+func (service *bracketService) leave(
+	ctx context.Context,
+	omBracketer om.Bracketer,
+	reason object.BracketReasonType,
+	dealSizes ...string,
+) (om.Bracketer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"leave",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "leave",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       service.configConfigger,
+		"om_bracketer": omBracketer,
+		"reason":       reason,
+		"deal_sizes":   dealSizes,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	size := omBracketer.GetSize()
+
+	for _, dealSize := range dealSizes {
+		if !bracketServicePositive(dealSize) {
+			continue
+		}
+
+		var err error
+
+		size, err = util.DecimalSubtract(size, dealSize)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrDecimalParse.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrDecimalParse.Error())
+
+			return nil, err
+		}
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldSize, size).
+		Debug(object.URIEmpty)
+
+	if !bracketServicePositive(size) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`!bracketServicePositive(size)`)
+
+		return service.close(ctx, omBracketer, reason)
+	}
+
+	omBracketer = bracketServiceBracketWithState(
+		omBracketer,
+		object.BracketStateTypeExit,
+		reason,
+		size,
+		bracketServiceClientOID(omBracketer.GetEntryClientOID(), object.URIBracketLegExit),
+		true,
+	)
+
+	updatedAt, err := service.GetServicer().GetBracketServicer().Update(ctx, omBracketer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketServiceUpdate.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return service.exit(ctx, omBracketer)
+}
+
+// protect keeps both legs on the exchange until one of them fills. A triggered
+// stop cancels the take-profit, which also releases the size it holds, and a
+// filled take-profit cancels the stop; a leg cancelled outside the bracket
+// closes it.
+func (service *bracketService) protect(
+	ctx context.Context,
+	omBracketer om.Bracketer,
+) (om.Bracketer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"protect",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "protect",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       service.configConfigger,
+		"om_bracketer": omBracketer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omStopOrderer, omTriggeredOrderer, err := service.stop(ctx, omBracketer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServicePlace.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServicePlace.Error())
+
+		return nil, err
+	}
+
+	if omTriggeredOrderer != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMOrder, omTriggeredOrderer).
+			Debug(`omTriggeredOrderer != nil`)
+
+		omTakeProfitOrderer, errCancel := service.cancelOrder(
+			ctx,
+			omBracketer.GetTakeProfitClientOID(),
+		)
+		if errCancel != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errCancel).
+				Error(object.ErrOrderServiceCancel.Error())
+			traceSpan.RecordError(errCancel)
+			traceSpan.SetStatus(codes.Error, object.ErrOrderServiceCancel.Error())
+
+			return nil, errCancel
+		}
+
+		if omTriggeredOrderer.GetIsActive() {
+			return omBracketer, nil
+		}
+
+		dealSizes := []string{omTriggeredOrderer.GetDealSize()}
+		if omTakeProfitOrderer != nil {
+			dealSizes = append(dealSizes, omTakeProfitOrderer.GetDealSize())
+		}
+
+		return service.leave(ctx, omBracketer, object.BracketReasonTypeStopLoss, dealSizes...)
+	}
+
+	omTakeProfitOrderer, err := service.takeProfit(ctx, omBracketer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServicePlace.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServicePlace.Error())
+
+		return nil, err
+	}
+
+	if omTakeProfitOrderer != nil && !omTakeProfitOrderer.GetIsActive() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMOrder, omTakeProfitOrderer).
+			Debug(`omTakeProfitOrderer != nil && !omTakeProfitOrderer.GetIsActive()`)
+
+		if err = service.cancelStopOrder(ctx, omBracketer.GetStopClientOID()); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrStopOrderServiceCancel.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceCancel.Error())
+
+			return nil, err
+		}
+
+		if !bracketServicePositive(omTakeProfitOrderer.GetDealSize()) {
+			return service.close(ctx, omBracketer, object.BracketReasonTypeCancelled)
+		}
+
+		return service.leave(
+			ctx,
+			omBracketer,
+			object.BracketReasonTypeTakeProfit,
+			omTakeProfitOrderer.GetDealSize(),
+		)
+	}
+
+	if !omStopOrderer.GetIsActive() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMStopOrder, omStopOrderer).
+			Debug(`!omStopOrderer.GetIsActive()`)
+
+		if _, err = service.cancelOrder(ctx, omBracketer.GetTakeProfitClientOID()); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrOrderServiceCancel.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrOrderServiceCancel.Error())
+
+			return nil, err
+		}
+
+		return service.close(ctx, omBracketer, object.BracketReasonTypeCancelled)
+	}
+
+	return service.trail(ctx, omBracketer, omStopOrderer)
+}
+
+// stop returns the stop leg while it waits for its stop price, placing it when
+// the exchange does not know it yet, or the order it became once triggered.
+func (service *bracketService) stop(
+	ctx context.Context,
+	omBracketer om.Bracketer,
+) (om.StopOrderer, om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"stop",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "stop",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       service.configConfigger,
+		"om_bracketer": omBracketer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	clientOID := omBracketer.GetStopClientOID()
+
+	_, err := service.GetServicer().
+		GetStopOrderServicer().
+		GetByClientOID(ctx, clientOID)
+	if errors.Is(err, object.ErrStopOrderNotFound) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(err, object.ErrStopOrderNotFound)`)
+
+		// A triggered stop order is only stored as an order.
+		omOrderer, errOrder := service.GetServicer().
+			GetOrderServicer().
+			GetByClientOID(ctx, clientOID)
+		if errOrder == nil {
+			omOrderer, errOrder = service.GetServicer().
+				GetOrderServicer().
+				Reconcile(ctx, omOrderer)
+		}
+
+		if !errors.Is(errOrder, object.ErrOrderNotFound) {
+			if errOrder != nil {
+				service.GetRuntimeLogger().
+					WithFields(fields).
+					WithField(object.URIFieldError, errOrder).
+					Error(object.ErrOrderServiceReconcile.Error())
+				traceSpan.RecordError(errOrder)
+				traceSpan.SetStatus(codes.Error, object.ErrOrderServiceReconcile.Error())
+			}
+
+			return nil, omOrderer, errOrder
+		}
+
+		err = nil
+	}
+
+	var omStopOrderer om.StopOrderer
+
+	// Placing the leg again resubmits nothing the exchange already knows.
+	if err == nil {
+		omStopOrderer, err = service.GetServicer().
+			GetStopOrderServicer().
+			Place(bracketServiceReduceOnly(ctx), bracketServiceStopRequest(omBracketer))
+	}
+
+	if err == nil && omStopOrderer.GetStatus() != object.URIKucoinStopOrderStatusTriggered {
+		omStopOrderer, err = service.GetServicer().
+			GetStopOrderServicer().
+			Reconcile(ctx, omStopOrderer)
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServicePlace.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServicePlace.Error())
+
+		return nil, nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMStopOrder, omStopOrderer).
+		Debug(object.URIEmpty)
+
+	if omStopOrderer.GetStatus() != object.URIKucoinStopOrderStatusTriggered {
+		return omStopOrderer, nil, nil
+	}
+
+	omOrderer, err := service.GetServicer().GetOrderServicer().GetByClientOID(ctx, clientOID)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceGetByClientOID.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceGetByClientOID.Error())
+
+		return nil, nil, err
+	}
+
+	return nil, omOrderer, nil
+}
+
+// takeProfit returns the take-profit leg, placing it when the exchange does not
+// know it yet, or nil when the bracket has none.
+func (service *bracketService) takeProfit(
+	ctx context.Context,
+	omBracketer om.Bracketer,
+) (om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"takeProfit",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "takeProfit",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       service.configConfigger,
+		"om_bracketer": omBracketer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if omBracketer.GetTakeProfitClientOID() == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omBracketer.GetTakeProfitClientOID() == object.URIEmpty`)
+
+		return nil, nil
+	}
+
+	var omOrderer om.Orderer
+
+	// Placing the leg again resubmits nothing the exchange already knows.
+	omOrderers, err := service.GetServicer().
+		GetOrderServicer().
+		Place(bracketServiceReduceOnly(ctx), bracketServiceTakeProfitRequest(omBracketer))
+	if err == nil {
+		omOrderer, err = service.GetServicer().GetOrderServicer().Reconcile(ctx, omOrderers[0])
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServicePlace.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServicePlace.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omOrderer).
+		Debug(object.URIEmpty)
+
+	return omOrderer, nil
+}
+
+// trail ratchets the stop after the best last price seen since the entry
+// filled. The stop only moves towards the price, by at least one price
+// increment; the new stop is recorded once the old one is cancelled, so a restart
+// still places it.
+func (service *bracketService) trail(
+	ctx context.Context,
+	omBracketer om.Bracketer,
+	omStopOrderer om.StopOrderer,
+) (om.Bracketer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"trail",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "trail",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_bracketer":    omBracketer,
+		"om_stop_orderer": omStopOrderer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if omBracketer.GetTrailingDistance() == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omBracketer.GetTrailingDistance() == object.URIEmpty`)
+
+		return omBracketer, nil
+	}
+
+	omTickerer, err := service.GetServicer().
+		GetTickerServicer().
+		GetBySymbol(ctx, omBracketer.GetSymbol())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrTickerServiceGetBySymbol.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrTickerServiceGetBySymbol.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMTicker, omTickerer).
+		Debug(object.URIEmpty)
+
+	if omTickerer.GetLast() == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omTickerer.GetLast() == object.URIEmpty`)
+
+		return omBracketer, nil
+	}
+
+	omSymboler, err := service.GetServicer().
+		GetSymbolServicer().
+		GetBySymbol(ctx, omBracketer.GetSymbol())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolServiceGetBySymbol.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceGetBySymbol.Error())
+
+		return nil, err
+	}
+
+	side := object.OrderSideType(omBracketer.GetSide())
+
+	trailingPrice, stopPrice, err := bracketServiceTrail(
+		side,
+		omBracketer.GetTrailingPrice(),
+		omTickerer.GetLast(),
+		omBracketer.GetTrailingDistance(),
+	)
+	if err == nil {
+		stopPrice, err = symbolServiceRound(
+			stopPrice,
+			omSymboler.GetPriceIncrement(),
+			bracketServiceExitSide(side) == object.OrderSideTypeSell,
+		)
+	}
+
+	var improved bool
+
+	if err == nil {
+		improved, err = bracketServiceImproved(side, stopPrice, omBracketer.GetStopPrice())
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrDecimalParse.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrDecimalParse.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldTrailingPrice, trailingPrice).
+		WithField(object.URIFieldStopPrice, stopPrice).
+		Debug(object.URIEmpty)
+
+	if !improved && trailingPrice == omBracketer.GetTrailingPrice() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`!improved && trailingPrice == omBracketer.GetTrailingPrice()`)
+
+		return omBracketer, nil
+	}
+
+	if !improved {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`!improved`)
+
+		stopPrice = omBracketer.GetStopPrice()
+	} else {
+		if _, err = service.GetServicer().
+			GetStopOrderServicer().
+			Cancel(ctx, omStopOrderer.GetID()); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrStopOrderServiceCancel.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceCancel.Error())
+
+			return nil, err
+		}
+	}
+
+	omBracketer = bracketServiceBracketWithStop(
+		omBracketer,
+		bracketServiceClientOID(
+			omBracketer.GetEntryClientOID(),
+			object.URIBracketLegStop,
+			stopPrice,
+		),
+		stopPrice,
+		trailingPrice,
+	)
+
+	updatedAt, err := service.GetServicer().GetBracketServicer().Update(ctx, omBracketer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBracketServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBracketServiceUpdate.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	if !improved {
+		return omBracketer, nil
+	}
+
+	omStopOrderer, err = service.GetServicer().
+		GetStopOrderServicer().
+		Place(bracketServiceReduceOnly(ctx), bracketServiceStopRequest(omBracketer))
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServicePlace.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServicePlace.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMStopOrder, omStopOrderer).
+		Debug(object.URIEmpty)
+
+	return omBracketer, nil
+}
+
+// bracketServiceBracketFromPlaceBracketRequest builds the bracket of an entry
+// that has not filled yet.
+func bracketServiceBracketFromPlaceBracketRequest(
+	account string,
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+	dtoPlaceBracketRequester dto.PlaceBracketRequester,
+	paper bool,
+	id uuid.UUID,
+) om.Bracketer {
+	takeProfitClientOID := object.URIEmpty
+	if dtoPlaceBracketRequester.GetTakeProfitPrice() != object.URIEmpty {
+		takeProfitClientOID = bracketServiceClientOID(
+			dtoPlaceOrderRequester.GetClientOID(),
+			object.URIBracketLegTakeProfit,
+		)
+	}
+
+	return om.NewBracket(
+		account,
+		dtoPlaceOrderRequester.GetClientOID(),
+		object.URIEmpty,
+		object.URIEmpty,
+		string(dtoPlaceOrderRequester.GetSide()),
+		object.URIEmpty,
+		string(object.BracketStateTypeEntry),
+		bracketServiceClientOID(
+			dtoPlaceOrderRequester.GetClientOID(),
+			object.URIBracketLegStop,
+			dtoPlaceBracketRequester.GetStopPrice(),
+		),
+		dtoPlaceBracketRequester.GetStopPrice(),
+		dtoPlaceOrderRequester.GetSymbol(),
+		takeProfitClientOID,
+		dtoPlaceBracketRequester.GetTakeProfitPrice(),
+		dtoPlaceBracketRequester.GetTrailingDistance(),
+		object.URIEmpty,
+		true,
+		paper,
+		id,
+	)
+}
+
+func bracketServiceBracketWithState(
+	omBracketer om.Bracketer,
+	state object.BracketStateType,
+	reason object.BracketReasonType,
+	size string,
+	exitClientOID string,
+	isActive bool,
+) om.Bracketer {
+	return om.NewBracket(
+		omBracketer.GetAccount(),
+		omBracketer.GetEntryClientOID(),
+		exitClientOID,
+		string(reason),
+		omBracketer.GetSide(),
+		size,
+		string(state),
+		omBracketer.GetStopClientOID(),
+		omBracketer.GetStopPrice(),
+		omBracketer.GetSymbol(),
+		omBracketer.GetTakeProfitClientOID(),
+		omBracketer.GetTakeProfitPrice(),
+		omBracketer.GetTrailingDistance(),
+		omBracketer.GetTrailingPrice(),
+		isActive,
+		omBracketer.GetPaper(),
+		omBracketer.GetID(),
+	)
+}
+
+func bracketServiceBracketWithStop(
+	omBracketer om.Bracketer,
+	stopClientOID string,
+	stopPrice string,
+	trailingPrice string,
+) om.Bracketer {
+	return om.NewBracket(
+		omBracketer.GetAccount(),
+		omBracketer.GetEntryClientOID(),
+		omBracketer.GetExitClientOID(),
+		omBracketer.GetReason(),
+		omBracketer.GetSide(),
+		omBracketer.GetSize(),
+		omBracketer.GetState(),
+		stopClientOID,
+		stopPrice,
+		omBracketer.GetSymbol(),
+		omBracketer.GetTakeProfitClientOID(),
+		omBracketer.GetTakeProfitPrice(),
+		omBracketer.GetTrailingDistance(),
+		trailingPrice,
+		omBracketer.GetIsActive(),
+		omBracketer.GetPaper(),
+		omBracketer.GetID(),
+	)
+}
+
+// bracketServiceCheck requires a stop price, on the losing side of the
+// take-profit price when there is one.
+func bracketServiceCheck(
+	side object.OrderSideType,
+	stopPrice string,
+	takeProfitPrice string,
+) error {
+	if !bracketServicePositive(stopPrice) {
+		return fmt.Errorf("%w: stop price %s", object.ErrOrderPriceInvalid, stopPrice)
+	}
+
+	if takeProfitPrice == object.URIEmpty {
+		return nil
+	}
+
+	improved, err := bracketServiceImproved(side, takeProfitPrice, stopPrice)
+	if err != nil || !improved {
+		return fmt.Errorf(
+			"%w: take profit price %s against stop price %s",
+			object.ErrOrderPriceInvalid,
+			takeProfitPrice,
+			stopPrice,
+		)
+	}
+
+	return nil
+}
+
+// bracketServiceClientOID derives the clientOid of a leg from the clientOid of
+// the entry, so every leg is placed at most once.
+func bracketServiceClientOID(
+	entryClientOID string,
+	parts ...string,
+) string {
+	return util.ClientOID(append([]string{entryClientOID}, parts...)...)
+}
+
+func bracketServiceExitRequest(
+	omBracketer om.Bracketer,
+) dto.PlaceOrderRequester {
+	return dto.NewPlaceOrderRequest(
+		omBracketer.GetExitClientOID(),
+		object.URIEmpty,
+		object.OrderTypeTypeMarket,
+		object.URIEmpty,
+		object.URIEmpty,
+		bracketServiceExitSide(object.OrderSideType(omBracketer.GetSide())),
+		omBracketer.GetSize(),
+		object.URIEmpty,
+		omBracketer.GetSymbol(),
+		object.TimeInForceType(object.URIEmpty),
+		object.OrderTypeTypeTrade,
+		object.URIEmpty,
+		0,
+		false,
+		false,
+		false,
+	)
+}
+
+func bracketServiceExitSide(
+	side object.OrderSideType,
+) object.OrderSideType {
+	if side == object.OrderSideTypeBuy {
+		return object.OrderSideTypeSell
+	}
+
+	return object.OrderSideTypeBuy
+}
+
+// bracketServiceImproved reports whether the price is better than the other one
+// for a position opened on the side: higher after a buy, lower after a sell.
+func bracketServiceImproved(
+	side object.OrderSideType,
+	price string,
+	other string,
+) (bool, error) {
+	compare, err := util.DecimalCompare(price, other)
+	if err != nil {
+		return false, err
+	}
+
+	if side == object.OrderSideTypeBuy {
+		return compare > 0, nil
+	}
+
+	return compare < 0, nil
+}
+
+func bracketServicePositive(
+	value string,
+) bool {
+	if value == object.URIEmpty {
+		return false
+	}
+
+	compare, err := util.DecimalCompare(value, "0")
+
+	return err == nil && compare > 0
+}
+
+// bracketServiceReduceOnly marks the context of a leg as reduce-only, since a
+// leg only closes the position the entry opened. The kill switch and a paused
+// exchange let it through, so an open position is not left unprotected.
+func bracketServiceReduceOnly(
+	ctx context.Context,
+) context.Context {
+	return util.WithRuntimeContextValue(ctx, object.URIRuntimeContextReduceOnly, true)
+}
+
+// bracketServiceStopRequest builds the stop leg as a market order. After a buy
+// it is a loss stop that sells once the price falls to the stop price, after a
+// sell an entry stop that buys once the price rises to it.
+func bracketServiceStopRequest(
+	omBracketer om.Bracketer,
+) dto.PlaceStopOrderRequester {
+	stop := object.OrderStopTypeEntry
+	if object.OrderSideType(omBracketer.GetSide()) == object.OrderSideTypeBuy {
+		stop = object.OrderStopTypeLoss
+	}
+
+	return dto.NewPlaceStopOrderRequest(
+		omBracketer.GetStopClientOID(),
+		object.URIEmpty,
+		object.OrderTypeTypeMarket,
+		object.URIEmpty,
+		object.URIEmpty,
+		bracketServiceExitSide(object.OrderSideType(omBracketer.GetSide())),
+		omBracketer.GetSize(),
+		stop,
+		omBracketer.GetStopPrice(),
+		object.URIEmpty,
+		omBracketer.GetSymbol(),
+		object.TimeInForceType(object.URIEmpty),
+		object.OrderTypeTypeTrade,
+		object.URIEmpty,
+		0,
+		false,
+		false,
+		false,
+	)
+}
+
+func bracketServiceTakeProfitRequest(
+	omBracketer om.Bracketer,
+) dto.PlaceOrderRequester {
+	return dto.NewPlaceOrderRequest(
+		omBracketer.GetTakeProfitClientOID(),
+		object.URIEmpty,
+		object.OrderTypeTypeLimit,
+		omBracketer.GetTakeProfitPrice(),
+		object.URIEmpty,
+		bracketServiceExitSide(object.OrderSideType(omBracketer.GetSide())),
+		omBracketer.GetSize(),
+		object.URIEmpty,
+		omBracketer.GetSymbol(),
+		object.TimeInForceTypeGTC,
+		object.OrderTypeTypeTrade,
+		object.URIEmpty,
+		0,
+		false,
+		false,
+		false,
+	)
+}
+
+// bracketServiceTrail returns the best last price seen so far and the stop
+// price at the distance from it.
+func bracketServiceTrail(
+	side object.OrderSideType,
+	trailingPrice string,
+	last string,
+	distance string,
+) (string, string, error) {
+	if trailingPrice != object.URIEmpty {
+		improved, err := bracketServiceImproved(side, last, trailingPrice)
+		if err != nil {
+			return object.URIEmpty, object.URIEmpty, err
+		}
+
+		if improved {
+			trailingPrice = last
+		}
+	} else {
+		trailingPrice = last
+	}
+
+	if side == object.OrderSideTypeBuy {
+		stopPrice, err := util.DecimalSubtract(trailingPrice, distance)
+
+		return trailingPrice, stopPrice, err
+	}
+
+	stopPrice, err := util.DecimalAdd(trailingPrice, distance)
+
+	return trailingPrice, stopPrice, err
+}
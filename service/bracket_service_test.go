@@ -0,0 +1,772 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type (
+	bracketServiceTestServicer struct {
+		Servicer
+		bracketServicer   BracketServicer
+		orderServicer     OrderServicer
+		stopOrderServicer StopOrderServicer
+		symbolServicer    SymbolServicer
+		tickerServicer    TickerServicer
+	}
+
+	bracketServiceTestBracketServicer struct {
+		BracketServicer
+		omBracketers []om.Bracketer
+		mutex        sync.Mutex
+	}
+
+	// bracketServiceTestOrderServicer is the exchange as the bracket sees it
+	// through the orders: an order is known by its clientOid and placing it
+	// again returns it unchanged.
+	bracketServiceTestOrderServicer struct {
+		OrderServicer
+		ids               map[string]uuid.UUID
+		kucoinOrderModels map[string]*kucoin.OrderModel
+		mutex             sync.Mutex
+	}
+
+	// bracketServiceTestStopOrderServicer is the exchange as the bracket sees it
+	// through the stop orders.
+	bracketServiceTestStopOrderServicer struct {
+		StopOrderServicer
+		omStopOrderers map[string]om.StopOrderer
+		mutex          sync.Mutex
+	}
+
+	bracketServiceTestSymbolServicer struct {
+		SymbolServicer
+	}
+
+	bracketServiceTestTickerServicer struct {
+		TickerServicer
+		last string
+	}
+)
+
+// GetBracketServicer is a function.
+func (servicer *bracketServiceTestServicer) GetBracketServicer() BracketServicer {
+	return servicer.bracketServicer
+}
+
+// GetOrderServicer is a function.
+func (servicer *bracketServiceTestServicer) GetOrderServicer() OrderServicer {
+	return servicer.orderServicer
+}
+
+// GetStopOrderServicer is a function.
+func (servicer *bracketServiceTestServicer) GetStopOrderServicer() StopOrderServicer {
+	return servicer.stopOrderServicer
+}
+
+// GetSymbolServicer is a function.
+func (servicer *bracketServiceTestServicer) GetSymbolServicer() SymbolServicer {
+	return servicer.symbolServicer
+}
+
+// GetTickerServicer is a function.
+func (servicer *bracketServiceTestServicer) GetTickerServicer() TickerServicer {
+	return servicer.tickerServicer
+}
+
+// Update is a function.
+func (servicer *bracketServiceTestBracketServicer) Update(
+	_ context.Context,
+	omBracketer om.Bracketer,
+) (time.Time, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	servicer.omBracketers = append(servicer.omBracketers, omBracketer)
+
+	return time.Time{}, nil
+}
+
+// Cancel is a function.
+func (servicer *bracketServiceTestOrderServicer) Cancel(
+	_ context.Context,
+	id uuid.UUID,
+) (om.Orderer, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	for clientOID, kucoinOrderModel := range servicer.kucoinOrderModels {
+		if servicer.ids[clientOID] == id {
+			kucoinOrderModel.IsActive = false
+			kucoinOrderModel.CancelExist = true
+
+			return servicer.get(clientOID), nil
+		}
+	}
+
+	return nil, object.ErrOrderNotFound
+}
+
+// GetByClientOID is a function.
+func (servicer *bracketServiceTestOrderServicer) GetByClientOID(
+	_ context.Context,
+	clientOID string,
+) (om.Orderer, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	if _, ok := servicer.kucoinOrderModels[clientOID]; !ok {
+		return nil, object.ErrOrderNotFound
+	}
+
+	return servicer.get(clientOID), nil
+}
+
+// Place is a function.
+func (servicer *bracketServiceTestOrderServicer) Place(
+	_ context.Context,
+	dtoPlaceOrderRequesters ...dto.PlaceOrderRequester,
+) ([]om.Orderer, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	omOrderers := make([]om.Orderer, 0, len(dtoPlaceOrderRequesters))
+
+	for _, dtoPlaceOrderRequester := range dtoPlaceOrderRequesters {
+		clientOID := dtoPlaceOrderRequester.GetClientOID()
+		if _, ok := servicer.kucoinOrderModels[clientOID]; !ok {
+			servicer.set(&kucoin.OrderModel{
+				Id:        clientOID,
+				ClientOid: clientOID,
+				Symbol:    dtoPlaceOrderRequester.GetSymbol(),
+				Type:      string(dtoPlaceOrderRequester.GetOrderType()),
+				Side:      string(dtoPlaceOrderRequester.GetSide()),
+				Price:     dtoPlaceOrderRequester.GetPrice(),
+				Size:      dtoPlaceOrderRequester.GetSize(),
+				DealSize:  "0",
+				IsActive:  true,
+			})
+		}
+
+		omOrderers = append(omOrderers, servicer.get(clientOID))
+	}
+
+	return omOrderers, nil
+}
+
+// Reconcile is a function.
+func (servicer *bracketServiceTestOrderServicer) Reconcile(
+	_ context.Context,
+	omOrderer om.Orderer,
+) (om.Orderer, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	return servicer.get(omOrderer.GetClientOID()), nil
+}
+
+func (servicer *bracketServiceTestOrderServicer) get(
+	clientOID string,
+) om.Orderer {
+	return orderServiceOrderFromModel(
+		object.URIEmpty,
+		servicer.kucoinOrderModels[clientOID],
+		false,
+		servicer.ids[clientOID],
+	)
+}
+
+func (servicer *bracketServiceTestOrderServicer) set(
+	kucoinOrderModel *kucoin.OrderModel,
+) {
+	if _, ok := servicer.ids[kucoinOrderModel.ClientOid]; !ok {
+		servicer.ids[kucoinOrderModel.ClientOid] = uuid.New()
+	}
+
+	servicer.kucoinOrderModels[kucoinOrderModel.ClientOid] = kucoinOrderModel
+}
+
+// Cancel is a function.
+func (servicer *bracketServiceTestStopOrderServicer) Cancel(
+	_ context.Context,
+	id uuid.UUID,
+) (om.StopOrderer, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	for clientOID, omStopOrderer := range servicer.omStopOrderers {
+		if omStopOrderer.GetID() == id {
+			servicer.omStopOrderers[clientOID] = stopOrderServiceStopOrderWith(
+				omStopOrderer,
+				omStopOrderer.GetKucoinID(),
+				omStopOrderer.GetStatus(),
+				false,
+			)
+
+			return servicer.omStopOrderers[clientOID], nil
+		}
+	}
+
+	return nil, object.ErrStopOrderNotFound
+}
+
+// GetByClientOID is a function.
+func (servicer *bracketServiceTestStopOrderServicer) GetByClientOID(
+	_ context.Context,
+	clientOID string,
+) (om.StopOrderer, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	omStopOrderer, ok := servicer.omStopOrderers[clientOID]
+	if !ok {
+		return nil, object.ErrStopOrderNotFound
+	}
+
+	return omStopOrderer, nil
+}
+
+// Place is a function.
+func (servicer *bracketServiceTestStopOrderServicer) Place(
+	_ context.Context,
+	dtoPlaceStopOrderRequester dto.PlaceStopOrderRequester,
+) (om.StopOrderer, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	clientOID := dtoPlaceStopOrderRequester.GetClientOID()
+	if _, ok := servicer.omStopOrderers[clientOID]; !ok {
+		servicer.omStopOrderers[clientOID] = stopOrderServiceStopOrderFromPlaceStopOrderRequest(
+			object.URIEmpty,
+			dtoPlaceStopOrderRequester,
+			false,
+			uuid.New(),
+		)
+	}
+
+	return servicer.omStopOrderers[clientOID], nil
+}
+
+// Reconcile is a function.
+func (servicer *bracketServiceTestStopOrderServicer) Reconcile(
+	_ context.Context,
+	omStopOrderer om.StopOrderer,
+) (om.StopOrderer, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	return servicer.omStopOrderers[omStopOrderer.GetClientOID()], nil
+}
+
+// GetBySymbol is a function.
+func (servicer *bracketServiceTestSymbolServicer) GetBySymbol(
+	_ context.Context,
+	symbol string,
+) (om.Symboler, error) {
+	return om.NewSymbol(
+		"BTC",
+		"0.0001",
+		object.URIEmpty,
+		object.URIEmpty,
+		"USDT",
+		"USDS",
+		object.URIEmpty,
+		symbol,
+		"0.01",
+		object.URIEmpty,
+		"USDT",
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		symbol,
+		true,
+		false,
+		uuid.Nil,
+	), nil
+}
+
+// GetBySymbol is a function.
+func (servicer *bracketServiceTestTickerServicer) GetBySymbol(
+	_ context.Context,
+	symbol string,
+) (om.Tickerer, error) {
+	return om.NewTicker(
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		servicer.last,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		symbol,
+		symbol,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		uuid.Nil,
+	), nil
+}
+
+func newBracketServiceTest(
+	last string,
+) (
+	*bracketServiceTestBracketServicer,
+	*bracketServiceTestOrderServicer,
+	*bracketServiceTestStopOrderServicer,
+) {
+	configConfigger := config.NewConfig(
+		config.WithKucoinConfigger(),
+		config.WithLogConfigger(),
+		config.WithPaperConfigger(),
+	)
+
+	bracketServicer := NewBracketServicer(
+		configConfigger,
+		nil,
+		log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+		trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+		util.NewUUID(),
+		nil,
+	)
+
+	testBracketServicer := &bracketServiceTestBracketServicer{
+		BracketServicer: bracketServicer,
+		omBracketers:    []om.Bracketer{},
+		mutex:           sync.Mutex{},
+	}
+
+	testOrderServicer := &bracketServiceTestOrderServicer{
+		OrderServicer:     nil,
+		ids:               map[string]uuid.UUID{},
+		kucoinOrderModels: map[string]*kucoin.OrderModel{},
+		mutex:             sync.Mutex{},
+	}
+
+	testStopOrderServicer := &bracketServiceTestStopOrderServicer{
+		StopOrderServicer: nil,
+		omStopOrderers:    map[string]om.StopOrderer{},
+		mutex:             sync.Mutex{},
+	}
+
+	bracketServicer.(WithServicer).WithServicer(&bracketServiceTestServicer{
+		Servicer:          nil,
+		bracketServicer:   testBracketServicer,
+		orderServicer:     testOrderServicer,
+		stopOrderServicer: testStopOrderServicer,
+		symbolServicer:    &bracketServiceTestSymbolServicer{SymbolServicer: nil},
+		tickerServicer: &bracketServiceTestTickerServicer{
+			TickerServicer: nil,
+			last:           last,
+		},
+	})
+
+	return testBracketServicer, testOrderServicer, testStopOrderServicer
+}
+
+// newBracketServiceTestBracket is a buy of one BTC-USDT stopped at 90 and taken
+// at 110.
+func newBracketServiceTestBracket(
+	state object.BracketStateType,
+	size string,
+	trailingDistance string,
+) om.Bracketer {
+	return om.NewBracket(
+		object.URIEmpty,
+		"entry",
+		object.URIEmpty,
+		object.URIEmpty,
+		string(object.OrderSideTypeBuy),
+		size,
+		string(state),
+		bracketServiceClientOID("entry", object.URIBracketLegStop, "90"),
+		"90",
+		"BTC-USDT",
+		bracketServiceClientOID("entry", object.URIBracketLegTakeProfit),
+		"110",
+		trailingDistance,
+		object.URIEmpty,
+		true,
+		false,
+		uuid.New(),
+	)
+}
+
+func bracketServiceTestDecimalEqual(
+	first string,
+	second string,
+) bool {
+	compare, err := util.DecimalCompare(first, second)
+
+	return err == nil && compare == 0
+}
+
+func TestBracketServiceReconcile(t *testing.T) {
+	t.Parallel()
+
+	stopClientOID := bracketServiceClientOID("entry", object.URIBracketLegStop, "90")
+	takeProfitClientOID := bracketServiceClientOID("entry", object.URIBracketLegTakeProfit)
+	omEntryBracketer := newBracketServiceTestBracket(
+		object.BracketStateTypeEntry,
+		object.URIEmpty,
+		object.URIEmpty,
+	)
+	omProtectedBracketer := newBracketServiceTestBracket(
+		object.BracketStateTypeProtected,
+		"1",
+		object.URIEmpty,
+	)
+
+	tests := []struct {
+		name        string
+		omBracketer om.Bracketer
+		setup       func(*bracketServiceTestOrderServicer, *bracketServiceTestStopOrderServicer)
+		state       object.BracketStateType
+		reason      object.BracketReasonType
+		size        string
+		legs        bool
+	}{
+		{
+			name:        "entry resting",
+			omBracketer: omEntryBracketer,
+			setup: func(
+				testOrderServicer *bracketServiceTestOrderServicer,
+				_ *bracketServiceTestStopOrderServicer,
+			) {
+				testOrderServicer.set(&kucoin.OrderModel{
+					ClientOid: "entry",
+					DealSize:  "0.5",
+					IsActive:  true,
+				})
+			},
+			state:  object.BracketStateTypeEntry,
+			reason: object.BracketReasonType(object.URIEmpty),
+			size:   object.URIEmpty,
+			legs:   false,
+		},
+		{
+			name:        "entry cancelled unfilled",
+			omBracketer: omEntryBracketer,
+			setup: func(
+				testOrderServicer *bracketServiceTestOrderServicer,
+				_ *bracketServiceTestStopOrderServicer,
+			) {
+				testOrderServicer.set(&kucoin.OrderModel{
+					ClientOid: "entry",
+					DealSize:  "0",
+					IsActive:  false,
+				})
+			},
+			state:  object.BracketStateTypeClosed,
+			reason: object.BracketReasonTypeUnfilled,
+			size:   object.URIEmpty,
+			legs:   false,
+		},
+		{
+			name:        "entry filled",
+			omBracketer: omEntryBracketer,
+			setup: func(
+				testOrderServicer *bracketServiceTestOrderServicer,
+				_ *bracketServiceTestStopOrderServicer,
+			) {
+				testOrderServicer.set(&kucoin.OrderModel{
+					ClientOid: "entry",
+					DealSize:  "0.8",
+					IsActive:  false,
+				})
+			},
+			state:  object.BracketStateTypeProtected,
+			reason: object.BracketReasonType(object.URIEmpty),
+			size:   "0.8",
+			legs:   true,
+		},
+		{
+			name:        "stop triggered and filled",
+			omBracketer: omProtectedBracketer,
+			setup: func(
+				testOrderServicer *bracketServiceTestOrderServicer,
+				_ *bracketServiceTestStopOrderServicer,
+			) {
+				testOrderServicer.set(&kucoin.OrderModel{
+					ClientOid: takeProfitClientOID,
+					DealSize:  "0",
+					IsActive:  true,
+				})
+				testOrderServicer.set(&kucoin.OrderModel{
+					ClientOid: stopClientOID,
+					DealSize:  "1",
+					IsActive:  false,
+				})
+			},
+			state:  object.BracketStateTypeClosed,
+			reason: object.BracketReasonTypeStopLoss,
+			size:   "1",
+			legs:   false,
+		},
+		{
+			name:        "take profit filled",
+			omBracketer: omProtectedBracketer,
+			setup: func(
+				testOrderServicer *bracketServiceTestOrderServicer,
+				testStopOrderServicer *bracketServiceTestStopOrderServicer,
+			) {
+				testOrderServicer.set(&kucoin.OrderModel{
+					ClientOid: takeProfitClientOID,
+					DealSize:  "1",
+					IsActive:  false,
+				})
+				_, _ = testStopOrderServicer.Place(
+					context.Background(),
+					bracketServiceStopRequest(omProtectedBracketer),
+				)
+			},
+			state:  object.BracketStateTypeClosed,
+			reason: object.BracketReasonTypeTakeProfit,
+			size:   "1",
+			legs:   false,
+		},
+		{
+			name:        "take profit partly filled",
+			omBracketer: omProtectedBracketer,
+			setup: func(
+				testOrderServicer *bracketServiceTestOrderServicer,
+				_ *bracketServiceTestStopOrderServicer,
+			) {
+				testOrderServicer.set(&kucoin.OrderModel{
+					ClientOid: takeProfitClientOID,
+					DealSize:  "0.4",
+					IsActive:  false,
+				})
+			},
+			state:  object.BracketStateTypeExit,
+			reason: object.BracketReasonTypeTakeProfit,
+			size:   "0.6",
+			legs:   false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			testBracketServicer, testOrderServicer, testStopOrderServicer :=
+				newBracketServiceTest(object.URIEmpty)
+			test.setup(testOrderServicer, testStopOrderServicer)
+
+			omBracketer, err := testBracketServicer.Reconcile(context.Background(), test.omBracketer)
+			if err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			if object.BracketStateType(omBracketer.GetState()) != test.state ||
+				object.BracketReasonType(omBracketer.GetReason()) != test.reason ||
+				omBracketer.GetIsActive() != (test.state != object.BracketStateTypeClosed) {
+				t.Errorf(
+					"Reconcile() = %q, %q, active %v, want %q, %q",
+					omBracketer.GetState(),
+					omBracketer.GetReason(),
+					omBracketer.GetIsActive(),
+					test.state,
+					test.reason,
+				)
+			}
+
+			if test.size != object.URIEmpty && !bracketServiceTestDecimalEqual(omBracketer.GetSize(), test.size) {
+				t.Errorf("GetSize() = %q, want %q", omBracketer.GetSize(), test.size)
+			}
+
+			if !test.legs {
+				return
+			}
+
+			// The legs protect what the entry filled, on the other side.
+			omTakeProfitOrderer, err := testOrderServicer.GetByClientOID(
+				context.Background(),
+				takeProfitClientOID,
+			)
+			if err != nil ||
+				!bracketServiceTestDecimalEqual(omTakeProfitOrderer.GetSize(), test.size) ||
+				omTakeProfitOrderer.GetSide() != string(object.OrderSideTypeSell) ||
+				omTakeProfitOrderer.GetPrice() != "110" {
+				t.Errorf("take profit = %v, %v, want a sell of %s at 110", omTakeProfitOrderer, err, test.size)
+			}
+
+			omStopOrderer, err := testStopOrderServicer.GetByClientOID(context.Background(), stopClientOID)
+			if err != nil ||
+				!bracketServiceTestDecimalEqual(omStopOrderer.GetSize(), test.size) ||
+				omStopOrderer.GetStop() != string(object.OrderStopTypeLoss) ||
+				omStopOrderer.GetStopPrice() != "90" {
+				t.Errorf("stop = %v, %v, want a loss stop of %s at 90", omStopOrderer, err, test.size)
+			}
+		})
+	}
+}
+
+func TestBracketServiceReconcileCancelsOtherLeg(t *testing.T) {
+	t.Parallel()
+
+	stopClientOID := bracketServiceClientOID("entry", object.URIBracketLegStop, "90")
+	takeProfitClientOID := bracketServiceClientOID("entry", object.URIBracketLegTakeProfit)
+
+	testBracketServicer, testOrderServicer, testStopOrderServicer := newBracketServiceTest(object.URIEmpty)
+	testOrderServicer.set(&kucoin.OrderModel{
+		ClientOid: takeProfitClientOID,
+		DealSize:  "0",
+		IsActive:  true,
+	})
+	testOrderServicer.set(&kucoin.OrderModel{ClientOid: stopClientOID, DealSize: "1", IsActive: false})
+
+	if _, err := testBracketServicer.Reconcile(
+		context.Background(),
+		newBracketServiceTestBracket(object.BracketStateTypeProtected, "1", object.URIEmpty),
+	); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	// The triggered stop cancels the take profit, which releases its size.
+	omOrderer, err := testOrderServicer.GetByClientOID(context.Background(), takeProfitClientOID)
+	if err != nil || omOrderer.GetIsActive() {
+		t.Errorf("take profit = %v, %v, want it cancelled", omOrderer, err)
+	}
+
+	if _, err = testStopOrderServicer.GetByClientOID(context.Background(), stopClientOID); !errors.Is(
+		err,
+		object.ErrStopOrderNotFound,
+	) {
+		t.Errorf("stop error = %v, want the stop not placed again", err)
+	}
+}
+
+func TestBracketServiceReconcileTrail(t *testing.T) {
+	t.Parallel()
+
+	stopClientOID := bracketServiceClientOID("entry", object.URIBracketLegStop, "90")
+	takeProfitClientOID := bracketServiceClientOID("entry", object.URIBracketLegTakeProfit)
+
+	testBracketServicer, testOrderServicer, testStopOrderServicer := newBracketServiceTest("104.567")
+	testOrderServicer.set(&kucoin.OrderModel{
+		ClientOid: takeProfitClientOID,
+		DealSize:  "0",
+		IsActive:  true,
+	})
+
+	omBracketer, err := testBracketServicer.Reconcile(
+		context.Background(),
+		newBracketServiceTestBracket(object.BracketStateTypeProtected, "1", "5"),
+	)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	// The stop follows the last price at the distance, rounded to the price
+	// increment away from the price.
+	if !bracketServiceTestDecimalEqual(omBracketer.GetStopPrice(), "99.57") ||
+		omBracketer.GetTrailingPrice() != "104.567" {
+		t.Errorf(
+			"Reconcile() = stop %q, trailing %q, want %q, %q",
+			omBracketer.GetStopPrice(),
+			omBracketer.GetTrailingPrice(),
+			"99.57",
+			"104.567",
+		)
+	}
+
+	if omStopOrderer, _ := testStopOrderServicer.GetByClientOID(
+		context.Background(),
+		stopClientOID,
+	); omStopOrderer == nil || omStopOrderer.GetIsActive() {
+		t.Errorf("old stop = %v, want it cancelled", omStopOrderer)
+	}
+
+	omStopOrderer, err := testStopOrderServicer.GetByClientOID(
+		context.Background(),
+		omBracketer.GetStopClientOID(),
+	)
+	if err != nil || omStopOrderer.GetStopPrice() != omBracketer.GetStopPrice() {
+		t.Errorf("new stop = %v, %v, want it at %s", omStopOrderer, err, omBracketer.GetStopPrice())
+	}
+
+	if len(testBracketServicer.omBracketers) == 0 {
+		t.Errorf("updates = 0, want the new stop recorded")
+	}
+}
+
+func TestBracketServiceCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		side            object.OrderSideType
+		stopPrice       string
+		takeProfitPrice string
+		want            error
+	}{
+		{
+			name:            "buy",
+			side:            object.OrderSideTypeBuy,
+			stopPrice:       "90",
+			takeProfitPrice: "110",
+			want:            nil,
+		},
+		{
+			name:            "sell",
+			side:            object.OrderSideTypeSell,
+			stopPrice:       "110",
+			takeProfitPrice: "90",
+			want:            nil,
+		},
+		{
+			name:            "stop only",
+			side:            object.OrderSideTypeBuy,
+			stopPrice:       "90",
+			takeProfitPrice: object.URIEmpty,
+			want:            nil,
+		},
+		{
+			name:            "no stop",
+			side:            object.OrderSideTypeBuy,
+			stopPrice:       object.URIEmpty,
+			takeProfitPrice: "110",
+			want:            object.ErrOrderPriceInvalid,
+		},
+		{
+			name:            "take profit on the losing side",
+			side:            object.OrderSideTypeBuy,
+			stopPrice:       "110",
+			takeProfitPrice: "90",
+			want:            object.ErrOrderPriceInvalid,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := bracketServiceCheck(test.side, test.stopPrice, test.takeProfitPrice)
+			if !errors.Is(err, test.want) {
+				t.Errorf("bracketServiceCheck() error = %v, want %v", err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,396 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// EquityServicer is an interface.
+	EquityServicer interface {
+		// Create is a function.
+		Create(
+			context.Context,
+			om.Equitier,
+		) (uuid.UUID, error)
+		// DeleteAll is a function.
+		DeleteAll(
+			context.Context,
+		) (time.Time, error)
+		// Get is a function.
+		Get(
+			context.Context,
+			uuid.UUID,
+		) (om.Equitier, error)
+		// GetListFromRepository is a function.
+		GetListFromRepository(
+			context.Context,
+			dao.Paginationer,
+			dao.EquityFilterer,
+		) ([]om.Equitier, dao.Cursorer, error)
+	}
+
+	// GetEquityServicer is an interface.
+	GetEquityServicer interface {
+		// GetEquityServicer is a function.
+		GetEquityServicer() EquityServicer
+	}
+
+	equityService struct {
+		configConfigger   config.Configger
+		repositorier      repository.EquityRepositorier
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+)
+
+var (
+	_ EquityServicer                   = (*equityService)(nil)
+	_ GetServicer                      = (*equityService)(nil)
+	_ WithServicer                     = (*equityService)(nil)
+	_ config.GetConfigger              = (*equityService)(nil)
+	_ exchange.GetExchanger            = (*equityService)(nil)
+	_ log.GetRuntimeLogger             = (*equityService)(nil)
+	_ repository.GetEquityRepositorier = (*equityService)(nil)
+	_ util.GetTracer                   = (*equityService)(nil)
+	_ util.GetUUIDer                   = (*equityService)(nil)
+)
+
+// NewEquityServicer is a function.
+func NewEquityServicer(
+	configConfigger config.Configger,
+	repositorier repository.EquityRepositorier,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) EquityServicer {
+	return &equityService{
+		configConfigger:   configConfigger,
+		repositorier:      repositorier,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *equityService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *equityService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *equityService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetEquityRepositorier is a function.
+func (service *equityService) GetEquityRepositorier() repository.EquityRepositorier {
+	return service.repositorier
+}
+
+// GetTracer is a function.
+func (service *equityService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *equityService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *equityService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *equityService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// Create is a function.
+func (service *equityService) Create(
+	ctx context.Context,
+	omEquitier om.Equitier,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":        "Create",
+		"rt_ctx":      utilRuntimeContext,
+		"sp_ctx":      utilSpanContext,
+		"config":      service.configConfigger,
+		"om_equitier": omEquitier,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoEquity := dao.NewEquity(
+		time.Time{},
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		uuid.Nil,
+		omEquitier.GetAccount(),
+		omEquitier.GetCurrency(),
+		omEquitier.GetEquity(),
+		omEquitier.GetFee(),
+		omEquitier.GetRealizedPnL(),
+		omEquitier.GetUnrealizedPnL(),
+		omEquitier.GetSnapshotAt(),
+		omEquitier.GetPaper(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOEquity, daoEquity).
+		Debug(object.URIEmpty)
+
+	equityID, err := service.GetEquityRepositorier().Create(ctx, daoEquity)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrEquityRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrEquityRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldEquityID, equityID).
+		Debug(object.URIEmpty)
+
+	return equityID, nil
+}
+
+// DeleteAll is a function.
+func (service *equityService) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	deletedAt, err := service.GetEquityRepositorier().DeleteAll(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrEquityRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrEquityRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDeletedAt, deletedAt).
+		Debug(object.URIEmpty)
+
+	return deletedAt, nil
+}
+
+// Get is a function.
+func (service *equityService) Get(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.Equitier, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Get",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Get",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoEquity, err := service.GetEquityRepositorier().Read(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrEquityRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrEquityRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOEquity, daoEquity).
+		Debug(object.URIEmpty)
+
+	omEquity := om.NewEquity(
+		daoEquity.GetAccount(),
+		daoEquity.GetCurrency(),
+		daoEquity.GetEquity(),
+		daoEquity.GetFee(),
+		daoEquity.GetRealizedPnL(),
+		daoEquity.GetUnrealizedPnL(),
+		daoEquity.GetSnapshotAt(),
+		daoEquity.GetPaper(),
+		daoEquity.GetID(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMEquity, omEquity).
+		Debug(object.URIEmpty)
+
+	return omEquity, nil
+}
+
+// GetListFromRepository is a function.
+func (service *equityService) GetListFromRepository(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoEquityFilterer dao.EquityFilterer,
+) ([]om.Equitier, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRepository",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                "GetListFromRepository",
+		"rt_ctx":              utilRuntimeContext,
+		"sp_ctx":              utilSpanContext,
+		"config":              service.configConfigger,
+		"dao_paginationer":    daoPaginationer,
+		"dao_equity_filterer": daoEquityFilterer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoEquities, daoCursorer, err := service.GetEquityRepositorier().
+		ReadList(ctx, daoPaginationer, daoEquityFilterer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrEquityRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrEquityRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOEquities, daoEquities).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	omEquities := make([]om.Equitier, 0, len(daoEquities))
+
+	for key, daoEquity := range daoEquities {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldDAOEquity, daoEquity).
+			Debug(object.URIEmpty)
+
+		omEquities = append(omEquities, om.NewEquity(
+			daoEquity.GetAccount(),
+			daoEquity.GetCurrency(),
+			daoEquity.GetEquity(),
+			daoEquity.GetFee(),
+			daoEquity.GetRealizedPnL(),
+			daoEquity.GetUnrealizedPnL(),
+			daoEquity.GetSnapshotAt(),
+			daoEquity.GetPaper(),
+			daoEquity.GetID(),
+		))
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMEquities, omEquities).
+		Debug(object.URIEmpty)
+
+	return omEquities, daoCursorer, nil
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/util"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// ExchangeStatusServicer is an interface.
+	ExchangeStatusServicer interface {
+		// GetMessage is a function.
+		GetMessage() string
+		// GetMode is a function.
+		GetMode() object.ExchangeModeType
+		// Sync is a function.
+		Sync(
+			context.Context,
+		) error
+	}
+
+	// GetExchangeStatusServicer is an interface.
+	GetExchangeStatusServicer interface {
+		// GetExchangeStatusServicer is a function.
+		GetExchangeStatusServicer() ExchangeStatusServicer
+	}
+
+	exchangeStatusService struct {
+		configConfigger   config.Configger
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+		message           string
+		mode              object.ExchangeModeType
+		mutex             sync.RWMutex
+	}
+)
+
+var (
+	_ ExchangeStatusServicer = (*exchangeStatusService)(nil)
+	_ GetServicer            = (*exchangeStatusService)(nil)
+	_ WithServicer           = (*exchangeStatusService)(nil)
+	_ config.GetConfigger    = (*exchangeStatusService)(nil)
+	_ exchange.GetExchanger  = (*exchangeStatusService)(nil)
+	_ log.GetRuntimeLogger   = (*exchangeStatusService)(nil)
+	_ util.GetTracer         = (*exchangeStatusService)(nil)
+	_ util.GetUUIDer         = (*exchangeStatusService)(nil)
+)
+
+// NewExchangeStatusServicer is a function.
+// The exchange is taken to be open until the first sync tells otherwise.
+func NewExchangeStatusServicer(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) ExchangeStatusServicer {
+	return &exchangeStatusService{
+		configConfigger:   configConfigger,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+		message:           object.URIEmpty,
+		mode:              object.ExchangeModeTypeOpen,
+		mutex:             sync.RWMutex{},
+	}
+}
+
+// GetConfigger is a function.
+func (service *exchangeStatusService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *exchangeStatusService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *exchangeStatusService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetTracer is a function.
+func (service *exchangeStatusService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *exchangeStatusService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *exchangeStatusService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *exchangeStatusService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// GetMessage is a function.
+// It is the message the exchange gave with its last status, like the reason
+// of a maintenance.
+func (service *exchangeStatusService) GetMessage() string {
+	service.mutex.RLock()
+	defer service.mutex.RUnlock()
+
+	return service.message
+}
+
+// GetMode is a function.
+// It is the mode of the last status the exchange reported.
+func (service *exchangeStatusService) GetMode() object.ExchangeModeType {
+	service.mutex.RLock()
+	defer service.mutex.RUnlock()
+
+	return service.mode
+}
+
+// Sync is a function.
+// Sync reads the status of the exchange and moves the service into its mode. A
+// failed read keeps the last mode, since an unreachable exchange is left to
+// the circuit breakers.
+func (service *exchangeStatusService) Sync(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Sync",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Sync",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	response, err := serviceExchanger(ctx, service).ServiceStatus()
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrExchangeStatusKucoinServiceGet.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrExchangeStatusKucoinServiceGet.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, errResponse).
+			Error(object.ErrExchangeStatusKucoinServiceGet.Error())
+		traceSpan.RecordError(errResponse)
+		traceSpan.SetStatus(codes.Error, object.ErrExchangeStatusKucoinServiceGet.Error())
+
+		return fmt.Errorf("%w: %w", object.ErrExchangeStatusKucoinServiceGet, errResponse)
+	}
+
+	kucoinServiceStatusModel := kucoin.ServiceStatusModel{}
+
+	if err = response.ReadData(&kucoinServiceStatusModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinServiceStatusModel, kucoinServiceStatusModel).
+		Debug(object.URIEmpty)
+
+	mode := exchangeStatusServiceMode(kucoinServiceStatusModel.Status)
+
+	service.mutex.Lock()
+	previous := service.mode
+	service.message = kucoinServiceStatusModel.Msg
+	service.mode = mode
+	service.mutex.Unlock()
+
+	if mode != previous {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldExchangeMode, mode).
+			Warn(kucoinServiceStatusModel.Msg)
+	}
+
+	return nil
+}
+
+// exchangeStatusServiceMode is the mode of a status the exchange reports. A
+// status it does not know pauses the service, rather than trade through a
+// state of the exchange nobody planned for.
+func exchangeStatusServiceMode(
+	status string,
+) object.ExchangeModeType {
+	switch status {
+	case object.URIKucoinServiceStatusOpen:
+		return object.ExchangeModeTypeOpen
+	case object.URIKucoinServiceStatusCancelOnly:
+		return object.ExchangeModeTypeCancelOnly
+	default:
+		return object.ExchangeModeTypePaused
+	}
+}
@@ -0,0 +1,850 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// FillServicer is an interface.
+	FillServicer interface {
+		// Create is a function.
+		Create(
+			context.Context,
+			om.Filler,
+		) (uuid.UUID, error)
+		// DeleteAll is a function.
+		DeleteAll(
+			context.Context,
+		) (time.Time, error)
+		// Get is a function.
+		Get(
+			context.Context,
+			uuid.UUID,
+		) (om.Filler, error)
+		// GetListFromRemote is a function.
+		GetListFromRemote(
+			context.Context,
+			dto.FillRequester,
+			int64,
+		) error
+		// GetListFromRepository is a function.
+		GetListFromRepository(
+			context.Context,
+			dao.Paginationer,
+			dao.FillFilterer,
+		) ([]om.Filler, dao.Cursorer, error)
+		// GetRecentListFromRemote is a function.
+		GetRecentListFromRemote(
+			context.Context,
+		) error
+		// Upsert is a function.
+		Upsert(
+			context.Context,
+			om.Filler,
+		) (uuid.UUID, error)
+	}
+
+	// GetFillServicer is an interface.
+	GetFillServicer interface {
+		// GetFillServicer is a function.
+		GetFillServicer() FillServicer
+	}
+
+	fillService struct {
+		configConfigger   config.Configger
+		repositorier      repository.FillRepositorier
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+)
+
+var (
+	_ FillServicer                   = (*fillService)(nil)
+	_ GetServicer                    = (*fillService)(nil)
+	_ WithServicer                   = (*fillService)(nil)
+	_ config.GetConfigger            = (*fillService)(nil)
+	_ exchange.GetExchanger          = (*fillService)(nil)
+	_ log.GetRuntimeLogger           = (*fillService)(nil)
+	_ repository.GetFillRepositorier = (*fillService)(nil)
+	_ util.GetTracer                 = (*fillService)(nil)
+	_ util.GetUUIDer                 = (*fillService)(nil)
+)
+
+// NewFillServicer is a function.
+func NewFillServicer(
+	configConfigger config.Configger,
+	repositorier repository.FillRepositorier,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) FillServicer {
+	return &fillService{
+		configConfigger:   configConfigger,
+		repositorier:      repositorier,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *fillService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *fillService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *fillService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetFillRepositorier is a function.
+func (service *fillService) GetFillRepositorier() repository.FillRepositorier {
+	return service.repositorier
+}
+
+// GetTracer is a function.
+func (service *fillService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *fillService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *fillService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *fillService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// Create is a function.
+func (service *fillService) Create(
+	ctx context.Context,
+	omFiller om.Filler,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":      "Create",
+		"rt_ctx":    utilRuntimeContext,
+		"sp_ctx":    utilSpanContext,
+		"config":    service.configConfigger,
+		"om_filler": omFiller,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoFill := dao.NewFill(
+		time.Time{},
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		uuid.Nil,
+		omFiller.GetAccount(),
+		omFiller.GetCounterOrderID(),
+		omFiller.GetFee(),
+		omFiller.GetFeeCurrency(),
+		omFiller.GetFeeRate(),
+		omFiller.GetFunds(),
+		omFiller.GetKucoinOrderID(),
+		omFiller.GetKucoinType(),
+		omFiller.GetLiquidity(),
+		omFiller.GetPrice(),
+		omFiller.GetSide(),
+		omFiller.GetSize(),
+		omFiller.GetStop(),
+		omFiller.GetSymbol(),
+		omFiller.GetTradeID(),
+		omFiller.GetTradeType(),
+		omFiller.GetKucoinCreatedAt(),
+		omFiller.GetForceTaker(),
+		omFiller.GetPaper(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOFill, daoFill).
+		Debug(object.URIEmpty)
+
+	fillID, err := service.GetFillRepositorier().Create(ctx, daoFill)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrFillRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrFillRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldFillID, fillID).
+		Debug(object.URIEmpty)
+
+	return fillID, nil
+}
+
+// DeleteAll is a function.
+func (service *fillService) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	deletedAt, err := service.GetFillRepositorier().DeleteAll(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrFillRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrFillRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDeletedAt, deletedAt).
+		Debug(object.URIEmpty)
+
+	return deletedAt, nil
+}
+
+// Get is a function.
+func (service *fillService) Get(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.Filler, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Get",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Get",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoFill, err := service.GetFillRepositorier().Read(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrFillRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrFillRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOFill, daoFill).
+		Debug(object.URIEmpty)
+
+	omFill := om.NewFill(
+		daoFill.GetAccount(),
+		daoFill.GetCounterOrderID(),
+		daoFill.GetFee(),
+		daoFill.GetFeeCurrency(),
+		daoFill.GetFeeRate(),
+		daoFill.GetFunds(),
+		daoFill.GetKucoinOrderID(),
+		daoFill.GetKucoinType(),
+		daoFill.GetLiquidity(),
+		daoFill.GetPrice(),
+		daoFill.GetSide(),
+		daoFill.GetSize(),
+		daoFill.GetStop(),
+		daoFill.GetSymbol(),
+		daoFill.GetTradeID(),
+		daoFill.GetTradeType(),
+		daoFill.GetKucoinCreatedAt(),
+		daoFill.GetForceTaker(),
+		daoFill.GetPaper(),
+		daoFill.GetID(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMFill, omFill).
+		Debug(object.URIEmpty)
+
+	return omFill, nil
+}
+
+// GetListFromRemote is a function.
+// GetListFromRemote stores every page of the fills matching the request, one
+// row per trade.
+func (service *fillService) GetListFromRemote(
+	ctx context.Context,
+	dtoFillRequester dto.FillRequester,
+	currentPage int64,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRemote",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":               "GetListFromRemote",
+		"rt_ctx":             utilRuntimeContext,
+		"sp_ctx":             utilSpanContext,
+		"config":             service.configConfigger,
+		"dto_fill_requester": dtoFillRequester,
+		"current_page":       currentPage,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	params := map[string]string{}
+
+	for key, value := range dtoFillRequester.GetMap() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		if newValue, ok := value.(string); ok && value != object.URIEmpty {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`newValue, ok := value.(string); ok && value != object.URIEmpty`)
+
+			params[key] = newValue
+		}
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldParams, params).
+		Debug(object.URIEmpty)
+
+	kucoinPaginationParam := &kucoin.PaginationParam{
+		CurrentPage: currentPage,
+		PageSize:    service.GetConfigger().GetRuntimeConfigger().GetKucoinPaginationRequestSize(),
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinPaginationParam, kucoinPaginationParam).
+		Debug(object.URIEmpty)
+
+	response, err := serviceExchanger(ctx, service).Fills(params, kucoinPaginationParam)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrFillKucoinServiceGetList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrFillKucoinServiceGetList.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, errResponse).
+			Error(object.ErrFillKucoinServiceGetList.Error())
+		traceSpan.RecordError(errResponse)
+		traceSpan.SetStatus(codes.Error, object.ErrFillKucoinServiceGetList.Error())
+
+		return fmt.Errorf("%w: %w", object.ErrFillKucoinServiceGetList, errResponse)
+	}
+
+	kucoinPaginationModel, err := response.ReadPaginationData(&kucoin.FillsModel{})
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadPaginationData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadPaginationData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinPaginationModel, kucoinPaginationModel).
+		Debug(object.URIEmpty)
+
+	kucoinFillsModel := kucoin.FillsModel{}
+
+	if err = json.Unmarshal(
+		kucoinPaginationModel.RawItems,
+		&kucoinFillsModel,
+	); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrUnmarshalJSON.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrUnmarshalJSON.Error())
+
+		return err
+	}
+
+	if err = service.upsertAll(ctx, kucoinFillsModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrFillServiceUpsert.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrFillServiceUpsert.Error())
+
+		return err
+	}
+
+	if kucoinPaginationModel.CurrentPage < kucoinPaginationModel.TotalPage {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`kucoinPaginationModel.CurrentPage < kucoinPaginationModel.TotalPage`)
+
+		if err = service.GetServicer().
+			GetFillServicer().
+			GetListFromRemote(ctx, dtoFillRequester, currentPage+1); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrFillServiceGetListFromRemote.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrFillServiceGetListFromRemote.Error())
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetListFromRepository is a function.
+func (service *fillService) GetListFromRepository(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoFillFilterer dao.FillFilterer,
+) ([]om.Filler, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRepository",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":              "GetListFromRepository",
+		"rt_ctx":            utilRuntimeContext,
+		"sp_ctx":            utilSpanContext,
+		"config":            service.configConfigger,
+		"dao_paginationer":  daoPaginationer,
+		"dao_fill_filterer": daoFillFilterer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoFills, daoCursorer, err := service.GetFillRepositorier().
+		ReadList(ctx, daoPaginationer, daoFillFilterer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrFillRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrFillRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOFills, daoFills).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	omFills := make([]om.Filler, 0, len(daoFills))
+
+	for key, daoFill := range daoFills {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldDAOFill, daoFill).
+			Debug(object.URIEmpty)
+
+		omFills = append(omFills, om.NewFill(
+			daoFill.GetAccount(),
+			daoFill.GetCounterOrderID(),
+			daoFill.GetFee(),
+			daoFill.GetFeeCurrency(),
+			daoFill.GetFeeRate(),
+			daoFill.GetFunds(),
+			daoFill.GetKucoinOrderID(),
+			daoFill.GetKucoinType(),
+			daoFill.GetLiquidity(),
+			daoFill.GetPrice(),
+			daoFill.GetSide(),
+			daoFill.GetSize(),
+			daoFill.GetStop(),
+			daoFill.GetSymbol(),
+			daoFill.GetTradeID(),
+			daoFill.GetTradeType(),
+			daoFill.GetKucoinCreatedAt(),
+			daoFill.GetForceTaker(),
+			daoFill.GetPaper(),
+			daoFill.GetID(),
+		))
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMFills, omFills).
+		Debug(object.URIEmpty)
+
+	return omFills, daoCursorer, nil
+}
+
+// GetRecentListFromRemote is a function.
+// GetRecentListFromRemote stores the fills of the last 24 hours, which the
+// exchange serves without pagination.
+func (service *fillService) GetRecentListFromRemote(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetRecentListFromRemote",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetRecentListFromRemote",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	response, err := serviceExchanger(ctx, service).RecentFills()
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrFillKucoinServiceGetRecentList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrFillKucoinServiceGetRecentList.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, errResponse).
+			Error(object.ErrFillKucoinServiceGetRecentList.Error())
+		traceSpan.RecordError(errResponse)
+		traceSpan.SetStatus(codes.Error, object.ErrFillKucoinServiceGetRecentList.Error())
+
+		return fmt.Errorf("%w: %w", object.ErrFillKucoinServiceGetRecentList, errResponse)
+	}
+
+	kucoinFillsModel := kucoin.FillsModel{}
+
+	if err = response.ReadData(&kucoinFillsModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	if err = service.upsertAll(ctx, kucoinFillsModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrFillServiceUpsert.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrFillServiceUpsert.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// Upsert is a function.
+// A trade is stored once: a fill whose trade id is already stored keeps its
+// row, so overlapping pages and repeated syncs do not duplicate it.
+func (service *fillService) Upsert(
+	ctx context.Context,
+	omFiller om.Filler,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Upsert",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":      "Upsert",
+		"rt_ctx":    utilRuntimeContext,
+		"sp_ctx":    utilSpanContext,
+		"config":    service.configConfigger,
+		"om_filler": omFiller,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoFills, _, err := service.GetFillRepositorier().ReadList(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewFillFilter(
+			omFiller.GetAccount(),
+			object.URIEmpty,
+			object.URIEmpty,
+			omFiller.GetTradeID(),
+		),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrFillRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrFillRepositoryReadList.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOFills, daoFills).
+		Debug(object.URIEmpty)
+
+	if len(daoFills) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(daoFills) == 0`)
+
+		return service.GetServicer().GetFillServicer().Create(ctx, omFiller)
+	}
+
+	return daoFills[0].GetID(), nil
+}
+
+func (service *fillService) upsertAll(
+	ctx context.Context,
+	kucoinFillsModel kucoin.FillsModel,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"upsertAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":               "upsertAll",
+		"rt_ctx":             utilRuntimeContext,
+		"sp_ctx":             utilSpanContext,
+		"config":             service.configConfigger,
+		"kucoin_fills_model": kucoinFillsModel,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	for key, value := range kucoinFillsModel {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		fillID, err := service.GetServicer().GetFillServicer().Upsert(
+			ctx,
+			fillServiceFillFromModel(
+				serviceAccount(ctx, service),
+				value,
+				service.GetConfigger().GetPaperConfigger().GetEnabled(),
+			),
+		)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrFillServiceUpsert.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrFillServiceUpsert.Error())
+
+			return err
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldFillID, fillID).
+			Debug(object.URIEmpty)
+	}
+
+	return nil
+}
+
+// fillServiceFillFromModel links the fill to its order through the kucoin id
+// of the order and to the account it was read from.
+func fillServiceFillFromModel(
+	account string,
+	kucoinFillModel *kucoin.FillModel,
+	paper bool,
+) om.Filler {
+	return om.NewFill(
+		account,
+		kucoinFillModel.CounterOrderId,
+		kucoinFillModel.Fee,
+		kucoinFillModel.FeeCurrency,
+		kucoinFillModel.FeeRate,
+		kucoinFillModel.Funds,
+		kucoinFillModel.OrderId,
+		kucoinFillModel.Type,
+		kucoinFillModel.Liquidity,
+		kucoinFillModel.Price,
+		kucoinFillModel.Side,
+		kucoinFillModel.Size,
+		kucoinFillModel.Stop,
+		kucoinFillModel.Symbol,
+		kucoinFillModel.TradeId,
+		kucoinFillModel.TradeType,
+		kucoinFillModel.CreatedAt,
+		kucoinFillModel.ForceTaker,
+		paper,
+		uuid.Nil,
+	)
+}
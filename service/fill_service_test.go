@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange/exchangetest"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type (
+	fillServiceTestServicer struct {
+		Servicer
+		fillServicer FillServicer
+	}
+
+	// fillServiceTestFillRepositorier keeps the fills instead of storing them,
+	// so the test runs without a database.
+	fillServiceTestFillRepositorier struct {
+		repository.FillRepositorier
+		daoFills []dao.Filler
+		mutex    sync.Mutex
+	}
+)
+
+// GetFillServicer is a function.
+func (servicer *fillServiceTestServicer) GetFillServicer() FillServicer {
+	return servicer.fillServicer
+}
+
+// Create is a function.
+func (repositorier *fillServiceTestFillRepositorier) Create(
+	_ context.Context,
+	daoFiller dao.Filler,
+) (uuid.UUID, error) {
+	repositorier.mutex.Lock()
+	defer repositorier.mutex.Unlock()
+
+	id := uuid.New()
+
+	repositorier.daoFills = append(repositorier.daoFills, dao.NewFill(
+		time.Time{},
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		id,
+		daoFiller.GetAccount(),
+		daoFiller.GetCounterOrderID(),
+		daoFiller.GetFee(),
+		daoFiller.GetFeeCurrency(),
+		daoFiller.GetFeeRate(),
+		daoFiller.GetFunds(),
+		daoFiller.GetKucoinOrderID(),
+		daoFiller.GetKucoinType(),
+		daoFiller.GetLiquidity(),
+		daoFiller.GetPrice(),
+		daoFiller.GetSide(),
+		daoFiller.GetSize(),
+		daoFiller.GetStop(),
+		daoFiller.GetSymbol(),
+		daoFiller.GetTradeID(),
+		daoFiller.GetTradeType(),
+		daoFiller.GetKucoinCreatedAt(),
+		daoFiller.GetForceTaker(),
+		daoFiller.GetPaper(),
+	))
+
+	return id, nil
+}
+
+// ReadList is a function.
+func (repositorier *fillServiceTestFillRepositorier) ReadList(
+	_ context.Context,
+	_ dao.Paginationer,
+	daoFillFilterer dao.FillFilterer,
+) ([]dao.Filler, dao.Cursorer, error) {
+	repositorier.mutex.Lock()
+	defer repositorier.mutex.Unlock()
+
+	daoFills := []dao.Filler{}
+
+	for _, daoFill := range repositorier.daoFills {
+		if daoFillFilterer.GetAccount() != object.URIEmpty &&
+			daoFill.GetAccount() != daoFillFilterer.GetAccount() {
+			continue
+		}
+
+		if daoFillFilterer.GetTradeID() != object.URIEmpty &&
+			daoFill.GetTradeID() != daoFillFilterer.GetTradeID() {
+			continue
+		}
+
+		daoFills = append(daoFills, daoFill)
+	}
+
+	return daoFills, nil, nil
+}
+
+func (repositorier *fillServiceTestFillRepositorier) get() []dao.Filler {
+	repositorier.mutex.Lock()
+	defer repositorier.mutex.Unlock()
+
+	return append([]dao.Filler{}, repositorier.daoFills...)
+}
+
+func newFillServiceTest(
+	t *testing.T,
+) (exchangetest.FakeServerer, FillServicer, *fillServiceTestFillRepositorier) {
+	t.Helper()
+
+	fakeServerer := exchangetest.NewFakeServer(
+		kucoin.ApiKeyOption("key"),
+		kucoin.ApiSecretOption("secret"),
+		kucoin.ApiPassPhraseOption("passphrase"),
+		kucoin.ApiKeyVersionOption(kucoin.ApiKeyVersionV2),
+	)
+	t.Cleanup(fakeServerer.Close)
+
+	configConfigger := config.NewConfig(
+		config.WithKucoinConfigger(),
+		config.WithLogConfigger(),
+		config.WithPaperConfigger(),
+		config.WithRuntimeConfigger(
+			config.WithRuntimeConfigKucoinPaginationRequestSize(2),
+		),
+	)
+
+	testFillRepositorier := &fillServiceTestFillRepositorier{
+		FillRepositorier: nil,
+		daoFills:         []dao.Filler{},
+		mutex:            sync.Mutex{},
+	}
+
+	fillServicer := NewFillServicer(
+		configConfigger,
+		testFillRepositorier,
+		log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+		trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+		util.NewUUID(),
+		fakeServerer.GetExchanger(),
+	)
+
+	fillServicer.(WithServicer).WithServicer(&fillServiceTestServicer{
+		Servicer:     nil,
+		fillServicer: fillServicer,
+	})
+
+	return fakeServerer, fillServicer, testFillRepositorier
+}
+
+func newFillServiceTestItem(
+	tradeID string,
+	orderID string,
+	liquidity string,
+) map[string]any {
+	return map[string]any{
+		"symbol":         "BTC-USDT",
+		"tradeId":        tradeID,
+		"orderId":        orderID,
+		"counterOrderId": "counter",
+		"side":           string(object.OrderSideTypeBuy),
+		"liquidity":      liquidity,
+		"forceTaker":     false,
+		"price":          "100",
+		"size":           "0.5",
+		"funds":          "50",
+		"fee":            "0.05",
+		"feeRate":        "0.001",
+		"feeCurrency":    "USDT",
+		"stop":           object.URIEmpty,
+		"type":           string(object.OrderTypeTypeLimit),
+		"createdAt":      1700000000000,
+		"tradeType":      string(object.OrderTypeTypeTrade),
+	}
+}
+
+func TestFillServiceGetListFromRemote(t *testing.T) {
+	t.Parallel()
+
+	fakeServerer, fillServicer, testFillRepositorier := newFillServiceTest(t)
+
+	// The third page repeats the first trade, as a page that shifted while it
+	// was read would.
+	items := []any{
+		newFillServiceTestItem("1", "order-1", "taker"),
+		newFillServiceTestItem("2", "order-1", "maker"),
+		newFillServiceTestItem("3", "order-2", "maker"),
+		newFillServiceTestItem("4", "order-2", "taker"),
+		newFillServiceTestItem("1", "order-1", "taker"),
+	}
+
+	fakeServerer.HandlePagination(http.MethodGet, object.URIKucoinPathFills, items)
+
+	if err := fillServicer.GetListFromRemote(
+		context.Background(),
+		dto.NewFillRequest(
+			object.URIEmpty,
+			object.URIEmpty,
+			object.OrderTypeType(object.URIEmpty),
+			object.OrderSideType(object.URIEmpty),
+			object.URIEmpty,
+			"BTC-USDT",
+			object.OrderTypeType(object.URIEmpty),
+		),
+		1,
+	); err != nil {
+		t.Fatalf("GetListFromRemote() error = %v", err)
+	}
+
+	if got := len(fakeServerer.GetRequesters(http.MethodGet, object.URIKucoinPathFills)); got != 3 {
+		t.Errorf("requests = %d, want 3", got)
+	}
+
+	daoFills := testFillRepositorier.get()
+	if len(daoFills) != 4 {
+		t.Fatalf("stored fills = %d, want 4", len(daoFills))
+	}
+
+	wants := []struct {
+		tradeID   string
+		orderID   string
+		liquidity string
+	}{
+		{tradeID: "1", orderID: "order-1", liquidity: "taker"},
+		{tradeID: "2", orderID: "order-1", liquidity: "maker"},
+		{tradeID: "3", orderID: "order-2", liquidity: "maker"},
+		{tradeID: "4", orderID: "order-2", liquidity: "taker"},
+	}
+
+	for index, want := range wants {
+		daoFill := daoFills[index]
+		if daoFill.GetTradeID() != want.tradeID ||
+			daoFill.GetKucoinOrderID() != want.orderID ||
+			daoFill.GetLiquidity() != want.liquidity ||
+			daoFill.GetPrice() != "100" ||
+			daoFill.GetSize() != "0.5" ||
+			daoFill.GetFee() != "0.05" ||
+			daoFill.GetFeeCurrency() != "USDT" ||
+			daoFill.GetKucoinCreatedAt() != 1700000000000 {
+			t.Errorf("fills[%d] = %v, want trade %s of %s", index, daoFill, want.tradeID, want.orderID)
+		}
+	}
+}
+
+func TestFillServiceGetRecentListFromRemote(t *testing.T) {
+	t.Parallel()
+
+	fakeServerer, fillServicer, testFillRepositorier := newFillServiceTest(t)
+
+	fakeServerer.Handle(
+		http.MethodGet,
+		object.URIKucoinPathLimitFills,
+		exchangetest.NewFakeSuccessResponse([]any{
+			newFillServiceTestItem("1", "order-1", "taker"),
+			newFillServiceTestItem("2", "order-1", "maker"),
+		}),
+	)
+
+	// A repeated sync finds every trade stored already.
+	for range []int{0, 1} {
+		if err := fillServicer.GetRecentListFromRemote(context.Background()); err != nil {
+			t.Fatalf("GetRecentListFromRemote() error = %v", err)
+		}
+	}
+
+	daoFills := testFillRepositorier.get()
+	if len(daoFills) != 2 {
+		t.Fatalf("stored fills = %d, want 2", len(daoFills))
+	}
+
+	fillID, err := fillServicer.Upsert(
+		context.Background(),
+		fillServiceFillFromModel(daoFills[0].GetAccount(), &kucoin.FillModel{TradeId: "1"}, false),
+	)
+	if err != nil || fillID != daoFills[0].GetID() {
+		t.Errorf("Upsert() = %v, %v, want %v", fillID, err, daoFills[0].GetID())
+	}
+}
+
+func TestFillServiceGetRecentListFromRemoteError(t *testing.T) {
+	t.Parallel()
+
+	fakeServerer, fillServicer, testFillRepositorier := newFillServiceTest(t)
+
+	fakeServerer.Handle(
+		http.MethodGet,
+		object.URIKucoinPathLimitFills,
+		exchangetest.NewFakeErrorResponse(http.StatusTooManyRequests, "429000", "Too Many Requests"),
+	)
+
+	err := fillServicer.GetRecentListFromRemote(context.Background())
+	if !errors.Is(err, object.ErrFillKucoinServiceGetRecentList) {
+		t.Errorf(
+			"GetRecentListFromRemote() error = %v, want %v",
+			err,
+			object.ErrFillKucoinServiceGetRecentList,
+		)
+	}
+
+	if got := len(testFillRepositorier.get()); got != 0 {
+		t.Errorf("stored fills = %d, want 0", got)
+	}
+}
@@ -0,0 +1,481 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// KillSwitchServicer is an interface.
+	KillSwitchServicer interface {
+		// Create is a function.
+		Create(
+			context.Context,
+			om.KillSwitcher,
+		) (uuid.UUID, error)
+		// DeleteAll is a function.
+		DeleteAll(
+			context.Context,
+		) (time.Time, error)
+		// Get is a function.
+		Get(
+			context.Context,
+			uuid.UUID,
+		) (om.KillSwitcher, error)
+		// GetListFromRepository is a function.
+		GetListFromRepository(
+			context.Context,
+			dao.Paginationer,
+			dao.KillSwitchFilterer,
+		) ([]om.KillSwitcher, dao.Cursorer, error)
+		// Upsert is a function.
+		Upsert(
+			context.Context,
+			om.KillSwitcher,
+		) (uuid.UUID, error)
+	}
+
+	// GetKillSwitchServicer is an interface.
+	GetKillSwitchServicer interface {
+		// GetKillSwitchServicer is a function.
+		GetKillSwitchServicer() KillSwitchServicer
+	}
+
+	killSwitchService struct {
+		configConfigger   config.Configger
+		repositorier      repository.KillSwitchRepositorier
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+)
+
+var (
+	_ KillSwitchServicer                   = (*killSwitchService)(nil)
+	_ GetServicer                          = (*killSwitchService)(nil)
+	_ WithServicer                         = (*killSwitchService)(nil)
+	_ config.GetConfigger                  = (*killSwitchService)(nil)
+	_ exchange.GetExchanger                = (*killSwitchService)(nil)
+	_ log.GetRuntimeLogger                 = (*killSwitchService)(nil)
+	_ repository.GetKillSwitchRepositorier = (*killSwitchService)(nil)
+	_ util.GetTracer                       = (*killSwitchService)(nil)
+	_ util.GetUUIDer                       = (*killSwitchService)(nil)
+)
+
+// NewKillSwitchServicer is a function.
+func NewKillSwitchServicer(
+	configConfigger config.Configger,
+	repositorier repository.KillSwitchRepositorier,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) KillSwitchServicer {
+	return &killSwitchService{
+		configConfigger:   configConfigger,
+		repositorier:      repositorier,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *killSwitchService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *killSwitchService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *killSwitchService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetKillSwitchRepositorier is a function.
+func (service *killSwitchService) GetKillSwitchRepositorier() repository.KillSwitchRepositorier {
+	return service.repositorier
+}
+
+// GetTracer is a function.
+func (service *killSwitchService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *killSwitchService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *killSwitchService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *killSwitchService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// Create is a function.
+func (service *killSwitchService) Create(
+	ctx context.Context,
+	omKillSwitcher om.KillSwitcher,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":             "Create",
+		"rt_ctx":           utilRuntimeContext,
+		"sp_ctx":           utilSpanContext,
+		"config":           service.configConfigger,
+		"om_kill_switcher": omKillSwitcher,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoKillSwitch := dao.NewKillSwitch(
+		time.Time{},
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		uuid.Nil,
+		omKillSwitcher.GetReason(),
+		omKillSwitcher.GetEnabled(),
+		omKillSwitcher.GetPaper(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOKillSwitch, daoKillSwitch).
+		Debug(object.URIEmpty)
+
+	killSwitchID, err := service.GetKillSwitchRepositorier().Create(ctx, daoKillSwitch)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKillSwitchRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKillSwitchRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKillSwitchID, killSwitchID).
+		Debug(object.URIEmpty)
+
+	return killSwitchID, nil
+}
+
+// DeleteAll is a function.
+func (service *killSwitchService) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	deletedAt, err := service.GetKillSwitchRepositorier().DeleteAll(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKillSwitchRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKillSwitchRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDeletedAt, deletedAt).
+		Debug(object.URIEmpty)
+
+	return deletedAt, nil
+}
+
+// Get is a function.
+func (service *killSwitchService) Get(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.KillSwitcher, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Get",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Get",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoKillSwitch, err := service.GetKillSwitchRepositorier().Read(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKillSwitchRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKillSwitchRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOKillSwitch, daoKillSwitch).
+		Debug(object.URIEmpty)
+
+	omKillSwitch := om.NewKillSwitch(
+		daoKillSwitch.GetReason(),
+		daoKillSwitch.GetEnabled(),
+		daoKillSwitch.GetPaper(),
+		daoKillSwitch.GetID(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMKillSwitch, omKillSwitch).
+		Debug(object.URIEmpty)
+
+	return omKillSwitch, nil
+}
+
+// GetListFromRepository is a function.
+func (service *killSwitchService) GetListFromRepository(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoKillSwitchFilterer dao.KillSwitchFilterer,
+) ([]om.KillSwitcher, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRepository",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                     "GetListFromRepository",
+		"rt_ctx":                   utilRuntimeContext,
+		"sp_ctx":                   utilSpanContext,
+		"config":                   service.configConfigger,
+		"dao_paginationer":         daoPaginationer,
+		"dao_kill_switch_filterer": daoKillSwitchFilterer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoKillSwitches, daoCursorer, err := service.GetKillSwitchRepositorier().
+		ReadList(ctx, daoPaginationer, daoKillSwitchFilterer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKillSwitchRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKillSwitchRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOKillSwitches, daoKillSwitches).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	omKillSwitches := make([]om.KillSwitcher, 0, len(daoKillSwitches))
+
+	for key, daoKillSwitch := range daoKillSwitches {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldDAOKillSwitch, daoKillSwitch).
+			Debug(object.URIEmpty)
+
+		omKillSwitches = append(omKillSwitches, om.NewKillSwitch(
+			daoKillSwitch.GetReason(),
+			daoKillSwitch.GetEnabled(),
+			daoKillSwitch.GetPaper(),
+			daoKillSwitch.GetID(),
+		))
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMKillSwitches, omKillSwitches).
+		Debug(object.URIEmpty)
+
+	return omKillSwitches, daoCursorer, nil
+}
+
+// Upsert is a function.
+func (service *killSwitchService) Upsert(
+	ctx context.Context,
+	omKillSwitcher om.KillSwitcher,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Upsert",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":             "Upsert",
+		"rt_ctx":           utilRuntimeContext,
+		"sp_ctx":           utilSpanContext,
+		"config":           service.configConfigger,
+		"om_kill_switcher": omKillSwitcher,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoKillSwitches, _, err := service.GetKillSwitchRepositorier().ReadList(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewKillSwitchFilter(omKillSwitcher.GetPaper()),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKillSwitchRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKillSwitchRepositoryReadList.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOKillSwitches, daoKillSwitches).
+		Debug(object.URIEmpty)
+
+	if len(daoKillSwitches) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(daoKillSwitches) == 0`)
+
+		return service.GetServicer().GetKillSwitchServicer().Create(ctx, omKillSwitcher)
+	}
+
+	daoKillSwitch := dao.NewKillSwitch(
+		daoKillSwitches[0].GetCreatedAt(),
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		daoKillSwitches[0].GetID(),
+		omKillSwitcher.GetReason(),
+		omKillSwitcher.GetEnabled(),
+		omKillSwitcher.GetPaper(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOKillSwitch, daoKillSwitch).
+		Debug(object.URIEmpty)
+
+	updatedAt, err := service.GetKillSwitchRepositorier().Update(ctx, daoKillSwitch)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKillSwitchRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKillSwitchRepositoryUpdate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return daoKillSwitch.GetID(), nil
+}
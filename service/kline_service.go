@@ -2,14 +2,23 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"sort"
+	"strconv"
+	"time"
 
-	"github.com/Kucoin/kucoin-go-sdk"
 	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/indicator"
 	"github.com/ShahoBashoki/kucoin/log"
 	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
 	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/repository"
 	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -17,11 +26,63 @@ import (
 type (
 	// KlineServicer is an interface.
 	KlineServicer interface {
+		// Backfill is a function.
+		Backfill(
+			context.Context,
+			dto.KlineRequester,
+		) (uint32, error)
+		// Create is a function.
+		Create(
+			context.Context,
+			om.Kliner,
+		) (uuid.UUID, error)
+		// DeleteAll is a function.
+		DeleteAll(
+			context.Context,
+		) (time.Time, error)
+		// Get is a function.
+		Get(
+			context.Context,
+			uuid.UUID,
+		) (om.Kliner, error)
+		// GetIndicator is a function.
+		GetIndicator(
+			context.Context,
+			dto.KlineRequester,
+			indicator.Indicatorer,
+		) ([]float64, error)
+		// GetListFromRemote is a function.
+		GetListFromRemote(
+			context.Context,
+			dto.KlineRequester,
+		) ([]om.Kliner, error)
+		// GetListFromRepository is a function.
+		GetListFromRepository(
+			context.Context,
+			dao.Paginationer,
+			dao.KlineFilterer,
+		) ([]om.Kliner, dao.Cursorer, error)
 		// GetOpenLowEqualityFromRemote is a function.
 		GetOpenLowEqualityFromRemote(
 			context.Context,
 			dto.KlineRequester,
 		) (bool, error)
+		// GetResampledListFromRepository is a function.
+		GetResampledListFromRepository(
+			context.Context,
+			dto.KlineRequester,
+		) ([]om.Kliner, error)
+		// Resample is a function.
+		Resample(
+			context.Context,
+			[]om.Kliner,
+			object.KlineTypeType,
+		) ([]om.Kliner, error)
+		// Upsert is a function.
+		Upsert(
+			context.Context,
+			om.Kliner,
+		) (uuid.UUID, error)
 	}
 
 	// GetKlineServicer is an interface.
@@ -31,40 +92,61 @@ type (
 	}
 
 	klineService struct {
-		configConfigger  config.Configger
-		logRuntimeLogger log.RuntimeLogger
-		servicer         Servicer
-		traceTracer      trace.Tracer
-		utilUUIDer       util.UUIDer
-		kucoinAPIService *kucoin.ApiService
+		configConfigger   config.Configger
+		repositorier      repository.KlineRepositorier
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		objectTimer       object.Timer
+		exchangeExchanger exchange.Exchanger
+	}
+
+	klineServiceBucket struct {
+		closeValue string
+		high       string
+		low        string
+		open       string
+		symbol     string
+		turnover   string
+		volume     string
+		count      int64
+		startAt    int64
 	}
 )
 
 var (
-	_ GetServicer          = (*klineService)(nil)
-	_ KlineServicer        = (*klineService)(nil)
-	_ WithServicer         = (*klineService)(nil)
-	_ config.GetConfigger  = (*klineService)(nil)
-	_ log.GetRuntimeLogger = (*klineService)(nil)
-	_ util.GetTracer       = (*klineService)(nil)
-	_ util.GetUUIDer       = (*klineService)(nil)
+	_ GetServicer                     = (*klineService)(nil)
+	_ KlineServicer                   = (*klineService)(nil)
+	_ WithServicer                    = (*klineService)(nil)
+	_ config.GetConfigger             = (*klineService)(nil)
+	_ exchange.GetExchanger           = (*klineService)(nil)
+	_ log.GetRuntimeLogger            = (*klineService)(nil)
+	_ object.GetTimer                 = (*klineService)(nil)
+	_ repository.GetKlineRepositorier = (*klineService)(nil)
+	_ util.GetTracer                  = (*klineService)(nil)
+	_ util.GetUUIDer                  = (*klineService)(nil)
 )
 
 // NewKlineServicer is a function.
 func NewKlineServicer(
 	configConfigger config.Configger,
+	repositorier repository.KlineRepositorier,
 	logRuntimeLogger log.RuntimeLogger,
 	traceTracer trace.Tracer,
 	utilUUIDer util.UUIDer,
-	kucoinAPIService *kucoin.ApiService,
+	objectTimer object.Timer,
+	exchangeExchanger exchange.Exchanger,
 ) KlineServicer {
 	return &klineService{
-		configConfigger:  configConfigger,
-		logRuntimeLogger: logRuntimeLogger,
-		servicer:         nil,
-		traceTracer:      traceTracer,
-		utilUUIDer:       utilUUIDer,
-		kucoinAPIService: kucoinAPIService,
+		configConfigger:   configConfigger,
+		repositorier:      repositorier,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		objectTimer:       objectTimer,
+		exchangeExchanger: exchangeExchanger,
 	}
 }
 
@@ -73,6 +155,11 @@ func (service *klineService) GetConfigger() config.Configger {
 	return service.configConfigger
 }
 
+// GetKlineRepositorier is a function.
+func (service *klineService) GetKlineRepositorier() repository.KlineRepositorier {
+	return service.repositorier
+}
+
 // GetRuntimeLogger is a function.
 func (service *klineService) GetRuntimeLogger() log.RuntimeLogger {
 	return service.logRuntimeLogger
@@ -93,9 +180,14 @@ func (service *klineService) GetUUIDer() util.UUIDer {
 	return service.utilUUIDer
 }
 
-// GetAPIService is a function.
-func (service *klineService) GetAPIService() *kucoin.ApiService {
-	return service.kucoinAPIService
+// GetTimer is a function.
+func (service *klineService) GetTimer() object.Timer {
+	return service.objectTimer
+}
+
+// GetExchanger is a function.
+func (service *klineService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
 }
 
 // WithServicer is a function.
@@ -105,16 +197,19 @@ func (service *klineService) WithServicer(
 	service.servicer = servicer
 }
 
-// GetOpenLowEqualityFromRemote is a function.
-func (service *klineService) GetOpenLowEqualityFromRemote(
+// Backfill is a function.
+// Backfill stores the candles from the last stored one, or from the start of
+// the request, up to its end. Without either it starts the configured lookback
+// before the end.
+func (service *klineService) Backfill(
 	ctx context.Context,
 	dtoKlineRequester dto.KlineRequester,
-) (bool, error) {
+) (uint32, error) {
 	var traceSpan trace.Span
 
 	ctx, traceSpan = service.GetTracer().Start(
 		ctx,
-		"GetOpenLowEqualityFromRemote",
+		"Backfill",
 		trace.WithSpanKind(trace.SpanKindProducer),
 	)
 	defer traceSpan.End()
@@ -122,7 +217,7 @@ func (service *klineService) GetOpenLowEqualityFromRemote(
 	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
 	utilSpanContext := util.NewSpanContext(traceSpan)
 	fields := map[string]any{
-		"name":                "GetOpenLowEqualityFromRemote",
+		"name":                "Backfill",
 		"rt_ctx":              utilRuntimeContext,
 		"sp_ctx":              utilSpanContext,
 		"config":              service.configConfigger,
@@ -133,72 +228,1331 @@ func (service *klineService) GetOpenLowEqualityFromRemote(
 		WithFields(fields).
 		Info(object.URIEmpty)
 
-	response, err := service.kucoinAPIService.KLines(
-		dtoKlineRequester.GetSymbol(),
-		string(dtoKlineRequester.GetKlineType()),
-		dtoKlineRequester.GetStartAt(),
-		dtoKlineRequester.GetEndAt(),
+	klineType := dtoKlineRequester.GetKlineType()
+	secondKlineType := util.KlineTypeToSecond(klineType)
+	startAt := dtoKlineRequester.GetStartAt()
+	endAt := dtoKlineRequester.GetEndAt()
+
+	if endAt == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`endAt == 0`)
+
+		endAt = service.GetTimer().NowUTC().Unix()
+	}
+
+	daoKliners, _, err := service.GetKlineRepositorier().ReadList(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewKlineFilter(string(klineType), dtoKlineRequester.GetSymbol(), startAt, endAt, true),
 	)
 	if err != nil {
 		service.GetRuntimeLogger().
 			WithFields(fields).
 			WithField(object.URIFieldError, err).
-			Error(object.ErrKlineKucoinServiceGetList.Error())
+			Error(object.ErrKlineRepositoryReadList.Error())
 		traceSpan.RecordError(err)
-		traceSpan.SetStatus(codes.Error, object.ErrKlineKucoinServiceGetList.Error())
+		traceSpan.SetStatus(codes.Error, object.ErrKlineRepositoryReadList.Error())
 
-		return false, fmt.Errorf("%w", err)
+		return 0, err
 	}
 
 	service.GetRuntimeLogger().
 		WithFields(fields).
-		WithField(object.URIFieldResponse, response).
+		WithField(object.URIFieldDAOKliners, daoKliners).
 		Debug(object.URIEmpty)
 
-	if response.Code != "200000" {
+	if len(daoKliners) != 0 {
 		service.GetRuntimeLogger().
 			WithFields(fields).
-			WithField(object.URIFieldError, response.Message).
-			Error(object.ErrKlineKucoinServiceGetList.Error())
-		traceSpan.RecordError(object.ErrKlineKucoinServiceGetList)
-		traceSpan.SetStatus(codes.Error, object.ErrKlineKucoinServiceGetList.Error())
+			Debug(`len(daoKliners) != 0`)
 
-		return false, object.ErrKlineKucoinServiceGetList
+		// The last stored candle may still have been open when it was written, so it is fetched again.
+		startAt = daoKliners[0].GetStartAt()
 	}
 
-	var kucoinKLinesModel [][]string
+	if startAt == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`startAt == 0`)
 
-	if err = response.ReadData(&kucoinKLinesModel); err != nil {
+		// KuCoin does not publish the listing time of a symbol, so the walk starts
+		// a configured lookback before the end instead of from 1970.
+		startAt = endAt - int64(
+			service.GetConfigger().GetKlineConfigger().GetBackfillLookback()/time.Second,
+		)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldSecondKlineType, secondKlineType).
+		WithField(object.URIFieldStartAt, startAt).
+		WithField(object.URIFieldEndAt, endAt).
+		Debug(object.URIEmpty)
+
+	var count uint32
+
+	for startAt < endAt {
+		windowEndAt := startAt + secondKlineType*object.NUMKlineRequestCandleLimit
+		if windowEndAt > endAt {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`windowEndAt > endAt`)
+
+			windowEndAt = endAt
+		}
+
+		windowCount, err := service.backfillWindow(
+			ctx,
+			dto.NewKlineRequest(klineType, dtoKlineRequester.GetSymbol(), windowEndAt, startAt),
+		)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrKlineServiceBackfill.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrKlineServiceBackfill.Error())
+
+			return count, err
+		}
+
+		count += windowCount
+		startAt = windowEndAt
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldCount, count).
+			WithField(object.URIFieldStartAt, startAt).
+			Debug(object.URIEmpty)
+	}
+
+	return count, nil
+}
+
+// Create is a function.
+func (service *klineService) Create(
+	ctx context.Context,
+	omKliner om.Kliner,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":      "Create",
+		"rt_ctx":    utilRuntimeContext,
+		"sp_ctx":    utilSpanContext,
+		"config":    service.configConfigger,
+		"om_kliner": omKliner,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoKline := dao.NewKline(
+		time.Time{},
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		uuid.Nil,
+		omKliner.GetClose(),
+		omKliner.GetHigh(),
+		omKliner.GetKlineType(),
+		omKliner.GetLow(),
+		omKliner.GetOpen(),
+		omKliner.GetSymbol(),
+		omKliner.GetTurnover(),
+		omKliner.GetVolume(),
+		omKliner.GetStartAt(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOKline, daoKline).
+		Debug(object.URIEmpty)
+
+	klineID, err := service.GetKlineRepositorier().Create(ctx, daoKline)
+	if err != nil {
 		service.GetRuntimeLogger().
 			WithFields(fields).
 			WithField(object.URIFieldError, err).
-			Error(object.ErrKucoinServiceReadPaginationData.Error())
+			Error(object.ErrKlineRepositoryCreate.Error())
 		traceSpan.RecordError(err)
-		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadPaginationData.Error())
+		traceSpan.SetStatus(codes.Error, object.ErrKlineRepositoryCreate.Error())
 
-		return false, fmt.Errorf("%w", err)
+		return uuid.Nil, err
 	}
 
 	service.GetRuntimeLogger().
 		WithFields(fields).
-		WithField(object.URIFieldKucoinKLinesModel, kucoinKLinesModel).
+		WithField(object.URIFieldKlineID, klineID).
 		Debug(object.URIEmpty)
 
-	for key, value := range kucoinKLinesModel {
+	return klineID, nil
+}
+
+// DeleteAll is a function.
+func (service *klineService) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	deletedAt, err := service.GetKlineRepositorier().DeleteAll(ctx)
+	if err != nil {
 		service.GetRuntimeLogger().
 			WithFields(fields).
-			WithField(object.URIFieldKey, key).
-			WithField(object.URIFieldValue, value).
-			Debug(object.URIEmpty)
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKlineRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineRepositoryDeleteAll.Error())
 
-		if value[1] == value[4] {
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDeletedAt, deletedAt).
+		Debug(object.URIEmpty)
+
+	return deletedAt, nil
+}
+
+// Get is a function.
+func (service *klineService) Get(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.Kliner, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Get",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Get",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoKline, err := service.GetKlineRepositorier().Read(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKlineRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOKline, daoKline).
+		Debug(object.URIEmpty)
+
+	omKline := om.NewKline(
+		daoKline.GetClose(),
+		daoKline.GetHigh(),
+		daoKline.GetKlineType(),
+		daoKline.GetLow(),
+		daoKline.GetOpen(),
+		daoKline.GetSymbol(),
+		daoKline.GetTurnover(),
+		daoKline.GetVolume(),
+		daoKline.GetStartAt(),
+		daoKline.GetID(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMKline, omKline).
+		Debug(object.URIEmpty)
+
+	return omKline, nil
+}
+
+// GetIndicator is a function.
+// The indicator is reset, fed every stored candle in the range and its latest values are returned.
+// Candles of an interval that is not stored are resampled from the stored 1min candles.
+func (service *klineService) GetIndicator(
+	ctx context.Context,
+	dtoKlineRequester dto.KlineRequester,
+	indicatorIndicatorer indicator.Indicatorer,
+) ([]float64, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetIndicator",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                  "GetIndicator",
+		"rt_ctx":                utilRuntimeContext,
+		"sp_ctx":                utilSpanContext,
+		"config":                service.configConfigger,
+		"dto_kline_requester":   dtoKlineRequester,
+		"indicator_indicatorer": indicatorIndicatorer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omKliners, err := service.getAllFromRepository(ctx, dao.NewKlineFilter(
+		string(dtoKlineRequester.GetKlineType()),
+		dtoKlineRequester.GetSymbol(),
+		dtoKlineRequester.GetStartAt(),
+		dtoKlineRequester.GetEndAt(),
+		false,
+	))
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKlineRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineRepositoryReadList.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMKlines, omKliners).
+		Debug(object.URIEmpty)
+
+	if len(omKliners) == 0 && dtoKlineRequester.GetKlineType() != object.KlineTypeType1min {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(omKliners) == 0 && dtoKlineRequester.GetKlineType() != object.KlineTypeType1min`)
+
+		omKliners, err = service.GetResampledListFromRepository(ctx, dtoKlineRequester)
+		if err != nil {
 			service.GetRuntimeLogger().
 				WithFields(fields).
-				Debug(`value[1] == value[4]`)
+				WithField(object.URIFieldError, err).
+				Error(object.ErrKlineServiceResample.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrKlineServiceResample.Error())
 
-			return true, nil
+			return nil, err
 		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMKlines, omKliners).
+			Debug(object.URIEmpty)
 	}
 
-	return false, nil
+	indicatorCandles, err := indicator.NewCandles(omKliners)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSTRCONVParseFloat.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseFloat.Error())
+
+		return nil, err
+	}
+
+	indicatorIndicatorer.Reset()
+
+	var (
+		values []float64
+		ready  bool
+	)
+
+	for _, indicatorCandle := range indicatorCandles {
+		values, ready = indicatorIndicatorer.Update(indicatorCandle)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldValues, values).
+		WithField(object.URIFieldReady, ready).
+		Debug(object.URIEmpty)
+
+	if !ready {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrKlineServiceGetIndicatorNotReady).
+			Error(object.ErrKlineServiceGetIndicatorNotReady.Error())
+		traceSpan.RecordError(object.ErrKlineServiceGetIndicatorNotReady)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineServiceGetIndicatorNotReady.Error())
+
+		return nil, object.ErrKlineServiceGetIndicatorNotReady
+	}
+
+	return values, nil
+}
+
+// GetListFromRemote is a function.
+// Candles are returned the way KuCoin sends them, newest first.
+func (service *klineService) GetListFromRemote(
+	ctx context.Context,
+	dtoKlineRequester dto.KlineRequester,
+) ([]om.Kliner, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRemote",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                "GetListFromRemote",
+		"rt_ctx":              utilRuntimeContext,
+		"sp_ctx":              utilSpanContext,
+		"config":              service.configConfigger,
+		"dto_kline_requester": dtoKlineRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	kucoinKLinesModel, err := service.getListFromRemote(ctx, dtoKlineRequester)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKlineKucoinServiceGetList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineKucoinServiceGetList.Error())
+
+		return nil, err
+	}
+
+	omKlines := make([]om.Kliner, 0, len(kucoinKLinesModel))
+
+	for key, value := range kucoinKLinesModel {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		if len(value) < object.NUMKlineCandleLength {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`len(value) < object.NUMKlineCandleLength`)
+
+			continue
+		}
+
+		startAt, err := strconv.ParseInt(value[0], 10, 64)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrSTRCONVParseInt.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseInt.Error())
+
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		omKlines = append(omKlines, om.NewKline(
+			value[2],
+			value[3],
+			string(dtoKlineRequester.GetKlineType()),
+			value[4],
+			value[1],
+			dtoKlineRequester.GetSymbol(),
+			value[6],
+			value[5],
+			startAt,
+			uuid.Nil,
+		))
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMKlines, omKlines).
+		Debug(object.URIEmpty)
+
+	return omKlines, nil
+}
+
+// GetListFromRepository is a function.
+func (service *klineService) GetListFromRepository(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoKlineFilterer dao.KlineFilterer,
+) ([]om.Kliner, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRepository",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":               "GetListFromRepository",
+		"rt_ctx":             utilRuntimeContext,
+		"sp_ctx":             utilSpanContext,
+		"config":             service.configConfigger,
+		"dao_paginationer":   daoPaginationer,
+		"dao_kline_filterer": daoKlineFilterer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoKlines, daoCursorer, err := service.GetKlineRepositorier().
+		ReadList(ctx, daoPaginationer, daoKlineFilterer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKlineRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOKlines, daoKlines).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	omKlines := make([]om.Kliner, 0, len(daoKlines))
+
+	for key, daoKline := range daoKlines {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldDAOKline, daoKline).
+			Debug(object.URIEmpty)
+
+		omKlines = append(omKlines, om.NewKline(
+			daoKline.GetClose(),
+			daoKline.GetHigh(),
+			daoKline.GetKlineType(),
+			daoKline.GetLow(),
+			daoKline.GetOpen(),
+			daoKline.GetSymbol(),
+			daoKline.GetTurnover(),
+			daoKline.GetVolume(),
+			daoKline.GetStartAt(),
+			daoKline.GetID(),
+		))
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMKlines, omKlines).
+		Debug(object.URIEmpty)
+
+	return omKlines, daoCursorer, nil
+}
+
+// GetOpenLowEqualityFromRemote is a function.
+func (service *klineService) GetOpenLowEqualityFromRemote(
+	ctx context.Context,
+	dtoKlineRequester dto.KlineRequester,
+) (bool, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetOpenLowEqualityFromRemote",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                "GetOpenLowEqualityFromRemote",
+		"rt_ctx":              utilRuntimeContext,
+		"sp_ctx":              utilSpanContext,
+		"config":              service.configConfigger,
+		"dto_kline_requester": dtoKlineRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	kucoinKLinesModel, err := service.getListFromRemote(ctx, dtoKlineRequester)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKlineKucoinServiceGetList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineKucoinServiceGetList.Error())
+
+		return false, err
+	}
+
+	for key, value := range kucoinKLinesModel {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		if value[1] == value[4] {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`value[1] == value[4]`)
+
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// GetResampledListFromRepository is a function.
+func (service *klineService) GetResampledListFromRepository(
+	ctx context.Context,
+	dtoKlineRequester dto.KlineRequester,
+) ([]om.Kliner, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetResampledListFromRepository",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                "GetResampledListFromRepository",
+		"rt_ctx":              utilRuntimeContext,
+		"sp_ctx":              utilSpanContext,
+		"config":              service.configConfigger,
+		"dto_kline_requester": dtoKlineRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	startAt := util.KlineBucketStartAt(
+		dtoKlineRequester.GetKlineType(),
+		dtoKlineRequester.GetStartAt(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldStartAt, startAt).
+		Debug(object.URIEmpty)
+
+	omKliners, err := service.getAllFromRepository(ctx, dao.NewKlineFilter(
+		string(object.KlineTypeType1min),
+		dtoKlineRequester.GetSymbol(),
+		startAt,
+		dtoKlineRequester.GetEndAt(),
+		false,
+	))
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKlineRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineRepositoryReadList.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMKlines, omKliners).
+		Debug(object.URIEmpty)
+
+	return service.Resample(ctx, omKliners, dtoKlineRequester.GetKlineType())
+}
+
+// Resample is a function.
+// Resample aggregates 1min candles into candles of the kline type. Only closed
+// buckets holding every one of their minutes are returned; the bucket still
+// forming is left out, and a bucket with missing minutes is reported and
+// skipped rather than returned with a wrong open, close or volume.
+func (service *klineService) Resample(
+	ctx context.Context,
+	omKliners []om.Kliner,
+	klineType object.KlineTypeType,
+) ([]om.Kliner, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Resample",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "Resample",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"om_kliners": omKliners,
+		"kline_type": klineType,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	// A minute seen twice keeps its latest version, as a streamed candle is updated until it closes.
+	omKlinersByStartAt := make(map[int64]om.Kliner, len(omKliners))
+	startAts := make([]int64, 0, len(omKliners))
+
+	for _, omKliner := range omKliners {
+		if omKliner.GetKlineType() != string(object.KlineTypeType1min) {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrKlineServiceResampleKlineType).
+				Error(object.ErrKlineServiceResampleKlineType.Error())
+			traceSpan.RecordError(object.ErrKlineServiceResampleKlineType)
+			traceSpan.SetStatus(codes.Error, object.ErrKlineServiceResampleKlineType.Error())
+
+			return nil, object.ErrKlineServiceResampleKlineType
+		}
+
+		if _, ok := omKlinersByStartAt[omKliner.GetStartAt()]; !ok {
+			startAts = append(startAts, omKliner.GetStartAt())
+		}
+
+		omKlinersByStartAt[omKliner.GetStartAt()] = omKliner
+	}
+
+	sort.Slice(startAts, func(first, second int) bool {
+		return startAts[first] < startAts[second]
+	})
+
+	buckets := make([]*klineServiceBucket, 0, len(startAts))
+
+	var bucket *klineServiceBucket
+
+	for _, startAt := range startAts {
+		omKliner := omKlinersByStartAt[startAt]
+		bucketStartAt := util.KlineBucketStartAt(klineType, startAt)
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMKline, omKliner).
+			WithField(object.URIFieldBucketStartAt, bucketStartAt).
+			Debug(object.URIEmpty)
+
+		if bucket == nil || bucket.startAt != bucketStartAt {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`bucket == nil || bucket.startAt != bucketStartAt`)
+
+			bucket = newKlineServiceBucket(omKliner, bucketStartAt)
+			buckets = append(buckets, bucket)
+
+			continue
+		}
+
+		if err := bucket.add(omKliner); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrKlineServiceResample.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrKlineServiceResample.Error())
+
+			return nil, err
+		}
+	}
+
+	secondKlineType := util.KlineTypeToSecond(klineType)
+	nowUnix := service.GetTimer().NowUTC().Unix()
+	resampledOMKliners := make([]om.Kliner, 0, len(buckets))
+
+	for _, bucket := range buckets {
+		if bucket.startAt+secondKlineType > nowUnix {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldBucketStartAt, bucket.startAt).
+				Debug(`bucket.startAt+secondKlineType > nowUnix`)
+
+			continue
+		}
+
+		if bucket.count < secondKlineType/object.NUM1MinToSecond {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldBucketStartAt, bucket.startAt).
+				WithField(object.URIFieldCount, bucket.count).
+				WithField(object.URIFieldError, object.ErrKlineServiceResampleGap).
+				Warn(object.ErrKlineServiceResampleGap.Error())
+
+			continue
+		}
+
+		resampledOMKliners = append(resampledOMKliners, bucket.kline(klineType))
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMKlines, resampledOMKliners).
+		Debug(object.URIEmpty)
+
+	return resampledOMKliners, nil
+}
+
+// Upsert is a function.
+func (service *klineService) Upsert(
+	ctx context.Context,
+	omKliner om.Kliner,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Upsert",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":      "Upsert",
+		"rt_ctx":    utilRuntimeContext,
+		"sp_ctx":    utilSpanContext,
+		"config":    service.configConfigger,
+		"om_kliner": omKliner,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoKliners, _, err := service.GetKlineRepositorier().ReadList(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewKlineFilter(
+			omKliner.GetKlineType(),
+			omKliner.GetSymbol(),
+			omKliner.GetStartAt(),
+			omKliner.GetStartAt()+1,
+			false,
+		),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKlineRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineRepositoryReadList.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOKliners, daoKliners).
+		Debug(object.URIEmpty)
+
+	if len(daoKliners) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(daoKliners) == 0`)
+
+		return service.Create(ctx, omKliner)
+	}
+
+	daoKline := dao.NewKline(
+		daoKliners[0].GetCreatedAt(),
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		daoKliners[0].GetID(),
+		omKliner.GetClose(),
+		omKliner.GetHigh(),
+		omKliner.GetKlineType(),
+		omKliner.GetLow(),
+		omKliner.GetOpen(),
+		omKliner.GetSymbol(),
+		omKliner.GetTurnover(),
+		omKliner.GetVolume(),
+		omKliner.GetStartAt(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOKline, daoKline).
+		Debug(object.URIEmpty)
+
+	updatedAt, err := service.GetKlineRepositorier().Update(ctx, daoKline)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKlineRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineRepositoryUpdate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return daoKline.GetID(), nil
+}
+
+func (service *klineService) backfillWindow(
+	ctx context.Context,
+	dtoKlineRequester dto.KlineRequester,
+) (uint32, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"backfillWindow",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                "backfillWindow",
+		"rt_ctx":              utilRuntimeContext,
+		"sp_ctx":              utilSpanContext,
+		"config":              service.configConfigger,
+		"dto_kline_requester": dtoKlineRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omKliners, err := service.GetListFromRemote(ctx, dtoKlineRequester)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKlineKucoinServiceGetList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineKucoinServiceGetList.Error())
+
+		return 0, err
+	}
+
+	daoKliners, _, err := service.GetKlineRepositorier().ReadList(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), object.NUMKlineRequestCandleLimit),
+		dao.NewKlineFilter(
+			string(dtoKlineRequester.GetKlineType()),
+			dtoKlineRequester.GetSymbol(),
+			dtoKlineRequester.GetStartAt(),
+			dtoKlineRequester.GetEndAt(),
+			false,
+		),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKlineRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineRepositoryReadList.Error())
+
+		return 0, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOKliners, daoKliners).
+		Debug(object.URIEmpty)
+
+	daoKlinersByStartAt := make(map[int64]dao.Kliner, len(daoKliners))
+	for _, daoKliner := range daoKliners {
+		daoKlinersByStartAt[daoKliner.GetStartAt()] = daoKliner
+	}
+
+	var count uint32
+
+	for key, omKline := range omKliners {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldOMKline, omKline).
+			Debug(object.URIEmpty)
+
+		startAt := omKline.GetStartAt()
+		if startAt < dtoKlineRequester.GetStartAt() || startAt >= dtoKlineRequester.GetEndAt() {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`startAt < dtoKlineRequester.GetStartAt() || startAt >= dtoKlineRequester.GetEndAt()`)
+
+			continue
+		}
+
+		daoKliner, ok := daoKlinersByStartAt[startAt]
+		if !ok {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`!ok`)
+
+			if _, err = service.Create(ctx, omKline); err != nil {
+				service.GetRuntimeLogger().
+					WithFields(fields).
+					WithField(object.URIFieldError, err).
+					Error(object.ErrKlineRepositoryCreate.Error())
+				traceSpan.RecordError(err)
+				traceSpan.SetStatus(codes.Error, object.ErrKlineRepositoryCreate.Error())
+
+				return count, err
+			}
+
+			count++
+
+			continue
+		}
+
+		if om.KlinerComparer(omKline, om.NewKline(
+			daoKliner.GetClose(),
+			daoKliner.GetHigh(),
+			daoKliner.GetKlineType(),
+			daoKliner.GetLow(),
+			daoKliner.GetOpen(),
+			daoKliner.GetSymbol(),
+			daoKliner.GetTurnover(),
+			daoKliner.GetVolume(),
+			daoKliner.GetStartAt(),
+			uuid.Nil,
+		)) {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`om.KlinerComparer(omKline, daoKliner)`)
+
+			continue
+		}
+
+		updatedAt, err := service.GetKlineRepositorier().Update(ctx, dao.NewKline(
+			daoKliner.GetCreatedAt(),
+			time.Time{},
+			sql.NullTime{
+				Time:  time.Time{},
+				Valid: false,
+			},
+			daoKliner.GetID(),
+			omKline.GetClose(),
+			omKline.GetHigh(),
+			omKline.GetKlineType(),
+			omKline.GetLow(),
+			omKline.GetOpen(),
+			omKline.GetSymbol(),
+			omKline.GetTurnover(),
+			omKline.GetVolume(),
+			omKline.GetStartAt(),
+		))
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrKlineRepositoryUpdate.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrKlineRepositoryUpdate.Error())
+
+			return count, err
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldUpdatedAt, updatedAt).
+			Debug(object.URIEmpty)
+
+		count++
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldCount, count).
+		Debug(object.URIEmpty)
+
+	return count, nil
+}
+
+func (service *klineService) getAllFromRepository(
+	ctx context.Context,
+	daoKlineFilterer dao.KlineFilterer,
+) ([]om.Kliner, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"getAllFromRepository",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":               "getAllFromRepository",
+		"rt_ctx":             utilRuntimeContext,
+		"sp_ctx":             utilSpanContext,
+		"config":             service.configConfigger,
+		"dao_kline_filterer": daoKlineFilterer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omKliners := make([]om.Kliner, 0, object.NUMKlineRequestCandleLimit)
+
+	var daoCursorer dao.Cursorer = dao.NewCursor(0)
+
+	for daoCursorer != nil {
+		omKlinersPage, daoNextCursorer, err := service.GetListFromRepository(
+			ctx,
+			dao.NewPagination(daoCursorer, object.NUMKlineRequestCandleLimit),
+			daoKlineFilterer,
+		)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrKlineRepositoryReadList.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrKlineRepositoryReadList.Error())
+
+			return nil, err
+		}
+
+		omKliners = append(omKliners, omKlinersPage...)
+		daoCursorer = daoNextCursorer
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMKlines, omKlinersPage).
+			WithField(object.URIFieldDAOCursor, daoCursorer).
+			Debug(object.URIEmpty)
+	}
+
+	return omKliners, nil
+}
+
+func (service *klineService) getListFromRemote(
+	ctx context.Context,
+	dtoKlineRequester dto.KlineRequester,
+) ([][]string, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"getListFromRemote",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                "getListFromRemote",
+		"rt_ctx":              utilRuntimeContext,
+		"sp_ctx":              utilSpanContext,
+		"config":              service.configConfigger,
+		"dto_kline_requester": dtoKlineRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	response, err := serviceExchanger(ctx, service).KLines(
+		dtoKlineRequester.GetSymbol(),
+		string(dtoKlineRequester.GetKlineType()),
+		dtoKlineRequester.GetStartAt(),
+		dtoKlineRequester.GetEndAt(),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKlineKucoinServiceGetList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineKucoinServiceGetList.Error())
+
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, errResponse).
+			Error(object.ErrKlineKucoinServiceGetList.Error())
+		traceSpan.RecordError(errResponse)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineKucoinServiceGetList.Error())
+
+		return nil, fmt.Errorf("%w: %w", object.ErrKlineKucoinServiceGetList, errResponse)
+	}
+
+	var kucoinKLinesModel [][]string
+
+	if err = response.ReadData(&kucoinKLinesModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadPaginationData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadPaginationData.Error())
+
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinKLinesModel, kucoinKLinesModel).
+		Debug(object.URIEmpty)
+
+	return kucoinKLinesModel, nil
+}
+
+func newKlineServiceBucket(
+	omKliner om.Kliner,
+	startAt int64,
+) *klineServiceBucket {
+	return &klineServiceBucket{
+		closeValue: omKliner.GetClose(),
+		high:       omKliner.GetHigh(),
+		low:        omKliner.GetLow(),
+		open:       omKliner.GetOpen(),
+		symbol:     omKliner.GetSymbol(),
+		turnover:   omKliner.GetTurnover(),
+		volume:     omKliner.GetVolume(),
+		count:      1,
+		startAt:    startAt,
+	}
+}
+
+func (bucket *klineServiceBucket) add(
+	omKliner om.Kliner,
+) error {
+	compareHigh, err := util.DecimalCompare(omKliner.GetHigh(), bucket.high)
+	if err != nil {
+		return err
+	}
+
+	if compareHigh > 0 {
+		bucket.high = omKliner.GetHigh()
+	}
+
+	compareLow, err := util.DecimalCompare(omKliner.GetLow(), bucket.low)
+	if err != nil {
+		return err
+	}
+
+	if compareLow < 0 {
+		bucket.low = omKliner.GetLow()
+	}
+
+	if bucket.turnover, err = util.DecimalAdd(bucket.turnover, omKliner.GetTurnover()); err != nil {
+		return err
+	}
+
+	if bucket.volume, err = util.DecimalAdd(bucket.volume, omKliner.GetVolume()); err != nil {
+		return err
+	}
+
+	bucket.closeValue = omKliner.GetClose()
+	bucket.count++
+
+	return nil
+}
+
+func (bucket *klineServiceBucket) kline(
+	klineType object.KlineTypeType,
+) om.Kliner {
+	return om.NewKline(
+		bucket.closeValue,
+		bucket.high,
+		string(klineType),
+		bucket.low,
+		bucket.open,
+		bucket.symbol,
+		bucket.turnover,
+		bucket.volume,
+		bucket.startAt,
+		uuid.Nil,
+	)
 }
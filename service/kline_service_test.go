@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+func TestKlineServiceResample(t *testing.T) {
+	t.Parallel()
+
+	configConfigger := config.NewConfig(config.WithLogConfigger())
+	startAt := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()
+	klineServicer := NewKlineServicer(
+		configConfigger,
+		nil,
+		log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+		trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+		util.NewUUID(),
+		&serviceTestTimer{
+			Timer: nil,
+			now:   time.Unix(startAt+14*object.NUM1MinToSecond, 0).UTC(),
+		},
+		nil,
+	)
+
+	omKliners := make([]om.Kliner, 0)
+
+	// The first bucket is complete, the second misses its third minute and the
+	// third is still forming.
+	for minute := int64(0); minute < 14; minute++ {
+		if minute == 7 {
+			continue
+		}
+
+		price := strconv.FormatInt(100+minute, 10)
+		omKliners = append(omKliners, om.NewKline(
+			price,
+			price,
+			string(object.KlineTypeType1min),
+			price,
+			price,
+			"BTC-USDT",
+			"10",
+			"1",
+			startAt+minute*object.NUM1MinToSecond,
+			uuid.Nil,
+		))
+	}
+
+	resampledOMKliners, err := klineServicer.Resample(
+		context.Background(),
+		omKliners,
+		object.KlineTypeType5min,
+	)
+	if err != nil {
+		t.Fatalf("Resample() error = %v", err)
+	}
+
+	if len(resampledOMKliners) != 1 {
+		t.Fatalf("Resample() = %d klines, want 1", len(resampledOMKliners))
+	}
+
+	omKliner := resampledOMKliners[0]
+	got := []string{
+		omKliner.GetOpen(),
+		omKliner.GetHigh(),
+		omKliner.GetLow(),
+		omKliner.GetClose(),
+		omKliner.GetVolume(),
+		omKliner.GetTurnover(),
+	}
+	want := []string{"100", "104", "100", "104", "5", "50"}
+
+	for index := range want {
+		if got[index] != want[index] {
+			t.Errorf("Resample()[0] = %v, want %v", got, want)
+
+			break
+		}
+	}
+
+	if omKliner.GetStartAt() != startAt {
+		t.Errorf("Resample()[0].GetStartAt() = %d, want %d", omKliner.GetStartAt(), startAt)
+	}
+}
@@ -0,0 +1,618 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// MarginBalanceServicer is an interface.
+	MarginBalanceServicer interface {
+		// Create is a function.
+		Create(
+			context.Context,
+			om.MarginBalancer,
+		) (uuid.UUID, error)
+		// DeleteAll is a function.
+		DeleteAll(
+			context.Context,
+		) (time.Time, error)
+		// Get is a function.
+		Get(
+			context.Context,
+			uuid.UUID,
+		) (om.MarginBalancer, error)
+		// GetListFromRemote is a function.
+		GetListFromRemote(
+			context.Context,
+		) error
+		// GetListFromRepository is a function.
+		GetListFromRepository(
+			context.Context,
+			dao.Paginationer,
+			dao.MarginBalanceFilterer,
+		) ([]om.MarginBalancer, dao.Cursorer, error)
+		// Upsert is a function.
+		Upsert(
+			context.Context,
+			om.MarginBalancer,
+		) (uuid.UUID, error)
+	}
+
+	// GetMarginBalanceServicer is an interface.
+	GetMarginBalanceServicer interface {
+		// GetMarginBalanceServicer is a function.
+		GetMarginBalanceServicer() MarginBalanceServicer
+	}
+
+	marginBalanceService struct {
+		configConfigger   config.Configger
+		repositorier      repository.MarginBalanceRepositorier
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+)
+
+var (
+	_ MarginBalanceServicer                   = (*marginBalanceService)(nil)
+	_ GetServicer                             = (*marginBalanceService)(nil)
+	_ WithServicer                            = (*marginBalanceService)(nil)
+	_ config.GetConfigger                     = (*marginBalanceService)(nil)
+	_ exchange.GetExchanger                   = (*marginBalanceService)(nil)
+	_ log.GetRuntimeLogger                    = (*marginBalanceService)(nil)
+	_ repository.GetMarginBalanceRepositorier = (*marginBalanceService)(nil)
+	_ util.GetTracer                          = (*marginBalanceService)(nil)
+	_ util.GetUUIDer                          = (*marginBalanceService)(nil)
+)
+
+// NewMarginBalanceServicer is a function.
+func NewMarginBalanceServicer(
+	configConfigger config.Configger,
+	repositorier repository.MarginBalanceRepositorier,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) MarginBalanceServicer {
+	return &marginBalanceService{
+		configConfigger:   configConfigger,
+		repositorier:      repositorier,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *marginBalanceService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *marginBalanceService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *marginBalanceService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetMarginBalanceRepositorier is a function.
+func (service *marginBalanceService) GetMarginBalanceRepositorier() repository.MarginBalanceRepositorier {
+	return service.repositorier
+}
+
+// GetTracer is a function.
+func (service *marginBalanceService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *marginBalanceService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *marginBalanceService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *marginBalanceService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// Create is a function.
+func (service *marginBalanceService) Create(
+	ctx context.Context,
+	omMarginBalancer om.MarginBalancer,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":               "Create",
+		"rt_ctx":             utilRuntimeContext,
+		"sp_ctx":             utilSpanContext,
+		"config":             service.configConfigger,
+		"om_margin_balancer": omMarginBalancer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoMarginBalance := dao.NewMarginBalance(
+		time.Time{},
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		uuid.Nil,
+		omMarginBalancer.GetAccount(),
+		omMarginBalancer.GetAvailable(),
+		omMarginBalancer.GetCurrency(),
+		omMarginBalancer.GetHolds(),
+		omMarginBalancer.GetLiability(),
+		omMarginBalancer.GetMaxBorrowSize(),
+		omMarginBalancer.GetTotal(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOMarginBalance, daoMarginBalance).
+		Debug(object.URIEmpty)
+
+	marginBalanceID, err := service.GetMarginBalanceRepositorier().Create(ctx, daoMarginBalance)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginBalanceRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginBalanceRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldMarginBalanceID, marginBalanceID).
+		Debug(object.URIEmpty)
+
+	return marginBalanceID, nil
+}
+
+// DeleteAll is a function.
+func (service *marginBalanceService) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	deletedAt, err := service.GetMarginBalanceRepositorier().DeleteAll(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginBalanceRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginBalanceRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDeletedAt, deletedAt).
+		Debug(object.URIEmpty)
+
+	return deletedAt, nil
+}
+
+// Get is a function.
+func (service *marginBalanceService) Get(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.MarginBalancer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Get",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Get",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoMarginBalance, err := service.GetMarginBalanceRepositorier().Read(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginBalanceRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginBalanceRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOMarginBalance, daoMarginBalance).
+		Debug(object.URIEmpty)
+
+	omMarginBalance := om.NewMarginBalance(
+		daoMarginBalance.GetAccount(),
+		daoMarginBalance.GetAvailable(),
+		daoMarginBalance.GetCurrency(),
+		daoMarginBalance.GetHolds(),
+		daoMarginBalance.GetLiability(),
+		daoMarginBalance.GetMaxBorrowSize(),
+		daoMarginBalance.GetTotal(),
+		daoMarginBalance.GetID(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMMarginBalance, omMarginBalance).
+		Debug(object.URIEmpty)
+
+	return omMarginBalance, nil
+}
+
+// GetListFromRemote is a function.
+// GetListFromRemote stores the cross margin balance of every currency, one row
+// per currency, with what is borrowed against it.
+func (service *marginBalanceService) GetListFromRemote(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRemote",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetListFromRemote",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	response, err := serviceExchanger(ctx, service).MarginAccount()
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginBalanceKucoinServiceGetList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginBalanceKucoinServiceGetList.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, errResponse).
+			Error(object.ErrMarginBalanceKucoinServiceGetList.Error())
+		traceSpan.RecordError(errResponse)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginBalanceKucoinServiceGetList.Error())
+
+		return fmt.Errorf("%w: %w", object.ErrMarginBalanceKucoinServiceGetList, errResponse)
+	}
+
+	kucoinMarginAccountModel := kucoin.MarginAccountModel{}
+
+	if err = response.ReadData(&kucoinMarginAccountModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinMarginAccountModel, kucoinMarginAccountModel).
+		Debug(object.URIEmpty)
+
+	for key, value := range kucoinMarginAccountModel.Accounts {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		omMarginBalance := om.NewMarginBalance(
+			serviceAccount(ctx, service),
+			value.AvailableBalance.String(),
+			value.Currency,
+			value.HoldBalance.String(),
+			value.Liability.String(),
+			value.MaxBorrowSize.String(),
+			value.TotalBalance.String(),
+			uuid.Nil,
+		)
+
+		marginBalanceID, errMarginBalanceUpsert := service.GetServicer().
+			GetMarginBalanceServicer().
+			Upsert(ctx, omMarginBalance)
+		if errMarginBalanceUpsert != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errMarginBalanceUpsert).
+				Error(object.ErrMarginBalanceServiceUpsert.Error())
+			traceSpan.RecordError(errMarginBalanceUpsert)
+			traceSpan.SetStatus(codes.Error, object.ErrMarginBalanceServiceUpsert.Error())
+
+			return errMarginBalanceUpsert
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldMarginBalanceID, marginBalanceID).
+			Debug(object.URIEmpty)
+	}
+
+	return nil
+}
+
+// GetListFromRepository is a function.
+func (service *marginBalanceService) GetListFromRepository(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoMarginBalanceFilterer dao.MarginBalanceFilterer,
+) ([]om.MarginBalancer, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRepository",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                        "GetListFromRepository",
+		"rt_ctx":                      utilRuntimeContext,
+		"sp_ctx":                      utilSpanContext,
+		"config":                      service.configConfigger,
+		"dao_paginationer":            daoPaginationer,
+		"dao_margin_balance_filterer": daoMarginBalanceFilterer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoMarginBalances, daoCursorer, err := service.GetMarginBalanceRepositorier().
+		ReadList(ctx, daoPaginationer, daoMarginBalanceFilterer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginBalanceRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginBalanceRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOMarginBalances, daoMarginBalances).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	omMarginBalances := make([]om.MarginBalancer, 0, len(daoMarginBalances))
+
+	for key, daoMarginBalance := range daoMarginBalances {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldDAOMarginBalance, daoMarginBalance).
+			Debug(object.URIEmpty)
+
+		omMarginBalances = append(omMarginBalances, om.NewMarginBalance(
+			daoMarginBalance.GetAccount(),
+			daoMarginBalance.GetAvailable(),
+			daoMarginBalance.GetCurrency(),
+			daoMarginBalance.GetHolds(),
+			daoMarginBalance.GetLiability(),
+			daoMarginBalance.GetMaxBorrowSize(),
+			daoMarginBalance.GetTotal(),
+			daoMarginBalance.GetID(),
+		))
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMMarginBalances, omMarginBalances).
+		Debug(object.URIEmpty)
+
+	return omMarginBalances, daoCursorer, nil
+}
+
+// Upsert is a function.
+func (service *marginBalanceService) Upsert(
+	ctx context.Context,
+	omMarginBalancer om.MarginBalancer,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Upsert",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":               "Upsert",
+		"rt_ctx":             utilRuntimeContext,
+		"sp_ctx":             utilSpanContext,
+		"config":             service.configConfigger,
+		"om_margin_balancer": omMarginBalancer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoMarginBalances, _, err := service.GetMarginBalanceRepositorier().ReadList(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewMarginBalanceFilter(omMarginBalancer.GetAccount(), omMarginBalancer.GetCurrency()),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginBalanceRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginBalanceRepositoryReadList.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOMarginBalances, daoMarginBalances).
+		Debug(object.URIEmpty)
+
+	if len(daoMarginBalances) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(daoMarginBalances) == 0`)
+
+		return service.GetServicer().GetMarginBalanceServicer().Create(ctx, omMarginBalancer)
+	}
+
+	daoMarginBalance := dao.NewMarginBalance(
+		daoMarginBalances[0].GetCreatedAt(),
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		daoMarginBalances[0].GetID(),
+		omMarginBalancer.GetAccount(),
+		omMarginBalancer.GetAvailable(),
+		omMarginBalancer.GetCurrency(),
+		omMarginBalancer.GetHolds(),
+		omMarginBalancer.GetLiability(),
+		omMarginBalancer.GetMaxBorrowSize(),
+		omMarginBalancer.GetTotal(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOMarginBalance, daoMarginBalance).
+		Debug(object.URIEmpty)
+
+	updatedAt, err := service.GetMarginBalanceRepositorier().Update(ctx, daoMarginBalance)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginBalanceRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginBalanceRepositoryUpdate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return daoMarginBalance.GetID(), nil
+}
@@ -0,0 +1,614 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// MarginRiskLimitServicer is an interface.
+	MarginRiskLimitServicer interface {
+		// Create is a function.
+		Create(
+			context.Context,
+			om.MarginRiskLimiter,
+		) (uuid.UUID, error)
+		// DeleteAll is a function.
+		DeleteAll(
+			context.Context,
+		) (time.Time, error)
+		// Get is a function.
+		Get(
+			context.Context,
+			uuid.UUID,
+		) (om.MarginRiskLimiter, error)
+		// GetListFromRemote is a function.
+		GetListFromRemote(
+			context.Context,
+			object.MarginModeType,
+		) error
+		// GetListFromRepository is a function.
+		GetListFromRepository(
+			context.Context,
+			dao.Paginationer,
+			dao.MarginRiskLimitFilterer,
+		) ([]om.MarginRiskLimiter, dao.Cursorer, error)
+		// Upsert is a function.
+		Upsert(
+			context.Context,
+			om.MarginRiskLimiter,
+		) (uuid.UUID, error)
+	}
+
+	// GetMarginRiskLimitServicer is an interface.
+	GetMarginRiskLimitServicer interface {
+		// GetMarginRiskLimitServicer is a function.
+		GetMarginRiskLimitServicer() MarginRiskLimitServicer
+	}
+
+	marginRiskLimitService struct {
+		configConfigger   config.Configger
+		repositorier      repository.MarginRiskLimitRepositorier
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+)
+
+var (
+	_ MarginRiskLimitServicer                   = (*marginRiskLimitService)(nil)
+	_ GetServicer                               = (*marginRiskLimitService)(nil)
+	_ WithServicer                              = (*marginRiskLimitService)(nil)
+	_ config.GetConfigger                       = (*marginRiskLimitService)(nil)
+	_ exchange.GetExchanger                     = (*marginRiskLimitService)(nil)
+	_ log.GetRuntimeLogger                      = (*marginRiskLimitService)(nil)
+	_ repository.GetMarginRiskLimitRepositorier = (*marginRiskLimitService)(nil)
+	_ util.GetTracer                            = (*marginRiskLimitService)(nil)
+	_ util.GetUUIDer                            = (*marginRiskLimitService)(nil)
+)
+
+// NewMarginRiskLimitServicer is a function.
+func NewMarginRiskLimitServicer(
+	configConfigger config.Configger,
+	repositorier repository.MarginRiskLimitRepositorier,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) MarginRiskLimitServicer {
+	return &marginRiskLimitService{
+		configConfigger:   configConfigger,
+		repositorier:      repositorier,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *marginRiskLimitService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *marginRiskLimitService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *marginRiskLimitService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetMarginRiskLimitRepositorier is a function.
+func (service *marginRiskLimitService) GetMarginRiskLimitRepositorier() repository.MarginRiskLimitRepositorier {
+	return service.repositorier
+}
+
+// GetTracer is a function.
+func (service *marginRiskLimitService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *marginRiskLimitService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *marginRiskLimitService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *marginRiskLimitService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// Create is a function.
+func (service *marginRiskLimitService) Create(
+	ctx context.Context,
+	omMarginRiskLimiter om.MarginRiskLimiter,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                   "Create",
+		"rt_ctx":                 utilRuntimeContext,
+		"sp_ctx":                 utilSpanContext,
+		"config":                 service.configConfigger,
+		"om_margin_risk_limiter": omMarginRiskLimiter,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoMarginRiskLimit := dao.NewMarginRiskLimit(
+		time.Time{},
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		uuid.Nil,
+		omMarginRiskLimiter.GetBorrowMaxAmount(),
+		omMarginRiskLimiter.GetBuyMaxAmount(),
+		omMarginRiskLimiter.GetCurrency(),
+		omMarginRiskLimiter.GetMarginModel(),
+		omMarginRiskLimiter.GetPrecision(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOMarginRiskLimit, daoMarginRiskLimit).
+		Debug(object.URIEmpty)
+
+	marginRiskLimitID, err := service.GetMarginRiskLimitRepositorier().Create(ctx, daoMarginRiskLimit)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginRiskLimitRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginRiskLimitRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldMarginRiskLimitID, marginRiskLimitID).
+		Debug(object.URIEmpty)
+
+	return marginRiskLimitID, nil
+}
+
+// DeleteAll is a function.
+func (service *marginRiskLimitService) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	deletedAt, err := service.GetMarginRiskLimitRepositorier().DeleteAll(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginRiskLimitRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginRiskLimitRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDeletedAt, deletedAt).
+		Debug(object.URIEmpty)
+
+	return deletedAt, nil
+}
+
+// Get is a function.
+func (service *marginRiskLimitService) Get(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.MarginRiskLimiter, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Get",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Get",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoMarginRiskLimit, err := service.GetMarginRiskLimitRepositorier().Read(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginRiskLimitRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginRiskLimitRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOMarginRiskLimit, daoMarginRiskLimit).
+		Debug(object.URIEmpty)
+
+	omMarginRiskLimit := om.NewMarginRiskLimit(
+		daoMarginRiskLimit.GetBorrowMaxAmount(),
+		daoMarginRiskLimit.GetBuyMaxAmount(),
+		daoMarginRiskLimit.GetCurrency(),
+		daoMarginRiskLimit.GetMarginModel(),
+		daoMarginRiskLimit.GetPrecision(),
+		daoMarginRiskLimit.GetID(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMMarginRiskLimit, omMarginRiskLimit).
+		Debug(object.URIEmpty)
+
+	return omMarginRiskLimit, nil
+}
+
+// GetListFromRemote is a function.
+// GetListFromRemote stores the borrow and buy limits of every currency of the
+// margin mode, one row per currency and mode.
+func (service *marginRiskLimitService) GetListFromRemote(
+	ctx context.Context,
+	marginMode object.MarginModeType,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRemote",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":        "GetListFromRemote",
+		"rt_ctx":      utilRuntimeContext,
+		"sp_ctx":      utilSpanContext,
+		"config":      service.configConfigger,
+		"margin_mode": marginMode,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	response, err := serviceExchanger(ctx, service).MarginRiskLimit(string(marginMode))
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginRiskLimitKucoinServiceGetList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginRiskLimitKucoinServiceGetList.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, errResponse).
+			Error(object.ErrMarginRiskLimitKucoinServiceGetList.Error())
+		traceSpan.RecordError(errResponse)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginRiskLimitKucoinServiceGetList.Error())
+
+		return fmt.Errorf("%w: %w", object.ErrMarginRiskLimitKucoinServiceGetList, errResponse)
+	}
+
+	kucoinMarginRiskLimitModel := kucoin.MarginRiskLimitModel{}
+
+	if err = response.ReadData(&kucoinMarginRiskLimitModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinMarginRiskLimitModel, kucoinMarginRiskLimitModel).
+		Debug(object.URIEmpty)
+
+	for key, value := range kucoinMarginRiskLimitModel {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		omMarginRiskLimit := om.NewMarginRiskLimit(
+			value.BorrowMaxAmount,
+			value.BuyMaxAmount,
+			value.Currency,
+			string(marginMode),
+			value.Precision.String(),
+			uuid.Nil,
+		)
+
+		marginRiskLimitID, errMarginRiskLimitUpsert := service.GetServicer().
+			GetMarginRiskLimitServicer().
+			Upsert(ctx, omMarginRiskLimit)
+		if errMarginRiskLimitUpsert != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errMarginRiskLimitUpsert).
+				Error(object.ErrMarginRiskLimitServiceUpsert.Error())
+			traceSpan.RecordError(errMarginRiskLimitUpsert)
+			traceSpan.SetStatus(codes.Error, object.ErrMarginRiskLimitServiceUpsert.Error())
+
+			return errMarginRiskLimitUpsert
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldMarginRiskLimitID, marginRiskLimitID).
+			Debug(object.URIEmpty)
+	}
+
+	return nil
+}
+
+// GetListFromRepository is a function.
+func (service *marginRiskLimitService) GetListFromRepository(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoMarginRiskLimitFilterer dao.MarginRiskLimitFilterer,
+) ([]om.MarginRiskLimiter, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRepository",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                           "GetListFromRepository",
+		"rt_ctx":                         utilRuntimeContext,
+		"sp_ctx":                         utilSpanContext,
+		"config":                         service.configConfigger,
+		"dao_paginationer":               daoPaginationer,
+		"dao_margin_risk_limit_filterer": daoMarginRiskLimitFilterer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoMarginRiskLimits, daoCursorer, err := service.GetMarginRiskLimitRepositorier().
+		ReadList(ctx, daoPaginationer, daoMarginRiskLimitFilterer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginRiskLimitRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginRiskLimitRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOMarginRiskLimits, daoMarginRiskLimits).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	omMarginRiskLimits := make([]om.MarginRiskLimiter, 0, len(daoMarginRiskLimits))
+
+	for key, daoMarginRiskLimit := range daoMarginRiskLimits {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldDAOMarginRiskLimit, daoMarginRiskLimit).
+			Debug(object.URIEmpty)
+
+		omMarginRiskLimits = append(omMarginRiskLimits, om.NewMarginRiskLimit(
+			daoMarginRiskLimit.GetBorrowMaxAmount(),
+			daoMarginRiskLimit.GetBuyMaxAmount(),
+			daoMarginRiskLimit.GetCurrency(),
+			daoMarginRiskLimit.GetMarginModel(),
+			daoMarginRiskLimit.GetPrecision(),
+			daoMarginRiskLimit.GetID(),
+		))
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMMarginRiskLimits, omMarginRiskLimits).
+		Debug(object.URIEmpty)
+
+	return omMarginRiskLimits, daoCursorer, nil
+}
+
+// Upsert is a function.
+func (service *marginRiskLimitService) Upsert(
+	ctx context.Context,
+	omMarginRiskLimiter om.MarginRiskLimiter,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Upsert",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                   "Upsert",
+		"rt_ctx":                 utilRuntimeContext,
+		"sp_ctx":                 utilSpanContext,
+		"config":                 service.configConfigger,
+		"om_margin_risk_limiter": omMarginRiskLimiter,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoMarginRiskLimits, _, err := service.GetMarginRiskLimitRepositorier().ReadList(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewMarginRiskLimitFilter(
+			omMarginRiskLimiter.GetCurrency(),
+			omMarginRiskLimiter.GetMarginModel(),
+		),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginRiskLimitRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginRiskLimitRepositoryReadList.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOMarginRiskLimits, daoMarginRiskLimits).
+		Debug(object.URIEmpty)
+
+	if len(daoMarginRiskLimits) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(daoMarginRiskLimits) == 0`)
+
+		return service.GetServicer().GetMarginRiskLimitServicer().Create(ctx, omMarginRiskLimiter)
+	}
+
+	daoMarginRiskLimit := dao.NewMarginRiskLimit(
+		daoMarginRiskLimits[0].GetCreatedAt(),
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		daoMarginRiskLimits[0].GetID(),
+		omMarginRiskLimiter.GetBorrowMaxAmount(),
+		omMarginRiskLimiter.GetBuyMaxAmount(),
+		omMarginRiskLimiter.GetCurrency(),
+		omMarginRiskLimiter.GetMarginModel(),
+		omMarginRiskLimiter.GetPrecision(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOMarginRiskLimit, daoMarginRiskLimit).
+		Debug(object.URIEmpty)
+
+	updatedAt, err := service.GetMarginRiskLimitRepositorier().Update(ctx, daoMarginRiskLimit)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginRiskLimitRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginRiskLimitRepositoryUpdate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return daoMarginRiskLimit.GetID(), nil
+}
@@ -0,0 +1,667 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// MarginServicer is an interface.
+	MarginServicer interface {
+		// Borrow is a function.
+		Borrow(
+			context.Context,
+			string,
+			string,
+		) (string, error)
+		// GetDebtRatio is a function.
+		GetDebtRatio(
+			context.Context,
+		) (string, error)
+		// Place is a function.
+		Place(
+			context.Context,
+			...dto.PlaceMarginOrderRequester,
+		) ([]om.Orderer, error)
+		// RepayAll is a function.
+		RepayAll(
+			context.Context,
+			string,
+			string,
+		) error
+		// RepaySingle is a function.
+		RepaySingle(
+			context.Context,
+			string,
+			string,
+			string,
+		) error
+		// Sync is a function.
+		Sync(
+			context.Context,
+		) error
+	}
+
+	// GetMarginServicer is an interface.
+	GetMarginServicer interface {
+		// GetMarginServicer is a function.
+		GetMarginServicer() MarginServicer
+	}
+
+	marginService struct {
+		configConfigger   config.Configger
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+)
+
+var (
+	_ GetServicer           = (*marginService)(nil)
+	_ MarginServicer        = (*marginService)(nil)
+	_ WithServicer          = (*marginService)(nil)
+	_ config.GetConfigger   = (*marginService)(nil)
+	_ exchange.GetExchanger = (*marginService)(nil)
+	_ log.GetRuntimeLogger  = (*marginService)(nil)
+	_ util.GetTracer        = (*marginService)(nil)
+	_ util.GetUUIDer        = (*marginService)(nil)
+)
+
+// NewMarginServicer is a function.
+func NewMarginServicer(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) MarginServicer {
+	return &marginService{
+		configConfigger:   configConfigger,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *marginService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *marginService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *marginService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetTracer is a function.
+func (service *marginService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *marginService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *marginService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *marginService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// Borrow is a function.
+// Borrow borrows the size of the currency into the cross margin account and
+// returns the id of the borrow order. The order is fill or kill, so nothing is
+// borrowed when the lending market cannot lend the whole size.
+func (service *marginService) Borrow(
+	ctx context.Context,
+	currency string,
+	size string,
+) (string, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Borrow",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":     "Borrow",
+		"rt_ctx":   utilRuntimeContext,
+		"sp_ctx":   utilSpanContext,
+		"config":   service.configConfigger,
+		"currency": currency,
+		"size":     size,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if service.GetConfigger().GetPaperConfigger().GetEnabled() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrMarginPaperUnsupported).
+			Error(object.ErrMarginServiceBorrow.Error())
+		traceSpan.RecordError(object.ErrMarginPaperUnsupported)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginServiceBorrow.Error())
+
+		return object.URIEmpty, object.ErrMarginPaperUnsupported
+	}
+
+	response, err := serviceExchanger(ctx, service).CreateBorrowOrder(map[string]string{
+		object.URIFieldCurrency: currency,
+		object.URIFieldSize:     size,
+		object.URIFieldType:     object.URIKucoinBorrowTypeFOK,
+	})
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if err = marginServiceResponseError(
+		response,
+		err,
+		object.ErrMarginKucoinServiceBorrow,
+	); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginKucoinServiceBorrow.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginKucoinServiceBorrow.Error())
+
+		return object.URIEmpty, err
+	}
+
+	kucoinCreateBorrowOrderResultModel := kucoin.CreateBorrowOrderResultModel{}
+
+	if err = response.ReadData(&kucoinCreateBorrowOrderResultModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return object.URIEmpty, fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(
+			object.URIFieldKucoinBorrowOrderResultModel,
+			kucoinCreateBorrowOrderResultModel,
+		).
+		Debug(object.URIEmpty)
+
+	return kucoinCreateBorrowOrderResultModel.OrderId, nil
+}
+
+// GetDebtRatio is a function.
+// GetDebtRatio is the debt ratio of the cross margin account as the exchange
+// reports it now, the liabilities over the total assets. Nothing is borrowed in
+// paper mode, so the ratio is 0 there.
+func (service *marginService) GetDebtRatio(
+	ctx context.Context,
+) (string, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetDebtRatio",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetDebtRatio",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if service.GetConfigger().GetPaperConfigger().GetEnabled() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`service.GetConfigger().GetPaperConfigger().GetEnabled()`)
+
+		return "0", nil
+	}
+
+	response, err := serviceExchanger(ctx, service).MarginAccount()
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if err = marginServiceResponseError(
+		response,
+		err,
+		object.ErrMarginKucoinServiceGetAccount,
+	); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginKucoinServiceGetAccount.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginKucoinServiceGetAccount.Error())
+
+		return object.URIEmpty, err
+	}
+
+	kucoinMarginAccountModel := kucoin.MarginAccountModel{}
+
+	if err = response.ReadData(&kucoinMarginAccountModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return object.URIEmpty, fmt.Errorf("%w", err)
+	}
+
+	debtRatio := kucoinMarginAccountModel.DebtRatio.String()
+	if debtRatio == object.URIEmpty {
+		debtRatio = "0"
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDebtRatio, debtRatio).
+		Debug(object.URIEmpty)
+
+	return debtRatio, nil
+}
+
+// Place is a function.
+// Place places cross and isolated margin orders, picked by their trade type,
+// through the order lifecycle of OrderServicer, so they are validated, risk
+// checked, stored and reconciled like spot orders. Margin is not simulated, so
+// nothing is placed in paper mode.
+func (service *marginService) Place(
+	ctx context.Context,
+	dtoPlaceMarginOrderRequesters ...dto.PlaceMarginOrderRequester,
+) ([]om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Place",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                              "Place",
+		"rt_ctx":                            utilRuntimeContext,
+		"sp_ctx":                            utilSpanContext,
+		"config":                            service.configConfigger,
+		"dto_place_margin_order_requesters": dtoPlaceMarginOrderRequesters,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if service.GetConfigger().GetPaperConfigger().GetEnabled() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrMarginPaperUnsupported).
+			Error(object.ErrMarginServicePlace.Error())
+		traceSpan.RecordError(object.ErrMarginPaperUnsupported)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginServicePlace.Error())
+
+		return nil, object.ErrMarginPaperUnsupported
+	}
+
+	dtoPlaceOrderRequesters := make([]dto.PlaceOrderRequester, 0, len(dtoPlaceMarginOrderRequesters))
+	errs := make([]error, 0)
+
+	for key, dtoPlaceMarginOrderRequester := range dtoPlaceMarginOrderRequesters {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, dtoPlaceMarginOrderRequester).
+			Debug(object.URIEmpty)
+
+		if _, ok := orderServiceMarginMode(dtoPlaceMarginOrderRequester.GetTradeType()); !ok {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`_, ok := orderServiceMarginMode(dtoPlaceMarginOrderRequester.GetTradeType()); !ok`)
+
+			errs = append(errs, fmt.Errorf(
+				"%w: client oid %s: %s",
+				object.ErrMarginTradeTypeInvalid,
+				dtoPlaceMarginOrderRequester.GetClientOID(),
+				dtoPlaceMarginOrderRequester.GetTradeType(),
+			))
+
+			continue
+		}
+
+		dtoPlaceOrderRequesters = append(dtoPlaceOrderRequesters, dtoPlaceMarginOrderRequester)
+	}
+
+	omOrderers, err := service.GetServicer().GetOrderServicer().Place(ctx, dtoPlaceOrderRequesters...)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServicePlace.Error())
+
+		errs = append(errs, err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrders, omOrderers).
+		Debug(object.URIEmpty)
+
+	if err = errors.Join(errs...); err != nil {
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginServicePlace.Error())
+
+		return omOrderers, err
+	}
+
+	return omOrderers, nil
+}
+
+// RepayAll is a function.
+// RepayAll repays the size of the currency across its borrows, the ones that
+// expire first first.
+func (service *marginService) RepayAll(
+	ctx context.Context,
+	currency string,
+	size string,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"RepayAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":     "RepayAll",
+		"rt_ctx":   utilRuntimeContext,
+		"sp_ctx":   utilSpanContext,
+		"config":   service.configConfigger,
+		"currency": currency,
+		"size":     size,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if service.GetConfigger().GetPaperConfigger().GetEnabled() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrMarginPaperUnsupported).
+			Error(object.ErrMarginServiceRepayAll.Error())
+		traceSpan.RecordError(object.ErrMarginPaperUnsupported)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginServiceRepayAll.Error())
+
+		return object.ErrMarginPaperUnsupported
+	}
+
+	response, err := serviceExchanger(ctx, service).RepayAll(map[string]string{
+		object.URIFieldCurrency: currency,
+		object.URIFieldSequence: object.URIKucoinRepaySequenceRecentlyExpireFirst,
+		object.URIFieldSize:     size,
+	})
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if err = marginServiceResponseError(
+		response,
+		err,
+		object.ErrMarginKucoinServiceRepay,
+	); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginKucoinServiceRepay.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginKucoinServiceRepay.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// RepaySingle is a function.
+// RepaySingle repays the size of one borrow, named by its trade id.
+func (service *marginService) RepaySingle(
+	ctx context.Context,
+	currency string,
+	tradeID string,
+	size string,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"RepaySingle",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":     "RepaySingle",
+		"rt_ctx":   utilRuntimeContext,
+		"sp_ctx":   utilSpanContext,
+		"config":   service.configConfigger,
+		"currency": currency,
+		"trade_id": tradeID,
+		"size":     size,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if service.GetConfigger().GetPaperConfigger().GetEnabled() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrMarginPaperUnsupported).
+			Error(object.ErrMarginServiceRepaySingle.Error())
+		traceSpan.RecordError(object.ErrMarginPaperUnsupported)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginServiceRepaySingle.Error())
+
+		return object.ErrMarginPaperUnsupported
+	}
+
+	response, err := serviceExchanger(ctx, service).RepaySingle(map[string]string{
+		object.URIFieldCurrency:      currency,
+		object.URIFieldSize:          size,
+		object.URIKucoinParamTradeID: tradeID,
+	})
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if err = marginServiceResponseError(
+		response,
+		err,
+		object.ErrMarginKucoinServiceRepay,
+	); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginKucoinServiceRepay.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginKucoinServiceRepay.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// Sync is a function.
+// Sync stores the margin balances and liabilities, the risk limits of both
+// margin modes and the outstanding and repaid borrow records. One part failing
+// does not stop the others. There is nothing to sync in paper mode.
+func (service *marginService) Sync(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Sync",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Sync",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if service.GetConfigger().GetPaperConfigger().GetEnabled() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`service.GetConfigger().GetPaperConfigger().GetEnabled()`)
+
+		return nil
+	}
+
+	errs := make([]error, 0)
+
+	if err := service.GetServicer().
+		GetMarginBalanceServicer().
+		GetListFromRemote(ctx); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrMarginBalanceServiceGetListFromRemote.Error())
+
+		errs = append(errs, err)
+	}
+
+	for _, marginMode := range []object.MarginModeType{
+		object.MarginModeTypeCross,
+		object.MarginModeTypeIsolated,
+	} {
+		if err := service.GetServicer().
+			GetMarginRiskLimitServicer().
+			GetListFromRemote(ctx, marginMode); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrMarginRiskLimitServiceGetListFromRemote.Error())
+
+			errs = append(errs, err)
+		}
+	}
+
+	for _, status := range []object.BorrowStatusType{
+		object.BorrowStatusTypeOutstanding,
+		object.BorrowStatusTypeRepaid,
+	} {
+		if err := service.GetServicer().
+			GetBorrowServicer().
+			GetListFromRemote(ctx, status, 1); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrBorrowServiceGetListFromRemote.Error())
+
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrMarginServiceSync.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// marginServiceResponseError is the error of a margin request the exchange did
+// not accept, wrapped in errKucoin.
+func marginServiceResponseError(
+	response *kucoin.ApiResponse,
+	err error,
+	errKucoin error,
+) error {
+	if err != nil {
+		return fmt.Errorf("%w: %w", errKucoin, err)
+	}
+
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
+		return fmt.Errorf("%w: %w", errKucoin, errResponse)
+	}
+
+	return nil
+}
@@ -0,0 +1,597 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange/exchangetest"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	marginServiceTestPathAccount   = "/api/v1/margin/account"
+	marginServiceTestPathBorrow    = "/api/v1/margin/borrow"
+	marginServiceTestPathRepayAll  = "/api/v1/margin/repay/all"
+	marginServiceTestPathRepayOnce = "/api/v1/margin/repay/single"
+)
+
+var errMarginServiceTest = errors.New("margin service test")
+
+type (
+	marginServiceTestServicer struct {
+		Servicer
+		borrowServicer          BorrowServicer
+		marginBalanceServicer   MarginBalanceServicer
+		marginRiskLimitServicer MarginRiskLimitServicer
+		orderServicer           OrderServicer
+	}
+
+	// marginServiceTestBorrowServicer fails to sync the repaid borrows.
+	marginServiceTestBorrowServicer struct {
+		BorrowServicer
+		statuses []object.BorrowStatusType
+		mutex    sync.Mutex
+	}
+
+	// marginServiceTestMarginBalanceRepositorier keeps the margin balances
+	// instead of storing them, so the test runs without a database.
+	marginServiceTestMarginBalanceRepositorier struct {
+		repository.MarginBalanceRepositorier
+		daoMarginBalances []dao.MarginBalancer
+		mutex             sync.Mutex
+	}
+
+	marginServiceTestMarginRiskLimitServicer struct {
+		MarginRiskLimitServicer
+		marginModes []object.MarginModeType
+		mutex       sync.Mutex
+	}
+
+	marginServiceTestOrderServicer struct {
+		OrderServicer
+		dtoPlaceOrderRequesters []dto.PlaceOrderRequester
+		mutex                   sync.Mutex
+	}
+)
+
+// GetBorrowServicer is a function.
+func (servicer *marginServiceTestServicer) GetBorrowServicer() BorrowServicer {
+	return servicer.borrowServicer
+}
+
+// GetMarginBalanceServicer is a function.
+func (servicer *marginServiceTestServicer) GetMarginBalanceServicer() MarginBalanceServicer {
+	return servicer.marginBalanceServicer
+}
+
+// GetMarginRiskLimitServicer is a function.
+func (servicer *marginServiceTestServicer) GetMarginRiskLimitServicer() MarginRiskLimitServicer {
+	return servicer.marginRiskLimitServicer
+}
+
+// GetOrderServicer is a function.
+func (servicer *marginServiceTestServicer) GetOrderServicer() OrderServicer {
+	return servicer.orderServicer
+}
+
+// GetListFromRemote is a function.
+func (servicer *marginServiceTestBorrowServicer) GetListFromRemote(
+	_ context.Context,
+	status object.BorrowStatusType,
+	_ int64,
+) error {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	servicer.statuses = append(servicer.statuses, status)
+
+	if status == object.BorrowStatusTypeRepaid {
+		return errMarginServiceTest
+	}
+
+	return nil
+}
+
+// Create is a function.
+func (repositorier *marginServiceTestMarginBalanceRepositorier) Create(
+	_ context.Context,
+	daoMarginBalancer dao.MarginBalancer,
+) (uuid.UUID, error) {
+	repositorier.mutex.Lock()
+	defer repositorier.mutex.Unlock()
+
+	id := uuid.New()
+
+	repositorier.daoMarginBalances = append(
+		repositorier.daoMarginBalances,
+		marginServiceTestMarginBalanceWithID(daoMarginBalancer, id),
+	)
+
+	return id, nil
+}
+
+// ReadList is a function.
+func (repositorier *marginServiceTestMarginBalanceRepositorier) ReadList(
+	_ context.Context,
+	_ dao.Paginationer,
+	daoMarginBalanceFilterer dao.MarginBalanceFilterer,
+) ([]dao.MarginBalancer, dao.Cursorer, error) {
+	repositorier.mutex.Lock()
+	defer repositorier.mutex.Unlock()
+
+	for _, daoMarginBalance := range repositorier.daoMarginBalances {
+		if daoMarginBalance.GetAccount() == daoMarginBalanceFilterer.GetAccount() &&
+			daoMarginBalance.GetCurrency() == daoMarginBalanceFilterer.GetCurrency() {
+			return []dao.MarginBalancer{daoMarginBalance}, nil, nil
+		}
+	}
+
+	return []dao.MarginBalancer{}, nil, nil
+}
+
+// Update is a function.
+func (repositorier *marginServiceTestMarginBalanceRepositorier) Update(
+	_ context.Context,
+	daoMarginBalancer dao.MarginBalancer,
+) (time.Time, error) {
+	repositorier.mutex.Lock()
+	defer repositorier.mutex.Unlock()
+
+	for key, daoMarginBalance := range repositorier.daoMarginBalances {
+		if daoMarginBalance.GetID() == daoMarginBalancer.GetID() {
+			repositorier.daoMarginBalances[key] = daoMarginBalancer
+
+			return time.Time{}, nil
+		}
+	}
+
+	return time.Time{}, object.ErrMarginBalanceRepositoryUpdate
+}
+
+func (repositorier *marginServiceTestMarginBalanceRepositorier) get() []dao.MarginBalancer {
+	repositorier.mutex.Lock()
+	defer repositorier.mutex.Unlock()
+
+	return append([]dao.MarginBalancer{}, repositorier.daoMarginBalances...)
+}
+
+// GetListFromRemote is a function.
+func (servicer *marginServiceTestMarginRiskLimitServicer) GetListFromRemote(
+	_ context.Context,
+	marginMode object.MarginModeType,
+) error {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	servicer.marginModes = append(servicer.marginModes, marginMode)
+
+	return nil
+}
+
+// Place is a function.
+func (servicer *marginServiceTestOrderServicer) Place(
+	_ context.Context,
+	dtoPlaceOrderRequesters ...dto.PlaceOrderRequester,
+) ([]om.Orderer, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	servicer.dtoPlaceOrderRequesters = append(
+		servicer.dtoPlaceOrderRequesters,
+		dtoPlaceOrderRequesters...,
+	)
+
+	return []om.Orderer{}, nil
+}
+
+func marginServiceTestMarginBalanceWithID(
+	daoMarginBalancer dao.MarginBalancer,
+	id uuid.UUID,
+) dao.MarginBalancer {
+	return dao.NewMarginBalance(
+		time.Time{},
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		id,
+		daoMarginBalancer.GetAccount(),
+		daoMarginBalancer.GetAvailable(),
+		daoMarginBalancer.GetCurrency(),
+		daoMarginBalancer.GetHolds(),
+		daoMarginBalancer.GetLiability(),
+		daoMarginBalancer.GetMaxBorrowSize(),
+		daoMarginBalancer.GetTotal(),
+	)
+}
+
+type marginServiceTest struct {
+	fakeServerer                  exchangetest.FakeServerer
+	marginServicer                MarginServicer
+	testBorrowServicer            *marginServiceTestBorrowServicer
+	testMarginBalanceRepositorier *marginServiceTestMarginBalanceRepositorier
+	testMarginRiskLimitServicer   *marginServiceTestMarginRiskLimitServicer
+	testOrderServicer             *marginServiceTestOrderServicer
+}
+
+func newMarginServiceTest(
+	t *testing.T,
+	paper bool,
+) *marginServiceTest {
+	t.Helper()
+
+	fakeServerer := exchangetest.NewFakeServer(
+		kucoin.ApiKeyOption("key"),
+		kucoin.ApiSecretOption("secret"),
+		kucoin.ApiPassPhraseOption("passphrase"),
+		kucoin.ApiKeyVersionOption(kucoin.ApiKeyVersionV2),
+	)
+	t.Cleanup(fakeServerer.Close)
+
+	configConfigger := config.NewConfig(
+		config.WithKucoinConfigger(),
+		config.WithLogConfigger(),
+		config.WithPaperConfigger(
+			config.WithPaperConfigEnabled(paper),
+		),
+	)
+	logRuntimeLogger := log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop())
+	traceTracer := trace.NewNoopTracerProvider().Tracer(object.URIEmpty)
+
+	testMarginBalanceRepositorier := &marginServiceTestMarginBalanceRepositorier{
+		MarginBalanceRepositorier: nil,
+		daoMarginBalances:         []dao.MarginBalancer{},
+		mutex:                     sync.Mutex{},
+	}
+
+	marginBalanceServicer := NewMarginBalanceServicer(
+		configConfigger,
+		testMarginBalanceRepositorier,
+		logRuntimeLogger,
+		traceTracer,
+		util.NewUUID(),
+		fakeServerer.GetExchanger(),
+	)
+
+	marginServicer := NewMarginServicer(
+		configConfigger,
+		logRuntimeLogger,
+		traceTracer,
+		util.NewUUID(),
+		fakeServerer.GetExchanger(),
+	)
+
+	test := &marginServiceTest{
+		fakeServerer:   fakeServerer,
+		marginServicer: marginServicer,
+		testBorrowServicer: &marginServiceTestBorrowServicer{
+			BorrowServicer: nil,
+			statuses:       []object.BorrowStatusType{},
+			mutex:          sync.Mutex{},
+		},
+		testMarginBalanceRepositorier: testMarginBalanceRepositorier,
+		testMarginRiskLimitServicer: &marginServiceTestMarginRiskLimitServicer{
+			MarginRiskLimitServicer: nil,
+			marginModes:             []object.MarginModeType{},
+			mutex:                   sync.Mutex{},
+		},
+		testOrderServicer: &marginServiceTestOrderServicer{
+			OrderServicer:           nil,
+			dtoPlaceOrderRequesters: []dto.PlaceOrderRequester{},
+			mutex:                   sync.Mutex{},
+		},
+	}
+
+	testServicer := &marginServiceTestServicer{
+		Servicer:                nil,
+		borrowServicer:          test.testBorrowServicer,
+		marginBalanceServicer:   marginBalanceServicer,
+		marginRiskLimitServicer: test.testMarginRiskLimitServicer,
+		orderServicer:           test.testOrderServicer,
+	}
+
+	marginBalanceServicer.(WithServicer).WithServicer(testServicer)
+	marginServicer.(WithServicer).WithServicer(testServicer)
+
+	return test
+}
+
+func newMarginServiceTestAccount(
+	debtRatio string,
+	liability string,
+) exchangetest.FakeResponser {
+	return exchangetest.NewFakeSuccessResponse(map[string]any{
+		"debtRatio": debtRatio,
+		"accounts": []any{
+			map[string]any{
+				"currency":         "USDT",
+				"availableBalance": "900",
+				"holdBalance":      "100",
+				"liability":        liability,
+				"maxBorrowSize":    "5000",
+				"totalBalance":     "1000",
+			},
+			map[string]any{
+				"currency":         "BTC",
+				"availableBalance": "1",
+				"holdBalance":      "0",
+				"liability":        "0",
+				"maxBorrowSize":    "2",
+				"totalBalance":     "1",
+			},
+		},
+	})
+}
+
+func TestMarginServiceSync(t *testing.T) {
+	t.Parallel()
+
+	test := newMarginServiceTest(t, false)
+
+	// The second sync finds the liability grown and updates the stored rows.
+	test.fakeServerer.Enqueue(
+		http.MethodGet,
+		marginServiceTestPathAccount,
+		newMarginServiceTestAccount("0.1", "100"),
+		newMarginServiceTestAccount("0.2", "200"),
+	)
+
+	for _, wantLiability := range []string{"100", "200"} {
+		err := test.marginServicer.Sync(context.Background())
+		if !errors.Is(err, errMarginServiceTest) {
+			t.Fatalf("Sync() error = %v, want %v", err, errMarginServiceTest)
+		}
+
+		daoMarginBalances := test.testMarginBalanceRepositorier.get()
+		if len(daoMarginBalances) != 2 {
+			t.Fatalf("stored margin balances = %d, want 2", len(daoMarginBalances))
+		}
+
+		daoMarginBalance := daoMarginBalances[0]
+		if daoMarginBalance.GetCurrency() != "USDT" ||
+			daoMarginBalance.GetAvailable() != "900" ||
+			daoMarginBalance.GetHolds() != "100" ||
+			daoMarginBalance.GetLiability() != wantLiability ||
+			daoMarginBalance.GetMaxBorrowSize() != "5000" ||
+			daoMarginBalance.GetTotal() != "1000" {
+			t.Errorf("margin balances[0] = %v, want a liability of %s USDT", daoMarginBalance, wantLiability)
+		}
+	}
+
+	// A failing borrow sync does not stop the risk limits of either mode.
+	wantMarginModes := []object.MarginModeType{
+		object.MarginModeTypeCross,
+		object.MarginModeTypeIsolated,
+		object.MarginModeTypeCross,
+		object.MarginModeTypeIsolated,
+	}
+	gotMarginModes := test.testMarginRiskLimitServicer.marginModes
+	if len(gotMarginModes) != len(wantMarginModes) {
+		t.Fatalf("risk limit margin modes = %v, want %v", gotMarginModes, wantMarginModes)
+	}
+
+	for index, wantMarginMode := range wantMarginModes {
+		if gotMarginModes[index] != wantMarginMode {
+			t.Errorf("risk limit margin modes = %v, want %v", gotMarginModes, wantMarginModes)
+		}
+	}
+
+	if got := test.testBorrowServicer.statuses; len(got) != 4 ||
+		got[0] != object.BorrowStatusTypeOutstanding ||
+		got[1] != object.BorrowStatusTypeRepaid {
+		t.Errorf("borrow statuses = %v, want outstanding then repaid", got)
+	}
+}
+
+func TestMarginServiceGetDebtRatio(t *testing.T) {
+	t.Parallel()
+
+	test := newMarginServiceTest(t, false)
+
+	test.fakeServerer.Enqueue(
+		http.MethodGet,
+		marginServiceTestPathAccount,
+		newMarginServiceTestAccount("0.35", "100"),
+		exchangetest.NewFakeErrorResponse(http.StatusBadRequest, "400100", "Invalid parameter"),
+	)
+
+	debtRatio, err := test.marginServicer.GetDebtRatio(context.Background())
+	if err != nil || debtRatio != "0.35" {
+		t.Errorf("GetDebtRatio() = %q, %v, want %q", debtRatio, err, "0.35")
+	}
+
+	_, err = test.marginServicer.GetDebtRatio(context.Background())
+	if !errors.Is(err, object.ErrMarginKucoinServiceGetAccount) ||
+		!errors.Is(err, object.ErrKucoinInvalidParameter) {
+		t.Errorf("GetDebtRatio() error = %v, want %v", err, object.ErrMarginKucoinServiceGetAccount)
+	}
+}
+
+func TestMarginServiceBorrowAndRepay(t *testing.T) {
+	t.Parallel()
+
+	test := newMarginServiceTest(t, false)
+
+	test.fakeServerer.Handle(
+		http.MethodPost,
+		marginServiceTestPathBorrow,
+		exchangetest.NewFakeSuccessResponse(map[string]any{
+			"orderId":  "borrow",
+			"currency": "USDT",
+		}),
+	)
+	test.fakeServerer.Handle(
+		http.MethodPost,
+		marginServiceTestPathRepayAll,
+		exchangetest.NewFakeSuccessResponse(nil),
+	)
+	test.fakeServerer.Handle(
+		http.MethodPost,
+		marginServiceTestPathRepayOnce,
+		exchangetest.NewFakeSuccessResponse(nil),
+	)
+
+	orderID, err := test.marginServicer.Borrow(context.Background(), "USDT", "100")
+	if err != nil || orderID != "borrow" {
+		t.Errorf("Borrow() = %q, %v, want %q", orderID, err, "borrow")
+	}
+
+	if err = test.marginServicer.RepayAll(context.Background(), "USDT", "60"); err != nil {
+		t.Errorf("RepayAll() error = %v", err)
+	}
+
+	if err = test.marginServicer.RepaySingle(context.Background(), "USDT", "trade", "40"); err != nil {
+		t.Errorf("RepaySingle() error = %v", err)
+	}
+
+	requests := []struct {
+		path string
+		want map[string]string
+	}{
+		{
+			path: marginServiceTestPathBorrow,
+			want: map[string]string{
+				object.URIFieldCurrency: "USDT",
+				object.URIFieldSize:     "100",
+				object.URIFieldType:     object.URIKucoinBorrowTypeFOK,
+			},
+		},
+		{
+			path: marginServiceTestPathRepayAll,
+			want: map[string]string{
+				object.URIFieldCurrency: "USDT",
+				object.URIFieldSequence: object.URIKucoinRepaySequenceRecentlyExpireFirst,
+				object.URIFieldSize:     "60",
+			},
+		},
+		{
+			path: marginServiceTestPathRepayOnce,
+			want: map[string]string{
+				object.URIFieldCurrency:      "USDT",
+				object.URIFieldSize:          "40",
+				object.URIKucoinParamTradeID: "trade",
+			},
+		},
+	}
+
+	for _, request := range requests {
+		fakeRequesters := test.fakeServerer.GetRequesters(http.MethodPost, request.path)
+		if len(fakeRequesters) != 1 {
+			t.Fatalf("%s requests = %d, want 1", request.path, len(fakeRequesters))
+		}
+
+		body := map[string]string{}
+		if err = json.Unmarshal(fakeRequesters[0].GetBody(), &body); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+
+		for key, value := range request.want {
+			if body[key] != value {
+				t.Errorf("%s body[%s] = %q, want %q", request.path, key, body[key], value)
+			}
+		}
+	}
+}
+
+func TestMarginServicePlace(t *testing.T) {
+	t.Parallel()
+
+	test := newMarginServiceTest(t, false)
+
+	omOrderers, err := test.marginServicer.Place(
+		context.Background(),
+		newMarginServiceTestPlaceMarginOrderRequest("cross", object.OrderTypeTypeMarginTrade),
+		newMarginServiceTestPlaceMarginOrderRequest("spot", object.OrderTypeTypeTrade),
+		newMarginServiceTestPlaceMarginOrderRequest("isolated", object.OrderTypeTypeMarginIsolatedTrade),
+	)
+	if !errors.Is(err, object.ErrMarginTradeTypeInvalid) {
+		t.Errorf("Place() error = %v, want %v", err, object.ErrMarginTradeTypeInvalid)
+	}
+
+	if omOrderers == nil {
+		t.Errorf("Place() = nil, want the placed orders")
+	}
+
+	dtoPlaceOrderRequesters := test.testOrderServicer.dtoPlaceOrderRequesters
+	if len(dtoPlaceOrderRequesters) != 2 ||
+		dtoPlaceOrderRequesters[0].GetClientOID() != "cross" ||
+		dtoPlaceOrderRequesters[1].GetClientOID() != "isolated" {
+		t.Errorf("Place() placed %v, want the cross and the isolated orders", dtoPlaceOrderRequesters)
+	}
+}
+
+func TestMarginServicePaper(t *testing.T) {
+	t.Parallel()
+
+	test := newMarginServiceTest(t, true)
+
+	if _, err := test.marginServicer.Borrow(context.Background(), "USDT", "100"); !errors.Is(
+		err,
+		object.ErrMarginPaperUnsupported,
+	) {
+		t.Errorf("Borrow() error = %v, want %v", err, object.ErrMarginPaperUnsupported)
+	}
+
+	if _, err := test.marginServicer.Place(
+		context.Background(),
+		newMarginServiceTestPlaceMarginOrderRequest("cross", object.OrderTypeTypeMarginTrade),
+	); !errors.Is(err, object.ErrMarginPaperUnsupported) {
+		t.Errorf("Place() error = %v, want %v", err, object.ErrMarginPaperUnsupported)
+	}
+
+	if debtRatio, err := test.marginServicer.GetDebtRatio(context.Background()); err != nil ||
+		debtRatio != "0" {
+		t.Errorf("GetDebtRatio() = %q, %v, want %q", debtRatio, err, "0")
+	}
+
+	if err := test.marginServicer.Sync(context.Background()); err != nil {
+		t.Errorf("Sync() error = %v", err)
+	}
+
+	if got := len(test.fakeServerer.GetRequesters(http.MethodGet, marginServiceTestPathAccount)); got != 0 {
+		t.Errorf("requests = %d, want 0", got)
+	}
+}
+
+func newMarginServiceTestPlaceMarginOrderRequest(
+	clientOID string,
+	tradeType object.OrderTypeType,
+) dto.PlaceMarginOrderRequester {
+	return dto.NewPlaceMarginOrderRequest(
+		clientOID,
+		object.URIEmpty,
+		object.OrderTypeTypeLimit,
+		"100",
+		object.URIEmpty,
+		object.OrderSideTypeSell,
+		"1",
+		object.URIEmpty,
+		"BTC-USDT",
+		object.TimeInForceTypeGTC,
+		tradeType,
+		object.URIEmpty,
+		0,
+		true,
+		false,
+		false,
+		false,
+	)
+}
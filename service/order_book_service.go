@@ -3,13 +3,20 @@ package service
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"sort"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/Kucoin/kucoin-go-sdk"
 	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
 	"github.com/ShahoBashoki/kucoin/log"
 	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/om"
 	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -17,12 +24,43 @@ import (
 type (
 	// OrderBookServicer is an interface.
 	OrderBookServicer interface {
+		// ApplyLevel2 is a function.
+		ApplyLevel2(
+			context.Context,
+			exchange.MarketLevel2Model,
+		) error
+		// GetBestBidAsk is a function.
+		GetBestBidAsk(
+			context.Context,
+			string,
+		) ([]string, []string, error)
+		// GetMarketRatio is a function.
+		GetMarketRatio(
+			context.Context,
+			string,
+			float64,
+		) (bool, error)
 		// GetMarketRatioFromRemote is a function.
 		GetMarketRatioFromRemote(
 			context.Context,
 			string,
 			float64,
 		) (bool, error)
+		// GetOrderBook is a function.
+		GetOrderBook(
+			context.Context,
+			string,
+			uint32,
+		) (om.OrderBooker, error)
+		// GetOrderBookFromRemote is a function.
+		GetOrderBookFromRemote(
+			context.Context,
+			string,
+		) (om.OrderBooker, error)
+		// Reset is a function.
+		Reset(
+			context.Context,
+		)
 	}
 
 	// GetOrderBookServicer is an interface.
@@ -32,23 +70,54 @@ type (
 	}
 
 	orderBookService struct {
-		configConfigger  config.Configger
-		logRuntimeLogger log.RuntimeLogger
-		servicer         Servicer
-		traceTracer      trace.Tracer
-		utilUUIDer       util.UUIDer
-		kucoinAPIService *kucoin.ApiService
+		configConfigger   config.Configger
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		objectTimer       object.Timer
+		exchangeExchanger exchange.Exchanger
+		books             map[string]*orderBookServiceBook
+		pendings          map[string]*orderBookServicePending
+		mutex             sync.RWMutex
+	}
+
+	// orderBookServiceBook keeps asks ascending and bids descending by price, so
+	// a read takes the top levels without sorting.
+	orderBookServiceBook struct {
+		asks     []orderBookServiceLevel
+		bids     []orderBookServiceLevel
+		sequence int64
+		time     int64
+	}
+
+	// orderBookServiceLevel is a price level. The price is compared as an
+	// exact decimal, and the string the exchange sent is kept for the reads.
+	orderBookServiceLevel struct {
+		price *big.Rat
+		key   string
+		size  string
+	}
+
+	// orderBookServicePending buffers the deltas of a symbol whose book is
+	// missing, until a snapshot they can be replayed on arrives.
+	orderBookServicePending struct {
+		fetchedAt time.Time
+		deltas    []exchange.MarketLevel2Model
+		fetching  bool
 	}
 )
 
 var (
-	_ GetServicer          = (*orderBookService)(nil)
-	_ OrderBookServicer    = (*orderBookService)(nil)
-	_ WithServicer         = (*orderBookService)(nil)
-	_ config.GetConfigger  = (*orderBookService)(nil)
-	_ log.GetRuntimeLogger = (*orderBookService)(nil)
-	_ util.GetTracer       = (*orderBookService)(nil)
-	_ util.GetUUIDer       = (*orderBookService)(nil)
+	_ GetServicer           = (*orderBookService)(nil)
+	_ OrderBookServicer     = (*orderBookService)(nil)
+	_ WithServicer          = (*orderBookService)(nil)
+	_ config.GetConfigger   = (*orderBookService)(nil)
+	_ exchange.GetExchanger = (*orderBookService)(nil)
+	_ log.GetRuntimeLogger  = (*orderBookService)(nil)
+	_ object.GetTimer       = (*orderBookService)(nil)
+	_ util.GetTracer        = (*orderBookService)(nil)
+	_ util.GetUUIDer        = (*orderBookService)(nil)
 )
 
 // NewOrderBookServicer is a function.
@@ -57,15 +126,20 @@ func NewOrderBookServicer(
 	logRuntimeLogger log.RuntimeLogger,
 	traceTracer trace.Tracer,
 	utilUUIDer util.UUIDer,
-	kucoinAPIService *kucoin.ApiService,
+	objectTimer object.Timer,
+	exchangeExchanger exchange.Exchanger,
 ) OrderBookServicer {
 	return &orderBookService{
-		configConfigger:  configConfigger,
-		logRuntimeLogger: logRuntimeLogger,
-		servicer:         nil,
-		traceTracer:      traceTracer,
-		utilUUIDer:       utilUUIDer,
-		kucoinAPIService: kucoinAPIService,
+		configConfigger:   configConfigger,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		objectTimer:       objectTimer,
+		exchangeExchanger: exchangeExchanger,
+		books:             map[string]*orderBookServiceBook{},
+		pendings:          map[string]*orderBookServicePending{},
+		mutex:             sync.RWMutex{},
 	}
 }
 
@@ -94,9 +168,14 @@ func (service *orderBookService) GetUUIDer() util.UUIDer {
 	return service.utilUUIDer
 }
 
-// GetAPIService is a function.
-func (service *orderBookService) GetAPIService() *kucoin.ApiService {
-	return service.kucoinAPIService
+// GetTimer is a function.
+func (service *orderBookService) GetTimer() object.Timer {
+	return service.objectTimer
+}
+
+// GetExchanger is a function.
+func (service *orderBookService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
 }
 
 // WithServicer is a function.
@@ -106,6 +185,296 @@ func (service *orderBookService) WithServicer(
 	service.servicer = servicer
 }
 
+// ApplyLevel2 is a function.
+// The book of the symbol is bootstrapped from the remote snapshot on the first
+// delta and rebuilt whenever a gap in the sequence is detected. Deltas are
+// buffered while the book is missing and replayed past the sequence of the
+// snapshot once it arrives. A snapshot is fetched at most once per resync
+// interval, so a snapshot older than the buffer waits for the next one instead
+// of fetching again on every delta.
+func (service *orderBookService) ApplyLevel2(
+	ctx context.Context,
+	exchangeMarketLevel2Model exchange.MarketLevel2Model,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"ApplyLevel2",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                         "ApplyLevel2",
+		"rt_ctx":                       utilRuntimeContext,
+		"sp_ctx":                       utilSpanContext,
+		"config":                       service.configConfigger,
+		"exchange_market_level2_model": exchangeMarketLevel2Model,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	symbol := exchangeMarketLevel2Model.Symbol
+
+	service.mutex.Lock()
+
+	book, ok := service.books[symbol]
+	if ok && book.sequence+1 >= exchangeMarketLevel2Model.SequenceStart {
+		defer service.mutex.Unlock()
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`ok && book.sequence+1 >= exchangeMarketLevel2Model.SequenceStart`)
+
+		if err := book.applyLevel2(exchangeMarketLevel2Model); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrSTRCONVParseInt.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseInt.Error())
+
+			return err
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldSequence, book.sequence).
+			Debug(object.URIEmpty)
+
+		return nil
+	}
+
+	delete(service.books, symbol)
+
+	pending, ok := service.pendings[symbol]
+	if !ok {
+		pending = &orderBookServicePending{
+			fetchedAt: time.Time{},
+			deltas:    []exchange.MarketLevel2Model{},
+			fetching:  false,
+		}
+		service.pendings[symbol] = pending
+	}
+
+	pending.deltas = append(pending.deltas, exchangeMarketLevel2Model)
+	if len(pending.deltas) > object.NUMOrderBookBufferLength {
+		pending.deltas = pending.deltas[len(pending.deltas)-object.NUMOrderBookBufferLength:]
+	}
+
+	now := service.GetTimer().NowUTC()
+	if pending.fetching || now.Sub(pending.fetchedAt) < object.NUMOrderBookResyncInterval {
+		service.mutex.Unlock()
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldBufferLength, len(pending.deltas)).
+			Debug(`pending.fetching || now.Sub(pending.fetchedAt) < object.NUMOrderBookResyncInterval`)
+
+		return nil
+	}
+
+	pending.fetching = true
+	pending.fetchedAt = now
+
+	service.mutex.Unlock()
+
+	kucoinFullOrderBookModel, err := service.getSnapshotFromRemote(ctx, symbol)
+
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+
+	pending.fetching = false
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderBookServiceGetSnapshotFromRemote.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderBookServiceGetSnapshotFromRemote.Error())
+
+		return err
+	}
+
+	if service.pendings[symbol] != pending {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`service.pendings[symbol] != pending`)
+
+		return nil
+	}
+
+	book, err = newOrderBookServiceBook(kucoinFullOrderBookModel)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSTRCONVParseInt.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseInt.Error())
+
+		return err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldSequence, book.sequence).
+		WithField(object.URIFieldBufferLength, len(pending.deltas)).
+		Debug(object.URIEmpty)
+
+	for _, delta := range pending.deltas {
+		if delta.SequenceEnd <= book.sequence {
+			continue
+		}
+
+		if book.sequence+1 < delta.SequenceStart {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, object.ErrOrderBookServiceSequence).
+				Error(object.ErrOrderBookServiceSequence.Error())
+			traceSpan.RecordError(object.ErrOrderBookServiceSequence)
+			traceSpan.SetStatus(codes.Error, object.ErrOrderBookServiceSequence.Error())
+
+			return object.ErrOrderBookServiceSequence
+		}
+
+		if err = book.applyLevel2(delta); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrSTRCONVParseInt.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseInt.Error())
+
+			return err
+		}
+	}
+
+	service.books[symbol] = book
+	delete(service.pendings, symbol)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldSequence, book.sequence).
+		Debug(object.URIEmpty)
+
+	return nil
+}
+
+// GetBestBidAsk is a function.
+func (service *orderBookService) GetBestBidAsk(
+	ctx context.Context,
+	symbol string,
+) ([]string, []string, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetBestBidAsk",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetBestBidAsk",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"symbol": symbol,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omOrderBook, err := service.GetOrderBook(ctx, symbol, 1)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderBookServiceGetOrderBook.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderBookServiceGetOrderBook.Error())
+
+		return nil, nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrderBook, omOrderBook).
+		Debug(object.URIEmpty)
+
+	if len(omOrderBook.GetBids()) == 0 || len(omOrderBook.GetAsks()) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrOrderBookServiceGetBestBidAsk).
+			Error(object.ErrOrderBookServiceGetBestBidAsk.Error())
+		traceSpan.RecordError(object.ErrOrderBookServiceGetBestBidAsk)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderBookServiceGetBestBidAsk.Error())
+
+		return nil, nil, object.ErrOrderBookServiceGetBestBidAsk
+	}
+
+	return omOrderBook.GetBids()[0], omOrderBook.GetAsks()[0], nil
+}
+
+// GetMarketRatio is a function.
+// The local book is used when it is in sync, otherwise the remote snapshot.
+func (service *orderBookService) GetMarketRatio(
+	ctx context.Context,
+	symbol string,
+	ratio float64,
+) (bool, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetMarketRatio",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetMarketRatio",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"symbol": symbol,
+		"ratio":  ratio,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omOrderBook, err := service.GetOrderBook(ctx, symbol, 0)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Debug(`err != nil`)
+
+		return service.GetMarketRatioFromRemote(ctx, symbol, ratio)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrderBook, omOrderBook).
+		Debug(object.URIEmpty)
+
+	return service.marketRatio(ctx, omOrderBook.GetBids(), omOrderBook.GetAsks(), ratio)
+}
+
 // GetMarketRatioFromRemote is a function.
 func (service *orderBookService) GetMarketRatioFromRemote(
 	ctx context.Context,
@@ -136,7 +505,222 @@ func (service *orderBookService) GetMarketRatioFromRemote(
 		WithFields(fields).
 		Info(object.URIEmpty)
 
-	response, err := service.kucoinAPIService.AggregatedFullOrderBookV3(symbol)
+	kucoinFullOrderBookModel, err := service.getSnapshotFromRemote(ctx, symbol)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderBookServiceGetSnapshotFromRemote.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderBookServiceGetSnapshotFromRemote.Error())
+
+		return false, err
+	}
+
+	return service.marketRatio(
+		ctx,
+		kucoinFullOrderBookModel.Bids,
+		kucoinFullOrderBookModel.Asks,
+		ratio,
+	)
+}
+
+// GetOrderBook is a function.
+// The returned book is a copy taken under a read lock, so bids and asks always
+// belong to the same sequence. A depth of 0 returns every level.
+func (service *orderBookService) GetOrderBook(
+	ctx context.Context,
+	symbol string,
+	depth uint32,
+) (om.OrderBooker, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetOrderBook",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetOrderBook",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"symbol": symbol,
+		"depth":  depth,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	service.mutex.RLock()
+	defer service.mutex.RUnlock()
+
+	book, ok := service.books[symbol]
+	if !ok {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrOrderBookServiceGetOrderBook).
+			Error(object.ErrOrderBookServiceGetOrderBook.Error())
+		traceSpan.RecordError(object.ErrOrderBookServiceGetOrderBook)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderBookServiceGetOrderBook.Error())
+
+		return nil, object.ErrOrderBookServiceGetOrderBook
+	}
+
+	omOrderBook := om.NewOrderBook(
+		orderBookServiceLevels(book.asks, depth),
+		orderBookServiceLevels(book.bids, depth),
+		symbol,
+		book.sequence,
+		book.time,
+		uuid.Nil,
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrderBook, omOrderBook).
+		Debug(object.URIEmpty)
+
+	return omOrderBook, nil
+}
+
+// GetOrderBookFromRemote is a function.
+func (service *orderBookService) GetOrderBookFromRemote(
+	ctx context.Context,
+	symbol string,
+) (om.OrderBooker, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetOrderBookFromRemote",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetOrderBookFromRemote",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"symbol": symbol,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	kucoinFullOrderBookModel, err := service.getSnapshotFromRemote(ctx, symbol)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderBookServiceGetSnapshotFromRemote.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderBookServiceGetSnapshotFromRemote.Error())
+
+		return nil, err
+	}
+
+	sequence, err := strconv.ParseInt(kucoinFullOrderBookModel.Sequence, 10, 64)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSTRCONVParseInt.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseInt.Error())
+
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	omOrderBook := om.NewOrderBook(
+		kucoinFullOrderBookModel.Asks,
+		kucoinFullOrderBookModel.Bids,
+		symbol,
+		sequence,
+		kucoinFullOrderBookModel.Time,
+		uuid.Nil,
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrderBook, omOrderBook).
+		Debug(object.URIEmpty)
+
+	return omOrderBook, nil
+}
+
+// Reset is a function.
+// Reset drops every local book and buffer, they are bootstrapped again by the
+// next delta.
+func (service *orderBookService) Reset(
+	ctx context.Context,
+) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Reset",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Reset",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+
+	service.books = map[string]*orderBookServiceBook{}
+	service.pendings = map[string]*orderBookServicePending{}
+}
+
+func (service *orderBookService) getSnapshotFromRemote(
+	ctx context.Context,
+	symbol string,
+) (*kucoin.FullOrderBookModel, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"getSnapshotFromRemote",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "getSnapshotFromRemote",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"symbol": symbol,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	response, err := serviceExchanger(ctx, service).AggregatedFullOrderBookV3(symbol)
 	if err != nil {
 		service.GetRuntimeLogger().
 			WithFields(fields).
@@ -145,7 +729,7 @@ func (service *orderBookService) GetMarketRatioFromRemote(
 		traceSpan.RecordError(err)
 		traceSpan.SetStatus(codes.Error, object.ErrOrderBookKucoinServiceGetList.Error())
 
-		return false, fmt.Errorf("%w", err)
+		return nil, fmt.Errorf("%w", err)
 	}
 
 	service.GetRuntimeLogger().
@@ -153,25 +737,25 @@ func (service *orderBookService) GetMarketRatioFromRemote(
 		WithField(object.URIFieldResponse, response).
 		Debug(object.URIEmpty)
 
-	if response.Code != "200000" {
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
 		service.GetRuntimeLogger().
 			WithFields(fields).
-			WithField(object.URIFieldError, response.Message).
+			WithField(object.URIFieldError, errResponse).
 			Error(object.ErrOrderBookKucoinServiceGetList.Error())
-		traceSpan.RecordError(object.ErrOrderBookKucoinServiceGetList)
+		traceSpan.RecordError(errResponse)
 		traceSpan.SetStatus(codes.Error, object.ErrOrderBookKucoinServiceGetList.Error())
 
-		return false, object.ErrOrderBookKucoinServiceGetList
+		return nil, fmt.Errorf("%w: %w", object.ErrOrderBookKucoinServiceGetList, errResponse)
 	}
 
-	kucoinFullOrderBookModel := kucoin.FullOrderBookModel{
+	kucoinFullOrderBookModel := &kucoin.FullOrderBookModel{
 		Sequence: "",
 		Time:     0,
 		Bids:     [][]string{},
 		Asks:     [][]string{},
 	}
 
-	if err = response.ReadData(&kucoinFullOrderBookModel); err != nil {
+	if err = response.ReadData(kucoinFullOrderBookModel); err != nil {
 		service.GetRuntimeLogger().
 			WithFields(fields).
 			WithField(object.URIFieldError, err).
@@ -179,7 +763,7 @@ func (service *orderBookService) GetMarketRatioFromRemote(
 		traceSpan.RecordError(err)
 		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadPaginationData.Error())
 
-		return false, fmt.Errorf("%w", err)
+		return nil, fmt.Errorf("%w", err)
 	}
 
 	service.GetRuntimeLogger().
@@ -187,48 +771,48 @@ func (service *orderBookService) GetMarketRatioFromRemote(
 		WithField(object.URIFieldKucoinFullOrderBookModel, kucoinFullOrderBookModel).
 		Debug(object.URIEmpty)
 
-	bidsValue := 0.0
-
-	for key, value := range kucoinFullOrderBookModel.Bids {
-		service.GetRuntimeLogger().
-			WithFields(fields).
-			WithField(object.URIFieldKey, key).
-			WithField(object.URIFieldValue, value).
-			Debug(object.URIEmpty)
-
-		if key > len(kucoinFullOrderBookModel.Bids)/2 {
-			service.GetRuntimeLogger().
-				WithFields(fields).
-				Debug(`key > len(kucoinFullOrderBookModel.Bids)/2`)
+	return kucoinFullOrderBookModel, nil
+}
 
-			break
-		}
+func (service *orderBookService) marketRatio(
+	ctx context.Context,
+	bids [][]string,
+	asks [][]string,
+	ratio float64,
+) (bool, error) {
+	var traceSpan trace.Span
 
-		firstValue, errParseFloat := strconv.ParseFloat(value[0], 64)
-		if errParseFloat != nil {
-			service.GetRuntimeLogger().
-				WithFields(fields).
-				WithField(object.URIFieldError, errParseFloat).
-				Error(object.ErrSTRCONVParseFloat.Error())
-			traceSpan.RecordError(errParseFloat)
-			traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseFloat.Error())
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"marketRatio",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
 
-			return false, errParseFloat
-		}
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "marketRatio",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"ratio":  ratio,
+	}
 
-		secondValue, errParseFloat := strconv.ParseFloat(value[1], 64)
-		if errParseFloat != nil {
-			service.GetRuntimeLogger().
-				WithFields(fields).
-				WithField(object.URIFieldError, errParseFloat).
-				Error(object.ErrSTRCONVParseFloat.Error())
-			traceSpan.RecordError(errParseFloat)
-			traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseFloat.Error())
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
 
-			return false, errParseFloat
-		}
+	bidsValue, err := util.OrderBookValue(bids)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSTRCONVParseFloat.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseFloat.Error())
 
-		bidsValue += firstValue + secondValue
+		return false, err
 	}
 
 	service.GetRuntimeLogger().
@@ -236,48 +820,16 @@ func (service *orderBookService) GetMarketRatioFromRemote(
 		WithField(object.URIFieldBidsValue, bidsValue).
 		Debug(object.URIEmpty)
 
-	asksValue := 0.0
-
-	for key, value := range kucoinFullOrderBookModel.Asks {
+	asksValue, err := util.OrderBookValue(asks)
+	if err != nil {
 		service.GetRuntimeLogger().
 			WithFields(fields).
-			WithField(object.URIFieldKey, key).
-			WithField(object.URIFieldValue, value).
-			Debug(object.URIEmpty)
-
-		if key > len(kucoinFullOrderBookModel.Asks)/2 {
-			service.GetRuntimeLogger().
-				WithFields(fields).
-				Debug(`key > len(kucoinFullOrderBookModel.Asks)/2`)
-
-			break
-		}
-
-		firstValue, errParseFloat := strconv.ParseFloat(value[0], 64)
-		if errParseFloat != nil {
-			service.GetRuntimeLogger().
-				WithFields(fields).
-				WithField(object.URIFieldError, errParseFloat).
-				Error(object.ErrSTRCONVParseFloat.Error())
-			traceSpan.RecordError(errParseFloat)
-			traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseFloat.Error())
-
-			return false, errParseFloat
-		}
-
-		secondValue, errParseFloat := strconv.ParseFloat(value[1], 64)
-		if errParseFloat != nil {
-			service.GetRuntimeLogger().
-				WithFields(fields).
-				WithField(object.URIFieldError, errParseFloat).
-				Error(object.ErrSTRCONVParseFloat.Error())
-			traceSpan.RecordError(errParseFloat)
-			traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseFloat.Error())
-
-			return false, errParseFloat
-		}
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSTRCONVParseFloat.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseFloat.Error())
 
-		asksValue += firstValue + secondValue
+		return false, err
 	}
 
 	service.GetRuntimeLogger().
@@ -295,3 +847,148 @@ func (service *orderBookService) GetMarketRatioFromRemote(
 
 	return false, nil
 }
+
+func newOrderBookServiceBook(
+	kucoinFullOrderBookModel *kucoin.FullOrderBookModel,
+) (*orderBookServiceBook, error) {
+	sequence, err := strconv.ParseInt(kucoinFullOrderBookModel.Sequence, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	book := &orderBookServiceBook{
+		asks:     make([]orderBookServiceLevel, 0, len(kucoinFullOrderBookModel.Asks)),
+		bids:     make([]orderBookServiceLevel, 0, len(kucoinFullOrderBookModel.Bids)),
+		sequence: sequence,
+		time:     kucoinFullOrderBookModel.Time,
+	}
+
+	for _, value := range kucoinFullOrderBookModel.Asks {
+		book.asks = orderBookServiceSet(book.asks, false, value[0], value[1])
+	}
+
+	for _, value := range kucoinFullOrderBookModel.Bids {
+		book.bids = orderBookServiceSet(book.bids, true, value[0], value[1])
+	}
+
+	return book, nil
+}
+
+// applyLevel2 applies the changes of a delta and moves the book to its
+// sequence.
+func (book *orderBookServiceBook) applyLevel2(
+	exchangeMarketLevel2Model exchange.MarketLevel2Model,
+) error {
+	if exchangeMarketLevel2Model.SequenceEnd <= book.sequence {
+		return nil
+	}
+
+	bids, err := book.apply(book.bids, true, exchangeMarketLevel2Model.Changes.Bids)
+	if err != nil {
+		return err
+	}
+
+	asks, err := book.apply(book.asks, false, exchangeMarketLevel2Model.Changes.Asks)
+	if err != nil {
+		return err
+	}
+
+	book.asks = asks
+	book.bids = bids
+	book.sequence = exchangeMarketLevel2Model.SequenceEnd
+	book.time = exchangeMarketLevel2Model.Time
+
+	return nil
+}
+
+func (book *orderBookServiceBook) apply(
+	levels []orderBookServiceLevel,
+	descending bool,
+	changes [][]string,
+) ([]orderBookServiceLevel, error) {
+	for _, change := range changes {
+		if len(change) < object.NUMOrderBookChangeLength {
+			return nil, object.ErrTypeAssertion
+		}
+
+		sequence, err := strconv.ParseInt(change[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		if sequence <= book.sequence || change[0] == object.URIOrderBookPriceZero {
+			continue
+		}
+
+		levels = orderBookServiceSet(levels, descending, change[0], change[1])
+	}
+
+	return levels, nil
+}
+
+// orderBookServiceSet is a function.
+// It sets the size of the level at price in levels kept sorted, and removes
+// the level when the size is zero. A price that is not a decimal is skipped.
+func orderBookServiceSet(
+	levels []orderBookServiceLevel,
+	descending bool,
+	key string,
+	size string,
+) []orderBookServiceLevel {
+	price, ok := new(big.Rat).SetString(key)
+	if !ok {
+		return levels
+	}
+
+	index := sort.Search(len(levels), func(index int) bool {
+		if descending {
+			return levels[index].price.Cmp(price) <= 0
+		}
+
+		return levels[index].price.Cmp(price) >= 0
+	})
+	found := index < len(levels) && levels[index].price.Cmp(price) == 0
+
+	sizeRat, ok := new(big.Rat).SetString(size)
+	if ok && sizeRat.Sign() == 0 {
+		if found {
+			levels = append(levels[:index], levels[index+1:]...)
+		}
+
+		return levels
+	}
+
+	level := orderBookServiceLevel{
+		price: price,
+		key:   key,
+		size:  size,
+	}
+
+	if found {
+		levels[index] = level
+
+		return levels
+	}
+
+	levels = append(levels, orderBookServiceLevel{})
+	copy(levels[index+1:], levels[index:])
+	levels[index] = level
+
+	return levels
+}
+
+func orderBookServiceLevels(
+	levels []orderBookServiceLevel,
+	depth uint32,
+) [][]string {
+	if depth != 0 && uint32(len(levels)) > depth {
+		levels = levels[:depth]
+	}
+
+	out := make([][]string, 0, len(levels))
+	for _, level := range levels {
+		out = append(out, []string{level.key, level.size})
+	}
+
+	return out
+}
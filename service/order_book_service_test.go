@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/exchange/exchangetest"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/util"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const orderBookServiceTestPath = "/api/v3/market/orderbook/level2"
+
+func newOrderBookServiceTestLevel2(
+	sequenceStart int64,
+	sequenceEnd int64,
+	bids [][]string,
+	asks [][]string,
+) exchange.MarketLevel2Model {
+	return exchange.MarketLevel2Model{
+		Changes: exchange.MarketLevel2ChangesModel{
+			Asks: asks,
+			Bids: bids,
+		},
+		Symbol:        "BTC-USDT",
+		SequenceEnd:   sequenceEnd,
+		SequenceStart: sequenceStart,
+		Time:          sequenceEnd,
+	}
+}
+
+func TestOrderBookServiceApplyLevel2(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fakeServerer := exchangetest.NewFakeServer()
+	t.Cleanup(fakeServerer.Close)
+
+	configConfigger := config.NewConfig(config.WithKucoinConfigger(), config.WithLogConfigger())
+	timer := &serviceTestTimer{
+		Timer: nil,
+		now:   time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	orderBookServicer := NewOrderBookServicer(
+		configConfigger,
+		log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+		trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+		util.NewUUID(),
+		timer,
+		fakeServerer.GetExchanger(),
+	)
+
+	// The first snapshot is older than the buffered delta, so it is dropped.
+	fakeServerer.Enqueue(
+		http.MethodGet,
+		orderBookServiceTestPath,
+		exchangetest.NewFakeSuccessResponse(map[string]any{
+			"sequence": "5",
+			"time":     5,
+			"bids":     [][]string{{"99", "1"}},
+			"asks":     [][]string{{"101", "1"}},
+		}),
+		exchangetest.NewFakeSuccessResponse(map[string]any{
+			"sequence": "11",
+			"time":     11,
+			"bids":     [][]string{{"99", "1"}, {"100", "2"}, {"9.5", "3"}},
+			"asks":     [][]string{{"101", "1"}, {"100.5", "2"}},
+		}),
+	)
+
+	err := orderBookServicer.ApplyLevel2(ctx, newOrderBookServiceTestLevel2(
+		11,
+		11,
+		[][]string{{"100", "5", "11"}},
+		[][]string{},
+	))
+	if !errors.Is(err, object.ErrOrderBookServiceSequence) {
+		t.Fatalf("ApplyLevel2() error = %v, want %v", err, object.ErrOrderBookServiceSequence)
+	}
+
+	// Deltas within the resync interval are buffered without a fetch.
+	if err = orderBookServicer.ApplyLevel2(ctx, newOrderBookServiceTestLevel2(
+		12,
+		12,
+		[][]string{{"99", "0", "12"}},
+		[][]string{{"100.50", "4", "12"}},
+	)); err != nil {
+		t.Fatalf("ApplyLevel2() error = %v", err)
+	}
+
+	if got := len(fakeServerer.GetRequesters(http.MethodGet, orderBookServiceTestPath)); got != 1 {
+		t.Fatalf("requests = %d, want 1", got)
+	}
+
+	timer.now = timer.now.Add(object.NUMOrderBookResyncInterval)
+
+	if err = orderBookServicer.ApplyLevel2(ctx, newOrderBookServiceTestLevel2(
+		13,
+		13,
+		[][]string{{"100.25", "6", "13"}},
+		[][]string{},
+	)); err != nil {
+		t.Fatalf("ApplyLevel2() error = %v", err)
+	}
+
+	if got := len(fakeServerer.GetRequesters(http.MethodGet, orderBookServiceTestPath)); got != 2 {
+		t.Fatalf("requests = %d, want 2", got)
+	}
+
+	omOrderBook, err := orderBookServicer.GetOrderBook(ctx, "BTC-USDT", 0)
+	if err != nil {
+		t.Fatalf("GetOrderBook() error = %v", err)
+	}
+
+	if got := omOrderBook.GetSequence(); got != 13 {
+		t.Errorf("GetSequence() = %d, want 13", got)
+	}
+
+	wantBids := [][]string{{"100.25", "6"}, {"100", "2"}, {"9.5", "3"}}
+	if got := omOrderBook.GetBids(); !reflect.DeepEqual(got, wantBids) {
+		t.Errorf("GetBids() = %v, want %v", got, wantBids)
+	}
+
+	wantAsks := [][]string{{"100.50", "4"}, {"101", "1"}}
+	if got := omOrderBook.GetAsks(); !reflect.DeepEqual(got, wantAsks) {
+		t.Errorf("GetAsks() = %v, want %v", got, wantAsks)
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Kucoin/kucoin-go-sdk"
 	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
 	"github.com/ShahoBashoki/kucoin/log"
 	"github.com/ShahoBashoki/kucoin/object"
 	"github.com/ShahoBashoki/kucoin/object/dao"
@@ -24,6 +28,26 @@ import (
 type (
 	// OrderServicer is an interface.
 	OrderServicer interface {
+		// Apply is a function.
+		Apply(
+			context.Context,
+			exchange.OrderChangeModel,
+		) (uuid.UUID, error)
+		// Cancel is a function.
+		Cancel(
+			context.Context,
+			uuid.UUID,
+		) (om.Orderer, error)
+		// CancelAllForSymbol is a function.
+		CancelAllForSymbol(
+			context.Context,
+			string,
+		) ([]om.Orderer, error)
+		// CancelByClientOID is a function.
+		CancelByClientOID(
+			context.Context,
+			string,
+		) (om.Orderer, error)
 		// Create is a function.
 		Create(
 			context.Context,
@@ -38,6 +62,11 @@ type (
 			context.Context,
 			uuid.UUID,
 		) (om.Orderer, error)
+		// GetByClientOID is a function.
+		GetByClientOID(
+			context.Context,
+			string,
+		) (om.Orderer, error)
 		// GetListFromRepository is a function.
 		GetListFromRepository(
 			context.Context,
@@ -50,6 +79,30 @@ type (
 			dto.OrderRequester,
 			int64,
 		) error
+		// Place is a function.
+		Place(
+			context.Context,
+			...dto.PlaceOrderRequester,
+		) ([]om.Orderer, error)
+		// Reconcile is a function.
+		Reconcile(
+			context.Context,
+			om.Orderer,
+		) (om.Orderer, error)
+		// Sync is a function.
+		Sync(
+			context.Context,
+		) error
+		// Update is a function.
+		Update(
+			context.Context,
+			om.Orderer,
+		) (time.Time, error)
+		// Upsert is a function.
+		Upsert(
+			context.Context,
+			om.Orderer,
+		) (uuid.UUID, error)
 	}
 
 	// GetOrderServicer is an interface.
@@ -59,13 +112,13 @@ type (
 	}
 
 	orderService struct {
-		configConfigger  config.Configger
-		repositorier     repository.OrderRepositorier
-		logRuntimeLogger log.RuntimeLogger
-		servicer         Servicer
-		traceTracer      trace.Tracer
-		utilUUIDer       util.UUIDer
-		kucoinAPIService *kucoin.ApiService
+		configConfigger   config.Configger
+		repositorier      repository.OrderRepositorier
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
 	}
 )
 
@@ -74,6 +127,7 @@ var (
 	_ OrderServicer                   = (*orderService)(nil)
 	_ WithServicer                    = (*orderService)(nil)
 	_ config.GetConfigger             = (*orderService)(nil)
+	_ exchange.GetExchanger           = (*orderService)(nil)
 	_ log.GetRuntimeLogger            = (*orderService)(nil)
 	_ repository.GetOrderRepositorier = (*orderService)(nil)
 	_ util.GetTracer                  = (*orderService)(nil)
@@ -87,16 +141,16 @@ func NewOrderServicer(
 	logRuntimeLogger log.RuntimeLogger,
 	traceTracer trace.Tracer,
 	utilUUIDer util.UUIDer,
-	kucoinAPIService *kucoin.ApiService,
+	exchangeExchanger exchange.Exchanger,
 ) OrderServicer {
 	return &orderService{
-		configConfigger:  configConfigger,
-		repositorier:     repositorier,
-		logRuntimeLogger: logRuntimeLogger,
-		servicer:         nil,
-		traceTracer:      traceTracer,
-		utilUUIDer:       utilUUIDer,
-		kucoinAPIService: kucoinAPIService,
+		configConfigger:   configConfigger,
+		repositorier:      repositorier,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
 	}
 }
 
@@ -130,9 +184,9 @@ func (service *orderService) GetUUIDer() util.UUIDer {
 	return service.utilUUIDer
 }
 
-// GetAPIService is a function.
-func (service *orderService) GetAPIService() *kucoin.ApiService {
-	return service.kucoinAPIService
+// GetExchanger is a function.
+func (service *orderService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
 }
 
 // WithServicer is a function.
@@ -178,6 +232,7 @@ func (service *orderService) Create(
 			Valid: false,
 		},
 		uuid.Nil,
+		omOrderer.GetAccount(),
 		omOrderer.GetChannel(),
 		omOrderer.GetClientOID(),
 		omOrderer.GetDealFunds(),
@@ -192,6 +247,7 @@ func (service *orderService) Create(
 		omOrderer.GetRemark(),
 		omOrderer.GetSide(),
 		omOrderer.GetSize(),
+		omOrderer.GetStatus(),
 		omOrderer.GetStop(),
 		omOrderer.GetStopPrice(),
 		omOrderer.GetSTP(),
@@ -206,6 +262,7 @@ func (service *orderService) Create(
 		omOrderer.GetHidden(),
 		omOrderer.GetIceBerg(),
 		omOrderer.GetIsActive(),
+		omOrderer.GetPaper(),
 		omOrderer.GetPostOnly(),
 		omOrderer.GetStopTriggered(),
 	)
@@ -327,6 +384,7 @@ func (service *orderService) Get(
 		Debug(object.URIEmpty)
 
 	omOrder := om.NewOrder(
+		daoOrder.GetAccount(),
 		daoOrder.GetChannel(),
 		daoOrder.GetClientOID(),
 		daoOrder.GetDealFunds(),
@@ -341,6 +399,7 @@ func (service *orderService) Get(
 		daoOrder.GetRemark(),
 		daoOrder.GetSide(),
 		daoOrder.GetSize(),
+		daoOrder.GetStatus(),
 		daoOrder.GetStop(),
 		daoOrder.GetStopPrice(),
 		daoOrder.GetSTP(),
@@ -355,6 +414,7 @@ func (service *orderService) Get(
 		daoOrder.GetHidden(),
 		daoOrder.GetIceBerg(),
 		daoOrder.GetIsActive(),
+		daoOrder.GetPaper(),
 		daoOrder.GetPostOnly(),
 		daoOrder.GetStopTriggered(),
 		daoOrder.GetID(),
@@ -427,6 +487,7 @@ func (service *orderService) GetListFromRepository(
 			Debug(object.URIEmpty)
 
 		omOrders = append(omOrders, om.NewOrder(
+			daoOrder.GetAccount(),
 			daoOrder.GetChannel(),
 			daoOrder.GetClientOID(),
 			daoOrder.GetDealFunds(),
@@ -441,6 +502,7 @@ func (service *orderService) GetListFromRepository(
 			daoOrder.GetRemark(),
 			daoOrder.GetSide(),
 			daoOrder.GetSize(),
+			daoOrder.GetStatus(),
 			daoOrder.GetStop(),
 			daoOrder.GetStopPrice(),
 			daoOrder.GetSTP(),
@@ -455,6 +517,7 @@ func (service *orderService) GetListFromRepository(
 			daoOrder.GetHidden(),
 			daoOrder.GetIceBerg(),
 			daoOrder.GetIsActive(),
+			daoOrder.GetPaper(),
 			daoOrder.GetPostOnly(),
 			daoOrder.GetStopTriggered(),
 			daoOrder.GetID(),
@@ -532,7 +595,7 @@ func (service *orderService) GetListFromRemote(
 		WithField(object.URIFieldKucoinPaginationParam, kucoinPaginationParam).
 		Debug(object.URIEmpty)
 
-	response, err := service.kucoinAPIService.Orders(params, kucoinPaginationParam)
+	response, err := serviceExchanger(ctx, service).Orders(params, kucoinPaginationParam)
 	if err != nil {
 		service.GetRuntimeLogger().
 			WithFields(fields).
@@ -549,15 +612,15 @@ func (service *orderService) GetListFromRemote(
 		WithField(object.URIFieldResponse, response).
 		Debug(object.URIEmpty)
 
-	if response.Code != "200000" {
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
 		service.GetRuntimeLogger().
 			WithFields(fields).
-			WithField(object.URIFieldError, response.Message).
+			WithField(object.URIFieldError, errResponse).
 			Error(object.ErrOrderKucoinServiceGetList.Error())
-		traceSpan.RecordError(object.ErrOrderKucoinServiceGetList)
+		traceSpan.RecordError(errResponse)
 		traceSpan.SetStatus(codes.Error, object.ErrOrderKucoinServiceGetList.Error())
 
-		return object.ErrOrderKucoinServiceGetList
+		return fmt.Errorf("%w: %w", object.ErrOrderKucoinServiceGetList, errResponse)
 	}
 
 	kucoinPaginationModel, err := response.ReadPaginationData(&kucoin.OrdersModel{})
@@ -606,6 +669,7 @@ func (service *orderService) GetListFromRemote(
 			Debug(object.URIEmpty)
 
 		omOrder := om.NewOrder(
+			serviceAccount(ctx, service),
 			value.Channel,
 			value.ClientOid,
 			value.DealFunds,
@@ -620,6 +684,7 @@ func (service *orderService) GetListFromRemote(
 			value.Remark,
 			value.Side,
 			value.Size,
+			string(orderServiceStatus(value.IsActive, value.CancelExist, value.DealSize)),
 			value.Stop,
 			value.StopPrice,
 			value.Stp,
@@ -634,21 +699,22 @@ func (service *orderService) GetListFromRemote(
 			value.Hidden,
 			value.IceBerg,
 			value.IsActive,
+			service.GetConfigger().GetPaperConfigger().GetEnabled(),
 			value.PostOnly,
 			value.StopTriggered,
 			uuid.Nil,
 		)
 
-		orderID, errOrderCreate := service.GetServicer().GetOrderServicer().Create(ctx, omOrder)
-		if errOrderCreate != nil {
+		orderID, errOrderUpsert := service.GetServicer().GetOrderServicer().Upsert(ctx, omOrder)
+		if errOrderUpsert != nil {
 			service.GetRuntimeLogger().
 				WithFields(fields).
-				WithField(object.URIFieldError, errOrderCreate).
-				Error(object.ErrOrderServiceCreate.Error())
-			traceSpan.RecordError(errOrderCreate)
-			traceSpan.SetStatus(codes.Error, object.ErrOrderServiceCreate.Error())
+				WithField(object.URIFieldError, errOrderUpsert).
+				Error(object.ErrOrderServiceUpsert.Error())
+			traceSpan.RecordError(errOrderUpsert)
+			traceSpan.SetStatus(codes.Error, object.ErrOrderServiceUpsert.Error())
 
-			return errOrderCreate
+			return errOrderUpsert
 		}
 
 		service.GetRuntimeLogger().
@@ -678,3 +744,2332 @@ func (service *orderService) GetListFromRemote(
 
 	return nil
 }
+
+// Apply is a function.
+// Apply moves the stored order through its states with one order change event
+// and returns the id of the stored order. An event that would move the order
+// backwards, such as one replayed after a reconnect, is ignored; an order the
+// service did not place is stored from the event.
+func (service *orderService) Apply(
+	ctx context.Context,
+	exchangeOrderChangeModel exchange.OrderChangeModel,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Apply",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                        "Apply",
+		"rt_ctx":                      utilRuntimeContext,
+		"sp_ctx":                      utilSpanContext,
+		"config":                      service.configConfigger,
+		"exchange_order_change_model": exchangeOrderChangeModel,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omOrderer, err := service.find(
+		ctx,
+		exchangeOrderChangeModel.OrderID,
+		exchangeOrderChangeModel.ClientOid,
+	)
+	if errors.Is(err, object.ErrOrderNotFound) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(err, object.ErrOrderNotFound)`)
+
+		omOrderer = orderServiceOrderFromChange(
+			serviceAccount(ctx, service),
+			exchangeOrderChangeModel,
+		)
+		err = nil
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceFind.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceFind.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omOrderer).
+		Debug(object.URIEmpty)
+
+	status := orderServiceChangeStatus(
+		object.OrderChangeTypeType(exchangeOrderChangeModel.Type),
+		object.OrderStatusType(omOrderer.GetStatus()),
+		exchangeOrderChangeModel.RemainSize,
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldStatus, status).
+		Debug(object.URIEmpty)
+
+	if !orderServiceTransition(object.OrderStatusType(omOrderer.GetStatus()), status) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`!orderServiceTransition(object.OrderStatusType(omOrderer.GetStatus()), status)`)
+
+		return omOrderer.GetID(), nil
+	}
+
+	omAppliedOrderer, err := orderServiceOrderWithChange(omOrderer, exchangeOrderChangeModel, status)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrDecimalParse.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrDecimalParse.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omAppliedOrderer).
+		Debug(object.URIEmpty)
+
+	orderID, err := service.GetServicer().GetOrderServicer().Upsert(ctx, omAppliedOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceUpsert.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceUpsert.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOrderID, orderID).
+		Debug(object.URIEmpty)
+
+	return orderID, nil
+}
+
+// Cancel is a function.
+func (service *orderService) Cancel(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Cancel",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Cancel",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omOrderer, err := service.GetServicer().GetOrderServicer().Get(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceGet.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceGet.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omOrderer).
+		Debug(object.URIEmpty)
+
+	if omOrderer.GetKucoinID() == omOrderer.GetClientOID() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omOrderer.GetKucoinID() == omOrderer.GetClientOID()`)
+
+		return service.GetServicer().
+			GetOrderServicer().
+			CancelByClientOID(ctx, omOrderer.GetClientOID())
+	}
+
+	response, err := serviceAccountExchanger(ctx, service, omOrderer.GetAccount()).
+		CancelOrder(omOrderer.GetKucoinID())
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if err = orderServiceResponseError(omOrderer.GetClientOID(), response, err); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderKucoinServiceCancel.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderKucoinServiceCancel.Error())
+
+		return nil, err
+	}
+
+	omOrderer, err = service.GetServicer().GetOrderServicer().Reconcile(ctx, omOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceReconcile.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceReconcile.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omOrderer).
+		Debug(object.URIEmpty)
+
+	return omOrderer, nil
+}
+
+// CancelAllForSymbol is a function.
+// Every active order of the symbol is cancelled by the exchange in one request
+// and the stored active orders of the symbol are reconciled afterwards.
+func (service *orderService) CancelAllForSymbol(
+	ctx context.Context,
+	symbol string,
+) ([]om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"CancelAllForSymbol",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "CancelAllForSymbol",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"symbol": symbol,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	response, err := serviceExchanger(ctx, service).CancelOrders(map[string]string{
+		object.URIFieldSymbol: symbol,
+	})
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if err = orderServiceResponseError(object.URIEmpty, response, err); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderKucoinServiceCancel.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderKucoinServiceCancel.Error())
+
+		return nil, err
+	}
+
+	omActiveOrderers := make([]om.Orderer, 0)
+	omOrderers := make([]om.Orderer, 0)
+	errs := make([]error, 0)
+
+	// Cancelled orders leave the active filter, so every page is read before
+	// any order is reconciled.
+	var daoCursorer dao.Cursorer = dao.NewCursor(0)
+
+	for daoCursorer != nil {
+		omOrderersPage, daoNextCursorer, errGetList := service.GetServicer().
+			GetOrderServicer().
+			GetListFromRepository(
+				ctx,
+				dao.NewPagination(daoCursorer, object.NUMKucoinRecentOrderCount),
+				dao.NewOrderFilter(
+					serviceAccount(ctx, service),
+					object.URIEmpty,
+					object.URIEmpty,
+					object.URIEmpty,
+					symbol,
+					true,
+				),
+			)
+		if errGetList != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errGetList).
+				Error(object.ErrOrderRepositoryReadList.Error())
+			traceSpan.RecordError(errGetList)
+			traceSpan.SetStatus(codes.Error, object.ErrOrderRepositoryReadList.Error())
+
+			return nil, errGetList
+		}
+
+		daoCursorer = daoNextCursorer
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMOrders, omOrderersPage).
+			WithField(object.URIFieldDAOCursor, daoCursorer).
+			Debug(object.URIEmpty)
+
+		omActiveOrderers = append(omActiveOrderers, omOrderersPage...)
+	}
+
+	for _, omOrderer := range omActiveOrderers {
+		omReconciledOrderer, errReconcile := service.GetServicer().
+			GetOrderServicer().
+			Reconcile(ctx, omOrderer)
+		if errReconcile != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errReconcile).
+				Error(object.ErrOrderServiceReconcile.Error())
+
+			errs = append(errs, errReconcile)
+
+			continue
+		}
+
+		omOrderers = append(omOrderers, omReconciledOrderer)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrders, omOrderers).
+		Debug(object.URIEmpty)
+
+	if err = errors.Join(errs...); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceCancelAllForSymbol.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceCancelAllForSymbol.Error())
+
+		return omOrderers, err
+	}
+
+	return omOrderers, nil
+}
+
+// CancelByClientOID is a function.
+func (service *orderService) CancelByClientOID(
+	ctx context.Context,
+	clientOID string,
+) (om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"CancelByClientOID",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "CancelByClientOID",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"client_oid": clientOID,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omOrderer, err := service.GetServicer().GetOrderServicer().GetByClientOID(ctx, clientOID)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceGetByClientOID.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceGetByClientOID.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omOrderer).
+		Debug(object.URIEmpty)
+
+	response, err := serviceAccountExchanger(ctx, service, omOrderer.GetAccount()).
+		CancelOrderByClient(clientOID)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if err = orderServiceResponseError(clientOID, response, err); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderKucoinServiceCancel.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderKucoinServiceCancel.Error())
+
+		return nil, err
+	}
+
+	omOrderer, err = service.GetServicer().GetOrderServicer().Reconcile(ctx, omOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceReconcile.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceReconcile.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omOrderer).
+		Debug(object.URIEmpty)
+
+	return omOrderer, nil
+}
+
+// GetByClientOID is a function.
+func (service *orderService) GetByClientOID(
+	ctx context.Context,
+	clientOID string,
+) (om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetByClientOID",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "GetByClientOID",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"client_oid": clientOID,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omOrderers, _, err := service.GetServicer().GetOrderServicer().GetListFromRepository(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewOrderFilter(
+			object.URIEmpty,
+			clientOID,
+			object.URIEmpty,
+			object.URIEmpty,
+			object.URIEmpty,
+			false,
+		),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderRepositoryReadList.Error())
+
+		return nil, err
+	}
+
+	if len(omOrderers) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrOrderNotFound).
+			Error(object.ErrOrderServiceGetByClientOID.Error())
+		traceSpan.RecordError(object.ErrOrderNotFound)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceGetByClientOID.Error())
+
+		return nil, object.ErrOrderNotFound
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omOrderers[0]).
+		Debug(object.URIEmpty)
+
+	return omOrderers[0], nil
+}
+
+// Place is a function.
+// Every order is checked against the rules of its symbol, stored before it is
+// submitted and reconciled after, and an order whose clientOid is already known
+// to the exchange is never submitted again. An empty clientOid is derived from
+// the account, the strategy and the intent time of the runtime context along
+// with the request, so a retry of the same intent is one order; without an
+// intent time the caller must set the clientOid.
+func (service *orderService) Place(
+	ctx context.Context,
+	dtoPlaceOrderRequesters ...dto.PlaceOrderRequester,
+) ([]om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Place",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                       "Place",
+		"rt_ctx":                     utilRuntimeContext,
+		"sp_ctx":                     utilSpanContext,
+		"config":                     service.configConfigger,
+		"dto_place_order_requesters": dtoPlaceOrderRequesters,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omOrderers := make([]om.Orderer, len(dtoPlaceOrderRequesters))
+	indexes := make(map[string]int, len(dtoPlaceOrderRequesters))
+	pending := make(map[string]om.Orderer, len(dtoPlaceOrderRequesters))
+	batches := map[string][]dto.PlaceOrderRequester{}
+	batchKeys := make([]string, 0)
+	errs := make([]error, 0)
+
+	for key, dtoPlaceOrderRequester := range dtoPlaceOrderRequesters {
+		if dtoPlaceOrderRequester.GetClientOID() == object.URIEmpty &&
+			utilRuntimeContext.GetIntentAt() == 0 {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`dtoPlaceOrderRequester.GetClientOID() == object.URIEmpty && utilRuntimeContext.GetIntentAt() == 0`)
+
+			errs = append(errs, fmt.Errorf(
+				"%w: symbol %s",
+				object.ErrOrderClientOIDRequired,
+				dtoPlaceOrderRequester.GetSymbol(),
+			))
+
+			continue
+		}
+
+		if dtoPlaceOrderRequester.GetClientOID() == object.URIEmpty {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`dtoPlaceOrderRequester.GetClientOID() == object.URIEmpty`)
+
+			dtoPlaceOrderRequester = orderServicePlaceOrderRequestWithClientOID(
+				serviceAccount(ctx, service),
+				utilRuntimeContext,
+				dtoPlaceOrderRequester,
+			)
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldDTOPlaceOrderRequest, dtoPlaceOrderRequester).
+			Debug(object.URIEmpty)
+
+		if _, ok := indexes[dtoPlaceOrderRequester.GetClientOID()]; ok {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`_, ok := indexes[dtoPlaceOrderRequester.GetClientOID()]; ok`)
+
+			continue
+		}
+
+		indexes[dtoPlaceOrderRequester.GetClientOID()] = key
+
+		dtoPlaceOrderRequester, err := service.GetServicer().
+			GetSymbolServicer().
+			Validate(ctx, dtoPlaceOrderRequester)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrSymbolServiceValidate.Error())
+
+			errs = append(errs, err)
+
+			continue
+		}
+
+		dtoPlaceOrderRequester = orderServicePlaceOrderRequestWithMargin(
+			dtoPlaceOrderRequester,
+			dtoPlaceOrderRequesters[key],
+		)
+
+		omOrderer, submit, err := service.prepare(ctx, dtoPlaceOrderRequester)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrOrderServicePlace.Error())
+
+			errs = append(errs, err)
+
+			continue
+		}
+
+		if !submit {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`!submit`)
+
+			omOrderers[key] = omOrderer
+
+			continue
+		}
+
+		// The batch endpoint takes only spot limit orders, so a margin order or
+		// an order of any other type is a batch of its own.
+		batchKey := dtoPlaceOrderRequester.GetSymbol()
+		if !orderServiceBatchable(dtoPlaceOrderRequester) {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`!orderServiceBatchable(dtoPlaceOrderRequester)`)
+
+			batchKey = dtoPlaceOrderRequester.GetClientOID()
+		}
+
+		if _, ok := batches[batchKey]; !ok {
+			batchKeys = append(batchKeys, batchKey)
+		}
+
+		batches[batchKey] = append(batches[batchKey], dtoPlaceOrderRequester)
+		pending[dtoPlaceOrderRequester.GetClientOID()] = omOrderer
+	}
+
+	for _, batchKey := range batchKeys {
+		batch := batches[batchKey]
+		symbol := batch[0].GetSymbol()
+
+		for start := 0; start < len(batch); start += object.NUMKucoinMultiOrderCount {
+			end := start + object.NUMKucoinMultiOrderCount
+			if end > len(batch) {
+				end = len(batch)
+			}
+
+			omSubmittedOrderers, errSubmit := service.submit(ctx, symbol, batch[start:end], pending)
+			for _, omSubmittedOrderer := range omSubmittedOrderers {
+				omOrderers[indexes[omSubmittedOrderer.GetClientOID()]] = omSubmittedOrderer
+			}
+
+			if errSubmit != nil {
+				service.GetRuntimeLogger().
+					WithFields(fields).
+					WithField(object.URIFieldError, errSubmit).
+					Error(object.ErrOrderServicePlace.Error())
+
+				errs = append(errs, errSubmit)
+			}
+		}
+	}
+
+	omPlacedOrderers := make([]om.Orderer, 0, len(omOrderers))
+
+	for _, omOrderer := range omOrderers {
+		if omOrderer != nil {
+			omPlacedOrderers = append(omPlacedOrderers, omOrderer)
+		}
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrders, omPlacedOrderers).
+		Debug(object.URIEmpty)
+
+	if err := errors.Join(errs...); err != nil {
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServicePlace.Error())
+
+		return omPlacedOrderers, err
+	}
+
+	return omPlacedOrderers, nil
+}
+
+// Reconcile is a function.
+// The stored order is overwritten by the order the exchange reports, looked up
+// by its kucoin id once acknowledged and by its clientOid before that.
+func (service *orderService) Reconcile(
+	ctx context.Context,
+	omOrderer om.Orderer,
+) (om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Reconcile",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "Reconcile",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"om_orderer": omOrderer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	var (
+		response *kucoin.ApiResponse
+		err      error
+	)
+
+	if omOrderer.GetKucoinID() == omOrderer.GetClientOID() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omOrderer.GetKucoinID() == omOrderer.GetClientOID()`)
+
+		response, err = serviceAccountExchanger(ctx, service, omOrderer.GetAccount()).
+			OrderByClient(omOrderer.GetClientOID())
+	} else {
+		response, err = serviceAccountExchanger(ctx, service, omOrderer.GetAccount()).
+			Order(omOrderer.GetKucoinID())
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if err = orderServiceResponseError(omOrderer.GetClientOID(), response, err); err != nil {
+		if errors.Is(err, object.ErrOrderRejected) {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`errors.Is(err, object.ErrOrderRejected)`)
+
+			err = fmt.Errorf("%w: %w", object.ErrOrderNotFound, err)
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderKucoinServiceGet.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderKucoinServiceGet.Error())
+
+		return nil, err
+	}
+
+	kucoinOrderModel := &kucoin.OrderModel{}
+	if err = response.ReadData(kucoinOrderModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinOrderModel, kucoinOrderModel).
+		Debug(object.URIEmpty)
+
+	if kucoinOrderModel.Id == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrOrderNotFound).
+			Error(object.ErrOrderKucoinServiceGet.Error())
+		traceSpan.RecordError(object.ErrOrderNotFound)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderKucoinServiceGet.Error())
+
+		return nil, object.ErrOrderNotFound
+	}
+
+	omOrder := orderServiceOrderFromModel(
+		omOrderer.GetAccount(),
+		kucoinOrderModel,
+		omOrderer.GetPaper(),
+		omOrderer.GetID(),
+	)
+
+	updatedAt, err := service.GetServicer().GetOrderServicer().Update(ctx, omOrder)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceUpdate.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omOrder).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return omOrder, nil
+}
+
+// Sync is a function.
+// Sync reconciles every active order with the exchange, repairing whatever the
+// order change events missed. A pending order the exchange does not know is
+// left to the next Place with its clientOid.
+func (service *orderService) Sync(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Sync",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Sync",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omActiveOrderers := make([]om.Orderer, 0)
+	errs := make([]error, 0)
+
+	// Reconciled orders leave the active filter, so every page is read before
+	// any order is reconciled.
+	var daoCursorer dao.Cursorer = dao.NewCursor(0)
+
+	for daoCursorer != nil {
+		omOrderersPage, daoNextCursorer, errGetList := service.GetServicer().
+			GetOrderServicer().
+			GetListFromRepository(
+				ctx,
+				dao.NewPagination(daoCursorer, object.NUMKucoinRecentOrderCount),
+				dao.NewOrderFilter(
+					object.URIEmpty,
+					object.URIEmpty,
+					object.URIEmpty,
+					object.URIEmpty,
+					object.URIEmpty,
+					true,
+				),
+			)
+		if errGetList != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errGetList).
+				Error(object.ErrOrderRepositoryReadList.Error())
+			traceSpan.RecordError(errGetList)
+			traceSpan.SetStatus(codes.Error, object.ErrOrderRepositoryReadList.Error())
+
+			return errGetList
+		}
+
+		daoCursorer = daoNextCursorer
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMOrders, omOrderersPage).
+			WithField(object.URIFieldDAOCursor, daoCursorer).
+			Debug(object.URIEmpty)
+
+		omActiveOrderers = append(omActiveOrderers, omOrderersPage...)
+	}
+
+	for _, omOrderer := range omActiveOrderers {
+		omReconciledOrderer, errReconcile := service.GetServicer().
+			GetOrderServicer().
+			Reconcile(ctx, omOrderer)
+
+		pending := omOrderer.GetKucoinID() == omOrderer.GetClientOID()
+		if pending && errors.Is(errReconcile, object.ErrOrderNotFound) {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldOMOrder, omOrderer).
+				Debug(`pending && errors.Is(errReconcile, object.ErrOrderNotFound)`)
+
+			continue
+		}
+
+		if errReconcile != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errReconcile).
+				Error(object.ErrOrderServiceReconcile.Error())
+
+			errs = append(errs, errReconcile)
+
+			continue
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMOrder, omReconciledOrderer).
+			Debug(object.URIEmpty)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceSync.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceSync.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// Update is a function.
+func (service *orderService) Update(
+	ctx context.Context,
+	omOrderer om.Orderer,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Update",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "Update",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"om_orderer": omOrderer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoOrderer, err := service.GetOrderRepositorier().Read(ctx, omOrderer.GetID())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderRepositoryRead.Error())
+
+		return time.Time{}, err
+	}
+
+	daoOrder := dao.NewOrder(
+		daoOrderer.GetCreatedAt(),
+		time.Time{},
+		daoOrderer.GetDeletedAt(),
+		omOrderer.GetID(),
+		omOrderer.GetAccount(),
+		omOrderer.GetChannel(),
+		omOrderer.GetClientOID(),
+		omOrderer.GetDealFunds(),
+		omOrderer.GetDealSize(),
+		omOrderer.GetFee(),
+		omOrderer.GetFeeCurrency(),
+		omOrderer.GetFunds(),
+		omOrderer.GetKucoinID(),
+		omOrderer.GetKucoinType(),
+		omOrderer.GetOPType(),
+		omOrderer.GetPrice(),
+		omOrderer.GetRemark(),
+		omOrderer.GetSide(),
+		omOrderer.GetSize(),
+		omOrderer.GetStatus(),
+		omOrderer.GetStop(),
+		omOrderer.GetStopPrice(),
+		omOrderer.GetSTP(),
+		omOrderer.GetSymbol(),
+		omOrderer.GetTags(),
+		omOrderer.GetTimeInForce(),
+		omOrderer.GetTradeType(),
+		omOrderer.GetVisibleSize(),
+		omOrderer.GetCancelAfter(),
+		omOrderer.GetKucoinCreatedAt(),
+		omOrderer.GetCancelExist(),
+		omOrderer.GetHidden(),
+		omOrderer.GetIceBerg(),
+		omOrderer.GetIsActive(),
+		omOrderer.GetPaper(),
+		omOrderer.GetPostOnly(),
+		omOrderer.GetStopTriggered(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOOrder, daoOrder).
+		Debug(object.URIEmpty)
+
+	updatedAt, err := service.GetOrderRepositorier().Update(ctx, daoOrder)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderRepositoryUpdate.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return updatedAt, nil
+}
+
+// Upsert is a function.
+// The order is matched on its kucoin id, or on its clientOid while the stored
+// row is still pending; the stored row keeps its id, account and paper flag.
+func (service *orderService) Upsert(
+	ctx context.Context,
+	omOrderer om.Orderer,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Upsert",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "Upsert",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"om_orderer": omOrderer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	var daoOrders []dao.Orderer
+
+	for _, daoOrderFilterer := range orderServiceFilters(
+		omOrderer.GetKucoinID(),
+		omOrderer.GetClientOID(),
+	) {
+		daoFilteredOrders, _, err := service.GetOrderRepositorier().ReadList(
+			ctx,
+			dao.NewPagination(dao.NewCursor(0), 1),
+			daoOrderFilterer,
+		)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrOrderRepositoryReadList.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrOrderRepositoryReadList.Error())
+
+			return uuid.Nil, err
+		}
+
+		if len(daoFilteredOrders) != 0 {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`len(daoFilteredOrders) != 0`)
+
+			daoOrders = daoFilteredOrders
+
+			break
+		}
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOOrders, daoOrders).
+		Debug(object.URIEmpty)
+
+	if len(daoOrders) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(daoOrders) == 0`)
+
+		return service.GetServicer().GetOrderServicer().Create(ctx, omOrderer)
+	}
+
+	daoOrder := dao.NewOrder(
+		daoOrders[0].GetCreatedAt(),
+		time.Time{},
+		daoOrders[0].GetDeletedAt(),
+		daoOrders[0].GetID(),
+		daoOrders[0].GetAccount(),
+		omOrderer.GetChannel(),
+		omOrderer.GetClientOID(),
+		omOrderer.GetDealFunds(),
+		omOrderer.GetDealSize(),
+		omOrderer.GetFee(),
+		omOrderer.GetFeeCurrency(),
+		omOrderer.GetFunds(),
+		omOrderer.GetKucoinID(),
+		omOrderer.GetKucoinType(),
+		omOrderer.GetOPType(),
+		omOrderer.GetPrice(),
+		omOrderer.GetRemark(),
+		omOrderer.GetSide(),
+		omOrderer.GetSize(),
+		omOrderer.GetStatus(),
+		omOrderer.GetStop(),
+		omOrderer.GetStopPrice(),
+		omOrderer.GetSTP(),
+		omOrderer.GetSymbol(),
+		omOrderer.GetTags(),
+		omOrderer.GetTimeInForce(),
+		omOrderer.GetTradeType(),
+		omOrderer.GetVisibleSize(),
+		omOrderer.GetCancelAfter(),
+		omOrderer.GetKucoinCreatedAt(),
+		omOrderer.GetCancelExist(),
+		omOrderer.GetHidden(),
+		omOrderer.GetIceBerg(),
+		omOrderer.GetIsActive(),
+		daoOrders[0].GetPaper(),
+		omOrderer.GetPostOnly(),
+		omOrderer.GetStopTriggered(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOOrder, daoOrder).
+		Debug(object.URIEmpty)
+
+	updatedAt, err := service.GetOrderRepositorier().Update(ctx, daoOrder)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderRepositoryUpdate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return daoOrder.GetID(), nil
+}
+
+// create sends one order to the endpoint of its trade type, on the account of
+// the runtime context.
+func (service *orderService) create(
+	ctx context.Context,
+	kucoinCreateOrderModel *kucoin.CreateOrderModel,
+) (*kucoin.ApiResponse, error) {
+	if kucoinCreateOrderModel.MarginMode != object.URIEmpty {
+		return serviceExchanger(ctx, service).CreateMarginOrder(kucoinCreateOrderModel)
+	}
+
+	return serviceExchanger(ctx, service).CreateOrder(kucoinCreateOrderModel)
+}
+
+func (service *orderService) find(
+	ctx context.Context,
+	kucoinID string,
+	clientOID string,
+) (om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"find",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "find",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"kucoin_id":  kucoinID,
+		"client_oid": clientOID,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	for _, daoOrderFilterer := range orderServiceFilters(kucoinID, clientOID) {
+		omOrderers, _, err := service.GetServicer().GetOrderServicer().GetListFromRepository(
+			ctx,
+			dao.NewPagination(dao.NewCursor(0), 1),
+			daoOrderFilterer,
+		)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrOrderRepositoryReadList.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrOrderRepositoryReadList.Error())
+
+			return nil, err
+		}
+
+		if len(omOrderers) != 0 {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldOMOrder, omOrderers[0]).
+				Debug(`len(omOrderers) != 0`)
+
+			return omOrderers[0], nil
+		}
+	}
+
+	return nil, object.ErrOrderNotFound
+}
+
+func (service *orderService) prepare(
+	ctx context.Context,
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+) (om.Orderer, bool, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"prepare",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                      "prepare",
+		"rt_ctx":                    utilRuntimeContext,
+		"sp_ctx":                    utilSpanContext,
+		"config":                    service.configConfigger,
+		"dto_place_order_requester": dtoPlaceOrderRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	account := serviceAccount(ctx, service)
+	paper := service.GetConfigger().GetPaperConfigger().GetEnabled()
+
+	omOrderer, err := service.GetServicer().
+		GetOrderServicer().
+		GetByClientOID(ctx, dtoPlaceOrderRequester.GetClientOID())
+	if errors.Is(err, object.ErrOrderNotFound) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(err, object.ErrOrderNotFound)`)
+
+		errRiskCheck := service.GetServicer().GetRiskServicer().Check(ctx, dtoPlaceOrderRequester)
+		if errRiskCheck != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errRiskCheck).
+				Error(object.ErrRiskServiceCheck.Error())
+			traceSpan.RecordError(errRiskCheck)
+			traceSpan.SetStatus(codes.Error, object.ErrRiskServiceCheck.Error())
+
+			return nil, false, errRiskCheck
+		}
+
+		omOrderer = orderServiceOrderFromPlaceOrderRequest(
+			account,
+			dtoPlaceOrderRequester,
+			paper,
+			uuid.Nil,
+		)
+
+		orderID, errOrderCreate := service.GetServicer().GetOrderServicer().Create(ctx, omOrderer)
+		if errOrderCreate != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errOrderCreate).
+				Error(object.ErrOrderServiceCreate.Error())
+			traceSpan.RecordError(errOrderCreate)
+			traceSpan.SetStatus(codes.Error, object.ErrOrderServiceCreate.Error())
+
+			return nil, false, errOrderCreate
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOrderID, orderID).
+			Debug(object.URIEmpty)
+
+		return orderServiceOrderFromPlaceOrderRequest(
+			account,
+			dtoPlaceOrderRequester,
+			paper,
+			orderID,
+		), true, nil
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceGetByClientOID.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceGetByClientOID.Error())
+
+		return nil, false, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrder, omOrderer).
+		Debug(object.URIEmpty)
+
+	if omOrderer.GetKucoinID() != omOrderer.GetClientOID() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omOrderer.GetKucoinID() != omOrderer.GetClientOID()`)
+
+		return omOrderer, false, nil
+	}
+
+	omReconciledOrderer, err := service.GetServicer().GetOrderServicer().Reconcile(ctx, omOrderer)
+	if err == nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMOrder, omReconciledOrderer).
+			Debug(`err == nil`)
+
+		return omReconciledOrderer, false, nil
+	}
+
+	if !errors.Is(err, object.ErrOrderNotFound) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceReconcile.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceReconcile.Error())
+
+		return nil, false, err
+	}
+
+	err = service.GetServicer().GetRiskServicer().Check(ctx, dtoPlaceOrderRequester)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrRiskServiceCheck.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrRiskServiceCheck.Error())
+
+		return nil, false, err
+	}
+
+	omOrderer = orderServiceOrderFromPlaceOrderRequest(
+		account,
+		dtoPlaceOrderRequester,
+		paper,
+		omOrderer.GetID(),
+	)
+
+	updatedAt, err := service.GetServicer().GetOrderServicer().Update(ctx, omOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceUpdate.Error())
+
+		return nil, false, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return omOrderer, true, nil
+}
+
+func (service *orderService) settle(
+	ctx context.Context,
+	omOrderer om.Orderer,
+	kucoinID string,
+	errSubmit error,
+) (om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"settle",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "settle",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"om_orderer": omOrderer,
+		"kucoin_id":  kucoinID,
+		"err_submit": errSubmit,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if errors.Is(errSubmit, object.ErrOrderRejected) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(errSubmit, object.ErrOrderRejected)`)
+
+		updatedAt, err := service.GetServicer().
+			GetOrderServicer().
+			Update(ctx, orderServiceOrderWith(
+				omOrderer,
+				omOrderer.GetKucoinID(),
+				object.OrderStatusTypeCanceled,
+				false,
+			))
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrOrderServiceUpdate.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrOrderServiceUpdate.Error())
+
+			return nil, errors.Join(errSubmit, err)
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldUpdatedAt, updatedAt).
+			Debug(object.URIEmpty)
+
+		traceSpan.RecordError(errSubmit)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderRejected.Error())
+
+		return nil, errSubmit
+	}
+
+	if errSubmit == nil && kucoinID != object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errSubmit == nil && kucoinID != object.URIEmpty`)
+
+		omOrderer = orderServiceOrderWith(omOrderer, kucoinID, object.OrderStatusTypeNew, true)
+	}
+
+	omReconciledOrderer, err := service.GetServicer().GetOrderServicer().Reconcile(ctx, omOrderer)
+	if err == nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMOrder, omReconciledOrderer).
+			Debug(`err == nil`)
+
+		return omReconciledOrderer, nil
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldError, err).
+		Error(object.ErrOrderServiceReconcile.Error())
+
+	if errSubmit != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errSubmit != nil`)
+
+		traceSpan.RecordError(errSubmit)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderUnknown.Error())
+
+		return nil, errSubmit
+	}
+
+	if omOrderer.GetKucoinID() == omOrderer.GetClientOID() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omOrderer.GetKucoinID() == omOrderer.GetClientOID()`)
+
+		err = object.NewOrderUnknownError(omOrderer.GetClientOID(), object.URIEmpty, err.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderUnknown.Error())
+
+		return nil, err
+	}
+
+	updatedAt, err := service.GetServicer().GetOrderServicer().Update(ctx, omOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceUpdate.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return omOrderer, nil
+}
+
+func (service *orderService) submit(
+	ctx context.Context,
+	symbol string,
+	dtoPlaceOrderRequesters []dto.PlaceOrderRequester,
+	pending map[string]om.Orderer,
+) ([]om.Orderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"submit",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                       "submit",
+		"rt_ctx":                     utilRuntimeContext,
+		"sp_ctx":                     utilSpanContext,
+		"config":                     service.configConfigger,
+		"symbol":                     symbol,
+		"dto_place_order_requesters": dtoPlaceOrderRequesters,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	kucoinCreateOrderModels := make([]*kucoin.CreateOrderModel, 0, len(dtoPlaceOrderRequesters))
+	kucoinIDs := make(map[string]string, len(dtoPlaceOrderRequesters))
+	errSubmits := make(map[string]error, len(dtoPlaceOrderRequesters))
+
+	for _, dtoPlaceOrderRequester := range dtoPlaceOrderRequesters {
+		kucoinCreateOrderModels = append(
+			kucoinCreateOrderModels,
+			orderServiceCreateOrderModel(dtoPlaceOrderRequester),
+		)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinCreateOrderModel, kucoinCreateOrderModels).
+		Debug(object.URIEmpty)
+
+	if len(kucoinCreateOrderModels) == 1 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(kucoinCreateOrderModels) == 1`)
+
+		clientOID := kucoinCreateOrderModels[0].ClientOid
+		response, err := service.create(ctx, kucoinCreateOrderModels[0])
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldResponse, response).
+			Debug(object.URIEmpty)
+
+		errSubmits[clientOID] = orderServiceResponseError(clientOID, response, err)
+		if errSubmits[clientOID] == nil {
+			kucoinCreateOrderResultModel := &kucoin.CreateOrderResultModel{}
+			if err = response.ReadData(kucoinCreateOrderResultModel); err != nil {
+				service.GetRuntimeLogger().
+					WithFields(fields).
+					WithField(object.URIFieldError, err).
+					Error(object.ErrKucoinServiceReadData.Error())
+			}
+
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldKucoinCreateOrderResultModel, kucoinCreateOrderResultModel).
+				Debug(object.URIEmpty)
+
+			kucoinIDs[clientOID] = kucoinCreateOrderResultModel.OrderId
+		}
+	} else {
+		response, err := serviceExchanger(ctx, service).CreateMultiOrder(symbol, kucoinCreateOrderModels)
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldResponse, response).
+			Debug(object.URIEmpty)
+
+		createMultiOrderResultModel := &exchange.CreateMultiOrderResultModel{}
+
+		errResponse := orderServiceResponseError(object.URIEmpty, response, err)
+		if errResponse == nil {
+			if err = response.ReadData(createMultiOrderResultModel); err != nil {
+				service.GetRuntimeLogger().
+					WithFields(fields).
+					WithField(object.URIFieldError, err).
+					Error(object.ErrKucoinServiceReadData.Error())
+			}
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldCreateMultiOrderResultModel, createMultiOrderResultModel).
+			Debug(object.URIEmpty)
+
+		for _, createMultiOrderModel := range createMultiOrderResultModel.Data {
+			switch createMultiOrderModel.Status {
+			case object.URIKucoinOrderStatusSuccess:
+				kucoinIDs[createMultiOrderModel.ClientOid] = createMultiOrderModel.ID
+				errSubmits[createMultiOrderModel.ClientOid] = nil
+			case object.URIKucoinOrderStatusFail:
+				if strings.Contains(
+					createMultiOrderModel.FailMsg,
+					object.URIKucoinMessageClientOIDDuplicated,
+				) {
+					errSubmits[createMultiOrderModel.ClientOid] = object.NewOrderUnknownError(
+						createMultiOrderModel.ClientOid,
+						object.URIKucoinCodeInvalidParameter,
+						createMultiOrderModel.FailMsg,
+					)
+
+					continue
+				}
+
+				errSubmits[createMultiOrderModel.ClientOid] = object.NewOrderRejectedError(
+					createMultiOrderModel.ClientOid,
+					object.URIEmpty,
+					createMultiOrderModel.FailMsg,
+				)
+			}
+		}
+
+		objectOrderErrorer := object.NewOrderUnknownError(
+			object.URIEmpty,
+			object.URIEmpty,
+			object.URIEmpty,
+		)
+		errors.As(errResponse, &objectOrderErrorer)
+
+		for _, kucoinCreateOrderModel := range kucoinCreateOrderModels {
+			if _, ok := errSubmits[kucoinCreateOrderModel.ClientOid]; ok {
+				continue
+			}
+
+			if errors.Is(errResponse, object.ErrOrderRejected) {
+				errSubmits[kucoinCreateOrderModel.ClientOid] = object.NewOrderRejectedError(
+					kucoinCreateOrderModel.ClientOid,
+					objectOrderErrorer.GetCode(),
+					objectOrderErrorer.GetMessage(),
+				)
+
+				continue
+			}
+
+			errSubmits[kucoinCreateOrderModel.ClientOid] = object.NewOrderUnknownError(
+				kucoinCreateOrderModel.ClientOid,
+				objectOrderErrorer.GetCode(),
+				objectOrderErrorer.GetMessage(),
+			)
+		}
+	}
+
+	omOrderers := make([]om.Orderer, 0, len(kucoinCreateOrderModels))
+	errs := make([]error, 0)
+
+	for _, kucoinCreateOrderModel := range kucoinCreateOrderModels {
+		omOrderer, err := service.settle(
+			ctx,
+			pending[kucoinCreateOrderModel.ClientOid],
+			kucoinIDs[kucoinCreateOrderModel.ClientOid],
+			errSubmits[kucoinCreateOrderModel.ClientOid],
+		)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrOrderKucoinServiceCreate.Error())
+
+			errs = append(errs, err)
+
+			continue
+		}
+
+		omOrderers = append(omOrderers, omOrderer)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMOrders, omOrderers).
+		Debug(object.URIEmpty)
+
+	if err := errors.Join(errs...); err != nil {
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderKucoinServiceCreate.Error())
+
+		return omOrderers, err
+	}
+
+	return omOrderers, nil
+}
+
+// orderServiceBatchable reports whether the order may be sent through the batch
+// endpoint, which accepts spot limit orders only. An order without a type is
+// a limit order to KuCoin.
+func orderServiceBatchable(
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+) bool {
+	if _, ok := orderServiceMarginMode(dtoPlaceOrderRequester.GetTradeType()); ok {
+		return false
+	}
+
+	switch dtoPlaceOrderRequester.GetOrderType() {
+	case object.OrderTypeTypeLimit, object.OrderTypeType(object.URIEmpty):
+		return true
+	default:
+		return false
+	}
+}
+
+// orderServiceResponseError classifies the answer of the exchange.
+// A transport failure or a busy exchange leaves the outcome unknown, and so
+// does a duplicated clientOid, since the exchange already has an order under
+// it that must be reconciled rather than canceled. Any other failure means the
+// request was refused. A request the open circuit or the rate limit held back
+// was never sent, so it is refused too, and the refusal still matches its
+// cause.
+func orderServiceResponseError(
+	clientOID string,
+	response *kucoin.ApiResponse,
+	err error,
+) error {
+	errResponse := exchange.NewResponseError(response, err)
+	if errResponse == nil {
+		return nil
+	}
+
+	if errors.Is(errResponse, object.ErrExchangeCircuitOpen) ||
+		errors.Is(errResponse, object.ErrExchangeRateLimitWait) {
+		return fmt.Errorf(
+			"%w: %w",
+			object.NewOrderRejectedError(clientOID, object.URIEmpty, errResponse.Error()),
+			errResponse,
+		)
+	}
+
+	var objectKucoinErrorer object.KucoinErrorer
+	if !errors.As(errResponse, &objectKucoinErrorer) {
+		return object.NewOrderUnknownError(clientOID, object.URIEmpty, errResponse.Error())
+	}
+
+	if errors.Is(errResponse, object.ErrKucoinTransport) ||
+		errors.Is(errResponse, object.ErrKucoinSystemBusy) ||
+		errors.Is(errResponse, object.ErrKucoinClientOIDDuplicated) {
+		return object.NewOrderUnknownError(
+			clientOID,
+			objectKucoinErrorer.GetCode(),
+			objectKucoinErrorer.GetMessage(),
+		)
+	}
+
+	return object.NewOrderRejectedError(
+		clientOID,
+		objectKucoinErrorer.GetCode(),
+		objectKucoinErrorer.GetMessage(),
+	)
+}
+
+func orderServiceCreateOrderModel(
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+) *kucoin.CreateOrderModel {
+	kucoinCreateOrderModel := &kucoin.CreateOrderModel{
+		ClientOid:   dtoPlaceOrderRequester.GetClientOID(),
+		Side:        string(dtoPlaceOrderRequester.GetSide()),
+		Symbol:      dtoPlaceOrderRequester.GetSymbol(),
+		Type:        string(dtoPlaceOrderRequester.GetOrderType()),
+		Remark:      dtoPlaceOrderRequester.GetRemark(),
+		STP:         dtoPlaceOrderRequester.GetSTP(),
+		TradeType:   string(dtoPlaceOrderRequester.GetTradeType()),
+		Price:       dtoPlaceOrderRequester.GetPrice(),
+		Size:        dtoPlaceOrderRequester.GetSize(),
+		TimeInForce: string(dtoPlaceOrderRequester.GetTimeInForce()),
+		CancelAfter: dtoPlaceOrderRequester.GetCancelAfter(),
+		PostOnly:    dtoPlaceOrderRequester.GetPostOnly(),
+		Hidden:      dtoPlaceOrderRequester.GetHidden(),
+		IceBerg:     dtoPlaceOrderRequester.GetIceBerg(),
+		VisibleSize: dtoPlaceOrderRequester.GetVisibleSize(),
+		Funds:       dtoPlaceOrderRequester.GetFunds(),
+	}
+
+	if marginMode, ok := orderServiceMarginMode(dtoPlaceOrderRequester.GetTradeType()); ok {
+		kucoinCreateOrderModel.MarginMode = string(marginMode)
+	}
+
+	if dtoPlaceMarginOrderRequester, ok := dtoPlaceOrderRequester.(dto.PlaceMarginOrderRequester); ok {
+		kucoinCreateOrderModel.AutoBorrow = dtoPlaceMarginOrderRequester.GetAutoBorrow()
+	}
+
+	return kucoinCreateOrderModel
+}
+
+// orderServiceMarginMode is the margin account an order of the trade type
+// trades on, and false for a spot order.
+func orderServiceMarginMode(
+	tradeType object.OrderTypeType,
+) (object.MarginModeType, bool) {
+	switch tradeType {
+	case object.OrderTypeTypeMarginTrade:
+		return object.MarginModeTypeCross, true
+	case object.OrderTypeTypeMarginIsolatedTrade:
+		return object.MarginModeTypeIsolated, true
+	}
+
+	return object.MarginModeTypeCross, false
+}
+
+// orderServiceOrderFromModel builds the order the way the exchange reports it.
+func orderServiceOrderFromModel(
+	account string,
+	kucoinOrderModel *kucoin.OrderModel,
+	paper bool,
+	id uuid.UUID,
+) om.Orderer {
+	return om.NewOrder(
+		account,
+		kucoinOrderModel.Channel,
+		kucoinOrderModel.ClientOid,
+		kucoinOrderModel.DealFunds,
+		kucoinOrderModel.DealSize,
+		kucoinOrderModel.Fee,
+		kucoinOrderModel.FeeCurrency,
+		kucoinOrderModel.Funds,
+		kucoinOrderModel.Id,
+		kucoinOrderModel.Type,
+		kucoinOrderModel.OpType,
+		kucoinOrderModel.Price,
+		kucoinOrderModel.Remark,
+		kucoinOrderModel.Side,
+		kucoinOrderModel.Size,
+		string(orderServiceStatus(
+			kucoinOrderModel.IsActive,
+			kucoinOrderModel.CancelExist,
+			kucoinOrderModel.DealSize,
+		)),
+		kucoinOrderModel.Stop,
+		kucoinOrderModel.StopPrice,
+		kucoinOrderModel.Stp,
+		kucoinOrderModel.Symbol,
+		kucoinOrderModel.Tags,
+		kucoinOrderModel.TimeInForce,
+		kucoinOrderModel.TradeType,
+		kucoinOrderModel.VisibleSize,
+		uint32(kucoinOrderModel.CancelAfter),
+		uint32(kucoinOrderModel.CreatedAt),
+		kucoinOrderModel.CancelExist,
+		kucoinOrderModel.Hidden,
+		kucoinOrderModel.IceBerg,
+		kucoinOrderModel.IsActive,
+		paper,
+		kucoinOrderModel.PostOnly,
+		kucoinOrderModel.StopTriggered,
+		id,
+	)
+}
+
+// orderServiceOrderFromPlaceOrderRequest builds the pending order.
+// Until the exchange assigns an id, the clientOid stands in for the kucoin id.
+func orderServiceOrderFromPlaceOrderRequest(
+	account string,
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+	paper bool,
+	id uuid.UUID,
+) om.Orderer {
+	return om.NewOrder(
+		account,
+		object.URIKucoinOrderChannelAPI,
+		dtoPlaceOrderRequester.GetClientOID(),
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		dtoPlaceOrderRequester.GetFunds(),
+		dtoPlaceOrderRequester.GetClientOID(),
+		string(dtoPlaceOrderRequester.GetOrderType()),
+		object.URIKucoinOrderOPTypeDeal,
+		dtoPlaceOrderRequester.GetPrice(),
+		dtoPlaceOrderRequester.GetRemark(),
+		string(dtoPlaceOrderRequester.GetSide()),
+		dtoPlaceOrderRequester.GetSize(),
+		string(object.OrderStatusTypeNew),
+		object.URIEmpty,
+		object.URIEmpty,
+		dtoPlaceOrderRequester.GetSTP(),
+		dtoPlaceOrderRequester.GetSymbol(),
+		object.URIEmpty,
+		string(dtoPlaceOrderRequester.GetTimeInForce()),
+		string(dtoPlaceOrderRequester.GetTradeType()),
+		dtoPlaceOrderRequester.GetVisibleSize(),
+		uint32(dtoPlaceOrderRequester.GetCancelAfter()),
+		0,
+		false,
+		dtoPlaceOrderRequester.GetHidden(),
+		dtoPlaceOrderRequester.GetIceBerg(),
+		true,
+		paper,
+		dtoPlaceOrderRequester.GetPostOnly(),
+		false,
+		id,
+	)
+}
+
+func orderServiceOrderWith(
+	omOrderer om.Orderer,
+	kucoinID string,
+	status object.OrderStatusType,
+	isActive bool,
+) om.Orderer {
+	return om.NewOrder(
+		omOrderer.GetAccount(),
+		omOrderer.GetChannel(),
+		omOrderer.GetClientOID(),
+		omOrderer.GetDealFunds(),
+		omOrderer.GetDealSize(),
+		omOrderer.GetFee(),
+		omOrderer.GetFeeCurrency(),
+		omOrderer.GetFunds(),
+		kucoinID,
+		omOrderer.GetKucoinType(),
+		omOrderer.GetOPType(),
+		omOrderer.GetPrice(),
+		omOrderer.GetRemark(),
+		omOrderer.GetSide(),
+		omOrderer.GetSize(),
+		string(status),
+		omOrderer.GetStop(),
+		omOrderer.GetStopPrice(),
+		omOrderer.GetSTP(),
+		omOrderer.GetSymbol(),
+		omOrderer.GetTags(),
+		omOrderer.GetTimeInForce(),
+		omOrderer.GetTradeType(),
+		omOrderer.GetVisibleSize(),
+		omOrderer.GetCancelAfter(),
+		omOrderer.GetKucoinCreatedAt(),
+		omOrderer.GetCancelExist(),
+		omOrderer.GetHidden(),
+		omOrderer.GetIceBerg(),
+		isActive,
+		omOrderer.GetPaper(),
+		omOrderer.GetPostOnly(),
+		omOrderer.GetStopTriggered(),
+		omOrderer.GetID(),
+	)
+}
+
+// orderServicePlaceOrderRequestWithClientOID derives the clientOid from the
+// account, the strategy and the intent time along with the fields of the
+// request, so two intents that ask for the same order are two orders.
+func orderServicePlaceOrderRequestWithClientOID(
+	account string,
+	utilRuntimeContexter util.RuntimeContexter,
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+) dto.PlaceOrderRequester {
+	return orderServicePlaceOrderRequestWithMargin(dto.NewPlaceOrderRequest(
+		util.ClientOID(
+			account,
+			utilRuntimeContexter.GetStrategy(),
+			strconv.FormatInt(utilRuntimeContexter.GetIntentAt(), 10),
+			dtoPlaceOrderRequester.GetSymbol(),
+			string(dtoPlaceOrderRequester.GetSide()),
+			string(dtoPlaceOrderRequester.GetOrderType()),
+			string(dtoPlaceOrderRequester.GetTradeType()),
+			dtoPlaceOrderRequester.GetPrice(),
+			dtoPlaceOrderRequester.GetSize(),
+			dtoPlaceOrderRequester.GetFunds(),
+			dtoPlaceOrderRequester.GetRemark(),
+		),
+		dtoPlaceOrderRequester.GetFunds(),
+		dtoPlaceOrderRequester.GetOrderType(),
+		dtoPlaceOrderRequester.GetPrice(),
+		dtoPlaceOrderRequester.GetRemark(),
+		dtoPlaceOrderRequester.GetSide(),
+		dtoPlaceOrderRequester.GetSize(),
+		dtoPlaceOrderRequester.GetSTP(),
+		dtoPlaceOrderRequester.GetSymbol(),
+		dtoPlaceOrderRequester.GetTimeInForce(),
+		dtoPlaceOrderRequester.GetTradeType(),
+		dtoPlaceOrderRequester.GetVisibleSize(),
+		dtoPlaceOrderRequester.GetCancelAfter(),
+		dtoPlaceOrderRequester.GetHidden(),
+		dtoPlaceOrderRequester.GetIceBerg(),
+		dtoPlaceOrderRequester.GetPostOnly(),
+	), dtoPlaceOrderRequester)
+}
+
+// orderServicePlaceOrderRequestWithMargin carries the margin options of the
+// original request over to a request rebuilt from it.
+func orderServicePlaceOrderRequestWithMargin(
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+	dtoOriginalPlaceOrderRequester dto.PlaceOrderRequester,
+) dto.PlaceOrderRequester {
+	dtoPlaceMarginOrderRequester, ok := dtoOriginalPlaceOrderRequester.(dto.PlaceMarginOrderRequester)
+	if !ok {
+		return dtoPlaceOrderRequester
+	}
+
+	return dto.NewPlaceMarginOrderRequest(
+		dtoPlaceOrderRequester.GetClientOID(),
+		dtoPlaceOrderRequester.GetFunds(),
+		dtoPlaceOrderRequester.GetOrderType(),
+		dtoPlaceOrderRequester.GetPrice(),
+		dtoPlaceOrderRequester.GetRemark(),
+		dtoPlaceOrderRequester.GetSide(),
+		dtoPlaceOrderRequester.GetSize(),
+		dtoPlaceOrderRequester.GetSTP(),
+		dtoPlaceOrderRequester.GetSymbol(),
+		dtoPlaceOrderRequester.GetTimeInForce(),
+		dtoPlaceOrderRequester.GetTradeType(),
+		dtoPlaceOrderRequester.GetVisibleSize(),
+		dtoPlaceOrderRequester.GetCancelAfter(),
+		dtoPlaceMarginOrderRequester.GetAutoBorrow(),
+		dtoPlaceOrderRequester.GetHidden(),
+		dtoPlaceOrderRequester.GetIceBerg(),
+		dtoPlaceOrderRequester.GetPostOnly(),
+	)
+}
+
+// orderServiceChangeStatus is the state an order change event moves the order
+// to. An update only resizes the order, so the order keeps its state.
+func orderServiceChangeStatus(
+	changeType object.OrderChangeTypeType,
+	status object.OrderStatusType,
+	remainSize string,
+) object.OrderStatusType {
+	switch changeType {
+	case object.OrderChangeTypeTypeReceived:
+		return object.OrderStatusTypeNew
+	case object.OrderChangeTypeTypeOpen:
+		return object.OrderStatusTypeOpen
+	case object.OrderChangeTypeTypeMatch:
+		if bracketServicePositive(remainSize) {
+			return object.OrderStatusTypePartiallyFilled
+		}
+
+		return object.OrderStatusTypeFilled
+	case object.OrderChangeTypeTypeFilled:
+		return object.OrderStatusTypeFilled
+	case object.OrderChangeTypeTypeCanceled:
+		return object.OrderStatusTypeCanceled
+	case object.OrderChangeTypeTypeUpdate:
+		return status
+	}
+
+	return object.OrderStatusType(object.URIEmpty)
+}
+
+// orderServiceFilters matches an order on its kucoin id and, while the stored
+// order is still pending, on its clientOid.
+func orderServiceFilters(
+	kucoinID string,
+	clientOID string,
+) []dao.OrderFilterer {
+	daoOrderFilterers := make([]dao.OrderFilterer, 0, 2)
+
+	if kucoinID != object.URIEmpty {
+		daoOrderFilterers = append(daoOrderFilterers, dao.NewOrderFilter(
+			object.URIEmpty,
+			object.URIEmpty,
+			kucoinID,
+			object.URIEmpty,
+			object.URIEmpty,
+			false,
+		))
+	}
+
+	if clientOID != object.URIEmpty && clientOID != kucoinID {
+		daoOrderFilterers = append(daoOrderFilterers, dao.NewOrderFilter(
+			object.URIEmpty,
+			clientOID,
+			clientOID,
+			object.URIEmpty,
+			object.URIEmpty,
+			false,
+		))
+	}
+
+	return daoOrderFilterers
+}
+
+// orderServiceOrderFromChange builds an order the service did not place from
+// its first event. It has no state yet, so any event moves it.
+func orderServiceOrderFromChange(
+	account string,
+	exchangeOrderChangeModel exchange.OrderChangeModel,
+) om.Orderer {
+	return om.NewOrder(
+		account,
+		object.URIEmpty,
+		exchangeOrderChangeModel.ClientOid,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		exchangeOrderChangeModel.OrderID,
+		exchangeOrderChangeModel.OrderType,
+		object.URIKucoinOrderOPTypeDeal,
+		exchangeOrderChangeModel.Price,
+		object.URIEmpty,
+		exchangeOrderChangeModel.Side,
+		exchangeOrderChangeModel.Size,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		exchangeOrderChangeModel.Symbol,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		0,
+		0,
+		false,
+		false,
+		false,
+		true,
+		false,
+		false,
+		false,
+		uuid.Nil,
+	)
+}
+
+// orderServiceOrderWithChange applies an order change event to the order. The
+// deal size only grows, and a match adds the newly filled size at its price to
+// the deal funds, so a replayed match is not counted twice.
+func orderServiceOrderWithChange(
+	omOrderer om.Orderer,
+	exchangeOrderChangeModel exchange.OrderChangeModel,
+	status object.OrderStatusType,
+) (om.Orderer, error) {
+	dealFunds := omOrderer.GetDealFunds()
+	dealSize := omOrderer.GetDealSize()
+
+	if bracketServicePositive(exchangeOrderChangeModel.FilledSize) {
+		filledSize := exchangeOrderChangeModel.FilledSize
+
+		if dealSize == object.URIEmpty {
+			dealSize = "0"
+		}
+
+		matchedSize, err := util.DecimalSubtract(filledSize, dealSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if bracketServicePositive(matchedSize) {
+			if exchangeOrderChangeModel.MatchPrice != object.URIEmpty {
+				matchedFunds, errMultiply := util.DecimalMultiply(
+					matchedSize,
+					exchangeOrderChangeModel.MatchPrice,
+				)
+				if errMultiply != nil {
+					return nil, errMultiply
+				}
+
+				if dealFunds == object.URIEmpty {
+					dealFunds = "0"
+				}
+
+				if dealFunds, err = util.DecimalAdd(dealFunds, matchedFunds); err != nil {
+					return nil, err
+				}
+			}
+
+			dealSize = filledSize
+		}
+	}
+
+	size := omOrderer.GetSize()
+	if exchangeOrderChangeModel.Size != object.URIEmpty {
+		size = exchangeOrderChangeModel.Size
+	}
+
+	return om.NewOrder(
+		omOrderer.GetAccount(),
+		omOrderer.GetChannel(),
+		omOrderer.GetClientOID(),
+		dealFunds,
+		dealSize,
+		omOrderer.GetFee(),
+		omOrderer.GetFeeCurrency(),
+		omOrderer.GetFunds(),
+		exchangeOrderChangeModel.OrderID,
+		omOrderer.GetKucoinType(),
+		omOrderer.GetOPType(),
+		omOrderer.GetPrice(),
+		omOrderer.GetRemark(),
+		omOrderer.GetSide(),
+		size,
+		string(status),
+		omOrderer.GetStop(),
+		omOrderer.GetStopPrice(),
+		omOrderer.GetSTP(),
+		omOrderer.GetSymbol(),
+		omOrderer.GetTags(),
+		omOrderer.GetTimeInForce(),
+		omOrderer.GetTradeType(),
+		omOrderer.GetVisibleSize(),
+		omOrderer.GetCancelAfter(),
+		omOrderer.GetKucoinCreatedAt(),
+		omOrderer.GetCancelExist() || status == object.OrderStatusTypeCanceled,
+		omOrderer.GetHidden(),
+		omOrderer.GetIceBerg(),
+		orderServiceStatusRank(status) < orderServiceStatusRank(object.OrderStatusTypeFilled),
+		omOrderer.GetPaper(),
+		omOrderer.GetPostOnly(),
+		omOrderer.GetStopTriggered(),
+		omOrderer.GetID(),
+	), nil
+}
+
+// orderServiceStatus is the state of an order the way the exchange reports it.
+func orderServiceStatus(
+	isActive bool,
+	cancelExist bool,
+	dealSize string,
+) object.OrderStatusType {
+	switch {
+	case isActive && bracketServicePositive(dealSize):
+		return object.OrderStatusTypePartiallyFilled
+	case isActive:
+		return object.OrderStatusTypeOpen
+	case cancelExist:
+		return object.OrderStatusTypeCanceled
+	}
+
+	return object.OrderStatusTypeFilled
+}
+
+// orderServiceStatusRank orders the states an order moves through: new, open,
+// partially filled, and then filled or canceled, which are both final.
+func orderServiceStatusRank(
+	status object.OrderStatusType,
+) int {
+	switch status {
+	case object.OrderStatusTypeNew:
+		return 1
+	case object.OrderStatusTypeOpen:
+		return 2
+	case object.OrderStatusTypePartiallyFilled:
+		return 3
+	case object.OrderStatusTypeFilled, object.OrderStatusTypeCanceled:
+		return 4
+	}
+
+	return 0
+}
+
+// orderServiceTransition reports whether an order may move from one state to
+// another. It only moves forward; staying in a state is allowed until the
+// state is final, so further fills and resizes still apply.
+func orderServiceTransition(
+	from object.OrderStatusType,
+	to object.OrderStatusType,
+) bool {
+	if orderServiceStatusRank(to) == 0 {
+		return false
+	}
+
+	if from == to {
+		return orderServiceStatusRank(to) < orderServiceStatusRank(object.OrderStatusTypeFilled)
+	}
+
+	return orderServiceStatusRank(to) > orderServiceStatusRank(from)
+}
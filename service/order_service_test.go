@@ -0,0 +1,531 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/exchange/exchangetest"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type (
+	orderServiceTestServicer struct {
+		Servicer
+		orderServicer  OrderServicer
+		riskServicer   RiskServicer
+		symbolServicer SymbolServicer
+	}
+
+	orderServiceTestOrderServicer struct {
+		OrderServicer
+		omOrderers []om.Orderer
+		mutex      sync.Mutex
+	}
+
+	orderServiceTestRiskServicer struct {
+		RiskServicer
+	}
+
+	orderServiceTestSymbolServicer struct {
+		SymbolServicer
+	}
+)
+
+// GetOrderServicer is a function.
+func (servicer *orderServiceTestServicer) GetOrderServicer() OrderServicer {
+	return servicer.orderServicer
+}
+
+// GetRiskServicer is a function.
+func (servicer *orderServiceTestServicer) GetRiskServicer() RiskServicer {
+	return servicer.riskServicer
+}
+
+// GetSymbolServicer is a function.
+func (servicer *orderServiceTestServicer) GetSymbolServicer() SymbolServicer {
+	return servicer.symbolServicer
+}
+
+// Create is a function.
+// It keeps the order instead of storing it, so the test runs without a
+// database.
+func (servicer *orderServiceTestOrderServicer) Create(
+	_ context.Context,
+	omOrderer om.Orderer,
+) (uuid.UUID, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	servicer.omOrderers = append(servicer.omOrderers, omOrderer)
+
+	return uuid.Nil, nil
+}
+
+// GetByClientOID is a function.
+// No order is stored, so every order is placed.
+func (servicer *orderServiceTestOrderServicer) GetByClientOID(
+	_ context.Context,
+	_ string,
+) (om.Orderer, error) {
+	return nil, object.ErrOrderNotFound
+}
+
+// Reconcile is a function.
+// The order is reported the way it was acknowledged.
+func (servicer *orderServiceTestOrderServicer) Reconcile(
+	_ context.Context,
+	omOrderer om.Orderer,
+) (om.Orderer, error) {
+	return omOrderer, nil
+}
+
+// Update is a function.
+func (servicer *orderServiceTestOrderServicer) Update(
+	_ context.Context,
+	_ om.Orderer,
+) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+// Upsert is a function.
+// It keeps the order instead of storing it, so the test runs without a
+// database.
+func (servicer *orderServiceTestOrderServicer) Upsert(
+	_ context.Context,
+	omOrderer om.Orderer,
+) (uuid.UUID, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	servicer.omOrderers = append(servicer.omOrderers, omOrderer)
+
+	return uuid.Nil, nil
+}
+
+// Check is a function.
+func (servicer *orderServiceTestRiskServicer) Check(
+	_ context.Context,
+	_ dto.PlaceOrderRequester,
+) error {
+	return nil
+}
+
+// Validate is a function.
+func (servicer *orderServiceTestSymbolServicer) Validate(
+	_ context.Context,
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+) (dto.PlaceOrderRequester, error) {
+	return dtoPlaceOrderRequester, nil
+}
+
+func newOrderServiceTest(
+	t *testing.T,
+) (exchangetest.FakeServerer, *orderServiceTestOrderServicer) {
+	t.Helper()
+
+	fakeServerer := exchangetest.NewFakeServer(
+		kucoin.ApiKeyOption("key"),
+		kucoin.ApiSecretOption("secret"),
+		kucoin.ApiPassPhraseOption("passphrase"),
+		kucoin.ApiKeyVersionOption(kucoin.ApiKeyVersionV2),
+	)
+	t.Cleanup(fakeServerer.Close)
+
+	return fakeServerer, newOrderServiceTestOrderServicer(fakeServerer.GetExchanger())
+}
+
+func newOrderServiceTestOrderServicer(
+	exchangeExchanger exchange.Exchanger,
+) *orderServiceTestOrderServicer {
+	configConfigger := config.NewConfig(
+		config.WithKucoinConfigger(),
+		config.WithLogConfigger(),
+		config.WithPaperConfigger(),
+		config.WithRuntimeConfigger(
+			config.WithRuntimeConfigKucoinPaginationRequestSize(2),
+		),
+	)
+
+	orderServicer := NewOrderServicer(
+		configConfigger,
+		nil,
+		log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+		trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+		util.NewUUID(),
+		exchangeExchanger,
+	)
+
+	testOrderServicer := &orderServiceTestOrderServicer{
+		OrderServicer: orderServicer,
+		omOrderers:    []om.Orderer{},
+		mutex:         sync.Mutex{},
+	}
+
+	orderServicer.(WithServicer).WithServicer(&orderServiceTestServicer{
+		Servicer:       nil,
+		orderServicer:  testOrderServicer,
+		riskServicer:   &orderServiceTestRiskServicer{RiskServicer: nil},
+		symbolServicer: &orderServiceTestSymbolServicer{SymbolServicer: nil},
+	})
+
+	return testOrderServicer
+}
+
+func TestOrderServiceGetListFromRemote(t *testing.T) {
+	t.Parallel()
+
+	fakeServerer, testOrderServicer := newOrderServiceTest(t)
+
+	items := []any{}
+	for index := 1; index <= 3; index++ {
+		items = append(items, map[string]any{
+			"id":        strconv.Itoa(index),
+			"clientOid": "client-" + strconv.Itoa(index),
+			"symbol":    "BTC-USDT",
+			"side":      string(object.OrderSideTypeBuy),
+			"isActive":  true,
+			"dealSize":  "0",
+		})
+	}
+
+	fakeServerer.HandlePagination(http.MethodGet, object.URIKucoinPathOrders, items)
+
+	if err := testOrderServicer.GetListFromRemote(
+		context.Background(),
+		dto.NewOrderRequest(
+			object.URIEmpty,
+			object.OrderTypeType(object.URIEmpty),
+			object.OrderSideTypeBuy,
+			object.URIEmpty,
+			object.OrderStateType(object.URIEmpty),
+			"BTC-USDT",
+			object.OrderTypeType(object.URIEmpty),
+		),
+		1,
+	); err != nil {
+		t.Fatalf("GetListFromRemote() error = %v", err)
+	}
+
+	if got := len(fakeServerer.GetRequesters(http.MethodGet, object.URIKucoinPathOrders)); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+
+	if got := len(testOrderServicer.omOrderers); got != len(items) {
+		t.Fatalf("upserts = %d, want %d", got, len(items))
+	}
+
+	for index, omOrderer := range testOrderServicer.omOrderers {
+		if want := strconv.Itoa(index + 1); omOrderer.GetKucoinID() != want {
+			t.Errorf("upserts[%d].GetKucoinID() = %q, want %q", index, omOrderer.GetKucoinID(), want)
+		}
+	}
+}
+
+func TestOrderServiceGetListFromRemoteError(t *testing.T) {
+	t.Parallel()
+
+	fakeServerer, testOrderServicer := newOrderServiceTest(t)
+
+	fakeServerer.Enqueue(
+		http.MethodGet,
+		object.URIKucoinPathOrders,
+		exchangetest.NewFakeErrorResponse(
+			http.StatusBadRequest,
+			object.URIKucoinCodeInvalidParameter,
+			"Parameter error",
+		),
+	)
+
+	err := testOrderServicer.GetListFromRemote(
+		context.Background(),
+		dto.NewOrderRequest(
+			object.URIEmpty,
+			object.OrderTypeType(object.URIEmpty),
+			object.OrderSideType(object.URIEmpty),
+			object.URIEmpty,
+			object.OrderStateType(object.URIEmpty),
+			object.URIEmpty,
+			object.OrderTypeType(object.URIEmpty),
+		),
+		1,
+	)
+
+	if !errors.Is(err, object.ErrOrderKucoinServiceGetList) {
+		t.Errorf("GetListFromRemote() error = %v, want %v", err, object.ErrOrderKucoinServiceGetList)
+	}
+
+	if !errors.Is(err, object.ErrKucoinInvalidParameter) {
+		t.Errorf("GetListFromRemote() error = %v, want %v", err, object.ErrKucoinInvalidParameter)
+	}
+
+	if got := len(testOrderServicer.omOrderers); got != 0 {
+		t.Errorf("upserts = %d, want 0", got)
+	}
+}
+
+func newOrderServiceTestPlaceOrderRequest(
+	orderType object.OrderTypeType,
+	price string,
+	size string,
+) dto.PlaceOrderRequester {
+	return dto.NewPlaceOrderRequest(
+		object.URIEmpty,
+		object.URIEmpty,
+		orderType,
+		price,
+		object.URIEmpty,
+		object.OrderSideTypeBuy,
+		size,
+		object.URIEmpty,
+		"BTC-USDT",
+		object.TimeInForceType(object.URIEmpty),
+		object.OrderTypeTypeTrade,
+		object.URIEmpty,
+		0,
+		false,
+		false,
+		false,
+	)
+}
+
+func TestOrderServicePlaceMarket(t *testing.T) {
+	t.Parallel()
+
+	fakeServerer, testOrderServicer := newOrderServiceTest(t)
+
+	fakeServerer.Enqueue(
+		http.MethodPost,
+		object.URIKucoinPathOrders,
+		exchangetest.NewFakeSuccessResponse(map[string]any{"orderId": "1"}),
+		exchangetest.NewFakeSuccessResponse(map[string]any{"orderId": "2"}),
+	)
+
+	ctx := util.WithRuntimeContextValue(
+		context.Background(),
+		object.URIRuntimeContextIntentAt,
+		int64(1704067200),
+	)
+
+	omOrderers, err := testOrderServicer.Place(
+		ctx,
+		newOrderServiceTestPlaceOrderRequest(object.OrderTypeTypeMarket, object.URIEmpty, "1"),
+		newOrderServiceTestPlaceOrderRequest(object.OrderTypeTypeMarket, object.URIEmpty, "2"),
+	)
+	if err != nil {
+		t.Fatalf("Place() error = %v", err)
+	}
+
+	if got := len(omOrderers); got != 2 {
+		t.Fatalf("orders = %d, want 2", got)
+	}
+
+	if got := len(fakeServerer.GetRequesters(http.MethodPost, object.URIKucoinPathOrders)); got != 2 {
+		t.Errorf("single requests = %d, want 2", got)
+	}
+
+	if got := len(fakeServerer.GetRequesters(http.MethodPost, object.URIKucoinPathOrdersMulti)); got != 0 {
+		t.Errorf("batch requests = %d, want 0", got)
+	}
+
+	for index, omOrderer := range omOrderers {
+		if want := strconv.Itoa(index + 1); omOrderer.GetKucoinID() != want {
+			t.Errorf("orders[%d].GetKucoinID() = %q, want %q", index, omOrderer.GetKucoinID(), want)
+		}
+	}
+}
+
+func TestOrderServicePlaceLimit(t *testing.T) {
+	t.Parallel()
+
+	fakeServerer, testOrderServicer := newOrderServiceTest(t)
+
+	fakeServerer.Enqueue(
+		http.MethodPost,
+		object.URIKucoinPathOrdersMulti,
+		exchangetest.NewFakeSuccessResponse(map[string]any{
+			"data": []map[string]any{},
+		}),
+	)
+
+	ctx := util.WithRuntimeContextValue(
+		context.Background(),
+		object.URIRuntimeContextIntentAt,
+		int64(1704067200),
+	)
+
+	_, _ = testOrderServicer.Place(
+		ctx,
+		newOrderServiceTestPlaceOrderRequest(object.OrderTypeTypeLimit, "100", "1"),
+		newOrderServiceTestPlaceOrderRequest(object.OrderTypeTypeLimit, "101", "1"),
+	)
+
+	if got := len(fakeServerer.GetRequesters(http.MethodPost, object.URIKucoinPathOrdersMulti)); got != 1 {
+		t.Errorf("batch requests = %d, want 1", got)
+	}
+
+	if got := len(fakeServerer.GetRequesters(http.MethodPost, object.URIKucoinPathOrders)); got != 0 {
+		t.Errorf("single requests = %d, want 0", got)
+	}
+}
+
+func TestOrderServicePlaceCircuitOpen(t *testing.T) {
+	t.Parallel()
+
+	fakeServerer := exchangetest.NewFakeServer(
+		kucoin.ApiKeyOption("key"),
+		kucoin.ApiSecretOption("secret"),
+		kucoin.ApiPassPhraseOption("passphrase"),
+		kucoin.ApiKeyVersionOption(kucoin.ApiKeyVersionV2),
+	)
+	t.Cleanup(fakeServerer.Close)
+
+	objectTimer := &serviceTestTimer{
+		Timer: nil,
+		now:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	circuitBreaker := exchange.NewCircuitBreaker(objectTimer, 1, time.Minute)
+	circuitBreaker.Record(object.NewKucoinTransportError(object.URIEmpty))
+
+	testOrderServicer := newOrderServiceTestOrderServicer(exchange.NewRetryExchange(
+		fakeServerer.GetExchanger(),
+		objectTimer,
+		map[object.EndpointGroupType]exchange.CircuitBreaker{
+			object.EndpointGroupTypeSpot: circuitBreaker,
+		},
+		map[object.EndpointGroupType]exchange.RateLimiter{},
+		1,
+		time.Millisecond,
+		time.Millisecond,
+	))
+
+	ctx := util.WithRuntimeContextValue(
+		context.Background(),
+		object.URIRuntimeContextIntentAt,
+		int64(1704067200),
+	)
+
+	_, err := testOrderServicer.Place(
+		ctx,
+		newOrderServiceTestPlaceOrderRequest(object.OrderTypeTypeMarket, object.URIEmpty, "1"),
+	)
+	if !errors.Is(err, object.ErrOrderRejected) {
+		t.Errorf("Place() error = %v, want %v", err, object.ErrOrderRejected)
+	}
+
+	if !errors.Is(err, object.ErrExchangeCircuitOpen) {
+		t.Errorf("Place() error = %v, want %v", err, object.ErrExchangeCircuitOpen)
+	}
+
+	if errors.Is(err, object.ErrOrderUnknown) {
+		t.Errorf("Place() error = %v, want no %v", err, object.ErrOrderUnknown)
+	}
+
+	if got := len(fakeServerer.GetRequesters(http.MethodPost, object.URIKucoinPathOrders)); got != 0 {
+		t.Errorf("single requests = %d, want 0", got)
+	}
+}
+
+func TestOrderServicePlaceErrorCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		fakeResponser exchangetest.FakeResponser
+		rejected      bool
+	}{
+		{
+			name: "balance insufficient",
+			fakeResponser: exchangetest.NewFakeErrorResponse(
+				http.StatusOK,
+				object.URIKucoinCodeBalanceInsufficient,
+				object.URIKucoinMessageBalanceInsufficient,
+			),
+			rejected: true,
+		},
+		{
+			name: "invalid parameter",
+			fakeResponser: exchangetest.NewFakeErrorResponse(
+				http.StatusOK,
+				object.URIKucoinCodeInvalidParameter,
+				"size invalid",
+			),
+			rejected: true,
+		},
+		{
+			name: "system busy",
+			fakeResponser: exchangetest.NewFakeErrorResponse(
+				http.StatusServiceUnavailable,
+				"503000",
+				"Service Unavailable",
+			),
+			rejected: false,
+		},
+		{
+			name: "clientOid duplicated",
+			fakeResponser: exchangetest.NewFakeErrorResponse(
+				http.StatusOK,
+				object.URIKucoinCodeInvalidParameter,
+				object.URIKucoinMessageClientOIDDuplicated,
+			),
+			rejected: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fakeServerer, testOrderServicer := newOrderServiceTest(t)
+			fakeServerer.Enqueue(http.MethodPost, object.URIKucoinPathOrders, test.fakeResponser)
+
+			ctx := util.WithRuntimeContextValue(
+				context.Background(),
+				object.URIRuntimeContextIntentAt,
+				int64(1704067200),
+			)
+
+			omOrderers, err := testOrderServicer.Place(
+				ctx,
+				newOrderServiceTestPlaceOrderRequest(object.OrderTypeTypeMarket, object.URIEmpty, "1"),
+			)
+			if got := len(fakeServerer.GetRequesters(http.MethodPost, object.URIKucoinPathOrders)); got != 1 {
+				t.Errorf("single requests = %d, want 1", got)
+			}
+
+			if test.rejected {
+				if !errors.Is(err, object.ErrOrderRejected) {
+					t.Errorf("Place() error = %v, want %v", err, object.ErrOrderRejected)
+				}
+
+				return
+			}
+
+			// The outcome is unknown, so the order is kept for the reconciliation
+			// under its clientOid instead of being failed.
+			if err != nil {
+				t.Fatalf("Place() error = %v", err)
+			}
+
+			if len(omOrderers) != 1 || omOrderers[0].GetKucoinID() != omOrderers[0].GetClientOID() {
+				t.Errorf("Place() = %v, want the order unacknowledged", omOrderers)
+			}
+		})
+	}
+}
@@ -0,0 +1,891 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// PortfolioServicer is an interface.
+	PortfolioServicer interface {
+		// GetEquity is a function.
+		GetEquity(
+			context.Context,
+		) (om.Equitier, error)
+		// GetPosition is a function.
+		GetPosition(
+			context.Context,
+			string,
+		) (om.Positioner, error)
+		// GetPositions is a function.
+		GetPositions(
+			context.Context,
+		) ([]om.Positioner, error)
+		// Snapshot is a function.
+		Snapshot(
+			context.Context,
+		) (uuid.UUID, error)
+		// Sync is a function.
+		Sync(
+			context.Context,
+		) error
+	}
+
+	// GetPortfolioServicer is an interface.
+	GetPortfolioServicer interface {
+		// GetPortfolioServicer is a function.
+		GetPortfolioServicer() PortfolioServicer
+	}
+
+	portfolioService struct {
+		configConfigger   config.Configger
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+
+	// portfolioServicePosition is the running position of one symbol while its
+	// fills are replayed. A negative quantity is a short position.
+	portfolioServicePosition struct {
+		averageCost string
+		fee         string
+		quantity    string
+		realizedPnL string
+	}
+)
+
+var (
+	_ GetServicer           = (*portfolioService)(nil)
+	_ PortfolioServicer     = (*portfolioService)(nil)
+	_ WithServicer          = (*portfolioService)(nil)
+	_ config.GetConfigger   = (*portfolioService)(nil)
+	_ exchange.GetExchanger = (*portfolioService)(nil)
+	_ log.GetRuntimeLogger  = (*portfolioService)(nil)
+	_ util.GetTracer        = (*portfolioService)(nil)
+	_ util.GetUUIDer        = (*portfolioService)(nil)
+)
+
+// NewPortfolioServicer is a function.
+func NewPortfolioServicer(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) PortfolioServicer {
+	return &portfolioService{
+		configConfigger:   configConfigger,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *portfolioService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *portfolioService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *portfolioService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetTracer is a function.
+func (service *portfolioService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *portfolioService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *portfolioService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *portfolioService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// GetEquity is a function.
+// GetEquity values the stored balances of the account in the portfolio
+// currency at the last ticker prices and adds up the PnL and fees of its
+// positions quoted in it. A currency without a ticker against the portfolio
+// currency is left out.
+func (service *portfolioService) GetEquity(
+	ctx context.Context,
+) (om.Equitier, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetEquity",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetEquity",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	currency := service.GetConfigger().GetPortfolioConfigger().GetCurrency()
+	paper := service.GetConfigger().GetPaperConfigger().GetEnabled()
+	omBalancers := make([]om.Balancer, 0)
+
+	var daoCursorer dao.Cursorer = dao.NewCursor(0)
+
+	for daoCursorer != nil {
+		omBalancersPage, daoNextCursorer, err := service.GetServicer().
+			GetBalanceServicer().
+			GetListFromRepository(
+				ctx,
+				dao.NewPagination(daoCursorer, object.NUMPortfolioPageSize),
+				dao.NewBalanceFilter(
+					serviceAccount(ctx, service),
+					object.URIEmpty,
+					object.URIEmpty,
+					object.URIEmpty,
+				),
+			)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrBalanceServiceGetListFromRepository.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrBalanceServiceGetListFromRepository.Error())
+
+			return nil, err
+		}
+
+		daoCursorer = daoNextCursorer
+
+		omBalancers = append(omBalancers, omBalancersPage...)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMBalances, omBalancers).
+		Debug(object.URIEmpty)
+
+	equity := "0"
+
+	for _, omBalancer := range omBalancers {
+		if omBalancer.GetPaper() != paper {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldOMBalance, omBalancer).
+				Debug(`omBalancer.GetPaper() != paper`)
+
+			continue
+		}
+
+		price, err := service.price(ctx, omBalancer.GetCurrency(), currency)
+		if errors.Is(err, object.ErrTickerServiceGetBySymbol) {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldOMBalance, omBalancer).
+				Debug(`errors.Is(err, object.ErrTickerServiceGetBySymbol)`)
+
+			continue
+		}
+
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrPortfolioServiceGetEquity.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrPortfolioServiceGetEquity.Error())
+
+			return nil, err
+		}
+
+		value, err := util.DecimalMultiply(omBalancer.GetBalance(), price)
+		if err == nil {
+			equity, err = util.DecimalAdd(equity, value)
+		}
+
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrPortfolioServiceGetEquity.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrPortfolioServiceGetEquity.Error())
+
+			return nil, err
+		}
+	}
+
+	omPositioners, err := service.positions(ctx, object.URIEmpty)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrPortfolioServiceGetPositions.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrPortfolioServiceGetPositions.Error())
+
+		return nil, err
+	}
+
+	fee := "0"
+	realizedPnL := "0"
+	unrealizedPnL := "0"
+
+	for _, omPositioner := range omPositioners {
+		if portfolioServiceQuote(omPositioner.GetSymbol()) != currency {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldOMPosition, omPositioner).
+				Debug(`portfolioServiceQuote(omPositioner.GetSymbol()) != currency`)
+
+			continue
+		}
+
+		fee, err = util.DecimalAdd(fee, omPositioner.GetFee())
+		if err == nil {
+			realizedPnL, err = util.DecimalAdd(realizedPnL, omPositioner.GetRealizedPnL())
+		}
+
+		if err == nil {
+			unrealizedPnL, err = util.DecimalAdd(unrealizedPnL, omPositioner.GetUnrealizedPnL())
+		}
+
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrPortfolioServiceGetEquity.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrPortfolioServiceGetEquity.Error())
+
+			return nil, err
+		}
+	}
+
+	omEquity := om.NewEquity(
+		serviceAccount(ctx, service),
+		currency,
+		equity,
+		fee,
+		realizedPnL,
+		unrealizedPnL,
+		time.Now().UnixMilli(),
+		paper,
+		uuid.Nil,
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMEquity, omEquity).
+		Debug(object.URIEmpty)
+
+	return omEquity, nil
+}
+
+// GetPosition is a function.
+// A symbol that was never traded has a flat position.
+func (service *portfolioService) GetPosition(
+	ctx context.Context,
+	symbol string,
+) (om.Positioner, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetPosition",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetPosition",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"symbol": symbol,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omPositioners, err := service.positions(ctx, symbol)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrPortfolioServiceGetPosition.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrPortfolioServiceGetPosition.Error())
+
+		return nil, err
+	}
+
+	if len(omPositioners) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(omPositioners) == 0`)
+
+		return om.NewPosition("0", "0", "0", "0", "0", "0", symbol, "0", uuid.Nil), nil
+	}
+
+	return omPositioners[0], nil
+}
+
+// GetPositions is a function.
+// GetPositions returns one position per traded symbol, sorted by symbol.
+func (service *portfolioService) GetPositions(
+	ctx context.Context,
+) ([]om.Positioner, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetPositions",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetPositions",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omPositioners, err := service.positions(ctx, object.URIEmpty)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrPortfolioServiceGetPositions.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrPortfolioServiceGetPositions.Error())
+
+		return nil, err
+	}
+
+	return omPositioners, nil
+}
+
+// Snapshot is a function.
+// Snapshot stores the current equity of the account so that it can be followed
+// over time.
+func (service *portfolioService) Snapshot(
+	ctx context.Context,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Snapshot",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Snapshot",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omEquitier, err := service.GetServicer().GetPortfolioServicer().GetEquity(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrPortfolioServiceGetEquity.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrPortfolioServiceGetEquity.Error())
+
+		return uuid.Nil, err
+	}
+
+	equityID, err := service.GetServicer().GetEquityServicer().Create(ctx, omEquitier)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrEquityServiceCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrEquityServiceCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldEquityID, equityID).
+		Debug(object.URIEmpty)
+
+	return equityID, nil
+}
+
+// Sync is a function.
+// Sync refreshes the stored balances from the exchange.
+func (service *portfolioService) Sync(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Sync",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Sync",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if err := service.GetServicer().GetBalanceServicer().GetListFromRemote(ctx); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrBalanceServiceGetListFromRemote.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrBalanceServiceGetListFromRemote.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// positions replays the stored fills of the symbol, or of every symbol when it
+// is empty, in trade order and marks the positions at the last ticker price.
+func (service *portfolioService) positions(
+	ctx context.Context,
+	symbol string,
+) ([]om.Positioner, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"positions",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "positions",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"symbol": symbol,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	paper := service.GetConfigger().GetPaperConfigger().GetEnabled()
+	omFillers := make([]om.Filler, 0)
+
+	var daoCursorer dao.Cursorer = dao.NewCursor(0)
+
+	for daoCursorer != nil {
+		omFillersPage, daoNextCursorer, err := service.GetServicer().
+			GetFillServicer().
+			GetListFromRepository(
+				ctx,
+				dao.NewPagination(daoCursorer, object.NUMPortfolioPageSize),
+				dao.NewFillFilter(
+					serviceAccount(ctx, service),
+					object.URIEmpty,
+					symbol,
+					object.URIEmpty,
+				),
+			)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrFillServiceGetListFromRepository.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrFillServiceGetListFromRepository.Error())
+
+			return nil, err
+		}
+
+		daoCursorer = daoNextCursorer
+
+		for _, omFiller := range omFillersPage {
+			if omFiller.GetPaper() == paper {
+				omFillers = append(omFillers, omFiller)
+			}
+		}
+	}
+
+	sort.SliceStable(omFillers, func(first int, second int) bool {
+		if omFillers[first].GetKucoinCreatedAt() != omFillers[second].GetKucoinCreatedAt() {
+			return omFillers[first].GetKucoinCreatedAt() < omFillers[second].GetKucoinCreatedAt()
+		}
+
+		return omFillers[first].GetTradeID() < omFillers[second].GetTradeID()
+	})
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMFills, omFillers).
+		Debug(object.URIEmpty)
+
+	positions := map[string]portfolioServicePosition{}
+
+	for _, omFiller := range omFillers {
+		position, ok := positions[omFiller.GetSymbol()]
+		if !ok {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`!ok`)
+
+			position = portfolioServicePosition{
+				averageCost: "0",
+				fee:         "0",
+				quantity:    "0",
+				realizedPnL: "0",
+			}
+		}
+
+		position, err := portfolioServiceApply(position, omFiller)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldOMFill, omFiller).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrPortfolioServiceGetPositions.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrPortfolioServiceGetPositions.Error())
+
+			return nil, err
+		}
+
+		positions[omFiller.GetSymbol()] = position
+	}
+
+	symbols := make([]string, 0, len(positions))
+	for positionSymbol := range positions {
+		symbols = append(symbols, positionSymbol)
+	}
+
+	sort.Strings(symbols)
+
+	omPositioners := make([]om.Positioner, 0, len(symbols))
+
+	for _, positionSymbol := range symbols {
+		position := positions[positionSymbol]
+
+		lastPrice := position.averageCost
+
+		omTickerer, err := service.GetServicer().GetTickerServicer().GetBySymbol(ctx, positionSymbol)
+		if err != nil && !errors.Is(err, object.ErrTickerServiceGetBySymbol) {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrTickerServiceGetBySymbol.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrTickerServiceGetBySymbol.Error())
+
+			return nil, err
+		}
+
+		if err == nil && omTickerer.GetLast() != object.URIEmpty {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldOMTicker, omTickerer).
+				Debug(`err == nil && omTickerer.GetLast() != object.URIEmpty`)
+
+			lastPrice = omTickerer.GetLast()
+		}
+
+		omPositioner, err := portfolioServiceMark(positionSymbol, position, lastPrice)
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrPortfolioServiceGetPositions.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrPortfolioServiceGetPositions.Error())
+
+			return nil, err
+		}
+
+		omPositioners = append(omPositioners, omPositioner)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMPositions, omPositioners).
+		Debug(object.URIEmpty)
+
+	return omPositioners, nil
+}
+
+// price is the last price of the currency in the quote currency.
+func (service *portfolioService) price(
+	ctx context.Context,
+	currency string,
+	quote string,
+) (string, error) {
+	if currency == quote {
+		return "1", nil
+	}
+
+	omTickerer, err := service.GetServicer().
+		GetTickerServicer().
+		GetBySymbol(ctx, currency+object.URIKucoinSymbolSeparator+quote)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	if omTickerer.GetLast() == object.URIEmpty {
+		return object.URIEmpty, object.ErrTickerServiceGetBySymbol
+	}
+
+	return omTickerer.GetLast(), nil
+}
+
+// portfolioServiceApply adds one fill to the position. A fill on the side of
+// the position moves the average cost; a fill against it realizes the PnL of
+// the closed part at the average cost, and what is left over opens a position
+// on the other side at the fill price. Only the fees charged in the quote
+// currency are counted.
+func portfolioServiceApply(
+	position portfolioServicePosition,
+	omFiller om.Filler,
+) (portfolioServicePosition, error) {
+	size := omFiller.GetSize()
+
+	var err error
+
+	if omFiller.GetSide() == string(object.OrderSideTypeSell) {
+		if size, err = util.DecimalSubtract("0", size); err != nil {
+			return position, err
+		}
+	}
+
+	if omFiller.GetFeeCurrency() == portfolioServiceQuote(omFiller.GetSymbol()) {
+		if position.fee, err = util.DecimalAdd(position.fee, omFiller.GetFee()); err != nil {
+			return position, err
+		}
+	}
+
+	sign, err := util.DecimalCompare(position.quantity, "0")
+	if err != nil {
+		return position, err
+	}
+
+	sizeSign, err := util.DecimalCompare(size, "0")
+	if err != nil {
+		return position, err
+	}
+
+	if sign == 0 || sign == sizeSign {
+		return portfolioServiceOpen(position, size, omFiller.GetPrice())
+	}
+
+	return portfolioServiceClose(position, size, omFiller.GetPrice(), sign)
+}
+
+// portfolioServiceOpen grows the position, averaging the cost.
+func portfolioServiceOpen(
+	position portfolioServicePosition,
+	size string,
+	price string,
+) (portfolioServicePosition, error) {
+	cost, err := util.DecimalMultiply(position.quantity, position.averageCost)
+	if err != nil {
+		return position, err
+	}
+
+	funds, err := util.DecimalMultiply(size, price)
+	if err != nil {
+		return position, err
+	}
+
+	if cost, err = util.DecimalAdd(cost, funds); err != nil {
+		return position, err
+	}
+
+	if position.quantity, err = util.DecimalAdd(position.quantity, size); err != nil {
+		return position, err
+	}
+
+	position.averageCost, err = util.DecimalDivide(
+		cost,
+		position.quantity,
+		object.URIPortfolioAverageCostIncrement,
+	)
+
+	return position, err
+}
+
+// portfolioServiceClose shrinks the position, realizing the PnL of the closed
+// part. The sign is the sign of the position before the fill.
+func portfolioServiceClose(
+	position portfolioServicePosition,
+	size string,
+	price string,
+	sign int,
+) (portfolioServicePosition, error) {
+	closed := strings.TrimPrefix(size, "-")
+	quantity := strings.TrimPrefix(position.quantity, "-")
+
+	compare, err := util.DecimalCompare(closed, quantity)
+	if err != nil {
+		return position, err
+	}
+
+	if compare > 0 {
+		closed = quantity
+	}
+
+	difference, err := util.DecimalSubtract(price, position.averageCost)
+	if err != nil {
+		return position, err
+	}
+
+	if sign < 0 {
+		if difference, err = util.DecimalSubtract("0", difference); err != nil {
+			return position, err
+		}
+	}
+
+	pnl, err := util.DecimalMultiply(difference, closed)
+	if err != nil {
+		return position, err
+	}
+
+	if position.realizedPnL, err = util.DecimalAdd(position.realizedPnL, pnl); err != nil {
+		return position, err
+	}
+
+	if position.quantity, err = util.DecimalAdd(position.quantity, size); err != nil {
+		return position, err
+	}
+
+	newSign, err := util.DecimalCompare(position.quantity, "0")
+	if err != nil {
+		return position, err
+	}
+
+	switch {
+	case newSign == 0:
+		position.averageCost = "0"
+	case newSign != sign:
+		position.averageCost = price
+	}
+
+	return position, nil
+}
+
+// portfolioServiceMark values the position at the last price.
+func portfolioServiceMark(
+	symbol string,
+	position portfolioServicePosition,
+	lastPrice string,
+) (om.Positioner, error) {
+	marketValue, err := util.DecimalMultiply(position.quantity, lastPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	difference, err := util.DecimalSubtract(lastPrice, position.averageCost)
+	if err != nil {
+		return nil, err
+	}
+
+	unrealizedPnL, err := util.DecimalMultiply(difference, position.quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	return om.NewPosition(
+		position.averageCost,
+		position.fee,
+		lastPrice,
+		marketValue,
+		position.quantity,
+		position.realizedPnL,
+		symbol,
+		unrealizedPnL,
+		uuid.Nil,
+	), nil
+}
+
+// portfolioServiceQuote is the quote currency of the symbol.
+func portfolioServiceQuote(
+	symbol string,
+) string {
+	_, quote, _ := strings.Cut(symbol, object.URIKucoinSymbolSeparator)
+
+	return quote
+}
@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type (
+	portfolioServiceTestServicer struct {
+		Servicer
+		balanceServicer   BalanceServicer
+		equityServicer    EquityServicer
+		fillServicer      FillServicer
+		portfolioServicer PortfolioServicer
+		tickerServicer    TickerServicer
+	}
+
+	portfolioServiceTestBalanceServicer struct {
+		BalanceServicer
+		omBalancers []om.Balancer
+	}
+
+	portfolioServiceTestEquityServicer struct {
+		EquityServicer
+		omEquitiers []om.Equitier
+		mutex       sync.Mutex
+	}
+
+	portfolioServiceTestFillServicer struct {
+		FillServicer
+		omFillers []om.Filler
+	}
+
+	// portfolioServiceTestTickerServicer knows the last price of the symbols it
+	// is keyed by and no other.
+	portfolioServiceTestTickerServicer struct {
+		TickerServicer
+		lasts map[string]string
+	}
+)
+
+// GetBalanceServicer is a function.
+func (servicer *portfolioServiceTestServicer) GetBalanceServicer() BalanceServicer {
+	return servicer.balanceServicer
+}
+
+// GetEquityServicer is a function.
+func (servicer *portfolioServiceTestServicer) GetEquityServicer() EquityServicer {
+	return servicer.equityServicer
+}
+
+// GetFillServicer is a function.
+func (servicer *portfolioServiceTestServicer) GetFillServicer() FillServicer {
+	return servicer.fillServicer
+}
+
+// GetPortfolioServicer is a function.
+func (servicer *portfolioServiceTestServicer) GetPortfolioServicer() PortfolioServicer {
+	return servicer.portfolioServicer
+}
+
+// GetTickerServicer is a function.
+func (servicer *portfolioServiceTestServicer) GetTickerServicer() TickerServicer {
+	return servicer.tickerServicer
+}
+
+// GetListFromRepository is a function.
+func (servicer *portfolioServiceTestBalanceServicer) GetListFromRepository(
+	_ context.Context,
+	_ dao.Paginationer,
+	_ dao.BalanceFilterer,
+) ([]om.Balancer, dao.Cursorer, error) {
+	return servicer.omBalancers, nil, nil
+}
+
+// Create is a function.
+func (servicer *portfolioServiceTestEquityServicer) Create(
+	_ context.Context,
+	omEquitier om.Equitier,
+) (uuid.UUID, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	servicer.omEquitiers = append(servicer.omEquitiers, omEquitier)
+
+	return uuid.New(), nil
+}
+
+// GetListFromRepository is a function.
+func (servicer *portfolioServiceTestFillServicer) GetListFromRepository(
+	_ context.Context,
+	_ dao.Paginationer,
+	daoFillFilterer dao.FillFilterer,
+) ([]om.Filler, dao.Cursorer, error) {
+	omFillers := []om.Filler{}
+
+	for _, omFiller := range servicer.omFillers {
+		if daoFillFilterer.GetSymbol() == object.URIEmpty ||
+			omFiller.GetSymbol() == daoFillFilterer.GetSymbol() {
+			omFillers = append(omFillers, omFiller)
+		}
+	}
+
+	return omFillers, nil, nil
+}
+
+// GetBySymbol is a function.
+func (servicer *portfolioServiceTestTickerServicer) GetBySymbol(
+	_ context.Context,
+	symbol string,
+) (om.Tickerer, error) {
+	last, ok := servicer.lasts[symbol]
+	if !ok {
+		return nil, object.ErrTickerServiceGetBySymbol
+	}
+
+	return om.NewTicker(
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		last,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		symbol,
+		symbol,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		uuid.Nil,
+	), nil
+}
+
+// newPortfolioServiceTest holds a long BTC-USDT position bought at 100 and 120
+// and partly sold at 130, and a short ETH-USDT position sold at 50 and partly
+// bought back at 40. Its fills are listed out of trade order.
+func newPortfolioServiceTest() (PortfolioServicer, *portfolioServiceTestEquityServicer) {
+	configConfigger := config.NewConfig(
+		config.WithKucoinConfigger(),
+		config.WithLogConfigger(),
+		config.WithPaperConfigger(),
+		config.WithPortfolioConfigger(
+			config.WithPortfolioConfigCurrency("USDT"),
+		),
+	)
+	paper := configConfigger.GetPaperConfigger().GetEnabled()
+
+	portfolioServicer := NewPortfolioServicer(
+		configConfigger,
+		log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+		trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+		util.NewUUID(),
+		nil,
+	)
+
+	testEquityServicer := &portfolioServiceTestEquityServicer{
+		EquityServicer: nil,
+		omEquitiers:    []om.Equitier{},
+		mutex:          sync.Mutex{},
+	}
+
+	portfolioServicer.(WithServicer).WithServicer(&portfolioServiceTestServicer{
+		Servicer: nil,
+		balanceServicer: &portfolioServiceTestBalanceServicer{
+			BalanceServicer: nil,
+			omBalancers: []om.Balancer{
+				newPortfolioServiceTestBalance("USDT", "1000", paper),
+				newPortfolioServiceTestBalance("BTC", "0.5", paper),
+				newPortfolioServiceTestBalance("DOGE", "10", paper),
+				newPortfolioServiceTestBalance("USDT", "5000", !paper),
+			},
+		},
+		equityServicer: testEquityServicer,
+		fillServicer: &portfolioServiceTestFillServicer{
+			FillServicer: nil,
+			omFillers: []om.Filler{
+				newPortfolioServiceTestFill("BTC-USDT", "3", object.OrderSideTypeSell, "130", "1.5", 3, paper),
+				newPortfolioServiceTestFill("BTC-USDT", "1", object.OrderSideTypeBuy, "100", "1", 1, paper),
+				newPortfolioServiceTestFill("ETH-USDT", "5", object.OrderSideTypeBuy, "40", "1", 2, paper),
+				newPortfolioServiceTestFill("BTC-USDT", "2", object.OrderSideTypeBuy, "120", "1", 2, paper),
+				newPortfolioServiceTestFill("ETH-USDT", "4", object.OrderSideTypeSell, "50", "2", 1, paper),
+				newPortfolioServiceTestFill("BTC-USDT", "6", object.OrderSideTypeBuy, "1", "100", 4, !paper),
+			},
+		},
+		portfolioServicer: portfolioServicer,
+		tickerServicer: &portfolioServiceTestTickerServicer{
+			TickerServicer: nil,
+			lasts: map[string]string{
+				"BTC-USDT": "140",
+				"ETH-USDT": "45",
+			},
+		},
+	})
+
+	return portfolioServicer, testEquityServicer
+}
+
+func newPortfolioServiceTestBalance(
+	currency string,
+	balance string,
+	paper bool,
+) om.Balancer {
+	return om.NewBalance(
+		object.URIEmpty,
+		balance,
+		balance,
+		currency,
+		"0",
+		currency,
+		"trade",
+		paper,
+		uuid.Nil,
+	)
+}
+
+// newPortfolioServiceTestFill charges a fee of a thousandth of the funds, in
+// USDT for BTC-USDT and in ETH for ETH-USDT.
+func newPortfolioServiceTestFill(
+	symbol string,
+	tradeID string,
+	side object.OrderSideType,
+	price string,
+	size string,
+	kucoinCreatedAt int64,
+	paper bool,
+) om.Filler {
+	funds, _ := util.DecimalMultiply(price, size)
+	fee, _ := util.DecimalMultiply(funds, "0.001")
+
+	feeCurrency := "USDT"
+	if symbol == "ETH-USDT" {
+		feeCurrency = "ETH"
+	}
+
+	return om.NewFill(
+		object.URIEmpty,
+		object.URIEmpty,
+		fee,
+		feeCurrency,
+		"0.001",
+		funds,
+		"order-"+tradeID,
+		string(object.OrderTypeTypeLimit),
+		"taker",
+		price,
+		string(side),
+		size,
+		object.URIEmpty,
+		symbol,
+		tradeID,
+		string(object.OrderTypeTypeTrade),
+		kucoinCreatedAt,
+		false,
+		paper,
+		uuid.Nil,
+	)
+}
+
+func TestPortfolioServiceGetPositions(t *testing.T) {
+	t.Parallel()
+
+	portfolioServicer, _ := newPortfolioServiceTest()
+
+	omPositioners, err := portfolioServicer.GetPositions(context.Background())
+	if err != nil {
+		t.Fatalf("GetPositions() error = %v", err)
+	}
+
+	wants := []om.Positioner{
+		om.NewPosition("110", "0.415", "140", "70", "0.5", "30", "BTC-USDT", "15", uuid.Nil),
+		om.NewPosition("50", "0", "45", "-45", "-1", "10", "ETH-USDT", "5", uuid.Nil),
+	}
+
+	if len(omPositioners) != len(wants) {
+		t.Fatalf("GetPositions() = %d positions, want %d", len(omPositioners), len(wants))
+	}
+
+	for index, want := range wants {
+		got := omPositioners[index]
+		if got.GetSymbol() != want.GetSymbol() ||
+			!algoOrderServiceTestEqual(got.GetAverageCost(), want.GetAverageCost()) ||
+			!algoOrderServiceTestEqual(got.GetFee(), want.GetFee()) ||
+			!algoOrderServiceTestEqual(got.GetLastPrice(), want.GetLastPrice()) ||
+			!algoOrderServiceTestEqual(got.GetMarketValue(), want.GetMarketValue()) ||
+			!algoOrderServiceTestEqual(got.GetQuantity(), want.GetQuantity()) ||
+			!algoOrderServiceTestEqual(got.GetRealizedPnL(), want.GetRealizedPnL()) ||
+			!algoOrderServiceTestEqual(got.GetUnrealizedPnL(), want.GetUnrealizedPnL()) {
+			t.Errorf("GetPositions()[%d] = %v, want %v", index, got, want)
+		}
+	}
+}
+
+func TestPortfolioServiceGetPosition(t *testing.T) {
+	t.Parallel()
+
+	portfolioServicer, _ := newPortfolioServiceTest()
+
+	tests := []struct {
+		name         string
+		symbol       string
+		wantQuantity string
+		wantLast     string
+	}{
+		{
+			name:         "traded",
+			symbol:       "BTC-USDT",
+			wantQuantity: "0.5",
+			wantLast:     "140",
+		},
+		{
+			name:         "never traded",
+			symbol:       "XRP-USDT",
+			wantQuantity: "0",
+			wantLast:     "0",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			omPositioner, err := portfolioServicer.GetPosition(context.Background(), test.symbol)
+			if err != nil {
+				t.Fatalf("GetPosition() error = %v", err)
+			}
+
+			if omPositioner.GetSymbol() != test.symbol ||
+				!algoOrderServiceTestEqual(omPositioner.GetQuantity(), test.wantQuantity) ||
+				!algoOrderServiceTestEqual(omPositioner.GetLastPrice(), test.wantLast) {
+				t.Errorf(
+					"GetPosition() = %v, want %s %s at %s",
+					omPositioner,
+					test.wantQuantity,
+					test.symbol,
+					test.wantLast,
+				)
+			}
+		})
+	}
+}
+
+func TestPortfolioServiceSnapshot(t *testing.T) {
+	t.Parallel()
+
+	portfolioServicer, testEquityServicer := newPortfolioServiceTest()
+
+	// The balances are valued at the last prices; DOGE has no price and the
+	// balances of the other mode are left out.
+	equityID, err := portfolioServicer.Snapshot(context.Background())
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	if len(testEquityServicer.omEquitiers) != 1 || equityID == uuid.Nil {
+		t.Fatalf("Snapshot() = %v, stored %d equities, want 1", equityID, len(testEquityServicer.omEquitiers))
+	}
+
+	omEquitier := testEquityServicer.omEquitiers[0]
+	if omEquitier.GetCurrency() != "USDT" ||
+		!algoOrderServiceTestEqual(omEquitier.GetEquity(), "1070") ||
+		!algoOrderServiceTestEqual(omEquitier.GetFee(), "0.415") ||
+		!algoOrderServiceTestEqual(omEquitier.GetRealizedPnL(), "40") ||
+		!algoOrderServiceTestEqual(omEquitier.GetUnrealizedPnL(), "20") ||
+		omEquitier.GetSnapshotAt() == 0 {
+		t.Errorf("Snapshot() stored %v, want an equity of 1070 USDT", omEquitier)
+	}
+}
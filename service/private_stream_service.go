@@ -0,0 +1,546 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/util"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// PrivateStreamServicer is an interface.
+	PrivateStreamServicer interface {
+		// GetBalance is a function.
+		GetBalance(
+			string,
+			string,
+		) (exchange.AccountBalanceModel, bool)
+		// Run is a function.
+		Run(
+			context.Context,
+		) error
+	}
+
+	// GetPrivateStreamServicer is an interface.
+	GetPrivateStreamServicer interface {
+		// GetPrivateStreamServicer is a function.
+		GetPrivateStreamServicer() PrivateStreamServicer
+	}
+
+	privateStreamService struct {
+		configConfigger   config.Configger
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+		balances          map[string]map[string]exchange.AccountBalanceModel
+		mutex             sync.RWMutex
+	}
+)
+
+var (
+	_ GetServicer           = (*privateStreamService)(nil)
+	_ PrivateStreamServicer = (*privateStreamService)(nil)
+	_ WithServicer          = (*privateStreamService)(nil)
+	_ config.GetConfigger   = (*privateStreamService)(nil)
+	_ exchange.GetExchanger = (*privateStreamService)(nil)
+	_ log.GetRuntimeLogger  = (*privateStreamService)(nil)
+	_ util.GetTracer        = (*privateStreamService)(nil)
+	_ util.GetUUIDer        = (*privateStreamService)(nil)
+)
+
+// NewPrivateStreamServicer is a function.
+func NewPrivateStreamServicer(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) PrivateStreamServicer {
+	return &privateStreamService{
+		configConfigger:   configConfigger,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+		balances:          map[string]map[string]exchange.AccountBalanceModel{},
+		mutex:             sync.RWMutex{},
+	}
+}
+
+// GetConfigger is a function.
+func (service *privateStreamService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *privateStreamService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *privateStreamService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetTracer is a function.
+func (service *privateStreamService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *privateStreamService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *privateStreamService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *privateStreamService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// GetBalance is a function.
+// It returns the last balance notice of the currency on the account.
+func (service *privateStreamService) GetBalance(
+	account string,
+	currency string,
+) (exchange.AccountBalanceModel, bool) {
+	service.mutex.RLock()
+	defer service.mutex.RUnlock()
+
+	exchangeAccountBalanceModel, ok := service.balances[account][currency]
+
+	return exchangeAccountBalanceModel, ok
+}
+
+// Run is a function.
+// Run blocks until ctx is done, reconnecting with the backoff of the stream.
+// It streams the account of ctx, so each account runs its own stream.
+// Paper orders never reach the exchange, so it does nothing while paper
+// trading.
+func (service *privateStreamService) Run(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Run",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Run",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	disabled := !service.GetConfigger().GetStreamConfigger().GetPrivate() ||
+		service.GetConfigger().GetPaperConfigger().GetEnabled()
+	if disabled {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`disabled`)
+
+		return nil
+	}
+
+	backoff := service.GetConfigger().GetStreamConfigger().GetReconnectMinBackoff()
+
+	for {
+		connected, err := service.connect(ctx)
+
+		if ctx.Err() != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`ctx.Err() != nil`)
+
+			return nil
+		}
+
+		if connected {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`connected`)
+
+			backoff = service.GetConfigger().GetStreamConfigger().GetReconnectMinBackoff()
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			WithField(object.URIFieldBackoff, backoff).
+			Error(object.ErrPrivateStreamServiceConnect.Error())
+		traceSpan.RecordError(err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > service.GetConfigger().GetStreamConfigger().GetReconnectMaxBackoff() {
+			backoff = service.GetConfigger().GetStreamConfigger().GetReconnectMaxBackoff()
+		}
+	}
+}
+
+// connect subscribes to the private topics and, once subscribed, reconciles
+// the active orders, so the events missed while disconnected are repaired.
+func (service *privateStreamService) connect(
+	ctx context.Context,
+) (bool, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"connect",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "connect",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	response, err := serviceExchanger(ctx, service).WebSocketPrivateToken()
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStreamKucoinServiceToken.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStreamKucoinServiceToken.Error())
+
+		return false, fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, errResponse).
+			Error(object.ErrStreamKucoinServiceToken.Error())
+		traceSpan.RecordError(errResponse)
+		traceSpan.SetStatus(codes.Error, object.ErrStreamKucoinServiceToken.Error())
+
+		return false, fmt.Errorf("%w: %w", object.ErrStreamKucoinServiceToken, errResponse)
+	}
+
+	kucoinWebSocketTokenModel := &kucoin.WebSocketTokenModel{
+		Token:             object.URIEmpty,
+		Servers:           kucoin.WebSocketServersModel{},
+		AcceptUserMessage: false,
+	}
+
+	if err = response.ReadData(kucoinWebSocketTokenModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return false, fmt.Errorf("%w", err)
+	}
+
+	kucoinWebSocketClient := serviceExchanger(ctx, service).
+		NewWebSocketClient(kucoinWebSocketTokenModel)
+
+	messages, errs, err := kucoinWebSocketClient.Connect()
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStreamKucoinServiceConnect.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStreamKucoinServiceConnect.Error())
+
+		return false, fmt.Errorf("%w", err)
+	}
+
+	defer kucoinWebSocketClient.Stop()
+
+	if err = kucoinWebSocketClient.Subscribe(
+		kucoin.NewSubscribeMessage(object.URIStreamTopicTradeOrders, true),
+		kucoin.NewSubscribeMessage(object.URIStreamTopicAccountBalance, true),
+	); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStreamKucoinServiceSubscribe.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStreamKucoinServiceSubscribe.Error())
+
+		return true, fmt.Errorf("%w", err)
+	}
+
+	if err = service.GetServicer().GetOrderServicer().Sync(ctx); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceSync.Error())
+		traceSpan.RecordError(err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return true, nil
+		case err = <-errs:
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrStreamKucoinServiceRead.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrStreamKucoinServiceRead.Error())
+
+			return true, fmt.Errorf("%w", err)
+		case kucoinWebSocketDownstreamMessage, ok := <-messages:
+			if !ok {
+				service.GetRuntimeLogger().
+					WithFields(fields).
+					Debug(`!ok`)
+
+				return true, object.ErrStreamKucoinServiceRead
+			}
+
+			if err = service.handle(ctx, kucoinWebSocketDownstreamMessage); err != nil {
+				service.GetRuntimeLogger().
+					WithFields(fields).
+					WithField(object.URIFieldError, err).
+					Error(object.ErrPrivateStreamServiceHandle.Error())
+				traceSpan.RecordError(err)
+			}
+		}
+	}
+}
+
+func (service *privateStreamService) handle(
+	ctx context.Context,
+	kucoinWebSocketDownstreamMessage *kucoin.WebSocketDownstreamMessage,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"handle",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                                 "handle",
+		"rt_ctx":                               utilRuntimeContext,
+		"sp_ctx":                               utilSpanContext,
+		"config":                               service.configConfigger,
+		"kucoin_web_socket_downstream_message": kucoinWebSocketDownstreamMessage,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	switch kucoinWebSocketDownstreamMessage.Subject {
+	case object.URIStreamSubjectOrderChange:
+		return service.handleOrderChange(ctx, kucoinWebSocketDownstreamMessage)
+	case object.URIStreamSubjectAccountBalance:
+		return service.handleAccountBalance(ctx, kucoinWebSocketDownstreamMessage)
+	}
+
+	return nil
+}
+
+func (service *privateStreamService) handleAccountBalance(
+	ctx context.Context,
+	kucoinWebSocketDownstreamMessage *kucoin.WebSocketDownstreamMessage,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"handleAccountBalance",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                                 "handleAccountBalance",
+		"rt_ctx":                               utilRuntimeContext,
+		"sp_ctx":                               utilSpanContext,
+		"config":                               service.configConfigger,
+		"kucoin_web_socket_downstream_message": kucoinWebSocketDownstreamMessage,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	exchangeAccountBalanceModel := exchange.AccountBalanceModel{
+		AccountID:       object.URIEmpty,
+		Available:       object.URIEmpty,
+		AvailableChange: object.URIEmpty,
+		Currency:        object.URIEmpty,
+		Hold:            object.URIEmpty,
+		HoldChange:      object.URIEmpty,
+		RelationEvent:   object.URIEmpty,
+		RelationEventID: object.URIEmpty,
+		Time:            object.URIEmpty,
+		Total:           object.URIEmpty,
+	}
+
+	if err := kucoinWebSocketDownstreamMessage.ReadData(&exchangeAccountBalanceModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldExchangeAccountBalanceModel, exchangeAccountBalanceModel).
+		Debug(object.URIEmpty)
+
+	account := serviceAccount(ctx, service)
+
+	service.mutex.Lock()
+	if _, ok := service.balances[account]; !ok {
+		service.balances[account] = map[string]exchange.AccountBalanceModel{}
+	}
+
+	service.balances[account][exchangeAccountBalanceModel.Currency] = exchangeAccountBalanceModel
+	service.mutex.Unlock()
+
+	return nil
+}
+
+func (service *privateStreamService) handleOrderChange(
+	ctx context.Context,
+	kucoinWebSocketDownstreamMessage *kucoin.WebSocketDownstreamMessage,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"handleOrderChange",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                                 "handleOrderChange",
+		"rt_ctx":                               utilRuntimeContext,
+		"sp_ctx":                               utilSpanContext,
+		"config":                               service.configConfigger,
+		"kucoin_web_socket_downstream_message": kucoinWebSocketDownstreamMessage,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	exchangeOrderChangeModel := exchange.OrderChangeModel{
+		ClientOid:  object.URIEmpty,
+		FilledSize: object.URIEmpty,
+		Liquidity:  object.URIEmpty,
+		MatchPrice: object.URIEmpty,
+		MatchSize:  object.URIEmpty,
+		OldSize:    object.URIEmpty,
+		OrderID:    object.URIEmpty,
+		OrderType:  object.URIEmpty,
+		Price:      object.URIEmpty,
+		RemainSize: object.URIEmpty,
+		Side:       object.URIEmpty,
+		Size:       object.URIEmpty,
+		Status:     object.URIEmpty,
+		Symbol:     object.URIEmpty,
+		TradeID:    object.URIEmpty,
+		Type:       object.URIEmpty,
+		OrderTime:  0,
+		TS:         0,
+	}
+
+	if err := kucoinWebSocketDownstreamMessage.ReadData(&exchangeOrderChangeModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldExchangeOrderChangeModel, exchangeOrderChangeModel).
+		Debug(object.URIEmpty)
+
+	orderID, err := service.GetServicer().GetOrderServicer().Apply(ctx, exchangeOrderChangeModel)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceApply.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceApply.Error())
+
+		return err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOrderID, orderID).
+		Debug(object.URIEmpty)
+
+	return nil
+}
@@ -0,0 +1,435 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type (
+	privateStreamServiceTestServicer struct {
+		Servicer
+		orderServicer OrderServicer
+	}
+
+	// privateStreamServiceTestOrderServicer is the order repository: an order
+	// is stored by its id and found the way the repository filters it.
+	privateStreamServiceTestOrderServicer struct {
+		OrderServicer
+		omOrderers []om.Orderer
+		mutex      sync.Mutex
+	}
+)
+
+// GetOrderServicer is a function.
+func (servicer *privateStreamServiceTestServicer) GetOrderServicer() OrderServicer {
+	return servicer.orderServicer
+}
+
+// GetListFromRepository is a function.
+func (servicer *privateStreamServiceTestOrderServicer) GetListFromRepository(
+	_ context.Context,
+	_ dao.Paginationer,
+	daoOrderFilterer dao.OrderFilterer,
+) ([]om.Orderer, dao.Cursorer, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	for _, omOrderer := range servicer.omOrderers {
+		if omOrderer.GetKucoinID() != daoOrderFilterer.GetKucoinID() {
+			continue
+		}
+
+		if daoOrderFilterer.GetClientOID() != object.URIEmpty &&
+			omOrderer.GetClientOID() != daoOrderFilterer.GetClientOID() {
+			continue
+		}
+
+		return []om.Orderer{omOrderer}, nil, nil
+	}
+
+	return []om.Orderer{}, nil, nil
+}
+
+// Upsert is a function.
+func (servicer *privateStreamServiceTestOrderServicer) Upsert(
+	_ context.Context,
+	omOrderer om.Orderer,
+) (uuid.UUID, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	for key, omStoredOrderer := range servicer.omOrderers {
+		if omStoredOrderer.GetID() == omOrderer.GetID() {
+			servicer.omOrderers[key] = omOrderer
+
+			return omOrderer.GetID(), nil
+		}
+	}
+
+	servicer.omOrderers = append(servicer.omOrderers, omOrderer)
+
+	return omOrderer.GetID(), nil
+}
+
+func (servicer *privateStreamServiceTestOrderServicer) get() []om.Orderer {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	return append([]om.Orderer{}, servicer.omOrderers...)
+}
+
+func newPrivateStreamServiceTest(
+	omOrderers ...om.Orderer,
+) (*privateStreamService, *privateStreamServiceTestOrderServicer) {
+	configConfigger := config.NewConfig(
+		config.WithKucoinConfigger(),
+		config.WithLogConfigger(),
+		config.WithPaperConfigger(),
+	)
+	logRuntimeLogger := log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop())
+	traceTracer := trace.NewNoopTracerProvider().Tracer(object.URIEmpty)
+
+	orderServicer := NewOrderServicer(
+		configConfigger,
+		nil,
+		logRuntimeLogger,
+		traceTracer,
+		util.NewUUID(),
+		nil,
+	)
+
+	testOrderServicer := &privateStreamServiceTestOrderServicer{
+		OrderServicer: orderServicer,
+		omOrderers:    omOrderers,
+		mutex:         sync.Mutex{},
+	}
+
+	privateStreamServicer := NewPrivateStreamServicer(
+		configConfigger,
+		logRuntimeLogger,
+		traceTracer,
+		util.NewUUID(),
+		nil,
+	)
+
+	testServicer := &privateStreamServiceTestServicer{
+		Servicer:      nil,
+		orderServicer: testOrderServicer,
+	}
+
+	orderServicer.(WithServicer).WithServicer(testServicer)
+	privateStreamServicer.(WithServicer).WithServicer(testServicer)
+
+	privateStreamService, _ := privateStreamServicer.(*privateStreamService)
+
+	return privateStreamService, testOrderServicer
+}
+
+func newPrivateStreamServiceTestOrderChange(
+	orderChangeType object.OrderChangeTypeType,
+	filledSize string,
+	remainSize string,
+	matchPrice string,
+) exchange.OrderChangeModel {
+	return exchange.OrderChangeModel{
+		ClientOid:  "client",
+		FilledSize: filledSize,
+		Liquidity:  object.URIEmpty,
+		MatchPrice: matchPrice,
+		MatchSize:  object.URIEmpty,
+		OldSize:    object.URIEmpty,
+		OrderID:    "kucoin",
+		OrderType:  string(object.OrderTypeTypeLimit),
+		Price:      "100",
+		RemainSize: remainSize,
+		Side:       string(object.OrderSideTypeBuy),
+		Size:       "1",
+		Status:     object.URIEmpty,
+		Symbol:     "BTC-USDT",
+		TradeID:    object.URIEmpty,
+		Type:       string(orderChangeType),
+		OrderTime:  0,
+		TS:         0,
+	}
+}
+
+func TestPrivateStreamServiceHandleOrderChange(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	privateStreamService, testOrderServicer := newPrivateStreamServiceTest(
+		orderServiceOrderFromPlaceOrderRequest(
+			object.URIEmpty,
+			dto.NewPlaceOrderRequest(
+				"client",
+				object.URIEmpty,
+				object.OrderTypeTypeLimit,
+				"100",
+				object.URIEmpty,
+				object.OrderSideTypeBuy,
+				"1",
+				object.URIEmpty,
+				"BTC-USDT",
+				object.TimeInForceTypeGTC,
+				object.OrderTypeTypeTrade,
+				object.URIEmpty,
+				0,
+				false,
+				false,
+				false,
+			),
+			false,
+			id,
+		),
+	)
+
+	// The events are applied in turn, each to the order the one before stored.
+	tests := []struct {
+		name                     string
+		exchangeOrderChangeModel exchange.OrderChangeModel
+		wantStatus               object.OrderStatusType
+		wantDealSize             string
+		wantDealFunds            string
+		wantIsActive             bool
+	}{
+		{
+			name: "received",
+			exchangeOrderChangeModel: newPrivateStreamServiceTestOrderChange(
+				object.OrderChangeTypeTypeReceived,
+				object.URIEmpty,
+				"1",
+				object.URIEmpty,
+			),
+			wantStatus:    object.OrderStatusTypeNew,
+			wantDealSize:  object.URIEmpty,
+			wantDealFunds: object.URIEmpty,
+			wantIsActive:  true,
+		},
+		{
+			name: "open",
+			exchangeOrderChangeModel: newPrivateStreamServiceTestOrderChange(
+				object.OrderChangeTypeTypeOpen,
+				"0",
+				"1",
+				object.URIEmpty,
+			),
+			wantStatus:    object.OrderStatusTypeOpen,
+			wantDealSize:  object.URIEmpty,
+			wantDealFunds: object.URIEmpty,
+			wantIsActive:  true,
+		},
+		{
+			name: "partial match",
+			exchangeOrderChangeModel: newPrivateStreamServiceTestOrderChange(
+				object.OrderChangeTypeTypeMatch,
+				"0.4",
+				"0.6",
+				"100",
+			),
+			wantStatus:    object.OrderStatusTypePartiallyFilled,
+			wantDealSize:  "0.4",
+			wantDealFunds: "40",
+			wantIsActive:  true,
+		},
+		{
+			name: "replayed match",
+			exchangeOrderChangeModel: newPrivateStreamServiceTestOrderChange(
+				object.OrderChangeTypeTypeMatch,
+				"0.4",
+				"0.6",
+				"100",
+			),
+			wantStatus:    object.OrderStatusTypePartiallyFilled,
+			wantDealSize:  "0.4",
+			wantDealFunds: "40",
+			wantIsActive:  true,
+		},
+		{
+			name: "replayed open",
+			exchangeOrderChangeModel: newPrivateStreamServiceTestOrderChange(
+				object.OrderChangeTypeTypeOpen,
+				"0",
+				"1",
+				object.URIEmpty,
+			),
+			wantStatus:    object.OrderStatusTypePartiallyFilled,
+			wantDealSize:  "0.4",
+			wantDealFunds: "40",
+			wantIsActive:  true,
+		},
+		{
+			name: "last match",
+			exchangeOrderChangeModel: newPrivateStreamServiceTestOrderChange(
+				object.OrderChangeTypeTypeMatch,
+				"1",
+				"0",
+				"101",
+			),
+			wantStatus:    object.OrderStatusTypeFilled,
+			wantDealSize:  "1",
+			wantDealFunds: "100.6",
+			wantIsActive:  false,
+		},
+		{
+			name: "canceled after filled",
+			exchangeOrderChangeModel: newPrivateStreamServiceTestOrderChange(
+				object.OrderChangeTypeTypeCanceled,
+				"1",
+				"0",
+				object.URIEmpty,
+			),
+			wantStatus:    object.OrderStatusTypeFilled,
+			wantDealSize:  "1",
+			wantDealFunds: "100.6",
+			wantIsActive:  false,
+		},
+	}
+
+	for _, test := range tests {
+		err := privateStreamService.handle(
+			context.Background(),
+			newStreamServiceTestMessage(
+				t,
+				object.URIEmpty,
+				object.URIStreamSubjectOrderChange,
+				test.exchangeOrderChangeModel,
+			),
+		)
+		if err != nil {
+			t.Fatalf("%s: handle() error = %v", test.name, err)
+		}
+
+		omOrderers := testOrderServicer.get()
+		if len(omOrderers) != 1 {
+			t.Fatalf("%s: handle() stored %d orders, want 1", test.name, len(omOrderers))
+		}
+
+		omOrderer := omOrderers[0]
+		if omOrderer.GetID() != id || omOrderer.GetKucoinID() != "kucoin" {
+			t.Errorf(
+				"%s: GetID(), GetKucoinID() = %v, %q, want %v, %q",
+				test.name,
+				omOrderer.GetID(),
+				omOrderer.GetKucoinID(),
+				id,
+				"kucoin",
+			)
+		}
+
+		dealSizeOK := omOrderer.GetDealSize() == test.wantDealSize ||
+			algoOrderServiceTestEqual(omOrderer.GetDealSize(), test.wantDealSize)
+		dealFundsOK := omOrderer.GetDealFunds() == test.wantDealFunds ||
+			algoOrderServiceTestEqual(omOrderer.GetDealFunds(), test.wantDealFunds)
+
+		if omOrderer.GetStatus() != string(test.wantStatus) ||
+			!dealSizeOK ||
+			!dealFundsOK ||
+			omOrderer.GetIsActive() != test.wantIsActive {
+			t.Errorf(
+				"%s: handle() = %s, %s, %s, %v, want %s, %s, %s, %v",
+				test.name,
+				omOrderer.GetStatus(),
+				omOrderer.GetDealSize(),
+				omOrderer.GetDealFunds(),
+				omOrderer.GetIsActive(),
+				test.wantStatus,
+				test.wantDealSize,
+				test.wantDealFunds,
+				test.wantIsActive,
+			)
+		}
+	}
+}
+
+func TestPrivateStreamServiceHandleOrderChangeUnknownOrder(t *testing.T) {
+	t.Parallel()
+
+	privateStreamService, testOrderServicer := newPrivateStreamServiceTest()
+
+	err := privateStreamService.handle(
+		context.Background(),
+		newStreamServiceTestMessage(
+			t,
+			object.URIEmpty,
+			object.URIStreamSubjectOrderChange,
+			newPrivateStreamServiceTestOrderChange(
+				object.OrderChangeTypeTypeOpen,
+				"0",
+				"1",
+				object.URIEmpty,
+			),
+		),
+	)
+	if err != nil {
+		t.Fatalf("handle() error = %v", err)
+	}
+
+	omOrderers := testOrderServicer.get()
+	if len(omOrderers) != 1 {
+		t.Fatalf("handle() stored %d orders, want 1", len(omOrderers))
+	}
+
+	omOrderer := omOrderers[0]
+	if omOrderer.GetStatus() != string(object.OrderStatusTypeOpen) ||
+		omOrderer.GetKucoinID() != "kucoin" ||
+		omOrderer.GetClientOID() != "client" ||
+		omOrderer.GetAccount() != privateStreamService.GetConfigger().GetKucoinConfigger().GetAccount() ||
+		!omOrderer.GetIsActive() {
+		t.Errorf("handle() stored %v, want the open order from the event", omOrderer)
+	}
+}
+
+func TestPrivateStreamServiceHandleAccountBalance(t *testing.T) {
+	t.Parallel()
+
+	privateStreamService, _ := newPrivateStreamServiceTest()
+	account := privateStreamService.GetConfigger().GetKucoinConfigger().GetAccount()
+
+	if _, ok := privateStreamService.GetBalance(account, "USDT"); ok {
+		t.Fatalf("GetBalance() ok = %v, want false", ok)
+	}
+
+	for _, available := range []string{"10", "12"} {
+		err := privateStreamService.handle(
+			context.Background(),
+			newStreamServiceTestMessage(
+				t,
+				object.URIEmpty,
+				object.URIStreamSubjectAccountBalance,
+				exchange.AccountBalanceModel{
+					AccountID:       "account",
+					Available:       available,
+					AvailableChange: "2",
+					Currency:        "USDT",
+					Hold:            "0",
+					HoldChange:      "0",
+					RelationEvent:   "trade.setted",
+					RelationEventID: "event",
+					Time:            "0",
+					Total:           available,
+				},
+			),
+		)
+		if err != nil {
+			t.Fatalf("handle() error = %v", err)
+		}
+	}
+
+	exchangeAccountBalanceModel, ok := privateStreamService.GetBalance(account, "USDT")
+	if !ok || exchangeAccountBalanceModel.Available != "12" {
+		t.Errorf("GetBalance() = %v, %v, want the last notice", exchangeAccountBalanceModel, ok)
+	}
+}
@@ -0,0 +1,1361 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// RiskServicer is an interface.
+	RiskServicer interface {
+		// Check is a function.
+		Check(
+			context.Context,
+			dto.PlaceOrderRequester,
+		) error
+		// CheckStop is a function.
+		CheckStop(
+			context.Context,
+			dto.PlaceStopOrderRequester,
+		) error
+		// Engage is a function.
+		Engage(
+			context.Context,
+			string,
+			bool,
+		) error
+		// GetKillSwitch is a function.
+		GetKillSwitch(
+			context.Context,
+		) (om.KillSwitcher, error)
+		// Release is a function.
+		Release(
+			context.Context,
+		) error
+	}
+
+	// GetRiskServicer is an interface.
+	GetRiskServicer interface {
+		// GetRiskServicer is a function.
+		GetRiskServicer() RiskServicer
+	}
+
+	riskService struct {
+		configConfigger   config.Configger
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		objectTimer       object.Timer
+		exchangeExchanger exchange.Exchanger
+		orders            map[string][]time.Time
+		mutex             sync.Mutex
+	}
+)
+
+var (
+	_ GetServicer           = (*riskService)(nil)
+	_ RiskServicer          = (*riskService)(nil)
+	_ WithServicer          = (*riskService)(nil)
+	_ config.GetConfigger   = (*riskService)(nil)
+	_ exchange.GetExchanger = (*riskService)(nil)
+	_ log.GetRuntimeLogger  = (*riskService)(nil)
+	_ object.GetTimer       = (*riskService)(nil)
+	_ util.GetTracer        = (*riskService)(nil)
+	_ util.GetUUIDer        = (*riskService)(nil)
+)
+
+// NewRiskServicer is a function.
+func NewRiskServicer(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	objectTimer object.Timer,
+	exchangeExchanger exchange.Exchanger,
+) RiskServicer {
+	return &riskService{
+		configConfigger:   configConfigger,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		objectTimer:       objectTimer,
+		exchangeExchanger: exchangeExchanger,
+		orders:            map[string][]time.Time{},
+		mutex:             sync.Mutex{},
+	}
+}
+
+// GetConfigger is a function.
+func (service *riskService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *riskService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *riskService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetTracer is a function.
+func (service *riskService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *riskService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetTimer is a function.
+func (service *riskService) GetTimer() object.Timer {
+	return service.objectTimer
+}
+
+// GetExchanger is a function.
+func (service *riskService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *riskService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// Check is a function.
+// Check runs the order through the kill switch, the mode of the exchange and
+// every configured limit before it is stored or submitted. A refused order
+// returns an error wrapping object.ErrRiskRejected with the reason code of the
+// limit it broke.
+func (service *riskService) Check(
+	ctx context.Context,
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Check",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                      "Check",
+		"rt_ctx":                    utilRuntimeContext,
+		"sp_ctx":                    utilSpanContext,
+		"config":                    service.configConfigger,
+		"dto_place_order_requester": dtoPlaceOrderRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	err := service.check(ctx, dtoPlaceOrderRequester, dtoPlaceOrderRequester.GetPrice(), true)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrRiskServiceCheck.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrRiskServiceCheck.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// CheckStop is a function.
+// A stop order is valued at its limit price, or at its stop price when it has
+// none, and is not held to the price band since it only becomes an order once
+// the market moves.
+func (service *riskService) CheckStop(
+	ctx context.Context,
+	dtoPlaceStopOrderRequester dto.PlaceStopOrderRequester,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"CheckStop",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                           "CheckStop",
+		"rt_ctx":                         utilRuntimeContext,
+		"sp_ctx":                         utilSpanContext,
+		"config":                         service.configConfigger,
+		"dto_place_stop_order_requester": dtoPlaceStopOrderRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	price := dtoPlaceStopOrderRequester.GetPrice()
+	if price == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`price == object.URIEmpty`)
+
+		price = dtoPlaceStopOrderRequester.GetStopPrice()
+	}
+
+	err := service.check(ctx, dtoPlaceStopOrderRequester, price, false)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrRiskServiceCheckStop.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrRiskServiceCheckStop.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// Engage is a function.
+// Engage turns the kill switch on so that every new order is refused until it
+// is released. With cancel set the active orders and stop orders are cancelled
+// as well.
+func (service *riskService) Engage(
+	ctx context.Context,
+	reason string,
+	cancel bool,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Engage",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Engage",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"reason": reason,
+		"cancel": cancel,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	paper := service.GetConfigger().GetPaperConfigger().GetEnabled()
+
+	killSwitchID, err := service.GetServicer().
+		GetKillSwitchServicer().
+		Upsert(ctx, om.NewKillSwitch(reason, true, paper, uuid.Nil))
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKillSwitchServiceUpsert.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKillSwitchServiceUpsert.Error())
+
+		return err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKillSwitchID, killSwitchID).
+		Debug(object.URIEmpty)
+
+	if !cancel {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`!cancel`)
+
+		return nil
+	}
+
+	omOrderers, err := service.activeOrders(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceGetListFromRepository.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceGetListFromRepository.Error())
+
+		return err
+	}
+
+	symbols := make(map[string]struct{}, len(omOrderers))
+	errs := make([]error, 0)
+
+	for _, omOrderer := range omOrderers {
+		if _, ok := symbols[omOrderer.GetSymbol()]; ok {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`_, ok := symbols[omOrderer.GetSymbol()]; ok`)
+
+			continue
+		}
+
+		symbols[omOrderer.GetSymbol()] = struct{}{}
+
+		omCanceledOrderers, errCancel := service.GetServicer().
+			GetOrderServicer().
+			CancelAllForSymbol(ctx, omOrderer.GetSymbol())
+		if errors.Is(errCancel, object.ErrKucoinOrderNotFound) {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`errors.Is(errCancel, object.ErrKucoinOrderNotFound)`)
+
+			continue
+		}
+
+		if errCancel != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errCancel).
+				Error(object.ErrOrderServiceCancelAllForSymbol.Error())
+
+			errs = append(errs, errCancel)
+
+			continue
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMOrders, omCanceledOrderers).
+			Debug(object.URIEmpty)
+	}
+
+	omStopOrderers, err := service.activeStopOrders(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServiceGetListFromRepository.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceGetListFromRepository.Error())
+
+		return err
+	}
+
+	for _, omStopOrderer := range omStopOrderers {
+		omCanceledStopOrderer, errCancel := service.GetServicer().
+			GetStopOrderServicer().
+			Cancel(ctx, omStopOrderer.GetID())
+		if errors.Is(errCancel, object.ErrKucoinOrderNotFound) {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`errors.Is(errCancel, object.ErrKucoinOrderNotFound)`)
+
+			continue
+		}
+
+		if errCancel != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errCancel).
+				Error(object.ErrStopOrderServiceCancel.Error())
+
+			errs = append(errs, errCancel)
+
+			continue
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMStopOrder, omCanceledStopOrderer).
+			Debug(object.URIEmpty)
+	}
+
+	if err = errors.Join(errs...); err != nil {
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrRiskServiceEngage.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// GetKillSwitch is a function.
+// The kill switch is off until it is engaged for the first time.
+func (service *riskService) GetKillSwitch(
+	ctx context.Context,
+) (om.KillSwitcher, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetKillSwitch",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetKillSwitch",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	paper := service.GetConfigger().GetPaperConfigger().GetEnabled()
+
+	omKillSwitchers, _, err := service.GetServicer().
+		GetKillSwitchServicer().
+		GetListFromRepository(
+			ctx,
+			dao.NewPagination(dao.NewCursor(0), 1),
+			dao.NewKillSwitchFilter(paper),
+		)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKillSwitchServiceGetListFromRepository.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKillSwitchServiceGetListFromRepository.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMKillSwitches, omKillSwitchers).
+		Debug(object.URIEmpty)
+
+	if len(omKillSwitchers) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(omKillSwitchers) == 0`)
+
+		return om.NewKillSwitch(object.URIEmpty, false, paper, uuid.Nil), nil
+	}
+
+	return omKillSwitchers[0], nil
+}
+
+// Release is a function.
+// Orders cancelled when the kill switch was engaged are not placed again.
+func (service *riskService) Release(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Release",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Release",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	paper := service.GetConfigger().GetPaperConfigger().GetEnabled()
+
+	killSwitchID, err := service.GetServicer().
+		GetKillSwitchServicer().
+		Upsert(ctx, om.NewKillSwitch(object.URIEmpty, false, paper, uuid.Nil))
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKillSwitchServiceUpsert.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKillSwitchServiceUpsert.Error())
+
+		return err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKillSwitchID, killSwitchID).
+		Debug(object.URIEmpty)
+
+	return nil
+}
+
+// check runs the limits in order and refuses the order at the first one it
+// breaks. The order is valued at price, falling back to the last ticker price,
+// and the order rate is only counted once every other limit has passed. A
+// reduce-only order, like a protective leg, passes the kill switch and the
+// mode of the exchange, since it only closes a position.
+func (service *riskService) check(
+	ctx context.Context,
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+	price string,
+	band bool,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"check",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                      "check",
+		"rt_ctx":                    utilRuntimeContext,
+		"sp_ctx":                    utilSpanContext,
+		"config":                    service.configConfigger,
+		"dto_place_order_requester": dtoPlaceOrderRequester,
+		"price":                     price,
+		"band":                      band,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	clientOID := dtoPlaceOrderRequester.GetClientOID()
+
+	omKillSwitcher, err := service.GetServicer().GetRiskServicer().GetKillSwitch(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrRiskServiceGetKillSwitch.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrRiskServiceGetKillSwitch.Error())
+
+		return err
+	}
+
+	reduceOnly := utilRuntimeContext.GetReduceOnly()
+
+	if omKillSwitcher.GetEnabled() && !reduceOnly {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omKillSwitcher.GetEnabled() && !reduceOnly`)
+
+		return service.reject(
+			fields,
+			clientOID,
+			object.RiskReasonTypeKillSwitch,
+			omKillSwitcher.GetReason(),
+		)
+	}
+
+	if mode := service.GetServicer().
+		GetExchangeStatusServicer().
+		GetMode(); mode != object.ExchangeModeTypeOpen && !reduceOnly {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldExchangeMode, mode).
+			Debug(`mode != object.ExchangeModeTypeOpen && !reduceOnly`)
+
+		return service.reject(
+			fields,
+			clientOID,
+			object.RiskReasonTypeExchangeMode,
+			service.GetServicer().GetExchangeStatusServicer().GetMessage(),
+		)
+	}
+
+	last, err := service.last(ctx, dtoPlaceOrderRequester.GetSymbol())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrTickerServiceGetBySymbol.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrTickerServiceGetBySymbol.Error())
+
+		return err
+	}
+
+	if _, limit := service.limit(ctx, object.RiskReasonTypePriceBand); band &&
+		price != object.URIEmpty && riskServiceEnabled(limit) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldLimit, limit).
+			Debug(`band && price != object.URIEmpty && riskServiceEnabled(limit)`)
+
+		if last == object.URIEmpty {
+			return service.reject(fields, clientOID, object.RiskReasonTypeNoPrice, limit)
+		}
+
+		breaks, errBand := riskServiceBand(price, last, limit)
+		if errBand != nil {
+			return service.fail(fields, traceSpan, errBand)
+		}
+
+		if breaks {
+			return service.reject(fields, clientOID, object.RiskReasonTypePriceBand, limit)
+		}
+	}
+
+	if price == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`price == object.URIEmpty`)
+
+		price = last
+	}
+
+	notional, err := riskServiceNotional(dtoPlaceOrderRequester, price)
+	if err != nil {
+		return service.fail(fields, traceSpan, err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldNotional, notional).
+		Debug(object.URIEmpty)
+
+	err = service.checkNotional(ctx, dtoPlaceOrderRequester, notional)
+	if errors.Is(err, object.ErrRiskRejected) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(err, object.ErrRiskRejected)`)
+
+		return err
+	}
+
+	if err != nil {
+		return service.fail(fields, traceSpan, err)
+	}
+
+	if _, limit := service.limit(ctx, object.RiskReasonTypeMaxOpenOrders); riskServiceEnabled(limit) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldLimit, limit).
+			Debug(`riskServiceEnabled(limit)`)
+
+		omOrderers, errOrders := service.activeOrders(ctx)
+		if errOrders != nil {
+			return service.fail(fields, traceSpan, errOrders)
+		}
+
+		omStopOrderers, errStopOrders := service.activeStopOrders(ctx)
+		if errStopOrders != nil {
+			return service.fail(fields, traceSpan, errStopOrders)
+		}
+
+		compare, errCompare := util.DecimalCompare(
+			strconv.Itoa(len(omOrderers)+len(omStopOrderers)),
+			limit,
+		)
+		if errCompare != nil {
+			return service.fail(fields, traceSpan, errCompare)
+		}
+
+		if compare >= 0 {
+			return service.reject(fields, clientOID, object.RiskReasonTypeMaxOpenOrders, limit)
+		}
+	}
+
+	if _, limit := service.limit(ctx, object.RiskReasonTypeDailyLoss); riskServiceEnabled(limit) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldLimit, limit).
+			Debug(`riskServiceEnabled(limit)`)
+
+		loss, errLoss := service.loss(ctx)
+		if errLoss != nil {
+			return service.fail(fields, traceSpan, errLoss)
+		}
+
+		if loss == object.URIEmpty {
+			return service.reject(fields, clientOID, object.RiskReasonTypeNoEquity, limit)
+		}
+
+		compare, errCompare := util.DecimalCompare(loss, limit)
+		if errCompare != nil {
+			return service.fail(fields, traceSpan, errCompare)
+		}
+
+		if compare >= 0 {
+			return service.reject(fields, clientOID, object.RiskReasonTypeDailyLoss, loss)
+		}
+	}
+
+	if _, limit := service.limit(ctx, object.RiskReasonTypeMaxDebtRatio); riskServiceEnabled(limit) &&
+		riskServiceBorrows(dtoPlaceOrderRequester) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldLimit, limit).
+			Debug(`riskServiceEnabled(limit) && riskServiceBorrows(dtoPlaceOrderRequester)`)
+
+		debtRatio, errDebtRatio := service.GetServicer().GetMarginServicer().GetDebtRatio(ctx)
+		if errDebtRatio != nil {
+			return service.fail(fields, traceSpan, errDebtRatio)
+		}
+
+		compare, errCompare := util.DecimalCompare(debtRatio, limit)
+		if errCompare != nil {
+			return service.fail(fields, traceSpan, errCompare)
+		}
+
+		if compare >= 0 {
+			return service.reject(fields, clientOID, object.RiskReasonTypeMaxDebtRatio, debtRatio)
+		}
+	}
+
+	key, limit := service.limit(ctx, object.RiskReasonTypeMaxOrdersPerMinute)
+	if !riskServiceEnabled(limit) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`!riskServiceEnabled(limit)`)
+
+		return nil
+	}
+
+	allowed, err := service.count(key, limit)
+	if err != nil {
+		return service.fail(fields, traceSpan, err)
+	}
+
+	if !allowed {
+		return service.reject(fields, clientOID, object.RiskReasonTypeMaxOrdersPerMinute, limit)
+	}
+
+	return nil
+}
+
+// checkNotional holds the value of the order against the order limit and the
+// projected position against the symbol and total exposure limits. Exposure
+// is the absolute market value of the positions quoted in the same currency
+// as the order, together with the unfilled value of the open orders on the
+// same side, so resting orders count before they fill. An order that reduces
+// it is always let through.
+func (service *riskService) checkNotional(
+	ctx context.Context,
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+	notional string,
+) error {
+	fields := map[string]any{
+		"name":                      "checkNotional",
+		"rt_ctx":                    util.NewRuntimeContext(ctx, service.GetUUIDer()),
+		"config":                    service.configConfigger,
+		"dto_place_order_requester": dtoPlaceOrderRequester,
+		"notional":                  notional,
+	}
+
+	clientOID := dtoPlaceOrderRequester.GetClientOID()
+	_, orderLimit := service.limit(ctx, object.RiskReasonTypeMaxOrderNotional)
+	_, symbolLimit := service.limit(ctx, object.RiskReasonTypeMaxSymbolNotional)
+	_, totalLimit := service.limit(ctx, object.RiskReasonTypeMaxTotalExposure)
+
+	if !riskServiceEnabled(orderLimit) && !riskServiceEnabled(symbolLimit) &&
+		!riskServiceEnabled(totalLimit) {
+		return nil
+	}
+
+	if notional == object.URIEmpty {
+		return service.reject(fields, clientOID, object.RiskReasonTypeNoPrice, object.URIEmpty)
+	}
+
+	if riskServiceEnabled(orderLimit) {
+		compare, err := util.DecimalCompare(notional, orderLimit)
+		if err != nil {
+			return err
+		}
+
+		if compare > 0 {
+			return service.reject(
+				fields,
+				clientOID,
+				object.RiskReasonTypeMaxOrderNotional,
+				orderLimit,
+			)
+		}
+	}
+
+	if !riskServiceEnabled(symbolLimit) && !riskServiceEnabled(totalLimit) {
+		return nil
+	}
+
+	omPositioners, err := service.GetServicer().GetPortfolioServicer().GetPositions(ctx)
+	if err != nil {
+		return err
+	}
+
+	symbol := dtoPlaceOrderRequester.GetSymbol()
+	current := "0"
+	others := "0"
+
+	for _, omPositioner := range omPositioners {
+		if omPositioner.GetSymbol() == symbol {
+			current = omPositioner.GetMarketValue()
+
+			continue
+		}
+
+		if portfolioServiceQuote(omPositioner.GetSymbol()) != portfolioServiceQuote(symbol) {
+			continue
+		}
+
+		value, errAbs := riskServiceAbs(omPositioner.GetMarketValue())
+		if errAbs == nil {
+			others, errAbs = util.DecimalAdd(others, value)
+		}
+
+		if errAbs != nil {
+			return errAbs
+		}
+	}
+
+	omOrderers, err := service.activeOrders(ctx)
+	if err != nil {
+		return err
+	}
+
+	pending := "0"
+
+	for _, omOrderer := range omOrderers {
+		if omOrderer.GetSide() != string(dtoPlaceOrderRequester.GetSide()) ||
+			portfolioServiceQuote(omOrderer.GetSymbol()) != portfolioServiceQuote(symbol) {
+			continue
+		}
+
+		value, errOpen := riskServiceOpenNotional(omOrderer)
+		if errOpen != nil {
+			return errOpen
+		}
+
+		if omOrderer.GetSymbol() == symbol {
+			if pending, err = util.DecimalAdd(pending, value); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if others, err = util.DecimalAdd(others, value); err != nil {
+			return err
+		}
+	}
+
+	if dtoPlaceOrderRequester.GetSide() == object.OrderSideTypeSell {
+		if notional, err = util.DecimalSubtract("0", notional); err != nil {
+			return err
+		}
+
+		if pending, err = util.DecimalSubtract("0", pending); err != nil {
+			return err
+		}
+	}
+
+	if current, err = util.DecimalAdd(current, pending); err != nil {
+		return err
+	}
+
+	projected, err := util.DecimalAdd(current, notional)
+	if err != nil {
+		return err
+	}
+
+	if current, err = riskServiceAbs(current); err != nil {
+		return err
+	}
+
+	if projected, err = riskServiceAbs(projected); err != nil {
+		return err
+	}
+
+	if compare, errCompare := util.DecimalCompare(projected, current); errCompare != nil ||
+		compare <= 0 {
+		return errCompare
+	}
+
+	if riskServiceEnabled(symbolLimit) {
+		compare, errCompare := util.DecimalCompare(projected, symbolLimit)
+		if errCompare != nil {
+			return errCompare
+		}
+
+		if compare > 0 {
+			return service.reject(
+				fields,
+				clientOID,
+				object.RiskReasonTypeMaxSymbolNotional,
+				symbolLimit,
+			)
+		}
+	}
+
+	if !riskServiceEnabled(totalLimit) {
+		return nil
+	}
+
+	total, err := util.DecimalAdd(others, projected)
+	if err != nil {
+		return err
+	}
+
+	compare, err := util.DecimalCompare(total, totalLimit)
+	if err != nil {
+		return err
+	}
+
+	if compare > 0 {
+		return service.reject(fields, clientOID, object.RiskReasonTypeMaxTotalExposure, totalLimit)
+	}
+
+	return nil
+}
+
+// activeOrders are the stored active orders of the current trading mode and
+// account.
+func (service *riskService) activeOrders(
+	ctx context.Context,
+) ([]om.Orderer, error) {
+	paper := service.GetConfigger().GetPaperConfigger().GetEnabled()
+	omOrderers := make([]om.Orderer, 0)
+
+	var daoCursorer dao.Cursorer = dao.NewCursor(0)
+
+	for daoCursorer != nil {
+		omOrderersPage, daoNextCursorer, err := service.GetServicer().
+			GetOrderServicer().
+			GetListFromRepository(
+				ctx,
+				dao.NewPagination(daoCursorer, object.NUMRiskPageSize),
+				dao.NewOrderFilter(
+					serviceAccount(ctx, service),
+					object.URIEmpty,
+					object.URIEmpty,
+					object.URIEmpty,
+					object.URIEmpty,
+					true,
+				),
+			)
+		if err != nil {
+			return nil, err
+		}
+
+		daoCursorer = daoNextCursorer
+
+		for _, omOrderer := range omOrderersPage {
+			if omOrderer.GetPaper() == paper {
+				omOrderers = append(omOrderers, omOrderer)
+			}
+		}
+	}
+
+	return omOrderers, nil
+}
+
+// activeStopOrders are the stored active stop orders of the current trading
+// mode.
+func (service *riskService) activeStopOrders(
+	ctx context.Context,
+) ([]om.StopOrderer, error) {
+	paper := service.GetConfigger().GetPaperConfigger().GetEnabled()
+	omStopOrderers := make([]om.StopOrderer, 0)
+
+	var daoCursorer dao.Cursorer = dao.NewCursor(0)
+
+	for daoCursorer != nil {
+		omStopOrderersPage, daoNextCursorer, err := service.GetServicer().
+			GetStopOrderServicer().
+			GetListFromRepository(
+				ctx,
+				dao.NewPagination(daoCursorer, object.NUMRiskPageSize),
+				dao.NewStopOrderFilter(
+					serviceAccount(ctx, service),
+					object.URIEmpty,
+					object.URIEmpty,
+					object.URIEmpty,
+					true,
+				),
+			)
+		if err != nil {
+			return nil, err
+		}
+
+		daoCursorer = daoNextCursorer
+
+		for _, omStopOrderer := range omStopOrderersPage {
+			if omStopOrderer.GetPaper() == paper {
+				omStopOrderers = append(omStopOrderers, omStopOrderer)
+			}
+		}
+	}
+
+	return omStopOrderers, nil
+}
+
+// count records the order in the window of the limit key unless the window
+// is already full.
+func (service *riskService) count(
+	key string,
+	limit string,
+) (bool, error) {
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+
+	now := service.GetTimer().NowUTC()
+	orders := make([]time.Time, 0, len(service.orders[key])+1)
+
+	for _, order := range service.orders[key] {
+		if now.Sub(order) < object.NUMRiskOrderWindow {
+			orders = append(orders, order)
+		}
+	}
+
+	compare, err := util.DecimalCompare(strconv.Itoa(len(orders)), limit)
+	if err != nil {
+		return false, err
+	}
+
+	if compare >= 0 {
+		service.orders[key] = orders
+
+		return false, nil
+	}
+
+	service.orders[key] = append(orders, now)
+
+	return true, nil
+}
+
+// equity is the first equity snapshot of the account and the current trading
+// mode taken in the window, in the order asked for. It tells whether there is
+// one as well.
+func (service *riskService) equity(
+	ctx context.Context,
+	snapshotAtFrom int64,
+	snapshotAtTo int64,
+	sortSnapshotAtDesc bool,
+) (om.Equitier, bool, error) {
+	currency := service.GetConfigger().GetPortfolioConfigger().GetCurrency()
+	paper := service.GetConfigger().GetPaperConfigger().GetEnabled()
+
+	var daoCursorer dao.Cursorer = dao.NewCursor(0)
+
+	for daoCursorer != nil {
+		omEquitiers, daoNextCursorer, err := service.GetServicer().
+			GetEquityServicer().
+			GetListFromRepository(
+				ctx,
+				dao.NewPagination(daoCursorer, object.NUMRiskPageSize),
+				dao.NewEquityFilter(
+					serviceAccount(ctx, service),
+					currency,
+					snapshotAtFrom,
+					snapshotAtTo,
+					sortSnapshotAtDesc,
+				),
+			)
+		if err != nil {
+			return nil, false, err
+		}
+
+		daoCursorer = daoNextCursorer
+
+		for _, omEquitier := range omEquitiers {
+			if omEquitier.GetPaper() == paper {
+				return omEquitier, true, nil
+			}
+		}
+	}
+
+	return nil, false, nil
+}
+
+// last is the last ticker price of the symbol, empty when there is none.
+func (service *riskService) last(
+	ctx context.Context,
+	symbol string,
+) (string, error) {
+	omTickerer, err := service.GetServicer().GetTickerServicer().GetBySymbol(ctx, symbol)
+	if errors.Is(err, object.ErrTickerServiceGetBySymbol) {
+		return object.URIEmpty, nil
+	}
+
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	return omTickerer.GetLast(), nil
+}
+
+// limit resolves a limit for the strategy of the runtime context and for the
+// account, which is the default account when the context names none, falling
+// back to the default limit. It returns the key the limit was found
+// under as well, which scopes the order rate window.
+func (service *riskService) limit(
+	ctx context.Context,
+	reason object.RiskReasonType,
+) (string, string) {
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	limits := service.GetConfigger().GetRiskConfigger().GetLimits()
+	keys := make([]string, 0, 3)
+
+	if strategy := utilRuntimeContext.GetStrategy(); strategy != object.URIEmpty {
+		keys = append(keys, strings.Join(
+			[]string{object.URIRiskLimitScopeStrategy, strategy, string(reason)},
+			object.URIRiskLimitSeparator,
+		))
+	}
+
+	keys = append(keys, strings.Join(
+		[]string{object.URIRiskLimitScopeAccount, serviceAccount(ctx, service), string(reason)},
+		object.URIRiskLimitSeparator,
+	))
+	keys = append(keys, string(reason))
+
+	for _, key := range keys {
+		if limit, ok := limits[key]; ok {
+			return key, limit
+		}
+	}
+
+	return string(reason), object.URIEmpty
+}
+
+// loss is the net realized loss of the account since its first equity snapshot
+// of the UTC day, or since its last snapshot before the day when none was taken
+// yet. It is empty when the account has no snapshot at all, since the loss
+// cannot be told then.
+func (service *riskService) loss(
+	ctx context.Context,
+) (string, error) {
+	midnight := service.GetTimer().NowUTC().Truncate(24 * time.Hour).UnixMilli()
+
+	omBaseEquitier, ok, err := service.equity(ctx, midnight, 0, false)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	if !ok {
+		if omBaseEquitier, ok, err = service.equity(ctx, 0, midnight, true); err != nil {
+			return object.URIEmpty, err
+		}
+	}
+
+	if !ok {
+		return object.URIEmpty, nil
+	}
+
+	omEquitier, err := service.GetServicer().GetPortfolioServicer().GetEquity(ctx)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	base, err := util.DecimalSubtract(omBaseEquitier.GetRealizedPnL(), omBaseEquitier.GetFee())
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	current, err := util.DecimalSubtract(omEquitier.GetRealizedPnL(), omEquitier.GetFee())
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	return util.DecimalSubtract(base, current)
+}
+
+// fail logs an error that stopped the check from completing.
+func (service *riskService) fail(
+	fields map[string]any,
+	traceSpan trace.Span,
+	err error,
+) error {
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldError, err).
+		Error(object.ErrRiskServiceCheck.Error())
+	traceSpan.RecordError(err)
+	traceSpan.SetStatus(codes.Error, object.ErrRiskServiceCheck.Error())
+
+	return err
+}
+
+// reject logs the refusal with its reason code and returns it as an error.
+func (service *riskService) reject(
+	fields map[string]any,
+	clientOID string,
+	reason object.RiskReasonType,
+	message string,
+) error {
+	err := object.NewRiskRejectedError(clientOID, reason, message)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldRiskReason, reason).
+		WithField(object.URIFieldError, err).
+		Warn(object.ErrRiskRejected.Error())
+
+	return err
+}
+
+// riskServiceAbs is the absolute value of a decimal.
+func riskServiceAbs(
+	value string,
+) (string, error) {
+	compare, err := util.DecimalCompare(value, "0")
+	if err != nil || compare >= 0 {
+		return value, err
+	}
+
+	return util.DecimalSubtract("0", value)
+}
+
+// riskServiceBand tells whether the price is further from the last price than
+// the band, given as a fraction of the last price.
+func riskServiceBand(
+	price string,
+	last string,
+	limit string,
+) (bool, error) {
+	difference, err := util.DecimalSubtract(price, last)
+	if err != nil {
+		return false, err
+	}
+
+	if difference, err = riskServiceAbs(difference); err != nil {
+		return false, err
+	}
+
+	deviation, err := util.DecimalDivide(difference, last, object.URIRiskPriceBandIncrement)
+	if err != nil {
+		return false, err
+	}
+
+	compare, err := util.DecimalCompare(deviation, limit)
+	if err != nil {
+		return false, err
+	}
+
+	return compare > 0, nil
+}
+
+// riskServiceBorrows tells whether the order may borrow, which only a margin
+// order with autoBorrow does. Any other order spends what the account holds,
+// so it cannot raise the debt ratio.
+func riskServiceBorrows(
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+) bool {
+	dtoPlaceMarginOrderRequester, ok := dtoPlaceOrderRequester.(dto.PlaceMarginOrderRequester)
+
+	return ok && dtoPlaceMarginOrderRequester.GetAutoBorrow()
+}
+
+// riskServiceEnabled tells whether a limit is set. An empty, zero, negative
+// or malformed limit leaves the check off.
+func riskServiceEnabled(
+	limit string,
+) bool {
+	if limit == object.URIEmpty {
+		return false
+	}
+
+	compare, err := util.DecimalCompare(limit, "0")
+
+	return err == nil && compare > 0
+}
+
+// riskServiceOpenNotional is the quote value of what is left of an open order
+// at its price. An order by funds is worth the funds it has not spent, and an
+// order without a price is not resting, so it is worth nothing.
+func riskServiceOpenNotional(
+	omOrderer om.Orderer,
+) (string, error) {
+	if omOrderer.GetSize() == object.URIEmpty {
+		if omOrderer.GetFunds() == object.URIEmpty {
+			return "0", nil
+		}
+
+		return util.DecimalSubtract(omOrderer.GetFunds(), riskServiceZero(omOrderer.GetDealFunds()))
+	}
+
+	if omOrderer.GetPrice() == object.URIEmpty {
+		return "0", nil
+	}
+
+	remaining, err := util.DecimalSubtract(omOrderer.GetSize(), riskServiceZero(omOrderer.GetDealSize()))
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	return util.DecimalMultiply(remaining, omOrderer.GetPrice())
+}
+
+// riskServiceNotional is the quote value of the order at the price. A market
+// order by funds is worth its funds, and the value is empty when there is no
+// price to go by.
+func riskServiceNotional(
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+	price string,
+) (string, error) {
+	if dtoPlaceOrderRequester.GetSize() == object.URIEmpty {
+		return dtoPlaceOrderRequester.GetFunds(), nil
+	}
+
+	if price == object.URIEmpty {
+		return object.URIEmpty, nil
+	}
+
+	return util.DecimalMultiply(dtoPlaceOrderRequester.GetSize(), price)
+}
+
+// riskServiceZero is the value, or zero when it is empty.
+func riskServiceZero(
+	value string,
+) string {
+	if value == object.URIEmpty {
+		return "0"
+	}
+
+	return value
+}
@@ -0,0 +1,526 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type (
+	riskServiceTestServicer struct {
+		Servicer
+		equityServicer         EquityServicer
+		exchangeStatusServicer ExchangeStatusServicer
+		orderServicer          OrderServicer
+		portfolioServicer      PortfolioServicer
+		riskServicer           RiskServicer
+		stopOrderServicer      StopOrderServicer
+		tickerServicer         TickerServicer
+	}
+
+	riskServiceTestEquityServicer struct {
+		EquityServicer
+		omEquitiers []om.Equitier
+	}
+
+	riskServiceTestExchangeStatusServicer struct {
+		ExchangeStatusServicer
+		mode object.ExchangeModeType
+	}
+
+	riskServiceTestOrderServicer struct {
+		OrderServicer
+		omOrderers []om.Orderer
+	}
+
+	riskServiceTestPortfolioServicer struct {
+		PortfolioServicer
+		omEquitier    om.Equitier
+		omPositioners []om.Positioner
+	}
+
+	riskServiceTestRiskServicer struct {
+		RiskServicer
+		enabled bool
+	}
+
+	riskServiceTestStopOrderServicer struct {
+		StopOrderServicer
+	}
+
+	riskServiceTestTickerServicer struct {
+		TickerServicer
+	}
+)
+
+// GetEquityServicer is a function.
+func (servicer *riskServiceTestServicer) GetEquityServicer() EquityServicer {
+	return servicer.equityServicer
+}
+
+// GetExchangeStatusServicer is a function.
+func (servicer *riskServiceTestServicer) GetExchangeStatusServicer() ExchangeStatusServicer {
+	return servicer.exchangeStatusServicer
+}
+
+// GetOrderServicer is a function.
+func (servicer *riskServiceTestServicer) GetOrderServicer() OrderServicer {
+	return servicer.orderServicer
+}
+
+// GetPortfolioServicer is a function.
+func (servicer *riskServiceTestServicer) GetPortfolioServicer() PortfolioServicer {
+	return servicer.portfolioServicer
+}
+
+// GetRiskServicer is a function.
+func (servicer *riskServiceTestServicer) GetRiskServicer() RiskServicer {
+	return servicer.riskServicer
+}
+
+// GetStopOrderServicer is a function.
+func (servicer *riskServiceTestServicer) GetStopOrderServicer() StopOrderServicer {
+	return servicer.stopOrderServicer
+}
+
+// GetTickerServicer is a function.
+func (servicer *riskServiceTestServicer) GetTickerServicer() TickerServicer {
+	return servicer.tickerServicer
+}
+
+// GetListFromRepository is a function.
+// It keeps to the window and the order of the filter, like the repository.
+func (servicer *riskServiceTestEquityServicer) GetListFromRepository(
+	_ context.Context,
+	_ dao.Paginationer,
+	daoEquityFilterer dao.EquityFilterer,
+) ([]om.Equitier, dao.Cursorer, error) {
+	omEquitiers := make([]om.Equitier, 0)
+
+	for _, omEquitier := range servicer.omEquitiers {
+		if daoEquityFilterer.GetSnapshotAtFrom() != 0 &&
+			omEquitier.GetSnapshotAt() < daoEquityFilterer.GetSnapshotAtFrom() {
+			continue
+		}
+
+		if daoEquityFilterer.GetSnapshotAtTo() != 0 &&
+			omEquitier.GetSnapshotAt() >= daoEquityFilterer.GetSnapshotAtTo() {
+			continue
+		}
+
+		omEquitiers = append(omEquitiers, omEquitier)
+	}
+
+	if daoEquityFilterer.GetSortSnapshotAtDesc() {
+		for index := 0; index < len(omEquitiers)/2; index++ {
+			last := len(omEquitiers) - 1 - index
+			omEquitiers[index], omEquitiers[last] = omEquitiers[last], omEquitiers[index]
+		}
+	}
+
+	return omEquitiers, nil, nil
+}
+
+// GetMessage is a function.
+func (servicer *riskServiceTestExchangeStatusServicer) GetMessage() string {
+	return string(servicer.mode)
+}
+
+// GetMode is a function.
+func (servicer *riskServiceTestExchangeStatusServicer) GetMode() object.ExchangeModeType {
+	return servicer.mode
+}
+
+// GetListFromRepository is a function.
+func (servicer *riskServiceTestOrderServicer) GetListFromRepository(
+	_ context.Context,
+	_ dao.Paginationer,
+	_ dao.OrderFilterer,
+) ([]om.Orderer, dao.Cursorer, error) {
+	return servicer.omOrderers, nil, nil
+}
+
+// GetEquity is a function.
+func (servicer *riskServiceTestPortfolioServicer) GetEquity(
+	_ context.Context,
+) (om.Equitier, error) {
+	return servicer.omEquitier, nil
+}
+
+// GetPositions is a function.
+func (servicer *riskServiceTestPortfolioServicer) GetPositions(
+	_ context.Context,
+) ([]om.Positioner, error) {
+	return servicer.omPositioners, nil
+}
+
+// GetKillSwitch is a function.
+func (servicer *riskServiceTestRiskServicer) GetKillSwitch(
+	_ context.Context,
+) (om.KillSwitcher, error) {
+	return om.NewKillSwitch("test", servicer.enabled, false, uuid.Nil), nil
+}
+
+// GetListFromRepository is a function.
+func (servicer *riskServiceTestStopOrderServicer) GetListFromRepository(
+	_ context.Context,
+	_ dao.Paginationer,
+	_ dao.StopOrderFilterer,
+) ([]om.StopOrderer, dao.Cursorer, error) {
+	return []om.StopOrderer{}, nil, nil
+}
+
+// GetBySymbol is a function.
+// Every symbol last traded at 100.
+func (servicer *riskServiceTestTickerServicer) GetBySymbol(
+	_ context.Context,
+	symbol string,
+) (om.Tickerer, error) {
+	return om.NewTicker(
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		"100",
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		symbol,
+		symbol,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		uuid.Nil,
+	), nil
+}
+
+func newRiskServiceTest(
+	limits map[string]string,
+) (RiskServicer, *riskServiceTestServicer) {
+	configConfigger := config.NewConfig(
+		config.WithKucoinConfigger(config.WithKucoinConfigAccount("master")),
+		config.WithLogConfigger(),
+		config.WithPaperConfigger(),
+		config.WithPortfolioConfigger(config.WithPortfolioConfigCurrency("USDT")),
+		config.WithRiskConfigger(config.WithRiskConfigLimits(limits)),
+	)
+
+	riskServicer := NewRiskServicer(
+		configConfigger,
+		log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+		trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+		util.NewUUID(),
+		&serviceTestTimer{
+			Timer: nil,
+			now:   time.Date(2024, time.January, 2, 12, 0, 0, 0, time.UTC),
+		},
+		nil,
+	)
+
+	testServicer := &riskServiceTestServicer{
+		Servicer:       nil,
+		equityServicer: &riskServiceTestEquityServicer{EquityServicer: nil, omEquitiers: []om.Equitier{}},
+		exchangeStatusServicer: &riskServiceTestExchangeStatusServicer{
+			ExchangeStatusServicer: nil,
+			mode:                   object.ExchangeModeTypeOpen,
+		},
+		orderServicer: &riskServiceTestOrderServicer{OrderServicer: nil, omOrderers: []om.Orderer{}},
+		portfolioServicer: &riskServiceTestPortfolioServicer{
+			PortfolioServicer: nil,
+			omEquitier:        nil,
+			omPositioners:     []om.Positioner{},
+		},
+		riskServicer:      &riskServiceTestRiskServicer{RiskServicer: riskServicer, enabled: false},
+		stopOrderServicer: &riskServiceTestStopOrderServicer{StopOrderServicer: nil},
+		tickerServicer:    &riskServiceTestTickerServicer{TickerServicer: nil},
+	}
+
+	riskServicer.(WithServicer).WithServicer(testServicer)
+
+	return riskServicer, testServicer
+}
+
+func newRiskServiceTestOrder(
+	side object.OrderSideType,
+	price string,
+	size string,
+	dealSize string,
+) om.Orderer {
+	return om.NewOrder(
+		"master",
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		dealSize,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		string(object.OrderTypeTypeLimit),
+		object.URIEmpty,
+		price,
+		object.URIEmpty,
+		string(side),
+		size,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		"BTC-USDT",
+		object.URIEmpty,
+		string(object.TimeInForceTypeGTC),
+		string(object.OrderTypeTypeTrade),
+		object.URIEmpty,
+		0,
+		0,
+		false,
+		false,
+		false,
+		true,
+		false,
+		false,
+		false,
+		uuid.Nil,
+	)
+}
+
+// riskServiceTestReason is the reason the check refused the order for, or empty
+// when it let the order through.
+func riskServiceTestReason(
+	t *testing.T,
+	err error,
+) object.RiskReasonType {
+	t.Helper()
+
+	if err == nil {
+		return object.RiskReasonType(object.URIEmpty)
+	}
+
+	var objectRiskErrorer object.RiskErrorer
+	if !errors.As(err, &objectRiskErrorer) {
+		t.Fatalf("Check() error = %v, want %v", err, object.ErrRiskRejected)
+	}
+
+	return objectRiskErrorer.GetReason()
+}
+
+func TestRiskServiceCheckReduceOnly(t *testing.T) {
+	t.Parallel()
+
+	reduceOnly := util.WithRuntimeContextValue(
+		context.Background(),
+		object.URIRuntimeContextReduceOnly,
+		true,
+	)
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		killed bool
+		mode   object.ExchangeModeType
+		want   object.RiskReasonType
+	}{
+		{
+			name:   "kill switch",
+			ctx:    context.Background(),
+			killed: true,
+			mode:   object.ExchangeModeTypeOpen,
+			want:   object.RiskReasonTypeKillSwitch,
+		},
+		{
+			name:   "kill switch reduce-only",
+			ctx:    reduceOnly,
+			killed: true,
+			mode:   object.ExchangeModeTypeOpen,
+			want:   object.RiskReasonType(object.URIEmpty),
+		},
+		{
+			name:   "cancel-only",
+			ctx:    context.Background(),
+			killed: false,
+			mode:   object.ExchangeModeTypeCancelOnly,
+			want:   object.RiskReasonTypeExchangeMode,
+		},
+		{
+			name:   "paused reduce-only",
+			ctx:    reduceOnly,
+			killed: false,
+			mode:   object.ExchangeModeTypePaused,
+			want:   object.RiskReasonType(object.URIEmpty),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			riskServicer, testServicer := newRiskServiceTest(map[string]string{})
+			testServicer.riskServicer.(*riskServiceTestRiskServicer).enabled = test.killed
+			testServicer.exchangeStatusServicer.(*riskServiceTestExchangeStatusServicer).mode = test.mode
+
+			err := riskServicer.Check(
+				test.ctx,
+				newOrderServiceTestPlaceOrderRequest(object.OrderTypeTypeLimit, "100", "1"),
+			)
+			if got := riskServiceTestReason(t, err); got != test.want {
+				t.Errorf("Check() reason = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRiskServiceCheckOpenOrders(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		omOrderers []om.Orderer
+		want       object.RiskReasonType
+	}{
+		{
+			name:       "no open orders",
+			omOrderers: []om.Orderer{},
+			want:       object.RiskReasonType(object.URIEmpty),
+		},
+		{
+			name: "open buy",
+			omOrderers: []om.Orderer{
+				newRiskServiceTestOrder(object.OrderSideTypeBuy, "100", "10", "1"),
+			},
+			want: object.RiskReasonTypeMaxSymbolNotional,
+		},
+		{
+			name: "open buy filled",
+			omOrderers: []om.Orderer{
+				newRiskServiceTestOrder(object.OrderSideTypeBuy, "100", "10", "10"),
+			},
+			want: object.RiskReasonType(object.URIEmpty),
+		},
+		{
+			name: "open sell",
+			omOrderers: []om.Orderer{
+				newRiskServiceTestOrder(object.OrderSideTypeSell, "100", "10", "0"),
+			},
+			want: object.RiskReasonType(object.URIEmpty),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			riskServicer, testServicer := newRiskServiceTest(map[string]string{
+				string(object.RiskReasonTypeMaxSymbolNotional): "1000",
+			})
+			testServicer.orderServicer.(*riskServiceTestOrderServicer).omOrderers = test.omOrderers
+
+			err := riskServicer.Check(
+				context.Background(),
+				newOrderServiceTestPlaceOrderRequest(object.OrderTypeTypeLimit, "100", "2"),
+			)
+			if got := riskServiceTestReason(t, err); got != test.want {
+				t.Errorf("Check() reason = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRiskServiceCheckDailyLoss(t *testing.T) {
+	t.Parallel()
+
+	midnight := time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC).UnixMilli()
+
+	tests := []struct {
+		name        string
+		omEquitiers []om.Equitier
+		want        object.RiskReasonType
+	}{
+		{
+			name:        "no snapshot",
+			omEquitiers: []om.Equitier{},
+			want:        object.RiskReasonTypeNoEquity,
+		},
+		{
+			name: "snapshot of the day",
+			omEquitiers: []om.Equitier{
+				om.NewEquity("master", "USDT", "0", "0", "85", "0", midnight+1, false, uuid.Nil),
+			},
+			want: object.RiskReasonType(object.URIEmpty),
+		},
+		{
+			name: "last snapshot before the day",
+			omEquitiers: []om.Equitier{
+				om.NewEquity("master", "USDT", "0", "0", "50", "0", midnight-2, false, uuid.Nil),
+				om.NewEquity("master", "USDT", "0", "0", "100", "0", midnight-1, false, uuid.Nil),
+			},
+			want: object.RiskReasonTypeDailyLoss,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			riskServicer, testServicer := newRiskServiceTest(map[string]string{
+				string(object.RiskReasonTypeDailyLoss): "10",
+			})
+			testServicer.equityServicer.(*riskServiceTestEquityServicer).omEquitiers = test.omEquitiers
+			testServicer.portfolioServicer.(*riskServiceTestPortfolioServicer).omEquitier = om.NewEquity(
+				"master",
+				"USDT",
+				"0",
+				"0",
+				"80",
+				"0",
+				midnight+2,
+				false,
+				uuid.Nil,
+			)
+
+			err := riskServicer.Check(
+				context.Background(),
+				newOrderServiceTestPlaceOrderRequest(object.OrderTypeTypeLimit, "100", "1"),
+			)
+			if got := riskServiceTestReason(t, err); got != test.want {
+				t.Errorf("Check() reason = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRiskServiceCheckDefaultAccountLimit(t *testing.T) {
+	t.Parallel()
+
+	riskServicer, _ := newRiskServiceTest(map[string]string{
+		object.URIRiskLimitScopeAccount + object.URIRiskLimitSeparator + "master" +
+			object.URIRiskLimitSeparator + string(object.RiskReasonTypeMaxOrderNotional): "50",
+	})
+
+	err := riskServicer.Check(
+		context.Background(),
+		newOrderServiceTestPlaceOrderRequest(object.OrderTypeTypeLimit, "100", "1"),
+	)
+	if got := riskServiceTestReason(t, err); got != object.RiskReasonTypeMaxOrderNotional {
+		t.Errorf("Check() reason = %q, want %q", got, object.RiskReasonTypeMaxOrderNotional)
+	}
+}
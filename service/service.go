@@ -1,9 +1,12 @@
 package service
 
 import (
-	"github.com/Kucoin/kucoin-go-sdk"
+	"context"
+
 	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
 	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
 	"github.com/ShahoBashoki/kucoin/repository"
 	"github.com/ShahoBashoki/kucoin/util"
 	"go.opentelemetry.io/otel/trace"
@@ -12,9 +15,28 @@ import (
 type (
 	// Servicer is an interface.
 	Servicer interface {
+		GetAlgoOrderServicer
+		GetBalanceServicer
+		GetBorrowServicer
+		GetBracketServicer
+		GetEquityServicer
+		GetExchangeStatusServicer
+		GetFillServicer
+		GetKillSwitchServicer
 		GetKlineServicer
+		GetMarginBalanceServicer
+		GetMarginRiskLimitServicer
+		GetMarginServicer
 		GetOrderBookServicer
 		GetOrderServicer
+		GetPortfolioServicer
+		GetPrivateStreamServicer
+		GetRiskServicer
+		GetSizingServicer
+		GetStopOrderServicer
+		GetStreamServicer
+		GetSubAccountServicer
+		GetSymbolServicer
 		GetTickerServicer
 	}
 
@@ -32,11 +54,36 @@ type (
 		)
 	}
 
+	serviceGetter interface {
+		config.GetConfigger
+		exchange.GetExchanger
+		util.GetUUIDer
+	}
+
 	service struct {
-		klineServicer     KlineServicer
-		orderBookServicer OrderBookServicer
-		orderServicer     OrderServicer
-		tickerServicer    TickerServicer
+		algoOrderServicer       AlgoOrderServicer
+		balanceServicer         BalanceServicer
+		borrowServicer          BorrowServicer
+		bracketServicer         BracketServicer
+		equityServicer          EquityServicer
+		exchangeStatusServicer  ExchangeStatusServicer
+		fillServicer            FillServicer
+		killSwitchServicer      KillSwitchServicer
+		klineServicer           KlineServicer
+		marginBalanceServicer   MarginBalanceServicer
+		marginRiskLimitServicer MarginRiskLimitServicer
+		marginServicer          MarginServicer
+		orderBookServicer       OrderBookServicer
+		orderServicer           OrderServicer
+		portfolioServicer       PortfolioServicer
+		privateStreamServicer   PrivateStreamServicer
+		riskServicer            RiskServicer
+		sizingServicer          SizingServicer
+		stopOrderServicer       StopOrderServicer
+		streamServicer          StreamServicer
+		subAccountServicer      SubAccountServicer
+		symbolServicer          SymbolServicer
+		tickerServicer          TickerServicer
 	}
 )
 
@@ -49,14 +96,114 @@ func NewServicer(
 	logRuntimeLogger log.RuntimeLogger,
 	traceTracer trace.Tracer,
 	utilUUIDer util.UUIDer,
-	kucoinAPIService *kucoin.ApiService,
+	objectTimer object.Timer,
+	exchangeExchanger exchange.Exchanger,
 ) Servicer {
+	algoOrderServicer := NewAlgoOrderServicer(
+		configConfigger,
+		repositorier.GetAlgoOrderRepositorier(),
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
+	balanceServicer := NewBalanceServicer(
+		configConfigger,
+		repositorier.GetBalanceRepositorier(),
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
+	borrowServicer := NewBorrowServicer(
+		configConfigger,
+		repositorier.GetBorrowRepositorier(),
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
+	bracketServicer := NewBracketServicer(
+		configConfigger,
+		repositorier.GetBracketRepositorier(),
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
+	equityServicer := NewEquityServicer(
+		configConfigger,
+		repositorier.GetEquityRepositorier(),
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
+	exchangeStatusServicer := NewExchangeStatusServicer(
+		configConfigger,
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
+	fillServicer := NewFillServicer(
+		configConfigger,
+		repositorier.GetFillRepositorier(),
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
+	killSwitchServicer := NewKillSwitchServicer(
+		configConfigger,
+		repositorier.GetKillSwitchRepositorier(),
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
 	klineServicer := NewKlineServicer(
 		configConfigger,
+		repositorier.GetKlineRepositorier(),
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		objectTimer,
+		exchangeExchanger,
+	)
+
+	marginServicer := NewMarginServicer(
+		configConfigger,
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
+	marginBalanceServicer := NewMarginBalanceServicer(
+		configConfigger,
+		repositorier.GetMarginBalanceRepositorier(),
 		logRuntimeLogger,
 		traceTracer,
 		utilUUIDer,
-		kucoinAPIService,
+		exchangeExchanger,
+	)
+
+	marginRiskLimitServicer := NewMarginRiskLimitServicer(
+		configConfigger,
+		repositorier.GetMarginRiskLimitRepositorier(),
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
 	)
 
 	orderBookServicer := NewOrderBookServicer(
@@ -64,7 +211,8 @@ func NewServicer(
 		logRuntimeLogger,
 		traceTracer,
 		utilUUIDer,
-		kucoinAPIService,
+		objectTimer,
+		exchangeExchanger,
 	)
 
 	orderServicer := NewOrderServicer(
@@ -73,7 +221,74 @@ func NewServicer(
 		logRuntimeLogger,
 		traceTracer,
 		utilUUIDer,
-		kucoinAPIService,
+		exchangeExchanger,
+	)
+
+	portfolioServicer := NewPortfolioServicer(
+		configConfigger,
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
+	privateStreamServicer := NewPrivateStreamServicer(
+		configConfigger,
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
+	riskServicer := NewRiskServicer(
+		configConfigger,
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		objectTimer,
+		exchangeExchanger,
+	)
+
+	sizingServicer := NewSizingServicer(
+		configConfigger,
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
+	stopOrderServicer := NewStopOrderServicer(
+		configConfigger,
+		repositorier.GetStopOrderRepositorier(),
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
+	streamServicer := NewStreamServicer(
+		configConfigger,
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
+	subAccountServicer := NewSubAccountServicer(
+		configConfigger,
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
+	)
+
+	symbolServicer := NewSymbolServicer(
+		configConfigger,
+		repositorier.GetSymbolRepositorier(),
+		logRuntimeLogger,
+		traceTracer,
+		utilUUIDer,
+		exchangeExchanger,
 	)
 
 	tickerServicer := NewTickerServicer(
@@ -82,14 +297,73 @@ func NewServicer(
 		logRuntimeLogger,
 		traceTracer,
 		utilUUIDer,
-		kucoinAPIService,
+		exchangeExchanger,
 	)
 
 	service := &service{
-		klineServicer:     klineServicer,
-		orderBookServicer: orderBookServicer,
-		orderServicer:     orderServicer,
-		tickerServicer:    tickerServicer,
+		algoOrderServicer:       algoOrderServicer,
+		balanceServicer:         balanceServicer,
+		borrowServicer:          borrowServicer,
+		bracketServicer:         bracketServicer,
+		equityServicer:          equityServicer,
+		exchangeStatusServicer:  exchangeStatusServicer,
+		fillServicer:            fillServicer,
+		killSwitchServicer:      killSwitchServicer,
+		klineServicer:           klineServicer,
+		marginBalanceServicer:   marginBalanceServicer,
+		marginRiskLimitServicer: marginRiskLimitServicer,
+		marginServicer:          marginServicer,
+		orderBookServicer:       orderBookServicer,
+		orderServicer:           orderServicer,
+		portfolioServicer:       portfolioServicer,
+		privateStreamServicer:   privateStreamServicer,
+		riskServicer:            riskServicer,
+		sizingServicer:          sizingServicer,
+		stopOrderServicer:       stopOrderServicer,
+		streamServicer:          streamServicer,
+		subAccountServicer:      subAccountServicer,
+		symbolServicer:          symbolServicer,
+		tickerServicer:          tickerServicer,
+	}
+
+	algoOrderServicerWithTypeCheck, ok := algoOrderServicer.(WithServicer)
+	if ok {
+		algoOrderServicerWithTypeCheck.WithServicer(service)
+	}
+
+	balanceServicerWithTypeCheck, ok := balanceServicer.(WithServicer)
+	if ok {
+		balanceServicerWithTypeCheck.WithServicer(service)
+	}
+
+	borrowServicerWithTypeCheck, ok := borrowServicer.(WithServicer)
+	if ok {
+		borrowServicerWithTypeCheck.WithServicer(service)
+	}
+
+	bracketServicerWithTypeCheck, ok := bracketServicer.(WithServicer)
+	if ok {
+		bracketServicerWithTypeCheck.WithServicer(service)
+	}
+
+	equityServicerWithTypeCheck, ok := equityServicer.(WithServicer)
+	if ok {
+		equityServicerWithTypeCheck.WithServicer(service)
+	}
+
+	exchangeStatusServicerWithTypeCheck, ok := exchangeStatusServicer.(WithServicer)
+	if ok {
+		exchangeStatusServicerWithTypeCheck.WithServicer(service)
+	}
+
+	fillServicerWithTypeCheck, ok := fillServicer.(WithServicer)
+	if ok {
+		fillServicerWithTypeCheck.WithServicer(service)
+	}
+
+	killSwitchServicerWithTypeCheck, ok := killSwitchServicer.(WithServicer)
+	if ok {
+		killSwitchServicerWithTypeCheck.WithServicer(service)
 	}
 
 	klineServicerWithTypeCheck, ok := klineServicer.(WithServicer)
@@ -97,6 +371,21 @@ func NewServicer(
 		klineServicerWithTypeCheck.WithServicer(service)
 	}
 
+	marginServicerWithTypeCheck, ok := marginServicer.(WithServicer)
+	if ok {
+		marginServicerWithTypeCheck.WithServicer(service)
+	}
+
+	marginBalanceServicerWithTypeCheck, ok := marginBalanceServicer.(WithServicer)
+	if ok {
+		marginBalanceServicerWithTypeCheck.WithServicer(service)
+	}
+
+	marginRiskLimitServicerWithTypeCheck, ok := marginRiskLimitServicer.(WithServicer)
+	if ok {
+		marginRiskLimitServicerWithTypeCheck.WithServicer(service)
+	}
+
 	orderBookServicerWithTypeCheck, ok := orderBookServicer.(WithServicer)
 	if ok {
 		orderBookServicerWithTypeCheck.WithServicer(service)
@@ -107,6 +396,46 @@ func NewServicer(
 		orderServicerWithTypeCheck.WithServicer(service)
 	}
 
+	portfolioServicerWithTypeCheck, ok := portfolioServicer.(WithServicer)
+	if ok {
+		portfolioServicerWithTypeCheck.WithServicer(service)
+	}
+
+	privateStreamServicerWithTypeCheck, ok := privateStreamServicer.(WithServicer)
+	if ok {
+		privateStreamServicerWithTypeCheck.WithServicer(service)
+	}
+
+	riskServicerWithTypeCheck, ok := riskServicer.(WithServicer)
+	if ok {
+		riskServicerWithTypeCheck.WithServicer(service)
+	}
+
+	sizingServicerWithTypeCheck, ok := sizingServicer.(WithServicer)
+	if ok {
+		sizingServicerWithTypeCheck.WithServicer(service)
+	}
+
+	stopOrderServicerWithTypeCheck, ok := stopOrderServicer.(WithServicer)
+	if ok {
+		stopOrderServicerWithTypeCheck.WithServicer(service)
+	}
+
+	streamServicerWithTypeCheck, ok := streamServicer.(WithServicer)
+	if ok {
+		streamServicerWithTypeCheck.WithServicer(service)
+	}
+
+	subAccountServicerWithTypeCheck, ok := subAccountServicer.(WithServicer)
+	if ok {
+		subAccountServicerWithTypeCheck.WithServicer(service)
+	}
+
+	symbolServicerWithTypeCheck, ok := symbolServicer.(WithServicer)
+	if ok {
+		symbolServicerWithTypeCheck.WithServicer(service)
+	}
+
 	tickerServicerWithTypeCheck, ok := tickerServicer.(WithServicer)
 	if ok {
 		tickerServicerWithTypeCheck.WithServicer(service)
@@ -115,11 +444,66 @@ func NewServicer(
 	return service
 }
 
+// GetAlgoOrderServicer is a function.
+func (service *service) GetAlgoOrderServicer() AlgoOrderServicer {
+	return service.algoOrderServicer
+}
+
+// GetBalanceServicer is a function.
+func (service *service) GetBalanceServicer() BalanceServicer {
+	return service.balanceServicer
+}
+
+// GetBorrowServicer is a function.
+func (service *service) GetBorrowServicer() BorrowServicer {
+	return service.borrowServicer
+}
+
+// GetBracketServicer is a function.
+func (service *service) GetBracketServicer() BracketServicer {
+	return service.bracketServicer
+}
+
+// GetEquityServicer is a function.
+func (service *service) GetEquityServicer() EquityServicer {
+	return service.equityServicer
+}
+
+// GetExchangeStatusServicer is a function.
+func (service *service) GetExchangeStatusServicer() ExchangeStatusServicer {
+	return service.exchangeStatusServicer
+}
+
+// GetFillServicer is a function.
+func (service *service) GetFillServicer() FillServicer {
+	return service.fillServicer
+}
+
+// GetKillSwitchServicer is a function.
+func (service *service) GetKillSwitchServicer() KillSwitchServicer {
+	return service.killSwitchServicer
+}
+
 // GetKlineServicer is a function.
 func (service *service) GetKlineServicer() KlineServicer {
 	return service.klineServicer
 }
 
+// GetMarginServicer is a function.
+func (service *service) GetMarginServicer() MarginServicer {
+	return service.marginServicer
+}
+
+// GetMarginBalanceServicer is a function.
+func (service *service) GetMarginBalanceServicer() MarginBalanceServicer {
+	return service.marginBalanceServicer
+}
+
+// GetMarginRiskLimitServicer is a function.
+func (service *service) GetMarginRiskLimitServicer() MarginRiskLimitServicer {
+	return service.marginRiskLimitServicer
+}
+
 // GetOrderBookServicer is a function.
 func (service *service) GetOrderBookServicer() OrderBookServicer {
 	return service.orderBookServicer
@@ -130,7 +514,92 @@ func (service *service) GetOrderServicer() OrderServicer {
 	return service.orderServicer
 }
 
+// GetPortfolioServicer is a function.
+func (service *service) GetPortfolioServicer() PortfolioServicer {
+	return service.portfolioServicer
+}
+
+// GetPrivateStreamServicer is a function.
+func (service *service) GetPrivateStreamServicer() PrivateStreamServicer {
+	return service.privateStreamServicer
+}
+
+// GetRiskServicer is a function.
+func (service *service) GetRiskServicer() RiskServicer {
+	return service.riskServicer
+}
+
+// GetSizingServicer is a function.
+func (service *service) GetSizingServicer() SizingServicer {
+	return service.sizingServicer
+}
+
+// GetStopOrderServicer is a function.
+func (service *service) GetStopOrderServicer() StopOrderServicer {
+	return service.stopOrderServicer
+}
+
+// GetStreamServicer is a function.
+func (service *service) GetStreamServicer() StreamServicer {
+	return service.streamServicer
+}
+
+// GetSubAccountServicer is a function.
+func (service *service) GetSubAccountServicer() SubAccountServicer {
+	return service.subAccountServicer
+}
+
+// GetSymbolServicer is a function.
+func (service *service) GetSymbolServicer() SymbolServicer {
+	return service.symbolServicer
+}
+
 // GetTickerServicer is a function.
 func (service *service) GetTickerServicer() TickerServicer {
 	return service.tickerServicer
 }
+
+// serviceAccount is a function.
+// It is the account of the runtime context, or the default account when the
+// context names none.
+func serviceAccount(
+	ctx context.Context,
+	getter serviceGetter,
+) string {
+	utilRuntimeContext := util.NewRuntimeContext(ctx, getter.GetUUIDer())
+	if account := utilRuntimeContext.GetAccount(); account != object.URIEmpty {
+		return account
+	}
+
+	return getter.GetConfigger().GetKucoinConfigger().GetAccount()
+}
+
+// serviceAccountExchanger is a function.
+// An exchanger without accounts, like the paper exchange, serves every
+// account. The exchanger is bound to ctx when it can be, so its retries are
+// traced on the span of ctx.
+func serviceAccountExchanger(
+	ctx context.Context,
+	getter serviceGetter,
+	account string,
+) exchange.Exchanger {
+	exchangeExchanger := getter.GetExchanger()
+	if exchangeAccountExchanger, ok := exchangeExchanger.(exchange.AccountExchanger); ok {
+		exchangeExchanger = exchangeAccountExchanger.GetAccountExchanger(account)
+	}
+
+	if exchangeContextExchanger, ok := exchangeExchanger.(exchange.ContextExchanger); ok {
+		return exchangeContextExchanger.WithContext(ctx)
+	}
+
+	return exchangeExchanger
+}
+
+// serviceExchanger is a function.
+// It is the exchanger of the account of the runtime context.
+func serviceExchanger(
+	ctx context.Context,
+	getter serviceGetter,
+) exchange.Exchanger {
+	return serviceAccountExchanger(ctx, getter, serviceAccount(ctx, getter))
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+// serviceTestTimer is a timer whose now only moves when a test moves it.
+type serviceTestTimer struct {
+	object.Timer
+	now time.Time
+}
+
+// NowUTC is a function.
+func (timer *serviceTestTimer) NowUTC() time.Time {
+	return timer.now
+}
@@ -0,0 +1,636 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/indicator"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// SizingServicer is an interface.
+	SizingServicer interface {
+		// Size is a function.
+		Size(
+			context.Context,
+			om.Signaler,
+		) (string, error)
+	}
+
+	// GetSizingServicer is an interface.
+	GetSizingServicer interface {
+		// GetSizingServicer is a function.
+		GetSizingServicer() SizingServicer
+	}
+
+	sizingService struct {
+		configConfigger   config.Configger
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+)
+
+var (
+	_ GetServicer           = (*sizingService)(nil)
+	_ SizingServicer        = (*sizingService)(nil)
+	_ WithServicer          = (*sizingService)(nil)
+	_ config.GetConfigger   = (*sizingService)(nil)
+	_ exchange.GetExchanger = (*sizingService)(nil)
+	_ log.GetRuntimeLogger  = (*sizingService)(nil)
+	_ util.GetTracer        = (*sizingService)(nil)
+	_ util.GetUUIDer        = (*sizingService)(nil)
+)
+
+// NewSizingServicer is a function.
+func NewSizingServicer(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) SizingServicer {
+	return &sizingService{
+		configConfigger:   configConfigger,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *sizingService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *sizingService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *sizingService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetTracer is a function.
+func (service *sizingService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *sizingService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *sizingService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *sizingService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// Size is a function.
+// Size turns the signal into an order size in the base currency with the
+// sizing model of its strategy. The capital is the portfolio equity for a
+// symbol quoted in the portfolio currency and the available trade balance of
+// the quote currency otherwise. The size never spends more than the available
+// trade balance, is rounded down to the base increment and is refused with
+// object.ErrSizingServiceBelowMinSize when the symbol would not accept it.
+func (service *sizingService) Size(
+	ctx context.Context,
+	omSignaler om.Signaler,
+) (string, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Size",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":        "Size",
+		"rt_ctx":      utilRuntimeContext,
+		"sp_ctx":      utilSpanContext,
+		"config":      service.configConfigger,
+		"om_signaler": omSignaler,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omSymboler, err := service.GetServicer().
+		GetSymbolServicer().
+		GetBySymbol(ctx, omSignaler.GetSymbol())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolServiceGetBySymbol.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceGetBySymbol.Error())
+
+		return object.URIEmpty, err
+	}
+
+	omTickerer, err := service.GetServicer().
+		GetTickerServicer().
+		GetBySymbol(ctx, omSignaler.GetSymbol())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrTickerServiceGetBySymbol.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrTickerServiceGetBySymbol.Error())
+
+		return object.URIEmpty, err
+	}
+
+	price := omTickerer.GetLast()
+	if price == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrSizingServicePriceNotFound).
+			Error(object.ErrSizingServicePriceNotFound.Error())
+		traceSpan.RecordError(object.ErrSizingServicePriceNotFound)
+		traceSpan.SetStatus(codes.Error, object.ErrSizingServicePriceNotFound.Error())
+
+		return object.URIEmpty, object.ErrSizingServicePriceNotFound
+	}
+
+	capital, err := service.capital(ctx, omSymboler.GetQuoteCurrency())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSizingServiceSize.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSizingServiceSize.Error())
+
+		return object.URIEmpty, err
+	}
+
+	model := object.SizingModelType(service.parameter(
+		omSignaler.GetStrategy(),
+		object.URISizingParameterModel,
+		string(object.SizingModelTypeFixedQuote),
+	))
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldCapital, capital).
+		WithField(object.URIFieldSizingModel, model).
+		Debug(object.URIEmpty)
+
+	var size string
+
+	switch model {
+	case object.SizingModelTypeFixedQuote:
+		size, err = service.sizeFixedQuote(omSignaler, price)
+	case object.SizingModelTypeFixedFraction:
+		size, err = service.sizeFixedFraction(omSignaler, capital, price)
+	case object.SizingModelTypeVolatility:
+		size, err = service.sizeVolatility(ctx, omSignaler, capital)
+	case object.SizingModelTypeKelly:
+		size, err = service.sizeKelly(omSignaler, capital, price)
+	default:
+		err = object.ErrSizingServiceModelNotFound
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSizingServiceSize.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSizingServiceSize.Error())
+
+		return object.URIEmpty, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldSize, size).
+		Debug(object.URIEmpty)
+
+	size, err = service.fit(ctx, omSignaler, omSymboler, size, price)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSizingServiceSize.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSizingServiceSize.Error())
+
+		return object.URIEmpty, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldSize, size).
+		Debug(object.URIEmpty)
+
+	return size, nil
+}
+
+// available is the available trade balance of the currency in the current
+// trading mode.
+func (service *sizingService) available(
+	ctx context.Context,
+	currency string,
+) (string, error) {
+	paper := service.GetConfigger().GetPaperConfigger().GetEnabled()
+	available := "0"
+
+	var daoCursorer dao.Cursorer = dao.NewCursor(0)
+
+	for daoCursorer != nil {
+		omBalancers, daoNextCursorer, err := service.GetServicer().
+			GetBalanceServicer().
+			GetListFromRepository(
+				ctx,
+				dao.NewPagination(daoCursorer, object.NUMPortfolioPageSize),
+				dao.NewBalanceFilter(
+					serviceAccount(ctx, service),
+					currency,
+					object.URIEmpty,
+					object.URIKucoinAccountTypeTrade,
+				),
+			)
+		if err != nil {
+			return object.URIEmpty, err
+		}
+
+		daoCursorer = daoNextCursorer
+
+		for _, omBalancer := range omBalancers {
+			if omBalancer.GetPaper() != paper {
+				continue
+			}
+
+			if available, err = util.DecimalAdd(available, omBalancer.GetAvailable()); err != nil {
+				return object.URIEmpty, err
+			}
+		}
+	}
+
+	return available, nil
+}
+
+// capital is the amount of the quote currency the fraction based models size
+// against.
+func (service *sizingService) capital(
+	ctx context.Context,
+	quote string,
+) (string, error) {
+	if quote != service.GetConfigger().GetPortfolioConfigger().GetCurrency() {
+		return service.available(ctx, quote)
+	}
+
+	omEquitier, err := service.GetServicer().GetPortfolioServicer().GetEquity(ctx)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	return omEquitier.GetEquity(), nil
+}
+
+// fit caps the size at what the available trade balance pays for, or holds
+// for a sell, and at the base max size, then rounds it down to the base
+// increment.
+func (service *sizingService) fit(
+	ctx context.Context,
+	omSignaler om.Signaler,
+	omSymboler om.Symboler,
+	size string,
+	price string,
+) (string, error) {
+	var (
+		available string
+		err       error
+	)
+
+	if omSignaler.GetSide() == string(object.OrderSideTypeSell) {
+		available, err = service.available(ctx, omSymboler.GetBaseCurrency())
+	} else {
+		available, err = service.available(ctx, omSymboler.GetQuoteCurrency())
+		if err == nil {
+			available, err = util.DecimalDivide(available, price, object.URISizingIncrement)
+		}
+	}
+
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	if size, err = sizingServiceMin(size, available); err != nil {
+		return object.URIEmpty, err
+	}
+
+	if omSymboler.GetBaseMaxSize() != object.URIEmpty {
+		if size, err = sizingServiceMin(size, omSymboler.GetBaseMaxSize()); err != nil {
+			return object.URIEmpty, err
+		}
+	}
+
+	if size, err = util.DecimalRound(size, omSymboler.GetBaseIncrement(), false); err != nil {
+		return object.URIEmpty, err
+	}
+
+	compare, err := util.DecimalCompare(size, omSymboler.GetBaseMinSize())
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	if compare < 0 {
+		return object.URIEmpty, object.ErrSizingServiceBelowMinSize
+	}
+
+	if omSymboler.GetMinFunds() == object.URIEmpty {
+		return size, nil
+	}
+
+	funds, err := util.DecimalMultiply(size, price)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	if compare, err = util.DecimalCompare(funds, omSymboler.GetMinFunds()); err != nil {
+		return object.URIEmpty, err
+	}
+
+	if compare < 0 {
+		return object.URIEmpty, object.ErrSizingServiceBelowMinSize
+	}
+
+	return size, nil
+}
+
+// parameter resolves a sizing parameter for the strategy, prefixed by its
+// name and a dot like the strategy parameters, falling back to the parameter
+// without a prefix and then to the default.
+func (service *sizingService) parameter(
+	strategy string,
+	key string,
+	fallback string,
+) string {
+	parameters := service.GetConfigger().GetSizingConfigger().GetParameters()
+
+	if value, ok := parameters[strategy+object.URIStrategyParameterSeparator+key]; ok {
+		return value
+	}
+
+	if value, ok := parameters[key]; ok {
+		return value
+	}
+
+	return fallback
+}
+
+// positive is a parameter that has to be a positive decimal.
+func (service *sizingService) positive(
+	strategy string,
+	key string,
+	fallback string,
+) (string, error) {
+	value := service.parameter(strategy, key, fallback)
+
+	compare, err := util.DecimalCompare(value, "0")
+	if err != nil || compare <= 0 {
+		return object.URIEmpty, object.ErrSizingServiceParameterInvalid
+	}
+
+	return value, nil
+}
+
+// sizeFixedFraction spends a fixed fraction of the capital.
+func (service *sizingService) sizeFixedFraction(
+	omSignaler om.Signaler,
+	capital string,
+	price string,
+) (string, error) {
+	fraction, err := service.positive(
+		omSignaler.GetStrategy(),
+		object.URISizingParameterFraction,
+		object.URIEmpty,
+	)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	quote, err := util.DecimalMultiply(capital, fraction)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	return util.DecimalDivide(quote, price, object.URISizingIncrement)
+}
+
+// sizeFixedQuote spends a fixed amount of the quote currency.
+func (service *sizingService) sizeFixedQuote(
+	omSignaler om.Signaler,
+	price string,
+) (string, error) {
+	quote, err := service.positive(
+		omSignaler.GetStrategy(),
+		object.URISizingParameterQuoteAmount,
+		object.URIEmpty,
+	)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	return util.DecimalDivide(quote, price, object.URISizingIncrement)
+}
+
+// sizeKelly spends the Kelly fraction of the capital for the win rate and the
+// payoff, the average win over the average loss, capped at the kelly cap. A
+// strategy without an edge gets no size.
+func (service *sizingService) sizeKelly(
+	omSignaler om.Signaler,
+	capital string,
+	price string,
+) (string, error) {
+	strategy := omSignaler.GetStrategy()
+
+	winRate, err := service.positive(strategy, object.URISizingParameterWinRate, object.URIEmpty)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	payoff, err := service.positive(strategy, object.URISizingParameterPayoff, object.URIEmpty)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	kellyCap, err := service.positive(
+		strategy,
+		object.URISizingParameterKellyCap,
+		object.URISizingDefaultKellyCap,
+	)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	fraction, err := sizingServiceKelly(winRate, payoff)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	if fraction, err = sizingServiceMin(fraction, kellyCap); err != nil {
+		return object.URIEmpty, err
+	}
+
+	quote, err := util.DecimalMultiply(capital, fraction)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	return util.DecimalDivide(quote, price, object.URISizingIncrement)
+}
+
+// sizeVolatility risks a fixed fraction of the capital on a move of the ATR
+// times the ATR multiplier, so that volatile symbols get smaller sizes. The
+// ATR is Wilder's, over the stored candles that closed before the signal.
+func (service *sizingService) sizeVolatility(
+	ctx context.Context,
+	omSignaler om.Signaler,
+	capital string,
+) (string, error) {
+	strategy := omSignaler.GetStrategy()
+
+	fraction, err := service.positive(strategy, object.URISizingParameterFraction, object.URIEmpty)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	multiplier, err := service.positive(
+		strategy,
+		object.URISizingParameterATRMultiplier,
+		object.URISizingDefaultATRMultiplier,
+	)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	period, err := strconv.ParseInt(service.parameter(
+		strategy,
+		object.URISizingParameterATRPeriod,
+		object.URISizingDefaultATRPeriod,
+	), 10, 64)
+	if err != nil || period <= 0 {
+		return object.URIEmpty, object.ErrSizingServiceParameterInvalid
+	}
+
+	klineType := object.KlineTypeType(service.parameter(
+		strategy,
+		object.URISizingParameterKlineType,
+		string(object.KlineTypeType1hour),
+	))
+	secondKlineType := util.KlineTypeToSecond(klineType)
+	endAt := util.KlineBucketStartAt(klineType, omSignaler.GetTime())
+	startAt := endAt - object.NUMSizingATRWarmup*period*secondKlineType
+
+	values, err := service.GetServicer().
+		GetKlineServicer().
+		GetIndicator(
+			ctx,
+			dto.NewKlineRequest(klineType, omSignaler.GetSymbol(), endAt, startAt),
+			indicator.NewATRIndicator(uint32(period)),
+		)
+	if errors.Is(err, object.ErrKlineServiceGetIndicatorNotReady) {
+		return object.URIEmpty, object.ErrSizingServiceKlineNotFound
+	}
+
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	atr := strconv.FormatFloat(values[0], 'f', -1, 64)
+	if compare, err := util.DecimalCompare(atr, "0"); err != nil || compare <= 0 {
+		return object.URIEmpty, object.ErrSizingServiceKlineNotFound
+	}
+
+	risk, err := util.DecimalMultiply(capital, fraction)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	move, err := util.DecimalMultiply(atr, multiplier)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	return util.DecimalDivide(risk, move, object.URISizingIncrement)
+}
+
+// sizingServiceKelly is the Kelly fraction W - (1 - W) / R, floored at zero.
+func sizingServiceKelly(
+	winRate string,
+	payoff string,
+) (string, error) {
+	lossRate, err := util.DecimalSubtract("1", winRate)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	edge, err := util.DecimalDivide(lossRate, payoff, object.URISizingIncrement)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	fraction, err := util.DecimalSubtract(winRate, edge)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	compare, err := util.DecimalCompare(fraction, "0")
+	if err != nil || compare > 0 {
+		return fraction, err
+	}
+
+	return "0", nil
+}
+
+// sizingServiceMin is the smaller of two decimals.
+func sizingServiceMin(
+	first string,
+	second string,
+) (string, error) {
+	compare, err := util.DecimalCompare(first, second)
+	if err != nil {
+		return object.URIEmpty, err
+	}
+
+	if compare > 0 {
+		return second, nil
+	}
+
+	return first, nil
+}
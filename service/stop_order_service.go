@@ -0,0 +1,2208 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// StopOrderServicer is an interface.
+	StopOrderServicer interface {
+		// Cancel is a function.
+		Cancel(
+			context.Context,
+			uuid.UUID,
+		) (om.StopOrderer, error)
+		// Create is a function.
+		Create(
+			context.Context,
+			om.StopOrderer,
+		) (uuid.UUID, error)
+		// DeleteAll is a function.
+		DeleteAll(
+			context.Context,
+		) (time.Time, error)
+		// Get is a function.
+		Get(
+			context.Context,
+			uuid.UUID,
+		) (om.StopOrderer, error)
+		// GetByClientOID is a function.
+		GetByClientOID(
+			context.Context,
+			string,
+		) (om.StopOrderer, error)
+		// GetListFromRemote is a function.
+		GetListFromRemote(
+			context.Context,
+			dto.OrderRequester,
+			int64,
+		) error
+		// GetListFromRepository is a function.
+		GetListFromRepository(
+			context.Context,
+			dao.Paginationer,
+			dao.StopOrderFilterer,
+		) ([]om.StopOrderer, dao.Cursorer, error)
+		// Place is a function.
+		Place(
+			context.Context,
+			dto.PlaceStopOrderRequester,
+		) (om.StopOrderer, error)
+		// Reconcile is a function.
+		Reconcile(
+			context.Context,
+			om.StopOrderer,
+		) (om.StopOrderer, error)
+		// Sync is a function.
+		Sync(
+			context.Context,
+		) error
+		// Update is a function.
+		Update(
+			context.Context,
+			om.StopOrderer,
+		) (time.Time, error)
+		// Upsert is a function.
+		Upsert(
+			context.Context,
+			om.StopOrderer,
+		) (uuid.UUID, error)
+	}
+
+	// GetStopOrderServicer is an interface.
+	GetStopOrderServicer interface {
+		// GetStopOrderServicer is a function.
+		GetStopOrderServicer() StopOrderServicer
+	}
+
+	stopOrderService struct {
+		configConfigger   config.Configger
+		repositorier      repository.StopOrderRepositorier
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+)
+
+var (
+	_ GetServicer                         = (*stopOrderService)(nil)
+	_ StopOrderServicer                   = (*stopOrderService)(nil)
+	_ WithServicer                        = (*stopOrderService)(nil)
+	_ config.GetConfigger                 = (*stopOrderService)(nil)
+	_ exchange.GetExchanger               = (*stopOrderService)(nil)
+	_ log.GetRuntimeLogger                = (*stopOrderService)(nil)
+	_ repository.GetStopOrderRepositorier = (*stopOrderService)(nil)
+	_ util.GetTracer                      = (*stopOrderService)(nil)
+	_ util.GetUUIDer                      = (*stopOrderService)(nil)
+)
+
+// NewStopOrderServicer is a function.
+func NewStopOrderServicer(
+	configConfigger config.Configger,
+	repositorier repository.StopOrderRepositorier,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) StopOrderServicer {
+	return &stopOrderService{
+		configConfigger:   configConfigger,
+		repositorier:      repositorier,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *stopOrderService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *stopOrderService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *stopOrderService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetStopOrderRepositorier is a function.
+func (service *stopOrderService) GetStopOrderRepositorier() repository.StopOrderRepositorier {
+	return service.repositorier
+}
+
+// GetTracer is a function.
+func (service *stopOrderService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *stopOrderService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *stopOrderService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *stopOrderService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// Create is a function.
+func (service *stopOrderService) Create(
+	ctx context.Context,
+	omStopOrderer om.StopOrderer,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "Create",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_stop_orderer": omStopOrderer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoStopOrder := dao.NewStopOrder(
+		time.Time{},
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		uuid.Nil,
+		omStopOrderer.GetAccount(),
+		omStopOrderer.GetChannel(),
+		omStopOrderer.GetClientOID(),
+		omStopOrderer.GetFeeCurrency(),
+		omStopOrderer.GetFunds(),
+		omStopOrderer.GetKucoinID(),
+		omStopOrderer.GetKucoinType(),
+		omStopOrderer.GetMakerFeeRate(),
+		omStopOrderer.GetPrice(),
+		omStopOrderer.GetRemark(),
+		omStopOrderer.GetSide(),
+		omStopOrderer.GetSize(),
+		omStopOrderer.GetStatus(),
+		omStopOrderer.GetStop(),
+		omStopOrderer.GetStopPrice(),
+		omStopOrderer.GetStopTriggerTime(),
+		omStopOrderer.GetSTP(),
+		omStopOrderer.GetSymbol(),
+		omStopOrderer.GetTags(),
+		omStopOrderer.GetTakerFeeRate(),
+		omStopOrderer.GetTimeInForce(),
+		omStopOrderer.GetTradeType(),
+		omStopOrderer.GetVisibleSize(),
+		omStopOrderer.GetCancelAfter(),
+		omStopOrderer.GetKucoinCreatedAt(),
+		omStopOrderer.GetOrderTime(),
+		omStopOrderer.GetHidden(),
+		omStopOrderer.GetIceBerg(),
+		omStopOrderer.GetIsActive(),
+		omStopOrderer.GetPaper(),
+		omStopOrderer.GetPostOnly(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOStopOrder, daoStopOrder).
+		Debug(object.URIEmpty)
+
+	stopOrderID, err := service.GetStopOrderRepositorier().Create(ctx, daoStopOrder)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldStopOrderID, stopOrderID).
+		Debug(object.URIEmpty)
+
+	return stopOrderID, nil
+}
+
+// DeleteAll is a function.
+func (service *stopOrderService) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	deletedAt, err := service.GetStopOrderRepositorier().DeleteAll(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDeletedAt, deletedAt).
+		Debug(object.URIEmpty)
+
+	return deletedAt, nil
+}
+
+// Get is a function.
+func (service *stopOrderService) Get(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.StopOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Get",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Get",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoStopOrder, err := service.GetStopOrderRepositorier().Read(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOStopOrder, daoStopOrder).
+		Debug(object.URIEmpty)
+
+	omStopOrder := om.NewStopOrder(
+		daoStopOrder.GetAccount(),
+		daoStopOrder.GetChannel(),
+		daoStopOrder.GetClientOID(),
+		daoStopOrder.GetFeeCurrency(),
+		daoStopOrder.GetFunds(),
+		daoStopOrder.GetKucoinID(),
+		daoStopOrder.GetKucoinType(),
+		daoStopOrder.GetMakerFeeRate(),
+		daoStopOrder.GetPrice(),
+		daoStopOrder.GetRemark(),
+		daoStopOrder.GetSide(),
+		daoStopOrder.GetSize(),
+		daoStopOrder.GetStatus(),
+		daoStopOrder.GetStop(),
+		daoStopOrder.GetStopPrice(),
+		daoStopOrder.GetStopTriggerTime(),
+		daoStopOrder.GetSTP(),
+		daoStopOrder.GetSymbol(),
+		daoStopOrder.GetTags(),
+		daoStopOrder.GetTakerFeeRate(),
+		daoStopOrder.GetTimeInForce(),
+		daoStopOrder.GetTradeType(),
+		daoStopOrder.GetVisibleSize(),
+		daoStopOrder.GetCancelAfter(),
+		daoStopOrder.GetKucoinCreatedAt(),
+		daoStopOrder.GetOrderTime(),
+		daoStopOrder.GetHidden(),
+		daoStopOrder.GetIceBerg(),
+		daoStopOrder.GetIsActive(),
+		daoStopOrder.GetPaper(),
+		daoStopOrder.GetPostOnly(),
+		daoStopOrder.GetID(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMStopOrder, omStopOrder).
+		Debug(object.URIEmpty)
+
+	return omStopOrder, nil
+}
+
+// GetListFromRepository is a function.
+func (service *stopOrderService) GetListFromRepository(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoStopOrderFilterer dao.StopOrderFilterer,
+) ([]om.StopOrderer, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRepository",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                    "GetListFromRepository",
+		"rt_ctx":                  utilRuntimeContext,
+		"sp_ctx":                  utilSpanContext,
+		"config":                  service.configConfigger,
+		"dao_paginationer":        daoPaginationer,
+		"dao_stop_order_filterer": daoStopOrderFilterer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoStopOrders, daoCursorer, err := service.GetStopOrderRepositorier().
+		ReadList(ctx, daoPaginationer, daoStopOrderFilterer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOStopOrders, daoStopOrders).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	omStopOrders := make([]om.StopOrderer, 0, len(daoStopOrders))
+
+	for key, daoStopOrder := range daoStopOrders {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldDAOStopOrder, daoStopOrder).
+			Debug(object.URIEmpty)
+
+		omStopOrders = append(omStopOrders, om.NewStopOrder(
+			daoStopOrder.GetAccount(),
+			daoStopOrder.GetChannel(),
+			daoStopOrder.GetClientOID(),
+			daoStopOrder.GetFeeCurrency(),
+			daoStopOrder.GetFunds(),
+			daoStopOrder.GetKucoinID(),
+			daoStopOrder.GetKucoinType(),
+			daoStopOrder.GetMakerFeeRate(),
+			daoStopOrder.GetPrice(),
+			daoStopOrder.GetRemark(),
+			daoStopOrder.GetSide(),
+			daoStopOrder.GetSize(),
+			daoStopOrder.GetStatus(),
+			daoStopOrder.GetStop(),
+			daoStopOrder.GetStopPrice(),
+			daoStopOrder.GetStopTriggerTime(),
+			daoStopOrder.GetSTP(),
+			daoStopOrder.GetSymbol(),
+			daoStopOrder.GetTags(),
+			daoStopOrder.GetTakerFeeRate(),
+			daoStopOrder.GetTimeInForce(),
+			daoStopOrder.GetTradeType(),
+			daoStopOrder.GetVisibleSize(),
+			daoStopOrder.GetCancelAfter(),
+			daoStopOrder.GetKucoinCreatedAt(),
+			daoStopOrder.GetOrderTime(),
+			daoStopOrder.GetHidden(),
+			daoStopOrder.GetIceBerg(),
+			daoStopOrder.GetIsActive(),
+			daoStopOrder.GetPaper(),
+			daoStopOrder.GetPostOnly(),
+			daoStopOrder.GetID(),
+		))
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMStopOrders, omStopOrders).
+		Debug(object.URIEmpty)
+
+	return omStopOrders, daoCursorer, nil
+}
+
+// GetListFromRemote is a function.
+// The stop orders the exchange still holds are upserted, so a stop order placed
+// elsewhere is tracked as well.
+func (service *stopOrderService) GetListFromRemote(
+	ctx context.Context,
+	dtoOrderRequester dto.OrderRequester,
+	currentPage int64,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRemote",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                "GetListFromRemote",
+		"rt_ctx":              utilRuntimeContext,
+		"sp_ctx":              utilSpanContext,
+		"config":              service.configConfigger,
+		"dto_order_requester": dtoOrderRequester,
+		"current_page":        currentPage,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	params := map[string]string{}
+
+	for key, value := range dtoOrderRequester.GetMap() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		if newValue, ok := value.(string); ok && value != object.URIEmpty {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`newValue, ok := value.(string); ok && value != object.URIEmpty`)
+
+			params[key] = newValue
+		}
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldParams, params).
+		Debug(object.URIEmpty)
+
+	kucoinPaginationParam := &kucoin.PaginationParam{
+		CurrentPage: currentPage,
+		PageSize:    service.GetConfigger().GetRuntimeConfigger().GetKucoinPaginationRequestSize(),
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinPaginationParam, kucoinPaginationParam).
+		Debug(object.URIEmpty)
+
+	response, err := serviceExchanger(ctx, service).StopOrders(params, kucoinPaginationParam)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderKucoinServiceGetList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderKucoinServiceGetList.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, errResponse).
+			Error(object.ErrStopOrderKucoinServiceGetList.Error())
+		traceSpan.RecordError(errResponse)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderKucoinServiceGetList.Error())
+
+		return fmt.Errorf("%w: %w", object.ErrStopOrderKucoinServiceGetList, errResponse)
+	}
+
+	kucoinPaginationModel, err := response.ReadPaginationData(&kucoin.StopOrderListModel{})
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadPaginationData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadPaginationData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinPaginationModel, kucoinPaginationModel).
+		Debug(object.URIEmpty)
+
+	kucoinStopOrderListModel := &kucoin.StopOrderListModel{}
+
+	if err = json.Unmarshal(
+		kucoinPaginationModel.RawItems,
+		kucoinStopOrderListModel,
+	); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrUnmarshalJSON.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrUnmarshalJSON.Error())
+
+		return err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinStopOrderListModel, kucoinStopOrderListModel).
+		Debug(object.URIEmpty)
+
+	for key, value := range *kucoinStopOrderListModel {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		stopOrderID, errStopOrderUpsert := service.GetServicer().
+			GetStopOrderServicer().
+			Upsert(ctx, stopOrderServiceStopOrderFromModel(
+				serviceAccount(ctx, service),
+				value,
+				service.GetConfigger().GetPaperConfigger().GetEnabled(),
+				uuid.Nil,
+			))
+		if errStopOrderUpsert != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errStopOrderUpsert).
+				Error(object.ErrStopOrderServiceUpsert.Error())
+			traceSpan.RecordError(errStopOrderUpsert)
+			traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceUpsert.Error())
+
+			return errStopOrderUpsert
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldStopOrderID, stopOrderID).
+			Debug(object.URIEmpty)
+	}
+
+	if kucoinPaginationModel.CurrentPage < kucoinPaginationModel.TotalPage {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`kucoinPaginationModel.CurrentPage < kucoinPaginationModel.TotalPage`)
+
+		if err = service.GetServicer().
+			GetStopOrderServicer().
+			GetListFromRemote(ctx, dtoOrderRequester, currentPage+1); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrStopOrderServiceGetListFromRemote.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceGetListFromRemote.Error())
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Cancel is a function.
+// A stop order the exchange has not acknowledged yet is cancelled by its clientOid.
+func (service *stopOrderService) Cancel(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.StopOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Cancel",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Cancel",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omStopOrderer, err := service.GetServicer().GetStopOrderServicer().Get(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServiceGet.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceGet.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMStopOrder, omStopOrderer).
+		Debug(object.URIEmpty)
+
+	kucoinID := omStopOrderer.GetKucoinID()
+
+	var response *kucoin.ApiResponse
+
+	if kucoinID == omStopOrderer.GetClientOID() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`kucoinID == omStopOrderer.GetClientOID()`)
+
+		response, err = serviceExchanger(ctx, service).
+			CancelStopOrderByClient(omStopOrderer.GetClientOID(), map[string]string{})
+		if exchange.NewResponseError(response, err) == nil {
+			kucoinCancelStopOrderByClientModel := &kucoin.CancelStopOrderByClientModel{}
+			if errReadData := response.ReadData(
+				kucoinCancelStopOrderByClientModel,
+			); errReadData != nil {
+				service.GetRuntimeLogger().
+					WithFields(fields).
+					WithField(object.URIFieldError, errReadData).
+					Error(object.ErrKucoinServiceReadData.Error())
+			}
+
+			if kucoinCancelStopOrderByClientModel.CancelledOrderId != object.URIEmpty {
+				kucoinID = kucoinCancelStopOrderByClientModel.CancelledOrderId
+			}
+		}
+	} else {
+		response, err = serviceExchanger(ctx, service).CancelStopOrder(kucoinID)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if err = orderServiceResponseError(omStopOrderer.GetClientOID(), response, err); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderKucoinServiceCancel.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderKucoinServiceCancel.Error())
+
+		return nil, err
+	}
+
+	omStopOrderer = stopOrderServiceStopOrderWith(
+		omStopOrderer,
+		kucoinID,
+		omStopOrderer.GetStatus(),
+		false,
+	)
+
+	updatedAt, err := service.GetServicer().GetStopOrderServicer().Update(ctx, omStopOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceUpdate.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMStopOrder, omStopOrderer).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return omStopOrderer, nil
+}
+
+// GetByClientOID is a function.
+func (service *stopOrderService) GetByClientOID(
+	ctx context.Context,
+	clientOID string,
+) (om.StopOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetByClientOID",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":       "GetByClientOID",
+		"rt_ctx":     utilRuntimeContext,
+		"sp_ctx":     utilSpanContext,
+		"config":     service.configConfigger,
+		"client_oid": clientOID,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omStopOrderers, _, err := service.GetServicer().GetStopOrderServicer().GetListFromRepository(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewStopOrderFilter(
+			serviceAccount(ctx, service),
+			clientOID,
+			object.URIEmpty,
+			object.URIEmpty,
+			false,
+		),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderRepositoryReadList.Error())
+
+		return nil, err
+	}
+
+	if len(omStopOrderers) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrStopOrderNotFound).
+			Error(object.ErrStopOrderServiceGetByClientOID.Error())
+		traceSpan.RecordError(object.ErrStopOrderNotFound)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceGetByClientOID.Error())
+
+		return nil, object.ErrStopOrderNotFound
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMStopOrder, omStopOrderers[0]).
+		Debug(object.URIEmpty)
+
+	return omStopOrderers[0], nil
+}
+
+// Place is a function.
+// The stop order is checked against the rules of its symbol, stored before it is
+// submitted and reconciled after, and a stop order whose clientOid is already
+// known to the exchange is never submitted again. An empty clientOid is derived
+// from the request.
+func (service *stopOrderService) Place(
+	ctx context.Context,
+	dtoPlaceStopOrderRequester dto.PlaceStopOrderRequester,
+) (om.StopOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Place",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                           "Place",
+		"rt_ctx":                         utilRuntimeContext,
+		"sp_ctx":                         utilSpanContext,
+		"config":                         service.configConfigger,
+		"dto_place_stop_order_requester": dtoPlaceStopOrderRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if dtoPlaceStopOrderRequester.GetClientOID() == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`dtoPlaceStopOrderRequester.GetClientOID() == object.URIEmpty`)
+
+		dtoPlaceStopOrderRequester = stopOrderServicePlaceStopOrderRequestWithClientOID(
+			dtoPlaceStopOrderRequester,
+		)
+	}
+
+	dtoPlaceStopOrderRequester, err := service.GetServicer().
+		GetSymbolServicer().
+		ValidateStop(ctx, dtoPlaceStopOrderRequester)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolServiceValidateStop.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceValidateStop.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDTOPlaceStopOrderRequest, dtoPlaceStopOrderRequester).
+		Debug(object.URIEmpty)
+
+	omStopOrderer, submit, err := service.prepare(ctx, dtoPlaceStopOrderRequester)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServicePlace.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServicePlace.Error())
+
+		return nil, err
+	}
+
+	if !submit {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`!submit`)
+
+		return omStopOrderer, nil
+	}
+
+	kucoinCreateOrderModel := orderServiceCreateOrderModel(dtoPlaceStopOrderRequester)
+	kucoinCreateOrderModel.Stop = string(dtoPlaceStopOrderRequester.GetStop())
+	kucoinCreateOrderModel.StopPrice = dtoPlaceStopOrderRequester.GetStopPrice()
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinCreateOrderModel, kucoinCreateOrderModel).
+		Debug(object.URIEmpty)
+
+	response, err := serviceExchanger(ctx, service).CreateStopOrder(kucoinCreateOrderModel)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	kucoinCreateOrderResultModel := &kucoin.CreateOrderResultModel{}
+
+	errSubmit := orderServiceResponseError(kucoinCreateOrderModel.ClientOid, response, err)
+	if errSubmit == nil {
+		if err = response.ReadData(kucoinCreateOrderResultModel); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrKucoinServiceReadData.Error())
+		}
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinCreateOrderResultModel, kucoinCreateOrderResultModel).
+		Debug(object.URIEmpty)
+
+	omStopOrderer, err = service.settle(
+		ctx,
+		omStopOrderer,
+		kucoinCreateOrderResultModel.OrderId,
+		errSubmit,
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderKucoinServiceCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderKucoinServiceCreate.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMStopOrder, omStopOrderer).
+		Debug(object.URIEmpty)
+
+	return omStopOrderer, nil
+}
+
+// Reconcile is a function.
+// A stop order the exchange still holds overwrites the stored one. Once it has
+// triggered, the exchange reports it as an order under the same id, so it is
+// moved into the orders and removed from the stop orders; when neither knows it,
+// it was cancelled.
+func (service *stopOrderService) Reconcile(
+	ctx context.Context,
+	omStopOrderer om.StopOrderer,
+) (om.StopOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Reconcile",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "Reconcile",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_stop_orderer": omStopOrderer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	pending := omStopOrderer.GetKucoinID() == omStopOrderer.GetClientOID()
+
+	kucoinStopOrderModel, err := service.remote(ctx, omStopOrderer, pending)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderKucoinServiceGet.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderKucoinServiceGet.Error())
+
+		return nil, err
+	}
+
+	if kucoinStopOrderModel != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`kucoinStopOrderModel != nil`)
+
+		omStopOrder := stopOrderServiceStopOrderFromModel(
+			omStopOrderer.GetAccount(),
+			kucoinStopOrderModel,
+			omStopOrderer.GetPaper(),
+			omStopOrderer.GetID(),
+		)
+
+		updatedAt, errStopOrderUpdate := service.GetServicer().
+			GetStopOrderServicer().
+			Update(ctx, omStopOrder)
+		if errStopOrderUpdate != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errStopOrderUpdate).
+				Error(object.ErrStopOrderServiceUpdate.Error())
+			traceSpan.RecordError(errStopOrderUpdate)
+			traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceUpdate.Error())
+
+			return nil, errStopOrderUpdate
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldUpdatedAt, updatedAt).
+			Debug(object.URIEmpty)
+
+		return omStopOrder, nil
+	}
+
+	var response *kucoin.ApiResponse
+
+	if pending {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`pending`)
+
+		response, err = serviceExchanger(ctx, service).OrderByClient(omStopOrderer.GetClientOID())
+	} else {
+		response, err = serviceExchanger(ctx, service).Order(omStopOrderer.GetKucoinID())
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	kucoinOrderModel := &kucoin.OrderModel{}
+
+	err = orderServiceResponseError(omStopOrderer.GetClientOID(), response, err)
+	if err == nil {
+		if err = response.ReadData(kucoinOrderModel); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrKucoinServiceReadData.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	if err != nil && !errors.Is(err, object.ErrOrderRejected) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderKucoinServiceGet.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderKucoinServiceGet.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinOrderModel, kucoinOrderModel).
+		Debug(object.URIEmpty)
+
+	if kucoinOrderModel.Id == object.URIEmpty && pending {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrStopOrderNotFound).
+			Error(object.ErrStopOrderKucoinServiceGet.Error())
+		traceSpan.RecordError(object.ErrStopOrderNotFound)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderKucoinServiceGet.Error())
+
+		return nil, object.ErrStopOrderNotFound
+	}
+
+	if kucoinOrderModel.Id == object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`kucoinOrderModel.Id == object.URIEmpty`)
+
+		omStopOrder := stopOrderServiceStopOrderWith(
+			omStopOrderer,
+			omStopOrderer.GetKucoinID(),
+			omStopOrderer.GetStatus(),
+			false,
+		)
+
+		updatedAt, errStopOrderUpdate := service.GetServicer().
+			GetStopOrderServicer().
+			Update(ctx, omStopOrder)
+		if errStopOrderUpdate != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errStopOrderUpdate).
+				Error(object.ErrStopOrderServiceUpdate.Error())
+			traceSpan.RecordError(errStopOrderUpdate)
+			traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceUpdate.Error())
+
+			return nil, errStopOrderUpdate
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldUpdatedAt, updatedAt).
+			Debug(object.URIEmpty)
+
+		return omStopOrder, nil
+	}
+
+	orderID, err := service.GetServicer().
+		GetOrderServicer().
+		Upsert(ctx, orderServiceOrderFromModel(
+			serviceAccount(ctx, service),
+			kucoinOrderModel,
+			omStopOrderer.GetPaper(),
+			uuid.Nil,
+		))
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderServiceUpsert.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderServiceUpsert.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOrderID, orderID).
+		Debug(object.URIEmpty)
+
+	deletedAt, err := service.GetStopOrderRepositorier().Delete(ctx, omStopOrderer.GetID())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderRepositoryDelete.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderRepositoryDelete.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDeletedAt, deletedAt).
+		Debug(object.URIEmpty)
+
+	return stopOrderServiceStopOrderWith(
+		omStopOrderer,
+		kucoinOrderModel.Id,
+		object.URIKucoinStopOrderStatusTriggered,
+		false,
+	), nil
+}
+
+// Sync is a function.
+// The stop orders the exchange holds are upserted first, then every stored
+// active stop order is reconciled, which moves the triggered ones into the
+// orders.
+func (service *stopOrderService) Sync(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Sync",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Sync",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if err := service.GetServicer().GetStopOrderServicer().GetListFromRemote(
+		ctx,
+		dto.NewOrderRequest(
+			object.URIEmpty,
+			object.OrderTypeType(object.URIEmpty),
+			object.OrderSideType(object.URIEmpty),
+			object.URIEmpty,
+			object.OrderStateType(object.URIEmpty),
+			object.URIEmpty,
+			object.OrderTypeType(object.URIEmpty),
+		),
+		1,
+	); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServiceGetListFromRemote.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceGetListFromRemote.Error())
+
+		return err
+	}
+
+	omActiveStopOrderers := make([]om.StopOrderer, 0)
+	errs := make([]error, 0)
+
+	// Reconciled stop orders leave the active filter, so every page is read
+	// before any stop order is reconciled.
+	var daoCursorer dao.Cursorer = dao.NewCursor(0)
+
+	for daoCursorer != nil {
+		omStopOrderersPage, daoNextCursorer, errGetList := service.GetServicer().
+			GetStopOrderServicer().
+			GetListFromRepository(
+				ctx,
+				dao.NewPagination(daoCursorer, object.NUMKucoinRecentOrderCount),
+				dao.NewStopOrderFilter(
+					serviceAccount(ctx, service),
+					object.URIEmpty,
+					object.URIEmpty,
+					object.URIEmpty,
+					true,
+				),
+			)
+		if errGetList != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errGetList).
+				Error(object.ErrStopOrderRepositoryReadList.Error())
+			traceSpan.RecordError(errGetList)
+			traceSpan.SetStatus(codes.Error, object.ErrStopOrderRepositoryReadList.Error())
+
+			return errGetList
+		}
+
+		daoCursorer = daoNextCursorer
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMStopOrders, omStopOrderersPage).
+			WithField(object.URIFieldDAOCursor, daoCursorer).
+			Debug(object.URIEmpty)
+
+		omActiveStopOrderers = append(omActiveStopOrderers, omStopOrderersPage...)
+	}
+
+	for _, omStopOrderer := range omActiveStopOrderers {
+		omReconciledStopOrderer, errReconcile := service.GetServicer().
+			GetStopOrderServicer().
+			Reconcile(ctx, omStopOrderer)
+		if errReconcile != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errReconcile).
+				Error(object.ErrStopOrderServiceReconcile.Error())
+
+			errs = append(errs, errReconcile)
+
+			continue
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMStopOrder, omReconciledStopOrderer).
+			Debug(object.URIEmpty)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServiceSync.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceSync.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// Update is a function.
+func (service *stopOrderService) Update(
+	ctx context.Context,
+	omStopOrderer om.StopOrderer,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Update",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "Update",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_stop_orderer": omStopOrderer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoStopOrderer, err := service.GetStopOrderRepositorier().Read(ctx, omStopOrderer.GetID())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderRepositoryRead.Error())
+
+		return time.Time{}, err
+	}
+
+	daoStopOrder := stopOrderServiceDAOStopOrder(
+		daoStopOrderer.GetCreatedAt(),
+		daoStopOrderer.GetDeletedAt(),
+		omStopOrderer.GetID(),
+		omStopOrderer,
+		omStopOrderer.GetPaper(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOStopOrder, daoStopOrder).
+		Debug(object.URIEmpty)
+
+	updatedAt, err := service.GetStopOrderRepositorier().Update(ctx, daoStopOrder)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderRepositoryUpdate.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return updatedAt, nil
+}
+
+// Upsert is a function.
+// The stop order is matched on its kucoin id, or on its clientOid while the
+// stored one still waits for the exchange to acknowledge it; the stored row keeps
+// its id and paper flag.
+func (service *stopOrderService) Upsert(
+	ctx context.Context,
+	omStopOrderer om.StopOrderer,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Upsert",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "Upsert",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_stop_orderer": omStopOrderer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoStopOrders, _, err := service.GetStopOrderRepositorier().ReadList(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewStopOrderFilter(
+			omStopOrderer.GetAccount(),
+			object.URIEmpty,
+			omStopOrderer.GetKucoinID(),
+			object.URIEmpty,
+			false,
+		),
+	)
+	if err == nil && len(daoStopOrders) == 0 && omStopOrderer.GetClientOID() != object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`err == nil && len(daoStopOrders) == 0 && omStopOrderer.GetClientOID() != object.URIEmpty`)
+
+		daoStopOrders, _, err = service.GetStopOrderRepositorier().ReadList(
+			ctx,
+			dao.NewPagination(dao.NewCursor(0), 1),
+			dao.NewStopOrderFilter(
+				omStopOrderer.GetAccount(),
+				omStopOrderer.GetClientOID(),
+				object.URIEmpty,
+				object.URIEmpty,
+				false,
+			),
+		)
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderRepositoryReadList.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOStopOrders, daoStopOrders).
+		Debug(object.URIEmpty)
+
+	if len(daoStopOrders) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(daoStopOrders) == 0`)
+
+		return service.GetServicer().GetStopOrderServicer().Create(ctx, omStopOrderer)
+	}
+
+	daoStopOrder := stopOrderServiceDAOStopOrder(
+		daoStopOrders[0].GetCreatedAt(),
+		daoStopOrders[0].GetDeletedAt(),
+		daoStopOrders[0].GetID(),
+		omStopOrderer,
+		daoStopOrders[0].GetPaper(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOStopOrder, daoStopOrder).
+		Debug(object.URIEmpty)
+
+	updatedAt, err := service.GetStopOrderRepositorier().Update(ctx, daoStopOrder)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderRepositoryUpdate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return daoStopOrder.GetID(), nil
+}
+
+func (service *stopOrderService) prepare(
+	ctx context.Context,
+	dtoPlaceStopOrderRequester dto.PlaceStopOrderRequester,
+) (om.StopOrderer, bool, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"prepare",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                           "prepare",
+		"rt_ctx":                         utilRuntimeContext,
+		"sp_ctx":                         utilSpanContext,
+		"config":                         service.configConfigger,
+		"dto_place_stop_order_requester": dtoPlaceStopOrderRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	paper := service.GetConfigger().GetPaperConfigger().GetEnabled()
+
+	omStopOrderer, err := service.GetServicer().
+		GetStopOrderServicer().
+		GetByClientOID(ctx, dtoPlaceStopOrderRequester.GetClientOID())
+	if errors.Is(err, object.ErrStopOrderNotFound) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(err, object.ErrStopOrderNotFound)`)
+
+		errRiskCheck := service.GetServicer().
+			GetRiskServicer().
+			CheckStop(ctx, dtoPlaceStopOrderRequester)
+		if errRiskCheck != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errRiskCheck).
+				Error(object.ErrRiskServiceCheckStop.Error())
+			traceSpan.RecordError(errRiskCheck)
+			traceSpan.SetStatus(codes.Error, object.ErrRiskServiceCheckStop.Error())
+
+			return nil, false, errRiskCheck
+		}
+
+		stopOrderID, errStopOrderCreate := service.GetServicer().
+			GetStopOrderServicer().
+			Create(ctx, stopOrderServiceStopOrderFromPlaceStopOrderRequest(
+				serviceAccount(ctx, service),
+				dtoPlaceStopOrderRequester,
+				paper,
+				uuid.Nil,
+			))
+		if errStopOrderCreate != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errStopOrderCreate).
+				Error(object.ErrStopOrderServiceCreate.Error())
+			traceSpan.RecordError(errStopOrderCreate)
+			traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceCreate.Error())
+
+			return nil, false, errStopOrderCreate
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldStopOrderID, stopOrderID).
+			Debug(object.URIEmpty)
+
+		return stopOrderServiceStopOrderFromPlaceStopOrderRequest(
+			serviceAccount(ctx, service),
+			dtoPlaceStopOrderRequester,
+			paper,
+			stopOrderID,
+		), true, nil
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServiceGetByClientOID.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceGetByClientOID.Error())
+
+		return nil, false, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMStopOrder, omStopOrderer).
+		Debug(object.URIEmpty)
+
+	if omStopOrderer.GetKucoinID() != omStopOrderer.GetClientOID() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omStopOrderer.GetKucoinID() != omStopOrderer.GetClientOID()`)
+
+		return omStopOrderer, false, nil
+	}
+
+	omReconciledStopOrderer, err := service.GetServicer().
+		GetStopOrderServicer().
+		Reconcile(ctx, omStopOrderer)
+	if err == nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMStopOrder, omReconciledStopOrderer).
+			Debug(`err == nil`)
+
+		return omReconciledStopOrderer, false, nil
+	}
+
+	if !errors.Is(err, object.ErrStopOrderNotFound) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServiceReconcile.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceReconcile.Error())
+
+		return nil, false, err
+	}
+
+	errRiskCheck := service.GetServicer().
+		GetRiskServicer().
+		CheckStop(ctx, dtoPlaceStopOrderRequester)
+	if errRiskCheck != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, errRiskCheck).
+			Error(object.ErrRiskServiceCheckStop.Error())
+		traceSpan.RecordError(errRiskCheck)
+		traceSpan.SetStatus(codes.Error, object.ErrRiskServiceCheckStop.Error())
+
+		return nil, false, errRiskCheck
+	}
+
+	omStopOrderer = stopOrderServiceStopOrderFromPlaceStopOrderRequest(
+		serviceAccount(ctx, service),
+		dtoPlaceStopOrderRequester,
+		paper,
+		omStopOrderer.GetID(),
+	)
+
+	updatedAt, err := service.GetServicer().GetStopOrderServicer().Update(ctx, omStopOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceUpdate.Error())
+
+		return nil, false, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return omStopOrderer, true, nil
+}
+
+// remote returns the stop order the exchange still holds, or nil once the
+// exchange no longer knows it as a stop order.
+func (service *stopOrderService) remote(
+	ctx context.Context,
+	omStopOrderer om.StopOrderer,
+	pending bool,
+) (*kucoin.StopOrderModel, error) {
+	var traceSpan trace.Span
+
+	_, traceSpan = service.GetTracer().Start(
+		ctx,
+		"remote",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "remote",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_stop_orderer": omStopOrderer,
+		"pending":         pending,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if pending {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`pending`)
+
+		response, err := serviceExchanger(ctx, service).
+			StopOrderByClient(omStopOrderer.GetClientOID(), map[string]string{})
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldResponse, response).
+			Debug(object.URIEmpty)
+
+		if err = orderServiceResponseError(
+			omStopOrderer.GetClientOID(),
+			response,
+			err,
+		); err != nil {
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrStopOrderKucoinServiceGet.Error())
+
+			return nil, err
+		}
+
+		kucoinStopOrderListModel := kucoin.StopOrderListModel{}
+		if err = response.ReadData(&kucoinStopOrderListModel); err != nil {
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKucoinStopOrderListModel, kucoinStopOrderListModel).
+			Debug(object.URIEmpty)
+
+		if len(kucoinStopOrderListModel) == 0 {
+			return nil, nil
+		}
+
+		return kucoinStopOrderListModel[0], nil
+	}
+
+	response, err := serviceExchanger(ctx, service).StopOrder(omStopOrderer.GetKucoinID())
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if err = orderServiceResponseError(omStopOrderer.GetClientOID(), response, err); err != nil {
+		if errors.Is(err, object.ErrOrderRejected) {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`errors.Is(err, object.ErrOrderRejected)`)
+
+			return nil, nil
+		}
+
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderKucoinServiceGet.Error())
+
+		return nil, err
+	}
+
+	kucoinStopOrderModel := &kucoin.StopOrderModel{}
+	if err = response.ReadData(kucoinStopOrderModel); err != nil {
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinStopOrderModel, kucoinStopOrderModel).
+		Debug(object.URIEmpty)
+
+	if kucoinStopOrderModel.Id == object.URIEmpty ||
+		kucoinStopOrderModel.Status == object.URIKucoinStopOrderStatusTriggered {
+		return nil, nil
+	}
+
+	return kucoinStopOrderModel, nil
+}
+
+func (service *stopOrderService) settle(
+	ctx context.Context,
+	omStopOrderer om.StopOrderer,
+	kucoinID string,
+	errSubmit error,
+) (om.StopOrderer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"settle",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":            "settle",
+		"rt_ctx":          utilRuntimeContext,
+		"sp_ctx":          utilSpanContext,
+		"config":          service.configConfigger,
+		"om_stop_orderer": omStopOrderer,
+		"kucoin_id":       kucoinID,
+		"err_submit":      errSubmit,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if errors.Is(errSubmit, object.ErrOrderRejected) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(errSubmit, object.ErrOrderRejected)`)
+
+		updatedAt, err := service.GetServicer().
+			GetStopOrderServicer().
+			Update(ctx, stopOrderServiceStopOrderWith(
+				omStopOrderer,
+				omStopOrderer.GetKucoinID(),
+				omStopOrderer.GetStatus(),
+				false,
+			))
+		if err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrStopOrderServiceUpdate.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceUpdate.Error())
+
+			return nil, errors.Join(errSubmit, err)
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldUpdatedAt, updatedAt).
+			Debug(object.URIEmpty)
+
+		traceSpan.RecordError(errSubmit)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderRejected.Error())
+
+		return nil, errSubmit
+	}
+
+	if errSubmit == nil && kucoinID != object.URIEmpty {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errSubmit == nil && kucoinID != object.URIEmpty`)
+
+		omStopOrderer = stopOrderServiceStopOrderWith(
+			omStopOrderer,
+			kucoinID,
+			omStopOrderer.GetStatus(),
+			true,
+		)
+	}
+
+	omReconciledStopOrderer, err := service.GetServicer().
+		GetStopOrderServicer().
+		Reconcile(ctx, omStopOrderer)
+	if err == nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMStopOrder, omReconciledStopOrderer).
+			Debug(`err == nil`)
+
+		return omReconciledStopOrderer, nil
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldError, err).
+		Error(object.ErrStopOrderServiceReconcile.Error())
+
+	if errSubmit != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errSubmit != nil`)
+
+		traceSpan.RecordError(errSubmit)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderUnknown.Error())
+
+		return nil, errSubmit
+	}
+
+	if omStopOrderer.GetKucoinID() == omStopOrderer.GetClientOID() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`omStopOrderer.GetKucoinID() == omStopOrderer.GetClientOID()`)
+
+		err = object.NewOrderUnknownError(omStopOrderer.GetClientOID(), object.URIEmpty, err.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderUnknown.Error())
+
+		return nil, err
+	}
+
+	updatedAt, err := service.GetServicer().GetStopOrderServicer().Update(ctx, omStopOrderer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStopOrderServiceUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStopOrderServiceUpdate.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return omStopOrderer, nil
+}
+
+func stopOrderServiceDAOStopOrder(
+	createdAt time.Time,
+	deletedAt sql.NullTime,
+	id uuid.UUID,
+	omStopOrderer om.StopOrderer,
+	paper bool,
+) dao.StopOrderer {
+	return dao.NewStopOrder(
+		createdAt,
+		time.Time{},
+		deletedAt,
+		id,
+		omStopOrderer.GetAccount(),
+		omStopOrderer.GetChannel(),
+		omStopOrderer.GetClientOID(),
+		omStopOrderer.GetFeeCurrency(),
+		omStopOrderer.GetFunds(),
+		omStopOrderer.GetKucoinID(),
+		omStopOrderer.GetKucoinType(),
+		omStopOrderer.GetMakerFeeRate(),
+		omStopOrderer.GetPrice(),
+		omStopOrderer.GetRemark(),
+		omStopOrderer.GetSide(),
+		omStopOrderer.GetSize(),
+		omStopOrderer.GetStatus(),
+		omStopOrderer.GetStop(),
+		omStopOrderer.GetStopPrice(),
+		omStopOrderer.GetStopTriggerTime(),
+		omStopOrderer.GetSTP(),
+		omStopOrderer.GetSymbol(),
+		omStopOrderer.GetTags(),
+		omStopOrderer.GetTakerFeeRate(),
+		omStopOrderer.GetTimeInForce(),
+		omStopOrderer.GetTradeType(),
+		omStopOrderer.GetVisibleSize(),
+		omStopOrderer.GetCancelAfter(),
+		omStopOrderer.GetKucoinCreatedAt(),
+		omStopOrderer.GetOrderTime(),
+		omStopOrderer.GetHidden(),
+		omStopOrderer.GetIceBerg(),
+		omStopOrderer.GetIsActive(),
+		paper,
+		omStopOrderer.GetPostOnly(),
+	)
+}
+
+// stopOrderServiceStopOrderFromModel builds the stop order the way the exchange
+// reports it while it waits for its stop price.
+func stopOrderServiceStopOrderFromModel(
+	account string,
+	kucoinStopOrderModel *kucoin.StopOrderModel,
+	paper bool,
+	id uuid.UUID,
+) om.StopOrderer {
+	return om.NewStopOrder(
+		account,
+		kucoinStopOrderModel.Channel,
+		kucoinStopOrderModel.ClientOid,
+		kucoinStopOrderModel.FeeCurrency,
+		kucoinStopOrderModel.Funds,
+		kucoinStopOrderModel.Id,
+		kucoinStopOrderModel.Type,
+		kucoinStopOrderModel.MakerFeeRate,
+		kucoinStopOrderModel.Price,
+		kucoinStopOrderModel.Remark,
+		kucoinStopOrderModel.Side,
+		kucoinStopOrderModel.Size,
+		kucoinStopOrderModel.Status,
+		kucoinStopOrderModel.Stop,
+		kucoinStopOrderModel.StopPrice,
+		kucoinStopOrderModel.StopTriggerTime,
+		kucoinStopOrderModel.Stp,
+		kucoinStopOrderModel.Symbol,
+		kucoinStopOrderModel.Tags,
+		kucoinStopOrderModel.TakerFeeRate,
+		kucoinStopOrderModel.TimeInForce,
+		kucoinStopOrderModel.TradeType,
+		kucoinStopOrderModel.VisibleSize,
+		kucoinStopOrderModel.CancelAfter,
+		kucoinStopOrderModel.CreatedAt,
+		kucoinStopOrderModel.OrderTime,
+		kucoinStopOrderModel.Hidden,
+		kucoinStopOrderModel.IceBerg,
+		true,
+		paper,
+		kucoinStopOrderModel.PostOnly,
+		id,
+	)
+}
+
+// stopOrderServiceStopOrderFromPlaceStopOrderRequest builds the pending stop order.
+// Until the exchange assigns an id, the clientOid stands in for the kucoin id.
+func stopOrderServiceStopOrderFromPlaceStopOrderRequest(
+	account string,
+	dtoPlaceStopOrderRequester dto.PlaceStopOrderRequester,
+	paper bool,
+	id uuid.UUID,
+) om.StopOrderer {
+	return om.NewStopOrder(
+		account,
+		object.URIKucoinOrderChannelAPI,
+		dtoPlaceStopOrderRequester.GetClientOID(),
+		object.URIEmpty,
+		dtoPlaceStopOrderRequester.GetFunds(),
+		dtoPlaceStopOrderRequester.GetClientOID(),
+		string(dtoPlaceStopOrderRequester.GetOrderType()),
+		object.URIEmpty,
+		dtoPlaceStopOrderRequester.GetPrice(),
+		dtoPlaceStopOrderRequester.GetRemark(),
+		string(dtoPlaceStopOrderRequester.GetSide()),
+		dtoPlaceStopOrderRequester.GetSize(),
+		object.URIKucoinStopOrderStatusNew,
+		string(dtoPlaceStopOrderRequester.GetStop()),
+		dtoPlaceStopOrderRequester.GetStopPrice(),
+		object.URIEmpty,
+		dtoPlaceStopOrderRequester.GetSTP(),
+		dtoPlaceStopOrderRequester.GetSymbol(),
+		object.URIEmpty,
+		object.URIEmpty,
+		string(dtoPlaceStopOrderRequester.GetTimeInForce()),
+		string(dtoPlaceStopOrderRequester.GetTradeType()),
+		dtoPlaceStopOrderRequester.GetVisibleSize(),
+		dtoPlaceStopOrderRequester.GetCancelAfter(),
+		0,
+		0,
+		dtoPlaceStopOrderRequester.GetHidden(),
+		dtoPlaceStopOrderRequester.GetIceBerg(),
+		true,
+		paper,
+		dtoPlaceStopOrderRequester.GetPostOnly(),
+		id,
+	)
+}
+
+// stopOrderServicePlaceStopOrderRequestWithClientOID derives the clientOid from
+// the fields of the request.
+func stopOrderServicePlaceStopOrderRequestWithClientOID(
+	dtoPlaceStopOrderRequester dto.PlaceStopOrderRequester,
+) dto.PlaceStopOrderRequester {
+	return dto.NewPlaceStopOrderRequest(
+		util.ClientOID(
+			dtoPlaceStopOrderRequester.GetSymbol(),
+			string(dtoPlaceStopOrderRequester.GetSide()),
+			string(dtoPlaceStopOrderRequester.GetOrderType()),
+			string(dtoPlaceStopOrderRequester.GetTradeType()),
+			dtoPlaceStopOrderRequester.GetPrice(),
+			dtoPlaceStopOrderRequester.GetSize(),
+			dtoPlaceStopOrderRequester.GetFunds(),
+			dtoPlaceStopOrderRequester.GetRemark(),
+			string(dtoPlaceStopOrderRequester.GetStop()),
+			dtoPlaceStopOrderRequester.GetStopPrice(),
+		),
+		dtoPlaceStopOrderRequester.GetFunds(),
+		dtoPlaceStopOrderRequester.GetOrderType(),
+		dtoPlaceStopOrderRequester.GetPrice(),
+		dtoPlaceStopOrderRequester.GetRemark(),
+		dtoPlaceStopOrderRequester.GetSide(),
+		dtoPlaceStopOrderRequester.GetSize(),
+		dtoPlaceStopOrderRequester.GetStop(),
+		dtoPlaceStopOrderRequester.GetStopPrice(),
+		dtoPlaceStopOrderRequester.GetSTP(),
+		dtoPlaceStopOrderRequester.GetSymbol(),
+		dtoPlaceStopOrderRequester.GetTimeInForce(),
+		dtoPlaceStopOrderRequester.GetTradeType(),
+		dtoPlaceStopOrderRequester.GetVisibleSize(),
+		dtoPlaceStopOrderRequester.GetCancelAfter(),
+		dtoPlaceStopOrderRequester.GetHidden(),
+		dtoPlaceStopOrderRequester.GetIceBerg(),
+		dtoPlaceStopOrderRequester.GetPostOnly(),
+	)
+}
+
+func stopOrderServiceStopOrderWith(
+	omStopOrderer om.StopOrderer,
+	kucoinID string,
+	status string,
+	isActive bool,
+) om.StopOrderer {
+	return om.NewStopOrder(
+		omStopOrderer.GetAccount(),
+		omStopOrderer.GetChannel(),
+		omStopOrderer.GetClientOID(),
+		omStopOrderer.GetFeeCurrency(),
+		omStopOrderer.GetFunds(),
+		kucoinID,
+		omStopOrderer.GetKucoinType(),
+		omStopOrderer.GetMakerFeeRate(),
+		omStopOrderer.GetPrice(),
+		omStopOrderer.GetRemark(),
+		omStopOrderer.GetSide(),
+		omStopOrderer.GetSize(),
+		status,
+		omStopOrderer.GetStop(),
+		omStopOrderer.GetStopPrice(),
+		omStopOrderer.GetStopTriggerTime(),
+		omStopOrderer.GetSTP(),
+		omStopOrderer.GetSymbol(),
+		omStopOrderer.GetTags(),
+		omStopOrderer.GetTakerFeeRate(),
+		omStopOrderer.GetTimeInForce(),
+		omStopOrderer.GetTradeType(),
+		omStopOrderer.GetVisibleSize(),
+		omStopOrderer.GetCancelAfter(),
+		omStopOrderer.GetKucoinCreatedAt(),
+		omStopOrderer.GetOrderTime(),
+		omStopOrderer.GetHidden(),
+		omStopOrderer.GetIceBerg(),
+		isActive,
+		omStopOrderer.GetPaper(),
+		omStopOrderer.GetPostOnly(),
+		omStopOrderer.GetID(),
+	)
+}
@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange/exchangetest"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type (
+	stopOrderServiceTestServicer struct {
+		Servicer
+		orderServicer     OrderServicer
+		stopOrderServicer StopOrderServicer
+	}
+
+	// stopOrderServiceTestStopOrderServicer serves the stored stop orders from
+	// memory and keeps what is written to them.
+	stopOrderServiceTestStopOrderServicer struct {
+		StopOrderServicer
+		omStopOrderers         []om.StopOrderer
+		omUpdatedStopOrderers  []om.StopOrderer
+		omUpsertedStopOrderers []om.StopOrderer
+		mutex                  sync.Mutex
+	}
+
+	stopOrderServiceTestStopOrderRepositorier struct {
+		repository.StopOrderRepositorier
+		ids   []uuid.UUID
+		mutex sync.Mutex
+	}
+)
+
+// GetOrderServicer is a function.
+func (servicer *stopOrderServiceTestServicer) GetOrderServicer() OrderServicer {
+	return servicer.orderServicer
+}
+
+// GetStopOrderServicer is a function.
+func (servicer *stopOrderServiceTestServicer) GetStopOrderServicer() StopOrderServicer {
+	return servicer.stopOrderServicer
+}
+
+// GetListFromRepository is a function.
+func (servicer *stopOrderServiceTestStopOrderServicer) GetListFromRepository(
+	_ context.Context,
+	_ dao.Paginationer,
+	_ dao.StopOrderFilterer,
+) ([]om.StopOrderer, dao.Cursorer, error) {
+	return servicer.omStopOrderers, nil, nil
+}
+
+// Update is a function.
+func (servicer *stopOrderServiceTestStopOrderServicer) Update(
+	_ context.Context,
+	omStopOrderer om.StopOrderer,
+) (time.Time, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	servicer.omUpdatedStopOrderers = append(servicer.omUpdatedStopOrderers, omStopOrderer)
+
+	return time.Time{}, nil
+}
+
+// Upsert is a function.
+func (servicer *stopOrderServiceTestStopOrderServicer) Upsert(
+	_ context.Context,
+	omStopOrderer om.StopOrderer,
+) (uuid.UUID, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	servicer.omUpsertedStopOrderers = append(servicer.omUpsertedStopOrderers, omStopOrderer)
+
+	return uuid.Nil, nil
+}
+
+// Delete is a function.
+func (repositorier *stopOrderServiceTestStopOrderRepositorier) Delete(
+	_ context.Context,
+	id uuid.UUID,
+) (time.Time, error) {
+	repositorier.mutex.Lock()
+	defer repositorier.mutex.Unlock()
+
+	repositorier.ids = append(repositorier.ids, id)
+
+	return time.Time{}, nil
+}
+
+func newStopOrderServiceTest(
+	t *testing.T,
+	omStopOrderers ...om.StopOrderer,
+) (
+	exchangetest.FakeServerer,
+	*stopOrderServiceTestStopOrderServicer,
+	*stopOrderServiceTestStopOrderRepositorier,
+	*orderServiceTestOrderServicer,
+) {
+	t.Helper()
+
+	fakeServerer := exchangetest.NewFakeServer(
+		kucoin.ApiKeyOption("key"),
+		kucoin.ApiSecretOption("secret"),
+		kucoin.ApiPassPhraseOption("passphrase"),
+		kucoin.ApiKeyVersionOption(kucoin.ApiKeyVersionV2),
+	)
+	t.Cleanup(fakeServerer.Close)
+
+	configConfigger := config.NewConfig(
+		config.WithKucoinConfigger(),
+		config.WithLogConfigger(),
+		config.WithPaperConfigger(),
+		config.WithRuntimeConfigger(),
+	)
+
+	testStopOrderRepositorier := &stopOrderServiceTestStopOrderRepositorier{
+		StopOrderRepositorier: nil,
+		ids:                   []uuid.UUID{},
+		mutex:                 sync.Mutex{},
+	}
+
+	stopOrderServicer := NewStopOrderServicer(
+		configConfigger,
+		testStopOrderRepositorier,
+		log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+		trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+		util.NewUUID(),
+		fakeServerer.GetExchanger(),
+	)
+
+	testStopOrderServicer := &stopOrderServiceTestStopOrderServicer{
+		StopOrderServicer:      stopOrderServicer,
+		omStopOrderers:         omStopOrderers,
+		omUpdatedStopOrderers:  []om.StopOrderer{},
+		omUpsertedStopOrderers: []om.StopOrderer{},
+		mutex:                  sync.Mutex{},
+	}
+
+	testOrderServicer := &orderServiceTestOrderServicer{
+		OrderServicer: nil,
+		omOrderers:    []om.Orderer{},
+		mutex:         sync.Mutex{},
+	}
+
+	stopOrderServicer.(WithServicer).WithServicer(&stopOrderServiceTestServicer{
+		Servicer:          nil,
+		orderServicer:     testOrderServicer,
+		stopOrderServicer: testStopOrderServicer,
+	})
+
+	return fakeServerer, testStopOrderServicer, testStopOrderRepositorier, testOrderServicer
+}
+
+func newStopOrderServiceTestStopOrder(
+	kucoinID string,
+	clientOID string,
+	status string,
+	id uuid.UUID,
+) om.StopOrderer {
+	return stopOrderServiceStopOrderFromModel(object.URIEmpty, &kucoin.StopOrderModel{
+		Id:        kucoinID,
+		Symbol:    "BTC-USDT",
+		Status:    status,
+		Type:      string(object.OrderTypeTypeLimit),
+		Side:      string(object.OrderSideTypeSell),
+		Price:     "90",
+		Size:      "1",
+		ClientOid: clientOID,
+		Stop:      string(object.OrderStopTypeLoss),
+		StopPrice: "91",
+	}, false, id)
+}
+
+func newStopOrderServiceTestOrderNotExist() exchangetest.FakeResponser {
+	return exchangetest.NewFakeErrorResponse(
+		http.StatusOK,
+		object.URIKucoinCodeInvalidParameter,
+		object.URIKucoinMessageOrderNotExist,
+	)
+}
+
+func TestStopOrderServiceSync(t *testing.T) {
+	t.Parallel()
+
+	waitingID, triggeredID, cancelledID := uuid.New(), uuid.New(), uuid.New()
+
+	fakeServerer, testStopOrderServicer, testStopOrderRepositorier, testOrderServicer :=
+		newStopOrderServiceTest(
+			t,
+			newStopOrderServiceTestStopOrder("1", "client-1", object.URIKucoinStopOrderStatusNew, waitingID),
+			newStopOrderServiceTestStopOrder("2", "client-2", object.URIKucoinStopOrderStatusNew, triggeredID),
+			newStopOrderServiceTestStopOrder("3", "client-3", object.URIKucoinStopOrderStatusNew, cancelledID),
+		)
+
+	fakeServerer.HandlePagination(http.MethodGet, object.URIKucoinPathStopOrder, []any{
+		map[string]any{
+			"id":        "1",
+			"clientOid": "client-1",
+			"symbol":    "BTC-USDT",
+			"status":    object.URIKucoinStopOrderStatusNew,
+		},
+	})
+
+	// The first stop order still waits for its stop price.
+	fakeServerer.Handle(
+		http.MethodGet,
+		object.URIKucoinPathStopOrder+object.URIKucoinPathSeparator+"1",
+		exchangetest.NewFakeSuccessResponse(map[string]any{
+			"id":        "1",
+			"clientOid": "client-1",
+			"symbol":    "BTC-USDT",
+			"status":    object.URIKucoinStopOrderStatusNew,
+		}),
+	)
+
+	// The second one triggered and became an order under the same id.
+	fakeServerer.Handle(
+		http.MethodGet,
+		object.URIKucoinPathStopOrder+object.URIKucoinPathSeparator+"2",
+		exchangetest.NewFakeSuccessResponse(map[string]any{
+			"id":     "2",
+			"status": object.URIKucoinStopOrderStatusTriggered,
+		}),
+	)
+	fakeServerer.Handle(
+		http.MethodGet,
+		object.URIKucoinPathOrders+object.URIKucoinPathSeparator+"2",
+		exchangetest.NewFakeSuccessResponse(map[string]any{
+			"id":            "2",
+			"clientOid":     "client-2",
+			"symbol":        "BTC-USDT",
+			"stopTriggered": true,
+			"isActive":      true,
+		}),
+	)
+
+	// The third one was cancelled, so the exchange knows neither.
+	fakeServerer.Handle(
+		http.MethodGet,
+		object.URIKucoinPathStopOrder+object.URIKucoinPathSeparator+"3",
+		newStopOrderServiceTestOrderNotExist(),
+	)
+	fakeServerer.Handle(
+		http.MethodGet,
+		object.URIKucoinPathOrders+object.URIKucoinPathSeparator+"3",
+		newStopOrderServiceTestOrderNotExist(),
+	)
+
+	if err := testStopOrderServicer.Sync(context.Background()); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	if got := len(testStopOrderServicer.omUpsertedStopOrderers); got != 1 ||
+		testStopOrderServicer.omUpsertedStopOrderers[0].GetKucoinID() != "1" {
+		t.Errorf(
+			"upserts = %v, want the stop order of the exchange",
+			testStopOrderServicer.omUpsertedStopOrderers,
+		)
+	}
+
+	omUpdatedStopOrderers := map[uuid.UUID]om.StopOrderer{}
+	for _, omStopOrderer := range testStopOrderServicer.omUpdatedStopOrderers {
+		omUpdatedStopOrderers[omStopOrderer.GetID()] = omStopOrderer
+	}
+
+	if omStopOrderer, ok := omUpdatedStopOrderers[waitingID]; !ok || !omStopOrderer.GetIsActive() {
+		t.Errorf("updates[waiting] = %v, want it still active", omStopOrderer)
+	}
+
+	if omStopOrderer, ok := omUpdatedStopOrderers[cancelledID]; !ok || omStopOrderer.GetIsActive() {
+		t.Errorf("updates[cancelled] = %v, want it inactive", omStopOrderer)
+	}
+
+	if _, ok := omUpdatedStopOrderers[triggeredID]; ok {
+		t.Errorf("updates[triggered] exists, want the stop order moved into the orders")
+	}
+
+	if len(testOrderServicer.omOrderers) != 1 || testOrderServicer.omOrderers[0].GetKucoinID() != "2" {
+		t.Errorf("order upserts = %v, want the triggered order", testOrderServicer.omOrderers)
+	}
+
+	if len(testStopOrderRepositorier.ids) != 1 || testStopOrderRepositorier.ids[0] != triggeredID {
+		t.Errorf("deletes = %v, want %v", testStopOrderRepositorier.ids, triggeredID)
+	}
+}
+
+func TestStopOrderServiceReconcilePending(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		stopList []any
+		want     error
+		isActive bool
+	}{
+		{
+			name: "acknowledged",
+			stopList: []any{map[string]any{
+				"id":        "1",
+				"clientOid": "client",
+				"symbol":    "BTC-USDT",
+				"status":    object.URIKucoinStopOrderStatusNew,
+			}},
+			want:     nil,
+			isActive: true,
+		},
+		{
+			name:     "never reached the exchange",
+			stopList: []any{},
+			want:     object.ErrStopOrderNotFound,
+			isActive: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			id := uuid.New()
+			fakeServerer, testStopOrderServicer, _, _ := newStopOrderServiceTest(t)
+
+			fakeServerer.Handle(
+				http.MethodGet,
+				object.URIKucoinPathStopOrderQueryByClientOID,
+				exchangetest.NewFakeSuccessResponse(test.stopList),
+			)
+			fakeServerer.Handle(
+				http.MethodGet,
+				object.URIKucoinPathOrderClientOrder+"client",
+				newStopOrderServiceTestOrderNotExist(),
+			)
+
+			// Until the exchange acknowledges it, the clientOid stands in for the id.
+			omStopOrderer, err := testStopOrderServicer.Reconcile(
+				context.Background(),
+				newStopOrderServiceTestStopOrder("client", "client", object.URIKucoinStopOrderStatusNew, id),
+			)
+			if !errors.Is(err, test.want) {
+				t.Fatalf("Reconcile() error = %v, want %v", err, test.want)
+			}
+
+			if test.want != nil {
+				return
+			}
+
+			if omStopOrderer.GetKucoinID() != "1" ||
+				omStopOrderer.GetID() != id ||
+				omStopOrderer.GetIsActive() != test.isActive {
+				t.Errorf("Reconcile() = %v, want the acknowledged stop order under %v", omStopOrderer, id)
+			}
+		})
+	}
+}
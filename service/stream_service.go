@@ -0,0 +1,852 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// StreamServicer is an interface.
+	StreamServicer interface {
+		// GetKline is a function.
+		GetKline(
+			string,
+			object.KlineTypeType,
+		) (om.Kliner, bool)
+		// Run is a function.
+		Run(
+			context.Context,
+		) error
+	}
+
+	// GetStreamServicer is an interface.
+	GetStreamServicer interface {
+		// GetStreamServicer is a function.
+		GetStreamServicer() StreamServicer
+	}
+
+	streamService struct {
+		configConfigger   config.Configger
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+		klines            map[string]om.Kliner
+		mutex             sync.RWMutex
+	}
+)
+
+var (
+	_ GetServicer           = (*streamService)(nil)
+	_ StreamServicer        = (*streamService)(nil)
+	_ WithServicer          = (*streamService)(nil)
+	_ config.GetConfigger   = (*streamService)(nil)
+	_ exchange.GetExchanger = (*streamService)(nil)
+	_ log.GetRuntimeLogger  = (*streamService)(nil)
+	_ util.GetTracer        = (*streamService)(nil)
+	_ util.GetUUIDer        = (*streamService)(nil)
+)
+
+// NewStreamServicer is a function.
+func NewStreamServicer(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) StreamServicer {
+	return &streamService{
+		configConfigger:   configConfigger,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+		klines:            map[string]om.Kliner{},
+		mutex:             sync.RWMutex{},
+	}
+}
+
+// GetConfigger is a function.
+func (service *streamService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *streamService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *streamService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetTracer is a function.
+func (service *streamService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *streamService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *streamService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *streamService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// GetKline is a function.
+func (service *streamService) GetKline(
+	symbol string,
+	klineType object.KlineTypeType,
+) (om.Kliner, bool) {
+	service.mutex.RLock()
+	defer service.mutex.RUnlock()
+
+	omKline, ok := service.klines[streamServiceKlineKey(symbol, string(klineType))]
+
+	return omKline, ok
+}
+
+// Run is a function.
+// Run blocks until ctx is done, reconnecting with an exponential backoff
+// between the configured bounds whenever the connection drops.
+func (service *streamService) Run(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Run",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Run",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if len(service.GetConfigger().GetStreamConfigger().GetSymbols()) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(service.GetConfigger().GetStreamConfigger().GetSymbols()) == 0`)
+
+		return nil
+	}
+
+	backoff := service.GetConfigger().GetStreamConfigger().GetReconnectMinBackoff()
+
+	for {
+		connected, err := service.connect(ctx)
+
+		service.GetServicer().GetOrderBookServicer().Reset(ctx)
+
+		if ctx.Err() != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`ctx.Err() != nil`)
+
+			return nil
+		}
+
+		if connected {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`connected`)
+
+			backoff = service.GetConfigger().GetStreamConfigger().GetReconnectMinBackoff()
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			WithField(object.URIFieldBackoff, backoff).
+			Error(object.ErrStreamServiceConnect.Error())
+		traceSpan.RecordError(err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > service.GetConfigger().GetStreamConfigger().GetReconnectMaxBackoff() {
+			backoff = service.GetConfigger().GetStreamConfigger().GetReconnectMaxBackoff()
+		}
+	}
+}
+
+func (service *streamService) connect(
+	ctx context.Context,
+) (bool, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"connect",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "connect",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	response, err := serviceExchanger(ctx, service).WebSocketPublicToken()
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStreamKucoinServiceToken.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStreamKucoinServiceToken.Error())
+
+		return false, fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, errResponse).
+			Error(object.ErrStreamKucoinServiceToken.Error())
+		traceSpan.RecordError(errResponse)
+		traceSpan.SetStatus(codes.Error, object.ErrStreamKucoinServiceToken.Error())
+
+		return false, fmt.Errorf("%w: %w", object.ErrStreamKucoinServiceToken, errResponse)
+	}
+
+	kucoinWebSocketTokenModel := &kucoin.WebSocketTokenModel{
+		Token:             object.URIEmpty,
+		Servers:           kucoin.WebSocketServersModel{},
+		AcceptUserMessage: false,
+	}
+
+	if err = response.ReadData(kucoinWebSocketTokenModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadPaginationData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadPaginationData.Error())
+
+		return false, fmt.Errorf("%w", err)
+	}
+
+	kucoinWebSocketClient := serviceExchanger(ctx, service).
+		NewWebSocketClient(kucoinWebSocketTokenModel)
+
+	messages, errs, err := kucoinWebSocketClient.Connect()
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStreamKucoinServiceConnect.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStreamKucoinServiceConnect.Error())
+
+		return false, fmt.Errorf("%w", err)
+	}
+
+	defer kucoinWebSocketClient.Stop()
+
+	topics := service.topics()
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldTopics, topics).
+		Debug(object.URIEmpty)
+
+	kucoinWebSocketSubscribeMessages := make([]*kucoin.WebSocketSubscribeMessage, 0, len(topics))
+	for _, topic := range topics {
+		kucoinWebSocketSubscribeMessages = append(
+			kucoinWebSocketSubscribeMessages,
+			kucoin.NewSubscribeMessage(topic, false),
+		)
+	}
+
+	if err = kucoinWebSocketClient.Subscribe(kucoinWebSocketSubscribeMessages...); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrStreamKucoinServiceSubscribe.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrStreamKucoinServiceSubscribe.Error())
+
+		return true, fmt.Errorf("%w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return true, nil
+		case err = <-errs:
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrStreamKucoinServiceRead.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrStreamKucoinServiceRead.Error())
+
+			return true, fmt.Errorf("%w", err)
+		case kucoinWebSocketDownstreamMessage, ok := <-messages:
+			if !ok {
+				service.GetRuntimeLogger().
+					WithFields(fields).
+					Debug(`!ok`)
+
+				return true, object.ErrStreamKucoinServiceRead
+			}
+
+			if err = service.handle(ctx, kucoinWebSocketDownstreamMessage); err != nil {
+				service.GetRuntimeLogger().
+					WithFields(fields).
+					WithField(object.URIFieldError, err).
+					Error(object.ErrStreamServiceHandle.Error())
+				traceSpan.RecordError(err)
+			}
+		}
+	}
+}
+
+func (service *streamService) handle(
+	ctx context.Context,
+	kucoinWebSocketDownstreamMessage *kucoin.WebSocketDownstreamMessage,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"handle",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                                 "handle",
+		"rt_ctx":                               utilRuntimeContext,
+		"sp_ctx":                               utilSpanContext,
+		"config":                               service.configConfigger,
+		"kucoin_web_socket_downstream_message": kucoinWebSocketDownstreamMessage,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	switch kucoinWebSocketDownstreamMessage.Subject {
+	case object.URIStreamSubjectTicker:
+		return service.handleTicker(ctx, kucoinWebSocketDownstreamMessage)
+	case object.URIStreamSubjectSnapshot:
+		return service.handleSnapshot(ctx, kucoinWebSocketDownstreamMessage)
+	case object.URIStreamSubjectCandlesAdd, object.URIStreamSubjectCandlesUpdate:
+		return service.handleCandles(ctx, kucoinWebSocketDownstreamMessage)
+	case object.URIStreamSubjectLevel2:
+		return service.handleLevel2(ctx, kucoinWebSocketDownstreamMessage)
+	}
+
+	return nil
+}
+
+func (service *streamService) handleCandles(
+	ctx context.Context,
+	kucoinWebSocketDownstreamMessage *kucoin.WebSocketDownstreamMessage,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"handleCandles",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                                 "handleCandles",
+		"rt_ctx":                               utilRuntimeContext,
+		"sp_ctx":                               utilSpanContext,
+		"config":                               service.configConfigger,
+		"kucoin_web_socket_downstream_message": kucoinWebSocketDownstreamMessage,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	exchangeMarketCandlesModel := exchange.MarketCandlesModel{
+		Symbol:  object.URIEmpty,
+		Candles: []string{},
+		Time:    0,
+	}
+
+	if err := kucoinWebSocketDownstreamMessage.ReadData(&exchangeMarketCandlesModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadPaginationData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadPaginationData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldExchangeMarketCandlesModel, exchangeMarketCandlesModel).
+		Debug(object.URIEmpty)
+
+	if len(exchangeMarketCandlesModel.Candles) < object.NUMKlineCandleLength {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTypeAssertion).
+			Error(object.ErrTypeAssertion.Error())
+		traceSpan.RecordError(object.ErrTypeAssertion)
+		traceSpan.SetStatus(codes.Error, object.ErrTypeAssertion.Error())
+
+		return object.ErrTypeAssertion
+	}
+
+	startAt, err := strconv.ParseInt(exchangeMarketCandlesModel.Candles[0], 10, 64)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSTRCONVParseInt.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseInt.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	_, klineType, _ := strings.Cut(
+		strings.TrimPrefix(kucoinWebSocketDownstreamMessage.Topic, object.URIStreamTopicCandles),
+		"_",
+	)
+
+	omKline := om.NewKline(
+		exchangeMarketCandlesModel.Candles[2],
+		exchangeMarketCandlesModel.Candles[3],
+		klineType,
+		exchangeMarketCandlesModel.Candles[4],
+		exchangeMarketCandlesModel.Candles[1],
+		exchangeMarketCandlesModel.Symbol,
+		exchangeMarketCandlesModel.Candles[6],
+		exchangeMarketCandlesModel.Candles[5],
+		startAt,
+		uuid.Nil,
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMKline, omKline).
+		Debug(object.URIEmpty)
+
+	klineKey := streamServiceKlineKey(omKline.GetSymbol(), omKline.GetKlineType())
+
+	service.mutex.Lock()
+	previousOMKline, ok := service.klines[klineKey]
+	service.klines[klineKey] = omKline
+	service.mutex.Unlock()
+
+	if !ok || previousOMKline.GetStartAt() == omKline.GetStartAt() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`!ok || previousOMKline.GetStartAt() == omKline.GetStartAt()`)
+
+		return nil
+	}
+
+	if _, err = service.GetServicer().GetKlineServicer().Upsert(ctx, previousOMKline); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKlineServiceUpsert.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKlineServiceUpsert.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func (service *streamService) handleLevel2(
+	ctx context.Context,
+	kucoinWebSocketDownstreamMessage *kucoin.WebSocketDownstreamMessage,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"handleLevel2",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                                 "handleLevel2",
+		"rt_ctx":                               utilRuntimeContext,
+		"sp_ctx":                               utilSpanContext,
+		"config":                               service.configConfigger,
+		"kucoin_web_socket_downstream_message": kucoinWebSocketDownstreamMessage,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	exchangeMarketLevel2Model := exchange.MarketLevel2Model{
+		Changes: exchange.MarketLevel2ChangesModel{
+			Asks: [][]string{},
+			Bids: [][]string{},
+		},
+		Symbol:        object.URIEmpty,
+		SequenceEnd:   0,
+		SequenceStart: 0,
+		Time:          0,
+	}
+
+	if err := kucoinWebSocketDownstreamMessage.ReadData(&exchangeMarketLevel2Model); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadPaginationData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadPaginationData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldExchangeMarketLevel2Model, exchangeMarketLevel2Model).
+		Debug(object.URIEmpty)
+
+	if err := service.GetServicer().
+		GetOrderBookServicer().
+		ApplyLevel2(ctx, exchangeMarketLevel2Model); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrOrderBookServiceApplyLevel2.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrOrderBookServiceApplyLevel2.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func (service *streamService) handleSnapshot(
+	ctx context.Context,
+	kucoinWebSocketDownstreamMessage *kucoin.WebSocketDownstreamMessage,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"handleSnapshot",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                                 "handleSnapshot",
+		"rt_ctx":                               utilRuntimeContext,
+		"sp_ctx":                               utilSpanContext,
+		"config":                               service.configConfigger,
+		"kucoin_web_socket_downstream_message": kucoinWebSocketDownstreamMessage,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	exchangeMarketSnapshotModel := exchange.MarketSnapshotModel{
+		Data:     exchange.MarketSnapshotDataModel{},
+		Sequence: object.URIEmpty,
+	}
+
+	if err := kucoinWebSocketDownstreamMessage.ReadData(&exchangeMarketSnapshotModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadPaginationData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadPaginationData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldExchangeMarketSnapshotModel, exchangeMarketSnapshotModel).
+		Debug(object.URIEmpty)
+
+	data := exchangeMarketSnapshotModel.Data
+	omTicker := om.NewTicker(
+		data.AveragePrice.String(),
+		data.Buy.String(),
+		data.ChangePrice.String(),
+		data.ChangeRate.String(),
+		data.High.String(),
+		data.LastTradedPrice.String(),
+		data.Low.String(),
+		data.MakerCoefficient.String(),
+		data.MakerFeeRate.String(),
+		data.Sell.String(),
+		data.Symbol,
+		data.Symbol,
+		data.TakerCoefficient.String(),
+		data.TakerFeeRate.String(),
+		data.Vol.String(),
+		data.VolValue.String(),
+		uuid.Nil,
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMTicker, omTicker).
+		Debug(object.URIEmpty)
+
+	tickerID, err := service.GetServicer().GetTickerServicer().Upsert(ctx, omTicker)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrTickerServiceUpsert.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrTickerServiceUpsert.Error())
+
+		return err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldTickerID, tickerID).
+		Debug(object.URIEmpty)
+
+	return nil
+}
+
+func (service *streamService) handleTicker(
+	ctx context.Context,
+	kucoinWebSocketDownstreamMessage *kucoin.WebSocketDownstreamMessage,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"handleTicker",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                                 "handleTicker",
+		"rt_ctx":                               utilRuntimeContext,
+		"sp_ctx":                               utilSpanContext,
+		"config":                               service.configConfigger,
+		"kucoin_web_socket_downstream_message": kucoinWebSocketDownstreamMessage,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	kucoinTickerLevel1Model := kucoin.TickerLevel1Model{
+		Sequence:    object.URIEmpty,
+		Price:       object.URIEmpty,
+		Size:        object.URIEmpty,
+		BestBid:     object.URIEmpty,
+		BestBidSize: object.URIEmpty,
+		BestAsk:     object.URIEmpty,
+		BestAskSize: object.URIEmpty,
+		Time:        0,
+	}
+
+	if err := kucoinWebSocketDownstreamMessage.ReadData(&kucoinTickerLevel1Model); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadPaginationData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadPaginationData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinTickerLevel1Model, kucoinTickerLevel1Model).
+		Debug(object.URIEmpty)
+
+	symbol := strings.TrimPrefix(kucoinWebSocketDownstreamMessage.Topic, object.URIStreamTopicTicker)
+
+	omTicker := om.NewTicker(
+		object.URIEmpty,
+		kucoinTickerLevel1Model.BestBid,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		kucoinTickerLevel1Model.Price,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		kucoinTickerLevel1Model.BestAsk,
+		symbol,
+		symbol,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		uuid.Nil,
+	)
+
+	omTickerer, err := service.GetServicer().GetTickerServicer().GetBySymbol(ctx, symbol)
+	if err == nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`err == nil`)
+
+		omTicker = om.NewTicker(
+			omTickerer.GetAveragePrice(),
+			kucoinTickerLevel1Model.BestBid,
+			omTickerer.GetChangePrice(),
+			omTickerer.GetChangeRate(),
+			omTickerer.GetHigh(),
+			kucoinTickerLevel1Model.Price,
+			omTickerer.GetLow(),
+			omTickerer.GetMakerCoefficient(),
+			omTickerer.GetMakerFeeRate(),
+			kucoinTickerLevel1Model.BestAsk,
+			omTickerer.GetSymbol(),
+			omTickerer.GetSymbolName(),
+			omTickerer.GetTakerCoefficient(),
+			omTickerer.GetTakerFeeRate(),
+			omTickerer.GetVol(),
+			omTickerer.GetVolValue(),
+			omTickerer.GetID(),
+		)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMTicker, omTicker).
+		Debug(object.URIEmpty)
+
+	tickerID, err := service.GetServicer().GetTickerServicer().Upsert(ctx, omTicker)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrTickerServiceUpsert.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrTickerServiceUpsert.Error())
+
+		return err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldTickerID, tickerID).
+		Debug(object.URIEmpty)
+
+	return nil
+}
+
+func (service *streamService) topics() []string {
+	symbols := service.GetConfigger().GetStreamConfigger().GetSymbols()
+	klineTypes := service.GetConfigger().GetStreamConfigger().GetKlineTypes()
+	topics := make([]string, 0, len(symbols)*(object.NUMStreamTopicPerSymbolCount+len(klineTypes)))
+
+	for start := 0; start < len(symbols); start += object.NUMStreamTopicSymbolCount {
+		end := start + object.NUMStreamTopicSymbolCount
+		if end > len(symbols) {
+			end = len(symbols)
+		}
+
+		topics = append(topics, object.URIStreamTopicTicker+strings.Join(symbols[start:end], ","))
+
+		if service.GetConfigger().GetStreamConfigger().GetOrderBook() {
+			topics = append(topics, object.URIStreamTopicLevel2+strings.Join(symbols[start:end], ","))
+		}
+	}
+
+	for _, symbol := range symbols {
+		topics = append(topics, object.URIStreamTopicSnapshot+symbol)
+
+		for _, klineType := range klineTypes {
+			topics = append(topics, object.URIStreamTopicCandles+streamServiceKlineKey(symbol, klineType))
+		}
+	}
+
+	return topics
+}
+
+func streamServiceKlineKey(
+	symbol string,
+	klineType string,
+) string {
+	return fmt.Sprintf("%s_%s", symbol, klineType)
+}
@@ -0,0 +1,420 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/exchange/exchangetest"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const streamServiceTestPath = "/api/v1/bullet-public"
+
+type (
+	streamServiceTestServicer struct {
+		Servicer
+		klineServicer     KlineServicer
+		orderBookServicer OrderBookServicer
+		tickerServicer    TickerServicer
+	}
+
+	streamServiceTestKlineServicer struct {
+		KlineServicer
+		omKliners []om.Kliner
+		mutex     sync.Mutex
+	}
+
+	streamServiceTestOrderBookServicer struct {
+		OrderBookServicer
+		reset int
+		mutex sync.Mutex
+	}
+
+	streamServiceTestTickerServicer struct {
+		TickerServicer
+		omTickerers map[string]om.Tickerer
+		mutex       sync.Mutex
+	}
+)
+
+// GetKlineServicer is a function.
+func (servicer *streamServiceTestServicer) GetKlineServicer() KlineServicer {
+	return servicer.klineServicer
+}
+
+// GetOrderBookServicer is a function.
+func (servicer *streamServiceTestServicer) GetOrderBookServicer() OrderBookServicer {
+	return servicer.orderBookServicer
+}
+
+// GetTickerServicer is a function.
+func (servicer *streamServiceTestServicer) GetTickerServicer() TickerServicer {
+	return servicer.tickerServicer
+}
+
+// Upsert is a function.
+func (servicer *streamServiceTestKlineServicer) Upsert(
+	_ context.Context,
+	omKliner om.Kliner,
+) (uuid.UUID, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	servicer.omKliners = append(servicer.omKliners, omKliner)
+
+	return uuid.Nil, nil
+}
+
+// Reset is a function.
+func (servicer *streamServiceTestOrderBookServicer) Reset(
+	_ context.Context,
+) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	servicer.reset++
+}
+
+// GetBySymbol is a function.
+func (servicer *streamServiceTestTickerServicer) GetBySymbol(
+	_ context.Context,
+	symbol string,
+) (om.Tickerer, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	omTickerer, ok := servicer.omTickerers[symbol]
+	if !ok {
+		return nil, object.ErrTickerServiceGetBySymbol
+	}
+
+	return omTickerer, nil
+}
+
+// Upsert is a function.
+func (servicer *streamServiceTestTickerServicer) Upsert(
+	_ context.Context,
+	omTickerer om.Tickerer,
+) (uuid.UUID, error) {
+	servicer.mutex.Lock()
+	defer servicer.mutex.Unlock()
+
+	servicer.omTickerers[omTickerer.GetSymbol()] = omTickerer
+
+	return omTickerer.GetID(), nil
+}
+
+func newStreamServiceTest(
+	exchangeExchanger exchange.Exchanger,
+) (*streamService, *streamServiceTestServicer) {
+	configConfigger := config.NewConfig(
+		config.WithKucoinConfigger(),
+		config.WithLogConfigger(),
+		config.WithStreamConfigger(
+			config.WithStreamConfigKlineTypes([]string{string(object.KlineTypeType1min)}),
+			config.WithStreamConfigSymbols([]string{"BTC-USDT"}),
+			config.WithStreamConfigReconnectMaxBackoff(2*time.Millisecond),
+			config.WithStreamConfigReconnectMinBackoff(time.Millisecond),
+		),
+	)
+
+	streamServicer := NewStreamServicer(
+		configConfigger,
+		log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+		trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+		util.NewUUID(),
+		exchangeExchanger,
+	)
+
+	testServicer := &streamServiceTestServicer{
+		Servicer: nil,
+		klineServicer: &streamServiceTestKlineServicer{
+			KlineServicer: nil,
+			omKliners:     []om.Kliner{},
+			mutex:         sync.Mutex{},
+		},
+		orderBookServicer: &streamServiceTestOrderBookServicer{
+			OrderBookServicer: nil,
+			reset:             0,
+			mutex:             sync.Mutex{},
+		},
+		tickerServicer: &streamServiceTestTickerServicer{
+			TickerServicer: nil,
+			omTickerers:    map[string]om.Tickerer{},
+			mutex:          sync.Mutex{},
+		},
+	}
+
+	streamServicer.(WithServicer).WithServicer(testServicer)
+
+	return streamServicer.(*streamService), testServicer
+}
+
+func newStreamServiceTestMessage(
+	t *testing.T,
+	topic string,
+	subject string,
+	data any,
+) *kucoin.WebSocketDownstreamMessage {
+	t.Helper()
+
+	rawData, err := json.Marshal(data)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	return &kucoin.WebSocketDownstreamMessage{
+		WebSocketMessage: &kucoin.WebSocketMessage{Id: "1", Type: kucoin.Message},
+		Sn:               object.URIEmpty,
+		Topic:            topic,
+		Subject:          subject,
+		RawData:          rawData,
+	}
+}
+
+func TestStreamServiceRunReconnect(t *testing.T) {
+	t.Parallel()
+
+	fakeServerer := exchangetest.NewFakeServer()
+	t.Cleanup(fakeServerer.Close)
+
+	// The first token is refused, the second points at a server that does not
+	// answer, and every later one is refused again.
+	fakeServerer.Enqueue(
+		http.MethodPost,
+		streamServiceTestPath,
+		exchangetest.NewFakeErrorResponse(http.StatusServiceUnavailable, "503000", "Service Unavailable"),
+		exchangetest.NewFakeSuccessResponse(map[string]any{
+			"token": "token",
+			"instanceServers": []map[string]any{{
+				"endpoint":     "ws://127.0.0.1:1/endpoint",
+				"protocol":     "websocket",
+				"encrypt":      false,
+				"pingInterval": 50000,
+				"pingTimeout":  10000,
+			}},
+		}),
+	)
+	fakeServerer.Handle(
+		http.MethodPost,
+		streamServiceTestPath,
+		exchangetest.NewFakeErrorResponse(http.StatusServiceUnavailable, "503000", "Service Unavailable"),
+	)
+
+	streamService, testServicer := newStreamServiceTest(fakeServerer.GetExchanger())
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() {
+		done <- streamService.Run(ctx)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(fakeServerer.GetRequesters(http.MethodPost, streamServiceTestPath)) < 4 {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Run() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out")
+	}
+
+	orderBookServicer, _ := testServicer.orderBookServicer.(*streamServiceTestOrderBookServicer)
+
+	orderBookServicer.mutex.Lock()
+	defer orderBookServicer.mutex.Unlock()
+
+	// Every dropped connection resets the order book before the next one.
+	if orderBookServicer.reset < 4 {
+		t.Errorf("Reset() calls = %d, want at least 4", orderBookServicer.reset)
+	}
+}
+
+func TestStreamServiceRunWithoutSymbols(t *testing.T) {
+	t.Parallel()
+
+	fakeServerer := exchangetest.NewFakeServer()
+	t.Cleanup(fakeServerer.Close)
+
+	configConfigger := config.NewConfig(
+		config.WithKucoinConfigger(),
+		config.WithLogConfigger(),
+		config.WithStreamConfigger(),
+	)
+	streamServicer := NewStreamServicer(
+		configConfigger,
+		log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+		trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+		util.NewUUID(),
+		fakeServerer.GetExchanger(),
+	)
+
+	if err := streamServicer.Run(context.Background()); err != nil {
+		t.Errorf("Run() error = %v", err)
+	}
+
+	if got := len(fakeServerer.GetRequesters(http.MethodPost, streamServiceTestPath)); got != 0 {
+		t.Errorf("token requests = %d, want 0", got)
+	}
+}
+
+func TestStreamServiceTopics(t *testing.T) {
+	t.Parallel()
+
+	streamService, _ := newStreamServiceTest(nil)
+
+	want := []string{
+		object.URIStreamTopicTicker + "BTC-USDT",
+		object.URIStreamTopicSnapshot + "BTC-USDT",
+		object.URIStreamTopicCandles + "BTC-USDT_1min",
+	}
+
+	got := streamService.topics()
+	if len(got) != len(want) {
+		t.Fatalf("topics() = %v, want %v", got, want)
+	}
+
+	for index := range want {
+		if got[index] != want[index] {
+			t.Errorf("topics()[%d] = %q, want %q", index, got[index], want[index])
+		}
+	}
+}
+
+func TestStreamServiceHandleTicker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	streamService, testServicer := newStreamServiceTest(nil)
+	tickerServicer, _ := testServicer.tickerServicer.(*streamServiceTestTickerServicer)
+	tickerID := uuid.New()
+
+	tickerServicer.omTickerers["BTC-USDT"] = om.NewTicker(
+		"100",
+		"99",
+		"1",
+		"0.01",
+		"110",
+		"100",
+		"90",
+		"1",
+		"0.001",
+		"101",
+		"BTC-USDT",
+		"BTC-USDT",
+		"1",
+		"0.002",
+		"10",
+		"1000",
+		tickerID,
+	)
+
+	if err := streamService.handle(ctx, newStreamServiceTestMessage(
+		t,
+		object.URIStreamTopicTicker+"BTC-USDT",
+		object.URIStreamSubjectTicker,
+		map[string]any{
+			"sequence":    "2",
+			"price":       "105",
+			"size":        "1",
+			"bestBid":     "104",
+			"bestBidSize": "1",
+			"bestAsk":     "106",
+			"bestAskSize": "1",
+			"time":        1704067200000,
+		},
+	)); err != nil {
+		t.Fatalf("handle() error = %v", err)
+	}
+
+	omTickerer := tickerServicer.omTickerers["BTC-USDT"]
+
+	// The top of book is replaced, while the stored statistics are kept.
+	if omTickerer.GetLast() != "105" || omTickerer.GetBuy() != "104" || omTickerer.GetSell() != "106" {
+		t.Errorf(
+			"GetLast(), GetBuy(), GetSell() = %q, %q, %q, want 105, 104, 106",
+			omTickerer.GetLast(),
+			omTickerer.GetBuy(),
+			omTickerer.GetSell(),
+		)
+	}
+
+	if omTickerer.GetID() != tickerID || omTickerer.GetTakerFeeRate() != "0.002" {
+		t.Errorf(
+			"GetID(), GetTakerFeeRate() = %v, %q, want %v, 0.002",
+			omTickerer.GetID(),
+			omTickerer.GetTakerFeeRate(),
+			tickerID,
+		)
+	}
+}
+
+func TestStreamServiceHandleCandles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	streamService, testServicer := newStreamServiceTest(nil)
+	klineServicer, _ := testServicer.klineServicer.(*streamServiceTestKlineServicer)
+	topic := object.URIStreamTopicCandles + "BTC-USDT_1min"
+
+	for _, candles := range [][]string{
+		{"1704067200", "100", "101", "102", "99", "1", "100"},
+		{"1704067200", "100", "103", "103", "99", "2", "201"},
+		{"1704067260", "103", "104", "104", "103", "1", "104"},
+	} {
+		if err := streamService.handle(ctx, newStreamServiceTestMessage(
+			t,
+			topic,
+			object.URIStreamSubjectCandlesUpdate,
+			map[string]any{
+				"symbol":  "BTC-USDT",
+				"candles": candles,
+				"time":    1704067200000,
+			},
+		)); err != nil {
+			t.Fatalf("handle() error = %v", err)
+		}
+	}
+
+	// Only a closed kline is stored, in its last state.
+	if len(klineServicer.omKliners) != 1 {
+		t.Fatalf("Upsert() calls = %d, want 1", len(klineServicer.omKliners))
+	}
+
+	if omKliner := klineServicer.omKliners[0]; omKliner.GetStartAt() != 1704067200 ||
+		omKliner.GetClose() != "103" {
+		t.Errorf(
+			"Upsert() = %d, %q, want 1704067200, 103",
+			omKliner.GetStartAt(),
+			omKliner.GetClose(),
+		)
+	}
+
+	omKliner, ok := streamService.GetKline("BTC-USDT", object.KlineTypeType1min)
+	if !ok || omKliner.GetStartAt() != 1704067260 {
+		t.Errorf("GetKline() = %v, %t, want the open kline", omKliner, ok)
+	}
+}
@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// SubAccountServicer is an interface.
+	SubAccountServicer interface {
+		// GetList is a function.
+		GetList(
+			context.Context,
+		) ([]om.SubAccounter, error)
+		// Transfer is a function.
+		Transfer(
+			context.Context,
+			string,
+			string,
+			string,
+			string,
+			object.SubTransferDirectionType,
+		) (string, error)
+	}
+
+	// GetSubAccountServicer is an interface.
+	GetSubAccountServicer interface {
+		// GetSubAccountServicer is a function.
+		GetSubAccountServicer() SubAccountServicer
+	}
+
+	subAccountService struct {
+		configConfigger   config.Configger
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+)
+
+var (
+	_ GetServicer           = (*subAccountService)(nil)
+	_ SubAccountServicer    = (*subAccountService)(nil)
+	_ WithServicer          = (*subAccountService)(nil)
+	_ config.GetConfigger   = (*subAccountService)(nil)
+	_ exchange.GetExchanger = (*subAccountService)(nil)
+	_ log.GetRuntimeLogger  = (*subAccountService)(nil)
+	_ util.GetTracer        = (*subAccountService)(nil)
+	_ util.GetUUIDer        = (*subAccountService)(nil)
+)
+
+// NewSubAccountServicer is a function.
+func NewSubAccountServicer(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) SubAccountServicer {
+	return &subAccountService{
+		configConfigger:   configConfigger,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *subAccountService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *subAccountService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *subAccountService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetTracer is a function.
+func (service *subAccountService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *subAccountService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *subAccountService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *subAccountService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// GetList is a function.
+// GetList discovers the sub-accounts of the master account. The sub-account
+// endpoints answer to the master credentials only, so it always calls the
+// default account, whatever the account of the runtime context.
+func (service *subAccountService) GetList(
+	ctx context.Context,
+) ([]om.SubAccounter, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetList",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetList",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if service.GetConfigger().GetPaperConfigger().GetEnabled() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrSubAccountPaperUnsupported).
+			Error(object.ErrSubAccountServiceGetList.Error())
+		traceSpan.RecordError(object.ErrSubAccountPaperUnsupported)
+		traceSpan.SetStatus(codes.Error, object.ErrSubAccountServiceGetList.Error())
+
+		return nil, object.ErrSubAccountPaperUnsupported
+	}
+
+	response, err := serviceAccountExchanger(
+		ctx,
+		service,
+		service.GetConfigger().GetKucoinConfigger().GetAccount(),
+	).SubAccounts()
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if err = subAccountServiceResponseError(
+		response,
+		err,
+		object.ErrSubAccountKucoinServiceGetList,
+	); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSubAccountKucoinServiceGetList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSubAccountKucoinServiceGetList.Error())
+
+		return nil, err
+	}
+
+	kucoinSubAccountsModel := kucoin.SubAccountsModel{}
+
+	if err = response.ReadData(&kucoinSubAccountsModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldKucoinSubAccountModels, kucoinSubAccountsModel).
+		Debug(object.URIEmpty)
+
+	omSubAccounts := make([]om.SubAccounter, 0, len(kucoinSubAccountsModel))
+
+	for _, kucoinSubAccountModel := range kucoinSubAccountsModel {
+		omSubAccounts = append(omSubAccounts, om.NewSubAccount(
+			kucoinSubAccountModel.SubName,
+			kucoinSubAccountModel.SubUserId,
+			uuid.Nil,
+		))
+	}
+
+	return omSubAccounts, nil
+}
+
+// Transfer is a function.
+// Transfer moves the amount of the currency between the trade accounts of the
+// master account and of the sub-account, into the sub-account for the in
+// direction and out of it for the out direction, and returns the id of the
+// transfer. The exchange transfers a clientOid once, so a retry with the same
+// clientOid moves nothing twice.
+func (service *subAccountService) Transfer(
+	ctx context.Context,
+	clientOID string,
+	subUserID string,
+	currency string,
+	amount string,
+	direction object.SubTransferDirectionType,
+) (string, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Transfer",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":        "Transfer",
+		"rt_ctx":      utilRuntimeContext,
+		"sp_ctx":      utilSpanContext,
+		"config":      service.configConfigger,
+		"client_oid":  clientOID,
+		"sub_user_id": subUserID,
+		"currency":    currency,
+		"amount":      amount,
+		"direction":   direction,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if service.GetConfigger().GetPaperConfigger().GetEnabled() {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrSubAccountPaperUnsupported).
+			Error(object.ErrSubAccountServiceTransfer.Error())
+		traceSpan.RecordError(object.ErrSubAccountPaperUnsupported)
+		traceSpan.SetStatus(codes.Error, object.ErrSubAccountServiceTransfer.Error())
+
+		return object.URIEmpty, object.ErrSubAccountPaperUnsupported
+	}
+
+	response, err := serviceAccountExchanger(
+		ctx,
+		service,
+		service.GetConfigger().GetKucoinConfigger().GetAccount(),
+	).SubTransferV2(map[string]string{
+		object.URIKucoinParamAccountType:    object.URIKucoinSubTransferAccountTypeTrade,
+		object.URIKucoinParamAmount:         amount,
+		object.URIKucoinParamClientOID:      clientOID,
+		object.URIFieldCurrency:             currency,
+		object.URIKucoinParamDirection:      string(direction),
+		object.URIKucoinParamSubAccountType: object.URIKucoinSubTransferAccountTypeTrade,
+		object.URIKucoinParamSubUserID:      subUserID,
+	})
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if err = subAccountServiceResponseError(
+		response,
+		err,
+		object.ErrSubAccountKucoinServiceTransfer,
+	); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSubAccountKucoinServiceTransfer.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSubAccountKucoinServiceTransfer.Error())
+
+		return object.URIEmpty, err
+	}
+
+	kucoinSubTransferResultModel := kucoin.SubTransferResultModel{}
+
+	if err = response.ReadData(&kucoinSubTransferResultModel); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return object.URIEmpty, fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(
+			object.URIFieldKucoinSubTransferResultModel,
+			kucoinSubTransferResultModel,
+		).
+		Debug(object.URIEmpty)
+
+	return kucoinSubTransferResultModel.OrderId, nil
+}
+
+func subAccountServiceResponseError(
+	response *kucoin.ApiResponse,
+	err error,
+	errKucoin error,
+) error {
+	if err != nil {
+		return fmt.Errorf("%w: %w", errKucoin, err)
+	}
+
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
+		return fmt.Errorf("%w: %w", errKucoin, errResponse)
+	}
+
+	return nil
+}
@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Kucoin/kucoin-go-sdk"
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/exchange/exchangetest"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/util"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	subAccountServiceTestPathSubTransfer = "/api/v2/accounts/sub-transfer"
+	subAccountServiceTestPathSubAccounts = "/api/v1/sub-accounts"
+)
+
+type subAccountServiceTest struct {
+	masterFakeServerer exchangetest.FakeServerer
+	alphaFakeServerer  exchangetest.FakeServerer
+	subAccountServicer SubAccountServicer
+}
+
+func newSubAccountServiceTestFakeServer(
+	t *testing.T,
+	key string,
+) exchangetest.FakeServerer {
+	t.Helper()
+
+	fakeServerer := exchangetest.NewFakeServer(
+		kucoin.ApiKeyOption(key),
+		kucoin.ApiSecretOption("secret"),
+		kucoin.ApiPassPhraseOption("passphrase"),
+		kucoin.ApiKeyVersionOption(kucoin.ApiKeyVersionV2),
+	)
+	t.Cleanup(fakeServerer.Close)
+
+	return fakeServerer
+}
+
+// newSubAccountServiceTest serves the master account and the alpha
+// sub-account, each from a server of its own.
+func newSubAccountServiceTest(
+	t *testing.T,
+	paper bool,
+) *subAccountServiceTest {
+	t.Helper()
+
+	masterFakeServerer := newSubAccountServiceTestFakeServer(t, "master")
+	alphaFakeServerer := newSubAccountServiceTestFakeServer(t, "alpha")
+
+	configConfigger := config.NewConfig(
+		config.WithKucoinConfigger(
+			config.WithKucoinConfigAccount("master"),
+		),
+		config.WithLogConfigger(),
+		config.WithPaperConfigger(
+			config.WithPaperConfigEnabled(paper),
+		),
+	)
+
+	return &subAccountServiceTest{
+		masterFakeServerer: masterFakeServerer,
+		alphaFakeServerer:  alphaFakeServerer,
+		subAccountServicer: NewSubAccountServicer(
+			configConfigger,
+			log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+			trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+			util.NewUUID(),
+			exchange.NewAccountExchange(
+				"master",
+				masterFakeServerer.GetExchanger(),
+				map[string]exchange.Exchanger{"alpha": alphaFakeServerer.GetExchanger()},
+			),
+		),
+	}
+}
+
+// newSubAccountServiceTestContext names the alpha sub-account, as the jobs of
+// a strategy that trades it do.
+func newSubAccountServiceTestContext() context.Context {
+	return util.WithRuntimeContextValue(
+		context.Background(),
+		object.URIRuntimeContextAccount,
+		"alpha",
+	)
+}
+
+func TestSubAccountServiceExchanger(t *testing.T) {
+	t.Parallel()
+
+	test := newSubAccountServiceTest(t, false)
+
+	test.masterFakeServerer.Handle(
+		http.MethodGet,
+		object.URIKucoinPathAccounts,
+		exchangetest.NewFakeSuccessResponse([]any{}),
+	)
+	test.alphaFakeServerer.Handle(
+		http.MethodGet,
+		object.URIKucoinPathAccounts,
+		exchangetest.NewFakeSuccessResponse([]any{}),
+	)
+
+	getter, ok := test.subAccountServicer.(serviceGetter)
+	if !ok {
+		t.Fatal("SubAccountServicer is not a serviceGetter")
+	}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		wantMaster  int
+		wantAlpha   int
+		wantErr     error
+		wantAccount string
+	}{
+		{
+			name:        "default",
+			ctx:         context.Background(),
+			wantMaster:  1,
+			wantAlpha:   0,
+			wantErr:     nil,
+			wantAccount: "master",
+		},
+		{
+			name:        "sub",
+			ctx:         newSubAccountServiceTestContext(),
+			wantMaster:  0,
+			wantAlpha:   1,
+			wantErr:     nil,
+			wantAccount: "alpha",
+		},
+		{
+			name: "unknown",
+			ctx: util.WithRuntimeContextValue(
+				context.Background(),
+				object.URIRuntimeContextAccount,
+				"gamma",
+			),
+			wantMaster:  0,
+			wantAlpha:   0,
+			wantErr:     object.ErrExchangeAccountUnknown,
+			wantAccount: "gamma",
+		},
+	}
+
+	// The cases run in turn, as they count the requests of the same servers.
+	for _, subTest := range tests {
+		masterRequests := len(test.masterFakeServerer.GetRequesters(
+			http.MethodGet,
+			object.URIKucoinPathAccounts,
+		))
+		alphaRequests := len(test.alphaFakeServerer.GetRequesters(
+			http.MethodGet,
+			object.URIKucoinPathAccounts,
+		))
+
+		if got := serviceAccount(subTest.ctx, getter); got != subTest.wantAccount {
+			t.Errorf("%s: serviceAccount() = %q, want %q", subTest.name, got, subTest.wantAccount)
+		}
+
+		_, err := serviceExchanger(subTest.ctx, getter).Accounts(object.URIEmpty, object.URIEmpty)
+		if !errors.Is(err, subTest.wantErr) {
+			t.Errorf("%s: Accounts() error = %v, want %v", subTest.name, err, subTest.wantErr)
+		}
+
+		if got := len(test.masterFakeServerer.GetRequesters(
+			http.MethodGet,
+			object.URIKucoinPathAccounts,
+		)) - masterRequests; got != subTest.wantMaster {
+			t.Errorf("%s: master requests = %d, want %d", subTest.name, got, subTest.wantMaster)
+		}
+
+		if got := len(test.alphaFakeServerer.GetRequesters(
+			http.MethodGet,
+			object.URIKucoinPathAccounts,
+		)) - alphaRequests; got != subTest.wantAlpha {
+			t.Errorf("%s: alpha requests = %d, want %d", subTest.name, got, subTest.wantAlpha)
+		}
+	}
+}
+
+func TestSubAccountServiceGetList(t *testing.T) {
+	t.Parallel()
+
+	test := newSubAccountServiceTest(t, false)
+
+	test.masterFakeServerer.Handle(
+		http.MethodGet,
+		subAccountServiceTestPathSubAccounts,
+		exchangetest.NewFakeSuccessResponse([]any{
+			map[string]any{"subName": "alpha", "userId": "1", "subUserId": "sub-alpha"},
+			map[string]any{"subName": "beta", "userId": "2", "subUserId": "sub-beta"},
+		}),
+	)
+
+	// Only the master account sees its sub-accounts, whichever account the
+	// context names.
+	omSubAccounts, err := test.subAccountServicer.GetList(newSubAccountServiceTestContext())
+	if err != nil {
+		t.Fatalf("GetList() error = %v", err)
+	}
+
+	if len(omSubAccounts) != 2 ||
+		omSubAccounts[0].GetSubName() != "alpha" ||
+		omSubAccounts[0].GetSubUserID() != "sub-alpha" ||
+		omSubAccounts[1].GetSubName() != "beta" ||
+		omSubAccounts[1].GetSubUserID() != "sub-beta" {
+		t.Errorf("GetList() = %v, want alpha and beta", omSubAccounts)
+	}
+
+	if got := len(test.alphaFakeServerer.GetRequesters(
+		http.MethodGet,
+		subAccountServiceTestPathSubAccounts,
+	)); got != 0 {
+		t.Errorf("alpha requests = %d, want 0", got)
+	}
+}
+
+func TestSubAccountServiceTransfer(t *testing.T) {
+	t.Parallel()
+
+	test := newSubAccountServiceTest(t, false)
+
+	test.masterFakeServerer.Enqueue(
+		http.MethodPost,
+		subAccountServiceTestPathSubTransfer,
+		exchangetest.NewFakeSuccessResponse(map[string]any{"orderId": "transfer"}),
+		exchangetest.NewFakeErrorResponse(
+			http.StatusBadRequest,
+			object.URIKucoinCodeBalanceInsufficient,
+			"Balance insufficient",
+		),
+	)
+
+	orderID, err := test.subAccountServicer.Transfer(
+		newSubAccountServiceTestContext(),
+		"client",
+		"sub-alpha",
+		"USDT",
+		"100",
+		object.SubTransferDirectionTypeIn,
+	)
+	if err != nil || orderID != "transfer" {
+		t.Errorf("Transfer() = %q, %v, want %q", orderID, err, "transfer")
+	}
+
+	fakeRequesters := test.masterFakeServerer.GetRequesters(
+		http.MethodPost,
+		subAccountServiceTestPathSubTransfer,
+	)
+	if len(fakeRequesters) != 1 {
+		t.Fatalf("master requests = %d, want 1", len(fakeRequesters))
+	}
+
+	body := map[string]string{}
+	if err = json.Unmarshal(fakeRequesters[0].GetBody(), &body); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	want := map[string]string{
+		object.URIKucoinParamAccountType:    object.URIKucoinSubTransferAccountTypeTrade,
+		object.URIKucoinParamAmount:         "100",
+		object.URIKucoinParamClientOID:      "client",
+		object.URIFieldCurrency:             "USDT",
+		object.URIKucoinParamDirection:      string(object.SubTransferDirectionTypeIn),
+		object.URIKucoinParamSubAccountType: object.URIKucoinSubTransferAccountTypeTrade,
+		object.URIKucoinParamSubUserID:      "sub-alpha",
+	}
+
+	for key, value := range want {
+		if body[key] != value {
+			t.Errorf("body[%s] = %q, want %q", key, body[key], value)
+		}
+	}
+
+	if _, err = test.subAccountServicer.Transfer(
+		context.Background(),
+		"client",
+		"sub-alpha",
+		"USDT",
+		"100",
+		object.SubTransferDirectionTypeIn,
+	); !errors.Is(err, object.ErrSubAccountKucoinServiceTransfer) ||
+		!errors.Is(err, object.ErrKucoinBalanceInsufficient) {
+		t.Errorf("Transfer() error = %v, want %v", err, object.ErrSubAccountKucoinServiceTransfer)
+	}
+}
+
+func TestSubAccountServicePaper(t *testing.T) {
+	t.Parallel()
+
+	test := newSubAccountServiceTest(t, true)
+
+	if _, err := test.subAccountServicer.GetList(context.Background()); !errors.Is(
+		err,
+		object.ErrSubAccountPaperUnsupported,
+	) {
+		t.Errorf("GetList() error = %v, want %v", err, object.ErrSubAccountPaperUnsupported)
+	}
+
+	if _, err := test.subAccountServicer.Transfer(
+		context.Background(),
+		"client",
+		"sub-alpha",
+		"USDT",
+		"100",
+		object.SubTransferDirectionTypeIn,
+	); !errors.Is(err, object.ErrSubAccountPaperUnsupported) {
+		t.Errorf("Transfer() error = %v, want %v", err, object.ErrSubAccountPaperUnsupported)
+	}
+}
@@ -0,0 +1,1079 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/repository"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// SymbolServicer is an interface.
+	SymbolServicer interface {
+		// Create is a function.
+		Create(
+			context.Context,
+			om.Symboler,
+		) (uuid.UUID, error)
+		// DeleteAll is a function.
+		DeleteAll(
+			context.Context,
+		) (time.Time, error)
+		// Get is a function.
+		Get(
+			context.Context,
+			uuid.UUID,
+		) (om.Symboler, error)
+		// GetBySymbol is a function.
+		GetBySymbol(
+			context.Context,
+			string,
+		) (om.Symboler, error)
+		// GetListFromRemote is a function.
+		GetListFromRemote(
+			context.Context,
+		) error
+		// GetListFromRepository is a function.
+		GetListFromRepository(
+			context.Context,
+			dao.Paginationer,
+			dao.SymbolFilterer,
+		) ([]om.Symboler, dao.Cursorer, error)
+		// Upsert is a function.
+		Upsert(
+			context.Context,
+			om.Symboler,
+		) (uuid.UUID, error)
+		// Validate is a function.
+		Validate(
+			context.Context,
+			dto.PlaceOrderRequester,
+		) (dto.PlaceOrderRequester, error)
+		// ValidateStop is a function.
+		ValidateStop(
+			context.Context,
+			dto.PlaceStopOrderRequester,
+		) (dto.PlaceStopOrderRequester, error)
+	}
+
+	// GetSymbolServicer is an interface.
+	GetSymbolServicer interface {
+		// GetSymbolServicer is a function.
+		GetSymbolServicer() SymbolServicer
+	}
+
+	symbolService struct {
+		configConfigger   config.Configger
+		repositorier      repository.SymbolRepositorier
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
+	}
+)
+
+var (
+	_ GetServicer                      = (*symbolService)(nil)
+	_ SymbolServicer                   = (*symbolService)(nil)
+	_ WithServicer                     = (*symbolService)(nil)
+	_ config.GetConfigger              = (*symbolService)(nil)
+	_ exchange.GetExchanger            = (*symbolService)(nil)
+	_ log.GetRuntimeLogger             = (*symbolService)(nil)
+	_ repository.GetSymbolRepositorier = (*symbolService)(nil)
+	_ util.GetTracer                   = (*symbolService)(nil)
+	_ util.GetUUIDer                   = (*symbolService)(nil)
+)
+
+// NewSymbolServicer is a function.
+func NewSymbolServicer(
+	configConfigger config.Configger,
+	repositorier repository.SymbolRepositorier,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	exchangeExchanger exchange.Exchanger,
+) SymbolServicer {
+	return &symbolService{
+		configConfigger:   configConfigger,
+		repositorier:      repositorier,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
+	}
+}
+
+// GetConfigger is a function.
+func (service *symbolService) GetConfigger() config.Configger {
+	return service.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (service *symbolService) GetRuntimeLogger() log.RuntimeLogger {
+	return service.logRuntimeLogger
+}
+
+// GetServicer is a function.
+func (service *symbolService) GetServicer() Servicer {
+	return service.servicer
+}
+
+// GetSymbolRepositorier is a function.
+func (service *symbolService) GetSymbolRepositorier() repository.SymbolRepositorier {
+	return service.repositorier
+}
+
+// GetTracer is a function.
+func (service *symbolService) GetTracer() trace.Tracer {
+	return service.traceTracer
+}
+
+// GetUUIDer is a function.
+func (service *symbolService) GetUUIDer() util.UUIDer {
+	return service.utilUUIDer
+}
+
+// GetExchanger is a function.
+func (service *symbolService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
+}
+
+// WithServicer is a function.
+func (service *symbolService) WithServicer(
+	servicer Servicer,
+) {
+	service.servicer = servicer
+}
+
+// Create is a function.
+func (service *symbolService) Create(
+	ctx context.Context,
+	omSymboler om.Symboler,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Create",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":        "Create",
+		"rt_ctx":      utilRuntimeContext,
+		"sp_ctx":      utilSpanContext,
+		"config":      service.configConfigger,
+		"om_symboler": omSymboler,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoSymbol := dao.NewSymbol(
+		time.Time{},
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		uuid.Nil,
+		omSymboler.GetBaseCurrency(),
+		omSymboler.GetBaseIncrement(),
+		omSymboler.GetBaseMaxSize(),
+		omSymboler.GetBaseMinSize(),
+		omSymboler.GetFeeCurrency(),
+		omSymboler.GetMarket(),
+		omSymboler.GetMinFunds(),
+		omSymboler.GetName(),
+		omSymboler.GetPriceIncrement(),
+		omSymboler.GetPriceLimitRate(),
+		omSymboler.GetQuoteCurrency(),
+		omSymboler.GetQuoteIncrement(),
+		omSymboler.GetQuoteMaxSize(),
+		omSymboler.GetQuoteMinSize(),
+		omSymboler.GetSymbol(),
+		omSymboler.GetEnableTrading(),
+		omSymboler.GetIsMarginEnabled(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOSymbol, daoSymbol).
+		Debug(object.URIEmpty)
+
+	symbolID, err := service.GetSymbolRepositorier().Create(ctx, daoSymbol)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolRepositoryCreate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolRepositoryCreate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldSymbolID, symbolID).
+		Debug(object.URIEmpty)
+
+	return symbolID, nil
+}
+
+// DeleteAll is a function.
+func (service *symbolService) DeleteAll(
+	ctx context.Context,
+) (time.Time, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"DeleteAll",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "DeleteAll",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	deletedAt, err := service.GetSymbolRepositorier().DeleteAll(ctx)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolRepositoryDeleteAll.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolRepositoryDeleteAll.Error())
+
+		return time.Time{}, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDeletedAt, deletedAt).
+		Debug(object.URIEmpty)
+
+	return deletedAt, nil
+}
+
+// Get is a function.
+func (service *symbolService) Get(
+	ctx context.Context,
+	id uuid.UUID,
+) (om.Symboler, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Get",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "Get",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"id":     id,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoSymbol, err := service.GetSymbolRepositorier().Read(ctx, id)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolRepositoryRead.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolRepositoryRead.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOSymbol, daoSymbol).
+		Debug(object.URIEmpty)
+
+	omSymbol := om.NewSymbol(
+		daoSymbol.GetBaseCurrency(),
+		daoSymbol.GetBaseIncrement(),
+		daoSymbol.GetBaseMaxSize(),
+		daoSymbol.GetBaseMinSize(),
+		daoSymbol.GetFeeCurrency(),
+		daoSymbol.GetMarket(),
+		daoSymbol.GetMinFunds(),
+		daoSymbol.GetName(),
+		daoSymbol.GetPriceIncrement(),
+		daoSymbol.GetPriceLimitRate(),
+		daoSymbol.GetQuoteCurrency(),
+		daoSymbol.GetQuoteIncrement(),
+		daoSymbol.GetQuoteMaxSize(),
+		daoSymbol.GetQuoteMinSize(),
+		daoSymbol.GetSymbol(),
+		daoSymbol.GetEnableTrading(),
+		daoSymbol.GetIsMarginEnabled(),
+		daoSymbol.GetID(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMSymbol, omSymbol).
+		Debug(object.URIEmpty)
+
+	return omSymbol, nil
+}
+
+// GetBySymbol is a function.
+func (service *symbolService) GetBySymbol(
+	ctx context.Context,
+	symbol string,
+) (om.Symboler, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetBySymbol",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetBySymbol",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"symbol": symbol,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omSymbols, _, err := service.GetListFromRepository(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewSymbolFilter(symbol, false),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolServiceGetListFromRepository.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceGetListFromRepository.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMSymbols, omSymbols).
+		Debug(object.URIEmpty)
+
+	if len(omSymbols) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrSymbolNotFound).
+			Error(object.ErrSymbolServiceGetBySymbol.Error())
+		traceSpan.RecordError(object.ErrSymbolNotFound)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceGetBySymbol.Error())
+
+		return nil, object.ErrSymbolNotFound
+	}
+
+	return omSymbols[0], nil
+}
+
+// GetListFromRemote is a function.
+func (service *symbolService) GetListFromRemote(
+	ctx context.Context,
+) error {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRemote",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetListFromRemote",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	response, err := serviceExchanger(ctx, service).Symbols(object.URIEmpty)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolKucoinServiceGetList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolKucoinServiceGetList.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldResponse, response).
+		Debug(object.URIEmpty)
+
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, errResponse).
+			Error(object.ErrSymbolKucoinServiceGetList.Error())
+		traceSpan.RecordError(errResponse)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolKucoinServiceGetList.Error())
+
+		return fmt.Errorf("%w: %w", object.ErrSymbolKucoinServiceGetList, errResponse)
+	}
+
+	symbolModels := []*exchange.SymbolModel{}
+
+	if err = response.ReadData(&symbolModels); err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrKucoinServiceReadData.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrKucoinServiceReadData.Error())
+
+		return fmt.Errorf("%w", err)
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldSymbolModels, symbolModels).
+		Debug(object.URIEmpty)
+
+	for key, value := range symbolModels {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldValue, value).
+			Debug(object.URIEmpty)
+
+		omSymbol := om.NewSymbol(
+			value.BaseCurrency,
+			value.BaseIncrement,
+			value.BaseMaxSize,
+			value.BaseMinSize,
+			value.FeeCurrency,
+			value.Market,
+			value.MinFunds,
+			value.Name,
+			value.PriceIncrement,
+			value.PriceLimitRate,
+			value.QuoteCurrency,
+			value.QuoteIncrement,
+			value.QuoteMaxSize,
+			value.QuoteMinSize,
+			value.Symbol,
+			value.EnableTrading,
+			value.IsMarginEnabled,
+			uuid.Nil,
+		)
+
+		symbolID, errSymbolUpsert := service.GetServicer().GetSymbolServicer().Upsert(ctx, omSymbol)
+		if errSymbolUpsert != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, errSymbolUpsert).
+				Error(object.ErrSymbolServiceUpsert.Error())
+			traceSpan.RecordError(errSymbolUpsert)
+			traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceUpsert.Error())
+
+			return errSymbolUpsert
+		}
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldSymbolID, symbolID).
+			Debug(object.URIEmpty)
+	}
+
+	return nil
+}
+
+// GetListFromRepository is a function.
+func (service *symbolService) GetListFromRepository(
+	ctx context.Context,
+	daoPaginationer dao.Paginationer,
+	daoSymbolFilterer dao.SymbolFilterer,
+) ([]om.Symboler, dao.Cursorer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetListFromRepository",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                "GetListFromRepository",
+		"rt_ctx":              utilRuntimeContext,
+		"sp_ctx":              utilSpanContext,
+		"config":              service.configConfigger,
+		"dao_paginationer":    daoPaginationer,
+		"dao_symbol_filterer": daoSymbolFilterer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoSymbols, daoCursorer, err := service.GetSymbolRepositorier().
+		ReadList(ctx, daoPaginationer, daoSymbolFilterer)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolRepositoryReadList.Error())
+
+		return nil, nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOSymbols, daoSymbols).
+		WithField(object.URIFieldDAOCursor, daoCursorer).
+		Debug(object.URIEmpty)
+
+	omSymbols := make([]om.Symboler, 0, len(daoSymbols))
+
+	for key, daoSymbol := range daoSymbols {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldDAOSymbol, daoSymbol).
+			Debug(object.URIEmpty)
+
+		omSymbols = append(omSymbols, om.NewSymbol(
+			daoSymbol.GetBaseCurrency(),
+			daoSymbol.GetBaseIncrement(),
+			daoSymbol.GetBaseMaxSize(),
+			daoSymbol.GetBaseMinSize(),
+			daoSymbol.GetFeeCurrency(),
+			daoSymbol.GetMarket(),
+			daoSymbol.GetMinFunds(),
+			daoSymbol.GetName(),
+			daoSymbol.GetPriceIncrement(),
+			daoSymbol.GetPriceLimitRate(),
+			daoSymbol.GetQuoteCurrency(),
+			daoSymbol.GetQuoteIncrement(),
+			daoSymbol.GetQuoteMaxSize(),
+			daoSymbol.GetQuoteMinSize(),
+			daoSymbol.GetSymbol(),
+			daoSymbol.GetEnableTrading(),
+			daoSymbol.GetIsMarginEnabled(),
+			daoSymbol.GetID(),
+		))
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMSymbols, omSymbols).
+		Debug(object.URIEmpty)
+
+	return omSymbols, daoCursorer, nil
+}
+
+// Upsert is a function.
+func (service *symbolService) Upsert(
+	ctx context.Context,
+	omSymboler om.Symboler,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Upsert",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":        "Upsert",
+		"rt_ctx":      utilRuntimeContext,
+		"sp_ctx":      utilSpanContext,
+		"config":      service.configConfigger,
+		"om_symboler": omSymboler,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoSymbols, _, err := service.GetSymbolRepositorier().ReadList(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewSymbolFilter(omSymboler.GetSymbol(), false),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolRepositoryReadList.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOSymbols, daoSymbols).
+		Debug(object.URIEmpty)
+
+	if len(daoSymbols) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(daoSymbols) == 0`)
+
+		return service.Create(ctx, omSymboler)
+	}
+
+	daoSymbol := dao.NewSymbol(
+		daoSymbols[0].GetCreatedAt(),
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		daoSymbols[0].GetID(),
+		omSymboler.GetBaseCurrency(),
+		omSymboler.GetBaseIncrement(),
+		omSymboler.GetBaseMaxSize(),
+		omSymboler.GetBaseMinSize(),
+		omSymboler.GetFeeCurrency(),
+		omSymboler.GetMarket(),
+		omSymboler.GetMinFunds(),
+		omSymboler.GetName(),
+		omSymboler.GetPriceIncrement(),
+		omSymboler.GetPriceLimitRate(),
+		omSymboler.GetQuoteCurrency(),
+		omSymboler.GetQuoteIncrement(),
+		omSymboler.GetQuoteMaxSize(),
+		omSymboler.GetQuoteMinSize(),
+		omSymboler.GetSymbol(),
+		omSymboler.GetEnableTrading(),
+		omSymboler.GetIsMarginEnabled(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOSymbol, daoSymbol).
+		Debug(object.URIEmpty)
+
+	updatedAt, err := service.GetSymbolRepositorier().Update(ctx, daoSymbol)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolRepositoryUpdate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return daoSymbol.GetID(), nil
+}
+
+// Validate is a function.
+// The price is rounded away from the market to the price increment, the sizes
+// and funds down to their increments, and an order on a disabled symbol or
+// below the minimum size or funds is refused. A symbol missing from the table
+// triggers one sync from the exchange.
+func (service *symbolService) Validate(
+	ctx context.Context,
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+) (dto.PlaceOrderRequester, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Validate",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                      "Validate",
+		"rt_ctx":                    utilRuntimeContext,
+		"sp_ctx":                    utilSpanContext,
+		"config":                    service.configConfigger,
+		"dto_place_order_requester": dtoPlaceOrderRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omSymboler, err := service.GetServicer().
+		GetSymbolServicer().
+		GetBySymbol(ctx, dtoPlaceOrderRequester.GetSymbol())
+	if errors.Is(err, object.ErrSymbolNotFound) {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`errors.Is(err, object.ErrSymbolNotFound)`)
+
+		if err = service.GetServicer().GetSymbolServicer().GetListFromRemote(ctx); err != nil {
+			service.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrSymbolServiceGetListFromRemote.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceGetListFromRemote.Error())
+
+			return nil, err
+		}
+
+		omSymboler, err = service.GetServicer().
+			GetSymbolServicer().
+			GetBySymbol(ctx, dtoPlaceOrderRequester.GetSymbol())
+	}
+
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolServiceGetBySymbol.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceGetBySymbol.Error())
+
+		return nil, fmt.Errorf("%w: %s", err, dtoPlaceOrderRequester.GetSymbol())
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMSymbol, omSymboler).
+		Debug(object.URIEmpty)
+
+	dtoValidPlaceOrderRequester, err := symbolServiceValidate(omSymboler, dtoPlaceOrderRequester)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolServiceValidate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceValidate.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDTOPlaceOrderRequest, dtoValidPlaceOrderRequester).
+		Debug(object.URIEmpty)
+
+	return dtoValidPlaceOrderRequester, nil
+}
+
+// ValidateStop is a function.
+// The order is validated like any other, and the stop price is rounded to the
+// price increment the same way as the price.
+func (service *symbolService) ValidateStop(
+	ctx context.Context,
+	dtoPlaceStopOrderRequester dto.PlaceStopOrderRequester,
+) (dto.PlaceStopOrderRequester, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"ValidateStop",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":                           "ValidateStop",
+		"rt_ctx":                         utilRuntimeContext,
+		"sp_ctx":                         utilSpanContext,
+		"config":                         service.configConfigger,
+		"dto_place_stop_order_requester": dtoPlaceStopOrderRequester,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	dtoPlaceOrderRequester, err := service.GetServicer().
+		GetSymbolServicer().
+		Validate(ctx, dtoPlaceStopOrderRequester)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolServiceValidate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceValidate.Error())
+
+		return nil, err
+	}
+
+	omSymboler, err := service.GetServicer().
+		GetSymbolServicer().
+		GetBySymbol(ctx, dtoPlaceStopOrderRequester.GetSymbol())
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolServiceGetBySymbol.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceGetBySymbol.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMSymbol, omSymboler).
+		Debug(object.URIEmpty)
+
+	stopPrice, err := symbolServiceRound(
+		dtoPlaceStopOrderRequester.GetStopPrice(),
+		omSymboler.GetPriceIncrement(),
+		dtoPlaceStopOrderRequester.GetSide() == object.OrderSideTypeSell,
+	)
+	below, errBelow := symbolServiceBelow(stopPrice, "0", true)
+	if err != nil || errBelow != nil || below || stopPrice == object.URIEmpty {
+		err = fmt.Errorf("%w: stop price %s", object.ErrOrderPriceInvalid, stopPrice)
+
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrSymbolServiceValidateStop.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrSymbolServiceValidateStop.Error())
+
+		return nil, err
+	}
+
+	dtoValidPlaceStopOrderRequester := dto.NewPlaceStopOrderRequest(
+		dtoPlaceOrderRequester.GetClientOID(),
+		dtoPlaceOrderRequester.GetFunds(),
+		dtoPlaceOrderRequester.GetOrderType(),
+		dtoPlaceOrderRequester.GetPrice(),
+		dtoPlaceOrderRequester.GetRemark(),
+		dtoPlaceOrderRequester.GetSide(),
+		dtoPlaceOrderRequester.GetSize(),
+		dtoPlaceStopOrderRequester.GetStop(),
+		stopPrice,
+		dtoPlaceOrderRequester.GetSTP(),
+		dtoPlaceOrderRequester.GetSymbol(),
+		dtoPlaceOrderRequester.GetTimeInForce(),
+		dtoPlaceOrderRequester.GetTradeType(),
+		dtoPlaceOrderRequester.GetVisibleSize(),
+		dtoPlaceOrderRequester.GetCancelAfter(),
+		dtoPlaceOrderRequester.GetHidden(),
+		dtoPlaceOrderRequester.GetIceBerg(),
+		dtoPlaceOrderRequester.GetPostOnly(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDTOPlaceStopOrderRequest, dtoValidPlaceStopOrderRequester).
+		Debug(object.URIEmpty)
+
+	return dtoValidPlaceStopOrderRequester, nil
+}
+
+func symbolServiceValidate(
+	omSymboler om.Symboler,
+	dtoPlaceOrderRequester dto.PlaceOrderRequester,
+) (dto.PlaceOrderRequester, error) {
+	if !omSymboler.GetEnableTrading() {
+		return nil, fmt.Errorf("%w: %s", object.ErrSymbolTradingDisabled, omSymboler.GetSymbol())
+	}
+
+	price, err := symbolServiceRound(
+		dtoPlaceOrderRequester.GetPrice(),
+		omSymboler.GetPriceIncrement(),
+		dtoPlaceOrderRequester.GetSide() == object.OrderSideTypeSell,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", object.ErrOrderPriceInvalid, err)
+	}
+
+	size, err := symbolServiceRound(
+		dtoPlaceOrderRequester.GetSize(),
+		omSymboler.GetBaseIncrement(),
+		false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", object.ErrOrderSizeBelowMinimum, err)
+	}
+
+	funds, err := symbolServiceRound(
+		dtoPlaceOrderRequester.GetFunds(),
+		omSymboler.GetQuoteIncrement(),
+		false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", object.ErrOrderFundsBelowMinimum, err)
+	}
+
+	visibleSize, err := symbolServiceRound(
+		dtoPlaceOrderRequester.GetVisibleSize(),
+		omSymboler.GetBaseIncrement(),
+		false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", object.ErrOrderSizeBelowMinimum, err)
+	}
+
+	below, err := symbolServiceBelow(price, "0", true)
+	if err != nil || below {
+		return nil, fmt.Errorf("%w: price %s", object.ErrOrderPriceInvalid, price)
+	}
+
+	below, err = symbolServiceBelow(size, omSymboler.GetBaseMinSize(), false)
+	if err != nil || below {
+		return nil, fmt.Errorf(
+			"%w: size %s below %s",
+			object.ErrOrderSizeBelowMinimum,
+			size,
+			omSymboler.GetBaseMinSize(),
+		)
+	}
+
+	minFunds := omSymboler.GetMinFunds()
+	if minFunds == object.URIEmpty {
+		minFunds = omSymboler.GetQuoteMinSize()
+	}
+
+	value := funds
+	if price != object.URIEmpty && size != object.URIEmpty {
+		if value, err = util.DecimalMultiply(price, size); err != nil {
+			return nil, fmt.Errorf("%w: %w", object.ErrOrderFundsBelowMinimum, err)
+		}
+	}
+
+	below, err = symbolServiceBelow(value, minFunds, false)
+	if err != nil || below {
+		return nil, fmt.Errorf(
+			"%w: funds %s below %s",
+			object.ErrOrderFundsBelowMinimum,
+			value,
+			minFunds,
+		)
+	}
+
+	return dto.NewPlaceOrderRequest(
+		dtoPlaceOrderRequester.GetClientOID(),
+		funds,
+		dtoPlaceOrderRequester.GetOrderType(),
+		price,
+		dtoPlaceOrderRequester.GetRemark(),
+		dtoPlaceOrderRequester.GetSide(),
+		size,
+		dtoPlaceOrderRequester.GetSTP(),
+		dtoPlaceOrderRequester.GetSymbol(),
+		dtoPlaceOrderRequester.GetTimeInForce(),
+		dtoPlaceOrderRequester.GetTradeType(),
+		visibleSize,
+		dtoPlaceOrderRequester.GetCancelAfter(),
+		dtoPlaceOrderRequester.GetHidden(),
+		dtoPlaceOrderRequester.GetIceBerg(),
+		dtoPlaceOrderRequester.GetPostOnly(),
+	), nil
+}
+
+// symbolServiceBelow reports whether the value is below the minimum, or equal
+// to it when inclusive is set. An empty value or minimum is never below.
+func symbolServiceBelow(
+	value string,
+	minimum string,
+	inclusive bool,
+) (bool, error) {
+	if value == object.URIEmpty || minimum == object.URIEmpty {
+		return false, nil
+	}
+
+	compare, err := util.DecimalCompare(value, minimum)
+	if err != nil {
+		return false, err
+	}
+
+	return compare < 0 || (inclusive && compare == 0), nil
+}
+
+// symbolServiceRound leaves the value alone when it or the increment is empty.
+func symbolServiceRound(
+	value string,
+	increment string,
+	up bool,
+) (string, error) {
+	if value == object.URIEmpty || increment == object.URIEmpty {
+		return value, nil
+	}
+
+	return util.DecimalRound(value, increment, up)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Kucoin/kucoin-go-sdk"
 	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/exchange"
 	"github.com/ShahoBashoki/kucoin/log"
 	"github.com/ShahoBashoki/kucoin/object"
 	"github.com/ShahoBashoki/kucoin/object/dao"
@@ -36,6 +37,11 @@ type (
 			context.Context,
 			uuid.UUID,
 		) (om.Tickerer, error)
+		// GetBySymbol is a function.
+		GetBySymbol(
+			context.Context,
+			string,
+		) (om.Tickerer, error)
 		// GetListFromRemote is a function.
 		GetListFromRemote(
 			context.Context,
@@ -46,6 +52,11 @@ type (
 			dao.Paginationer,
 			dao.TickerFilterer,
 		) ([]om.Tickerer, dao.Cursorer, error)
+		// Upsert is a function.
+		Upsert(
+			context.Context,
+			om.Tickerer,
+		) (uuid.UUID, error)
 	}
 
 	// GetTickerServicer is an interface.
@@ -55,13 +66,13 @@ type (
 	}
 
 	tickerService struct {
-		configConfigger  config.Configger
-		repositorier     repository.TickerRepositorier
-		logRuntimeLogger log.RuntimeLogger
-		servicer         Servicer
-		traceTracer      trace.Tracer
-		utilUUIDer       util.UUIDer
-		kucoinAPIService *kucoin.ApiService
+		configConfigger   config.Configger
+		repositorier      repository.TickerRepositorier
+		logRuntimeLogger  log.RuntimeLogger
+		servicer          Servicer
+		traceTracer       trace.Tracer
+		utilUUIDer        util.UUIDer
+		exchangeExchanger exchange.Exchanger
 	}
 )
 
@@ -70,6 +81,7 @@ var (
 	_ TickerServicer                   = (*tickerService)(nil)
 	_ WithServicer                     = (*tickerService)(nil)
 	_ config.GetConfigger              = (*tickerService)(nil)
+	_ exchange.GetExchanger            = (*tickerService)(nil)
 	_ log.GetRuntimeLogger             = (*tickerService)(nil)
 	_ repository.GetTickerRepositorier = (*tickerService)(nil)
 	_ util.GetTracer                   = (*tickerService)(nil)
@@ -83,16 +95,16 @@ func NewTickerServicer(
 	logRuntimeLogger log.RuntimeLogger,
 	traceTracer trace.Tracer,
 	utilUUIDer util.UUIDer,
-	kucoinAPIService *kucoin.ApiService,
+	exchangeExchanger exchange.Exchanger,
 ) TickerServicer {
 	return &tickerService{
-		configConfigger:  configConfigger,
-		repositorier:     repositorier,
-		logRuntimeLogger: logRuntimeLogger,
-		servicer:         nil,
-		traceTracer:      traceTracer,
-		utilUUIDer:       utilUUIDer,
-		kucoinAPIService: kucoinAPIService,
+		configConfigger:   configConfigger,
+		repositorier:      repositorier,
+		logRuntimeLogger:  logRuntimeLogger,
+		servicer:          nil,
+		traceTracer:       traceTracer,
+		utilUUIDer:        utilUUIDer,
+		exchangeExchanger: exchangeExchanger,
 	}
 }
 
@@ -126,9 +138,9 @@ func (service *tickerService) GetUUIDer() util.UUIDer {
 	return service.utilUUIDer
 }
 
-// GetAPIService is a function.
-func (service *tickerService) GetAPIService() *kucoin.ApiService {
-	return service.kucoinAPIService
+// GetExchanger is a function.
+func (service *tickerService) GetExchanger() exchange.Exchanger {
+	return service.exchangeExchanger
 }
 
 // WithServicer is a function.
@@ -336,6 +348,69 @@ func (service *tickerService) Get(
 	return omTicker, nil
 }
 
+// GetBySymbol is a function.
+func (service *tickerService) GetBySymbol(
+	ctx context.Context,
+	symbol string,
+) (om.Tickerer, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"GetBySymbol",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":   "GetBySymbol",
+		"rt_ctx": utilRuntimeContext,
+		"sp_ctx": utilSpanContext,
+		"config": service.configConfigger,
+		"symbol": symbol,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	omTickers, _, err := service.GetListFromRepository(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewTickerFilter(symbol, false),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrTickerServiceGetListFromRepository.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrTickerServiceGetListFromRepository.Error())
+
+		return nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMTickers, omTickers).
+		Debug(object.URIEmpty)
+
+	if len(omTickers) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, object.ErrTickerServiceGetBySymbol).
+			Error(object.ErrTickerServiceGetBySymbol.Error())
+		traceSpan.RecordError(object.ErrTickerServiceGetBySymbol)
+		traceSpan.SetStatus(codes.Error, object.ErrTickerServiceGetBySymbol.Error())
+
+		return nil, object.ErrTickerServiceGetBySymbol
+	}
+
+	return omTickers[0], nil
+}
+
 // GetListFromRemote is a function.
 func (service *tickerService) GetListFromRemote(
 	ctx context.Context,
@@ -362,7 +437,7 @@ func (service *tickerService) GetListFromRemote(
 		WithFields(fields).
 		Info(object.URIEmpty)
 
-	response, err := service.kucoinAPIService.Tickers()
+	response, err := serviceExchanger(ctx, service).Tickers()
 	if err != nil {
 		service.GetRuntimeLogger().
 			WithFields(fields).
@@ -379,15 +454,15 @@ func (service *tickerService) GetListFromRemote(
 		WithField(object.URIFieldResponse, response).
 		Debug(object.URIEmpty)
 
-	if response.Code != "200000" {
+	if errResponse := exchange.NewResponseError(response, nil); errResponse != nil {
 		service.GetRuntimeLogger().
 			WithFields(fields).
-			WithField(object.URIFieldError, response.Message).
+			WithField(object.URIFieldError, errResponse).
 			Error(object.ErrTickerKucoinServiceGetList.Error())
-		traceSpan.RecordError(object.ErrTickerKucoinServiceGetList)
+		traceSpan.RecordError(errResponse)
 		traceSpan.SetStatus(codes.Error, object.ErrTickerKucoinServiceGetList.Error())
 
-		return object.ErrTickerKucoinServiceGetList
+		return fmt.Errorf("%w: %w", object.ErrTickerKucoinServiceGetList, errResponse)
 	}
 
 	kucoinTickersModel := kucoin.TickersResponseModel{
@@ -438,16 +513,16 @@ func (service *tickerService) GetListFromRemote(
 			uuid.Nil,
 		)
 
-		tickerID, errTickerCreate := service.GetServicer().GetTickerServicer().Create(ctx, omTicker)
-		if errTickerCreate != nil {
+		tickerID, errTickerUpsert := service.GetServicer().GetTickerServicer().Upsert(ctx, omTicker)
+		if errTickerUpsert != nil {
 			service.GetRuntimeLogger().
 				WithFields(fields).
-				WithField(object.URIFieldError, errTickerCreate).
-				Error(object.ErrTickerServiceCreate.Error())
-			traceSpan.RecordError(errTickerCreate)
-			traceSpan.SetStatus(codes.Error, object.ErrTickerServiceCreate.Error())
+				WithField(object.URIFieldError, errTickerUpsert).
+				Error(object.ErrTickerServiceUpsert.Error())
+			traceSpan.RecordError(errTickerUpsert)
+			traceSpan.SetStatus(codes.Error, object.ErrTickerServiceUpsert.Error())
 
-			return errTickerCreate
+			return errTickerUpsert
 		}
 
 		service.GetRuntimeLogger().
@@ -545,3 +620,111 @@ func (service *tickerService) GetListFromRepository(
 
 	return omTickers, daoCursorer, nil
 }
+
+// Upsert is a function.
+func (service *tickerService) Upsert(
+	ctx context.Context,
+	omTickerer om.Tickerer,
+) (uuid.UUID, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = service.GetTracer().Start(
+		ctx,
+		"Upsert",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, service.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":        "Upsert",
+		"rt_ctx":      utilRuntimeContext,
+		"sp_ctx":      utilSpanContext,
+		"config":      service.configConfigger,
+		"om_tickerer": omTickerer,
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	daoTickers, _, err := service.GetTickerRepositorier().ReadList(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), 1),
+		dao.NewTickerFilter(omTickerer.GetSymbol(), false),
+	)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrTickerRepositoryReadList.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrTickerRepositoryReadList.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOTickers, daoTickers).
+		Debug(object.URIEmpty)
+
+	if len(daoTickers) == 0 {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			Debug(`len(daoTickers) == 0`)
+
+		return service.Create(ctx, omTickerer)
+	}
+
+	daoTicker := dao.NewTicker(
+		daoTickers[0].GetCreatedAt(),
+		time.Time{},
+		sql.NullTime{
+			Time:  time.Time{},
+			Valid: false,
+		},
+		daoTickers[0].GetID(),
+		omTickerer.GetAveragePrice(),
+		omTickerer.GetBuy(),
+		omTickerer.GetChangePrice(),
+		omTickerer.GetChangeRate(),
+		omTickerer.GetHigh(),
+		omTickerer.GetLast(),
+		omTickerer.GetLow(),
+		omTickerer.GetMakerCoefficient(),
+		omTickerer.GetMakerFeeRate(),
+		omTickerer.GetSell(),
+		omTickerer.GetSymbol(),
+		omTickerer.GetSymbolName(),
+		omTickerer.GetTakerCoefficient(),
+		omTickerer.GetTakerFeeRate(),
+		omTickerer.GetVol(),
+		omTickerer.GetVolValue(),
+	)
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldDAOTicker, daoTicker).
+		Debug(object.URIEmpty)
+
+	updatedAt, err := service.GetTickerRepositorier().Update(ctx, daoTicker)
+	if err != nil {
+		service.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrTickerRepositoryUpdate.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrTickerRepositoryUpdate.Error())
+
+		return uuid.Nil, err
+	}
+
+	service.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldUpdatedAt, updatedAt).
+		Debug(object.URIEmpty)
+
+	return daoTicker.GetID(), nil
+}
@@ -0,0 +1,4 @@
+/*
+Package strategy is a package.
+*/
+package strategy
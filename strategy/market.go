@@ -0,0 +1,96 @@
+package strategy
+
+import (
+	"context"
+
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dao"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/service"
+)
+
+type market struct {
+	objectTimer object.Timer
+	servicer    service.Servicer
+}
+
+var (
+	_ Marketer            = (*market)(nil)
+	_ object.GetTimer     = (*market)(nil)
+	_ service.GetServicer = (*market)(nil)
+)
+
+// NewMarket is a function.
+// It is the live Marketer, backed by the services and the wall clock.
+func NewMarket(
+	objectTimer object.Timer,
+	servicer service.Servicer,
+) *market {
+	return &market{
+		objectTimer: objectTimer,
+		servicer:    servicer,
+	}
+}
+
+// GetServicer is a function.
+func (market *market) GetServicer() service.Servicer {
+	return market.servicer
+}
+
+// GetTimer is a function.
+func (market *market) GetTimer() object.Timer {
+	return market.objectTimer
+}
+
+// GetDebtRatio is a function.
+// It is the debt ratio of the cross margin account, for strategies that borrow.
+func (market *market) GetDebtRatio(
+	ctx context.Context,
+) (string, error) {
+	return market.GetServicer().GetMarginServicer().GetDebtRatio(ctx)
+}
+
+// GetExchangeMode is a function.
+// It is the mode the exchange last reported, so a strategy can hold off while
+// no new order would be accepted.
+func (market *market) GetExchangeMode() object.ExchangeModeType {
+	return market.GetServicer().GetExchangeStatusServicer().GetMode()
+}
+
+// GetKlines is a function.
+func (market *market) GetKlines(
+	ctx context.Context,
+	dtoKlineRequester dto.KlineRequester,
+) ([]om.Kliner, error) {
+	return market.GetServicer().GetKlineServicer().GetListFromRemote(ctx, dtoKlineRequester)
+}
+
+// GetOrderBook is a function.
+// The local book is used when it is in sync, otherwise the remote snapshot.
+func (market *market) GetOrderBook(
+	ctx context.Context,
+	symbol string,
+) (om.OrderBooker, error) {
+	omOrderBooker, err := market.GetServicer().GetOrderBookServicer().GetOrderBook(ctx, symbol, 0)
+	if err == nil {
+		return omOrderBooker, nil
+	}
+
+	return market.GetServicer().GetOrderBookServicer().GetOrderBookFromRemote(ctx, symbol)
+}
+
+// GetTickers is a function.
+// Tickers are the USDT ones sorted by change rate, highest first.
+func (market *market) GetTickers(
+	ctx context.Context,
+	count uint32,
+) ([]om.Tickerer, error) {
+	omTickerers, _, err := market.GetServicer().GetTickerServicer().GetListFromRepository(
+		ctx,
+		dao.NewPagination(dao.NewCursor(0), count),
+		dao.NewTickerFilter(object.URIEmpty, true),
+	)
+
+	return omTickerers, err
+}
@@ -0,0 +1,325 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type openLowMarketRatioStrategy struct {
+	configConfigger  config.Configger
+	logRuntimeLogger log.RuntimeLogger
+	traceTracer      trace.Tracer
+	utilUUIDer       util.UUIDer
+	klineType        object.KlineTypeType
+	ratio            float64
+	tickerCount      uint32
+}
+
+var (
+	_ Strategier           = (*openLowMarketRatioStrategy)(nil)
+	_ config.GetConfigger  = (*openLowMarketRatioStrategy)(nil)
+	_ log.GetRuntimeLogger = (*openLowMarketRatioStrategy)(nil)
+	_ util.GetTracer       = (*openLowMarketRatioStrategy)(nil)
+	_ util.GetUUIDer       = (*openLowMarketRatioStrategy)(nil)
+)
+
+// NewOpenLowMarketRatioStrategy is a function.
+// It looks at the top tickers by change rate and signals a buy when one of their last candles
+// opened at its low and the bids outweigh the asks by more than ratio.
+// The parameters are kline_type, ratio and ticker_count.
+func NewOpenLowMarketRatioStrategy(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+	parameters map[string]string,
+) (Strategier, error) {
+	strategy := &openLowMarketRatioStrategy{
+		configConfigger:  configConfigger,
+		logRuntimeLogger: logRuntimeLogger,
+		traceTracer:      traceTracer,
+		utilUUIDer:       utilUUIDer,
+		klineType:        object.KlineTypeType5min,
+		ratio:            object.NUMStrategyOpenLowMarketRatioDefaultRatio,
+		tickerCount:      object.NUMTopTickerChangeRateCount,
+	}
+
+	if klineType, ok := parameters[object.URIStrategyParameterKlineType]; ok {
+		strategy.klineType = object.KlineTypeType(klineType)
+	}
+
+	if ratio, ok := parameters[object.URIStrategyParameterRatio]; ok {
+		ratioValue, err := strconv.ParseFloat(ratio, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		strategy.ratio = ratioValue
+	}
+
+	if tickerCount, ok := parameters[object.URIStrategyParameterTickerCount]; ok {
+		tickerCountValue, err := strconv.ParseUint(tickerCount, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		strategy.tickerCount = uint32(tickerCountValue)
+	}
+
+	return strategy, nil
+}
+
+// GetConfigger is a function.
+func (strategy *openLowMarketRatioStrategy) GetConfigger() config.Configger {
+	return strategy.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (strategy *openLowMarketRatioStrategy) GetRuntimeLogger() log.RuntimeLogger {
+	return strategy.logRuntimeLogger
+}
+
+// GetTracer is a function.
+func (strategy *openLowMarketRatioStrategy) GetTracer() trace.Tracer {
+	return strategy.traceTracer
+}
+
+// GetUUIDer is a function.
+func (strategy *openLowMarketRatioStrategy) GetUUIDer() util.UUIDer {
+	return strategy.utilUUIDer
+}
+
+// GetName is a function.
+func (strategy *openLowMarketRatioStrategy) GetName() string {
+	return object.URIStrategyOpenLowMarketRatio
+}
+
+// Evaluate is a function.
+// Nothing is signalled while the exchange is not open, and the scan stops at the
+// first symbol that finds the circuit of the exchange open, rather than fail
+// once for every symbol left.
+func (strategy *openLowMarketRatioStrategy) Evaluate(
+	ctx context.Context,
+	marketer Marketer,
+) ([]om.Signaler, error) {
+	var traceSpan trace.Span
+
+	ctx, traceSpan = strategy.GetTracer().Start(
+		ctx,
+		"Evaluate",
+		trace.WithSpanKind(trace.SpanKindProducer),
+	)
+	defer traceSpan.End()
+
+	utilRuntimeContext := util.NewRuntimeContext(ctx, strategy.GetUUIDer())
+	utilSpanContext := util.NewSpanContext(traceSpan)
+	fields := map[string]any{
+		"name":         "Evaluate",
+		"rt_ctx":       utilRuntimeContext,
+		"sp_ctx":       utilSpanContext,
+		"config":       strategy.GetConfigger(),
+		"strategy":     strategy.GetName(),
+		"kline_type":   strategy.klineType,
+		"ratio":        strategy.ratio,
+		"ticker_count": strategy.tickerCount,
+	}
+
+	strategy.GetRuntimeLogger().
+		WithFields(fields).
+		Info(object.URIEmpty)
+
+	if mode := marketer.GetExchangeMode(); mode != object.ExchangeModeTypeOpen {
+		strategy.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldExchangeMode, mode).
+			Debug(`mode != object.ExchangeModeTypeOpen`)
+
+		return []om.Signaler{}, nil
+	}
+
+	omTickers, err := marketer.GetTickers(ctx, strategy.tickerCount)
+	if err != nil {
+		strategy.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldError, err).
+			Error(object.ErrTickerServiceGetListFromRepository.Error())
+		traceSpan.RecordError(err)
+		traceSpan.SetStatus(codes.Error, object.ErrTickerServiceGetListFromRepository.Error())
+
+		return nil, err
+	}
+
+	strategy.GetRuntimeLogger().
+		WithFields(fields).
+		WithField(object.URIFieldOMTickers, omTickers).
+		Debug(object.URIEmpty)
+
+	omSignals := make([]om.Signaler, 0, len(omTickers))
+
+	for key, omTicker := range omTickers {
+		strategy.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldKey, key).
+			WithField(object.URIFieldOMTicker, omTicker).
+			Debug(object.URIEmpty)
+
+		timeNowUnix := marketer.GetTimer().NowUTC().Unix()
+		secondKlineType := util.KlineTypeToSecond(strategy.klineType)
+		startAt := util.KlineBucketStartAt(strategy.klineType, timeNowUnix) -
+			secondKlineType -
+			object.NUMKlineDifference
+
+		strategy.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldTimeNowUnix, timeNowUnix).
+			WithField(object.URIFieldSecondKlineType, secondKlineType).
+			WithField(object.URIFieldStartAt, startAt).
+			Debug(object.URIEmpty)
+
+		dtoKlineRequest := dto.NewKlineRequest(
+			strategy.klineType,
+			omTicker.GetSymbol(),
+			0,
+			startAt,
+		)
+
+		strategy.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldDTOKlineRequest, dtoKlineRequest).
+			Debug(object.URIEmpty)
+
+		omKlines, err := marketer.GetKlines(ctx, dtoKlineRequest)
+		if errors.Is(err, object.ErrExchangeCircuitOpen) {
+			strategy.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrKlineKucoinServiceGetList.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrKlineKucoinServiceGetList.Error())
+
+			return nil, err
+		}
+
+		if err != nil {
+			strategy.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrKlineKucoinServiceGetList.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrKlineKucoinServiceGetList.Error())
+
+			continue
+		}
+
+		strategy.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMKlines, omKlines).
+			Debug(object.URIEmpty)
+
+		openLowEquality := false
+
+		for _, omKline := range omKlines {
+			if omKline.GetOpen() == omKline.GetLow() {
+				openLowEquality = true
+
+				break
+			}
+		}
+
+		strategy.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOpenLowEquality, openLowEquality).
+			Debug(object.URIEmpty)
+
+		if !openLowEquality {
+			strategy.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`!openLowEquality`)
+
+			continue
+		}
+
+		omOrderBook, err := marketer.GetOrderBook(ctx, omTicker.GetSymbol())
+		if err != nil {
+			strategy.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrOrderBookServiceGetOrderBook.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrOrderBookServiceGetOrderBook.Error())
+
+			continue
+		}
+
+		marketRatio, err := strategy.marketRatio(omOrderBook)
+		if err != nil {
+			strategy.GetRuntimeLogger().
+				WithFields(fields).
+				WithField(object.URIFieldError, err).
+				Error(object.ErrSTRCONVParseFloat.Error())
+			traceSpan.RecordError(err)
+			traceSpan.SetStatus(codes.Error, object.ErrSTRCONVParseFloat.Error())
+
+			continue
+		}
+
+		strategy.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldMarketRatio, marketRatio).
+			Debug(object.URIEmpty)
+
+		if !marketRatio {
+			strategy.GetRuntimeLogger().
+				WithFields(fields).
+				Debug(`!marketRatio`)
+
+			continue
+		}
+
+		omSignal := om.NewSignal(
+			object.URIStrategyReasonOpenLowMarketRatio,
+			string(object.OrderSideTypeBuy),
+			strategy.GetName(),
+			omTicker.GetSymbol(),
+			timeNowUnix,
+			uuid.Nil,
+		)
+
+		strategy.GetRuntimeLogger().
+			WithFields(fields).
+			WithField(object.URIFieldOMSignal, omSignal).
+			Debug(object.URIEmpty)
+
+		omSignals = append(omSignals, omSignal)
+	}
+
+	return omSignals, nil
+}
+
+func (strategy *openLowMarketRatioStrategy) marketRatio(
+	omOrderBooker om.OrderBooker,
+) (bool, error) {
+	bidsValue, err := util.OrderBookValue(omOrderBooker.GetBids())
+	if err != nil {
+		return false, err
+	}
+
+	asksValue, err := util.OrderBookValue(omOrderBooker.GetAsks())
+	if err != nil {
+		return false, err
+	}
+
+	return bidsValue/asksValue > strategy.ratio, nil
+}
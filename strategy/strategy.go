@@ -0,0 +1,212 @@
+package strategy
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// Strategier is an interface.
+	Strategier interface {
+		// GetName is a function.
+		GetName() string
+		// Evaluate is a function.
+		Evaluate(
+			context.Context,
+			Marketer,
+		) ([]om.Signaler, error)
+	}
+
+	// Marketer is an interface.
+	Marketer interface {
+		object.GetTimer
+		// GetDebtRatio is a function.
+		GetDebtRatio(
+			context.Context,
+		) (string, error)
+		// GetExchangeMode is a function.
+		GetExchangeMode() object.ExchangeModeType
+		// GetKlines is a function.
+		GetKlines(
+			context.Context,
+			dto.KlineRequester,
+		) ([]om.Kliner, error)
+		// GetOrderBook is a function.
+		GetOrderBook(
+			context.Context,
+			string,
+		) (om.OrderBooker, error)
+		// GetTickers is a function.
+		GetTickers(
+			context.Context,
+			uint32,
+		) ([]om.Tickerer, error)
+	}
+
+	// Registrier is an interface.
+	Registrier interface {
+		// GetNames is a function.
+		GetNames() []string
+		// New is a function.
+		New(
+			string,
+		) (Strategier, error)
+		// NewList is a function.
+		NewList() ([]Strategier, error)
+		// Register is a function.
+		Register(
+			string,
+			Factory,
+		)
+	}
+
+	// Factory is a function.
+	Factory func(
+		configConfigger config.Configger,
+		logRuntimeLogger log.RuntimeLogger,
+		traceTracer trace.Tracer,
+		utilUUIDer util.UUIDer,
+		parameters map[string]string,
+	) (Strategier, error)
+
+	registry struct {
+		configConfigger  config.Configger
+		factories        map[string]Factory
+		logRuntimeLogger log.RuntimeLogger
+		mutex            sync.RWMutex
+		traceTracer      trace.Tracer
+		utilUUIDer       util.UUIDer
+	}
+)
+
+var (
+	_ Registrier           = (*registry)(nil)
+	_ config.GetConfigger  = (*registry)(nil)
+	_ log.GetRuntimeLogger = (*registry)(nil)
+	_ util.GetTracer       = (*registry)(nil)
+	_ util.GetUUIDer       = (*registry)(nil)
+)
+
+// NewRegistry is a function.
+// Every built-in strategy is registered already.
+func NewRegistry(
+	configConfigger config.Configger,
+	logRuntimeLogger log.RuntimeLogger,
+	traceTracer trace.Tracer,
+	utilUUIDer util.UUIDer,
+) Registrier {
+	registry := &registry{
+		configConfigger:  configConfigger,
+		factories:        map[string]Factory{},
+		logRuntimeLogger: logRuntimeLogger,
+		mutex:            sync.RWMutex{},
+		traceTracer:      traceTracer,
+		utilUUIDer:       utilUUIDer,
+	}
+
+	registry.Register(object.URIStrategyOpenLowMarketRatio, NewOpenLowMarketRatioStrategy)
+
+	return registry
+}
+
+// GetConfigger is a function.
+func (registry *registry) GetConfigger() config.Configger {
+	return registry.configConfigger
+}
+
+// GetRuntimeLogger is a function.
+func (registry *registry) GetRuntimeLogger() log.RuntimeLogger {
+	return registry.logRuntimeLogger
+}
+
+// GetTracer is a function.
+func (registry *registry) GetTracer() trace.Tracer {
+	return registry.traceTracer
+}
+
+// GetUUIDer is a function.
+func (registry *registry) GetUUIDer() util.UUIDer {
+	return registry.utilUUIDer
+}
+
+// GetNames is a function.
+func (registry *registry) GetNames() []string {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	names := make([]string, 0, len(registry.factories))
+	for name := range registry.factories {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// New is a function.
+// The strategy gets the config parameters prefixed by its name and a dot,
+// for example open_low_market_ratio.ratio.
+func (registry *registry) New(
+	name string,
+) (Strategier, error) {
+	registry.mutex.RLock()
+	factory, ok := registry.factories[name]
+	registry.mutex.RUnlock()
+
+	if !ok {
+		return nil, object.ErrStrategyRegistryNotFound
+	}
+
+	parameters := map[string]string{}
+
+	for key, value := range registry.GetConfigger().GetStrategyConfigger().GetParameters() {
+		if parameter, found := strings.CutPrefix(key, name+object.URIStrategyParameterSeparator); found {
+			parameters[parameter] = value
+		}
+	}
+
+	return factory(
+		registry.GetConfigger(),
+		registry.GetRuntimeLogger(),
+		registry.GetTracer(),
+		registry.GetUUIDer(),
+		parameters,
+	)
+}
+
+// NewList is a function.
+// It builds every strategy enabled in the strategy config, in the configured order.
+func (registry *registry) NewList() ([]Strategier, error) {
+	names := registry.GetConfigger().GetStrategyConfigger().GetNames()
+	strategiers := make([]Strategier, 0, len(names))
+
+	for _, name := range names {
+		strategier, err := registry.New(name)
+		if err != nil {
+			return nil, err
+		}
+
+		strategiers = append(strategiers, strategier)
+	}
+
+	return strategiers, nil
+}
+
+// Register is a function.
+func (registry *registry) Register(
+	name string,
+	factory Factory,
+) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	registry.factories[name] = factory
+}
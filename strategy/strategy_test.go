@@ -0,0 +1,429 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ShahoBashoki/kucoin/config"
+	"github.com/ShahoBashoki/kucoin/log"
+	"github.com/ShahoBashoki/kucoin/object"
+	"github.com/ShahoBashoki/kucoin/object/dto"
+	"github.com/ShahoBashoki/kucoin/object/om"
+	"github.com/ShahoBashoki/kucoin/util"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type (
+	strategyTestMarket struct {
+		Marketer
+		errKlines      error
+		klines         map[string][]om.Kliner
+		mode           object.ExchangeModeType
+		now            time.Time
+		orderBooks     map[string]om.OrderBooker
+		tickers        []om.Tickerer
+		klineRequests  []dto.KlineRequester
+		tickerRequests []uint32
+	}
+
+	strategyTestTimer struct {
+		object.Timer
+		now time.Time
+	}
+)
+
+// GetExchangeMode is a function.
+func (market *strategyTestMarket) GetExchangeMode() object.ExchangeModeType {
+	return market.mode
+}
+
+// GetKlines is a function.
+func (market *strategyTestMarket) GetKlines(
+	_ context.Context,
+	dtoKlineRequester dto.KlineRequester,
+) ([]om.Kliner, error) {
+	market.klineRequests = append(market.klineRequests, dtoKlineRequester)
+
+	if market.errKlines != nil {
+		return nil, market.errKlines
+	}
+
+	return market.klines[dtoKlineRequester.GetSymbol()], nil
+}
+
+// GetOrderBook is a function.
+func (market *strategyTestMarket) GetOrderBook(
+	_ context.Context,
+	symbol string,
+) (om.OrderBooker, error) {
+	return market.orderBooks[symbol], nil
+}
+
+// GetTickers is a function.
+func (market *strategyTestMarket) GetTickers(
+	_ context.Context,
+	count uint32,
+) ([]om.Tickerer, error) {
+	market.tickerRequests = append(market.tickerRequests, count)
+
+	return market.tickers, nil
+}
+
+// GetTimer is a function.
+func (market *strategyTestMarket) GetTimer() object.Timer {
+	return &strategyTestTimer{
+		Timer: nil,
+		now:   market.now,
+	}
+}
+
+// NowUTC is a function.
+func (timer *strategyTestTimer) NowUTC() time.Time {
+	return timer.now
+}
+
+func newStrategyTestRegistry(
+	names []string,
+	parameters map[string]string,
+) Registrier {
+	configConfigger := config.NewConfig(
+		config.WithLogConfigger(),
+		config.WithStrategyConfigger(
+			config.WithStrategyConfigNames(names),
+			config.WithStrategyConfigParameters(parameters),
+		),
+	)
+
+	return NewRegistry(
+		configConfigger,
+		log.NewRuntimeLog(configConfigger, map[string]any{}, zap.NewNop()),
+		trace.NewNoopTracerProvider().Tracer(object.URIEmpty),
+		util.NewUUID(),
+	)
+}
+
+func newStrategyTestTicker(
+	symbol string,
+) om.Tickerer {
+	return om.NewTicker(
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		symbol,
+		symbol,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		object.URIEmpty,
+		uuid.Nil,
+	)
+}
+
+func newStrategyTestKline(
+	symbol string,
+	open string,
+	low string,
+) om.Kliner {
+	return om.NewKline(
+		"105",
+		"110",
+		string(object.KlineTypeType5min),
+		low,
+		open,
+		symbol,
+		"1000",
+		"10",
+		1704067200,
+		uuid.Nil,
+	)
+}
+
+func newStrategyTestOrderBook(
+	symbol string,
+	bid string,
+	ask string,
+) om.OrderBooker {
+	return om.NewOrderBook(
+		[][]string{{ask, "0"}},
+		[][]string{{bid, "0"}},
+		symbol,
+		1,
+		1704067200000,
+		uuid.Nil,
+	)
+}
+
+func newStrategyTestMarket() *strategyTestMarket {
+	return &strategyTestMarket{
+		Marketer:  nil,
+		errKlines: nil,
+		klines: map[string][]om.Kliner{
+			"AAA-USDT": {newStrategyTestKline("AAA-USDT", "100", "100")},
+			"BBB-USDT": {newStrategyTestKline("BBB-USDT", "100", "99")},
+			"CCC-USDT": {newStrategyTestKline("CCC-USDT", "100", "100")},
+		},
+		mode: object.ExchangeModeTypeOpen,
+		now:  time.Date(2024, time.January, 1, 0, 7, 0, 0, time.UTC),
+		orderBooks: map[string]om.OrderBooker{
+			"AAA-USDT": newStrategyTestOrderBook("AAA-USDT", "30", "10"),
+			"BBB-USDT": newStrategyTestOrderBook("BBB-USDT", "30", "10"),
+			"CCC-USDT": newStrategyTestOrderBook("CCC-USDT", "15", "10"),
+		},
+		tickers: []om.Tickerer{
+			newStrategyTestTicker("AAA-USDT"),
+			newStrategyTestTicker("BBB-USDT"),
+			newStrategyTestTicker("CCC-USDT"),
+		},
+		klineRequests:  []dto.KlineRequester{},
+		tickerRequests: []uint32{},
+	}
+}
+
+func TestRegistryNew(t *testing.T) {
+	t.Parallel()
+
+	registrier := newStrategyTestRegistry(
+		[]string{object.URIStrategyOpenLowMarketRatio},
+		map[string]string{
+			object.URIStrategyOpenLowMarketRatio + object.URIStrategyParameterSeparator +
+				object.URIStrategyParameterRatio: "3",
+			object.URIStrategyOpenLowMarketRatio + object.URIStrategyParameterSeparator +
+				object.URIStrategyParameterTickerCount: "5",
+			"other" + object.URIStrategyParameterSeparator + object.URIStrategyParameterRatio: "x",
+		},
+	)
+
+	if got := registrier.GetNames(); len(got) != 1 || got[0] != object.URIStrategyOpenLowMarketRatio {
+		t.Errorf("GetNames() = %v, want %v", got, []string{object.URIStrategyOpenLowMarketRatio})
+	}
+
+	strategiers, err := registrier.NewList()
+	if err != nil {
+		t.Fatalf("NewList() error = %v", err)
+	}
+
+	if len(strategiers) != 1 {
+		t.Fatalf("NewList() = %d strategies, want 1", len(strategiers))
+	}
+
+	// Only the parameters prefixed by the name of the strategy reach it.
+	strategy, ok := strategiers[0].(*openLowMarketRatioStrategy)
+	if !ok {
+		t.Fatalf("NewList()[0] = %T, want *openLowMarketRatioStrategy", strategiers[0])
+	}
+
+	if strategy.ratio != 3 || strategy.tickerCount != 5 || strategy.klineType != object.KlineTypeType5min {
+		t.Errorf(
+			"ratio, tickerCount, klineType = %v, %d, %q, want 3, 5, %q",
+			strategy.ratio,
+			strategy.tickerCount,
+			strategy.klineType,
+			object.KlineTypeType5min,
+		)
+	}
+
+	if _, err = registrier.New("missing"); !errors.Is(err, object.ErrStrategyRegistryNotFound) {
+		t.Errorf("New() error = %v, want %v", err, object.ErrStrategyRegistryNotFound)
+	}
+}
+
+func TestRegistryRegister(t *testing.T) {
+	t.Parallel()
+
+	registrier := newStrategyTestRegistry([]string{"custom"}, map[string]string{
+		"custom" + object.URIStrategyParameterSeparator + "threshold": "7",
+	})
+
+	var got map[string]string
+
+	registrier.Register("custom", func(
+		configConfigger config.Configger,
+		logRuntimeLogger log.RuntimeLogger,
+		traceTracer trace.Tracer,
+		utilUUIDer util.UUIDer,
+		parameters map[string]string,
+	) (Strategier, error) {
+		got = parameters
+
+		return NewOpenLowMarketRatioStrategy(
+			configConfigger,
+			logRuntimeLogger,
+			traceTracer,
+			utilUUIDer,
+			map[string]string{},
+		)
+	})
+
+	names := registrier.GetNames()
+	sort.Strings(names)
+
+	if len(names) != 2 || names[0] != "custom" || names[1] != object.URIStrategyOpenLowMarketRatio {
+		t.Errorf("GetNames() = %v, want custom and %s", names, object.URIStrategyOpenLowMarketRatio)
+	}
+
+	if _, err := registrier.NewList(); err != nil {
+		t.Fatalf("NewList() error = %v", err)
+	}
+
+	if got["threshold"] != "7" {
+		t.Errorf("parameters = %v, want threshold 7", got)
+	}
+}
+
+func TestNewOpenLowMarketRatioStrategyInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, parameters := range []map[string]string{
+		{object.URIStrategyParameterRatio: "x"},
+		{object.URIStrategyParameterTickerCount: "-1"},
+	} {
+		if _, err := NewOpenLowMarketRatioStrategy(
+			nil,
+			nil,
+			nil,
+			nil,
+			parameters,
+		); !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("NewOpenLowMarketRatioStrategy(%v) error = %v, want %v", parameters, err, strconv.ErrSyntax)
+		}
+	}
+}
+
+func TestOpenLowMarketRatioStrategyEvaluate(t *testing.T) {
+	t.Parallel()
+
+	registrier := newStrategyTestRegistry([]string{}, map[string]string{})
+
+	tests := []struct {
+		name         string
+		market       func() *strategyTestMarket
+		wantSymbols  []string
+		wantErr      error
+		wantRequests int
+	}{
+		{
+			name:         "open at low and bids outweigh asks",
+			market:       newStrategyTestMarket,
+			wantSymbols:  []string{"AAA-USDT"},
+			wantErr:      nil,
+			wantRequests: 3,
+		},
+		{
+			name: "exchange not open",
+			market: func() *strategyTestMarket {
+				market := newStrategyTestMarket()
+				market.mode = object.ExchangeModeTypeCancelOnly
+
+				return market
+			},
+			wantSymbols:  []string{},
+			wantErr:      nil,
+			wantRequests: 0,
+		},
+		{
+			name: "circuit open",
+			market: func() *strategyTestMarket {
+				market := newStrategyTestMarket()
+				market.errKlines = object.ErrExchangeCircuitOpen
+
+				return market
+			},
+			wantSymbols:  nil,
+			wantErr:      object.ErrExchangeCircuitOpen,
+			wantRequests: 1,
+		},
+		{
+			name: "klines failed",
+			market: func() *strategyTestMarket {
+				market := newStrategyTestMarket()
+				market.errKlines = object.ErrKlineKucoinServiceGetList
+
+				return market
+			},
+			wantSymbols:  []string{},
+			wantErr:      nil,
+			wantRequests: 3,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			strategier, err := registrier.New(object.URIStrategyOpenLowMarketRatio)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+
+			market := test.market()
+
+			omSignalers, err := strategier.Evaluate(context.Background(), market)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("Evaluate() error = %v, want %v", err, test.wantErr)
+			}
+
+			if len(market.klineRequests) != test.wantRequests {
+				t.Errorf("GetKlines() calls = %d, want %d", len(market.klineRequests), test.wantRequests)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			if len(omSignalers) != len(test.wantSymbols) {
+				t.Fatalf("Evaluate() = %v, want %v", omSignalers, test.wantSymbols)
+			}
+
+			for index, omSignaler := range omSignalers {
+				if omSignaler.GetSymbol() != test.wantSymbols[index] ||
+					omSignaler.GetSide() != string(object.OrderSideTypeBuy) ||
+					omSignaler.GetReason() != object.URIStrategyReasonOpenLowMarketRatio {
+					t.Errorf("Evaluate()[%d] = %v, want a buy of %s", index, omSignaler, test.wantSymbols[index])
+				}
+			}
+		})
+	}
+}
+
+func TestOpenLowMarketRatioStrategyEvaluateKlineRequest(t *testing.T) {
+	t.Parallel()
+
+	strategier, err := newStrategyTestRegistry([]string{}, map[string]string{}).
+		New(object.URIStrategyOpenLowMarketRatio)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	market := newStrategyTestMarket()
+	market.tickers = market.tickers[:1]
+
+	if _, err = strategier.Evaluate(context.Background(), market); err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+
+	if len(market.tickerRequests) != 1 || market.tickerRequests[0] != object.NUMTopTickerChangeRateCount {
+		t.Errorf("GetTickers() = %v, want %d", market.tickerRequests, object.NUMTopTickerChangeRateCount)
+	}
+
+	// 00:07 is inside the 00:05 kline, so the request starts at the closed one
+	// before it.
+	want := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC).Unix() - object.NUMKlineDifference
+	if got := market.klineRequests[0].GetStartAt(); got != want {
+		t.Errorf("GetStartAt() = %d, want %d", got, want)
+	}
+}
@@ -34,3 +34,34 @@ func Cast(
 
 	return out
 }
+
+// CastStringMapString is a function.
+// It keeps the entries whose value is an object, with the string fields of the
+// object.
+func CastStringMapString(
+	in map[string]any,
+) map[string]map[string]string {
+	out := map[string]map[string]string{}
+
+	for key, value := range in {
+		value, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		innerMap := make(map[string]string, len(value))
+
+		for innerKey, innerValue := range value {
+			innerValue, okInnerValue := innerValue.(string)
+			if !okInnerValue {
+				continue
+			}
+
+			innerMap[innerKey] = innerValue
+		}
+
+		out[key] = innerMap
+	}
+
+	return out
+}
@@ -0,0 +1,21 @@
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+// ClientOID is a function.
+// It derives the clientOid from the parts that identify an order intent, such as
+// the strategy, the symbol, the side and the signal time, so a retry of the same
+// intent always reuses the same clientOid.
+func ClientOID(
+	parts ...string,
+) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, object.URIClientOIDSeparator)))
+
+	return hex.EncodeToString(sum[:])[:object.NUMClientOIDLength]
+}
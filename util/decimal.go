@@ -0,0 +1,153 @@
+package util
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+// DecimalAdd is a function.
+func DecimalAdd(
+	first string,
+	second string,
+) (string, error) {
+	firstRat, ok := new(big.Rat).SetString(first)
+	if !ok {
+		return object.URIEmpty, object.ErrDecimalParse
+	}
+
+	secondRat, ok := new(big.Rat).SetString(second)
+	if !ok {
+		return object.URIEmpty, object.ErrDecimalParse
+	}
+
+	precision := decimalPrecision(first)
+	if secondPrecision := decimalPrecision(second); secondPrecision > precision {
+		precision = secondPrecision
+	}
+
+	return new(big.Rat).Add(firstRat, secondRat).FloatString(precision), nil
+}
+
+// DecimalCompare is a function.
+func DecimalCompare(
+	first string,
+	second string,
+) (int, error) {
+	firstRat, ok := new(big.Rat).SetString(first)
+	if !ok {
+		return 0, object.ErrDecimalParse
+	}
+
+	secondRat, ok := new(big.Rat).SetString(second)
+	if !ok {
+		return 0, object.ErrDecimalParse
+	}
+
+	return firstRat.Cmp(secondRat), nil
+}
+
+// DecimalDivide is a function.
+// It keeps as many decimals as the increment has, rounding the last one.
+func DecimalDivide(
+	first string,
+	second string,
+	increment string,
+) (string, error) {
+	firstRat, ok := new(big.Rat).SetString(first)
+	if !ok {
+		return object.URIEmpty, object.ErrDecimalParse
+	}
+
+	secondRat, ok := new(big.Rat).SetString(second)
+	if !ok || secondRat.Sign() == 0 {
+		return object.URIEmpty, object.ErrDecimalParse
+	}
+
+	return new(big.Rat).Quo(firstRat, secondRat).FloatString(decimalPrecision(increment)), nil
+}
+
+// DecimalMultiply is a function.
+func DecimalMultiply(
+	first string,
+	second string,
+) (string, error) {
+	firstRat, ok := new(big.Rat).SetString(first)
+	if !ok {
+		return object.URIEmpty, object.ErrDecimalParse
+	}
+
+	secondRat, ok := new(big.Rat).SetString(second)
+	if !ok {
+		return object.URIEmpty, object.ErrDecimalParse
+	}
+
+	return new(big.Rat).
+		Mul(firstRat, secondRat).
+		FloatString(decimalPrecision(first) + decimalPrecision(second)), nil
+}
+
+// DecimalRound is a function.
+// It rounds the value to a multiple of the increment, towards zero unless up is
+// set, and keeps as many decimals as the increment has.
+func DecimalRound(
+	value string,
+	increment string,
+	up bool,
+) (string, error) {
+	valueRat, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return object.URIEmpty, object.ErrDecimalParse
+	}
+
+	incrementRat, ok := new(big.Rat).SetString(increment)
+	if !ok || incrementRat.Sign() <= 0 {
+		return object.URIEmpty, object.ErrDecimalParse
+	}
+
+	quotientRat := new(big.Rat).Quo(valueRat, incrementRat)
+	quotient, remainder := new(big.Int).QuoRem(quotientRat.Num(), quotientRat.Denom(), new(big.Int))
+
+	if up && remainder.Sign() > 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+
+	return new(big.Rat).
+		Mul(new(big.Rat).SetInt(quotient), incrementRat).
+		FloatString(decimalPrecision(increment)), nil
+}
+
+// DecimalSubtract is a function.
+func DecimalSubtract(
+	first string,
+	second string,
+) (string, error) {
+	firstRat, ok := new(big.Rat).SetString(first)
+	if !ok {
+		return object.URIEmpty, object.ErrDecimalParse
+	}
+
+	secondRat, ok := new(big.Rat).SetString(second)
+	if !ok {
+		return object.URIEmpty, object.ErrDecimalParse
+	}
+
+	precision := decimalPrecision(first)
+	if secondPrecision := decimalPrecision(second); secondPrecision > precision {
+		precision = secondPrecision
+	}
+
+	return new(big.Rat).Sub(firstRat, secondRat).FloatString(precision), nil
+}
+
+func decimalPrecision(
+	value string,
+) int {
+	index := strings.IndexByte(value, '.')
+	if index == -1 {
+		return 0
+	}
+
+	return len(value) - index - 1
+}
@@ -0,0 +1,25 @@
+package util
+
+import (
+	"github.com/ShahoBashoki/kucoin/object"
+)
+
+// KlineBucketStartAt is a function.
+func KlineBucketStartAt(
+	klineType object.KlineTypeType,
+	startAt int64,
+) int64 {
+	secondKlineType := KlineTypeToSecond(klineType)
+	offset := int64(0)
+
+	if klineType == object.KlineTypeType1week {
+		offset = object.NUMUnixEpochToFirstMondayToSecond
+	}
+
+	modTime := (startAt - offset) % secondKlineType
+	if modTime < 0 {
+		modTime += secondKlineType
+	}
+
+	return startAt - modTime
+}
@@ -0,0 +1,34 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// OrderBookValue is a function.
+// It adds price and size of every level up to the middle of the book.
+func OrderBookValue(
+	levels [][]string,
+) (float64, error) {
+	value := 0.0
+
+	for key, level := range levels {
+		if key > len(levels)/2 {
+			break
+		}
+
+		price, err := strconv.ParseFloat(level[0], 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w", err)
+		}
+
+		size, err := strconv.ParseFloat(level[1], 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w", err)
+		}
+
+		value += price + size
+	}
+
+	return value, nil
+}
@@ -21,6 +21,14 @@ type (
 		GetClientPort() string
 		// GetUserID is a function.
 		GetUserID() uuid.UUID
+		// GetAccount is a function.
+		GetAccount() string
+		// GetStrategy is a function.
+		GetStrategy() string
+		// GetIntentAt is a function.
+		GetIntentAt() int64
+		// GetReduceOnly is a function.
+		GetReduceOnly() bool
 	}
 
 	runtimeContext struct {
@@ -28,6 +36,10 @@ type (
 		clientHost string
 		clientPort string
 		userID     uuid.UUID
+		account    string
+		strategy   string
+		intentAt   int64
+		reduceOnly bool
 	}
 )
 
@@ -68,16 +80,59 @@ func NewRuntimeContext(
 		userUUID = uuid.Nil
 	}
 
+	account, ok := values[object.URIRuntimeContextAccount].(string)
+	if !ok {
+		account = object.URIEmpty
+	}
+
+	strategy, ok := values[object.URIRuntimeContextStrategy].(string)
+	if !ok {
+		strategy = object.URIEmpty
+	}
+
+	intentAt, ok := values[object.URIRuntimeContextIntentAt].(int64)
+	if !ok {
+		intentAt = 0
+	}
+
+	reduceOnly, ok := values[object.URIRuntimeContextReduceOnly].(bool)
+	if !ok {
+		reduceOnly = false
+	}
+
 	runtimeContext := &runtimeContext{
 		md:         metadataMD,
 		clientHost: clientHost,
 		clientPort: clientPort,
 		userID:     userUUID,
+		account:    account,
+		strategy:   strategy,
+		intentAt:   intentAt,
+		reduceOnly: reduceOnly,
 	}
 
 	return runtimeContext
 }
 
+// WithRuntimeContextValue is a function.
+// It returns a copy of the context whose runtime context has the value under
+// the key, leaving the tags of the parent context untouched.
+func WithRuntimeContextValue(
+	ctx context.Context,
+	key string,
+	value any,
+) context.Context {
+	grpcTagsTags := grpcTags.NewTags()
+
+	for tagKey, tagValue := range grpcTags.Extract(ctx).Values() {
+		grpcTagsTags.Set(tagKey, tagValue)
+	}
+
+	grpcTagsTags.Set(key, value)
+
+	return grpcTags.SetInContext(ctx, grpcTagsTags)
+}
+
 // GetMetadata is a function.
 func (runtimeContext *runtimeContext) GetMetadata() metadata.MD {
 	return runtimeContext.md
@@ -98,6 +153,30 @@ func (runtimeContext *runtimeContext) GetUserID() uuid.UUID {
 	return runtimeContext.userID
 }
 
+// GetAccount is a function.
+func (runtimeContext *runtimeContext) GetAccount() string {
+	return runtimeContext.account
+}
+
+// GetStrategy is a function.
+func (runtimeContext *runtimeContext) GetStrategy() string {
+	return runtimeContext.strategy
+}
+
+// GetIntentAt is a function.
+// It is the Unix time of the signal or the decision an order comes from, or
+// zero when the context names none.
+func (runtimeContext *runtimeContext) GetIntentAt() int64 {
+	return runtimeContext.intentAt
+}
+
+// GetReduceOnly is a function.
+// It tells whether the orders of the context only close a position, like the
+// protective legs of a bracket do.
+func (runtimeContext *runtimeContext) GetReduceOnly() bool {
+	return runtimeContext.reduceOnly
+}
+
 // GetMap is a function.
 func (runtimeContext *runtimeContext) GetMap() map[string]any {
 	return map[string]any{
@@ -105,6 +184,10 @@ func (runtimeContext *runtimeContext) GetMap() map[string]any {
 		"client_host": runtimeContext.GetClientHost(),
 		"client_port": runtimeContext.GetClientPort(),
 		"user_id":     runtimeContext.GetUserID(),
+		"account":     runtimeContext.GetAccount(),
+		"strategy":    runtimeContext.GetStrategy(),
+		"intent_at":   runtimeContext.GetIntentAt(),
+		"reduce_only": runtimeContext.GetReduceOnly(),
 	}
 }
 